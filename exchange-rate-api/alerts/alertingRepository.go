@@ -0,0 +1,57 @@
+package alerts
+
+import (
+	"context"
+
+	"github.com/kolan92/exchange-rate-api/logging"
+	"github.com/kolan92/exchange-rate-api/models"
+	"github.com/kolan92/exchange-rate-api/repositories"
+)
+
+// AlertingRepository evaluates alert rules for every exchange rate stored through the wrapped repository.
+type AlertingRepository struct {
+	repositories.CurrenciesRepository
+	evaluator *Evaluator
+}
+
+func NewAlertingRepository(repo repositories.CurrenciesRepository, evaluator *Evaluator) repositories.CurrenciesRepository {
+	return &AlertingRepository{repo, evaluator}
+}
+
+func (r *AlertingRepository) InsertExchangeRate(ctx context.Context, exchangeRate *models.ExchangeRate) error {
+	if err := r.CurrenciesRepository.InsertExchangeRate(ctx, exchangeRate); err != nil {
+		return err
+	}
+
+	go r.evaluator.Evaluate(logging.Detach(ctx), *exchangeRate)
+	return nil
+}
+
+func (r *AlertingRepository) UpdateExchangeRate(ctx context.Context, exchangeRate *models.ExchangeRate) error {
+	if err := r.CurrenciesRepository.UpdateExchangeRate(ctx, exchangeRate); err != nil {
+		return err
+	}
+
+	go r.evaluator.Evaluate(logging.Detach(ctx), *exchangeRate)
+	return nil
+}
+
+// ImportExchangeRates evaluates inserted and updated rates one by one in single goroutine,
+// so large imports don't start goroutine per rate.
+func (r *AlertingRepository) ImportExchangeRates(ctx context.Context, exchangeRates []models.ExchangeRate, replace bool) (models.ImportSummary, error) {
+	summary, err := r.CurrenciesRepository.ImportExchangeRates(ctx, exchangeRates, replace)
+	if err != nil || len(summary.Stored) == 0 {
+		return summary, err
+	}
+
+	storedRates := make([]models.ExchangeRate, 0, len(summary.Stored))
+	for _, i := range summary.Stored {
+		storedRates = append(storedRates, exchangeRates[i])
+	}
+	go func(ctx context.Context) {
+		for _, exchangeRate := range storedRates {
+			r.evaluator.Evaluate(ctx, exchangeRate)
+		}
+	}(logging.Detach(ctx))
+	return summary, nil
+}
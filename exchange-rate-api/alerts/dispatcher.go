@@ -0,0 +1,128 @@
+package alerts
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/kolan92/exchange-rate-api/logging"
+	"github.com/kolan92/exchange-rate-api/models"
+	"github.com/kolan92/exchange-rate-api/repositories"
+	"go.uber.org/zap"
+)
+
+const (
+	// SignatureHeader contains hex encoded HMAC-SHA256 of the request body, keyed with the rule secret.
+	SignatureHeader = "X-Signature-256"
+	AttemptHeader   = "X-Delivery-Attempt"
+
+	defaultMaxAttempts = 5
+	defaultRetryDelay  = time.Second
+	defaultTimeout     = 10 * time.Second
+)
+
+// Dispatcher delivers webhooks, retrying failed deliveries with exponential backoff.
+// Every attempt is stored in delivery log.
+type Dispatcher struct {
+	alertsRepo  repositories.AlertsRepository
+	client      *http.Client
+	maxAttempts int
+	retryDelay  time.Duration
+}
+
+func NewDispatcher(alertsRepo repositories.AlertsRepository) *Dispatcher {
+	return &Dispatcher{
+		alertsRepo:  alertsRepo,
+		client:      &http.Client{Timeout: defaultTimeout},
+		maxAttempts: defaultMaxAttempts,
+		retryDelay:  defaultRetryDelay,
+	}
+}
+
+// Dispatch blocks until webhook is delivered or all attempts failed.
+func (d *Dispatcher) Dispatch(ctx context.Context, alertRule models.AlertRule, event models.AlertEvent) {
+	logger := logging.FromContext(ctx).With(zap.Int("alertRuleId", alertRule.Id))
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		logger.Error("Can't serialize alert event", zap.Error(err))
+		return
+	}
+
+	retryDelay := d.retryDelay
+	for attempt := 1; attempt <= d.maxAttempts; attempt++ {
+		delivery := d.deliver(alertRule, payload, attempt)
+
+		if err := d.alertsRepo.InsertWebhookDelivery(ctx, delivery); err != nil {
+			logger.Error("Can't store webhook delivery", zap.Int("attempt", attempt), zap.Error(err))
+		}
+
+		if delivery.Error == nil {
+			return
+		}
+
+		if attempt < d.maxAttempts {
+			time.Sleep(retryDelay)
+			retryDelay *= 2
+		}
+	}
+
+	logger.Warn("Webhook delivery failed", zap.Int("attempts", d.maxAttempts))
+}
+
+func (d *Dispatcher) deliver(alertRule models.AlertRule, payload []byte, attempt int) *models.WebhookDelivery {
+	delivery := &models.WebhookDelivery{
+		AlertRuleId: alertRule.Id,
+		Payload:     string(payload),
+		Attempt:     attempt,
+	}
+
+	request, err := http.NewRequest(http.MethodPost, alertRule.CallbackUrl, bytes.NewReader(payload))
+	if err != nil {
+		return withDeliveryError(delivery, err.Error())
+	}
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set(SignatureHeader, "sha256="+Sign(alertRule.Secret, payload))
+	request.Header.Set(AttemptHeader, fmt.Sprint(attempt))
+
+	response, err := d.client.Do(request)
+	if err != nil {
+		return withDeliveryError(delivery, err.Error())
+	}
+	defer response.Body.Close()
+
+	delivery.StatusCode = &response.StatusCode
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return withDeliveryError(delivery, fmt.Sprintf("unexpected status code %d", response.StatusCode))
+	}
+
+	return delivery
+}
+
+func withDeliveryError(delivery *models.WebhookDelivery, err string) *models.WebhookDelivery {
+	delivery.Error = &err
+	return delivery
+}
+
+// Sign returns hex encoded HMAC-SHA256 of the payload, receivers can use it to verify webhooks.
+func Sign(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// NewSecret generates random secret used to sign webhooks of a rule.
+func NewSecret() (string, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(secret), nil
+}
@@ -0,0 +1,79 @@
+package alerts
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/kolan92/exchange-rate-api/models"
+	testhelpers "github.com/kolan92/exchange-rate-api/testHelpers"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestDispatcher(alertsRepo *testhelpers.MockAlertsRepository) *Dispatcher {
+	dispatcher := NewDispatcher(alertsRepo)
+	dispatcher.maxAttempts = 3
+	dispatcher.retryDelay = time.Millisecond
+	return dispatcher
+}
+
+func TestDispatchSignsPayload(t *testing.T) {
+	const secret = "secret"
+	var signature, body string
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		payload, _ := ioutil.ReadAll(r.Body)
+		body = string(payload)
+		signature = r.Header.Get(SignatureHeader)
+	}))
+	defer receiver.Close()
+
+	alertsRepo := testhelpers.NewMockAlertsRepository()
+	alertRule := models.AlertRule{Id: 1, CallbackUrl: receiver.URL, Secret: secret}
+
+	newTestDispatcher(alertsRepo).Dispatch(context.Background(), alertRule, models.AlertEvent{AlertRuleId: 1})
+
+	assert.Equal(t, "sha256="+Sign(secret, []byte(body)), signature)
+	assert.Len(t, alertsRepo.WebhookDeliveries, 1)
+	assert.Nil(t, alertsRepo.WebhookDeliveries[0].Error)
+	assert.Equal(t, http.StatusOK, *alertsRepo.WebhookDeliveries[0].StatusCode)
+}
+
+func TestDispatchRetriesFailedDeliveries(t *testing.T) {
+	requests := 0
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests < 2 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer receiver.Close()
+
+	alertsRepo := testhelpers.NewMockAlertsRepository()
+	alertRule := models.AlertRule{Id: 1, CallbackUrl: receiver.URL, Secret: "secret"}
+
+	newTestDispatcher(alertsRepo).Dispatch(context.Background(), alertRule, models.AlertEvent{AlertRuleId: 1})
+
+	assert.Equal(t, 2, requests)
+	assert.Len(t, alertsRepo.WebhookDeliveries, 2)
+	assert.Equal(t, http.StatusServiceUnavailable, *alertsRepo.WebhookDeliveries[0].StatusCode)
+	assert.NotNil(t, alertsRepo.WebhookDeliveries[0].Error)
+	assert.Equal(t, 2, alertsRepo.WebhookDeliveries[1].Attempt)
+	assert.Nil(t, alertsRepo.WebhookDeliveries[1].Error)
+}
+
+func TestDispatchGivesUpAfterMaxAttempts(t *testing.T) {
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer receiver.Close()
+
+	alertsRepo := testhelpers.NewMockAlertsRepository()
+	alertRule := models.AlertRule{Id: 1, CallbackUrl: receiver.URL, Secret: "secret"}
+
+	newTestDispatcher(alertsRepo).Dispatch(context.Background(), alertRule, models.AlertEvent{AlertRuleId: 1})
+
+	assert.Len(t, alertsRepo.WebhookDeliveries, 3)
+}
@@ -0,0 +1,123 @@
+package alerts
+
+import (
+	"context"
+	"time"
+
+	"github.com/kolan92/exchange-rate-api/logging"
+	"github.com/kolan92/exchange-rate-api/models"
+	"github.com/kolan92/exchange-rate-api/repositories"
+	"github.com/shopspring/decimal"
+	"go.uber.org/zap"
+)
+
+// previousRateLookback is how far back previous rate is searched, long enough to skip weekends and holidays.
+const previousRateLookback = 7 * 24 * time.Hour
+
+var hundred = decimal.NewFromInt(100)
+
+// Evaluator checks alert rules against inserted exchange rates and dispatches webhooks for the triggered ones.
+type Evaluator struct {
+	currenciesRepo repositories.CurrenciesRepository
+	alertsRepo     repositories.AlertsRepository
+	dispatcher     *Dispatcher
+}
+
+func NewEvaluator(currenciesRepo repositories.CurrenciesRepository, alertsRepo repositories.AlertsRepository, dispatcher *Dispatcher) *Evaluator {
+	return &Evaluator{currenciesRepo, alertsRepo, dispatcher}
+}
+
+// Evaluate compares exchange rate with the previous known rate of the same currencies.
+// Webhooks of triggered rules are delivered in background.
+func (e *Evaluator) Evaluate(ctx context.Context, exchangeRate models.ExchangeRate) {
+	if exchangeRate.Rate == nil {
+		return
+	}
+
+	alertRules, err := e.alertsRepo.GetAlertRulesForCurrencies(ctx, exchangeRate.Source, exchangeRate.Destination)
+	if err != nil {
+		logging.FromContext(ctx).Error("Can't get alert rules",
+			zap.String("source", exchangeRate.Source),
+			zap.String("destination", exchangeRate.Destination),
+			zap.Error(err))
+		return
+	}
+
+	if len(alertRules) == 0 {
+		return
+	}
+
+	previous, err := e.getPreviousExchangeRate(ctx, exchangeRate)
+	if err != nil {
+		logging.FromContext(ctx).Error("Can't get previous exchange rate",
+			zap.String("source", exchangeRate.Source),
+			zap.String("destination", exchangeRate.Destination),
+			zap.Error(err))
+		return
+	}
+
+	for _, alertRule := range alertRules {
+		if !IsTriggered(alertRule, *exchangeRate.Rate, previous) {
+			continue
+		}
+
+		event := models.AlertEvent{
+			AlertRuleId:   alertRule.Id,
+			ExchangeRate:  exchangeRate,
+			Threshold:     alertRule.Threshold,
+			ChangePercent: alertRule.ChangePercent,
+		}
+		if previous != nil {
+			event.PreviousRate = previous.Rate
+			event.PreviousRateDate = &previous.Date
+		}
+
+		go e.dispatcher.Dispatch(ctx, alertRule, event)
+	}
+}
+
+// getPreviousExchangeRate returns most recent not null rate before the date of exchange rate, or nil if there is none.
+func (e *Evaluator) getPreviousExchangeRate(ctx context.Context, exchangeRate models.ExchangeRate) (*models.ExchangeRate, error) {
+	currencyCodesMap := e.currenciesRepo.GetCurrenciesCodesIdsMap(ctx)
+	from := exchangeRate.Date.Add(-previousRateLookback)
+	till := exchangeRate.Date
+
+	exchangeRates, err := e.currenciesRepo.GetRangeExchangeRate(
+		ctx,
+		currencyCodesMap[exchangeRate.Source],
+		currencyCodesMap[exchangeRate.Destination],
+		&from,
+		&till)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range exchangeRates {
+		if exchangeRates[i].Rate != nil {
+			return &exchangeRates[i], nil
+		}
+	}
+
+	return nil, nil
+}
+
+// IsTriggered returns true when rate crossed the threshold of the rule,
+// or changed by more than percent of the rule, compared to previous rate.
+// Rule is never triggered without previous rate.
+func IsTriggered(alertRule models.AlertRule, rate decimal.Decimal, previous *models.ExchangeRate) bool {
+	if previous == nil || previous.Rate == nil {
+		return false
+	}
+	previousRate := *previous.Rate
+
+	if alertRule.Threshold != nil {
+		return previousRate.LessThan(*alertRule.Threshold) != rate.LessThan(*alertRule.Threshold)
+	}
+
+	if alertRule.ChangePercent != nil && !previousRate.IsZero() {
+		change := rate.Sub(previousRate).Abs().Div(previousRate).Mul(hundred)
+		return change.GreaterThan(*alertRule.ChangePercent)
+	}
+
+	return false
+}
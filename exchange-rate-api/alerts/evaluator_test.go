@@ -0,0 +1,57 @@
+package alerts
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kolan92/exchange-rate-api/models"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func rate(value string) *decimal.Decimal {
+	d := decimal.RequireFromString(value)
+	return &d
+}
+
+func previous(value string) *models.ExchangeRate {
+	return &models.ExchangeRate{
+		Source:      "CHF",
+		Destination: "USD",
+		Date:        time.Date(2022, 04, 29, 0, 00, 00, 0, time.UTC),
+		Rate:        rate(value),
+	}
+}
+
+func TestThresholdIsTriggeredWhenCrossedUp(t *testing.T) {
+	alertRule := models.AlertRule{Threshold: rate("1.00")}
+
+	assert.True(t, IsTriggered(alertRule, *rate("1.0001"), previous("0.9950")))
+	assert.True(t, IsTriggered(alertRule, *rate("1.00"), previous("0.9950")))
+}
+
+func TestThresholdIsTriggeredWhenCrossedDown(t *testing.T) {
+	alertRule := models.AlertRule{Threshold: rate("1.00")}
+
+	assert.True(t, IsTriggered(alertRule, *rate("0.9999"), previous("1.0020")))
+}
+
+func TestThresholdIsNotTriggeredOnTheSameSide(t *testing.T) {
+	alertRule := models.AlertRule{Threshold: rate("1.00")}
+
+	assert.False(t, IsTriggered(alertRule, *rate("1.0100"), previous("1.0020")))
+	assert.False(t, IsTriggered(alertRule, *rate("0.9100"), previous("0.9020")))
+}
+
+func TestChangePercentIsTriggeredByBiggerMove(t *testing.T) {
+	alertRule := models.AlertRule{ChangePercent: rate("2")}
+
+	assert.True(t, IsTriggered(alertRule, *rate("1.0210"), previous("1.00")))
+	assert.True(t, IsTriggered(alertRule, *rate("0.9790"), previous("1.00")))
+	assert.False(t, IsTriggered(alertRule, *rate("1.0200"), previous("1.00")))
+}
+
+func TestRuleIsNotTriggeredWithoutPreviousRate(t *testing.T) {
+	assert.False(t, IsTriggered(models.AlertRule{Threshold: rate("1.00")}, *rate("1.10"), nil))
+	assert.False(t, IsTriggered(models.AlertRule{ChangePercent: rate("2")}, *rate("1.10"), &models.ExchangeRate{}))
+}
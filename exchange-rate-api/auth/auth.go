@@ -0,0 +1,160 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/kolan92/exchange-rate-api/config"
+	customerros "github.com/kolan92/exchange-rate-api/custom-erros"
+	"github.com/kolan92/exchange-rate-api/models"
+	"github.com/kolan92/exchange-rate-api/repositories"
+	"gorm.io/gorm"
+)
+
+const (
+	ScopeRead  = "read"
+	ScopeWrite = "write"
+	ScopeAdmin = "admin"
+)
+
+// keyPrefix makes api keys recognizable, e.g. by secret scanners.
+const keyPrefix = "era_"
+
+var (
+	ErrMissingCredentials = customerros.New(customerros.CodeUnauthorized, "missing api key or bearer token")
+	ErrInvalidCredentials = customerros.New(customerros.CodeUnauthorized, "invalid api key or bearer token")
+)
+
+// Principal is authenticated caller with its granted scopes.
+type Principal struct {
+	// Subject is "key:<id>" for api keys, subject claim for tokens, or "admin" for configured admin key.
+	Subject string
+	Scopes  []string
+	// Anonymous is caller without credentials when reads are public, it has only read scope.
+	Anonymous bool
+}
+
+func (p *Principal) HasScope(scope string) bool {
+	for _, granted := range p.Scopes {
+		// admin can do everything
+		if granted == scope || granted == ScopeAdmin {
+			return true
+		}
+	}
+	return false
+}
+
+type principalKey struct{}
+
+func WithPrincipal(ctx context.Context, principal *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, principal)
+}
+
+// PrincipalFromContext returns nil when request is not authenticated, e.g. when auth is disabled.
+func PrincipalFromContext(ctx context.Context) *Principal {
+	principal, _ := ctx.Value(principalKey{}).(*Principal)
+	return principal
+}
+
+// Authenticator verifies api keys against their hashes in repository and, when secret is configured, HS256 bearer tokens.
+// Without repository only admin key and tokens are accepted.
+type Authenticator struct {
+	apiKeysRepo repositories.ApiKeysRepository
+	cfg         config.AuthConfig
+}
+
+func NewAuthenticator(apiKeysRepo repositories.ApiKeysRepository, cfg config.AuthConfig) *Authenticator {
+	return &Authenticator{apiKeysRepo, cfg}
+}
+
+func (a *Authenticator) AuthenticateApiKey(ctx context.Context, key string) (*Principal, error) {
+	if a.cfg.AdminKey != "" && subtle.ConstantTimeCompare([]byte(key), []byte(a.cfg.AdminKey)) == 1 {
+		return &Principal{Subject: "admin", Scopes: []string{ScopeAdmin}}, nil
+	}
+
+	if a.apiKeysRepo == nil {
+		return nil, ErrInvalidCredentials
+	}
+
+	apiKey, err := a.apiKeysRepo.GetActiveApiKeyByHash(ctx, HashKey(key))
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrInvalidCredentials
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &Principal{Subject: fmt.Sprintf("key:%d", apiKey.Id), Scopes: models.SplitScopes(apiKey.Scopes)}, nil
+}
+
+type claims struct {
+	Scope string `json:"scope"`
+	jwt.RegisteredClaims
+}
+
+// AuthenticateToken accepts HS256 tokens with space separated scopes in scope claim.
+// Tokens have to expire, issuer and audience are verified when configured.
+func (a *Authenticator) AuthenticateToken(token string) (*Principal, error) {
+	if a.cfg.JwtSecret == "" {
+		return nil, ErrInvalidCredentials
+	}
+
+	tokenClaims := &claims{}
+	_, err := jwt.ParseWithClaims(token, tokenClaims, func(*jwt.Token) (interface{}, error) {
+		return []byte(a.cfg.JwtSecret), nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}))
+	// exp is verified only when it is present, token without it would be valid forever
+	if err != nil || tokenClaims.ExpiresAt == nil {
+		return nil, ErrInvalidCredentials
+	}
+
+	if a.cfg.JwtIssuer != "" && !tokenClaims.VerifyIssuer(a.cfg.JwtIssuer, true) {
+		return nil, ErrInvalidCredentials
+	}
+	if a.cfg.JwtAudience != "" && !tokenClaims.VerifyAudience(a.cfg.JwtAudience, true) {
+		return nil, ErrInvalidCredentials
+	}
+
+	return &Principal{Subject: tokenClaims.Subject, Scopes: models.SplitScopes(tokenClaims.Scope)}, nil
+}
+
+// Authenticate uses api key when it is sent, otherwise bearer token. Caller without credentials is anonymous
+// principal when reads are public.
+func (a *Authenticator) Authenticate(ctx context.Context, apiKey, authorization string) (*Principal, error) {
+	if apiKey != "" {
+		return a.AuthenticateApiKey(ctx, apiKey)
+	}
+
+	if token := strings.TrimPrefix(authorization, "Bearer "); token != authorization && token != "" {
+		return a.AuthenticateToken(token)
+	}
+
+	if a.cfg.PublicReads {
+		return &Principal{Subject: "anonymous", Scopes: []string{ScopeRead}, Anonymous: true}, nil
+	}
+	return nil, ErrMissingCredentials
+}
+
+// NewKey returns random api key and its prefix, which is stored to identify the key.
+func NewKey() (key string, prefix string, err error) {
+	bytes := make([]byte, 32)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", "", err
+	}
+
+	key = keyPrefix + hex.EncodeToString(bytes)
+	return key, key[:len(keyPrefix)+8], nil
+}
+
+// HashKey returns hex encoded SHA-256 of the key. Keys are random, so they don't need slow password hash.
+func HashKey(key string) string {
+	hash := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(hash[:])
+}
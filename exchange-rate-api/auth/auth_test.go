@@ -0,0 +1,206 @@
+package auth
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/kolan92/exchange-rate-api/config"
+	"github.com/kolan92/exchange-rate-api/models"
+	testhelpers "github.com/kolan92/exchange-rate-api/testHelpers"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	adminKey  = "admin-key-with-at-least-32-characters"
+	jwtSecret = "jwt-secret"
+)
+
+var (
+	apiKeysRepo   *testhelpers.MockApiKeysRepository
+	authenticator *Authenticator
+)
+
+func setup() {
+	apiKeysRepo = testhelpers.NewMockApiKeysRepository()
+	authenticator = NewAuthenticator(apiKeysRepo, config.AuthConfig{
+		Enabled:   true,
+		AdminKey:  adminKey,
+		JwtSecret: jwtSecret,
+		JwtIssuer: "issuer",
+	})
+}
+
+func issueKey(scopes ...string) string {
+	key, prefix, _ := NewKey()
+	apiKeysRepo.InsertApiKey(context.Background(), &models.DbApiKey{
+		Name:    "test",
+		Prefix:  prefix,
+		KeyHash: HashKey(key),
+		Scopes:  models.JoinScopes(scopes),
+	})
+	return key
+}
+
+func signToken(method jwt.SigningMethod, secret string, claims jwt.MapClaims) string {
+	token, _ := jwt.NewWithClaims(method, claims).SignedString([]byte(secret))
+	return token
+}
+
+func TestAuthenticatesIssuedApiKey(t *testing.T) {
+	setup()
+	key := issueKey(ScopeRead)
+
+	principal, err := authenticator.Authenticate(context.Background(), key, "")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "key:1", principal.Subject)
+	assert.True(t, principal.HasScope(ScopeRead))
+	assert.False(t, principal.HasScope(ScopeWrite))
+}
+
+func TestRejectsRevokedApiKey(t *testing.T) {
+	setup()
+	key := issueKey(ScopeRead)
+	assert.NoError(t, apiKeysRepo.RevokeApiKey(context.Background(), 1))
+
+	_, err := authenticator.Authenticate(context.Background(), key, "")
+
+	assert.ErrorIs(t, err, ErrInvalidCredentials)
+}
+
+func TestAdminKeyHasAllScopes(t *testing.T) {
+	setup()
+
+	principal, err := authenticator.Authenticate(context.Background(), adminKey, "")
+
+	assert.NoError(t, err)
+	assert.True(t, principal.HasScope(ScopeWrite))
+}
+
+func TestAcceptsOnlyAdminKeyWithoutRepository(t *testing.T) {
+	authenticator := NewAuthenticator(nil, config.AuthConfig{Enabled: true, AdminKey: adminKey})
+
+	_, adminErr := authenticator.Authenticate(context.Background(), adminKey, "")
+	_, err := authenticator.Authenticate(context.Background(), "era_unknown", "")
+
+	assert.NoError(t, adminErr)
+	assert.ErrorIs(t, err, ErrInvalidCredentials)
+}
+
+func TestAuthenticatesBearerToken(t *testing.T) {
+	setup()
+	token := signToken(jwt.SigningMethodHS256, jwtSecret, jwt.MapClaims{
+		"sub":   "importer",
+		"iss":   "issuer",
+		"scope": "read write",
+		"exp":   time.Now().Add(time.Hour).Unix(),
+	})
+
+	principal, err := authenticator.Authenticate(context.Background(), "", "Bearer "+token)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "importer", principal.Subject)
+	assert.True(t, principal.HasScope(ScopeWrite))
+}
+
+func TestRejectsInvalidBearerTokens(t *testing.T) {
+	setup()
+	valid := jwt.MapClaims{"iss": "issuer", "scope": "read", "exp": time.Now().Add(time.Hour).Unix()}
+	expired := jwt.MapClaims{"iss": "issuer", "scope": "read", "exp": time.Now().Add(-time.Hour).Unix()}
+	otherIssuer := jwt.MapClaims{"iss": "other", "scope": "read", "exp": time.Now().Add(time.Hour).Unix()}
+
+	for _, token := range []string{
+		signToken(jwt.SigningMethodHS256, "other-secret", valid),
+		signToken(jwt.SigningMethodHS512, jwtSecret, valid),
+		signToken(jwt.SigningMethodHS256, jwtSecret, expired),
+		signToken(jwt.SigningMethodHS256, jwtSecret, otherIssuer),
+	} {
+		_, err := authenticator.Authenticate(context.Background(), "", "Bearer "+token)
+		assert.ErrorIs(t, err, ErrInvalidCredentials)
+	}
+}
+
+func TestRejectsBearerTokenWithoutExpiry(t *testing.T) {
+	setup()
+	token := signToken(jwt.SigningMethodHS256, jwtSecret, jwt.MapClaims{"iss": "issuer", "scope": "read write admin"})
+
+	_, err := authenticator.Authenticate(context.Background(), "", "Bearer "+token)
+
+	assert.ErrorIs(t, err, ErrInvalidCredentials)
+}
+
+func TestMiddlewareRequiresCredentialsAndScope(t *testing.T) {
+	setup()
+	readKey := issueKey(ScopeRead)
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(Middleware(authenticator), RequireMethodScope(nil))
+	router.GET("/rates", func(c *gin.Context) { c.Status(http.StatusOK) })
+	router.POST("/rates", func(c *gin.Context) { c.Status(http.StatusAccepted) })
+
+	request := func(method, key string) int {
+		recorder := httptest.NewRecorder()
+		r := httptest.NewRequest(method, "/rates", nil)
+		if key != "" {
+			r.Header.Set(ApiKeyHeader, key)
+		}
+		router.ServeHTTP(recorder, r)
+		return recorder.Code
+	}
+
+	assert.Equal(t, http.StatusUnauthorized, request(http.MethodGet, ""))
+	assert.Equal(t, http.StatusUnauthorized, request(http.MethodGet, "era_unknown"))
+	assert.Equal(t, http.StatusOK, request(http.MethodGet, readKey))
+	assert.Equal(t, http.StatusForbidden, request(http.MethodPost, readKey))
+	assert.Equal(t, http.StatusAccepted, request(http.MethodPost, adminKey))
+}
+
+func newScopedRouter() *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(Middleware(authenticator), RequireMethodScope(map[string]string{"/convert": ScopeRead}))
+	router.GET("/rates", func(c *gin.Context) { c.Status(http.StatusOK) })
+	router.POST("/rates", func(c *gin.Context) { c.Status(http.StatusAccepted) })
+	router.POST("/convert", func(c *gin.Context) { c.Status(http.StatusOK) })
+	router.GET("/admin", RequireScope(ScopeAdmin), func(c *gin.Context) { c.Status(http.StatusOK) })
+	return router
+}
+
+func serve(router *gin.Engine, method, path, key string) *httptest.ResponseRecorder {
+	recorder := httptest.NewRecorder()
+	r := httptest.NewRequest(method, path, nil)
+	if key != "" {
+		r.Header.Set(ApiKeyHeader, key)
+	}
+	router.ServeHTTP(recorder, r)
+	return recorder
+}
+
+func TestMiddlewareRequiresScopeOfRoute(t *testing.T) {
+	setup()
+	readKey := issueKey(ScopeRead)
+	router := newScopedRouter()
+
+	assert.Equal(t, http.StatusOK, serve(router, http.MethodPost, "/convert", readKey).Code)
+	assert.Equal(t, http.StatusForbidden, serve(router, http.MethodPost, "/rates", readKey).Code)
+}
+
+func TestMiddlewareServesPublicReadsWithoutCredentials(t *testing.T) {
+	setup()
+	authenticator = NewAuthenticator(apiKeysRepo, config.AuthConfig{Enabled: true, AdminKey: adminKey, PublicReads: true})
+	router := newScopedRouter()
+
+	assert.Equal(t, http.StatusOK, serve(router, http.MethodGet, "/rates", "").Code)
+	assert.Equal(t, http.StatusOK, serve(router, http.MethodPost, "/convert", "").Code)
+	write := serve(router, http.MethodPost, "/rates", "")
+	assert.Equal(t, http.StatusUnauthorized, write.Code)
+	assert.NotEmpty(t, write.Header().Get("WWW-Authenticate"))
+	assert.Equal(t, http.StatusUnauthorized, serve(router, http.MethodGet, "/admin", "").Code)
+	assert.Equal(t, http.StatusUnauthorized, serve(router, http.MethodGet, "/rates", "era_unknown").Code)
+	assert.Equal(t, http.StatusAccepted, serve(router, http.MethodPost, "/rates", adminKey).Code)
+}
@@ -0,0 +1,127 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	customerros "github.com/kolan92/exchange-rate-api/custom-erros"
+	"github.com/kolan92/exchange-rate-api/logging"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const ApiKeyHeader = "X-API-Key"
+
+func abort(g *gin.Context, err error) {
+	customerros.Abort(g, err, logging.RequestId(g.Request.Context()))
+}
+
+// Middleware authenticates request with X-API-Key header or bearer token and puts principal to request context.
+func Middleware(authenticator *Authenticator) gin.HandlerFunc {
+	return func(g *gin.Context) {
+		ctx := g.Request.Context()
+		principal, err := authenticator.Authenticate(ctx, g.GetHeader(ApiKeyHeader), g.GetHeader("Authorization"))
+		if err != nil {
+			if errors.Is(err, ErrMissingCredentials) || errors.Is(err, ErrInvalidCredentials) {
+				g.Header("WWW-Authenticate", `Bearer realm="exchange-rate-api"`)
+				abort(g, err)
+				return
+			}
+			logging.FromContext(ctx).Error("Can't authenticate request", zap.Error(err))
+			abort(g, err)
+			return
+		}
+
+		g.Request = g.Request.WithContext(WithPrincipal(ctx, principal))
+		g.Next()
+	}
+}
+
+// RequireScope rejects requests of principals without the scope. Requests are not checked when auth is disabled.
+func RequireScope(scope string) gin.HandlerFunc {
+	return func(g *gin.Context) {
+		if !hasScope(g.Request.Context(), scope) {
+			abortMissingScope(g, scope)
+			return
+		}
+		g.Next()
+	}
+}
+
+// RequireMethodScope requires scope of the route from routeScopes, keyed by full path of the route, e.g. read scope
+// of POST route which only computes results. Other routes require read scope for GET, HEAD and OPTIONS requests
+// and write scope for all others.
+func RequireMethodScope(routeScopes map[string]string) gin.HandlerFunc {
+	return func(g *gin.Context) {
+		scope, isFound := routeScopes[g.FullPath()]
+		if !isFound {
+			scope = ScopeWrite
+			switch g.Request.Method {
+			case http.MethodGet, http.MethodHead, http.MethodOptions:
+				scope = ScopeRead
+			}
+		}
+
+		if !hasScope(g.Request.Context(), scope) {
+			abortMissingScope(g, scope)
+			return
+		}
+		g.Next()
+	}
+}
+
+// abortMissingScope asks anonymous caller for credentials, authenticated caller is forbidden.
+func abortMissingScope(g *gin.Context, scope string) {
+	if principal := PrincipalFromContext(g.Request.Context()); principal != nil && principal.Anonymous {
+		g.Header("WWW-Authenticate", `Bearer realm="exchange-rate-api"`)
+		abort(g, ErrMissingCredentials)
+		return
+	}
+	abort(g, customerros.Newf(customerros.CodeForbidden, "missing %s scope", scope))
+}
+
+func hasScope(ctx context.Context, scope string) bool {
+	principal := PrincipalFromContext(ctx)
+	return principal == nil || principal.HasScope(scope)
+}
+
+// UnaryServerInterceptor authenticates grpc requests with x-api-key or authorization metadata.
+// Methods require scope from methodScopes, read scope when not listed.
+func UnaryServerInterceptor(authenticator *Authenticator, methodScopes map[string]string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, request interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		md, _ := metadata.FromIncomingContext(ctx)
+		principal, err := authenticator.Authenticate(ctx, firstValue(md, ApiKeyHeader), firstValue(md, "authorization"))
+		if err != nil {
+			if errors.Is(err, ErrMissingCredentials) || errors.Is(err, ErrInvalidCredentials) {
+				return nil, status.Error(codes.Unauthenticated, err.Error())
+			}
+			logging.FromContext(ctx).Error("Can't authenticate request", zap.Error(err))
+			return nil, status.Error(codes.Internal, "Error while authenticating request")
+		}
+
+		scope, isFound := methodScopes[info.FullMethod]
+		if !isFound {
+			scope = ScopeRead
+		}
+		if !principal.HasScope(scope) {
+			if principal.Anonymous {
+				return nil, status.Error(codes.Unauthenticated, ErrMissingCredentials.Error())
+			}
+			return nil, status.Error(codes.PermissionDenied, "missing "+scope+" scope")
+		}
+
+		return handler(WithPrincipal(ctx, principal), request)
+	}
+}
+
+func firstValue(md metadata.MD, key string) string {
+	if values := md.Get(key); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
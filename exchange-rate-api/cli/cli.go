@@ -0,0 +1,115 @@
+// Package cli operates exchange rates from command line. It uses CurrenciesRepository and validators
+// like the api, so rates are accepted by the same rules.
+package cli
+
+import (
+	"context"
+	"io"
+	"strings"
+
+	customerros "github.com/kolan92/exchange-rate-api/custom-erros"
+	"github.com/kolan92/exchange-rate-api/models"
+	"github.com/kolan92/exchange-rate-api/repositories"
+	"github.com/kolan92/exchange-rate-api/validators"
+	"github.com/shopspring/decimal"
+)
+
+type Cli struct {
+	repo repositories.CurrenciesRepository
+	out  io.Writer
+}
+
+// New returns commands writing their output to out.
+func New(repo repositories.CurrenciesRepository, out io.Writer) *Cli {
+	return &Cli{repo, out}
+}
+
+// Insert stores one exchange rate, empty rate is stored as rate without value, e.g. on a bank holiday.
+func (c *Cli) Insert(ctx context.Context, source, destination, date, rate string) error {
+	exchangeRate, err := c.validExchangeRate(ctx, source, destination, date, rate)
+	if err != nil {
+		return err
+	}
+	return c.repo.InsertExchangeRate(ctx, exchangeRate)
+}
+
+// Correct replaces rate of stored exchange rate.
+func (c *Cli) Correct(ctx context.Context, source, destination, date, rate string) error {
+	exchangeRate, err := c.validExchangeRate(ctx, source, destination, date, rate)
+	if err != nil {
+		return err
+	}
+	return c.repo.UpdateExchangeRate(ctx, exchangeRate)
+}
+
+func (c *Cli) validExchangeRate(ctx context.Context, source, destination, date, rate string) (*models.ExchangeRate, error) {
+	exchangeRate, err := newExchangeRate(source, destination, date, rate)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validators.ValidateNewExchangeRate(exchangeRate, c.repo.GetCurrenciesCodesIdsMap(ctx)); err != nil {
+		return nil, err
+	}
+	return exchangeRate, nil
+}
+
+func newExchangeRate(source, destination, date, rate string) (*models.ExchangeRate, error) {
+	dateValue, err := validators.ParseDate(date)
+	if err != nil {
+		return nil, err
+	}
+
+	rateValue, err := parseRate(rate)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.ExchangeRate{Source: source, Destination: destination, Date: dateValue, Rate: rateValue}, nil
+}
+
+// parseRate returns nil for empty rate, or for dot used by data/*.csv files.
+func parseRate(rate string) (*decimal.Decimal, error) {
+	if rate == "" || rate == "." {
+		return nil, nil
+	}
+
+	value, err := decimal.NewFromString(rate)
+	if err != nil {
+		return nil, customerros.Newf(customerros.CodeValidationFailed, "rate %s is not a decimal number", rate)
+	}
+	return &value, nil
+}
+
+// parsePairs accepts pairs as SOURCE/DESTINATION, destination is USD when it is omitted like in the api.
+func (c *Cli) parsePairs(ctx context.Context, pairs []string) ([]models.CurrencyPair, error) {
+	if len(pairs) == 0 {
+		return nil, customerros.New(customerros.CodeMissingParameter, "missing currency pair, e.g. USD/CHF")
+	}
+
+	currencyCodesMap := c.repo.GetCurrenciesCodesIdsMap(ctx)
+	currencyPairs := make([]models.CurrencyPair, 0, len(pairs))
+	for _, pair := range pairs {
+		source, destination, _ := strings.Cut(pair, "/")
+		sourceCurrencyId, destinationCurrencyId, err := validators.GetCurrenciesIds(currencyCodesMap, source, destination)
+		if err != nil {
+			return nil, err
+		}
+		currencyPairs = append(currencyPairs, models.CurrencyPair{SourceCurrencyId: sourceCurrencyId, DestinationCurrencyId: destinationCurrencyId})
+	}
+	return currencyPairs, nil
+}
+
+// withCurrencies adds codes inserted with imported rates to validated currencies, their ids are assigned by the import.
+func withCurrencies(currencyCodesMap map[string]int, codes []string) map[string]int {
+	currencies := make(map[string]int, len(currencyCodesMap)+len(codes))
+	for code, id := range currencyCodesMap {
+		currencies[code] = id
+	}
+	for _, code := range codes {
+		if _, isFound := currencies[code]; !isFound {
+			currencies[code] = 0
+		}
+	}
+	return currencies
+}
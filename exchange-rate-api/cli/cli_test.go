@@ -0,0 +1,216 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	customerros "github.com/kolan92/exchange-rate-api/custom-erros"
+	"github.com/kolan92/exchange-rate-api/fred"
+	"github.com/kolan92/exchange-rate-api/models"
+	"github.com/kolan92/exchange-rate-api/repositories"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+var (
+	ctx      context.Context
+	repo     repositories.CurrenciesRepository
+	out      *bytes.Buffer
+	commands *Cli
+)
+
+func setup() {
+	ctx = context.Background()
+	repo = repositories.NewMemoryCurrenciesRepository(repositories.SeedCurrenciesCodes)
+	out = &bytes.Buffer{}
+	commands = New(repo, out)
+}
+
+func writeFile(t *testing.T, name, content string) string {
+	path := filepath.Join(t.TempDir(), name)
+	assert.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func TestImportsDataFile(t *testing.T) {
+	setup()
+	path := writeFile(t, "CHFUSD.csv", "DATE,CHFUSD\n2022-05-02,1.01\n2022-05-03,\n2022-05-04,.\n")
+
+	summary, err := commands.Import(ctx, path, false)
+
+	assert.NoError(t, err)
+	assert.Equal(t, models.ImportSummary{Inserted: 3, Stored: []int{0, 1, 2}}, summary)
+	commands.Export(ctx, []string{"CHF/USD"}, "2022-05-01", "2022-05-05", FormatCsv)
+	assert.Equal(t, "date,source,destination,rate\n2022-05-02,CHF,USD,1.01\n2022-05-03,CHF,USD,\n2022-05-04,CHF,USD,\n", out.String())
+}
+
+func TestImportSkipsOrReplacesStoredRates(t *testing.T) {
+	setup()
+	commands.Insert(ctx, "CHF", "USD", "2022-05-02", "1")
+	path := writeFile(t, "rates.csv", "date,source,destination,rate\n2022-05-02,CHF,USD,1.01\n2022-05-03,CHF,USD,1.02\n")
+
+	skipped, err := commands.Import(ctx, path, false)
+	replaced, replaceErr := commands.Import(ctx, path, true)
+
+	assert.NoError(t, err)
+	assert.NoError(t, replaceErr)
+	assert.Equal(t, models.ImportSummary{Inserted: 1, Skipped: 1, Stored: []int{1}}, skipped)
+	assert.Equal(t, models.ImportSummary{Updated: 2, Stored: []int{0, 1}}, replaced)
+	lastRate, _ := repo.GetLastExchangeRate(ctx, 2, 1)
+	assert.Equal(t, "1.02", lastRate.Rate.String())
+}
+
+func TestImportValidatesAllRatesBeforeStoringThem(t *testing.T) {
+	setup()
+	path := writeFile(t, "EURUSD.csv", "DATE,CHFUSD\n2022-05-02,1.01\n2022-05-03,abc\n")
+
+	_, err := commands.Import(ctx, path, false)
+
+	assert.ErrorContains(t, err, "line 3: rate abc is not a decimal number")
+	newestDate, _ := repo.GetNewestExchangeRateDate(ctx)
+	assert.Nil(t, newestDate)
+}
+
+func TestImportStoresNothingWhenAnyRateIsRejected(t *testing.T) {
+	setup()
+	commands.Insert(ctx, "USD", "JPY", "2022-05-02", "130")
+	path := writeFile(t, "rates.csv", "date,source,destination,rate\n2022-05-02,CHF,USD,1.01\n2022-05-02,JPY,USD,0.0077\n")
+
+	_, err := commands.Import(ctx, path, false)
+
+	assert.Equal(t, customerros.CodeInvertedPair, customerros.Code(err))
+	_, err = repo.GetLastExchangeRate(ctx, 2, 1)
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+}
+
+func TestImportRejectsUnknownCurrency(t *testing.T) {
+	setup()
+	path := writeFile(t, "EURUSD.csv", "DATE,EURUSD\n2022-05-02,1.01\n")
+
+	_, err := commands.Import(ctx, path, false)
+
+	assert.Equal(t, customerros.CodeUnknownCurrency, customerros.Code(err))
+}
+
+func TestImportRejectsUnknownHeader(t *testing.T) {
+	setup()
+	path := writeFile(t, "rates.csv", "day,rate\n2022-05-02,1.01\n")
+
+	_, err := commands.Import(ctx, path, false)
+
+	assert.ErrorContains(t, err, "header must be")
+}
+
+func TestImportEcbInsertsEuroAndRates(t *testing.T) {
+	setup()
+	path := writeFile(t, "eurofxref-daily.xml", `<Envelope><Cube><Cube time="2022-05-02">
+		<Cube currency="USD" rate="1.0526"/><Cube currency="CHF" rate="1.0251"/><Cube currency="GBP" rate="0.8399"/>
+	</Cube></Cube></Envelope>`)
+
+	summary, err := commands.ImportEcb(ctx, path, false)
+	repeated, repeatErr := commands.ImportEcb(ctx, path, false)
+
+	assert.NoError(t, err)
+	assert.NoError(t, repeatErr)
+	assert.Equal(t, models.ImportSummary{Inserted: 3, Stored: []int{0, 1, 2}}, summary)
+	assert.Equal(t, models.ImportSummary{Skipped: 3}, repeated)
+	commands.Export(ctx, []string{"EUR/GBP"}, "2022-05-01", "2022-05-05", FormatCsv)
+	assert.Equal(t, "date,source,destination,rate\n2022-05-02,EUR,GBP,0.8399\n", out.String())
+}
+
+func TestRejectedEcbImportLeavesNoCurrencies(t *testing.T) {
+	setup()
+	repo.InsertCurrenciesCodes(ctx, []string{"EUR"})
+	commands.Insert(ctx, "USD", "EUR", "2022-05-02", "0.95")
+	path := writeFile(t, "eurofxref-daily.xml", `<Envelope><Cube><Cube time="2022-05-03">
+		<Cube currency="GBP" rate="0.8399"/><Cube currency="USD" rate="1.0526"/>
+	</Cube></Cube></Envelope>`)
+
+	_, err := commands.ImportEcb(ctx, path, false)
+
+	assert.Equal(t, customerros.CodeInvertedPair, customerros.Code(err))
+	assert.NotContains(t, repo.GetCurrenciesCodesIdsMap(ctx), "GBP")
+}
+
+func TestImportFredInsertsMappedPair(t *testing.T) {
+	setup()
+	mapping := fred.Mapping{"DEXUSEU": {Source: "USD", Destination: "EUR", Convention: fred.UsdPerUnit}}
+	path := writeFile(t, "DEXUSEU.csv", "DATE,DEXUSEU\n2022-05-02,1.0524\n2022-05-03,\n")
+
+	summary, err := commands.ImportFred(ctx, mapping, path, false)
+
+	assert.NoError(t, err)
+	assert.Equal(t, models.ImportSummary{Inserted: 2, Stored: []int{0, 1}}, summary)
+	commands.Export(ctx, []string{"USD/EUR"}, "2022-05-01", "2022-05-05", FormatCsv)
+	assert.Equal(t, "date,source,destination,rate\n2022-05-02,USD,EUR,0.950209\n2022-05-03,USD,EUR,\n", out.String())
+}
+
+func TestExportsJson(t *testing.T) {
+	setup()
+	commands.Insert(ctx, "CHF", "USD", "2022-05-02", "1.01")
+
+	err := commands.Export(ctx, []string{"CHF"}, "2022-05-01", "2022-05-05", FormatJson)
+
+	assert.NoError(t, err)
+	assert.JSONEq(t, `[{"source": "CHF", "destination": "USD", "date": "2022-05-02T00:00:00Z", "rate": "1.01"}]`, out.String())
+}
+
+func TestExportRejectsUnknownFormat(t *testing.T) {
+	setup()
+
+	err := commands.Export(ctx, []string{"CHF/USD"}, "2022-05-01", "2022-05-05", "xml")
+
+	assert.Equal(t, customerros.CodeValidationFailed, customerros.Code(err))
+}
+
+func TestExportRequiresPair(t *testing.T) {
+	setup()
+
+	err := commands.Export(ctx, []string{}, "2022-05-01", "2022-05-05", FormatCsv)
+
+	assert.Equal(t, customerros.CodeMissingParameter, customerros.Code(err))
+}
+
+func TestGapsListsWeekdaysWithoutRates(t *testing.T) {
+	setup()
+	// 2022-05-06 is Friday, 2022-05-10 is Tuesday
+	commands.Insert(ctx, "CHF", "USD", "2022-05-05", "1.01")
+	commands.Insert(ctx, "CHF", "USD", "2022-05-10", "")
+	commands.Insert(ctx, "JPY", "USD", "2022-05-05", "130")
+	commands.Insert(ctx, "JPY", "USD", "2022-05-06", "131")
+
+	err := commands.Gaps(ctx, []string{"CHF/USD", "JPY/USD"}, "2022-05-01", "2022-05-31")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "date,source,destination\n2022-05-06,CHF,USD\n2022-05-09,CHF,USD\n", out.String())
+}
+
+func TestInsertUsesApiValidation(t *testing.T) {
+	setup()
+
+	sameCurrencyErr := commands.Insert(ctx, "CHF", "CHF", "2022-05-02", "1")
+	dateErr := commands.Insert(ctx, "CHF", "USD", "02.05.2022", "1")
+	commands.Insert(ctx, "CHF", "USD", "2022-05-02", "1")
+	duplicateErr := commands.Insert(ctx, "CHF", "USD", "2022-05-02", "1")
+
+	assert.Equal(t, customerros.CodeSameCurrency, customerros.Code(sameCurrencyErr))
+	assert.Equal(t, customerros.CodeInvalidDate, customerros.Code(dateErr))
+	assert.ErrorIs(t, duplicateErr, customerros.ErrDuplicateKeyViolation)
+}
+
+func TestCorrectUpdatesStoredRate(t *testing.T) {
+	setup()
+	commands.Insert(ctx, "CHF", "USD", "2022-05-02", "1")
+
+	err := commands.Correct(ctx, "CHF", "USD", "2022-05-02", "1.5")
+	missingErr := commands.Correct(ctx, "CHF", "USD", "2022-05-03", "1.5")
+
+	assert.NoError(t, err)
+	assert.ErrorIs(t, missingErr, gorm.ErrRecordNotFound)
+	exchangeRates, _ := repo.GetAllExchangeRatesFromDate(ctx, time.Date(2022, 05, 02, 0, 00, 00, 0, time.UTC))
+	assert.Equal(t, "1.5", exchangeRates[0].Rate.String())
+}
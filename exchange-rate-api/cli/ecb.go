@@ -0,0 +1,33 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/kolan92/exchange-rate-api/ecb"
+	"github.com/kolan92/exchange-rate-api/models"
+	"github.com/kolan92/exchange-rate-api/validators"
+)
+
+// ecbClient downloads eurofxref xml, historical file has several megabytes.
+var ecbClient = &http.Client{Timeout: 5 * time.Minute}
+
+// ImportEcb loads daily or historical eurofxref xml from file or url. Missing currencies and all rates
+// are stored in one transaction. Stored rates are skipped, or corrected when replace is set.
+func (c *Cli) ImportEcb(ctx context.Context, source string, replace bool) (models.ImportSummary, error) {
+	exchangeRates, err := ecb.Read(ctx, source, ecbClient)
+	if err != nil {
+		return models.ImportSummary{}, err
+	}
+
+	currencyCodesMap := withCurrencies(c.repo.GetCurrenciesCodesIdsMap(ctx), ecb.Codes(exchangeRates))
+	for i := range exchangeRates {
+		if err := validators.ValidateNewExchangeRate(&exchangeRates[i], currencyCodesMap); err != nil {
+			return models.ImportSummary{}, fmt.Errorf("%s: %w", source, err)
+		}
+	}
+
+	return c.repo.ImportExchangeRates(ctx, exchangeRates, replace)
+}
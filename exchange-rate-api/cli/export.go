@@ -0,0 +1,118 @@
+package cli
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	customerros "github.com/kolan92/exchange-rate-api/custom-erros"
+	"github.com/kolan92/exchange-rate-api/models"
+	"github.com/kolan92/exchange-rate-api/validators"
+)
+
+const (
+	FormatCsv  = "csv"
+	FormatJson = "json"
+)
+
+// Export writes rates of pairs from the from date inclusive till the till date exclusive, ordered by date.
+// Csv can be imported again, rates without value are written as empty.
+func (c *Cli) Export(ctx context.Context, pairs []string, from, till, format string) error {
+	if format != FormatCsv && format != FormatJson {
+		return customerros.Newf(customerros.CodeValidationFailed, "format %s must be %s or %s", format, FormatCsv, FormatJson)
+	}
+
+	exchangeRates, err := c.rangeExchangeRates(ctx, pairs, from, till)
+	if err != nil {
+		return err
+	}
+
+	if format == FormatJson {
+		encoder := json.NewEncoder(c.out)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(exchangeRates)
+	}
+
+	writer := csv.NewWriter(c.out)
+	writer.Write(exportHeader)
+	for _, exchangeRate := range exchangeRates {
+		rate := ""
+		if exchangeRate.Rate != nil {
+			rate = exchangeRate.Rate.String()
+		}
+		writer.Write([]string{exchangeRate.Date.Format(validators.DateLayout), exchangeRate.Source, exchangeRate.Destination, rate})
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// Gaps writes weekdays without stored rate of each pair, between its first and last rate in the range.
+// Stored rates without value, e.g. on bank holidays, are not gaps.
+func (c *Cli) Gaps(ctx context.Context, pairs []string, from, till string) error {
+	exchangeRates, err := c.rangeExchangeRates(ctx, pairs, from, till)
+	if err != nil {
+		return err
+	}
+
+	type pair struct{ source, destination string }
+	pairsOrder := []pair{}
+	datesByPair := make(map[pair]map[time.Time]bool)
+	for _, exchangeRate := range exchangeRates {
+		ratePair := pair{exchangeRate.Source, exchangeRate.Destination}
+		if datesByPair[ratePair] == nil {
+			datesByPair[ratePair] = make(map[time.Time]bool)
+			pairsOrder = append(pairsOrder, ratePair)
+		}
+		datesByPair[ratePair][exchangeRate.Date.UTC()] = true
+	}
+
+	writer := csv.NewWriter(c.out)
+	writer.Write([]string{"date", "source", "destination"})
+	for _, ratePair := range pairsOrder {
+		dates := datesByPair[ratePair]
+		first, last := dateRange(dates)
+		for date := first; !date.After(last); date = date.AddDate(0, 0, 1) {
+			if !dates[date] && date.Weekday() != time.Saturday && date.Weekday() != time.Sunday {
+				writer.Write([]string{date.Format(validators.DateLayout), ratePair.source, ratePair.destination})
+			}
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// rangeExchangeRates returns rates ordered by date ascending, rates of the same date in order of pairs ids.
+func (c *Cli) rangeExchangeRates(ctx context.Context, pairs []string, from, till string) ([]models.ExchangeRate, error) {
+	currencyPairs, err := c.parsePairs(ctx, pairs)
+	if err != nil {
+		return nil, err
+	}
+
+	fromValue, tillValue, err := validators.ParseFromAndTillDates(from, till)
+	if err != nil {
+		return nil, err
+	}
+
+	exchangeRates, err := c.repo.GetRangeExchangeRates(ctx, currencyPairs, fromValue, tillValue)
+	if err != nil {
+		return nil, fmt.Errorf("can't load exchange rates: %w", err)
+	}
+
+	sort.SliceStable(exchangeRates, func(i, j int) bool { return exchangeRates[i].Date.Before(exchangeRates[j].Date) })
+	return exchangeRates, nil
+}
+
+func dateRange(dates map[time.Time]bool) (first, last time.Time) {
+	for date := range dates {
+		if first.IsZero() || date.Before(first) {
+			first = date
+		}
+		if date.After(last) {
+			last = date
+		}
+	}
+	return first, last
+}
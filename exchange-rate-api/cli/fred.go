@@ -0,0 +1,28 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kolan92/exchange-rate-api/fred"
+	"github.com/kolan92/exchange-rate-api/models"
+	"github.com/kolan92/exchange-rate-api/validators"
+)
+
+// ImportFred loads FRED series file, e.g. DEXSZUS.csv, as pair of its mapping. Missing currencies of the pair
+// and all rates are stored in one transaction. Stored rates are skipped, or corrected when replace is set.
+func (c *Cli) ImportFred(ctx context.Context, mapping fred.Mapping, path string, replace bool) (models.ImportSummary, error) {
+	exchangeRates, err := mapping.ReadFile(path)
+	if err != nil || len(exchangeRates) == 0 {
+		return models.ImportSummary{}, err
+	}
+
+	currencyCodesMap := withCurrencies(c.repo.GetCurrenciesCodesIdsMap(ctx), []string{exchangeRates[0].Source, exchangeRates[0].Destination})
+	for i := range exchangeRates {
+		if err := validators.ValidateNewExchangeRate(&exchangeRates[i], currencyCodesMap); err != nil {
+			return models.ImportSummary{}, fmt.Errorf("%s: %w", path, err)
+		}
+	}
+
+	return c.repo.ImportExchangeRates(ctx, exchangeRates, replace)
+}
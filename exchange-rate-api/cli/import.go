@@ -0,0 +1,88 @@
+package cli
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/kolan92/exchange-rate-api/models"
+	"github.com/kolan92/exchange-rate-api/validators"
+)
+
+// exportHeader is header of csv files written by Export.
+var exportHeader = []string{"date", "source", "destination", "rate"}
+
+// Import loads csv file with DATE,<SOURCE><DESTINATION> header like data/USDCHF.csv, or file written by Export.
+// All rates are validated first, then stored in one transaction like ImportEcb and ImportFred do.
+// Stored rates are skipped, or corrected when replace is set.
+func (c *Cli) Import(ctx context.Context, path string, replace bool) (models.ImportSummary, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return models.ImportSummary{}, err
+	}
+	defer file.Close()
+
+	exchangeRates, err := c.readExchangeRates(ctx, file)
+	if err != nil {
+		return models.ImportSummary{}, fmt.Errorf("%s: %w", path, err)
+	}
+
+	return c.repo.ImportExchangeRates(ctx, exchangeRates, replace)
+}
+
+func (c *Cli) readExchangeRates(ctx context.Context, reader io.Reader) ([]models.ExchangeRate, error) {
+	csvReader := csv.NewReader(reader)
+	csvReader.ReuseRecord = true
+
+	header, err := csvReader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("can't read header: %w", err)
+	}
+	toExchangeRate, err := exchangeRateReader(header)
+	if err != nil {
+		return nil, err
+	}
+
+	currencyCodesMap := c.repo.GetCurrenciesCodesIdsMap(ctx)
+	exchangeRates := []models.ExchangeRate{}
+	for {
+		record, err := csvReader.Read()
+		if err == io.EOF {
+			return exchangeRates, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		line, _ := csvReader.FieldPos(0)
+		exchangeRate, err := toExchangeRate(record)
+		if err == nil {
+			err = validators.ValidateNewExchangeRate(exchangeRate, currencyCodesMap)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		exchangeRates = append(exchangeRates, *exchangeRate)
+	}
+}
+
+// exchangeRateReader returns function converting records of file with given header.
+func exchangeRateReader(header []string) (func(record []string) (*models.ExchangeRate, error), error) {
+	if strings.Join(header, ",") == strings.Join(exportHeader, ",") {
+		return func(record []string) (*models.ExchangeRate, error) {
+			return newExchangeRate(record[1], record[2], record[0], record[3])
+		}, nil
+	}
+
+	if len(header) == 2 && strings.EqualFold(header[0], "date") && len(header[1]) == 6 {
+		source, destination := header[1][:3], header[1][3:]
+		return func(record []string) (*models.ExchangeRate, error) {
+			return newExchangeRate(source, destination, record[0], record[1])
+		}, nil
+	}
+
+	return nil, fmt.Errorf("header must be DATE,<SOURCE><DESTINATION> or %s", strings.Join(exportHeader, ","))
+}
@@ -0,0 +1,601 @@
+package config
+
+import (
+	"encoding"
+	"flag"
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Config is loaded from defaults, optional yaml file, env variables and flags, each one overriding the previous.
+// Every setting is described by its tags: yaml key, env variable, flag name and usage.
+type Config struct {
+	Database  DatabaseConfig  `yaml:"database"`
+	Server    ServerConfig    `yaml:"server"`
+	LogLevel  string          `yaml:"logLevel" env:"LOG_LEVEL" flag:"log-level" usage:"debug, info, warn or error"`
+	Features  FeaturesConfig  `yaml:"features"`
+	Tracing   TracingConfig   `yaml:"tracing"`
+	Auth      AuthConfig      `yaml:"auth"`
+	RateLimit RateLimitConfig `yaml:"rateLimit"`
+	Ingestion IngestionConfig `yaml:"ingestion"`
+}
+
+type DatabaseConfig struct {
+	Backend    string `yaml:"backend" env:"DB_BACKEND" flag:"db-backend" usage:"storage of exchange rates: postgres, sqlite or memory, only postgres supports alerts and stored api keys"`
+	SqlitePath string `yaml:"sqlitePath" env:"DB_SQLITE_PATH" flag:"db-sqlite-path" usage:"database file of sqlite backend, it is created when missing"`
+	// sqlite schema is migrated on every start, the database file belongs to the api.
+	AutoMigrate bool `yaml:"autoMigrate" env:"DB_AUTO_MIGRATE" flag:"db-auto-migrate" usage:"applies pending postgres migrations on startup"`
+	MigrateData bool `yaml:"migrateData" env:"DB_MIGRATE_DATA" flag:"db-migrate-data" usage:"applies also data migrations, e.g. seed exchange rates"`
+	// Dsn takes precedence over discrete connection fields.
+	Dsn             string        `yaml:"dsn" env:"DB_DSN" flag:"db-dsn" usage:"full postgres connection string, replaces other db connection settings"`
+	User            string        `yaml:"user" env:"DB_USER" flag:"db-user" usage:"database user"`
+	Password        string        `yaml:"password" env:"DB_PASSWORD" flag:"db-password" usage:"database password"`
+	Name            string        `yaml:"name" env:"DB_NAME" flag:"db-name" usage:"database name"`
+	Host            string        `yaml:"host" env:"DB_HOST" flag:"db-host" usage:"database host"`
+	Port            int           `yaml:"port" env:"DB_PORT" flag:"db-port" usage:"database port"`
+	SslMode         string        `yaml:"sslMode" env:"DB_SSLMODE" flag:"db-sslmode" usage:"postgres sslmode, driver default is used when empty"`
+	MaxOpenConns    int           `yaml:"maxOpenConns" env:"DB_MAX_OPEN_CONNS" flag:"db-max-open-conns" usage:"maximum number of open connections, 0 is unlimited"`
+	MaxIdleConns    int           `yaml:"maxIdleConns" env:"DB_MAX_IDLE_CONNS" flag:"db-max-idle-conns" usage:"maximum number of idle connections"`
+	ConnMaxLifetime time.Duration `yaml:"connMaxLifetime" env:"DB_CONN_MAX_LIFETIME" flag:"db-conn-max-lifetime" usage:"maximum time connection is reused, 0 is forever"`
+}
+
+type ServerConfig struct {
+	ListenAddress     string        `yaml:"listenAddress" env:"LISTEN_ADDRESS" flag:"listen" usage:"address of http server"`
+	GrpcListenAddress string        `yaml:"grpcListenAddress" env:"GRPC_LISTEN_ADDRESS" flag:"grpc-listen" usage:"address of grpc server"`
+	SwaggerHost       string        `yaml:"swaggerHost" env:"SWAGGER_HOST" flag:"swagger-host" usage:"host shown in swagger ui"`
+	BasePath          string        `yaml:"basePath" env:"BASE_PATH" flag:"base-path" usage:"base path of rest api"`
+	ReadTimeout       time.Duration `yaml:"readTimeout" env:"READ_TIMEOUT" flag:"read-timeout" usage:"maximum duration of reading http request, 0 is no timeout"`
+	WriteTimeout      time.Duration `yaml:"writeTimeout" env:"WRITE_TIMEOUT" flag:"write-timeout" usage:"maximum duration of writing http response, it limits also server-sent events streams, 0 is no timeout"`
+	IdleTimeout       time.Duration `yaml:"idleTimeout" env:"IDLE_TIMEOUT" flag:"idle-timeout" usage:"how long keep-alive connection is kept open, 0 is read timeout"`
+	ShutdownTimeout   time.Duration `yaml:"shutdownTimeout" env:"SHUTDOWN_TIMEOUT" flag:"shutdown-timeout" usage:"how long in-flight requests are awaited on shutdown"`
+	ReadinessTimeout  time.Duration `yaml:"readinessTimeout" env:"READINESS_TIMEOUT" flag:"readiness-timeout" usage:"maximum duration of readiness checks"`
+	MaxRateAge        time.Duration `yaml:"maxRateAge" env:"MAX_RATE_AGE" flag:"max-rate-age" usage:"api is not ready when the newest exchange rate is older, 0 only reports the age"`
+	// client ip is taken from X-Forwarded-For only when request comes from trusted proxy.
+	TrustedProxies Networks `yaml:"trustedProxies" env:"TRUSTED_PROXIES" flag:"trusted-proxies" usage:"comma separated ip addresses or CIDRs of reverse proxies, none are trusted when empty"`
+}
+
+// Networks are written as comma separated ip addresses or CIDRs, e.g. 10.0.0.0/8,192.168.1.1.
+type Networks []string
+
+func (n *Networks) UnmarshalText(text []byte) error {
+	networks := Networks{}
+	for _, value := range strings.Split(string(text), ",") {
+		value = strings.TrimSpace(value)
+		if value == "" {
+			continue
+		}
+
+		if _, _, err := net.ParseCIDR(value); err != nil && net.ParseIP(value) == nil {
+			return fmt.Errorf("%s is not ip address or CIDR", value)
+		}
+		networks = append(networks, value)
+	}
+
+	*n = networks
+	return nil
+}
+
+func (n Networks) String() string {
+	return strings.Join(n, ",")
+}
+
+type FeaturesConfig struct {
+	Grpc          bool `yaml:"grpc" env:"FEATURE_GRPC" flag:"feature-grpc" usage:"enables grpc server"`
+	Graphql       bool `yaml:"graphql" env:"FEATURE_GRAPHQL" flag:"feature-graphql" usage:"enables graphql endpoint"`
+	Subscriptions bool `yaml:"subscriptions" env:"FEATURE_SUBSCRIPTIONS" flag:"feature-subscriptions" usage:"enables server-sent events of inserted rates"`
+	Alerts        bool `yaml:"alerts" env:"FEATURE_ALERTS" flag:"feature-alerts" usage:"enables alert rules and webhooks"`
+	Swagger       bool `yaml:"swagger" env:"FEATURE_SWAGGER" flag:"feature-swagger" usage:"enables swagger ui"`
+	Metrics       bool `yaml:"metrics" env:"FEATURE_METRICS" flag:"feature-metrics" usage:"enables prometheus metrics endpoint"`
+}
+
+type TracingConfig struct {
+	Exporter     string  `yaml:"exporter" env:"TRACING_EXPORTER" flag:"tracing-exporter" usage:"none, stdout or otlp"`
+	OtlpEndpoint string  `yaml:"otlpEndpoint" env:"TRACING_OTLP_ENDPOINT" flag:"tracing-otlp-endpoint" usage:"host:port of otlp http collector, OTEL_EXPORTER_OTLP_ENDPOINT is used when empty"`
+	OtlpInsecure bool    `yaml:"otlpInsecure" env:"TRACING_OTLP_INSECURE" flag:"tracing-otlp-insecure" usage:"sends spans to otlp collector without tls"`
+	ServiceName  string  `yaml:"serviceName" env:"TRACING_SERVICE_NAME" flag:"tracing-service-name" usage:"service name of exported spans"`
+	SampleRatio  float64 `yaml:"sampleRatio" env:"TRACING_SAMPLE_RATIO" flag:"tracing-sample-ratio" usage:"part of new traces which are sampled, from 0 to 1"`
+}
+
+type AuthConfig struct {
+	Enabled     bool   `yaml:"enabled" env:"AUTH_ENABLED" flag:"auth-enabled" usage:"requires api key or bearer token for api requests"`
+	PublicReads bool   `yaml:"publicReads" env:"AUTH_PUBLIC_READS" flag:"auth-public-reads" usage:"serves requests of read scope without credentials, writes and admin endpoints still require them"`
+	AdminKey    string `yaml:"adminKey" env:"AUTH_ADMIN_KEY" flag:"auth-admin-key" usage:"key with admin scope, used to issue api keys"`
+	JwtSecret   string `yaml:"jwtSecret" env:"AUTH_JWT_SECRET" flag:"auth-jwt-secret" usage:"HS256 secret of accepted bearer tokens, tokens are not accepted when empty"`
+	JwtIssuer   string `yaml:"jwtIssuer" env:"AUTH_JWT_ISSUER" flag:"auth-jwt-issuer" usage:"required iss claim of bearer tokens"`
+	JwtAudience string `yaml:"jwtAudience" env:"AUTH_JWT_AUDIENCE" flag:"auth-jwt-audience" usage:"required aud claim of bearer tokens"`
+}
+
+// RateLimitConfig sets token bucket limits per route group. Requests are counted per api key or token subject,
+// or per client ip when request is not authenticated.
+type RateLimitConfig struct {
+	Enabled    bool      `yaml:"enabled" env:"RATE_LIMIT_ENABLED" flag:"rate-limit-enabled" usage:"enables rate limiting of api requests"`
+	Read       RateLimit `yaml:"read" env:"RATE_LIMIT_READ" flag:"rate-limit-read" usage:"limit of GET requests, e.g. 600/m:100 is 600 requests per minute with burst of 100, 0 is unlimited"`
+	Write      RateLimit `yaml:"write" env:"RATE_LIMIT_WRITE" flag:"rate-limit-write" usage:"limit of requests changing data"`
+	Conversion RateLimit `yaml:"conversion" env:"RATE_LIMIT_CONVERSION" flag:"rate-limit-conversion" usage:"limit of batch conversions"`
+	Graphql    RateLimit `yaml:"graphql" env:"RATE_LIMIT_GRAPHQL" flag:"rate-limit-graphql" usage:"limit of graphql queries"`
+	Admin      RateLimit `yaml:"admin" env:"RATE_LIMIT_ADMIN" flag:"rate-limit-admin" usage:"limit of admin requests"`
+	// Ip is checked before authentication, so it limits also requests with invalid credentials.
+	Ip RateLimit `yaml:"ip" env:"RATE_LIMIT_IP" flag:"rate-limit-ip" usage:"limit of all api requests per client ip"`
+}
+
+// RateLimit is written as requests/period with optional burst, e.g. 100/s or 600/m:100. Period is s, m or h.
+// Burst defaults to number of requests, 0 disables the limit.
+type RateLimit struct {
+	Requests int
+	Period   time.Duration
+	Burst    int
+}
+
+var rateLimitPeriods = map[string]time.Duration{"s": time.Second, "m": time.Minute, "h": time.Hour}
+
+func (l *RateLimit) UnmarshalText(text []byte) error {
+	value := strings.TrimSpace(string(text))
+	if value == "" || value == "0" {
+		*l = RateLimit{}
+		return nil
+	}
+
+	rate, burstValue, hasBurst := strings.Cut(value, ":")
+	requestsValue, periodValue, isFound := strings.Cut(rate, "/")
+	period, isPeriodFound := rateLimitPeriods[periodValue]
+	if !isFound || !isPeriodFound {
+		return fmt.Errorf("rate limit %s must be requests/period, e.g. 600/m:100", value)
+	}
+
+	requests, err := strconv.Atoi(requestsValue)
+	if err != nil || requests <= 0 {
+		return fmt.Errorf("rate limit %s must have positive number of requests", value)
+	}
+
+	burst := requests
+	if hasBurst {
+		burst, err = strconv.Atoi(burstValue)
+		if err != nil || burst <= 0 {
+			return fmt.Errorf("rate limit %s must have positive burst", value)
+		}
+	}
+
+	*l = RateLimit{Requests: requests, Period: period, Burst: burst}
+	return nil
+}
+
+func (l RateLimit) IsUnlimited() bool {
+	return l.Requests == 0
+}
+
+// IngestionConfig sets periodic fetching of rates from provider.
+type IngestionConfig struct {
+	Enabled    bool          `yaml:"enabled" env:"INGESTION_ENABLED" flag:"ingestion-enabled" usage:"periodically fetches rates of configured pairs from provider"`
+	Provider   string        `yaml:"provider" env:"INGESTION_PROVIDER" flag:"ingestion-provider" usage:"file, http or ecb"`
+	Source     string        `yaml:"source" env:"INGESTION_SOURCE" flag:"ingestion-source" usage:"json file of file provider, url of http provider, eurofxref xml url or file of ecb provider"`
+	Pairs      CurrencyPairs `yaml:"pairs" env:"INGESTION_PAIRS" flag:"ingestion-pairs" usage:"comma separated pairs, e.g. USD/CHF,USD/JPY"`
+	Interval   time.Duration `yaml:"interval" env:"INGESTION_INTERVAL" flag:"ingestion-interval" usage:"time between fetches"`
+	Timeout    time.Duration `yaml:"timeout" env:"INGESTION_TIMEOUT" flag:"ingestion-timeout" usage:"maximum duration of one fetch"`
+	OnConflict string        `yaml:"onConflict" env:"INGESTION_ON_CONFLICT" flag:"ingestion-on-conflict" usage:"skip keeps stored rates, update replaces them with fetched ones"`
+}
+
+// CurrencyPairs are written as comma separated SOURCE/DESTINATION pairs, e.g. USD/CHF,USD/JPY.
+type CurrencyPairs []CurrencyPair
+
+type CurrencyPair struct {
+	Source      string
+	Destination string
+}
+
+func (p *CurrencyPairs) UnmarshalText(text []byte) error {
+	pairs := CurrencyPairs{}
+	for _, value := range strings.Split(string(text), ",") {
+		value = strings.TrimSpace(value)
+		if value == "" {
+			continue
+		}
+
+		source, destination, isFound := strings.Cut(value, "/")
+		if !isFound || len(source) != 3 || len(destination) != 3 {
+			return fmt.Errorf("currency pair %s must be SOURCE/DESTINATION, e.g. USD/CHF", value)
+		}
+		pairs = append(pairs, CurrencyPair{strings.ToUpper(source), strings.ToUpper(destination)})
+	}
+
+	*p = pairs
+	return nil
+}
+
+func (p CurrencyPairs) String() string {
+	values := make([]string, 0, len(p))
+	for _, pair := range p {
+		values = append(values, pair.String())
+	}
+	return strings.Join(values, ",")
+}
+
+func (p CurrencyPair) String() string {
+	return p.Source + "/" + p.Destination
+}
+
+const configFileEnv = "CONFIG_FILE"
+
+const (
+	BackendPostgres = "postgres"
+	BackendSqlite   = "sqlite"
+	BackendMemory   = "memory"
+)
+
+const (
+	ProviderFile = "file"
+	ProviderHttp = "http"
+	ProviderEcb  = "ecb"
+)
+
+const (
+	OnConflictSkip   = "skip"
+	OnConflictUpdate = "update"
+)
+
+var (
+	backends  = []string{BackendPostgres, BackendSqlite, BackendMemory}
+	providers = []string{ProviderFile, ProviderHttp, ProviderEcb}
+	sslModes  = []string{"disable", "allow", "prefer", "require", "verify-ca", "verify-full"}
+	logLevels = []string{"debug", "info", "warn", "error"}
+	exporters = []string{"none", "stdout", "otlp"}
+)
+
+func defaultConfig() *Config {
+	return &Config{
+		Database: DatabaseConfig{
+			Backend:      BackendPostgres,
+			SqlitePath:   "exchange-rates.db",
+			Port:         5432,
+			MaxIdleConns: 2,
+		},
+		Server: ServerConfig{
+			ListenAddress:     ":8081",
+			GrpcListenAddress: ":8082",
+			SwaggerHost:       "localhost:8081",
+			BasePath:          "/api/v1",
+			ReadTimeout:       15 * time.Second,
+			IdleTimeout:       2 * time.Minute,
+			ShutdownTimeout:   10 * time.Second,
+			ReadinessTimeout:  2 * time.Second,
+		},
+		LogLevel: "info",
+		Features: FeaturesConfig{
+			Grpc:          true,
+			Graphql:       true,
+			Subscriptions: true,
+			Alerts:        true,
+			Swagger:       true,
+			Metrics:       true,
+		},
+		RateLimit: RateLimitConfig{
+			Read:       RateLimit{Requests: 1200, Period: time.Minute, Burst: 200},
+			Write:      RateLimit{Requests: 120, Period: time.Minute, Burst: 20},
+			Conversion: RateLimit{Requests: 60, Period: time.Minute, Burst: 10},
+			Graphql:    RateLimit{Requests: 600, Period: time.Minute, Burst: 100},
+			Admin:      RateLimit{Requests: 30, Period: time.Minute, Burst: 10},
+			Ip:         RateLimit{Requests: 3000, Period: time.Minute, Burst: 300},
+		},
+		Ingestion: IngestionConfig{
+			Provider:   ProviderHttp,
+			Interval:   time.Hour,
+			Timeout:    30 * time.Second,
+			OnConflict: OnConflictSkip,
+		},
+		Tracing: TracingConfig{
+			Exporter:    "none",
+			ServiceName: "exchange-rate-api",
+			SampleRatio: 1,
+		},
+	}
+}
+
+// ValidationError lists all configuration problems, so they can be fixed at once.
+type ValidationError struct {
+	Problems []string
+}
+
+func (e *ValidationError) Error() string {
+	return "invalid configuration:\n  " + strings.Join(e.Problems, "\n  ")
+}
+
+// Load reads configuration for command line arguments, without program name.
+// Config file is set with -config flag or CONFIG_FILE env variable.
+func Load(args []string) (*Config, error) {
+	cfg, _, err := LoadCommand("exchange-rate-api", args, func(*flag.FlagSet) {})
+	return cfg, err
+}
+
+// LoadCommand reads configuration of subcommand, which defines its own flags in the same flag set as settings.
+// Arguments remaining after flags are returned.
+func LoadCommand(name string, args []string, defineFlags func(*flag.FlagSet)) (*Config, []string, error) {
+	cfg := defaultConfig()
+	problems := []string{}
+
+	flagSet := flag.NewFlagSet(name, flag.ContinueOnError)
+	defineFlags(flagSet)
+	configFile := flagSet.String("config", os.Getenv(configFileEnv), "optional yaml config file")
+	flagValues := make(map[string]*string)
+	for _, setting := range settings(cfg) {
+		flagValues[setting.flag] = flagSet.String(setting.flag, "", setting.usage+" ("+setting.env+")")
+	}
+
+	if err := flagSet.Parse(args); err != nil {
+		return nil, nil, err
+	}
+
+	if *configFile != "" {
+		if err := loadFile(*configFile, cfg); err != nil {
+			problems = append(problems, err.Error())
+		}
+	}
+
+	for _, setting := range settings(cfg) {
+		if value, isSet := os.LookupEnv(setting.env); isSet {
+			if err := setting.set(value); err != nil {
+				problems = append(problems, fmt.Sprintf("env %s: %s", setting.env, err.Error()))
+			}
+		}
+	}
+
+	flagSet.Visit(func(f *flag.Flag) {
+		for _, setting := range settings(cfg) {
+			if setting.flag == f.Name {
+				if err := setting.set(*flagValues[f.Name]); err != nil {
+					problems = append(problems, fmt.Sprintf("flag -%s: %s", f.Name, err.Error()))
+				}
+			}
+		}
+	})
+
+	problems = append(problems, cfg.validate()...)
+	if len(problems) > 0 {
+		return nil, nil, &ValidationError{problems}
+	}
+
+	return cfg, flagSet.Args(), nil
+}
+
+func loadFile(path string, cfg *Config) error {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("config file: %w", err)
+	}
+
+	if err := yaml.Unmarshal(content, cfg); err != nil {
+		return fmt.Errorf("config file %s: %w", path, err)
+	}
+
+	return nil
+}
+
+func (cfg *Config) validate() []string {
+	problems := []string{}
+
+	database := cfg.Database
+	if !contains(backends, database.Backend) {
+		problems = append(problems, fmt.Sprintf("DB_BACKEND %s must be one of %s", database.Backend, strings.Join(backends, ", ")))
+	}
+
+	if database.Backend == BackendSqlite && database.SqlitePath == "" {
+		problems = append(problems, "missing required setting DB_SQLITE_PATH")
+	}
+
+	if database.Backend == BackendPostgres && database.Dsn == "" {
+		required := []struct{ env, value string }{
+			{"DB_USER", database.User},
+			{"DB_PASSWORD", database.Password},
+			{"DB_NAME", database.Name},
+			{"DB_HOST", database.Host},
+		}
+		for _, setting := range required {
+			if setting.value == "" {
+				problems = append(problems, fmt.Sprintf("missing required setting %s, or DB_DSN", setting.env))
+			}
+		}
+
+		if database.Port <= 0 || database.Port > 65535 {
+			problems = append(problems, fmt.Sprintf("DB_PORT %d is out of range", database.Port))
+		}
+	}
+
+	if database.SslMode != "" && !contains(sslModes, database.SslMode) {
+		problems = append(problems, fmt.Sprintf("DB_SSLMODE %s must be one of %s", database.SslMode, strings.Join(sslModes, ", ")))
+	}
+
+	if database.MaxOpenConns < 0 || database.MaxIdleConns < 0 || database.ConnMaxLifetime < 0 {
+		problems = append(problems, "database pool settings can't be negative")
+	}
+
+	if database.MaxOpenConns > 0 && database.MaxIdleConns > database.MaxOpenConns {
+		problems = append(problems, "DB_MAX_IDLE_CONNS can't be greater than DB_MAX_OPEN_CONNS")
+	}
+
+	if _, _, err := net.SplitHostPort(cfg.Server.ListenAddress); err != nil {
+		problems = append(problems, fmt.Sprintf("LISTEN_ADDRESS %s is invalid: %s", cfg.Server.ListenAddress, err.Error()))
+	}
+
+	if cfg.Features.Grpc {
+		if _, _, err := net.SplitHostPort(cfg.Server.GrpcListenAddress); err != nil {
+			problems = append(problems, fmt.Sprintf("GRPC_LISTEN_ADDRESS %s is invalid: %s", cfg.Server.GrpcListenAddress, err.Error()))
+		}
+	}
+
+	if cfg.Server.ReadTimeout < 0 || cfg.Server.WriteTimeout < 0 || cfg.Server.IdleTimeout < 0 {
+		problems = append(problems, "server timeouts can't be negative")
+	}
+
+	if cfg.Server.ShutdownTimeout <= 0 {
+		problems = append(problems, "SHUTDOWN_TIMEOUT must be positive")
+	}
+
+	if cfg.Server.ReadinessTimeout <= 0 {
+		problems = append(problems, "READINESS_TIMEOUT must be positive")
+	}
+
+	if cfg.Server.MaxRateAge < 0 {
+		problems = append(problems, "MAX_RATE_AGE can't be negative")
+	}
+
+	if !strings.HasPrefix(cfg.Server.BasePath, "/") {
+		problems = append(problems, fmt.Sprintf("BASE_PATH %s must start with /", cfg.Server.BasePath))
+	}
+
+	if !contains(logLevels, cfg.LogLevel) {
+		problems = append(problems, fmt.Sprintf("LOG_LEVEL %s must be one of %s", cfg.LogLevel, strings.Join(logLevels, ", ")))
+	}
+
+	if !contains(exporters, cfg.Tracing.Exporter) {
+		problems = append(problems, fmt.Sprintf("TRACING_EXPORTER %s must be one of %s", cfg.Tracing.Exporter, strings.Join(exporters, ", ")))
+	}
+
+	if cfg.Auth.Enabled && cfg.Auth.AdminKey == "" && cfg.Auth.JwtSecret == "" {
+		problems = append(problems, "AUTH_ADMIN_KEY or AUTH_JWT_SECRET is required when auth is enabled, otherwise api keys can't be issued")
+	}
+
+	if cfg.Auth.AdminKey != "" && len(cfg.Auth.AdminKey) < 32 {
+		problems = append(problems, "AUTH_ADMIN_KEY must have at least 32 characters")
+	}
+
+	if cfg.Tracing.SampleRatio < 0 || cfg.Tracing.SampleRatio > 1 {
+		problems = append(problems, "TRACING_SAMPLE_RATIO must be between 0 and 1")
+	}
+
+	if ingestion := cfg.Ingestion; ingestion.Enabled {
+		if !contains(providers, ingestion.Provider) {
+			problems = append(problems, fmt.Sprintf("INGESTION_PROVIDER %s must be one of %s", ingestion.Provider, strings.Join(providers, ", ")))
+		}
+		if ingestion.Source == "" {
+			problems = append(problems, "INGESTION_SOURCE is required when ingestion is enabled")
+		}
+		if len(ingestion.Pairs) == 0 {
+			problems = append(problems, "INGESTION_PAIRS is required when ingestion is enabled")
+		}
+		if ingestion.Interval <= 0 || ingestion.Timeout <= 0 {
+			problems = append(problems, "INGESTION_INTERVAL and INGESTION_TIMEOUT must be positive")
+		}
+		if ingestion.OnConflict != OnConflictSkip && ingestion.OnConflict != OnConflictUpdate {
+			problems = append(problems, fmt.Sprintf("INGESTION_ON_CONFLICT %s must be %s or %s", ingestion.OnConflict, OnConflictSkip, OnConflictUpdate))
+		}
+	}
+
+	return problems
+}
+
+// ConnectionString returns Dsn, or connection string build from discrete fields.
+func (database DatabaseConfig) ConnectionString() string {
+	if database.Dsn != "" {
+		return database.Dsn
+	}
+
+	connectionUrl := url.URL{
+		Scheme: "postgresql",
+		User:   url.UserPassword(database.User, database.Password),
+		Host:   net.JoinHostPort(database.Host, strconv.Itoa(database.Port)),
+		Path:   database.Name,
+	}
+
+	if database.SslMode != "" {
+		connectionUrl.RawQuery = url.Values{"sslmode": []string{database.SslMode}}.Encode()
+	}
+
+	return connectionUrl.String()
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+type setting struct {
+	env, flag, usage string
+	value            reflect.Value
+}
+
+// settings returns all tagged fields of the config, so they can be set from env variables and flags.
+func settings(cfg *Config) []setting {
+	return collectSettings(reflect.ValueOf(cfg).Elem())
+}
+
+func collectSettings(value reflect.Value) []setting {
+	result := []setting{}
+
+	for i := 0; i < value.NumField(); i++ {
+		field := value.Type().Field(i)
+		fieldValue := value.Field(i)
+
+		if fieldValue.Kind() == reflect.Struct && !isTextUnmarshaler(fieldValue) {
+			result = append(result, collectSettings(fieldValue)...)
+			continue
+		}
+
+		if env, isFound := field.Tag.Lookup("env"); isFound {
+			result = append(result, setting{
+				env:   env,
+				flag:  field.Tag.Get("flag"),
+				usage: field.Tag.Get("usage"),
+				value: fieldValue,
+			})
+		}
+	}
+
+	return result
+}
+
+func isTextUnmarshaler(value reflect.Value) bool {
+	_, isUnmarshaler := value.Addr().Interface().(encoding.TextUnmarshaler)
+	return isUnmarshaler
+}
+
+func (s setting) set(value string) error {
+	if unmarshaler, isUnmarshaler := s.value.Addr().Interface().(encoding.TextUnmarshaler); isUnmarshaler {
+		return unmarshaler.UnmarshalText([]byte(value))
+	}
+
+	if s.value.Type() == reflect.TypeOf(time.Duration(0)) {
+		duration, err := time.ParseDuration(value)
+		if err != nil {
+			return err
+		}
+		s.value.SetInt(int64(duration))
+		return nil
+	}
+
+	switch s.value.Kind() {
+	case reflect.String:
+		s.value.SetString(value)
+	case reflect.Int:
+		intValue, err := strconv.Atoi(value)
+		if err != nil {
+			return fmt.Errorf("%s is not a number", value)
+		}
+		s.value.SetInt(int64(intValue))
+	case reflect.Float64:
+		floatValue, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return fmt.Errorf("%s is not a number", value)
+		}
+		s.value.SetFloat(floatValue)
+	case reflect.Bool:
+		boolValue, err := strconv.ParseBool(value)
+		if err != nil {
+			return fmt.Errorf("%s is not a boolean", value)
+		}
+		s.value.SetBool(boolValue)
+	default:
+		return fmt.Errorf("unsupported setting type %s", s.value.Type())
+	}
+
+	return nil
+}
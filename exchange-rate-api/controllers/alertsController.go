@@ -0,0 +1,167 @@
+package controllers
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/kolan92/exchange-rate-api/alerts"
+	customerros "github.com/kolan92/exchange-rate-api/custom-erros"
+	"github.com/kolan92/exchange-rate-api/models"
+	"github.com/kolan92/exchange-rate-api/repositories"
+	"github.com/kolan92/exchange-rate-api/validators"
+)
+
+type AlertsController struct {
+	repo       repositories.CurrenciesRepository
+	alertsRepo repositories.AlertsRepository
+}
+
+func NewAlertsController(repo repositories.CurrenciesRepository, alertsRepo repositories.AlertsRepository) *AlertsController {
+	return &AlertsController{repo, alertsRepo}
+}
+
+func (controller *AlertsController) RegisterRouter(routerGroup *gin.RouterGroup) {
+	alertRules := routerGroup.Group("/alerts")
+	{
+		alertRules.GET("/", func(c *gin.Context) {
+			controller.GetAlertRules(c)
+		})
+
+		alertRules.POST("/", func(c *gin.Context) {
+			controller.InsertAlertRule(c)
+		})
+
+		alertRules.DELETE("/:id", func(c *gin.Context) {
+			controller.DeleteAlertRule(c)
+		})
+
+		alertRules.GET("/:id/deliveries", func(c *gin.Context) {
+			controller.GetWebhookDeliveries(c)
+		})
+	}
+}
+
+// @Summary GetAlertRules
+// @Tags		alerts
+// @Schemes
+// @Accept		json
+// @Produce		json
+// @Description Returns all alert rules, secrets are not included
+// @Router		/alerts	[get]
+// @Success 	200		{object}	[]models.AlertRule
+// @Failure 	default	{object}	customerros.Problem	"RFC 7807 problem details"
+func (c *AlertsController) GetAlertRules(g *gin.Context) {
+	alertRules, err := c.alertsRepo.GetAlertRules(g.Request.Context())
+	if err != nil {
+		respondWithError(g, err)
+		return
+	}
+
+	for i := range alertRules {
+		alertRules[i].Secret = ""
+	}
+	g.JSON(http.StatusOK, alertRules)
+}
+
+// @Summary InsertAlertRule
+// @Description Registers alert rule. Webhook is sent to callbackUrl when exchange rate inserted for the currencies crosses threshold,
+// @Description or changes by more than changePercent compared to the previous rate. Exactly one of threshold and changePercent must be set.
+// @Description Webhook body is signed with HMAC-SHA256 using returned secret, hex encoded signature is sent in X-Signature-256 header prefixed with sha256=.
+// @Tags		alerts
+// @Schemes
+// @Accept		json
+// @Produce		json
+// @Param		alertRule	body	models.AlertRule	true	"New alert rule, id, secret and createdAt are ignored"
+// @Router		/alerts	[post]
+// @Success 	201		{object}	models.AlertRule
+// @Failure 	default	{object}	customerros.Problem	"RFC 7807 problem details"
+func (c *AlertsController) InsertAlertRule(g *gin.Context) {
+	alertRule := &models.AlertRule{}
+
+	if err := g.ShouldBindJSON(alertRule); err != nil {
+		respondWithError(g, customerros.NewBindingError(err))
+		return
+	}
+
+	if err := validators.ValidateNewAlertRule(alertRule, c.repo.GetCurrenciesCodesIdsMap(g.Request.Context())); err != nil {
+		respondWithError(g, err)
+		return
+	}
+
+	secret, err := alerts.NewSecret()
+	if err != nil {
+		respondWithError(g, fmt.Errorf("generating alert rule secret: %w", err))
+		return
+	}
+	alertRule.Secret = secret
+
+	if err := c.alertsRepo.InsertAlertRule(g.Request.Context(), alertRule); err != nil {
+		respondWithError(g, fmt.Errorf("inserting alert rule: %w", err))
+		return
+	}
+
+	g.JSON(http.StatusCreated, alertRule)
+}
+
+// @Summary DeleteAlertRule
+// @Description Deletes alert rule together with its delivery log
+// @Tags		alerts
+// @Schemes
+// @Param		id	path	int	true	"Alert rule id"
+// @Router		/alerts/{id}	[delete]
+// @Success 	204
+// @Success 	404
+// @Failure 	default	{object}	customerros.Problem	"RFC 7807 problem details"
+func (c *AlertsController) DeleteAlertRule(g *gin.Context) {
+	id, err := parseIdParam(g)
+	if err != nil {
+		respondWithError(g, err)
+		return
+	}
+
+	if err := c.alertsRepo.DeleteAlertRule(g.Request.Context(), id); err != nil {
+		respondWithError(g, err)
+		return
+	}
+
+	g.Status(http.StatusNoContent)
+}
+
+// @Summary GetWebhookDeliveries
+// @Description Returns delivery log of alert rule webhooks, most recent attempts first
+// @Tags		alerts
+// @Schemes
+// @Produce		json
+// @Param		id	path	int	true	"Alert rule id"
+// @Router		/alerts/{id}/deliveries	[get]
+// @Success 	200		{object}	[]models.WebhookDelivery
+// @Success 	404
+// @Failure 	default	{object}	customerros.Problem	"RFC 7807 problem details"
+func (c *AlertsController) GetWebhookDeliveries(g *gin.Context) {
+	id, err := parseIdParam(g)
+	if err != nil {
+		respondWithError(g, err)
+		return
+	}
+
+	deliveries, err := c.alertsRepo.GetWebhookDeliveries(g.Request.Context(), id)
+	if err != nil {
+		respondWithError(g, err)
+		return
+	}
+
+	g.JSON(http.StatusOK, deliveries)
+}
+
+func parseIdParam(g *gin.Context) (int, error) {
+	const idParamKey = "id"
+
+	idParam := g.Param(idParamKey)
+	id, err := strconv.Atoi(idParam)
+	if err != nil {
+		return 0, customerros.Newf(customerros.CodeInvalidId, "id %s is in incorrect format", idParam)
+	}
+	return id, nil
+}
@@ -0,0 +1,101 @@
+package controllers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	customerros "github.com/kolan92/exchange-rate-api/custom-erros"
+	"github.com/kolan92/exchange-rate-api/models"
+	testhelpers "github.com/kolan92/exchange-rate-api/testHelpers"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	alertsRepository *testhelpers.MockAlertsRepository
+	alertsRouter     *gin.Engine
+)
+
+func setupAlerts() {
+	setup()
+	alertsRepository = testhelpers.NewMockAlertsRepository()
+
+	alertsRouter = gin.New()
+	NewAlertsController(repository, alertsRepository).RegisterRouter(&alertsRouter.RouterGroup)
+}
+
+func serveAlerts(method, path, body string) *httptest.ResponseRecorder {
+	recorder := httptest.NewRecorder()
+	alertsRouter.ServeHTTP(recorder, httptest.NewRequest(method, path, strings.NewReader(body)))
+	return recorder
+}
+
+func TestInsertAlertRuleReturnsRuleWithSecret(t *testing.T) {
+	setupAlerts()
+
+	recorder := serveAlerts(http.MethodPost, "/alerts/", `{"source":"CHF","destination":"USD","threshold":"1.00","callbackUrl":"https://example.com/hooks"}`)
+
+	assert.Equal(t, http.StatusCreated, recorder.Code)
+	var alertRule models.AlertRule
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &alertRule))
+	assert.Equal(t, 1, alertRule.Id)
+	assert.NotEmpty(t, alertRule.Secret)
+	assert.Len(t, alertsRepository.AlertRules, 1)
+}
+
+func TestInsertAlertRuleRejectsUnknownCurrency(t *testing.T) {
+	setupAlerts()
+
+	recorder := serveAlerts(http.MethodPost, "/alerts/", `{"source":"XXX","destination":"USD","threshold":"1.00","callbackUrl":"https://example.com/hooks"}`)
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), customerros.CodeUnknownCurrency)
+	assert.Empty(t, alertsRepository.AlertRules)
+}
+
+func TestDeleteAlertRule(t *testing.T) {
+	setupAlerts()
+	alertsRepository.AlertRules = []models.AlertRule{{Id: 1, Source: "CHF", Destination: "USD"}}
+
+	deleted := serveAlerts(http.MethodDelete, "/alerts/1", "")
+	missing := serveAlerts(http.MethodDelete, "/alerts/1", "")
+
+	assert.Equal(t, http.StatusNoContent, deleted.Code)
+	assert.Equal(t, http.StatusNotFound, missing.Code)
+	assert.Contains(t, missing.Body.String(), customerros.CodeNotFound)
+}
+
+func TestGetWebhookDeliveriesOfAlertRule(t *testing.T) {
+	setupAlerts()
+	alertsRepository.AlertRules = []models.AlertRule{{Id: 1, Source: "CHF", Destination: "USD"}}
+	alertsRepository.WebhookDeliveries = []models.WebhookDelivery{{Id: 1, AlertRuleId: 1, Attempt: 1}, {Id: 2, AlertRuleId: 2, Attempt: 1}}
+
+	recorder := serveAlerts(http.MethodGet, "/alerts/1/deliveries", "")
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	var deliveries []models.WebhookDelivery
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &deliveries))
+	assert.Len(t, deliveries, 1)
+	assert.Equal(t, 1, deliveries[0].Id)
+}
+
+func TestGetWebhookDeliveriesOfMissingAlertRuleIsNotFound(t *testing.T) {
+	setupAlerts()
+
+	recorder := serveAlerts(http.MethodGet, "/alerts/1/deliveries", "")
+
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), customerros.CodeNotFound)
+}
+
+func TestGetWebhookDeliveriesRejectsInvalidId(t *testing.T) {
+	setupAlerts()
+
+	recorder := serveAlerts(http.MethodGet, "/alerts/abc/deliveries", "")
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), customerros.CodeInvalidId)
+}
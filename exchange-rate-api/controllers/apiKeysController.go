@@ -0,0 +1,127 @@
+package controllers
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/kolan92/exchange-rate-api/auth"
+	customerros "github.com/kolan92/exchange-rate-api/custom-erros"
+	"github.com/kolan92/exchange-rate-api/models"
+	"github.com/kolan92/exchange-rate-api/repositories"
+)
+
+type ApiKeysController struct {
+	apiKeysRepo repositories.ApiKeysRepository
+}
+
+func NewApiKeysController(apiKeysRepo repositories.ApiKeysRepository) *ApiKeysController {
+	return &ApiKeysController{apiKeysRepo}
+}
+
+// RegisterRouter registers admin endpoints, all of them require admin scope.
+func (controller *ApiKeysController) RegisterRouter(routerGroup *gin.RouterGroup) {
+	apiKeys := routerGroup.Group("/admin/api-keys", auth.RequireScope(auth.ScopeAdmin))
+	{
+		apiKeys.GET("/", func(c *gin.Context) {
+			controller.GetApiKeys(c)
+		})
+
+		apiKeys.POST("/", func(c *gin.Context) {
+			controller.IssueApiKey(c)
+		})
+
+		apiKeys.DELETE("/:id", func(c *gin.Context) {
+			controller.RevokeApiKey(c)
+		})
+	}
+}
+
+// @Summary GetApiKeys
+// @Description Returns all issued api keys, including revoked ones. Keys themselves are never returned
+// @Tags		admin
+// @Schemes
+// @Produce		json
+// @Security	ApiKeyAuth
+// @Router		/admin/api-keys	[get]
+// @Success 	200		{object}	[]models.ApiKey
+// @Failure 	default	{object}	customerros.Problem	"RFC 7807 problem details"
+func (c *ApiKeysController) GetApiKeys(g *gin.Context) {
+	dbApiKeys, err := c.apiKeysRepo.GetApiKeys(g.Request.Context())
+	if err != nil {
+		respondWithError(g, err)
+		return
+	}
+
+	apiKeys := make([]models.ApiKey, 0, len(dbApiKeys))
+	for _, dbApiKey := range dbApiKeys {
+		apiKeys = append(apiKeys, dbApiKey.ToApiKey())
+	}
+	g.JSON(http.StatusOK, apiKeys)
+}
+
+// @Summary IssueApiKey
+// @Description Issues api key with given scopes: read, write or admin. Key is returned only in this response, only its hash is stored
+// @Tags		admin
+// @Schemes
+// @Accept		json
+// @Produce		json
+// @Security	ApiKeyAuth
+// @Param		apiKey	body	models.ApiKey	true	"Name and scopes of new key, other fields are ignored"
+// @Router		/admin/api-keys	[post]
+// @Success 	201		{object}	models.ApiKey
+// @Failure 	default	{object}	customerros.Problem	"RFC 7807 problem details"
+func (c *ApiKeysController) IssueApiKey(g *gin.Context) {
+	apiKey := &models.ApiKey{}
+
+	if err := g.ShouldBindJSON(apiKey); err != nil {
+		respondWithError(g, customerros.NewBindingError(err))
+		return
+	}
+
+	key, prefix, err := auth.NewKey()
+	if err != nil {
+		respondWithError(g, fmt.Errorf("generating api key: %w", err))
+		return
+	}
+
+	dbApiKey := &models.DbApiKey{
+		Name:    apiKey.Name,
+		Prefix:  prefix,
+		KeyHash: auth.HashKey(key),
+		Scopes:  models.JoinScopes(apiKey.Scopes),
+	}
+	if err := c.apiKeysRepo.InsertApiKey(g.Request.Context(), dbApiKey); err != nil {
+		respondWithError(g, fmt.Errorf("inserting api key: %w", err))
+		return
+	}
+
+	issuedApiKey := dbApiKey.ToApiKey()
+	issuedApiKey.Key = key
+	g.JSON(http.StatusCreated, issuedApiKey)
+}
+
+// @Summary RevokeApiKey
+// @Description Revokes api key, requests with it are rejected immediately
+// @Tags		admin
+// @Schemes
+// @Security	ApiKeyAuth
+// @Param		id	path	int	true	"Api key id"
+// @Router		/admin/api-keys/{id}	[delete]
+// @Success 	204
+// @Success 	404
+// @Failure 	default	{object}	customerros.Problem	"RFC 7807 problem details"
+func (c *ApiKeysController) RevokeApiKey(g *gin.Context) {
+	id, err := parseIdParam(g)
+	if err != nil {
+		respondWithError(g, err)
+		return
+	}
+
+	if err := c.apiKeysRepo.RevokeApiKey(g.Request.Context(), id); err != nil {
+		respondWithError(g, err)
+		return
+	}
+
+	g.Status(http.StatusNoContent)
+}
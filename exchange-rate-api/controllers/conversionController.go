@@ -0,0 +1,64 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/kolan92/exchange-rate-api/conversion"
+	customerros "github.com/kolan92/exchange-rate-api/custom-erros"
+	"github.com/kolan92/exchange-rate-api/models"
+	"github.com/kolan92/exchange-rate-api/rounding"
+)
+
+type ConversionController struct {
+	converter *conversion.Converter
+}
+
+func NewConversionController(converter *conversion.Converter) *ConversionController {
+	return &ConversionController{converter}
+}
+
+func (controller *ConversionController) RegisterRouter(routerGroup *gin.RouterGroup) {
+	convert := routerGroup.Group("/convert")
+	{
+		convert.POST("/batch", func(c *gin.Context) {
+			controller.ConvertBatch(c)
+		})
+	}
+}
+
+// @Summary ConvertBatch
+// @Description Converts list of amounts, each one with rate from its own date. When there is no rate for the date, e.g. on holidays,
+// @Description the most recent rate from previous 7 days is used. Currencies without direct rate are converted through USD.
+// @Description Items which can't be converted have error set and are not included in totals.
+// @Description Converted amounts are rounded to ISO 4217 minor units of target currency, e.g. whole units for JPY and KRW, using requested rounding mode.
+// @Tags		convert
+// @Schemes
+// @Accept		json
+// @Produce		json
+// @Param		request	body	models.BatchConversionRequest	true	"Up to 1000 items to convert"
+// @Router		/convert/batch	[post]
+// @Success 	200		{object}	models.BatchConversionResponse
+// @Failure 	default	{object}	customerros.Problem	"RFC 7807 problem details"
+func (c *ConversionController) ConvertBatch(g *gin.Context) {
+	request := &models.BatchConversionRequest{}
+
+	if err := g.ShouldBindJSON(request); err != nil {
+		respondWithError(g, customerros.NewBindingError(err))
+		return
+	}
+
+	roundingMode, err := rounding.ParseMode(request.Rounding)
+	if err != nil {
+		respondWithError(g, err)
+		return
+	}
+
+	response, err := c.converter.ConvertBatch(g.Request.Context(), request.Items, roundingMode, request.IncludeUnrounded)
+	if err != nil {
+		respondWithError(g, err)
+		return
+	}
+
+	g.JSON(http.StatusOK, response)
+}
@@ -1,9 +1,6 @@
 package controllers
 
 import (
-	"errors"
-	"fmt"
-	"log"
 	"net/http"
 	"time"
 
@@ -11,11 +8,9 @@ import (
 	customerros "github.com/kolan92/exchange-rate-api/custom-erros"
 	"github.com/kolan92/exchange-rate-api/models"
 	"github.com/kolan92/exchange-rate-api/repositories"
-	"gorm.io/gorm"
+	"github.com/kolan92/exchange-rate-api/validators"
 )
 
-const dateLayout = "2006-01-02"
-
 type ExchangeRatesController struct {
 	repo repositories.CurrenciesRepository
 }
@@ -62,8 +57,9 @@ func (controller *ExchangeRatesController) RegisterRouter(routerGroup *gin.Route
 // @Description Returns list of all currencies
 // @Router		/currencies	[get]
 // @Success 	200		{object}	[]string
+// @Failure 	default	{object}	customerros.Problem	"RFC 7807 problem details"
 func (c *ExchangeRatesController) GetAllCurrencies(g *gin.Context) {
-	currencies := c.repo.GetCurrenciesCodes()
+	currencies := c.repo.GetCurrenciesCodes(g.Request.Context())
 	g.JSON(http.StatusOK, currencies)
 }
 
@@ -72,26 +68,29 @@ func (c *ExchangeRatesController) GetAllCurrencies(g *gin.Context) {
 // @Schemes
 // @Accept		json
 // @Produce		json
-// @Description Returns most recent exchange rate  which is not null in database for source - destinaion currencies
-// @Param		destination		query	string	false	"destination currency, default is USD"
-// @Param		source	query	string	true	"source, currency"
+// @Description Returns most recent exchange rate  which is not null in database for source - destinaion currencies.
+// @Description Rate is amount of destination (quote) currency for one unit of source (base) currency.
+// @Description Rate of pair stored the other way round is inverted, e.g. CHF/USD of stored USD/CHF
+// @Param		destination		query	string	false	"destination (quote) currency, default is USD"
+// @Param		source	query	string	true	"source (base) currency"
 // @Router		/exchange-rate/last	[get]
 // @Success 	200		{object}	models.ExchangeRate
 // @Success 	404
+// @Failure 	default	{object}	customerros.Problem	"RFC 7807 problem details"
 func (c *ExchangeRatesController) GetLastExchangeRate(g *gin.Context) {
-	currencyCodesMap := c.repo.GetCurrenciesCodesIdsMap()
+	currencyCodesMap := c.repo.GetCurrenciesCodesIdsMap(g.Request.Context())
 
 	sourceCurrencyId, destinationCurrencyId, err := getCurrenciesIds(g, currencyCodesMap)
 
 	if err != nil {
-		g.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		respondWithError(g, err)
 		return
 	}
 
-	exchangeRate, err := c.repo.GetLastExchangeRate(sourceCurrencyId, destinationCurrencyId)
+	exchangeRate, err := c.repo.GetLastExchangeRate(g.Request.Context(), sourceCurrencyId, destinationCurrencyId)
 
 	if err != nil {
-		g.JSON(errToStatusCode(err), gin.H{"error": err.Error()})
+		respondWithError(g, err)
 	} else {
 		g.JSON(http.StatusOK, exchangeRate)
 	}
@@ -106,25 +105,27 @@ func (c *ExchangeRatesController) GetLastExchangeRate(g *gin.Context) {
 // @Param		date	path	string	true	"Date for which exchange rates should be retrived. Date must be formated in YYYY-MM-DD"
 // @Router		/exchange-rate/all-from-date/{date}	[get]
 // @Success 	203		{object}	[]models.ExchangeRate
+// @Failure 	default	{object}	customerros.Problem	"RFC 7807 problem details"
 func (c *ExchangeRatesController) GetAllExchangeRatesFromDate(g *gin.Context) {
 	const dateParmKey = "date"
 	dateParam := g.Param(dateParmKey)
-	dateValue, err := time.Parse(dateLayout, dateParam)
+	dateValue, err := validators.ParseDate(dateParam)
 	if err != nil {
-		g.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("date %s is in incorrect format", dateParam)})
+		respondWithError(g, err)
 		return
 	}
-	exchangeRatesFromDate, err := c.repo.GetAllExchangeRatesFromDate(dateValue)
+	exchangeRatesFromDate, err := c.repo.GetAllExchangeRatesFromDate(g.Request.Context(), dateValue)
 
 	if err != nil {
-		g.JSON(errToStatusCode(err), gin.H{"error": err.Error()})
+		respondWithError(g, err)
 	} else {
 		g.JSON(http.StatusOK, exchangeRatesFromDate)
 	}
 }
 
 // @Summary InsertExchangeRate
-// @Description Inserts new exchange rate
+// @Description Inserts new exchange rate, rate is amount of destination (quote) currency for one unit of source (base) currency.
+// @Description Rate of pair which is stored the other way round is rejected with inverted_pair code, e.g. CHF/USD when USD/CHF is stored
 // @Tags		exchange-rate
 // @Schemes
 // @Accept		json
@@ -132,43 +133,23 @@ func (c *ExchangeRatesController) GetAllExchangeRatesFromDate(g *gin.Context) {
 // @Param		newExchangeRate	body	models.ExchangeRate	true	"New exchange rate to insert. Date has to be in RFC3339 format due to gin limitation. Time part will be ignored"
 // @Router		/exchange-rate	[post]
 // @Success 	204		{object}	models.ExchangeRate
+// @Failure 	default	{object}	customerros.Problem	"RFC 7807 problem details"
 func (c *ExchangeRatesController) InsertExchangeRate(g *gin.Context) {
 	newExchangeRate := &models.ExchangeRate{}
 
 	if err := g.ShouldBindJSON(&newExchangeRate); err != nil {
-		g.AbortWithStatusJSON(http.StatusBadRequest,
-			gin.H{"error": "incorrect exchange rate in body " + err.Error()})
-		return
-	}
-
-	year, month, day := newExchangeRate.Date.Date()
-	newExchangeRate.Date = time.Date(year, month, day, 0, 00, 00, 0, time.UTC)
-
-	currencyCodesMap := c.repo.GetCurrenciesCodesIdsMap()
-	if _, isFound := currencyCodesMap[newExchangeRate.Source]; !isFound {
-		g.JSON(http.StatusBadRequest, gin.H{"error": "Unknown source currency code"})
+		respondWithError(g, customerros.NewBindingError(err))
 		return
 	}
 
-	if _, isFound := currencyCodesMap[newExchangeRate.Destination]; !isFound {
-		g.JSON(http.StatusBadRequest, gin.H{"error": "Unknown destination currency code"})
+	currencyCodesMap := c.repo.GetCurrenciesCodesIdsMap(g.Request.Context())
+	if err := validators.ValidateNewExchangeRate(newExchangeRate, currencyCodesMap); err != nil {
+		respondWithError(g, err)
 		return
 	}
 
-	if newExchangeRate.Destination == newExchangeRate.Source {
-		g.JSON(http.StatusBadRequest, gin.H{"error": "Source and Destination currencies must be different"})
-		return
-	}
-
-	if err := c.repo.InsertExchangeRate(newExchangeRate); err != nil {
-		statusCode := errToStatusCode(err)
-		switch statusCode {
-		case http.StatusConflict:
-			g.JSON(statusCode, gin.H{"error": "Record exists for given currencies and date"})
-		default:
-			log.Println(fmt.Sprintf("Error while inserting new exchange rate to database: %s", err.Error()))
-			g.JSON(statusCode, gin.H{"error": "Error while inserting new exchange rate to database"})
-		}
+	if err := c.repo.InsertExchangeRate(g.Request.Context(), newExchangeRate); err != nil {
+		respondWithError(g, err)
 		return
 	}
 
@@ -180,96 +161,48 @@ func (c *ExchangeRatesController) InsertExchangeRate(g *gin.Context) {
 // @Schemes
 // @Accept		json
 // @Produce		json
-// @Description Returns exchange rates for currencies in the time period
-// @Param		destination		query	string	false	"destination currency, default is USD"
-// @Param		source	query	string	true	"source currency"
+// @Description Returns exchange rates for currencies in the time period.
+// @Description Rates of pair stored the other way round are inverted, e.g. CHF/USD of stored USD/CHF
+// @Param		destination		query	string	false	"destination (quote) currency, default is USD"
+// @Param		source	query	string	true	"source (base) currency"
 // @Param		from	query	string	true	"From date, inclusive, must be formated in YYYY-MM-DD"
 // @Param		till	query	string	true	"Till date, exclusive, must be formated in YYYY-MM-DD"
 // @Router		/exchange-rate/range [get]
 // @Success		200	{object}	[]models.ExchangeRate
+// @Failure 	default	{object}	customerros.Problem	"RFC 7807 problem details"
 func (c *ExchangeRatesController) GetRangeExchangeRate(g *gin.Context) {
-	currencyCodesMap := c.repo.GetCurrenciesCodesIdsMap()
+	currencyCodesMap := c.repo.GetCurrenciesCodesIdsMap(g.Request.Context())
 
 	sourceCurrencyId, destinationCurrencyId, err := getCurrenciesIds(g, currencyCodesMap)
+	if err != nil {
+		respondWithError(g, err)
+		return
+	}
 
 	from, till, err := parseFromAndTillDates(g)
 	if err != nil {
-		g.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		respondWithError(g, err)
 		return
 	}
-	exchangeRates, err := c.repo.GetRangeExchangeRate(sourceCurrencyId, destinationCurrencyId, from, till)
+	exchangeRates, err := c.repo.GetRangeExchangeRate(g.Request.Context(), sourceCurrencyId, destinationCurrencyId, from, till)
 
 	if err != nil {
-		g.JSON(errToStatusCode(err), gin.H{"error": err.Error()})
+		respondWithError(g, err)
 	} else {
 		g.JSON(http.StatusOK, exchangeRates)
 	}
 }
 
-func errToStatusCode(err error) int {
-	switch err {
-	case customerros.ErrDuplicateKeyViolation:
-		return http.StatusConflict
-	case gorm.ErrRecordNotFound:
-		return http.StatusNotFound
-	default:
-		return http.StatusInternalServerError
-	}
-}
-
 func getCurrenciesIds(g *gin.Context, currencyCodesMap map[string]int) (sourceCurrencyId, destinationCurrencyId int, err error) {
-
 	const sourceCurrencyParamKey = "source"
-	sourceCurrencyCode := g.Query(sourceCurrencyParamKey)
-
 	const destinationCurrencyParamKey = "destination"
-	destinationCurrencyCode := g.Query(destinationCurrencyParamKey)
-
-	if len(sourceCurrencyCode) == 0 {
-		return 0, 0, errors.New("missing source currency")
-	}
 
-	if len(destinationCurrencyCode) == 0 {
-		destinationCurrencyCode = "USD"
-	}
-
-	if sourceCurrencyCode == destinationCurrencyCode {
-		return 0, 0, errors.New("source and destination currency are the same")
-	}
-
-	sourceCurrencyId, isFound := currencyCodesMap[sourceCurrencyCode]
-	if !isFound {
-		return 0, 0, errors.New(fmt.Sprintf("Unknown %s source currency", sourceCurrencyCode))
-	}
-
-	destinationCurrencyId, isFound = currencyCodesMap[destinationCurrencyCode]
-	if !isFound {
-		return 0, 0, errors.New(fmt.Sprintf("Unknown %s destination currency", destinationCurrencyCode))
-	}
-
-	return sourceCurrencyId, destinationCurrencyId, nil
+	return validators.GetCurrenciesIds(currencyCodesMap, g.Query(sourceCurrencyParamKey), g.Query(destinationCurrencyParamKey))
 }
 
 func parseFromAndTillDates(g *gin.Context) (from, till *time.Time, err error) {
 	const fromParamKey = "from"
-
-	fromParam := g.Query(fromParamKey)
-	fromValue, err := time.Parse(dateLayout, fromParam)
-	if err != nil {
-		return nil, nil, errors.New(fmt.Sprintf("from %s is in incorrect format", fromParam))
-	}
-
 	const tillParamKey = "till"
 
-	tillParam := g.Query(tillParamKey)
-	tillValue, err := time.Parse(dateLayout, tillParam)
-	if err != nil {
-		return nil, nil, errors.New(fmt.Sprintf("till %s is in incorrect format", tillParam))
-	}
-
-	if fromValue.After(tillValue) {
-		return nil, nil, errors.New("from must be before till")
-	}
-
-	return &fromValue, &tillValue, nil
+	return validators.ParseFromAndTillDates(g.Query(fromParamKey), g.Query(tillParamKey))
 }
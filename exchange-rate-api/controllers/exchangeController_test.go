@@ -1,17 +1,24 @@
 package controllers
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/gin-gonic/gin"
+	customerros "github.com/kolan92/exchange-rate-api/custom-erros"
+	"github.com/kolan92/exchange-rate-api/logging"
 	"github.com/kolan92/exchange-rate-api/models"
+	"github.com/kolan92/exchange-rate-api/repositories"
 	testhelpers "github.com/kolan92/exchange-rate-api/testHelpers"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
 	"gorm.io/gorm"
 )
 
@@ -37,6 +44,7 @@ func setup() {
 	gin.SetMode(gin.TestMode)
 	context, _ := gin.CreateTestContext(recorder)
 	ginContext = context
+	ginContext.Request = httptest.NewRequest(http.MethodGet, "/", nil)
 }
 
 func TestGetLastExchangeRateReturnsValue(t *testing.T) {
@@ -127,3 +135,104 @@ func setQueryString(queryString string) {
 		},
 	}
 }
+
+func TestErrorResponseContainsRequestId(t *testing.T) {
+	setup()
+	router := gin.New()
+	router.Use(logging.Middleware(zap.NewNop()))
+	controller.RegisterRouter(&router.RouterGroup)
+	request := httptest.NewRequest(http.MethodGet, "/exchange-rate/last?source=PLN", nil)
+	request.Header.Set(logging.RequestIdHeader, "abc-123")
+
+	router.ServeHTTP(recorder, request)
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	assert.Equal(t, customerros.ProblemContentType, recorder.Header().Get("Content-Type"))
+	var problem customerros.Problem
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &problem))
+	assert.Equal(t, "abc-123", problem.RequestId)
+	assert.Equal(t, customerros.CodeUnknownCurrency, problem.Code)
+	assert.Equal(t, "/exchange-rate/last", problem.Instance)
+}
+
+func TestInsertExchangeRateReturnsInvalidFields(t *testing.T) {
+	setup()
+	router := gin.New()
+	controller.RegisterRouter(&router.RouterGroup)
+	request := httptest.NewRequest(http.MethodPost, "/exchange-rate/", strings.NewReader(`{"source": "CHF"}`))
+
+	router.ServeHTTP(recorder, request)
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	var problem customerros.Problem
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &problem))
+	assert.Equal(t, customerros.CodeValidationFailed, problem.Code)
+	assert.Equal(t, []customerros.FieldError{
+		{Field: "destination", Code: "required", Message: "is required"},
+		{Field: "date", Code: "required", Message: "is required"},
+	}, problem.Errors)
+}
+
+func TestInsertExchangeRateReturnsConflict(t *testing.T) {
+	setup()
+	ginContext.Request = httptest.NewRequest(http.MethodPost, "/exchange-rate/", strings.NewReader(`{"source": "CHF", "destination": "USD", "date": "2022-05-01T00:00:00Z"}`))
+	repository.InsertExchangeRateError = customerros.ErrDuplicateKeyViolation
+
+	controller.InsertExchangeRate(ginContext)
+
+	assert.Equal(t, http.StatusConflict, recorder.Code)
+	var problem customerros.Problem
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &problem))
+	assert.Equal(t, customerros.CodeDuplicateRate, problem.Code)
+}
+
+func TestInsertExchangeRateRejectsNonPositiveRate(t *testing.T) {
+	setup()
+	ginContext.Request = httptest.NewRequest(http.MethodPost, "/exchange-rate/", strings.NewReader(`{"source": "USD", "destination": "CHF", "date": "2022-05-01T00:00:00Z", "rate": 0}`))
+
+	controller.InsertExchangeRate(ginContext)
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	var problem customerros.Problem
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &problem))
+	assert.Equal(t, customerros.CodeValidationFailed, problem.Code)
+}
+
+func TestInsertExchangeRateRejectsPairStoredTheOtherWayRound(t *testing.T) {
+	memoryRepository := repositories.NewMemoryCurrenciesRepository(repositories.SeedCurrenciesCodes)
+	router := gin.New()
+	NewExchangeRatesController(memoryRepository).RegisterRouter(&router.RouterGroup)
+	stored, inverted := httptest.NewRecorder(), httptest.NewRecorder()
+
+	router.ServeHTTP(stored, httptest.NewRequest(http.MethodPost, "/exchange-rate/", strings.NewReader(`{"source": "USD", "destination": "CHF", "date": "2022-05-02T00:00:00Z", "rate": 0.9774}`)))
+	router.ServeHTTP(inverted, httptest.NewRequest(http.MethodPost, "/exchange-rate/", strings.NewReader(`{"source": "CHF", "destination": "USD", "date": "2022-05-03T00:00:00Z", "rate": 1.0231}`)))
+
+	assert.Equal(t, http.StatusAccepted, stored.Code)
+	assert.Equal(t, http.StatusConflict, inverted.Code)
+	var problem customerros.Problem
+	assert.NoError(t, json.Unmarshal(inverted.Body.Bytes(), &problem))
+	assert.Equal(t, customerros.CodeInvertedPair, problem.Code)
+}
+
+func TestGetRangeExchangeRateFiltersDatesOfMemoryRepository(t *testing.T) {
+	memoryRepository := repositories.NewMemoryCurrenciesRepository(repositories.SeedCurrenciesCodes)
+	for day := 1; day <= 5; day++ {
+		rate := decimal.NewFromFloat(1.01)
+		memoryRepository.InsertExchangeRate(context.Background(), &models.ExchangeRate{
+			Source:      "CHF",
+			Destination: "USD",
+			Date:        time.Date(2022, 05, day, 0, 00, 00, 0, time.UTC),
+			Rate:        &rate,
+		})
+	}
+	router := gin.New()
+	NewExchangeRatesController(memoryRepository).RegisterRouter(&router.RouterGroup)
+	recorder := httptest.NewRecorder()
+
+	router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/exchange-rate/range/?source=CHF&from=2022-05-02&till=2022-05-04", nil))
+
+	var exchangeRates []models.ExchangeRate
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &exchangeRates))
+	assert.Len(t, exchangeRates, 2)
+	assert.Equal(t, time.Date(2022, 05, 03, 0, 00, 00, 0, time.UTC), exchangeRates[0].Date)
+}
@@ -0,0 +1,45 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/kolan92/exchange-rate-api/health"
+)
+
+// HealthController serves orchestrator probes. They are registered outside of api base path and swagger,
+// as they are not part of public api.
+type HealthController struct {
+	readiness *health.Checker
+}
+
+func NewHealthController(readiness *health.Checker) *HealthController {
+	return &HealthController{readiness}
+}
+
+func (controller *HealthController) RegisterRouter(routerGroup *gin.RouterGroup) {
+	routerGroup.GET("/livez", func(c *gin.Context) {
+		controller.Livez(c)
+	})
+
+	routerGroup.GET("/readyz", func(c *gin.Context) {
+		controller.Readyz(c)
+	})
+}
+
+// Livez reports that process is able to serve requests, it doesn't check dependencies,
+// so database outage doesn't restart all instances.
+func (c *HealthController) Livez(g *gin.Context) {
+	g.JSON(http.StatusOK, gin.H{"status": health.StatusOk})
+}
+
+// Readyz runs readiness checks and responds with 503 when any of them failed, with result of every check.
+func (c *HealthController) Readyz(g *gin.Context) {
+	report := c.readiness.Run(g.Request.Context())
+
+	statusCode := http.StatusOK
+	if report.Status != health.StatusOk {
+		statusCode = http.StatusServiceUnavailable
+	}
+	g.JSON(statusCode, report)
+}
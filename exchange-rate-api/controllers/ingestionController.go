@@ -0,0 +1,83 @@
+package controllers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/kolan92/exchange-rate-api/auth"
+	customerros "github.com/kolan92/exchange-rate-api/custom-erros"
+	"github.com/kolan92/exchange-rate-api/ingestion"
+	"github.com/kolan92/exchange-rate-api/repositories"
+)
+
+const (
+	defaultIngestionRunsLimit = 20
+	maxIngestionRunsLimit     = 1000
+)
+
+type IngestionController struct {
+	scheduler *ingestion.Scheduler
+	runsRepo  repositories.IngestionRunsRepository
+}
+
+func NewIngestionController(scheduler *ingestion.Scheduler, runsRepo repositories.IngestionRunsRepository) *IngestionController {
+	return &IngestionController{scheduler, runsRepo}
+}
+
+// RegisterRouter registers admin endpoints, all of them require admin scope.
+func (controller *IngestionController) RegisterRouter(routerGroup *gin.RouterGroup) {
+	runs := routerGroup.Group("/admin/ingestion/runs", auth.RequireScope(auth.ScopeAdmin))
+	{
+		runs.GET("/", func(c *gin.Context) {
+			controller.GetIngestionRuns(c)
+		})
+
+		runs.POST("/", func(c *gin.Context) {
+			controller.RunIngestion(c)
+		})
+	}
+}
+
+// @Summary GetIngestionRuns
+// @Description Returns outcomes of the most recent ingestion runs, newest first
+// @Tags		admin
+// @Schemes
+// @Produce		json
+// @Security	ApiKeyAuth
+// @Param		limit	query	int	false	"Maximum number of runs, 20 by default"
+// @Router		/admin/ingestion/runs	[get]
+// @Success 	200		{object}	[]models.IngestionRun
+// @Failure 	default	{object}	customerros.Problem	"RFC 7807 problem details"
+func (c *IngestionController) GetIngestionRuns(g *gin.Context) {
+	limit := defaultIngestionRunsLimit
+	if limitParam := g.Query("limit"); limitParam != "" {
+		value, err := strconv.Atoi(limitParam)
+		if err != nil || value < 1 || value > maxIngestionRunsLimit {
+			respondWithError(g, customerros.Newf(customerros.CodeValidationFailed, "limit must be number between 1 and %d", maxIngestionRunsLimit))
+			return
+		}
+		limit = value
+	}
+
+	runs, err := c.runsRepo.GetIngestionRuns(g.Request.Context(), limit)
+	if err != nil {
+		respondWithError(g, err)
+		return
+	}
+	g.JSON(http.StatusOK, runs)
+}
+
+// @Summary RunIngestion
+// @Description Fetches rates of configured pairs from provider immediately and returns outcome of the run.
+// @Description Waits for scheduled run when it is in progress
+// @Tags		admin
+// @Schemes
+// @Produce		json
+// @Security	ApiKeyAuth
+// @Router		/admin/ingestion/runs	[post]
+// @Success 	200		{object}	models.IngestionRun
+// @Failure 	default	{object}	customerros.Problem	"RFC 7807 problem details"
+func (c *IngestionController) RunIngestion(g *gin.Context) {
+	g.JSON(http.StatusOK, c.scheduler.RunOnce(g.Request.Context()))
+}
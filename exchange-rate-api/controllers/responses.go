@@ -0,0 +1,33 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
+	customerros "github.com/kolan92/exchange-rate-api/custom-erros"
+	"github.com/kolan92/exchange-rate-api/logging"
+	"go.uber.org/zap"
+)
+
+func init() {
+	if validate, isValidator := binding.Validator.Engine().(*validator.Validate); isValidator {
+		customerros.RegisterJsonFieldNames(validate)
+	}
+}
+
+// respondWithError stops handling of the request with problem details of the error and request id, so client can report it.
+// Internal errors are logged, their messages are not returned.
+func respondWithError(g *gin.Context, err error) {
+	ctx := g.Request.Context()
+	if customerros.NewProblem(err).Status == http.StatusInternalServerError {
+		logging.FromContext(ctx).Error("Error while handling request", zap.Error(err))
+	}
+	customerros.Abort(g, err, logging.RequestId(ctx))
+}
+
+// NoRoute responds with problem details to requests of unknown routes.
+func NoRoute(g *gin.Context) {
+	respondWithError(g, customerros.Newf(customerros.CodeNotFound, "route %s %s not found", g.Request.Method, g.Request.URL.Path))
+}
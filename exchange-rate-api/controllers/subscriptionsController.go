@@ -0,0 +1,106 @@
+package controllers
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/kolan92/exchange-rate-api/events"
+	"github.com/kolan92/exchange-rate-api/repositories"
+	"github.com/kolan92/exchange-rate-api/validators"
+)
+
+// Keeps idle connections open through proxies closing connections without traffic.
+const heartbeatInterval = 30 * time.Second
+
+type SubscriptionsController struct {
+	repo   repositories.CurrenciesRepository
+	broker *events.Broker
+}
+
+func NewSubscriptionsController(repo repositories.CurrenciesRepository, broker *events.Broker) *SubscriptionsController {
+	return &SubscriptionsController{repo, broker}
+}
+
+func (controller *SubscriptionsController) RegisterRouter(routerGroup *gin.RouterGroup) {
+	exchangeRate := routerGroup.Group("/exchange-rate")
+	{
+		exchangeRate.GET("/subscribe", func(c *gin.Context) {
+			controller.Subscribe(c)
+		})
+	}
+}
+
+// @Summary Subscribe
+// @Tags		exchange-rate
+// @Schemes
+// @Produce		text/event-stream
+// @Description Streams newly inserted exchange rates as server-sent events named exchange-rate, with models.ExchangeRate json as data.
+// @Description Events named heartbeat are sent periodically to keep connection open.
+// @Param		pairs	query	string	false	"Comma separated currency pairs formatted as SOURCE-DESTINATION, e.g. USD-CHF,USD-JPY. All pairs are streamed when missing"
+// @Router		/exchange-rate/subscribe [get]
+// @Success		200	{object}	models.ExchangeRate
+// @Failure 	default	{object}	customerros.Problem	"RFC 7807 problem details"
+func (c *SubscriptionsController) Subscribe(g *gin.Context) {
+	const pairsParamKey = "pairs"
+
+	pairs, err := c.parsePairs(g.Request.Context(), g.Query(pairsParamKey))
+	if err != nil {
+		respondWithError(g, err)
+		return
+	}
+
+	subscription := c.broker.Subscribe(pairs...)
+	defer subscription.Unsubscribe()
+
+	heartbeat := time.NewTicker(heartbeatInterval)
+	defer heartbeat.Stop()
+
+	// headers are flushed before the first event, so content type can't be left to SSEvent
+	g.Header("Content-Type", "text/event-stream")
+	g.Header("Cache-Control", "no-cache")
+	g.Header("Connection", "keep-alive")
+	g.Status(http.StatusOK)
+	g.Writer.Flush()
+
+	g.Stream(func(w io.Writer) bool {
+		select {
+		case exchangeRate, isOpen := <-subscription.C:
+			if !isOpen {
+				return false
+			}
+			g.SSEvent("exchange-rate", exchangeRate)
+			return true
+		case tick := <-heartbeat.C:
+			g.SSEvent("heartbeat", tick.UTC().Format(time.RFC3339))
+			return true
+		case <-g.Request.Context().Done():
+			return false
+		}
+	})
+}
+
+func (c *SubscriptionsController) parsePairs(ctx context.Context, pairsParam string) ([]events.Pair, error) {
+	pairs := []events.Pair{}
+	if len(pairsParam) == 0 {
+		return pairs, nil
+	}
+
+	currencyCodesMap := c.repo.GetCurrenciesCodesIdsMap(ctx)
+	for _, pairParam := range strings.Split(pairsParam, ",") {
+		source, destination, _ := strings.Cut(pairParam, "-")
+		if len(destination) == 0 {
+			destination = validators.DefaultDestinationCurrency
+		}
+
+		if _, _, err := validators.GetCurrenciesIds(currencyCodesMap, source, destination); err != nil {
+			return nil, err
+		}
+		pairs = append(pairs, events.Pair{Source: source, Destination: destination})
+	}
+
+	return pairs, nil
+}
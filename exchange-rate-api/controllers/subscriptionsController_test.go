@@ -0,0 +1,26 @@
+package controllers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/kolan92/exchange-rate-api/events"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSubscribeRespondsWithEventStream(t *testing.T) {
+	setup()
+	router := gin.New()
+	NewSubscriptionsController(repository, events.NewBroker()).RegisterRouter(&router.RouterGroup)
+	server := httptest.NewServer(router)
+	defer server.Close()
+
+	response, err := http.Get(server.URL + "/exchange-rate/subscribe?pairs=USD-CHF")
+
+	assert.NoError(t, err)
+	defer response.Body.Close()
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	assert.Equal(t, "text/event-stream", response.Header.Get("Content-Type"))
+}
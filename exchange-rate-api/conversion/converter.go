@@ -0,0 +1,263 @@
+package conversion
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	customerros "github.com/kolan92/exchange-rate-api/custom-erros"
+	"github.com/kolan92/exchange-rate-api/models"
+	"github.com/kolan92/exchange-rate-api/repositories"
+	"github.com/kolan92/exchange-rate-api/rounding"
+	"github.com/kolan92/exchange-rate-api/validators"
+	"github.com/shopspring/decimal"
+)
+
+// asOfLookback is how far back rate is searched when there is no rate for the date, e.g. on weekends and holidays.
+const asOfLookback = 7 * 24 * time.Hour
+
+var one = decimal.NewFromInt(1)
+
+// Converter converts amounts using rates from the date of each amount.
+// Stored rate is amount of destination (quote) currency for one unit of source (base) currency.
+// Currencies without direct rate are converted through USD.
+type Converter struct {
+	repo repositories.CurrenciesRepository
+}
+
+func NewConverter(repo repositories.CurrenciesRepository) *Converter {
+	return &Converter{repo}
+}
+
+type codesPair struct {
+	source, destination string
+}
+
+// ratio keeps inverted and cross rates as fraction, so amount is divided only once and doesn't lose precision.
+type ratio struct {
+	numerator, denominator decimal.Decimal
+}
+
+func (r ratio) mul(other ratio) ratio {
+	return ratio{r.numerator.Mul(other.numerator), r.denominator.Mul(other.denominator)}
+}
+
+func (r ratio) apply(amount decimal.Decimal) decimal.Decimal {
+	return amount.Mul(r.numerator).Div(r.denominator)
+}
+
+type datedRate struct {
+	date time.Time
+	rate decimal.Decimal
+}
+
+// rateTable holds not null rates per currency pair, sorted by date ascending.
+type rateTable map[codesPair][]datedRate
+
+// ConvertBatch validates all items up front, then loads rates of all currencies and dates with single repository query.
+// Items without rate on or shortly before their date are returned with error and skipped in totals.
+// Converted amounts are rounded to minor units of target currency, totals are sum of rounded amounts.
+func (c *Converter) ConvertBatch(ctx context.Context, items []models.ConversionItem, roundingMode rounding.Mode, includeUnrounded bool) (*models.BatchConversionResponse, error) {
+	response := &models.BatchConversionResponse{
+		Items:  make([]models.ConvertedItem, 0, len(items)),
+		Totals: make(map[string]decimal.Decimal),
+	}
+	if includeUnrounded {
+		response.UnroundedTotals = make(map[string]decimal.Decimal)
+	}
+	if len(items) == 0 {
+		return response, nil
+	}
+
+	currencyCodesMap := c.repo.GetCurrenciesCodesIdsMap(ctx)
+
+	dates := make([]time.Time, len(items))
+	for i := range items {
+		if len(items[i].Target) == 0 {
+			items[i].Target = validators.DefaultDestinationCurrency
+		}
+
+		if err := validateCurrency(currencyCodesMap, items[i].Currency); err != nil {
+			return nil, fmt.Errorf("item %d: %w", i, err)
+		}
+		if err := validateCurrency(currencyCodesMap, items[i].Target); err != nil {
+			return nil, fmt.Errorf("item %d: %w", i, err)
+		}
+
+		date, err := validators.ParseDate(items[i].Date)
+		if err != nil {
+			return nil, fmt.Errorf("item %d: %w", i, err)
+		}
+		dates[i] = date
+	}
+
+	rates, err := c.loadRates(ctx, currencyCodesMap, items, dates)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, item := range items {
+		convertedItem := models.ConvertedItem{ConversionItem: item}
+
+		rate, rateDate, isFound := rates.conversionRate(item.Currency, item.Target, dates[i])
+		if !isFound {
+			convertedItem.Error = fmt.Sprintf("no %s %s rate on or before %s", item.Currency, item.Target, item.Date)
+			response.Items = append(response.Items, convertedItem)
+			continue
+		}
+
+		unroundedAmount := rate.apply(item.Amount)
+		convertedAmount := rounding.Round(unroundedAmount, item.Target, roundingMode)
+		unitRate := rate.apply(one)
+		formattedRateDate := rateDate.Format(validators.DateLayout)
+		convertedItem.ConvertedAmount = &convertedAmount
+		convertedItem.Rate = &unitRate
+		convertedItem.RateDate = &formattedRateDate
+
+		response.Totals[item.Target] = response.Totals[item.Target].Add(convertedAmount)
+		if includeUnrounded {
+			convertedItem.UnroundedAmount = &unroundedAmount
+			response.UnroundedTotals[item.Target] = response.UnroundedTotals[item.Target].Add(unroundedAmount)
+		}
+		response.Items = append(response.Items, convertedItem)
+	}
+
+	return response, nil
+}
+
+func validateCurrency(currencyCodesMap map[string]int, currencyCode string) error {
+	if _, isFound := currencyCodesMap[currencyCode]; !isFound {
+		return customerros.Newf(customerros.CodeUnknownCurrency, "unknown %s currency", currencyCode)
+	}
+	return nil
+}
+
+// loadRates loads all pairs which could be used to convert items, in both directions and through USD.
+func (c *Converter) loadRates(ctx context.Context, currencyCodesMap map[string]int, items []models.ConversionItem, dates []time.Time) (rateTable, error) {
+	rates := make(rateTable)
+
+	currencyPairs := []models.CurrencyPair{}
+	isAdded := make(map[models.CurrencyPair]bool)
+	addPair := func(source, destination string) {
+		if source == destination {
+			return
+		}
+		currencyPair := models.CurrencyPair{
+			SourceCurrencyId:      currencyCodesMap[source],
+			DestinationCurrencyId: currencyCodesMap[destination],
+		}
+		if !isAdded[currencyPair] {
+			isAdded[currencyPair] = true
+			currencyPairs = append(currencyPairs, currencyPair)
+		}
+	}
+
+	from, till := dates[0], dates[0]
+	for i, item := range items {
+		for _, currency := range []string{item.Currency, item.Target} {
+			for _, other := range []string{item.Currency, item.Target, validators.DefaultDestinationCurrency} {
+				addPair(currency, other)
+				addPair(other, currency)
+			}
+		}
+
+		if dates[i].Before(from) {
+			from = dates[i]
+		}
+		if dates[i].After(till) {
+			till = dates[i]
+		}
+	}
+
+	if len(currencyPairs) == 0 {
+		return rates, nil
+	}
+
+	from = from.Add(-asOfLookback)
+	till = till.AddDate(0, 0, 1)
+	exchangeRates, err := c.repo.GetRangeExchangeRates(ctx, currencyPairs, &from, &till)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, exchangeRate := range exchangeRates {
+		if exchangeRate.Rate == nil || exchangeRate.Rate.IsZero() {
+			continue
+		}
+		pair := codesPair{exchangeRate.Source, exchangeRate.Destination}
+		rates[pair] = append(rates[pair], datedRate{exchangeRate.Date, *exchangeRate.Rate})
+	}
+
+	for _, pairRates := range rates {
+		sort.Slice(pairRates, func(i, j int) bool {
+			return pairRates[i].date.Before(pairRates[j].date)
+		})
+	}
+
+	return rates, nil
+}
+
+// conversionRate returns amount of target currency for one unit of currency, as of the date.
+// Returned date is the oldest date of rates used.
+func (rates rateTable) conversionRate(currency, target string, date time.Time) (ratio, time.Time, bool) {
+	if currency == target {
+		return ratio{one, one}, date, true
+	}
+
+	if rate, rateDate, isFound := rates.pairRate(currency, target, date); isFound {
+		return rate, rateDate, true
+	}
+
+	const pivot = validators.DefaultDestinationCurrency
+	if currency == pivot || target == pivot {
+		return ratio{}, time.Time{}, false
+	}
+
+	toPivot, toPivotDate, isFound := rates.pairRate(currency, pivot, date)
+	if !isFound {
+		return ratio{}, time.Time{}, false
+	}
+
+	fromPivot, fromPivotDate, isFound := rates.pairRate(pivot, target, date)
+	if !isFound {
+		return ratio{}, time.Time{}, false
+	}
+
+	rateDate := toPivotDate
+	if fromPivotDate.Before(rateDate) {
+		rateDate = fromPivotDate
+	}
+	return toPivot.mul(fromPivot), rateDate, true
+}
+
+// pairRate uses rate of the pair stored in any direction, rate of pair stored as target/currency is inverted.
+func (rates rateTable) pairRate(currency, target string, date time.Time) (ratio, time.Time, bool) {
+	if rate, isFound := rates.asOf(codesPair{currency, target}, date); isFound {
+		return ratio{rate.rate, one}, rate.date, true
+	}
+
+	if rate, isFound := rates.asOf(codesPair{target, currency}, date); isFound {
+		return ratio{one, rate.rate}, rate.date, true
+	}
+
+	return ratio{}, time.Time{}, false
+}
+
+// asOf returns the most recent rate on or before the date, within lookback period.
+func (rates rateTable) asOf(pair codesPair, date time.Time) (datedRate, bool) {
+	pairRates := rates[pair]
+
+	i := sort.Search(len(pairRates), func(i int) bool {
+		return pairRates[i].date.After(date)
+	})
+	if i == 0 {
+		return datedRate{}, false
+	}
+
+	rate := pairRates[i-1]
+	if date.Sub(rate.date) > asOfLookback {
+		return datedRate{}, false
+	}
+	return rate, true
+}
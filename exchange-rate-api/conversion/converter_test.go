@@ -0,0 +1,136 @@
+package conversion
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kolan92/exchange-rate-api/models"
+	"github.com/kolan92/exchange-rate-api/rounding"
+	testhelpers "github.com/kolan92/exchange-rate-api/testHelpers"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	repository *testhelpers.MockRepository
+	converter  *Converter
+)
+
+func setup() {
+	repository = testhelpers.NewMockRepository()
+	repository.CodesCurrenciesIdsMap["USD"] = 1
+	repository.CodesCurrenciesIdsMap["CHF"] = 2
+	repository.CodesCurrenciesIdsMap["JPY"] = 3
+
+	repository.RangeExchangeRates = []models.ExchangeRate{
+		exchangeRate("CHF", "2017-05-01", "0.9960"),
+		exchangeRate("CHF", "2017-04-28", "0.9950"),
+		exchangeRate("JPY", "2017-05-01", "112.00"),
+		exchangeRate("JPY", "2017-04-28", ""),
+	}
+
+	converter = NewConverter(repository)
+}
+
+// exchangeRate returns USD/quote rate, amount of quote currency for one dollar.
+func exchangeRate(quote, date, rate string) models.ExchangeRate {
+	dateValue, _ := time.Parse("2006-01-02", date)
+	exchangeRate := models.ExchangeRate{Source: "USD", Destination: quote, Date: dateValue}
+	if rate != "" {
+		rateValue := decimal.RequireFromString(rate)
+		exchangeRate.Rate = &rateValue
+	}
+	return exchangeRate
+}
+
+// Amounts converted to USD use inverted USD/CHF rate.
+func TestConvertBatchUsesRateFromItemDate(t *testing.T) {
+	setup()
+
+	response, err := converter.ConvertBatch(context.Background(), []models.ConversionItem{
+		{Amount: decimal.RequireFromString("99.60"), Currency: "CHF", Date: "2017-05-01"},
+		{Amount: decimal.RequireFromString("99.50"), Currency: "CHF", Date: "2017-04-28", Target: "USD"},
+	}, rounding.HalfEven, false)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "100", response.Items[0].ConvertedAmount.String())
+	assert.Equal(t, "2017-05-01", *response.Items[0].RateDate)
+	assert.Equal(t, "100", response.Items[1].ConvertedAmount.String())
+	assert.Equal(t, "200", response.Totals["USD"].String())
+	assert.Len(t, repository.CurrencyPairsCalls, 1)
+}
+
+func TestConvertBatchUsesLastRateBeforeHoliday(t *testing.T) {
+	setup()
+
+	response, err := converter.ConvertBatch(context.Background(), []models.ConversionItem{
+		{Amount: decimal.RequireFromString("99.60"), Currency: "CHF", Date: "2017-05-03"},
+	}, rounding.HalfEven, false)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "100", response.Items[0].ConvertedAmount.String())
+	assert.Equal(t, "2017-05-01", *response.Items[0].RateDate)
+}
+
+func TestConvertBatchFromUSDUsesStoredRate(t *testing.T) {
+	setup()
+
+	response, err := converter.ConvertBatch(context.Background(), []models.ConversionItem{
+		{Amount: decimal.RequireFromString("100"), Currency: "USD", Date: "2017-05-01", Target: "JPY"},
+	}, rounding.HalfEven, false)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "11200", response.Items[0].ConvertedAmount.String())
+}
+
+func TestConvertBatchConvertsThroughUSD(t *testing.T) {
+	setup()
+
+	response, err := converter.ConvertBatch(context.Background(), []models.ConversionItem{
+		{Amount: decimal.RequireFromString("99.60"), Currency: "CHF", Date: "2017-05-01", Target: "JPY"},
+	}, rounding.HalfEven, false)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "11200", response.Items[0].ConvertedAmount.String())
+}
+
+func TestConvertBatchSkipsItemsWithoutRate(t *testing.T) {
+	setup()
+
+	response, err := converter.ConvertBatch(context.Background(), []models.ConversionItem{
+		{Amount: decimal.RequireFromString("100"), Currency: "JPY", Date: "2017-04-28"},
+		{Amount: decimal.RequireFromString("99.60"), Currency: "CHF", Date: "2017-05-01"},
+	}, rounding.HalfEven, false)
+
+	assert.NoError(t, err)
+	assert.NotEmpty(t, response.Items[0].Error)
+	assert.Nil(t, response.Items[0].ConvertedAmount)
+	assert.Equal(t, "100", response.Totals["USD"].String())
+}
+
+func TestConvertBatchRejectsUnknownCurrency(t *testing.T) {
+	setup()
+
+	_, err := converter.ConvertBatch(context.Background(), []models.ConversionItem{
+		{Amount: decimal.RequireFromString("100"), Currency: "PLN", Date: "2017-05-01"},
+	}, rounding.HalfEven, false)
+
+	assert.Error(t, err)
+	assert.Empty(t, repository.CurrencyPairsCalls)
+}
+
+func TestConvertBatchRoundsToMinorUnitsOfTarget(t *testing.T) {
+	setup()
+
+	response, err := converter.ConvertBatch(context.Background(), []models.ConversionItem{
+		{Amount: decimal.RequireFromString("10.005"), Currency: "USD", Date: "2017-05-01", Target: "CHF"},
+		{Amount: decimal.RequireFromString("0.123"), Currency: "USD", Date: "2017-05-01", Target: "JPY"},
+	}, rounding.HalfUp, true)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "9.96", response.Items[0].ConvertedAmount.String())
+	assert.Equal(t, "9.96498", response.Items[0].UnroundedAmount.String())
+	assert.Equal(t, "14", response.Items[1].ConvertedAmount.String())
+	assert.Equal(t, "13.776", response.UnroundedTotals["JPY"].String())
+}
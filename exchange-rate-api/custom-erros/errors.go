@@ -1,5 +1,67 @@
 package customerros
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+)
 
-var ErrDuplicateKeyViolation = errors.New("duplicate key value")
+// Stable codes of errors returned to clients, they don't change together with messages.
+const (
+	CodeInvalidRequest   = "invalid_request"
+	CodeValidationFailed = "validation_failed"
+	CodeMissingParameter = "missing_parameter"
+	CodeUnknownCurrency  = "unknown_currency"
+	CodeSameCurrency     = "same_currency"
+	CodeInvalidDate      = "invalid_date"
+	CodeInvalidDateRange = "invalid_date_range"
+	CodeInvalidId        = "invalid_id"
+	CodeNotFound         = "not_found"
+	CodeDuplicateRate    = "duplicate_rate"
+	CodeInvertedPair     = "inverted_pair"
+	CodeUnauthorized     = "unauthorized"
+	CodeForbidden        = "forbidden"
+	CodeRateLimited      = "rate_limited"
+	CodeInternal         = "internal_error"
+)
+
+// Error is a domain error with stable code, which is mapped to problem details returned to clients.
+type Error struct {
+	Code    string
+	Message string
+	Fields  []FieldError
+}
+
+// FieldError describes invalid field of request body, Field is json path, e.g. items[2].currency.
+type FieldError struct {
+	Field   string `json:"field"`
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+func New(code, message string) *Error {
+	return &Error{Code: code, Message: message}
+}
+
+func Newf(code, format string, args ...interface{}) *Error {
+	return &Error{Code: code, Message: fmt.Sprintf(format, args...)}
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+// Extensions adds the code to graphql errors.
+func (e *Error) Extensions() map[string]interface{} {
+	return map[string]interface{}{"code": e.Code}
+}
+
+// Code returns code of domain error wrapped in err, or internal_error for other errors.
+func Code(err error) string {
+	var domainError *Error
+	if errors.As(err, &domainError) {
+		return domainError.Code
+	}
+	return CodeInternal
+}
+
+var ErrDuplicateKeyViolation = New(CodeDuplicateRate, "record exists for given currencies and date")
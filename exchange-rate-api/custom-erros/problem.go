@@ -0,0 +1,171 @@
+package customerros
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"gorm.io/gorm"
+)
+
+const ProblemContentType = "application/problem+json"
+
+// Problem is RFC 7807 problem details, extended with error code, request id and invalid fields.
+type Problem struct {
+	Type      string       `json:"type"`
+	Title     string       `json:"title"`
+	Status    int          `json:"status"`
+	Detail    string       `json:"detail,omitempty"`
+	Instance  string       `json:"instance,omitempty"`
+	Code      string       `json:"code"`
+	RequestId string       `json:"requestId,omitempty"`
+	Errors    []FieldError `json:"errors,omitempty"`
+}
+
+type problemType struct {
+	status int
+	title  string
+}
+
+var problemTypes = map[string]problemType{
+	CodeInvalidRequest:   {http.StatusBadRequest, "Request body is malformed"},
+	CodeValidationFailed: {http.StatusBadRequest, "Request is invalid"},
+	CodeMissingParameter: {http.StatusBadRequest, "Required parameter is missing"},
+	CodeUnknownCurrency:  {http.StatusBadRequest, "Currency is unknown"},
+	CodeSameCurrency:     {http.StatusBadRequest, "Source and destination currency are the same"},
+	CodeInvalidDate:      {http.StatusBadRequest, "Date is invalid"},
+	CodeInvalidDateRange: {http.StatusBadRequest, "Date range is invalid"},
+	CodeInvalidId:        {http.StatusBadRequest, "Id is invalid"},
+	CodeNotFound:         {http.StatusNotFound, "Resource not found"},
+	CodeDuplicateRate:    {http.StatusConflict, "Exchange rate already exists"},
+	CodeInvertedPair:     {http.StatusConflict, "Pair is quoted the other way round"},
+	CodeUnauthorized:     {http.StatusUnauthorized, "Authentication required"},
+	CodeForbidden:        {http.StatusForbidden, "Permission denied"},
+	CodeRateLimited:      {http.StatusTooManyRequests, "Too many requests"},
+	CodeInternal:         {http.StatusInternalServerError, "Internal server error"},
+}
+
+// NewProblem maps error to problem details. Messages of errors which are not domain errors are not exposed.
+func NewProblem(err error) Problem {
+	code := Code(err)
+	detail := err.Error()
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		code = CodeNotFound
+	} else if code == CodeInternal {
+		detail = ""
+	}
+
+	problemType, isFound := problemTypes[code]
+	if !isFound {
+		problemType = problemTypes[CodeInvalidRequest]
+	}
+
+	problem := Problem{
+		Type:   "urn:exchange-rate-api:problem:" + code,
+		Title:  problemType.title,
+		Status: problemType.status,
+		Detail: detail,
+		Code:   code,
+	}
+
+	var domainError *Error
+	if errors.As(err, &domainError) {
+		problem.Errors = domainError.Fields
+	}
+
+	return problem
+}
+
+// Abort stops handling of the request with problem details of the error.
+func Abort(g *gin.Context, err error, requestId string) {
+	problem := NewProblem(err)
+	if g.Request.URL != nil {
+		problem.Instance = g.Request.URL.Path
+	}
+	problem.RequestId = requestId
+
+	g.Header("Content-Type", ProblemContentType)
+	g.AbortWithStatusJSON(problem.Status, problem)
+}
+
+// NewBindingError converts error of binding request body to validation error listing invalid fields.
+// Field names are taken from json tags when validator is configured with RegisterJsonFieldNames.
+func NewBindingError(err error) *Error {
+	var validationErrors validator.ValidationErrors
+	if errors.As(err, &validationErrors) {
+		fields := make([]FieldError, 0, len(validationErrors))
+		for _, fieldError := range validationErrors {
+			fields = append(fields, FieldError{
+				Field:   fieldPath(fieldError),
+				Code:    fieldError.Tag(),
+				Message: fieldMessage(fieldError),
+			})
+		}
+		return &Error{Code: CodeValidationFailed, Message: "request body has invalid fields", Fields: fields}
+	}
+
+	var typeError *json.UnmarshalTypeError
+	if errors.As(err, &typeError) {
+		return &Error{
+			Code:    CodeValidationFailed,
+			Message: "request body has invalid fields",
+			Fields: []FieldError{{
+				Field:   typeError.Field,
+				Code:    "type",
+				Message: "must be " + typeError.Type.String(),
+			}},
+		}
+	}
+
+	return Newf(CodeInvalidRequest, "request body is malformed: %s", err.Error())
+}
+
+// fieldPath drops the name of the top level struct from the namespace.
+func fieldPath(fieldError validator.FieldError) string {
+	_, path, _ := strings.Cut(fieldError.Namespace(), ".")
+	return path
+}
+
+func fieldMessage(fieldError validator.FieldError) string {
+	switch fieldError.Tag() {
+	case "required":
+		return "is required"
+	case "oneof":
+		return "must be one of " + fieldError.Param()
+	case "min":
+		return boundMessage(fieldError, "at least")
+	case "max":
+		return boundMessage(fieldError, "at most")
+	case "url":
+		return "must be url"
+	default:
+		return fmt.Sprintf("failed %s validation", fieldError.Tag())
+	}
+}
+
+func boundMessage(fieldError validator.FieldError, bound string) string {
+	switch fieldError.Kind() {
+	case reflect.Slice, reflect.Array, reflect.Map:
+		return fmt.Sprintf("must have %s %s items", bound, fieldError.Param())
+	case reflect.String:
+		return fmt.Sprintf("must have %s %s characters", bound, fieldError.Param())
+	default:
+		return fmt.Sprintf("must be %s %s", bound, fieldError.Param())
+	}
+}
+
+// RegisterJsonFieldNames makes validator report fields by json names instead of go names.
+func RegisterJsonFieldNames(validate *validator.Validate) {
+	validate.RegisterTagNameFunc(func(field reflect.StructField) string {
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" {
+			return ""
+		}
+		return name
+	})
+}
@@ -0,0 +1,64 @@
+package customerros
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+type item struct {
+	Currency string `json:"currency" validate:"required"`
+}
+
+type request struct {
+	Items []item `json:"items" validate:"required,min=1,dive"`
+	Mode  string `json:"mode" validate:"oneof=up down"`
+}
+
+func TestNewProblemUsesCodeOfWrappedError(t *testing.T) {
+	problem := NewProblem(fmt.Errorf("item 2: %w", New(CodeUnknownCurrency, "unknown XXX currency")))
+
+	assert.Equal(t, http.StatusBadRequest, problem.Status)
+	assert.Equal(t, CodeUnknownCurrency, problem.Code)
+	assert.Equal(t, "urn:exchange-rate-api:problem:unknown_currency", problem.Type)
+	assert.Equal(t, "item 2: unknown XXX currency", problem.Detail)
+}
+
+func TestNewProblemMapsDuplicateAndNotFound(t *testing.T) {
+	assert.Equal(t, http.StatusConflict, NewProblem(ErrDuplicateKeyViolation).Status)
+	assert.Equal(t, CodeNotFound, NewProblem(gorm.ErrRecordNotFound).Code)
+	assert.Equal(t, http.StatusNotFound, NewProblem(gorm.ErrRecordNotFound).Status)
+}
+
+func TestNewProblemHidesInternalErrors(t *testing.T) {
+	problem := NewProblem(errors.New("connection refused"))
+
+	assert.Equal(t, http.StatusInternalServerError, problem.Status)
+	assert.Equal(t, CodeInternal, problem.Code)
+	assert.Empty(t, problem.Detail)
+}
+
+func TestNewBindingErrorListsInvalidFields(t *testing.T) {
+	validate := validator.New()
+	RegisterJsonFieldNames(validate)
+
+	err := NewBindingError(validate.Struct(request{Items: []item{{Currency: "CHF"}, {}}, Mode: "sideways"}))
+
+	assert.Equal(t, CodeValidationFailed, err.Code)
+	assert.Equal(t, []FieldError{
+		{Field: "items[1].currency", Code: "required", Message: "is required"},
+		{Field: "mode", Code: "oneof", Message: "must be one of up down"},
+	}, err.Fields)
+	assert.Equal(t, err.Fields, NewProblem(err).Errors)
+}
+
+func TestNewBindingErrorReportsMalformedBody(t *testing.T) {
+	err := NewBindingError(errors.New("unexpected EOF"))
+
+	assert.Equal(t, CodeInvalidRequest, err.Code)
+}
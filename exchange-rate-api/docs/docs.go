@@ -21,6 +21,334 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
+        "/admin/api-keys": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Returns all issued api keys, including revoked ones. Keys themselves are never returned",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "GetApiKeys",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.ApiKey"
+                            }
+                        }
+                    },
+                    "default": {
+                        "description": "RFC 7807 problem details",
+                        "schema": {
+                            "$ref": "#/definitions/customerros.Problem"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Issues api key with given scopes: read, write or admin. Key is returned only in this response, only its hash is stored",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "IssueApiKey",
+                "parameters": [
+                    {
+                        "description": "Name and scopes of new key, other fields are ignored",
+                        "name": "apiKey",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.ApiKey"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.ApiKey"
+                        }
+                    },
+                    "default": {
+                        "description": "RFC 7807 problem details",
+                        "schema": {
+                            "$ref": "#/definitions/customerros.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/api-keys/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Revokes api key, requests with it are rejected immediately",
+                "tags": [
+                    "admin"
+                ],
+                "summary": "RevokeApiKey",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Api key id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": ""
+                    },
+                    "404": {
+                        "description": ""
+                    },
+                    "default": {
+                        "description": "RFC 7807 problem details",
+                        "schema": {
+                            "$ref": "#/definitions/customerros.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/ingestion/runs": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Returns outcomes of the most recent ingestion runs, newest first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "GetIngestionRuns",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Maximum number of runs, 20 by default",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.IngestionRun"
+                            }
+                        }
+                    },
+                    "default": {
+                        "description": "RFC 7807 problem details",
+                        "schema": {
+                            "$ref": "#/definitions/customerros.Problem"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Fetches rates of configured pairs from provider immediately and returns outcome of the run.\nWaits for scheduled run when it is in progress",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "RunIngestion",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.IngestionRun"
+                        }
+                    },
+                    "default": {
+                        "description": "RFC 7807 problem details",
+                        "schema": {
+                            "$ref": "#/definitions/customerros.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/alerts": {
+            "get": {
+                "description": "Returns all alert rules, secrets are not included",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "alerts"
+                ],
+                "summary": "GetAlertRules",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.AlertRule"
+                            }
+                        }
+                    },
+                    "default": {
+                        "description": "RFC 7807 problem details",
+                        "schema": {
+                            "$ref": "#/definitions/customerros.Problem"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Registers alert rule. Webhook is sent to callbackUrl when exchange rate inserted for the currencies crosses threshold,\nor changes by more than changePercent compared to the previous rate. Exactly one of threshold and changePercent must be set.\nWebhook body is signed with HMAC-SHA256 using returned secret, hex encoded signature is sent in X-Signature-256 header prefixed with sha256=.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "alerts"
+                ],
+                "summary": "InsertAlertRule",
+                "parameters": [
+                    {
+                        "description": "New alert rule, id, secret and createdAt are ignored",
+                        "name": "alertRule",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.AlertRule"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.AlertRule"
+                        }
+                    },
+                    "default": {
+                        "description": "RFC 7807 problem details",
+                        "schema": {
+                            "$ref": "#/definitions/customerros.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/alerts/{id}": {
+            "delete": {
+                "description": "Deletes alert rule together with its delivery log",
+                "tags": [
+                    "alerts"
+                ],
+                "summary": "DeleteAlertRule",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Alert rule id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": ""
+                    },
+                    "404": {
+                        "description": ""
+                    },
+                    "default": {
+                        "description": "RFC 7807 problem details",
+                        "schema": {
+                            "$ref": "#/definitions/customerros.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/alerts/{id}/deliveries": {
+            "get": {
+                "description": "Returns delivery log of alert rule webhooks, most recent attempts first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "alerts"
+                ],
+                "summary": "GetWebhookDeliveries",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Alert rule id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.WebhookDelivery"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": ""
+                    },
+                    "default": {
+                        "description": "RFC 7807 problem details",
+                        "schema": {
+                            "$ref": "#/definitions/customerros.Problem"
+                        }
+                    }
+                }
+            }
+        },
         "/check": {
             "get": {
                 "description": "basic healthcheck",
@@ -44,6 +372,46 @@ const docTemplate = `{
                 }
             }
         },
+        "/convert/batch": {
+            "post": {
+                "description": "Converts list of amounts, each one with rate from its own date. When there is no rate for the date, e.g. on holidays,\nthe most recent rate from previous 7 days is used. Currencies without direct rate are converted through USD.\nItems which can't be converted have error set and are not included in totals.\nConverted amounts are rounded to ISO 4217 minor units of target currency, e.g. whole units for JPY and KRW, using requested rounding mode.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "convert"
+                ],
+                "summary": "ConvertBatch",
+                "parameters": [
+                    {
+                        "description": "Up to 1000 items to convert",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.BatchConversionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.BatchConversionResponse"
+                        }
+                    },
+                    "default": {
+                        "description": "RFC 7807 problem details",
+                        "schema": {
+                            "$ref": "#/definitions/customerros.Problem"
+                        }
+                    }
+                }
+            }
+        },
         "/currencies": {
             "get": {
                 "description": "Returns list of all currencies",
@@ -66,13 +434,19 @@ const docTemplate = `{
                                 "type": "string"
                             }
                         }
+                    },
+                    "default": {
+                        "description": "RFC 7807 problem details",
+                        "schema": {
+                            "$ref": "#/definitions/customerros.Problem"
+                        }
                     }
                 }
             }
         },
         "/exchange-rate": {
             "post": {
-                "description": "Inserts new exchange rate",
+                "description": "Inserts new exchange rate, rate is amount of destination (quote) currency for one unit of source (base) currency.\nRate of pair which is stored the other way round is rejected with inverted_pair code, e.g. CHF/USD when USD/CHF is stored",
                 "consumes": [
                     "application/json"
                 ],
@@ -100,6 +474,12 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/models.ExchangeRate"
                         }
+                    },
+                    "default": {
+                        "description": "RFC 7807 problem details",
+                        "schema": {
+                            "$ref": "#/definitions/customerros.Problem"
+                        }
                     }
                 }
             }
@@ -135,13 +515,19 @@ const docTemplate = `{
                                 "$ref": "#/definitions/models.ExchangeRate"
                             }
                         }
+                    },
+                    "default": {
+                        "description": "RFC 7807 problem details",
+                        "schema": {
+                            "$ref": "#/definitions/customerros.Problem"
+                        }
                     }
                 }
             }
         },
         "/exchange-rate/last": {
             "get": {
-                "description": "Returns most recent exchange rate  which is not null in database for source - destinaion currencies",
+                "description": "Returns most recent exchange rate  which is not null in database for source - destinaion currencies.\nRate is amount of destination (quote) currency for one unit of source (base) currency.\nRate of pair stored the other way round is inverted, e.g. CHF/USD of stored USD/CHF",
                 "consumes": [
                     "application/json"
                 ],
@@ -155,13 +541,13 @@ const docTemplate = `{
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "destination currency, default is USD",
+                        "description": "destination (quote) currency, default is USD",
                         "name": "destination",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "source, currency",
+                        "description": "source (base) currency",
                         "name": "source",
                         "in": "query",
                         "required": true
@@ -176,13 +562,19 @@ const docTemplate = `{
                     },
                     "404": {
                         "description": ""
+                    },
+                    "default": {
+                        "description": "RFC 7807 problem details",
+                        "schema": {
+                            "$ref": "#/definitions/customerros.Problem"
+                        }
                     }
                 }
             }
         },
         "/exchange-rate/range": {
             "get": {
-                "description": "Returns exchange rates for currencies in the time period",
+                "description": "Returns exchange rates for currencies in the time period.\nRates of pair stored the other way round are inverted, e.g. CHF/USD of stored USD/CHF",
                 "consumes": [
                     "application/json"
                 ],
@@ -196,13 +588,13 @@ const docTemplate = `{
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "destination currency, default is USD",
+                        "description": "destination (quote) currency, default is USD",
                         "name": "destination",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "source currency",
+                        "description": "source (base) currency",
                         "name": "source",
                         "in": "query",
                         "required": true
@@ -231,12 +623,309 @@ const docTemplate = `{
                                 "$ref": "#/definitions/models.ExchangeRate"
                             }
                         }
+                    },
+                    "default": {
+                        "description": "RFC 7807 problem details",
+                        "schema": {
+                            "$ref": "#/definitions/customerros.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/exchange-rate/subscribe": {
+            "get": {
+                "description": "Streams newly inserted exchange rates as server-sent events named exchange-rate, with models.ExchangeRate json as data.\nEvents named heartbeat are sent periodically to keep connection open.",
+                "produces": [
+                    "text/event-stream"
+                ],
+                "tags": [
+                    "exchange-rate"
+                ],
+                "summary": "Subscribe",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Comma separated currency pairs formatted as SOURCE-DESTINATION, e.g. USD-CHF,USD-JPY. All pairs are streamed when missing",
+                        "name": "pairs",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ExchangeRate"
+                        }
+                    },
+                    "default": {
+                        "description": "RFC 7807 problem details",
+                        "schema": {
+                            "$ref": "#/definitions/customerros.Problem"
+                        }
                     }
                 }
             }
         }
     },
     "definitions": {
+        "customerros.FieldError": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string"
+                },
+                "field": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "customerros.Problem": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string"
+                },
+                "detail": {
+                    "type": "string"
+                },
+                "errors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/customerros.FieldError"
+                    }
+                },
+                "instance": {
+                    "type": "string"
+                },
+                "requestId": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "integer"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.AlertRule": {
+            "type": "object",
+            "required": [
+                "callbackUrl",
+                "destination",
+                "source"
+            ],
+            "properties": {
+                "callbackUrl": {
+                    "type": "string",
+                    "example": "https://example.com/hooks/rates"
+                },
+                "changePercent": {
+                    "type": "number",
+                    "example": 2
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "destination": {
+                    "type": "string",
+                    "example": "USD"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "secret": {
+                    "description": "Secret used to sign webhooks, returned only when rule is created.",
+                    "type": "string"
+                },
+                "source": {
+                    "type": "string",
+                    "example": "CHF"
+                },
+                "threshold": {
+                    "type": "number",
+                    "example": 1
+                }
+            }
+        },
+        "models.ApiKey": {
+            "type": "object",
+            "required": [
+                "name",
+                "scopes"
+            ],
+            "properties": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "key": {
+                    "description": "Key is returned only when key is issued.",
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "rates importer"
+                },
+                "prefix": {
+                    "description": "Prefix identifies key in listings without revealing it.",
+                    "type": "string"
+                },
+                "revokedAt": {
+                    "type": "string"
+                },
+                "scopes": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "read",
+                        "write"
+                    ]
+                }
+            }
+        },
+        "models.BatchConversionRequest": {
+            "type": "object",
+            "required": [
+                "items"
+            ],
+            "properties": {
+                "includeUnrounded": {
+                    "description": "Returns also amounts before rounding",
+                    "type": "boolean"
+                },
+                "items": {
+                    "type": "array",
+                    "maxItems": 1000,
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/models.ConversionItem"
+                    }
+                },
+                "rounding": {
+                    "description": "Converted amounts are rounded to minor units of target currency, default is half-even",
+                    "type": "string",
+                    "enum": [
+                        "half-even",
+                        "half-up",
+                        "up",
+                        "down",
+                        "ceiling",
+                        "floor"
+                    ],
+                    "example": "half-even"
+                }
+            }
+        },
+        "models.BatchConversionResponse": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ConvertedItem"
+                    }
+                },
+                "totals": {
+                    "description": "Sum of converted amounts per target currency, items which couldn't be converted are skipped\nSum of rounded amounts, so totals match the items",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "number"
+                    }
+                },
+                "unroundedTotals": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "number"
+                    }
+                }
+            }
+        },
+        "models.ConversionItem": {
+            "type": "object",
+            "required": [
+                "currency",
+                "date"
+            ],
+            "properties": {
+                "amount": {
+                    "type": "number",
+                    "example": 125.5
+                },
+                "currency": {
+                    "type": "string",
+                    "example": "CHF"
+                },
+                "date": {
+                    "description": "Formatted as YYYY-MM-DD",
+                    "type": "string",
+                    "example": "2017-05-01"
+                },
+                "target": {
+                    "description": "Default is USD",
+                    "type": "string",
+                    "example": "USD"
+                }
+            }
+        },
+        "models.ConvertedItem": {
+            "type": "object",
+            "required": [
+                "currency",
+                "date"
+            ],
+            "properties": {
+                "amount": {
+                    "type": "number",
+                    "example": 125.5
+                },
+                "convertedAmount": {
+                    "description": "Rounded to minor units of target currency",
+                    "type": "number"
+                },
+                "currency": {
+                    "type": "string",
+                    "example": "CHF"
+                },
+                "date": {
+                    "description": "Formatted as YYYY-MM-DD",
+                    "type": "string",
+                    "example": "2017-05-01"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "rate": {
+                    "description": "Amount of target currency for one unit of currency",
+                    "type": "number"
+                },
+                "rateDate": {
+                    "description": "Date of the rate used, can be before date of the item when there was no rate for that day",
+                    "type": "string",
+                    "example": "2017-05-01"
+                },
+                "target": {
+                    "description": "Default is USD",
+                    "type": "string",
+                    "example": "USD"
+                },
+                "unroundedAmount": {
+                    "type": "number"
+                }
+            }
+        },
         "models.ExchangeRate": {
             "type": "object",
             "required": [
@@ -250,18 +939,94 @@ const docTemplate = `{
                     "example": "2022-05-01T00:00:00.00Z"
                 },
                 "destination": {
+                    "description": "Quote currency, rate is amount of it for one unit of source currency",
                     "type": "string",
                     "example": "CHF"
                 },
                 "rate": {
+                    "description": "Amount of destination currency for one unit of source currency, positive",
                     "type": "number",
                     "example": 1.0456
                 },
                 "source": {
+                    "description": "Base currency, one unit of it is worth rate of destination currency",
                     "type": "string",
                     "example": "USD"
                 }
             }
+        },
+        "models.IngestionRun": {
+            "type": "object",
+            "properties": {
+                "errorMessage": {
+                    "description": "ErrorMessage is empty when run succeeded.",
+                    "type": "string"
+                },
+                "fetched": {
+                    "description": "Fetched counts rates of configured pairs returned by provider.",
+                    "type": "integer"
+                },
+                "finishedAt": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "inserted": {
+                    "type": "integer"
+                },
+                "provider": {
+                    "type": "string",
+                    "example": "http"
+                },
+                "rejected": {
+                    "description": "Rejected rates failed validation, e.g. with unknown currency.",
+                    "type": "integer"
+                },
+                "skipped": {
+                    "description": "Skipped rates were already stored.",
+                    "type": "integer"
+                },
+                "startedAt": {
+                    "type": "string"
+                },
+                "updated": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.WebhookDelivery": {
+            "type": "object",
+            "properties": {
+                "alertRuleId": {
+                    "type": "integer"
+                },
+                "attempt": {
+                    "type": "integer"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "payload": {
+                    "type": "string"
+                },
+                "statusCode": {
+                    "type": "integer"
+                }
+            }
+        }
+    },
+    "securityDefinitions": {
+        "ApiKeyAuth": {
+            "type": "apiKey",
+            "name": "X-API-Key",
+            "in": "header"
         }
     }
 }`
@@ -273,7 +1038,7 @@ var SwaggerInfo = &swag.Spec{
 	BasePath:         "/",
 	Schemes:          []string{"http"},
 	Title:            "Rate Exchange API",
-	Description:      "Provides basic functionality for checking currency exchange rate.",
+	Description:      "Provides basic functionality for checking currency exchange rate.\nRates are quoted as base/quote: one unit of source currency is rate units of destination currency, e.g. USD/CHF 0.9774.",
 	InfoInstanceName: "swagger",
 	SwaggerTemplate:  docTemplate,
 }
@@ -0,0 +1,150 @@
+// Package ecb reads euro foreign exchange reference rates of the European Central Bank in eurofxref xml format,
+// e.g. https://www.ecb.europa.eu/stats/eurofxref/eurofxref-daily.xml or historical eurofxref-hist.xml.
+//
+// ECB quotes amount of currency for one euro, so rates are mapped to EUR/currency pairs with EUR as base currency.
+package ecb
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/kolan92/exchange-rate-api/config"
+	"github.com/kolan92/exchange-rate-api/models"
+	"github.com/kolan92/exchange-rate-api/validators"
+	"github.com/shopspring/decimal"
+)
+
+// Base is base currency of all ECB quotes.
+const Base = "EUR"
+
+// envelope matches elements by local name, so namespaces of gesmes envelope don't have to be declared.
+type envelope struct {
+	Days []struct {
+		Time   string `xml:"time,attr"`
+		Quotes []struct {
+			Currency string `xml:"currency,attr"`
+			Rate     string `xml:"rate,attr"`
+		} `xml:"Cube"`
+	} `xml:"Cube>Cube"`
+}
+
+// Parse reads daily or historical eurofxref xml, rates are returned in the order of the file.
+func Parse(reader io.Reader) ([]models.ExchangeRate, error) {
+	document := envelope{}
+	if err := xml.NewDecoder(reader).Decode(&document); err != nil {
+		return nil, fmt.Errorf("can't decode eurofxref xml: %w", err)
+	}
+	if len(document.Days) == 0 {
+		return nil, fmt.Errorf("eurofxref xml has no rates")
+	}
+
+	exchangeRates := []models.ExchangeRate{}
+	for _, day := range document.Days {
+		date, err := time.Parse(validators.DateLayout, day.Time)
+		if err != nil {
+			return nil, fmt.Errorf("time %s is not YYYY-MM-DD", day.Time)
+		}
+
+		for _, quote := range day.Quotes {
+			if !isCurrencyCode(quote.Currency) {
+				return nil, fmt.Errorf("%s: currency %s is not ISO 4217 code", day.Time, quote.Currency)
+			}
+
+			rate, err := decimal.NewFromString(quote.Rate)
+			if err != nil || !rate.IsPositive() {
+				return nil, fmt.Errorf("%s: rate %s of %s is not a positive decimal number", day.Time, quote.Rate, quote.Currency)
+			}
+
+			exchangeRates = append(exchangeRates, models.ExchangeRate{Source: Base, Destination: quote.Currency, Date: date, Rate: &rate})
+		}
+	}
+	return exchangeRates, nil
+}
+
+// Read parses eurofxref xml from http or https url, or from file.
+func Read(ctx context.Context, source string, client *http.Client) ([]models.ExchangeRate, error) {
+	if !strings.HasPrefix(source, "http://") && !strings.HasPrefix(source, "https://") {
+		file, err := os.Open(source)
+		if err != nil {
+			return nil, err
+		}
+		defer file.Close()
+
+		exchangeRates, err := Parse(file)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", source, err)
+		}
+		return exchangeRates, nil
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, source, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s responded with status %d", source, response.StatusCode)
+	}
+
+	exchangeRates, err := Parse(response.Body)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", source, err)
+	}
+	return exchangeRates, nil
+}
+
+// Codes returns currencies of rates including EUR, in the order of their first occurrence.
+func Codes(exchangeRates []models.ExchangeRate) []string {
+	codes := []string{Base}
+	isAdded := map[string]bool{Base: true}
+	for _, exchangeRate := range exchangeRates {
+		if !isAdded[exchangeRate.Destination] {
+			isAdded[exchangeRate.Destination] = true
+			codes = append(codes, exchangeRate.Destination)
+		}
+	}
+	return codes
+}
+
+func isCurrencyCode(code string) bool {
+	if len(code) != 3 {
+		return false
+	}
+	for _, letter := range code {
+		if letter < 'A' || letter > 'Z' {
+			return false
+		}
+	}
+	return true
+}
+
+// Provider fetches rates for ingestion, from url of daily xml or from file. All quotes are returned,
+// rates of pairs which are not configured are ignored by ingestion.
+type Provider struct {
+	source string
+	client *http.Client
+}
+
+func NewProvider(source string, client *http.Client) *Provider {
+	return &Provider{source, client}
+}
+
+func (p *Provider) Name() string {
+	return config.ProviderEcb
+}
+
+func (p *Provider) FetchRates(ctx context.Context, pairs []config.CurrencyPair) ([]models.ExchangeRate, error) {
+	return Read(ctx, p.source, p.client)
+}
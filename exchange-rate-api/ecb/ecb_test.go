@@ -0,0 +1,90 @@
+package ecb
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const historicalXml = `<?xml version="1.0" encoding="UTF-8"?>
+<gesmes:Envelope xmlns:gesmes="http://www.gesmes.org/xml/2002-08-01" xmlns="http://www.ecb.int/vocabulary/2002-08-01/eurofxref">
+	<gesmes:subject>Reference rates</gesmes:subject>
+	<gesmes:Sender>
+		<gesmes:name>European Central Bank</gesmes:name>
+	</gesmes:Sender>
+	<Cube>
+		<Cube time="2022-05-03">
+			<Cube currency="USD" rate="1.0524"/>
+			<Cube currency="JPY" rate="137.01"/>
+		</Cube>
+		<Cube time="2022-05-02">
+			<Cube currency="USD" rate="1.0526"/>
+			<Cube currency="CHF" rate="1.0251"/>
+		</Cube>
+	</Cube>
+</gesmes:Envelope>`
+
+func date(day int) time.Time {
+	return time.Date(2022, 05, day, 0, 00, 00, 0, time.UTC)
+}
+
+func TestParseMapsEuroQuotesToCurrencyPerEuro(t *testing.T) {
+	exchangeRates, err := Parse(strings.NewReader(historicalXml))
+
+	assert.NoError(t, err)
+	assert.Len(t, exchangeRates, 4)
+	assert.Equal(t, "EUR", exchangeRates[0].Source)
+	assert.Equal(t, "USD", exchangeRates[0].Destination)
+	assert.Equal(t, date(3), exchangeRates[0].Date)
+	assert.Equal(t, "1.0524", exchangeRates[0].Rate.String())
+	assert.Equal(t, "CHF", exchangeRates[3].Destination)
+	assert.Equal(t, date(2), exchangeRates[3].Date)
+	assert.Equal(t, []string{"EUR", "USD", "JPY", "CHF"}, Codes(exchangeRates))
+}
+
+func TestParseRejectsInvalidDocuments(t *testing.T) {
+	for name, content := range map[string]string{
+		"not xml":  "DATE,CHFUSD",
+		"no rates": `<Envelope><Cube></Cube></Envelope>`,
+		"date":     `<Envelope><Cube><Cube time="03.05.2022"><Cube currency="USD" rate="1.05"/></Cube></Cube></Envelope>`,
+		"currency": `<Envelope><Cube><Cube time="2022-05-03"><Cube currency="usd" rate="1.05"/></Cube></Cube></Envelope>`,
+		"rate":     `<Envelope><Cube><Cube time="2022-05-03"><Cube currency="USD" rate="N/A"/></Cube></Cube></Envelope>`,
+	} {
+		_, err := Parse(strings.NewReader(content))
+
+		assert.Error(t, err, name)
+	}
+}
+
+func TestReadsFileAndUrl(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "eurofxref-hist.xml")
+	os.WriteFile(path, []byte(historicalXml), 0o600)
+	stub := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(historicalXml))
+	}))
+	defer stub.Close()
+
+	fileRates, fileErr := Read(context.Background(), path, stub.Client())
+	urlRates, urlErr := NewProvider(stub.URL, stub.Client()).FetchRates(context.Background(), nil)
+
+	assert.NoError(t, fileErr)
+	assert.NoError(t, urlErr)
+	assert.Len(t, fileRates, 4)
+	assert.Equal(t, fileRates, urlRates)
+}
+
+func TestReadFailsOnErrorStatus(t *testing.T) {
+	stub := httptest.NewServer(http.NotFoundHandler())
+	defer stub.Close()
+
+	_, err := Read(context.Background(), stub.URL, stub.Client())
+
+	assert.ErrorContains(t, err, "responded with status 404")
+}
@@ -0,0 +1,105 @@
+package events
+
+import (
+	"sync"
+
+	"github.com/kolan92/exchange-rate-api/models"
+	"go.uber.org/zap"
+)
+
+// subscriptionBufferSize limits how many not yet consumed exchange rates are kept per subscriber.
+// Exchange rates published to a full subscription are dropped, so slow subscriber can't block inserts.
+const subscriptionBufferSize = 64
+
+type Pair struct {
+	Source      string
+	Destination string
+}
+
+type Subscription struct {
+	C      <-chan models.ExchangeRate
+	c      chan models.ExchangeRate
+	pairs  map[Pair]bool
+	broker *Broker
+}
+
+// Broker is in-process pub/sub of newly inserted exchange rates.
+// It allows serving many subscribers without polling database.
+type Broker struct {
+	mu            sync.RWMutex
+	subscriptions map[*Subscription]bool
+}
+
+func NewBroker() *Broker {
+	return &Broker{subscriptions: make(map[*Subscription]bool)}
+}
+
+// Subscribe returns subscription receiving exchange rates for given currency pairs. Rates of pairs subscribed
+// the other way round are inverted, e.g. CHF/USD subscription receives inverted USD/CHF rates.
+// Subscription without pairs receives exchange rates of all currencies as they are stored.
+func (b *Broker) Subscribe(pairs ...Pair) *Subscription {
+	c := make(chan models.ExchangeRate, subscriptionBufferSize)
+	subscription := &Subscription{
+		C:      c,
+		c:      c,
+		pairs:  make(map[Pair]bool),
+		broker: b,
+	}
+	for _, pair := range pairs {
+		subscription.pairs[pair] = true
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.subscriptions[subscription] = true
+
+	return subscription
+}
+
+// Unsubscribe stops delivery and closes subscription channel.
+func (s *Subscription) Unsubscribe() {
+	s.broker.mu.Lock()
+	defer s.broker.mu.Unlock()
+
+	if s.broker.subscriptions[s] {
+		delete(s.broker.subscriptions, s)
+		close(s.c)
+	}
+}
+
+// Close ends all subscriptions, so streams can finish before server shutdown.
+func (b *Broker) Close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for subscription := range b.subscriptions {
+		delete(b.subscriptions, subscription)
+		close(subscription.c)
+	}
+}
+
+func (b *Broker) Publish(exchangeRate models.ExchangeRate) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	pair := Pair{exchangeRate.Source, exchangeRate.Destination}
+	invertedPair := Pair{exchangeRate.Destination, exchangeRate.Source}
+	for subscription := range b.subscriptions {
+		event := exchangeRate
+		switch {
+		case len(subscription.pairs) == 0 || subscription.pairs[pair]:
+		case subscription.pairs[invertedPair]:
+			event = exchangeRate.Inverted()
+		default:
+			continue
+		}
+
+		select {
+		case subscription.c <- event:
+		default:
+			zap.L().Warn("Dropping exchange rate event for slow subscriber",
+				zap.String("source", exchangeRate.Source),
+				zap.String("destination", exchangeRate.Destination))
+		}
+	}
+}
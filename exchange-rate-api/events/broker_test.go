@@ -0,0 +1,112 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	customerros "github.com/kolan92/exchange-rate-api/custom-erros"
+	"github.com/kolan92/exchange-rate-api/models"
+	testhelpers "github.com/kolan92/exchange-rate-api/testHelpers"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+var chfUsd = models.ExchangeRate{
+	Source:      "CHF",
+	Destination: "USD",
+	Date:        time.Date(2022, 04, 30, 0, 00, 00, 0, time.UTC),
+}
+
+func TestSubscriptionReceivesOnlySubscribedPairs(t *testing.T) {
+	broker := NewBroker()
+	subscription := broker.Subscribe(Pair{"JPY", "USD"})
+
+	broker.Publish(chfUsd)
+	jpyUsd := models.ExchangeRate{Source: "JPY", Destination: "USD"}
+	broker.Publish(jpyUsd)
+
+	assert.Equal(t, jpyUsd, <-subscription.C)
+	assert.Empty(t, subscription.C)
+}
+
+func TestSubscriptionOfInvertedPairReceivesInvertedRate(t *testing.T) {
+	broker := NewBroker()
+	subscription := broker.Subscribe(Pair{"CHF", "USD"})
+	rate := decimal.RequireFromString("0.9774")
+
+	broker.Publish(models.ExchangeRate{Source: "USD", Destination: "CHF", Date: chfUsd.Date, Rate: &rate})
+
+	exchangeRate := <-subscription.C
+	assert.Equal(t, "CHF", exchangeRate.Source)
+	assert.Equal(t, "USD", exchangeRate.Destination)
+	assert.Equal(t, "1.023123", exchangeRate.Rate.String())
+}
+
+func TestSubscriptionWithoutPairsReceivesAll(t *testing.T) {
+	broker := NewBroker()
+	first := broker.Subscribe()
+	second := broker.Subscribe()
+
+	broker.Publish(chfUsd)
+
+	assert.Equal(t, chfUsd, <-first.C)
+	assert.Equal(t, chfUsd, <-second.C)
+}
+
+func TestUnsubscribeClosesChannel(t *testing.T) {
+	broker := NewBroker()
+	subscription := broker.Subscribe()
+
+	subscription.Unsubscribe()
+	subscription.Unsubscribe()
+	broker.Publish(chfUsd)
+
+	_, isOpen := <-subscription.C
+	assert.False(t, isOpen)
+}
+
+func TestPublishDoesNotBlockOnSlowSubscriber(t *testing.T) {
+	broker := NewBroker()
+	subscription := broker.Subscribe()
+
+	for i := 0; i < subscriptionBufferSize+1; i++ {
+		broker.Publish(chfUsd)
+	}
+
+	assert.Len(t, subscription.C, subscriptionBufferSize)
+}
+
+func TestPublishingRepositoryPublishesOnlyStoredRates(t *testing.T) {
+	broker := NewBroker()
+	subscription := broker.Subscribe()
+	mockRepository := testhelpers.NewMockRepository()
+	repo := NewPublishingRepository(mockRepository, broker)
+
+	assert.NoError(t, repo.InsertExchangeRate(context.Background(), &chfUsd))
+	assert.Equal(t, chfUsd, <-subscription.C)
+
+	mockRepository.InsertExchangeRateError = customerros.ErrDuplicateKeyViolation
+	err := repo.InsertExchangeRate(context.Background(), &chfUsd)
+	assert.True(t, errors.Is(err, customerros.ErrDuplicateKeyViolation))
+	assert.Empty(t, subscription.C)
+}
+
+func TestPublishingRepositoryPublishesUpdatedAndImportedRates(t *testing.T) {
+	broker := NewBroker()
+	subscription := broker.Subscribe()
+	mockRepository := testhelpers.NewMockRepository()
+	repo := NewPublishingRepository(mockRepository, broker)
+	skippedRate := chfUsd
+	skippedRate.Date = chfUsd.Date.AddDate(0, 0, -1)
+
+	assert.NoError(t, repo.UpdateExchangeRate(context.Background(), &chfUsd))
+	assert.Equal(t, chfUsd, <-subscription.C)
+
+	mockRepository.ImportSummary = models.ImportSummary{Inserted: 1, Skipped: 1, Stored: []int{1}}
+	_, err := repo.ImportExchangeRates(context.Background(), []models.ExchangeRate{skippedRate, chfUsd}, false)
+	assert.NoError(t, err)
+	assert.Equal(t, chfUsd, <-subscription.C)
+	assert.Empty(t, subscription.C)
+}
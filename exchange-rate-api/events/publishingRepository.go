@@ -0,0 +1,50 @@
+package events
+
+import (
+	"context"
+
+	"github.com/kolan92/exchange-rate-api/models"
+	"github.com/kolan92/exchange-rate-api/repositories"
+)
+
+// PublishingRepository publishes every exchange rate stored through the wrapped repository,
+// regardless of transport used to insert it.
+type PublishingRepository struct {
+	repositories.CurrenciesRepository
+	broker *Broker
+}
+
+func NewPublishingRepository(repo repositories.CurrenciesRepository, broker *Broker) repositories.CurrenciesRepository {
+	return &PublishingRepository{repo, broker}
+}
+
+func (r *PublishingRepository) InsertExchangeRate(ctx context.Context, exchangeRate *models.ExchangeRate) error {
+	if err := r.CurrenciesRepository.InsertExchangeRate(ctx, exchangeRate); err != nil {
+		return err
+	}
+
+	r.broker.Publish(*exchangeRate)
+	return nil
+}
+
+func (r *PublishingRepository) UpdateExchangeRate(ctx context.Context, exchangeRate *models.ExchangeRate) error {
+	if err := r.CurrenciesRepository.UpdateExchangeRate(ctx, exchangeRate); err != nil {
+		return err
+	}
+
+	r.broker.Publish(*exchangeRate)
+	return nil
+}
+
+// ImportExchangeRates publishes inserted and updated rates once the import is committed, skipped ones are not published.
+func (r *PublishingRepository) ImportExchangeRates(ctx context.Context, exchangeRates []models.ExchangeRate, replace bool) (models.ImportSummary, error) {
+	summary, err := r.CurrenciesRepository.ImportExchangeRates(ctx, exchangeRates, replace)
+	if err != nil {
+		return summary, err
+	}
+
+	for _, i := range summary.Stored {
+		r.broker.Publish(exchangeRates[i])
+	}
+	return summary, nil
+}
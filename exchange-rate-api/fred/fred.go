@@ -0,0 +1,147 @@
+// Package fred reads exchange rate series downloaded from FRED, e.g. DEXSZUS.csv with DATE,DEXSZUS header.
+// Series ids don't tell the currency pair nor the direction of the quote, so every series is mapped to pair
+// and quoting convention in a yaml file, see data/fred-series.yaml.
+package fred
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/kolan92/exchange-rate-api/models"
+	"github.com/kolan92/exchange-rate-api/validators"
+	"github.com/shopspring/decimal"
+	"gopkg.in/yaml.v3"
+)
+
+// Quoting conventions of FRED series, all of them quote against US dollar.
+const (
+	// UnitsPerUsd series quote amount of currency for one dollar, e.g. DEXSZUS is Swiss francs to one dollar.
+	UnitsPerUsd = "units-per-usd"
+	// UsdPerUnit series quote dollars for one unit of currency, e.g. DEXUSEU is dollars to one euro.
+	UsdPerUnit = "usd-per-unit"
+)
+
+const usd = "USD"
+
+// Series maps FRED series to stored pair. Rate of stored pair is amount of destination (quote) currency for one unit
+// of source (base) currency. Series value is inverted when pair is quoted the other way round.
+type Series struct {
+	Source      string `yaml:"source"`
+	Destination string `yaml:"destination"`
+	Convention  string `yaml:"convention"`
+}
+
+// Mapping maps series ids to pairs.
+type Mapping map[string]Series
+
+// LoadMapping reads yaml file with series ids as keys, e.g.
+//
+//	DEXSZUS: {source: USD, destination: CHF, convention: units-per-usd}
+func LoadMapping(path string) (Mapping, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	mapping := Mapping{}
+	if err := yaml.Unmarshal(content, &mapping); err != nil {
+		return nil, fmt.Errorf("mapping %s: %w", path, err)
+	}
+	if err := mapping.validate(); err != nil {
+		return nil, fmt.Errorf("mapping %s: %w", path, err)
+	}
+	return mapping, nil
+}
+
+func (m Mapping) validate() error {
+	seriesIds := make([]string, 0, len(m))
+	for seriesId := range m {
+		seriesIds = append(seriesIds, seriesId)
+	}
+	sort.Strings(seriesIds)
+
+	for _, seriesId := range seriesIds {
+		series := m[seriesId]
+		if len(series.Source) != 3 || len(series.Destination) != 3 || series.Source == series.Destination {
+			return fmt.Errorf("series %s must have different source and destination currency codes", seriesId)
+		}
+		if series.Source != usd && series.Destination != usd {
+			return fmt.Errorf("series %s must have USD source or destination", seriesId)
+		}
+		if series.Convention != UnitsPerUsd && series.Convention != UsdPerUnit {
+			return fmt.Errorf("series %s convention must be %s or %s", seriesId, UnitsPerUsd, UsdPerUnit)
+		}
+	}
+	return nil
+}
+
+// ReadFile reads FRED csv file with DATE,<SERIES> header. Empty values and dots are missing rates.
+func (m Mapping) ReadFile(path string) ([]models.ExchangeRate, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	exchangeRates, err := m.read(file)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return exchangeRates, nil
+}
+
+func (m Mapping) read(reader io.Reader) ([]models.ExchangeRate, error) {
+	csvReader := csv.NewReader(reader)
+
+	header, err := csvReader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("can't read header: %w", err)
+	}
+	// FRED downloads name the first column DATE or observation_date, values column is the series id
+	if len(header) != 2 || (!strings.EqualFold(header[0], "date") && !strings.EqualFold(header[0], "observation_date")) {
+		return nil, fmt.Errorf("header %s must be DATE,<SERIES>", strings.Join(header, ","))
+	}
+
+	seriesId := strings.ToUpper(strings.TrimSpace(header[1]))
+	series, isFound := m[seriesId]
+	if !isFound {
+		return nil, fmt.Errorf("series %s is not mapped to currency pair", seriesId)
+	}
+	isInverted := (series.Convention == UnitsPerUsd) != (series.Source == usd)
+
+	exchangeRates := []models.ExchangeRate{}
+	for {
+		record, err := csvReader.Read()
+		if err == io.EOF {
+			return exchangeRates, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		line, _ := csvReader.FieldPos(0)
+		date, err := time.Parse(validators.DateLayout, record[0])
+		if err != nil {
+			return nil, fmt.Errorf("line %d: date %s is not YYYY-MM-DD", line, record[0])
+		}
+
+		exchangeRate := models.ExchangeRate{Source: series.Source, Destination: series.Destination, Date: date}
+		value := strings.TrimSpace(record[1])
+		if value != "" && value != "." {
+			rate, err := decimal.NewFromString(value)
+			if err != nil || !rate.IsPositive() {
+				return nil, fmt.Errorf("line %d: rate %s is not a positive decimal number", line, value)
+			}
+			if isInverted {
+				rate = decimal.NewFromInt(1).DivRound(rate, models.RateScale)
+			}
+			exchangeRate.Rate = &rate
+		}
+		exchangeRates = append(exchangeRates, exchangeRate)
+	}
+}
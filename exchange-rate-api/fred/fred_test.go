@@ -0,0 +1,83 @@
+package fred
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var mapping = Mapping{
+	"DEXSZUS": {Source: "USD", Destination: "CHF", Convention: UnitsPerUsd},
+	"DEXUSEU": {Source: "EUR", Destination: "USD", Convention: UsdPerUnit},
+	"DEXUSUK": {Source: "USD", Destination: "GBP", Convention: UsdPerUnit},
+}
+
+func day(day int) time.Time {
+	return time.Date(2022, 05, day, 0, 00, 00, 0, time.UTC)
+}
+
+func TestReadMapsSeriesToPair(t *testing.T) {
+	exchangeRates, err := mapping.read(strings.NewReader("DATE,DEXSZUS\n2022-05-02,0.9774\n2022-05-03,\n2022-05-04,.\n"))
+
+	assert.NoError(t, err)
+	assert.Len(t, exchangeRates, 3)
+	assert.Equal(t, "USD", exchangeRates[0].Source)
+	assert.Equal(t, "CHF", exchangeRates[0].Destination)
+	assert.Equal(t, day(2), exchangeRates[0].Date)
+	assert.Equal(t, "0.9774", exchangeRates[0].Rate.String())
+	assert.Nil(t, exchangeRates[1].Rate)
+	assert.Nil(t, exchangeRates[2].Rate)
+}
+
+func TestReadInvertsRatesQuotedTheOtherWayRound(t *testing.T) {
+	direct, err := mapping.read(strings.NewReader("observation_date,DEXUSEU\n2022-05-02,1.0524\n"))
+	inverted, invertedErr := mapping.read(strings.NewReader("DATE,DEXUSUK\n2022-05-02,1.25\n"))
+
+	assert.NoError(t, err)
+	assert.NoError(t, invertedErr)
+	assert.Equal(t, "EUR", direct[0].Source)
+	assert.Equal(t, "USD", direct[0].Destination)
+	assert.Equal(t, "1.0524", direct[0].Rate.String())
+	assert.Equal(t, "GBP", inverted[0].Destination)
+	assert.Equal(t, "0.8", inverted[0].Rate.String())
+}
+
+func TestReadRejectsInvalidFiles(t *testing.T) {
+	for name, content := range map[string]string{
+		"header":          "DATE\n2022-05-02\n",
+		"unmapped series": "DATE,DEXJPUS\n2022-05-02,130\n",
+		"date":            "DATE,DEXSZUS\n02.05.2022,0.97\n",
+		"rate":            "DATE,DEXSZUS\n2022-05-02,#N/A\n",
+		"zero rate":       "DATE,DEXSZUS\n2022-05-02,0\n",
+	} {
+		_, err := mapping.read(strings.NewReader(content))
+
+		assert.Error(t, err, name)
+	}
+}
+
+func TestLoadsMappingOfDataFiles(t *testing.T) {
+	loaded, err := LoadMapping("../../data/fred-series.yaml")
+
+	assert.NoError(t, err)
+	assert.Equal(t, Series{Source: "USD", Destination: "CHF", Convention: UnitsPerUsd}, loaded["DEXSZUS"])
+}
+
+func TestLoadMappingRejectsInvalidSeries(t *testing.T) {
+	for name, content := range map[string]string{
+		"same currency": "DEXSZUS: {source: USD, destination: USD, convention: units-per-usd}",
+		"without usd":   "DEXSZUS: {source: CHF, destination: EUR, convention: units-per-usd}",
+		"convention":    "DEXSZUS: {source: CHF, destination: USD, convention: per-usd}",
+	} {
+		path := filepath.Join(t.TempDir(), "mapping.yaml")
+		os.WriteFile(path, []byte(content), 0o600)
+
+		_, err := LoadMapping(path)
+
+		assert.Error(t, err, name)
+	}
+}
@@ -0,0 +1,26 @@
+package graphqlapi
+
+import (
+	_ "embed"
+	"net/http"
+
+	"github.com/graph-gophers/graphql-go"
+	"github.com/graph-gophers/graphql-go/relay"
+	otelgraphql "github.com/graph-gophers/graphql-go/trace/otel"
+	"github.com/kolan92/exchange-rate-api/repositories"
+)
+
+//go:embed schema.graphql
+var schema string
+
+// NewHandler returns http handler serving GraphQL queries on top of CurrenciesRepository.
+func NewHandler(repo repositories.CurrenciesRepository) http.Handler {
+	relayHandler := &relay.Handler{
+		Schema: graphql.MustParseSchema(schema, &rootResolver{repo}, graphql.Tracer(otelgraphql.DefaultTracer())),
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := withLoaders(r.Context(), newLoaders(repo))
+		relayHandler.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
@@ -0,0 +1,85 @@
+package graphqlapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kolan92/exchange-rate-api/models"
+	testhelpers "github.com/kolan92/exchange-rate-api/testHelpers"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	repository *testhelpers.MockRepository
+	recorder   *httptest.ResponseRecorder
+)
+
+func setup() {
+	repository = testhelpers.NewMockRepository()
+	repository.CodesCurrenciesIdsMap["USD"] = 1
+	repository.CodesCurrenciesIdsMap["CHF"] = 2
+	repository.CodesCurrenciesIdsMap["JPY"] = 3
+
+	recorder = httptest.NewRecorder()
+}
+
+func execute(query string) map[string]interface{} {
+	body, _ := json.Marshal(map[string]string{"query": query})
+	request := httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(string(body)))
+	NewHandler(repository).ServeHTTP(recorder, request)
+
+	var response map[string]interface{}
+	json.Unmarshal(recorder.Body.Bytes(), &response)
+	return response
+}
+
+func TestLastExchangeRateForManyPairsIsBatched(t *testing.T) {
+	setup()
+	rate := decimal.RequireFromString("1.0226")
+	repository.LatestExchangeRates = []models.ExchangeRate{
+		{Source: "CHF", Destination: "USD", Date: time.Date(2022, 04, 29, 0, 00, 00, 0, time.UTC), Rate: &rate},
+	}
+
+	response := execute(`{
+		chf: lastExchangeRate(source: "CHF") { source date rate }
+		jpy: lastExchangeRate(source: "JPY", destination: "USD") { source rate }
+	}`)
+
+	assert.Nil(t, response["errors"])
+	assert.Len(t, repository.CurrencyPairsCalls, 1)
+	assert.ElementsMatch(t, []models.CurrencyPair{
+		{SourceCurrencyId: 2, DestinationCurrencyId: 1},
+		{SourceCurrencyId: 3, DestinationCurrencyId: 1},
+	}, repository.CurrencyPairsCalls[0])
+
+	data := response["data"].(map[string]interface{})
+	assert.Equal(t, map[string]interface{}{"source": "CHF", "date": "2022-04-29", "rate": "1.0226"}, data["chf"])
+	assert.Nil(t, data["jpy"])
+}
+
+func TestRangeExchangeRateForManyPairsIsBatched(t *testing.T) {
+	setup()
+
+	response := execute(`{
+		chf: rangeExchangeRate(source: "CHF", from: "2022-04-01", till: "2022-05-01") { rate }
+		jpy: rangeExchangeRate(source: "JPY", from: "2022-04-01", till: "2022-05-01") { rate }
+	}`)
+
+	assert.Nil(t, response["errors"])
+	assert.Len(t, repository.CurrencyPairsCalls, 1)
+	assert.Len(t, repository.CurrencyPairsCalls[0], 2)
+}
+
+func TestLastExchangeRateUnknownCurrency(t *testing.T) {
+	setup()
+
+	response := execute(`{ lastExchangeRate(source: "PLN") { rate } }`)
+
+	assert.NotNil(t, response["errors"])
+	assert.Empty(t, repository.CurrencyPairsCalls)
+}
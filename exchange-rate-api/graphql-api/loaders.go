@@ -0,0 +1,121 @@
+package graphqlapi
+
+import (
+	"context"
+	"time"
+
+	"github.com/graph-gophers/dataloader/v7"
+	"github.com/kolan92/exchange-rate-api/models"
+	"github.com/kolan92/exchange-rate-api/repositories"
+)
+
+// Resolvers of the same query run in parallel, loaders collect currency pairs requested by them
+// during the wait window, so query for many pairs is resolved by one repository call.
+const loaderWait = 2 * time.Millisecond
+
+type loadersKey struct{}
+
+type rangeKey struct {
+	currencyPair models.CurrencyPair
+	from, till   time.Time
+}
+
+type loaders struct {
+	lastExchangeRate  *dataloader.Loader[models.CurrencyPair, *models.ExchangeRate]
+	rangeExchangeRate *dataloader.Loader[rangeKey, []models.ExchangeRate]
+}
+
+// newLoaders has to be called per request, as loaders cache loaded values.
+func newLoaders(repo repositories.CurrenciesRepository) *loaders {
+	return &loaders{
+		lastExchangeRate: dataloader.NewBatchedLoader(
+			lastExchangeRateBatch(repo),
+			dataloader.WithWait[models.CurrencyPair, *models.ExchangeRate](loaderWait)),
+		rangeExchangeRate: dataloader.NewBatchedLoader(
+			rangeExchangeRateBatch(repo),
+			dataloader.WithWait[rangeKey, []models.ExchangeRate](loaderWait)),
+	}
+}
+
+func withLoaders(ctx context.Context, l *loaders) context.Context {
+	return context.WithValue(ctx, loadersKey{}, l)
+}
+
+func loadersFromContext(ctx context.Context) *loaders {
+	return ctx.Value(loadersKey{}).(*loaders)
+}
+
+func lastExchangeRateBatch(repo repositories.CurrenciesRepository) dataloader.BatchFunc[models.CurrencyPair, *models.ExchangeRate] {
+	return func(ctx context.Context, currencyPairs []models.CurrencyPair) []*dataloader.Result[*models.ExchangeRate] {
+		exchangeRates, err := repo.GetLastExchangeRates(ctx, currencyPairs)
+		results := make([]*dataloader.Result[*models.ExchangeRate], len(currencyPairs))
+		if err != nil {
+			for i := range results {
+				results[i] = &dataloader.Result[*models.ExchangeRate]{Error: err}
+			}
+			return results
+		}
+
+		byCurrencyPair := groupByCurrencyPair(repo.GetCurrenciesCodesIdsMap(ctx), exchangeRates)
+		for i, currencyPair := range currencyPairs {
+			result := &dataloader.Result[*models.ExchangeRate]{}
+			if pairExchangeRates := byCurrencyPair[currencyPair]; len(pairExchangeRates) > 0 {
+				result.Data = &pairExchangeRates[0]
+			}
+			results[i] = result
+		}
+		return results
+	}
+}
+
+func rangeExchangeRateBatch(repo repositories.CurrenciesRepository) dataloader.BatchFunc[rangeKey, []models.ExchangeRate] {
+	return func(ctx context.Context, keys []rangeKey) []*dataloader.Result[[]models.ExchangeRate] {
+		type period struct{ from, till time.Time }
+
+		currencyPairsByPeriod := make(map[period][]models.CurrencyPair)
+		for _, key := range keys {
+			p := period{key.from, key.till}
+			currencyPairsByPeriod[p] = append(currencyPairsByPeriod[p], key.currencyPair)
+		}
+
+		currencyCodesMap := repo.GetCurrenciesCodesIdsMap(ctx)
+		byPeriod := make(map[period]map[models.CurrencyPair][]models.ExchangeRate)
+		errByPeriod := make(map[period]error)
+		for p, currencyPairs := range currencyPairsByPeriod {
+			from, till := p.from, p.till
+			exchangeRates, err := repo.GetRangeExchangeRates(ctx, currencyPairs, &from, &till)
+			if err != nil {
+				errByPeriod[p] = err
+				continue
+			}
+			byPeriod[p] = groupByCurrencyPair(currencyCodesMap, exchangeRates)
+		}
+
+		results := make([]*dataloader.Result[[]models.ExchangeRate], len(keys))
+		for i, key := range keys {
+			p := period{key.from, key.till}
+			if err, isFound := errByPeriod[p]; isFound {
+				results[i] = &dataloader.Result[[]models.ExchangeRate]{Error: err}
+				continue
+			}
+			exchangeRates := byPeriod[p][key.currencyPair]
+			if exchangeRates == nil {
+				exchangeRates = []models.ExchangeRate{}
+			}
+			results[i] = &dataloader.Result[[]models.ExchangeRate]{Data: exchangeRates}
+		}
+		return results
+	}
+}
+
+func groupByCurrencyPair(currencyCodesMap map[string]int, exchangeRates []models.ExchangeRate) map[models.CurrencyPair][]models.ExchangeRate {
+	byCurrencyPair := make(map[models.CurrencyPair][]models.ExchangeRate)
+	for _, exchangeRate := range exchangeRates {
+		currencyPair := models.CurrencyPair{
+			SourceCurrencyId:      currencyCodesMap[exchangeRate.Source],
+			DestinationCurrencyId: currencyCodesMap[exchangeRate.Destination],
+		}
+		byCurrencyPair[currencyPair] = append(byCurrencyPair[currencyPair], exchangeRate)
+	}
+	return byCurrencyPair
+}
@@ -0,0 +1,119 @@
+package graphqlapi
+
+import (
+	"context"
+
+	"github.com/kolan92/exchange-rate-api/models"
+	"github.com/kolan92/exchange-rate-api/repositories"
+	"github.com/kolan92/exchange-rate-api/validators"
+)
+
+type rootResolver struct {
+	repo repositories.CurrenciesRepository
+}
+
+type exchangeRateResolver struct {
+	exchangeRate models.ExchangeRate
+}
+
+func (r *rootResolver) Currencies(ctx context.Context) []string {
+	return r.repo.GetCurrenciesCodes(ctx)
+}
+
+func (r *rootResolver) LastExchangeRate(ctx context.Context, args struct {
+	Source      string
+	Destination *string
+}) (*exchangeRateResolver, error) {
+	currencyPair, err := r.getCurrencyPair(ctx, args.Source, args.Destination)
+	if err != nil {
+		return nil, err
+	}
+
+	exchangeRate, err := loadersFromContext(ctx).lastExchangeRate.Load(ctx, currencyPair)()
+	if err != nil || exchangeRate == nil {
+		return nil, err
+	}
+
+	return &exchangeRateResolver{*exchangeRate}, nil
+}
+
+func (r *rootResolver) RangeExchangeRate(ctx context.Context, args struct {
+	Source      string
+	Destination *string
+	From        string
+	Till        string
+}) ([]*exchangeRateResolver, error) {
+	currencyPair, err := r.getCurrencyPair(ctx, args.Source, args.Destination)
+	if err != nil {
+		return nil, err
+	}
+
+	from, till, err := validators.ParseFromAndTillDates(args.From, args.Till)
+	if err != nil {
+		return nil, err
+	}
+
+	key := rangeKey{currencyPair: currencyPair, from: *from, till: *till}
+	exchangeRates, err := loadersFromContext(ctx).rangeExchangeRate.Load(ctx, key)()
+	if err != nil {
+		return nil, err
+	}
+
+	return toExchangeRateResolvers(exchangeRates), nil
+}
+
+func (r *rootResolver) AllExchangeRatesFromDate(ctx context.Context, args struct{ Date string }) ([]*exchangeRateResolver, error) {
+	dateValue, err := validators.ParseDate(args.Date)
+	if err != nil {
+		return nil, err
+	}
+
+	exchangeRates, err := r.repo.GetAllExchangeRatesFromDate(ctx, dateValue)
+	if err != nil {
+		return nil, err
+	}
+
+	return toExchangeRateResolvers(exchangeRates), nil
+}
+
+func (r *rootResolver) getCurrencyPair(ctx context.Context, source string, destination *string) (models.CurrencyPair, error) {
+	destinationCode := ""
+	if destination != nil {
+		destinationCode = *destination
+	}
+
+	sourceCurrencyId, destinationCurrencyId, err := validators.GetCurrenciesIds(r.repo.GetCurrenciesCodesIdsMap(ctx), source, destinationCode)
+	if err != nil {
+		return models.CurrencyPair{}, err
+	}
+
+	return models.CurrencyPair{SourceCurrencyId: sourceCurrencyId, DestinationCurrencyId: destinationCurrencyId}, nil
+}
+
+func toExchangeRateResolvers(exchangeRates []models.ExchangeRate) []*exchangeRateResolver {
+	resolvers := make([]*exchangeRateResolver, 0, len(exchangeRates))
+	for _, exchangeRate := range exchangeRates {
+		resolvers = append(resolvers, &exchangeRateResolver{exchangeRate})
+	}
+	return resolvers
+}
+
+func (r *exchangeRateResolver) Source() string {
+	return r.exchangeRate.Source
+}
+
+func (r *exchangeRateResolver) Destination() string {
+	return r.exchangeRate.Destination
+}
+
+func (r *exchangeRateResolver) Date() string {
+	return r.exchangeRate.Date.Format(validators.DateLayout)
+}
+
+func (r *exchangeRateResolver) Rate() *string {
+	if r.exchangeRate.Rate == nil {
+		return nil
+	}
+	rate := r.exchangeRate.Rate.String()
+	return &rate
+}
@@ -0,0 +1,184 @@
+package grpcserver
+
+import (
+	"context"
+	"errors"
+
+	customerros "github.com/kolan92/exchange-rate-api/custom-erros"
+	"github.com/kolan92/exchange-rate-api/logging"
+	"github.com/kolan92/exchange-rate-api/models"
+	exchangeratepb "github.com/kolan92/exchange-rate-api/proto"
+	"github.com/kolan92/exchange-rate-api/repositories"
+	"github.com/kolan92/exchange-rate-api/validators"
+	"github.com/shopspring/decimal"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+// ExchangeRatesServer exposes CurrenciesRepository over gRPC.
+// It uses the same validation as ExchangeRatesController, so both transports behave identically.
+type ExchangeRatesServer struct {
+	exchangeratepb.UnimplementedExchangeRatesServer
+	repo repositories.CurrenciesRepository
+}
+
+func NewExchangeRatesServer(repo repositories.CurrenciesRepository) *ExchangeRatesServer {
+	return &ExchangeRatesServer{repo: repo}
+}
+
+func (s *ExchangeRatesServer) Register(server *grpc.Server) {
+	exchangeratepb.RegisterExchangeRatesServer(server, s)
+}
+
+func (s *ExchangeRatesServer) GetCurrencies(ctx context.Context, request *exchangeratepb.GetCurrenciesRequest) (*exchangeratepb.GetCurrenciesResponse, error) {
+	return &exchangeratepb.GetCurrenciesResponse{Currencies: s.repo.GetCurrenciesCodes(ctx)}, nil
+}
+
+func (s *ExchangeRatesServer) GetLastExchangeRate(ctx context.Context, request *exchangeratepb.GetLastExchangeRateRequest) (*exchangeratepb.ExchangeRate, error) {
+	currencyCodesMap := s.repo.GetCurrenciesCodesIdsMap(ctx)
+
+	sourceCurrencyId, destinationCurrencyId, err := validators.GetCurrenciesIds(currencyCodesMap, request.Source, request.Destination)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	exchangeRate, err := s.repo.GetLastExchangeRate(ctx, sourceCurrencyId, destinationCurrencyId)
+	if err != nil {
+		return nil, errToStatus(err)
+	}
+
+	return toProtoExchangeRate(exchangeRate), nil
+}
+
+func (s *ExchangeRatesServer) GetAllExchangeRatesFromDate(ctx context.Context, request *exchangeratepb.GetAllExchangeRatesFromDateRequest) (*exchangeratepb.ExchangeRatesResponse, error) {
+	dateValue, err := validators.ParseDate(request.Date)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	exchangeRates, err := s.repo.GetAllExchangeRatesFromDate(ctx, dateValue)
+	if err != nil {
+		return nil, errToStatus(err)
+	}
+
+	return toProtoExchangeRates(exchangeRates), nil
+}
+
+func (s *ExchangeRatesServer) GetRangeExchangeRate(ctx context.Context, request *exchangeratepb.GetRangeExchangeRateRequest) (*exchangeratepb.ExchangeRatesResponse, error) {
+	currencyCodesMap := s.repo.GetCurrenciesCodesIdsMap(ctx)
+
+	sourceCurrencyId, destinationCurrencyId, err := validators.GetCurrenciesIds(currencyCodesMap, request.Source, request.Destination)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	from, till, err := validators.ParseFromAndTillDates(request.From, request.Till)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	exchangeRates, err := s.repo.GetRangeExchangeRate(ctx, sourceCurrencyId, destinationCurrencyId, from, till)
+	if err != nil {
+		return nil, errToStatus(err)
+	}
+
+	return toProtoExchangeRates(exchangeRates), nil
+}
+
+func (s *ExchangeRatesServer) InsertExchangeRate(ctx context.Context, request *exchangeratepb.InsertExchangeRateRequest) (*exchangeratepb.ExchangeRate, error) {
+	newExchangeRate, err := fromProtoExchangeRate(request.ExchangeRate)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	currencyCodesMap := s.repo.GetCurrenciesCodesIdsMap(ctx)
+	if err := validators.ValidateNewExchangeRate(newExchangeRate, currencyCodesMap); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := s.repo.InsertExchangeRate(ctx, newExchangeRate); err != nil {
+		if errors.Is(err, customerros.ErrDuplicateKeyViolation) {
+			return nil, status.Error(codes.AlreadyExists, err.Error())
+		}
+		if customerros.Code(err) == customerros.CodeInvertedPair {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		logging.FromContext(ctx).Error("Error while inserting new exchange rate to database", zap.Error(err))
+		return nil, status.Error(codes.Internal, "Error while inserting new exchange rate to database")
+	}
+
+	return toProtoExchangeRate(newExchangeRate), nil
+}
+
+func errToStatus(err error) error {
+	switch {
+	case errors.Is(err, customerros.ErrDuplicateKeyViolation):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case customerros.Code(err) == customerros.CodeInvertedPair:
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
+func toProtoExchangeRate(exchangeRate *models.ExchangeRate) *exchangeratepb.ExchangeRate {
+	protoExchangeRate := &exchangeratepb.ExchangeRate{
+		Source:      exchangeRate.Source,
+		Destination: exchangeRate.Destination,
+		Date:        timestamppb.New(exchangeRate.Date),
+	}
+
+	if exchangeRate.Rate != nil {
+		rate := exchangeRate.Rate.String()
+		protoExchangeRate.Rate = &rate
+	}
+
+	return protoExchangeRate
+}
+
+func toProtoExchangeRates(exchangeRates []models.ExchangeRate) *exchangeratepb.ExchangeRatesResponse {
+	response := &exchangeratepb.ExchangeRatesResponse{
+		ExchangeRates: make([]*exchangeratepb.ExchangeRate, 0, len(exchangeRates)),
+	}
+
+	for i := range exchangeRates {
+		response.ExchangeRates = append(response.ExchangeRates, toProtoExchangeRate(&exchangeRates[i]))
+	}
+
+	return response
+}
+
+// fromProtoExchangeRate only converts the message, missing fields are rejected by validators.ValidateNewExchangeRate.
+func fromProtoExchangeRate(protoExchangeRate *exchangeratepb.ExchangeRate) (*models.ExchangeRate, error) {
+	if protoExchangeRate == nil {
+		return nil, errors.New("missing exchange rate")
+	}
+
+	exchangeRate := &models.ExchangeRate{
+		Source:      protoExchangeRate.Source,
+		Destination: protoExchangeRate.Destination,
+	}
+
+	if protoExchangeRate.Date != nil {
+		if err := protoExchangeRate.Date.CheckValid(); err != nil {
+			return nil, err
+		}
+		exchangeRate.Date = protoExchangeRate.Date.AsTime()
+	}
+
+	if protoExchangeRate.Rate != nil {
+		rate, err := decimal.NewFromString(*protoExchangeRate.Rate)
+		if err != nil {
+			return nil, err
+		}
+		exchangeRate.Rate = &rate
+	}
+
+	return exchangeRate, nil
+}
@@ -0,0 +1,117 @@
+package grpcserver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	customerros "github.com/kolan92/exchange-rate-api/custom-erros"
+	"github.com/kolan92/exchange-rate-api/models"
+	exchangeratepb "github.com/kolan92/exchange-rate-api/proto"
+	testhelpers "github.com/kolan92/exchange-rate-api/testHelpers"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+var (
+	repository *testhelpers.MockRepository
+	server     *ExchangeRatesServer
+)
+
+func setup() {
+	repository = testhelpers.NewMockRepository()
+	repository.CodesCurrenciesIdsMap["USD"] = 1
+	repository.CodesCurrenciesIdsMap["CHF"] = 2
+
+	server = NewExchangeRatesServer(repository)
+}
+
+func TestGetLastExchangeRateReturnsValue(t *testing.T) {
+	setup()
+	repository.LatestExchangeRate = &models.ExchangeRate{
+		Source:      "CHF",
+		Destination: "USD",
+		Date:        time.Date(2022, 04, 30, 0, 00, 00, 0, time.UTC),
+	}
+
+	exchangeRate, err := server.GetLastExchangeRate(context.Background(), &exchangeratepb.GetLastExchangeRateRequest{Source: "CHF"})
+	assert.NoError(t, err)
+	assert.Equal(t, "CHF", exchangeRate.Source)
+	assert.Equal(t, "USD", exchangeRate.Destination)
+	assert.Nil(t, exchangeRate.Rate)
+	assert.Equal(t, 2, repository.SourceCurrencyId)
+	assert.Equal(t, 1, repository.DestinaionCurrencyId)
+}
+
+func TestGetLastExchangeRateReturnsNotFound(t *testing.T) {
+	setup()
+	repository.LatestExchangeRateError = gorm.ErrRecordNotFound
+
+	_, err := server.GetLastExchangeRate(context.Background(), &exchangeratepb.GetLastExchangeRateRequest{Source: "USD", Destination: "CHF"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestGetLastExchangeRateUnknownCurrency(t *testing.T) {
+	setup()
+
+	_, err := server.GetLastExchangeRate(context.Background(), &exchangeratepb.GetLastExchangeRateRequest{Source: "PLN"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestGetRangeExchangeRateIncorrectDates(t *testing.T) {
+	setup()
+
+	_, err := server.GetRangeExchangeRate(context.Background(), &exchangeratepb.GetRangeExchangeRateRequest{
+		Source: "CHF",
+		From:   "2022-05-06",
+		Till:   "2022-05-01",
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestInsertExchangeRateSameCurrencies(t *testing.T) {
+	setup()
+
+	_, err := server.InsertExchangeRate(context.Background(), &exchangeratepb.InsertExchangeRateRequest{
+		ExchangeRate: &exchangeratepb.ExchangeRate{
+			Source:      "USD",
+			Destination: "USD",
+			Date:        timestamppb.New(time.Date(2022, 04, 30, 10, 00, 00, 0, time.UTC)),
+		},
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestInsertExchangeRateOfInvertedPairFailsPrecondition(t *testing.T) {
+	setup()
+	repository.InsertExchangeRateError = customerros.New(customerros.CodeInvertedPair, "CHF/USD is quoted as USD/CHF")
+
+	_, err := server.InsertExchangeRate(context.Background(), &exchangeratepb.InsertExchangeRateRequest{
+		ExchangeRate: &exchangeratepb.ExchangeRate{
+			Source:      "CHF",
+			Destination: "USD",
+			Date:        timestamppb.New(time.Date(2022, 04, 30, 10, 00, 00, 0, time.UTC)),
+		},
+	})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestInsertExchangeRateIgnoresTime(t *testing.T) {
+	setup()
+	rate := "1.0456"
+
+	exchangeRate, err := server.InsertExchangeRate(context.Background(), &exchangeratepb.InsertExchangeRateRequest{
+		ExchangeRate: &exchangeratepb.ExchangeRate{
+			Source:      "CHF",
+			Destination: "USD",
+			Date:        timestamppb.New(time.Date(2022, 04, 30, 10, 00, 00, 0, time.UTC)),
+			Rate:        &rate,
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2022, 04, 30, 0, 00, 00, 0, time.UTC), exchangeRate.Date.AsTime())
+	assert.Equal(t, rate, *exchangeRate.Rate)
+}
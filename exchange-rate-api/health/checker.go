@@ -0,0 +1,100 @@
+package health
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+const (
+	StatusOk     = "ok"
+	StatusFailed = "failed"
+)
+
+// Check verifies single dependency. Returned detail is reported in readiness response also when check fails.
+type Check func(ctx context.Context) (detail interface{}, err error)
+
+type CheckResult struct {
+	Status   string      `json:"status"`
+	Detail   interface{} `json:"detail,omitempty"`
+	Error    string      `json:"error,omitempty"`
+	Duration string      `json:"duration"`
+}
+
+type Report struct {
+	Status string                 `json:"status"`
+	Checks map[string]CheckResult `json:"checks"`
+}
+
+// Checker runs all registered checks concurrently, each one limited by timeout,
+// so single hanging dependency doesn't block the probe.
+type Checker struct {
+	timeout time.Duration
+	names   []string
+	checks  map[string]Check
+}
+
+func NewChecker(timeout time.Duration) *Checker {
+	return &Checker{timeout: timeout, checks: make(map[string]Check)}
+}
+
+func (c *Checker) AddCheck(name string, check Check) {
+	c.names = append(c.names, name)
+	c.checks[name] = check
+}
+
+// Run returns report with ok status only when all checks passed.
+func (c *Checker) Run(ctx context.Context) Report {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	report := Report{Status: StatusOk, Checks: make(map[string]CheckResult, len(c.names))}
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for _, name := range c.names {
+		wg.Add(1)
+		go func(name string, check Check) {
+			defer wg.Done()
+			result := runCheck(ctx, check)
+
+			mu.Lock()
+			defer mu.Unlock()
+			report.Checks[name] = result
+			if result.Status != StatusOk {
+				report.Status = StatusFailed
+			}
+		}(name, c.checks[name])
+	}
+	wg.Wait()
+
+	return report
+}
+
+func runCheck(ctx context.Context, check Check) CheckResult {
+	type outcome struct {
+		detail interface{}
+		err    error
+	}
+
+	start := time.Now()
+	done := make(chan outcome, 1)
+	go func() {
+		detail, err := check(ctx)
+		done <- outcome{detail, err}
+	}()
+
+	var result outcome
+	select {
+	case result = <-done:
+	case <-ctx.Done():
+		result.err = ctx.Err()
+	}
+
+	checkResult := CheckResult{Status: StatusOk, Detail: result.detail, Duration: time.Since(start).String()}
+	if result.err != nil {
+		checkResult.Status = StatusFailed
+		checkResult.Error = result.err.Error()
+	}
+	return checkResult
+}
@@ -0,0 +1,105 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	testhelpers "github.com/kolan92/exchange-rate-api/testHelpers"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	repository *testhelpers.MockRepository
+	now        = time.Date(2022, 05, 02, 12, 00, 00, 0, time.UTC)
+)
+
+type mockPinger struct {
+	err error
+}
+
+func (p mockPinger) PingContext(ctx context.Context) error {
+	return p.err
+}
+
+func setup() *Checker {
+	repository = testhelpers.NewMockRepository()
+	repository.CodesCurrenciesIdsMap["USD"] = 1
+	repository.CodesCurrenciesIdsMap["CHF"] = 2
+	newestDate := time.Date(2022, 05, 01, 0, 00, 00, 0, time.UTC)
+	repository.NewestExchangeRateDate = &newestDate
+
+	checker := NewChecker(time.Second)
+	checker.AddCheck("currencies", CurrenciesCheck(repository))
+	checker.AddCheck("freshness", FreshnessCheck(repository, 48*time.Hour, func() time.Time { return now }))
+	return checker
+}
+
+func TestRunReportsOkWhenAllChecksPass(t *testing.T) {
+	checker := setup()
+	checker.AddCheck("database", DatabaseCheck(mockPinger{}))
+
+	report := checker.Run(context.Background())
+
+	assert.Equal(t, StatusOk, report.Status)
+	assert.Len(t, report.Checks, 3)
+	assert.Equal(t, currenciesDetail{2}, report.Checks["currencies"].Detail)
+	assert.Equal(t, freshnessDetail{"2022-05-01", "36h0m0s", "48h0m0s"}, report.Checks["freshness"].Detail)
+}
+
+func TestRunReportsFailedDatabase(t *testing.T) {
+	checker := setup()
+	checker.AddCheck("database", DatabaseCheck(mockPinger{errors.New("connection refused")}))
+
+	report := checker.Run(context.Background())
+
+	assert.Equal(t, StatusFailed, report.Status)
+	assert.Equal(t, "connection refused", report.Checks["database"].Error)
+	assert.Equal(t, StatusOk, report.Checks["currencies"].Status)
+}
+
+func TestRunReportsNotLoadedCurrencies(t *testing.T) {
+	checker := setup()
+	repository.CodesCurrenciesIdsMap = map[string]int{}
+
+	report := checker.Run(context.Background())
+
+	assert.Equal(t, StatusFailed, report.Status)
+	assert.Equal(t, StatusFailed, report.Checks["currencies"].Status)
+}
+
+func TestRunReportsStaleRates(t *testing.T) {
+	checker := setup()
+	staleDate := time.Date(2022, 04, 29, 0, 00, 00, 0, time.UTC)
+	repository.NewestExchangeRateDate = &staleDate
+
+	report := checker.Run(context.Background())
+
+	assert.Equal(t, StatusFailed, report.Status)
+	assert.Equal(t, "newest exchange rate is older than 48h0m0s", report.Checks["freshness"].Error)
+}
+
+func TestFreshnessWithoutMaxAgeOnlyReportsAge(t *testing.T) {
+	setup()
+	staleDate := time.Date(2020, 01, 01, 0, 00, 00, 0, time.UTC)
+	repository.NewestExchangeRateDate = &staleDate
+
+	detail, err := FreshnessCheck(repository, 0, func() time.Time { return now })(context.Background())
+
+	assert.NoError(t, err)
+	assert.Equal(t, "2020-01-01", detail.(freshnessDetail).NewestRateDate)
+}
+
+func TestRunTimesOutHangingCheck(t *testing.T) {
+	checker := NewChecker(10 * time.Millisecond)
+	checker.AddCheck("hanging", func(ctx context.Context) (interface{}, error) {
+		time.Sleep(time.Second)
+		return nil, nil
+	})
+
+	report := checker.Run(context.Background())
+
+	assert.Equal(t, StatusFailed, report.Status)
+	assert.Equal(t, context.DeadlineExceeded.Error(), report.Checks["hanging"].Error)
+}
@@ -0,0 +1,71 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/kolan92/exchange-rate-api/repositories"
+	"github.com/kolan92/exchange-rate-api/validators"
+)
+
+type Pinger interface {
+	PingContext(ctx context.Context) error
+}
+
+type currenciesDetail struct {
+	Count int `json:"count"`
+}
+
+type freshnessDetail struct {
+	NewestRateDate string `json:"newestRateDate,omitempty"`
+	Age            string `json:"age,omitempty"`
+	MaxAge         string `json:"maxAge,omitempty"`
+}
+
+// DatabaseCheck pings database, e.g. *sql.DB connection pool.
+func DatabaseCheck(pinger Pinger) Check {
+	return func(ctx context.Context) (interface{}, error) {
+		return nil, pinger.PingContext(ctx)
+	}
+}
+
+// CurrenciesCheck fails until currency codes are loaded, without them no request can be validated.
+func CurrenciesCheck(repo repositories.CurrenciesRepository) Check {
+	return func(ctx context.Context) (interface{}, error) {
+		count := len(repo.GetCurrenciesCodesIdsMap(ctx))
+		if count == 0 {
+			return currenciesDetail{count}, errors.New("currencies are not loaded")
+		}
+		return currenciesDetail{count}, nil
+	}
+}
+
+// FreshnessCheck reports age of the newest exchange rate. It fails when rate is older than maxAge,
+// maxAge 0 only reports the age, e.g. when rates are loaded manually.
+func FreshnessCheck(repo repositories.CurrenciesRepository, maxAge time.Duration, now func() time.Time) Check {
+	return func(ctx context.Context) (interface{}, error) {
+		detail := freshnessDetail{}
+		if maxAge > 0 {
+			detail.MaxAge = maxAge.String()
+		}
+
+		newestDate, err := repo.GetNewestExchangeRateDate(ctx)
+		if err != nil {
+			return detail, err
+		}
+		if newestDate == nil {
+			return detail, errors.New("there are no exchange rates")
+		}
+
+		age := now().Sub(*newestDate).Truncate(time.Second)
+		detail.NewestRateDate = newestDate.Format(validators.DateLayout)
+		detail.Age = age.String()
+
+		if maxAge > 0 && age > maxAge {
+			return detail, fmt.Errorf("newest exchange rate is older than %s", maxAge)
+		}
+		return detail, nil
+	}
+}
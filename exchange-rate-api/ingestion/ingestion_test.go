@@ -0,0 +1,207 @@
+package ingestion
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/kolan92/exchange-rate-api/config"
+	"github.com/kolan92/exchange-rate-api/models"
+	"github.com/kolan92/exchange-rate-api/repositories"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	ctx      context.Context
+	repo     repositories.CurrenciesRepository
+	runsRepo repositories.IngestionRunsRepository
+	cfg      config.IngestionConfig
+)
+
+func setup() {
+	ctx = context.Background()
+	repo = repositories.NewMemoryCurrenciesRepository(repositories.SeedCurrenciesCodes)
+	runsRepo = repositories.NewMemoryIngestionRunsRepository()
+	cfg = config.IngestionConfig{
+		Pairs:      config.CurrencyPairs{{Source: "CHF", Destination: "USD"}, {Source: "JPY", Destination: "USD"}},
+		Interval:   time.Hour,
+		Timeout:    time.Second,
+		OnConflict: config.OnConflictSkip,
+	}
+}
+
+type staticProvider struct {
+	exchangeRates []models.ExchangeRate
+	err           error
+}
+
+func (p *staticProvider) Name() string {
+	return "static"
+}
+
+func (p *staticProvider) FetchRates(ctx context.Context, pairs []config.CurrencyPair) ([]models.ExchangeRate, error) {
+	return p.exchangeRates, p.err
+}
+
+func day(day int) time.Time {
+	return time.Date(2022, 05, day, 0, 00, 00, 0, time.UTC)
+}
+
+func exchangeRate(source string, date time.Time, rate string) models.ExchangeRate {
+	value := decimal.RequireFromString(rate)
+	return models.ExchangeRate{Source: source, Destination: "USD", Date: date, Rate: &value}
+}
+
+func TestRunInsertsRatesOfConfiguredPairs(t *testing.T) {
+	setup()
+	provider := &staticProvider{exchangeRates: []models.ExchangeRate{
+		exchangeRate("CHF", day(2), "1.01"),
+		exchangeRate("JPY", day(2), "130"),
+		exchangeRate("EUR", day(2), "1.05"),
+	}}
+
+	run := NewScheduler(provider, repo, runsRepo, cfg).RunOnce(ctx)
+
+	assert.Equal(t, 2, run.Fetched)
+	assert.Equal(t, 2, run.Inserted)
+	assert.Empty(t, run.ErrorMessage)
+	exchangeRates, _ := repo.GetAllExchangeRatesFromDate(ctx, day(2))
+	assert.Len(t, exchangeRates, 2)
+}
+
+func TestRunSkipsOrUpdatesStoredRates(t *testing.T) {
+	setup()
+	provider := &staticProvider{exchangeRates: []models.ExchangeRate{exchangeRate("CHF", day(2), "1.01")}}
+	NewScheduler(provider, repo, runsRepo, cfg).RunOnce(ctx)
+	provider.exchangeRates = []models.ExchangeRate{exchangeRate("CHF", day(2), "1.02")}
+
+	skipped := NewScheduler(provider, repo, runsRepo, cfg).RunOnce(ctx)
+	cfg.OnConflict = config.OnConflictUpdate
+	updated := NewScheduler(provider, repo, runsRepo, cfg).RunOnce(ctx)
+
+	assert.Equal(t, 1, skipped.Skipped)
+	assert.Equal(t, 1, updated.Updated)
+	lastRate, _ := repo.GetLastExchangeRate(ctx, 2, 1)
+	assert.Equal(t, "1.02", lastRate.Rate.String())
+}
+
+func TestRunRejectsInvalidRates(t *testing.T) {
+	setup()
+	provider := &staticProvider{exchangeRates: []models.ExchangeRate{
+		{Source: "JPY", Destination: "USD"},
+		{Source: "CHF", Destination: "USD"},
+		exchangeRate("CHF", day(2), "1.01"),
+	}}
+
+	run := NewScheduler(provider, repo, runsRepo, cfg).RunOnce(ctx)
+
+	assert.Equal(t, 3, run.Fetched)
+	assert.Equal(t, 2, run.Rejected)
+	assert.Equal(t, 1, run.Inserted)
+}
+
+func TestRunRejectsRatesOfPairStoredTheOtherWayRound(t *testing.T) {
+	setup()
+	cfg.Pairs = append(cfg.Pairs, config.CurrencyPair{Source: "USD", Destination: "CHF"})
+	inverted := exchangeRate("USD", day(2), "0.99")
+	inverted.Destination = "CHF"
+	provider := &staticProvider{exchangeRates: []models.ExchangeRate{exchangeRate("CHF", day(1), "1.01"), inverted}}
+
+	run := NewScheduler(provider, repo, runsRepo, cfg).RunOnce(ctx)
+
+	assert.Equal(t, 1, run.Inserted)
+	assert.Equal(t, 1, run.Rejected)
+	assert.Empty(t, run.ErrorMessage)
+}
+
+func TestRunInsertsCurrenciesOfConfiguredPairs(t *testing.T) {
+	setup()
+	cfg.Pairs = config.CurrencyPairs{{Source: "USD", Destination: "EUR"}}
+	euroRate := exchangeRate("USD", day(2), "1.05")
+	euroRate.Destination = "EUR"
+	provider := &staticProvider{exchangeRates: []models.ExchangeRate{euroRate}}
+
+	run := NewScheduler(provider, repo, runsRepo, cfg).RunOnce(ctx)
+
+	assert.Equal(t, 1, run.Inserted)
+	assert.Contains(t, repo.GetCurrenciesCodesIdsMap(ctx), "EUR")
+}
+
+func TestRunRecordsOutcome(t *testing.T) {
+	setup()
+	scheduler := NewScheduler(&staticProvider{err: errors.New("provider is down")}, repo, runsRepo, cfg)
+
+	scheduler.RunOnce(ctx)
+	scheduler.RunOnce(ctx)
+
+	runs, err := runsRepo.GetIngestionRuns(ctx, 1)
+	assert.NoError(t, err)
+	assert.Len(t, runs, 1)
+	assert.Equal(t, 2, runs[0].Id)
+	assert.Equal(t, "static", runs[0].Provider)
+	assert.Equal(t, "provider is down", runs[0].ErrorMessage)
+	assert.False(t, runs[0].FinishedAt.Before(runs[0].StartedAt))
+}
+
+func TestRunStopsWhenContextIsDone(t *testing.T) {
+	setup()
+	runCtx, cancel := context.WithCancel(ctx)
+	cancel()
+
+	done := make(chan struct{})
+	go func() {
+		NewScheduler(&staticProvider{}, repo, runsRepo, cfg).Run(runCtx)
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("scheduler didn't stop")
+	}
+	runs, _ := runsRepo.GetIngestionRuns(ctx, 10)
+	assert.Len(t, runs, 1)
+}
+
+func TestFileProviderReadsExportedJson(t *testing.T) {
+	setup()
+	path := filepath.Join(t.TempDir(), "rates.json")
+	os.WriteFile(path, []byte(`[{"source": "CHF", "destination": "USD", "date": "2022-05-02T00:00:00Z", "rate": 1.01}]`), 0o600)
+
+	run := NewScheduler(NewFileProvider(path), repo, runsRepo, cfg).RunOnce(ctx)
+
+	assert.Equal(t, config.ProviderFile, run.Provider)
+	assert.Equal(t, 1, run.Inserted)
+}
+
+func TestHttpProviderRequestsConfiguredPairsFromStub(t *testing.T) {
+	setup()
+	stub := httptest.NewServer(NewStubHandler([]models.ExchangeRate{
+		exchangeRate("CHF", day(2), "1.01"),
+		exchangeRate("EUR", day(2), "1.05"),
+	}))
+	defer stub.Close()
+
+	exchangeRates, err := NewHttpProvider(stub.URL, stub.Client()).FetchRates(ctx, cfg.Pairs)
+
+	assert.NoError(t, err)
+	assert.Len(t, exchangeRates, 1)
+	assert.Equal(t, "CHF", exchangeRates[0].Source)
+	assert.Equal(t, "1.01", exchangeRates[0].Rate.String())
+}
+
+func TestHttpProviderFailsOnErrorStatus(t *testing.T) {
+	setup()
+	stub := httptest.NewServer(http.NotFoundHandler())
+	defer stub.Close()
+
+	run := NewScheduler(NewHttpProvider(stub.URL, stub.Client()), repo, runsRepo, cfg).RunOnce(ctx)
+
+	assert.Contains(t, run.ErrorMessage, "responded with status 404")
+}
@@ -0,0 +1,134 @@
+// Package ingestion periodically fetches exchange rates of configured pairs from a provider and stores them.
+package ingestion
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/kolan92/exchange-rate-api/config"
+	"github.com/kolan92/exchange-rate-api/models"
+)
+
+// RateProvider returns exchange rates of requested pairs. Rates of other pairs may be returned as well,
+// they are ignored by Scheduler.
+type RateProvider interface {
+	Name() string
+	FetchRates(ctx context.Context, pairs []config.CurrencyPair) ([]models.ExchangeRate, error)
+}
+
+// FileProvider reads json array of exchange rates, e.g. file written by rates export -format json.
+// File is read on every fetch, so it can be replaced while the api is running.
+type FileProvider struct {
+	path string
+}
+
+func NewFileProvider(path string) *FileProvider {
+	return &FileProvider{path}
+}
+
+func (p *FileProvider) Name() string {
+	return config.ProviderFile
+}
+
+func (p *FileProvider) FetchRates(ctx context.Context, pairs []config.CurrencyPair) ([]models.ExchangeRate, error) {
+	file, err := os.Open(p.path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	exchangeRates, err := decodeExchangeRates(file)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", p.path, err)
+	}
+	return exchangeRates, nil
+}
+
+// HttpProvider gets json array of exchange rates from url, requested pairs are sent in pairs query parameter,
+// e.g. ?pairs=USD/CHF,USD/JPY.
+type HttpProvider struct {
+	url    string
+	client *http.Client
+}
+
+func NewHttpProvider(url string, client *http.Client) *HttpProvider {
+	return &HttpProvider{url, client}
+}
+
+func (p *HttpProvider) Name() string {
+	return config.ProviderHttp
+}
+
+func (p *HttpProvider) FetchRates(ctx context.Context, pairs []config.CurrencyPair) ([]models.ExchangeRate, error) {
+	requestUrl, err := url.Parse(p.url)
+	if err != nil {
+		return nil, err
+	}
+	query := requestUrl.Query()
+	query.Set("pairs", config.CurrencyPairs(pairs).String())
+	requestUrl.RawQuery = query.Encode()
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, requestUrl.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("Accept", "application/json")
+
+	response, err := p.client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s responded with status %d", p.url, response.StatusCode)
+	}
+
+	exchangeRates, err := decodeExchangeRates(response.Body)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", p.url, err)
+	}
+	return exchangeRates, nil
+}
+
+func decodeExchangeRates(reader io.Reader) ([]models.ExchangeRate, error) {
+	exchangeRates := []models.ExchangeRate{}
+	if err := json.NewDecoder(reader).Decode(&exchangeRates); err != nil {
+		return nil, fmt.Errorf("can't decode exchange rates: %w", err)
+	}
+	return exchangeRates, nil
+}
+
+// NewStubHandler serves rates like a provider of HttpProvider, so ingestion can be run without network access.
+// Rates are filtered by pairs query parameter, all of them are returned when it is missing.
+func NewStubHandler(exchangeRates []models.ExchangeRate) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		requested := map[string]bool{}
+		for _, pair := range strings.Split(r.URL.Query().Get("pairs"), ",") {
+			if pair != "" {
+				requested[strings.ToUpper(pair)] = true
+			}
+		}
+
+		response := []models.ExchangeRate{}
+		for _, exchangeRate := range exchangeRates {
+			if len(requested) == 0 || requested[exchangeRate.Source+"/"+exchangeRate.Destination] {
+				response = append(response, exchangeRate)
+			}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(response)
+	})
+}
@@ -0,0 +1,130 @@
+package ingestion
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/kolan92/exchange-rate-api/config"
+	customerros "github.com/kolan92/exchange-rate-api/custom-erros"
+	"github.com/kolan92/exchange-rate-api/models"
+	"github.com/kolan92/exchange-rate-api/repositories"
+	"github.com/kolan92/exchange-rate-api/validators"
+	"go.uber.org/zap"
+)
+
+// Scheduler fetches rates of configured pairs and inserts them through CurrenciesRepository,
+// so they are validated, published and evaluated by alerts like rates inserted by the api.
+// Rates updated on conflict are published and evaluated too.
+type Scheduler struct {
+	provider RateProvider
+	repo     repositories.CurrenciesRepository
+	runsRepo repositories.IngestionRunsRepository
+	cfg      config.IngestionConfig
+	// running prevents overlapping runs, e.g. when run is triggered while scheduled one is in progress.
+	running sync.Mutex
+}
+
+func NewScheduler(provider RateProvider, repo repositories.CurrenciesRepository, runsRepo repositories.IngestionRunsRepository, cfg config.IngestionConfig) *Scheduler {
+	return &Scheduler{provider: provider, repo: repo, runsRepo: runsRepo, cfg: cfg}
+}
+
+// Run fetches rates immediately and then every interval until ctx is done.
+func (s *Scheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		s.RunOnce(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunOnce fetches and stores rates once and records outcome of the run. Error of the run is returned
+// in ErrorMessage, rates stored before the error are kept.
+func (s *Scheduler) RunOnce(ctx context.Context) models.IngestionRun {
+	s.running.Lock()
+	defer s.running.Unlock()
+
+	run := models.IngestionRun{Provider: s.provider.Name(), StartedAt: time.Now().UTC()}
+
+	runCtx, cancel := context.WithTimeout(ctx, s.cfg.Timeout)
+	err := s.ingest(runCtx, &run)
+	cancel()
+
+	run.FinishedAt = time.Now().UTC()
+	logger := zap.L().With(zap.String("provider", run.Provider), zap.Int("fetched", run.Fetched), zap.Int("inserted", run.Inserted),
+		zap.Int("updated", run.Updated), zap.Int("skipped", run.Skipped), zap.Int("rejected", run.Rejected))
+	if err != nil {
+		run.ErrorMessage = err.Error()
+		logger.Error("Ingestion run failed", zap.Error(err))
+	} else {
+		logger.Info("Ingestion run finished")
+	}
+
+	// run is recorded even when ctx is cancelled, e.g. on shutdown
+	if err := s.runsRepo.InsertIngestionRun(context.Background(), &run); err != nil {
+		zap.L().Error("Can't record ingestion run", zap.Error(err))
+	}
+	return run
+}
+
+func (s *Scheduler) ingest(ctx context.Context, run *models.IngestionRun) error {
+	exchangeRates, err := s.provider.FetchRates(ctx, s.cfg.Pairs)
+	if err != nil {
+		return err
+	}
+
+	// currencies of configured pairs are inserted when missing, e.g. EUR of ecb provider
+	isConfigured := make(map[config.CurrencyPair]bool, len(s.cfg.Pairs))
+	codes := make([]string, 0, 2*len(s.cfg.Pairs))
+	for _, pair := range s.cfg.Pairs {
+		isConfigured[pair] = true
+		codes = append(codes, pair.Source, pair.Destination)
+	}
+	if err := s.repo.InsertCurrenciesCodes(ctx, codes); err != nil {
+		return err
+	}
+
+	currencyCodesMap := s.repo.GetCurrenciesCodesIdsMap(ctx)
+	for i := range exchangeRates {
+		exchangeRate := &exchangeRates[i]
+		if !isConfigured[config.CurrencyPair{Source: exchangeRate.Source, Destination: exchangeRate.Destination}] {
+			continue
+		}
+		run.Fetched++
+
+		if err := validators.ValidateNewExchangeRate(exchangeRate, currencyCodesMap); err != nil {
+			zap.L().Warn("Rejected fetched exchange rate", zap.String("source", exchangeRate.Source),
+				zap.String("destination", exchangeRate.Destination), zap.Time("date", exchangeRate.Date), zap.Error(err))
+			run.Rejected++
+			continue
+		}
+
+		err := s.repo.InsertExchangeRate(ctx, exchangeRate)
+		switch {
+		case err == nil:
+			run.Inserted++
+		case errors.Is(err, customerros.ErrDuplicateKeyViolation) && s.cfg.OnConflict == config.OnConflictUpdate:
+			if err := s.repo.UpdateExchangeRate(ctx, exchangeRate); err != nil {
+				return err
+			}
+			run.Updated++
+		case errors.Is(err, customerros.ErrDuplicateKeyViolation):
+			run.Skipped++
+		case customerros.Code(err) == customerros.CodeInvertedPair:
+			zap.L().Warn("Rejected fetched exchange rate", zap.String("source", exchangeRate.Source),
+				zap.String("destination", exchangeRate.Destination), zap.Time("date", exchangeRate.Date), zap.Error(err))
+			run.Rejected++
+		default:
+			return err
+		}
+	}
+	return nil
+}
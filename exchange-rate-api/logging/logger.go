@@ -0,0 +1,79 @@
+package logging
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+type loggerKey struct{}
+
+// New returns logger writing json lines to stdout, with level set to debug, info, warn or error.
+func New(level string) (*zap.Logger, error) {
+	cfg, err := newConfig(level)
+	if err != nil {
+		return nil, err
+	}
+	return cfg.Build()
+}
+
+// NewCommandLogger returns logger of command line tools, it writes to stderr so it doesn't mix with their output.
+func NewCommandLogger(level string) (*zap.Logger, error) {
+	cfg, err := newConfig(level)
+	if err != nil {
+		return nil, err
+	}
+	cfg.OutputPaths = []string{"stderr"}
+	cfg.DisableStacktrace = true
+	return cfg.Build()
+}
+
+func newConfig(level string) (zap.Config, error) {
+	zapLevel, err := zapcore.ParseLevel(level)
+	if err != nil {
+		return zap.Config{}, err
+	}
+
+	cfg := zap.NewProductionConfig()
+	cfg.Level = zap.NewAtomicLevelAt(zapLevel)
+	cfg.Sampling = nil
+	cfg.OutputPaths = []string{"stdout"}
+	cfg.EncoderConfig.TimeKey = "time"
+	cfg.EncoderConfig.EncodeTime = zapcore.ISO8601TimeEncoder
+
+	return cfg, nil
+}
+
+// WithLogger returns context carrying logger, e.g. with request id of the request.
+func WithLogger(ctx context.Context, logger *zap.Logger) context.Context {
+	return context.WithValue(ctx, loggerKey{}, logger)
+}
+
+// FromContext returns logger of the request, or global logger outside of requests.
+// Trace id is added when context carries recorded span, so log lines can be found from traces.
+func FromContext(ctx context.Context) *zap.Logger {
+	logger, isFound := ctx.Value(loggerKey{}).(*zap.Logger)
+	if !isFound {
+		logger = zap.L()
+	}
+
+	if spanContext := trace.SpanContextFromContext(ctx); spanContext.IsValid() {
+		logger = logger.With(zap.String("traceId", spanContext.TraceID().String()))
+	}
+	return logger
+}
+
+// Detach returns context for work which outlives the request, e.g. in goroutine. It keeps logger, request id and trace
+// of the request, but not its cancellation.
+func Detach(ctx context.Context) context.Context {
+	detached := trace.ContextWithSpanContext(context.Background(), trace.SpanContextFromContext(ctx))
+	if logger, isFound := ctx.Value(loggerKey{}).(*zap.Logger); isFound {
+		detached = WithLogger(detached, logger)
+	}
+	if requestId := RequestId(ctx); requestId != "" {
+		detached = context.WithValue(detached, requestIdKey{}, requestId)
+	}
+	return detached
+}
@@ -0,0 +1,114 @@
+package logging
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"regexp"
+	"runtime/debug"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	customerros "github.com/kolan92/exchange-rate-api/custom-erros"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const RequestIdHeader = "X-Request-ID"
+
+type requestIdKey struct{}
+
+// validRequestId accepts ids sent by proxies and clients, anything else is replaced, so it can't forge log lines.
+var validRequestId = regexp.MustCompile(`^[A-Za-z0-9._:-]{1,128}$`)
+
+func newRequestId() string {
+	bytes := make([]byte, 16)
+	if _, err := rand.Read(bytes); err != nil {
+		return "unknown"
+	}
+	return hex.EncodeToString(bytes)
+}
+
+func requestIdOrNew(requestId string) string {
+	if validRequestId.MatchString(requestId) {
+		return requestId
+	}
+	return newRequestId()
+}
+
+// RequestId returns id of the request from context, empty outside of requests.
+func RequestId(ctx context.Context) string {
+	requestId, _ := ctx.Value(requestIdKey{}).(string)
+	return requestId
+}
+
+func withRequestId(ctx context.Context, logger *zap.Logger, requestId string) context.Context {
+	ctx = context.WithValue(ctx, requestIdKey{}, requestId)
+	return WithLogger(ctx, logger.With(zap.String("requestId", requestId)))
+}
+
+// Middleware takes request id from X-Request-ID header or generates new one, returns it in response header
+// and adds it to logger of the request. Every request is logged when finished, panics are logged and answered with 500.
+func Middleware(logger *zap.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+		requestId := requestIdOrNew(c.GetHeader(RequestIdHeader))
+		c.Header(RequestIdHeader, requestId)
+		c.Request = c.Request.WithContext(withRequestId(c.Request.Context(), logger, requestId))
+
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				FromContext(c.Request.Context()).Error("Request panicked",
+					zap.Any("panic", recovered),
+					zap.ByteString("stack", debug.Stack()))
+				customerros.Abort(c, errors.New("request panicked"), requestId)
+			}
+
+			FromContext(c.Request.Context()).Info("Request handled",
+				zap.String("method", c.Request.Method),
+				zap.String("path", c.Request.URL.Path),
+				zap.String("route", c.FullPath()),
+				zap.Int("status", c.Writer.Status()),
+				zap.Duration("duration", time.Since(start)),
+				zap.String("clientIp", c.ClientIP()))
+		}()
+
+		c.Next()
+	}
+}
+
+// UnaryServerInterceptor does the same as Middleware for grpc, request id is read from and returned in x-request-id metadata.
+func UnaryServerInterceptor(logger *zap.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, request interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (response interface{}, err error) {
+		start := time.Now()
+		requestId := ""
+		if md, isFound := metadata.FromIncomingContext(ctx); isFound {
+			if values := md.Get(RequestIdHeader); len(values) > 0 {
+				requestId = values[0]
+			}
+		}
+		requestId = requestIdOrNew(requestId)
+		_ = grpc.SetHeader(ctx, metadata.Pairs(RequestIdHeader, requestId))
+		ctx = withRequestId(ctx, logger, requestId)
+
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				FromContext(ctx).Error("Request panicked",
+					zap.Any("panic", recovered),
+					zap.ByteString("stack", debug.Stack()))
+				err = status.Error(codes.Internal, "Internal server error")
+			}
+
+			FromContext(ctx).Info("Request handled",
+				zap.String("method", info.FullMethod),
+				zap.String("code", status.Code(err).String()),
+				zap.Duration("duration", time.Since(start)))
+		}()
+
+		return handler(ctx, request)
+	}
+}
@@ -0,0 +1,100 @@
+package logging
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+var (
+	logs   *observer.ObservedLogs
+	router *gin.Engine
+)
+
+func setup() {
+	core, observedLogs := observer.New(zapcore.InfoLevel)
+	logs = observedLogs
+
+	gin.SetMode(gin.TestMode)
+	router = gin.New()
+	router.Use(Middleware(zap.New(core)))
+	router.GET("/currencies", func(c *gin.Context) {
+		FromContext(c.Request.Context()).Info("Handling request")
+		c.JSON(http.StatusOK, []string{})
+	})
+	router.GET("/panic", func(c *gin.Context) {
+		panic("unexpected")
+	})
+}
+
+func get(path, requestId string) *httptest.ResponseRecorder {
+	recorder := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodGet, path, nil)
+	if requestId != "" {
+		request.Header.Set(RequestIdHeader, requestId)
+	}
+	router.ServeHTTP(recorder, request)
+	return recorder
+}
+
+func TestMiddlewareKeepsRequestIdOfCaller(t *testing.T) {
+	setup()
+
+	recorder := get("/currencies", "abc-123")
+
+	assert.Equal(t, "abc-123", recorder.Header().Get(RequestIdHeader))
+	assert.Equal(t, 2, logs.FilterField(zap.String("requestId", "abc-123")).Len())
+}
+
+func TestMiddlewareGeneratesRequestId(t *testing.T) {
+	setup()
+
+	recorder := get("/currencies", "")
+
+	requestId := recorder.Header().Get(RequestIdHeader)
+	assert.Len(t, requestId, 32)
+	assert.Equal(t, 2, logs.FilterField(zap.String("requestId", requestId)).Len())
+}
+
+func TestMiddlewareReplacesInvalidRequestId(t *testing.T) {
+	setup()
+
+	recorder := get("/currencies", "forged\"} {\"level\":\"error")
+
+	assert.Len(t, recorder.Header().Get(RequestIdHeader), 32)
+}
+
+func TestMiddlewareRecoversPanicWithRequestId(t *testing.T) {
+	setup()
+
+	recorder := get("/panic", "abc-123")
+
+	assert.Equal(t, http.StatusInternalServerError, recorder.Code)
+	assert.JSONEq(t, `{
+		"type": "urn:exchange-rate-api:problem:internal_error",
+		"title": "Internal server error",
+		"status": 500,
+		"instance": "/panic",
+		"code": "internal_error",
+		"requestId": "abc-123"
+	}`, recorder.Body.String())
+	assert.Equal(t, 1, logs.FilterMessage("Request panicked").FilterField(zap.String("requestId", "abc-123")).Len())
+}
+
+func TestDetachKeepsRequestId(t *testing.T) {
+	ctx := withRequestId(context.Background(), zap.NewNop(), "abc-123")
+	ctx, cancel := context.WithCancel(ctx)
+	cancel()
+
+	detached := Detach(ctx)
+
+	assert.Equal(t, "abc-123", RequestId(detached))
+	assert.NoError(t, detached.Err())
+}
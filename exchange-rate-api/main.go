@@ -1,97 +1,252 @@
 package main
 
 import (
-	"fmt"
+	"context"
+	"errors"
+	"flag"
 	"log"
 	"net/http"
 	"os"
+	"path"
 
 	"github.com/gin-gonic/gin"
+	"github.com/kolan92/exchange-rate-api/alerts"
+	"github.com/kolan92/exchange-rate-api/auth"
+	"github.com/kolan92/exchange-rate-api/config"
 	"github.com/kolan92/exchange-rate-api/controllers"
+	"github.com/kolan92/exchange-rate-api/conversion"
 	docs "github.com/kolan92/exchange-rate-api/docs"
+	"github.com/kolan92/exchange-rate-api/events"
+	graphqlapi "github.com/kolan92/exchange-rate-api/graphql-api"
+	grpcserver "github.com/kolan92/exchange-rate-api/grpc-server"
+	"github.com/kolan92/exchange-rate-api/ingestion"
+	"github.com/kolan92/exchange-rate-api/logging"
+	"github.com/kolan92/exchange-rate-api/metrics"
+	exchangeratepb "github.com/kolan92/exchange-rate-api/proto"
+	"github.com/kolan92/exchange-rate-api/ratelimit"
 	"github.com/kolan92/exchange-rate-api/repositories"
+	"github.com/kolan92/exchange-rate-api/tracing"
 	"github.com/shopspring/decimal"
 	swaggerfiles "github.com/swaggo/files"
 	ginSwagger "github.com/swaggo/gin-swagger"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
 )
 
 // @title Rate Exchange API
 // @version 1.0
 // @description Provides basic functionality for checking currency exchange rate.
+// @description Rates are quoted as base/quote: one unit of source currency is rate units of destination currency, e.g. USD/CHF 0.9774.
 // @termsOfService http://swagger.io/terms/
 
 // @license.name Apache 2.0
 // @license.url http://www.apache.org/licenses/LICENSE-2.0.html
 
 // @BasePath /
+
+// @securityDefinitions.apikey ApiKeyAuth
+// @in header
+// @name X-API-Key
 // @schemes http
 
 func main() {
-	docs.SwaggerInfo.BasePath = "/api/v1"
-	docs.SwaggerInfo.Host = "localhost:8081"
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "migrate":
+			runMigrate(os.Args[2:])
+			return
+		case "rates":
+			runRates(os.Args[2:])
+			return
+		}
+	}
+
+	cfg, err := config.Load(os.Args[1:])
+	if errors.Is(err, flag.ErrHelp) {
+		return
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	docs.SwaggerInfo.BasePath = cfg.Server.BasePath
+	docs.SwaggerInfo.Host = cfg.Server.SwaggerHost
 	decimal.MarshalJSONWithoutQuotes = true
 
-	log.Println("Starting exchange rate api...")
+	if cfg.LogLevel != "debug" {
+		gin.SetMode(gin.ReleaseMode)
+	}
+
+	logger, err := logging.New(cfg.LogLevel)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer logger.Sync()
+	zap.ReplaceGlobals(logger)
+	zap.RedirectStdLog(logger)
+
+	logger.Info("Starting exchange rate api")
+
+	shutdownTracing, err := tracing.Setup(context.Background(), cfg.Tracing)
+	if err != nil {
+		logger.Fatal("Can't set up tracing", zap.Error(err))
+	}
+
+	db, storedRepo := openStorage(cfg.Database)
+
+	currenciesRepo := tracing.NewTracingRepository(storedRepo)
+	var appMetrics *metrics.Metrics
+	if cfg.Features.Metrics {
+		appMetrics = metrics.New()
+		currenciesRepo = metrics.NewInstrumentedRepository(currenciesRepo, appMetrics)
+	}
+
+	// alerts and api keys are stored only in postgres
+	var alertsRepo repositories.AlertsRepository
+	var apiKeysRepo repositories.ApiKeysRepository
+	if cfg.Database.Backend == config.BackendPostgres {
+		alertsRepo = repositories.NewPostgresAlertsRepository(db)
+		apiKeysRepo = repositories.NewPostgresApiKeysRepository(db)
+	} else if cfg.Features.Alerts {
+		logger.Warn("Alerts are disabled, they require postgres backend")
+		cfg.Features.Alerts = false
+	}
+
+	repo := currenciesRepo
+	if cfg.Features.Alerts {
+		evaluator := alerts.NewEvaluator(currenciesRepo, alertsRepo, alerts.NewDispatcher(alertsRepo))
+		repo = alerts.NewAlertingRepository(repo, evaluator)
+	}
+
+	broker := events.NewBroker()
+	if cfg.Features.Subscriptions {
+		repo = events.NewPublishingRepository(repo, broker)
+	}
 
-	connectionString := getConnectionString()
-	repo := repositories.NewPostgresCurrenciesRepository(connectionString)
+	// read apis serve pairs in both directions, converter inverts rates itself without rounding them
+	readRepo := repositories.NewInvertingCurrenciesRepository(repo)
 
-	controller := controllers.NewExchangeRatesController(repo)
+	router := gin.New()
+	if err := router.SetTrustedProxies(cfg.Server.TrustedProxies); err != nil {
+		logger.Fatal("Can't set trusted proxies", zap.Error(err))
+	}
+	router.NoRoute(controllers.NoRoute)
+	router.Use(logging.Middleware(logger))
+	router.Use(tracing.Middleware())
+	if cfg.Features.Metrics {
+		router.Use(appMetrics.Middleware())
+		if db != nil {
+			if sqlDb, err := db.DB(); err == nil {
+				appMetrics.RegisterDatabase(sqlDb, cfg.Database.Backend)
+			}
+		}
+		appMetrics.RegisterFreshness(currenciesRepo)
+		router.GET("/metrics", gin.WrapH(appMetrics.Handler()))
+	}
 
-	router := gin.Default()
-	v1 := router.Group("/api/v1")
+	v1 := router.Group(cfg.Server.BasePath)
 	{
 		v1.GET("/check", HealthCheck)
 	}
-	controller.RegisterRouter(v1)
 
-	router.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerfiles.Handler))
-	router.Run(":8081")
-}
+	// routes registered below require credentials when auth is enabled
+	graphqlHandlers := []gin.HandlerFunc{}
+	grpcInterceptors := []grpc.UnaryServerInterceptor{
+		otelgrpc.UnaryServerInterceptor(),
+		logging.UnaryServerInterceptor(logger),
+	}
+	rateLimitStore := ratelimit.NewMemoryStore()
+	rateLimits := ratelimit.NewLimits(cfg.RateLimit)
+	if cfg.RateLimit.Enabled {
+		ipRateLimiter := ratelimit.IpMiddleware(rateLimitStore, rateLimits)
+		v1.Use(ipRateLimiter)
+		graphqlHandlers = append(graphqlHandlers, ipRateLimiter)
+	}
 
-// @Summary healthcheck
-// @Tags	healthcheck
-// @Schemes
-// @Description basic healthcheck
-// @Produce json
-// @Success 200 {object} map[string]string
-// @Router /check [get]
-func HealthCheck(g *gin.Context) {
-	g.JSON(http.StatusOK, gin.H{"check": "ok"})
-}
+	if cfg.Auth.Enabled {
+		authenticator := auth.NewAuthenticator(apiKeysRepo, cfg.Auth)
+		// batch conversion only computes amounts, so read scope is enough
+		v1.Use(auth.Middleware(authenticator), auth.RequireMethodScope(map[string]string{
+			path.Join(cfg.Server.BasePath, "/convert/batch"): auth.ScopeRead,
+		}))
+		graphqlHandlers = append(graphqlHandlers, auth.Middleware(authenticator), auth.RequireScope(auth.ScopeRead))
+		grpcInterceptors = append(grpcInterceptors, auth.UnaryServerInterceptor(authenticator, map[string]string{
+			exchangeratepb.ExchangeRates_InsertExchangeRate_FullMethodName: auth.ScopeWrite,
+		}))
+	}
 
-func getConnectionString() string {
-	const missingEnvVariableLog = "Missing required env variable: %s"
+	if cfg.RateLimit.Enabled {
+		rateLimiter := ratelimit.Middleware(rateLimitStore, rateLimitGroup, rateLimits)
+		v1.Use(rateLimiter)
+		graphqlHandlers = append(graphqlHandlers, rateLimiter)
+	}
+
+	if cfg.Auth.Enabled && apiKeysRepo != nil {
+		controllers.NewApiKeysController(apiKeysRepo).RegisterRouter(v1)
+	}
+
+	controllers.NewExchangeRatesController(readRepo).RegisterRouter(v1)
+	controllers.NewHealthController(newReadinessChecker(cfg.Server, db, repo)).RegisterRouter(&router.RouterGroup)
+	controllers.NewConversionController(conversion.NewConverter(repo)).RegisterRouter(v1)
+
+	if cfg.Features.Subscriptions {
+		controllers.NewSubscriptionsController(repo, broker).RegisterRouter(v1)
+	}
+
+	if cfg.Features.Alerts {
+		controllers.NewAlertsController(repo, alertsRepo).RegisterRouter(v1)
+	}
 
-	const dbUserVar = "DB_USER"
-	dbUser := os.Getenv(dbUserVar)
-	if dbUser == "" {
-		panic(fmt.Sprintf(missingEnvVariableLog, dbUserVar))
+	var scheduler *ingestion.Scheduler
+	if cfg.Ingestion.Enabled {
+		runsRepo := newIngestionRunsRepository(db)
+		scheduler = ingestion.NewScheduler(newRateProvider(cfg.Ingestion), repo, runsRepo, cfg.Ingestion)
+		controllers.NewIngestionController(scheduler, runsRepo).RegisterRouter(v1)
 	}
 
-	const dbPasswordVar = "DB_PASSWORD"
-	dbPassword := os.Getenv(dbPasswordVar)
-	if dbPassword == "" {
-		panic(fmt.Sprintf(missingEnvVariableLog, dbPasswordVar))
+	if cfg.Features.Swagger {
+		router.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerfiles.Handler))
 	}
 
-	const dbNameVar = "DB_NAME"
-	dbName := os.Getenv(dbNameVar)
-	if dbName == "" {
-		panic(fmt.Sprintf(missingEnvVariableLog, dbNameVar))
+	if cfg.Features.Graphql {
+		router.POST("/graphql", append(graphqlHandlers, gin.WrapH(graphqlapi.NewHandler(readRepo)))...)
 	}
 
-	const dbHostVar = "DB_HOST"
-	dbHost := os.Getenv(dbHostVar)
-	if dbHost == "" {
-		panic(fmt.Sprintf(missingEnvVariableLog, dbHostVar))
+	var grpcServer *grpc.Server
+	if cfg.Features.Grpc {
+		grpcServer = grpc.NewServer(grpc.ChainUnaryInterceptor(grpcInterceptors...))
+		grpcserver.NewExchangeRatesServer(readRepo).Register(grpcServer)
 	}
 
-	const dbPostVar = "DB_PORT"
-	dbPort := os.Getenv(dbPostVar)
-	if dbPort == "" {
-		panic(fmt.Sprintf(missingEnvVariableLog, dbPostVar))
+	server := newServer(cfg.Server, router, grpcServer, db)
+	server.onShutdown(broker.Close)
+	if scheduler != nil {
+		ingestionCtx, stopIngestion := context.WithCancel(context.Background())
+		server.onShutdown(stopIngestion)
+		go scheduler.Run(ingestionCtx)
 	}
+	runErr := server.run()
 
-	return fmt.Sprintf("postgresql://%s:%s@%s:%s/%s", dbUser, dbPassword, dbHost, dbPort, dbName)
+	ctx, cancel := context.WithTimeout(context.Background(), cfg.Server.ShutdownTimeout)
+	defer cancel()
+	if err := shutdownTracing(ctx); err != nil {
+		logger.Error("Can't export remaining spans", zap.Error(err))
+	}
+
+	// non-zero exit code lets restart policy of the container restart failed server
+	if runErr != nil {
+		logger.Fatal("Exchange rate api failed", zap.Error(runErr))
+	}
+}
+
+// @Summary healthcheck
+// @Tags	healthcheck
+// @Schemes
+// @Description basic healthcheck
+// @Produce json
+// @Success 200 {object} map[string]string
+// @Router /check [get]
+func HealthCheck(g *gin.Context) {
+	g.JSON(http.StatusOK, gin.H{"check": "ok"})
 }
@@ -0,0 +1,42 @@
+package metrics
+
+import (
+	"context"
+
+	"github.com/kolan92/exchange-rate-api/repositories"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+)
+
+var newestRateDateDesc = prometheus.NewDesc(
+	prometheus.BuildFQName(namespace, "", "newest_rate_date_seconds"),
+	"Unix time of the newest exchange rate date per currency pair.",
+	[]string{"source", "destination"}, nil,
+)
+
+// freshnessCollector reads newest dates from repository when scraped, so it shows rates inserted by any api instance.
+type freshnessCollector struct {
+	repo repositories.CurrenciesRepository
+}
+
+func newFreshnessCollector(repo repositories.CurrenciesRepository) *freshnessCollector {
+	return &freshnessCollector{repo}
+}
+
+func (c *freshnessCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- newestRateDateDesc
+}
+
+func (c *freshnessCollector) Collect(ch chan<- prometheus.Metric) {
+	exchangeRates, err := c.repo.GetNewestExchangeRates(context.Background())
+	if err != nil {
+		zap.L().Error("Can't collect newest exchange rate dates", zap.Error(err))
+		ch <- prometheus.NewInvalidMetric(newestRateDateDesc, err)
+		return
+	}
+
+	for _, exchangeRate := range exchangeRates {
+		ch <- prometheus.MustNewConstMetric(newestRateDateDesc, prometheus.GaugeValue,
+			float64(exchangeRate.Date.Unix()), exchangeRate.Source, exchangeRate.Destination)
+	}
+}
@@ -0,0 +1,141 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	customerros "github.com/kolan92/exchange-rate-api/custom-erros"
+	"github.com/kolan92/exchange-rate-api/models"
+	"github.com/kolan92/exchange-rate-api/repositories"
+)
+
+const (
+	resultOk       = "ok"
+	resultError    = "error"
+	resultInserted = "inserted"
+	resultConflict = "conflict"
+)
+
+// InstrumentedRepository times every method of the wrapped repository and counts inserted exchange rates.
+type InstrumentedRepository struct {
+	repo    repositories.CurrenciesRepository
+	metrics *Metrics
+}
+
+func NewInstrumentedRepository(repo repositories.CurrenciesRepository, metrics *Metrics) repositories.CurrenciesRepository {
+	return &InstrumentedRepository{repo, metrics}
+}
+
+func (r *InstrumentedRepository) observe(method string, start time.Time, err error) {
+	result := resultOk
+	if err != nil {
+		result = resultError
+	}
+	r.metrics.repositoryDuration.WithLabelValues(method, result).Observe(time.Since(start).Seconds())
+}
+
+func (r *InstrumentedRepository) GetCurrenciesCodesIdsMap(ctx context.Context) map[string]int {
+	defer r.observe("GetCurrenciesCodesIdsMap", time.Now(), nil)
+	return r.repo.GetCurrenciesCodesIdsMap(ctx)
+}
+
+func (r *InstrumentedRepository) InsertCurrenciesCodes(ctx context.Context, codes []string) error {
+	start := time.Now()
+	err := r.repo.InsertCurrenciesCodes(ctx, codes)
+	r.observe("InsertCurrenciesCodes", start, err)
+	return err
+}
+
+func (r *InstrumentedRepository) GetCurrenciesCodes(ctx context.Context) []string {
+	defer r.observe("GetCurrenciesCodes", time.Now(), nil)
+	return r.repo.GetCurrenciesCodes(ctx)
+}
+
+func (r *InstrumentedRepository) GetLastExchangeRate(ctx context.Context, sourceCurrencyId, destinationCurrencyId int) (*models.ExchangeRate, error) {
+	start := time.Now()
+	exchangeRate, err := r.repo.GetLastExchangeRate(ctx, sourceCurrencyId, destinationCurrencyId)
+	r.observe("GetLastExchangeRate", start, err)
+	return exchangeRate, err
+}
+
+func (r *InstrumentedRepository) GetLastExchangeRates(ctx context.Context, currencyPairs []models.CurrencyPair) ([]models.ExchangeRate, error) {
+	start := time.Now()
+	exchangeRates, err := r.repo.GetLastExchangeRates(ctx, currencyPairs)
+	r.observe("GetLastExchangeRates", start, err)
+	return exchangeRates, err
+}
+
+func (r *InstrumentedRepository) GetAllExchangeRatesFromDate(ctx context.Context, date time.Time) ([]models.ExchangeRate, error) {
+	start := time.Now()
+	exchangeRates, err := r.repo.GetAllExchangeRatesFromDate(ctx, date)
+	r.observe("GetAllExchangeRatesFromDate", start, err)
+	return exchangeRates, err
+}
+
+func (r *InstrumentedRepository) GetRangeExchangeRate(ctx context.Context, sourceCurrencyId, destinationCurrencyId int, from, till *time.Time) ([]models.ExchangeRate, error) {
+	start := time.Now()
+	exchangeRates, err := r.repo.GetRangeExchangeRate(ctx, sourceCurrencyId, destinationCurrencyId, from, till)
+	r.observe("GetRangeExchangeRate", start, err)
+	return exchangeRates, err
+}
+
+func (r *InstrumentedRepository) GetRangeExchangeRates(ctx context.Context, currencyPairs []models.CurrencyPair, from, till *time.Time) ([]models.ExchangeRate, error) {
+	start := time.Now()
+	exchangeRates, err := r.repo.GetRangeExchangeRates(ctx, currencyPairs, from, till)
+	r.observe("GetRangeExchangeRates", start, err)
+	return exchangeRates, err
+}
+
+func (r *InstrumentedRepository) GetNewestExchangeRateDate(ctx context.Context) (*time.Time, error) {
+	start := time.Now()
+	newestDate, err := r.repo.GetNewestExchangeRateDate(ctx)
+	r.observe("GetNewestExchangeRateDate", start, err)
+	return newestDate, err
+}
+
+func (r *InstrumentedRepository) GetNewestExchangeRates(ctx context.Context) ([]models.ExchangeRate, error) {
+	start := time.Now()
+	exchangeRates, err := r.repo.GetNewestExchangeRates(ctx)
+	r.observe("GetNewestExchangeRates", start, err)
+	return exchangeRates, err
+}
+
+// InsertExchangeRate reports duplicates as conflicts, they are expected when rates are loaded again.
+func (r *InstrumentedRepository) InsertExchangeRate(ctx context.Context, exchangeRate *models.ExchangeRate) error {
+	start := time.Now()
+	err := r.repo.InsertExchangeRate(ctx, exchangeRate)
+	r.observe("InsertExchangeRate", start, err)
+
+	switch {
+	case err == nil:
+		r.metrics.insertedRates.WithLabelValues(resultInserted).Inc()
+	case errors.Is(err, customerros.ErrDuplicateKeyViolation):
+		r.metrics.insertedRates.WithLabelValues(resultConflict).Inc()
+	default:
+		r.metrics.insertedRates.WithLabelValues(resultError).Inc()
+	}
+	return err
+}
+
+func (r *InstrumentedRepository) UpdateExchangeRate(ctx context.Context, exchangeRate *models.ExchangeRate) error {
+	start := time.Now()
+	err := r.repo.UpdateExchangeRate(ctx, exchangeRate)
+	r.observe("UpdateExchangeRate", start, err)
+	return err
+}
+
+// ImportExchangeRates counts skipped rates as conflicts, updated ones are not counted like UpdateExchangeRate.
+func (r *InstrumentedRepository) ImportExchangeRates(ctx context.Context, exchangeRates []models.ExchangeRate, replace bool) (models.ImportSummary, error) {
+	start := time.Now()
+	summary, err := r.repo.ImportExchangeRates(ctx, exchangeRates, replace)
+	r.observe("ImportExchangeRates", start, err)
+
+	if err != nil {
+		r.metrics.insertedRates.WithLabelValues(resultError).Add(float64(len(exchangeRates)))
+		return summary, err
+	}
+	r.metrics.insertedRates.WithLabelValues(resultInserted).Add(float64(summary.Inserted))
+	r.metrics.insertedRates.WithLabelValues(resultConflict).Add(float64(summary.Skipped))
+	return summary, nil
+}
@@ -0,0 +1,88 @@
+package metrics
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	customerros "github.com/kolan92/exchange-rate-api/custom-erros"
+	"github.com/kolan92/exchange-rate-api/models"
+	"github.com/kolan92/exchange-rate-api/repositories"
+	testhelpers "github.com/kolan92/exchange-rate-api/testHelpers"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	repository *testhelpers.MockRepository
+	appMetrics *Metrics
+)
+
+func setup() {
+	repository = testhelpers.NewMockRepository()
+	appMetrics = New()
+}
+
+func TestMiddlewareLabelsRequestsWithRoutePattern(t *testing.T) {
+	setup()
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(appMetrics.Middleware())
+	router.GET("/alerts/:id", func(c *gin.Context) { c.Status(http.StatusNoContent) })
+
+	for _, path := range []string{"/alerts/1", "/alerts/2", "/missing"} {
+		router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, path, nil))
+	}
+
+	assert.Equal(t, 2.0, testutil.ToFloat64(appMetrics.requests.WithLabelValues("/alerts/:id", "GET", "204")))
+	assert.Equal(t, 1.0, testutil.ToFloat64(appMetrics.requests.WithLabelValues(unmatchedRoute, "GET", "404")))
+}
+
+func TestInstrumentedRepositoryCountsInsertResults(t *testing.T) {
+	setup()
+	repo := NewInstrumentedRepository(repository, appMetrics)
+
+	assert.NoError(t, repo.InsertExchangeRate(context.Background(), &models.ExchangeRate{}))
+	repository.InsertExchangeRateError = customerros.ErrDuplicateKeyViolation
+	assert.ErrorIs(t, repo.InsertExchangeRate(context.Background(), &models.ExchangeRate{}), customerros.ErrDuplicateKeyViolation)
+
+	assert.Equal(t, 1.0, testutil.ToFloat64(appMetrics.insertedRates.WithLabelValues(resultInserted)))
+	assert.Equal(t, 1.0, testutil.ToFloat64(appMetrics.insertedRates.WithLabelValues(resultConflict)))
+	assert.Equal(t, 2, testutil.CollectAndCount(appMetrics.repositoryDuration))
+}
+
+func TestFreshnessReportsNewestDatePerPair(t *testing.T) {
+	setup()
+	repository.NewestExchangeRates = []models.ExchangeRate{
+		{Source: "CHF", Destination: "USD", Date: time.Date(2022, 05, 01, 0, 00, 00, 0, time.UTC)},
+	}
+
+	expected := `
+# HELP exchange_rate_api_newest_rate_date_seconds Unix time of the newest exchange rate date per currency pair.
+# TYPE exchange_rate_api_newest_rate_date_seconds gauge
+exchange_rate_api_newest_rate_date_seconds{destination="USD",source="CHF"} 1.6513632e+09
+`
+	assert.NoError(t, testutil.CollectAndCompare(newFreshnessCollector(repository), strings.NewReader(expected)))
+}
+
+func TestRegisterDatabaseLabelsPoolWithDatabaseName(t *testing.T) {
+	setup()
+	db := repositories.ConnectSqlite(filepath.Join(t.TempDir(), "rates.db"))
+	sqlDb, err := db.DB()
+	assert.NoError(t, err)
+	defer sqlDb.Close()
+
+	appMetrics.RegisterDatabase(sqlDb, "sqlite")
+
+	expected := `
+# HELP go_sql_max_open_connections Maximum number of open connections to the database.
+# TYPE go_sql_max_open_connections gauge
+go_sql_max_open_connections{db_name="sqlite"} 0
+`
+	assert.NoError(t, testutil.GatherAndCompare(appMetrics.registry, strings.NewReader(expected), "go_sql_max_open_connections"))
+}
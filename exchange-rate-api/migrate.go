@@ -0,0 +1,117 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"text/tabwriter"
+
+	"github.com/kolan92/exchange-rate-api/config"
+	"github.com/kolan92/exchange-rate-api/logging"
+	"github.com/kolan92/exchange-rate-api/migrations"
+	"github.com/kolan92/exchange-rate-api/repositories"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+const migrateUsage = "usage: exchange-rate-api migrate up|down|status [flags]"
+
+// runMigrate handles migrate subcommand: up applies pending migrations, down reverts the last one
+// and status lists migrations. Remaining arguments are the same flags as of the api.
+func runMigrate(args []string) {
+	if len(args) == 0 || (args[0] != "up" && args[0] != "down" && args[0] != "status") {
+		log.Fatal(migrateUsage)
+	}
+	command := args[0]
+
+	cfg, err := config.Load(args[1:])
+	if errors.Is(err, flag.ErrHelp) {
+		return
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	logger, err := logging.NewCommandLogger(cfg.LogLevel)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer logger.Sync()
+	zap.ReplaceGlobals(logger)
+
+	db, err := connectDatabase(cfg.Database)
+	if err != nil {
+		logger.Fatal("Can't migrate database", zap.Error(err))
+	}
+	migrator, err := migrations.New(db, cfg.Database.MigrateData)
+	if err != nil {
+		logger.Fatal("Can't load migrations", zap.Error(err))
+	}
+
+	ctx := context.Background()
+	switch command {
+	case "up":
+		applied, err := migrator.Up(ctx)
+		if err != nil {
+			logger.Fatal("Can't apply migrations", zap.Error(err))
+		}
+		logger.Info("Database is up to date", zap.Int("applied", len(applied)))
+	case "down":
+		reverted, err := migrator.Down(ctx)
+		if err != nil {
+			logger.Fatal("Can't revert migration", zap.Error(err))
+		}
+		if reverted == nil {
+			logger.Info("No migration to revert")
+		}
+	case "status":
+		statuses, err := migrator.Status(ctx)
+		if err != nil {
+			logger.Fatal("Can't read migrations status", zap.Error(err))
+		}
+		printMigrationsStatus(statuses)
+	}
+}
+
+// connectDatabase opens database of configured backend, memory backend has no schema to migrate.
+func connectDatabase(cfg config.DatabaseConfig) (*gorm.DB, error) {
+	switch cfg.Backend {
+	case config.BackendMemory:
+		return nil, errors.New("memory backend has no database")
+	case config.BackendSqlite:
+		return repositories.ConnectSqlite(cfg.SqlitePath), nil
+	default:
+		return repositories.ConnectPostgres(cfg), nil
+	}
+}
+
+// migrateOnStart applies pending migrations before the api starts serving.
+func migrateOnStart(db *gorm.DB, includeData bool) {
+	migrator, err := migrations.New(db, includeData)
+	if err == nil {
+		_, err = migrator.Up(context.Background())
+	}
+	if err != nil {
+		zap.L().Fatal("Can't migrate database", zap.Error(err))
+	}
+}
+
+func printMigrationsStatus(statuses []migrations.Status) {
+	writer := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(writer, "VERSION\tNAME\tKIND\tAPPLIED AT")
+	for _, status := range statuses {
+		kind := "schema"
+		if status.IsData {
+			kind = "data"
+		}
+		appliedAt := "pending"
+		if status.AppliedAt != nil {
+			appliedAt = status.AppliedAt.Format("2006-01-02 15:04:05")
+		}
+		fmt.Fprintf(writer, "%d\t%s\t%s\t%s\n", status.Version, status.Name, kind, appliedAt)
+	}
+	writer.Flush()
+}
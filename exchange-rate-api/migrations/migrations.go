@@ -0,0 +1,241 @@
+// Package migrations applies versioned schema changes embedded in the binary.
+//
+// Migrations of every dialect are in its directory as <version>_<name>.up.sql and <version>_<name>.down.sql.
+// Migrations named seed_* are data migrations, they are applied only when requested.
+// Applied versions are recorded in schema_version table.
+package migrations
+
+import (
+	"context"
+	"embed"
+	"fmt"
+	"io/fs"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+//go:generate go run ../tools/seedgen -data ../../data -output postgres/0005_seed_base_quote_rates.up.sql -down-output postgres/0005_seed_base_quote_rates.down.sql
+
+//go:embed postgres/*.sql sqlite/*.sql
+var files embed.FS
+
+// baselineTable is created by the first migration, databases created before migrations have it without schema_version.
+const baselineTable = "exchange_rates"
+
+const dataPrefix = "seed_"
+
+var fileNamePattern = regexp.MustCompile(`^(\d+)_(\w+)\.(up|down)\.sql$`)
+
+// Migration is one version of database, Down reverts changes of Up.
+type Migration struct {
+	Version int
+	Name    string
+	// IsData is set for migrations inserting data, e.g. seed exchange rates, instead of changing schema.
+	IsData bool
+	Up     string
+	Down   string
+}
+
+// Status is a migration with time it was applied at, nil when it is pending.
+type Status struct {
+	Migration
+	AppliedAt *time.Time
+}
+
+type schemaVersion struct {
+	Version   int
+	Name      string
+	AppliedAt time.Time
+}
+
+func (schemaVersion) TableName() string {
+	return "schema_version"
+}
+
+// Load returns migrations of dialect, e.g. postgres or sqlite, ordered by version.
+func Load(dialect string) ([]Migration, error) {
+	return load(files, dialect)
+}
+
+func load(fsys fs.FS, dialect string) ([]Migration, error) {
+	entries, err := fs.ReadDir(fsys, dialect)
+	if err != nil {
+		return nil, fmt.Errorf("no migrations of %s dialect: %w", dialect, err)
+	}
+
+	migrationsByVersion := make(map[int]*Migration)
+	for _, entry := range entries {
+		match := fileNamePattern.FindStringSubmatch(entry.Name())
+		if match == nil {
+			return nil, fmt.Errorf("migration file %s doesn't match <version>_<name>.(up|down).sql", entry.Name())
+		}
+		version, _ := strconv.Atoi(match[1])
+		name, direction := match[2], match[3]
+
+		content, err := fs.ReadFile(fsys, path.Join(dialect, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+
+		migration, isFound := migrationsByVersion[version]
+		if !isFound {
+			migration = &Migration{Version: version, Name: name, IsData: strings.HasPrefix(name, dataPrefix)}
+			migrationsByVersion[version] = migration
+		}
+		if migration.Name != name {
+			return nil, fmt.Errorf("migration %d is named both %s and %s", version, migration.Name, name)
+		}
+
+		if direction == "up" {
+			migration.Up = string(content)
+		} else {
+			migration.Down = string(content)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(migrationsByVersion))
+	for _, migration := range migrationsByVersion {
+		if migration.Up == "" || migration.Down == "" {
+			return nil, fmt.Errorf("migration %d_%s needs both up and down file", migration.Version, migration.Name)
+		}
+		migrations = append(migrations, *migration)
+	}
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+
+	return migrations, nil
+}
+
+// Migrator applies migrations of database dialect, each one in its own transaction.
+type Migrator struct {
+	db          *gorm.DB
+	migrations  []Migration
+	includeData bool
+}
+
+// New returns migrator of db dialect, data migrations are applied only when includeData is set.
+func New(db *gorm.DB, includeData bool) (*Migrator, error) {
+	migrations, err := Load(db.Dialector.Name())
+	if err != nil {
+		return nil, err
+	}
+	return &Migrator{db, migrations, includeData}, nil
+}
+
+// Status returns all migrations with time they were applied at.
+func (m *Migrator) Status(ctx context.Context) ([]Status, error) {
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]Status, 0, len(m.migrations))
+	for _, migration := range m.migrations {
+		status := Status{Migration: migration}
+		if version, isApplied := applied[migration.Version]; isApplied {
+			status.AppliedAt = &version.AppliedAt
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses, nil
+}
+
+// Up applies pending migrations in order of versions and returns them. Skipped data migrations stay pending.
+func (m *Migrator) Up(ctx context.Context) ([]Migration, error) {
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	appliedNow := []Migration{}
+	for _, migration := range m.migrations {
+		if _, isApplied := applied[migration.Version]; isApplied || (migration.IsData && !m.includeData) {
+			continue
+		}
+
+		err := m.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			if err := tx.Exec(migration.Up).Error; err != nil {
+				return err
+			}
+			return tx.Create(&schemaVersion{migration.Version, migration.Name, time.Now().UTC()}).Error
+		})
+		if err != nil {
+			return appliedNow, fmt.Errorf("migration %d_%s failed: %w", migration.Version, migration.Name, err)
+		}
+
+		zap.L().Info("Applied migration", zap.Int("version", migration.Version), zap.String("name", migration.Name))
+		appliedNow = append(appliedNow, migration)
+	}
+	return appliedNow, nil
+}
+
+// Down reverts the most recently applied migration and returns it, nil when no migration is applied.
+func (m *Migrator) Down(ctx context.Context) (*Migration, error) {
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := len(m.migrations) - 1; i >= 0; i-- {
+		migration := m.migrations[i]
+		if _, isApplied := applied[migration.Version]; !isApplied {
+			continue
+		}
+
+		err := m.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			if err := tx.Exec(migration.Down).Error; err != nil {
+				return err
+			}
+			return tx.Delete(&schemaVersion{}, "version = ?", migration.Version).Error
+		})
+		if err != nil {
+			return nil, fmt.Errorf("reverting migration %d_%s failed: %w", migration.Version, migration.Name, err)
+		}
+
+		zap.L().Info("Reverted migration", zap.Int("version", migration.Version), zap.String("name", migration.Name))
+		return &migration, nil
+	}
+	return nil, nil
+}
+
+// applied returns applied versions, schema_version table is created when missing.
+// Database created before migrations is recorded as migrated to the first version.
+func (m *Migrator) applied(ctx context.Context) (map[int]schemaVersion, error) {
+	db := m.db.WithContext(ctx)
+
+	if !db.Migrator().HasTable(&schemaVersion{}) {
+		err := db.Exec(`CREATE TABLE schema_version (
+    version INT PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    applied_at TIMESTAMP NOT NULL
+)`).Error
+		if err != nil {
+			return nil, fmt.Errorf("can't create schema_version table: %w", err)
+		}
+
+		if db.Migrator().HasTable(baselineTable) && len(m.migrations) > 0 {
+			baseline := m.migrations[0]
+			if err := db.Create(&schemaVersion{baseline.Version, baseline.Name, time.Now().UTC()}).Error; err != nil {
+				return nil, err
+			}
+			zap.L().Info("Existing database recorded as migrated", zap.Int("version", baseline.Version), zap.String("name", baseline.Name))
+		}
+	}
+
+	versions := []schemaVersion{}
+	if err := db.Find(&versions).Error; err != nil {
+		return nil, err
+	}
+
+	applied := make(map[int]schemaVersion, len(versions))
+	for _, version := range versions {
+		applied[version.Version] = version
+	}
+	return applied, nil
+}
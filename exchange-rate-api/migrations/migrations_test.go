@@ -0,0 +1,230 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+
+	"github.com/kolan92/exchange-rate-api/repositories"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+var (
+	ctx context.Context
+	db  *gorm.DB
+)
+
+func setup(t *testing.T) {
+	ctx = context.Background()
+	db = repositories.ConnectSqlite(filepath.Join(t.TempDir(), "rates.db"))
+	t.Cleanup(func() {
+		if sqlDb, err := db.DB(); err == nil {
+			sqlDb.Close()
+		}
+	})
+}
+
+func TestLoadsEmbeddedMigrations(t *testing.T) {
+	for _, dialect := range []string{"postgres", "sqlite"} {
+		migrations, err := Load(dialect)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, migrations[0].Version)
+		assert.Equal(t, "init", migrations[0].Name)
+		assert.False(t, migrations[0].IsData)
+	}
+
+	migrations, _ := Load("postgres")
+	assert.Equal(t, "seed_rates", migrations[1].Name)
+	assert.True(t, migrations[1].IsData)
+	assert.Equal(t, "seed_base_quote_rates", migrations[4].Name)
+	assert.True(t, migrations[4].IsData)
+}
+
+func TestLoadOrdersMigrationsByVersion(t *testing.T) {
+	fsys := fstest.MapFS{
+		"test/0010_second.up.sql":   {Data: []byte("up 10")},
+		"test/0010_second.down.sql": {Data: []byte("down 10")},
+		"test/0002_first.up.sql":    {Data: []byte("up 2")},
+		"test/0002_first.down.sql":  {Data: []byte("down 2")},
+	}
+
+	migrations, err := load(fsys, "test")
+
+	assert.NoError(t, err)
+	assert.Equal(t, []Migration{
+		{Version: 2, Name: "first", Up: "up 2", Down: "down 2"},
+		{Version: 10, Name: "second", Up: "up 10", Down: "down 10"},
+	}, migrations)
+}
+
+func TestLoadRejectsInvalidFiles(t *testing.T) {
+	for name, fsys := range map[string]fstest.MapFS{
+		"missing down": {"test/0001_init.up.sql": {Data: []byte("up")}},
+		"invalid name": {"test/init.sql": {Data: []byte("up")}},
+		"different names": {
+			"test/0001_init.up.sql":    {Data: []byte("up")},
+			"test/0001_other.down.sql": {Data: []byte("down")},
+		},
+	} {
+		_, err := load(fsys, "test")
+
+		assert.Error(t, err, name)
+	}
+}
+
+func TestUpAppliesPendingMigrations(t *testing.T) {
+	setup(t)
+	migrations, _ := Load("sqlite")
+	migrator, _ := New(db, false)
+
+	applied, err := migrator.Up(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, migrations, applied)
+	assert.True(t, db.Migrator().HasTable("exchange_rates"))
+
+	applied, err = migrator.Up(ctx)
+
+	assert.NoError(t, err)
+	assert.Empty(t, applied)
+}
+
+func TestUpSkipsDataMigrationsUnlessIncluded(t *testing.T) {
+	setup(t)
+	migrations := []Migration{
+		{Version: 1, Name: "init", Up: "CREATE TABLE numbers (value INT)", Down: "DROP TABLE numbers"},
+		{Version: 2, Name: "seed_numbers", IsData: true, Up: "INSERT INTO numbers (value) VALUES (1)", Down: "DELETE FROM numbers"},
+		{Version: 3, Name: "more", Up: "ALTER TABLE numbers ADD COLUMN name TEXT", Down: "ALTER TABLE numbers DROP COLUMN name"},
+	}
+
+	applied, err := (&Migrator{db, migrations, false}).Up(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []Migration{migrations[0], migrations[2]}, applied)
+
+	applied, err = (&Migrator{db, migrations, true}).Up(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []Migration{migrations[1]}, applied)
+	var count int64
+	db.Table("numbers").Count(&count)
+	assert.Equal(t, int64(1), count)
+}
+
+func TestFailedMigrationIsNotRecorded(t *testing.T) {
+	setup(t)
+	migrations := []Migration{{Version: 1, Name: "broken", Up: "CREATE TABLE numbers (value INT); INSERT INTO missing VALUES (1)", Down: ""}}
+	migrator := &Migrator{db, migrations, false}
+
+	_, err := migrator.Up(ctx)
+
+	assert.Error(t, err)
+	assert.False(t, db.Migrator().HasTable("numbers"))
+	statuses, _ := migrator.Status(ctx)
+	assert.Nil(t, statuses[0].AppliedAt)
+}
+
+func TestDownRevertsLastAppliedMigration(t *testing.T) {
+	setup(t)
+	migrator, _ := New(db, false)
+	migrator.Up(ctx)
+
+	reverted, err := migrator.Down(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 4, reverted.Version)
+
+	reverted, err = migrator.Down(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 3, reverted.Version)
+	assert.False(t, db.Migrator().HasTable("ingestion_runs"))
+	assert.True(t, db.Migrator().HasTable("exchange_rates"))
+
+	reverted, err = migrator.Down(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, reverted.Version)
+	assert.False(t, db.Migrator().HasTable("exchange_rates"))
+
+	reverted, err = migrator.Down(ctx)
+
+	assert.NoError(t, err)
+	assert.Nil(t, reverted)
+}
+
+func TestStatusListsAppliedAndPendingMigrations(t *testing.T) {
+	setup(t)
+	migrations := []Migration{
+		{Version: 1, Name: "init", Up: "CREATE TABLE numbers (value INT)", Down: "DROP TABLE numbers"},
+		{Version: 2, Name: "seed_numbers", IsData: true, Up: "INSERT INTO numbers (value) VALUES (1)", Down: "DELETE FROM numbers"},
+	}
+	migrator := &Migrator{db, migrations, false}
+	migrator.Up(ctx)
+
+	statuses, err := migrator.Status(ctx)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, statuses[0].AppliedAt)
+	assert.Nil(t, statuses[1].AppliedAt)
+}
+
+func TestExistingDatabaseIsRecordedAsFirstVersion(t *testing.T) {
+	setup(t)
+	migrations, _ := Load("sqlite")
+	db.Exec(migrations[0].Up)
+	migrator, _ := New(db, false)
+
+	applied, err := migrator.Up(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, migrations[1:], applied)
+	statuses, _ := migrator.Status(ctx)
+	assert.NotNil(t, statuses[0].AppliedAt)
+}
+
+func TestBaseQuoteMigrationSwapsStoredPairs(t *testing.T) {
+	setup(t)
+	migrations, _ := Load("sqlite")
+	(&Migrator{db, migrations[:2], false}).Up(ctx)
+	db.Exec(`INSERT INTO exchange_rates (source_currency_id, destination_currency_id, date, rate) VALUES
+		(2, 1, '2022-05-02 00:00:00+00:00', '1.0226'),
+		(4, 1, '2022-05-02 00:00:00+00:00', NULL),
+		(1, 2, '2022-05-03 00:00:00+00:00', '0.99')`)
+	migrator := &Migrator{db, migrations, false}
+
+	applied, err := migrator.Up(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "base_quote_rates", applied[0].Name)
+	assert.Equal(t, []string{"1 2 1.0226", "1 4 <nil>", "2 1 0.99"}, storedPairs())
+
+	_, err = migrator.Down(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"1 2 0.99", "2 1 1.0226", "4 1 <nil>"}, storedPairs())
+}
+
+// storedPairs returns source, destination and rate of stored rates ordered by currencies ids.
+func storedPairs() []string {
+	rows := []struct {
+		SourceCurrencyId      int
+		DestinationCurrencyId int
+		Rate                  *string
+	}{}
+	db.Raw("SELECT source_currency_id, destination_currency_id, rate FROM exchange_rates ORDER BY source_currency_id, destination_currency_id").Scan(&rows)
+
+	pairs := make([]string, 0, len(rows))
+	for _, row := range rows {
+		rate := "<nil>"
+		if row.Rate != nil {
+			rate = *row.Rate
+		}
+		pairs = append(pairs, fmt.Sprintf("%d %d %s", row.SourceCurrencyId, row.DestinationCurrencyId, rate))
+	}
+	return pairs
+}
@@ -0,0 +1,9 @@
+DROP TABLE api_keys;
+
+DROP TABLE webhook_deliveries;
+
+DROP TABLE alert_rules;
+
+DROP TABLE exchange_rates;
+
+DROP TABLE currencies_codes;
@@ -0,0 +1,66 @@
+CREATE TABLE currencies_codes (
+    id serial PRIMARY KEY,
+    code VARCHAR(3) UNIQUE NOT NULL
+);
+
+INSERT INTO
+    currencies_codes (code)
+VALUES
+    ('USD'),
+    ('CHF'),
+    ('CNY'),
+    ('JPY'),
+    ('KRW'),
+    ('NOK'),
+    ('SEK'),
+    ('THB'),
+    ('TWD');
+
+CREATE TABLE exchange_rates (
+    source_currency_id INT NOT NULL,
+    destination_currency_id INT NOT NULL,
+    date TIMESTAMP NOT NULL,
+    rate NUMERIC(15, 6),
+    PRIMARY KEY (
+        source_currency_id,
+        destination_currency_id,
+        date
+    ),
+    FOREIGN KEY (source_currency_id) REFERENCES currencies_codes (id),
+    FOREIGN KEY (destination_currency_id) REFERENCES currencies_codes (id)
+);
+
+CREATE TABLE alert_rules (
+    id serial PRIMARY KEY,
+    source_currency_id INT NOT NULL,
+    destination_currency_id INT NOT NULL,
+    threshold NUMERIC(15, 6),
+    change_percent NUMERIC(15, 6),
+    callback_url TEXT NOT NULL,
+    secret TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    CHECK ((threshold IS NULL) <> (change_percent IS NULL)),
+    FOREIGN KEY (source_currency_id) REFERENCES currencies_codes (id),
+    FOREIGN KEY (destination_currency_id) REFERENCES currencies_codes (id)
+);
+
+CREATE TABLE webhook_deliveries (
+    id serial PRIMARY KEY,
+    alert_rule_id INT NOT NULL,
+    payload TEXT NOT NULL,
+    attempt INT NOT NULL,
+    status_code INT,
+    error TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    FOREIGN KEY (alert_rule_id) REFERENCES alert_rules (id) ON DELETE CASCADE
+);
+
+CREATE TABLE api_keys (
+    id serial PRIMARY KEY,
+    name TEXT NOT NULL,
+    prefix VARCHAR(16) NOT NULL,
+    key_hash CHAR(64) NOT NULL UNIQUE,
+    scopes TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    revoked_at TIMESTAMP
+);
@@ -0,0 +1,67 @@
+-- Frozen seed of rates stored in the legacy direction (amount of source for one destination), do not edit.
+-- It was generated from data/CHFUSD.csv and similar files, which are now stored as data/USDCHF.csv,
+-- so tools/seedgen can't reproduce it. 0005_seed_base_quote_rates is the generated seed.
+
+-- CHFUSD.csv
+DELETE FROM
+    exchange_rates
+WHERE
+    source_currency_id = (SELECT id FROM currencies_codes WHERE code = 'CHF')
+    AND destination_currency_id = (SELECT id FROM currencies_codes WHERE code = 'USD')
+    AND date BETWEEN '2016-01-29' AND '2021-01-29';
+
+-- CNYUSD.csv
+DELETE FROM
+    exchange_rates
+WHERE
+    source_currency_id = (SELECT id FROM currencies_codes WHERE code = 'CNY')
+    AND destination_currency_id = (SELECT id FROM currencies_codes WHERE code = 'USD')
+    AND date BETWEEN '2016-01-29' AND '2021-01-29';
+
+-- JPYUSD.csv
+DELETE FROM
+    exchange_rates
+WHERE
+    source_currency_id = (SELECT id FROM currencies_codes WHERE code = 'JPY')
+    AND destination_currency_id = (SELECT id FROM currencies_codes WHERE code = 'USD')
+    AND date BETWEEN '2016-01-29' AND '2021-01-29';
+
+-- KRWUSD.csv
+DELETE FROM
+    exchange_rates
+WHERE
+    source_currency_id = (SELECT id FROM currencies_codes WHERE code = 'KRW')
+    AND destination_currency_id = (SELECT id FROM currencies_codes WHERE code = 'USD')
+    AND date BETWEEN '2016-01-29' AND '2021-01-29';
+
+-- NOKUSD.csv
+DELETE FROM
+    exchange_rates
+WHERE
+    source_currency_id = (SELECT id FROM currencies_codes WHERE code = 'NOK')
+    AND destination_currency_id = (SELECT id FROM currencies_codes WHERE code = 'USD')
+    AND date BETWEEN '2016-01-29' AND '2021-01-29';
+
+-- SEKUSD.csv
+DELETE FROM
+    exchange_rates
+WHERE
+    source_currency_id = (SELECT id FROM currencies_codes WHERE code = 'SEK')
+    AND destination_currency_id = (SELECT id FROM currencies_codes WHERE code = 'USD')
+    AND date BETWEEN '2016-01-29' AND '2021-01-29';
+
+-- THBUSD.csv
+DELETE FROM
+    exchange_rates
+WHERE
+    source_currency_id = (SELECT id FROM currencies_codes WHERE code = 'THB')
+    AND destination_currency_id = (SELECT id FROM currencies_codes WHERE code = 'USD')
+    AND date BETWEEN '2016-01-29' AND '2021-01-29';
+
+-- TWDUSD.csv
+DELETE FROM
+    exchange_rates
+WHERE
+    source_currency_id = (SELECT id FROM currencies_codes WHERE code = 'TWD')
+    AND destination_currency_id = (SELECT id FROM currencies_codes WHERE code = 'USD')
+    AND date BETWEEN '2016-01-29' AND '2021-01-29';
@@ -0,0 +1,10625 @@
+-- Frozen seed of rates stored in the legacy direction (amount of source for one destination), do not edit.
+-- It was generated from data/CHFUSD.csv and similar files, which are now stored as data/USDCHF.csv,
+-- so tools/seedgen can't reproduce it. 0005_seed_base_quote_rates is the generated seed.
+
+INSERT INTO
+    currencies_codes (code)
+VALUES
+    ('CHF'),
+    ('CNY'),
+    ('JPY'),
+    ('KRW'),
+    ('NOK'),
+    ('SEK'),
+    ('THB'),
+    ('TWD'),
+    ('USD')
+ON CONFLICT (code) DO NOTHING;
+
+-- CHFUSD.csv
+WITH rates (date, rate) AS (
+    VALUES
+        ('2016-01-29', '1.0226'),
+        ('2016-02-01', '1.0202'),
+        ('2016-02-02', '1.0181'),
+        ('2016-02-03', '1.0070'),
+        ('2016-02-04', '0.9926'),
+        ('2016-02-05', '0.9938'),
+        ('2016-02-08', '0.9885'),
+        ('2016-02-09', '0.9720'),
+        ('2016-02-10', '0.9779'),
+        ('2016-02-11', '0.9706'),
+        ('2016-02-12', '0.9768'),
+        ('2016-02-15', NULL),
+        ('2016-02-16', '0.9884'),
+        ('2016-02-17', '0.9926'),
+        ('2016-02-18', '0.9940'),
+        ('2016-02-19', '0.9893'),
+        ('2016-02-22', '0.9986'),
+        ('2016-02-23', '0.9920'),
+        ('2016-02-24', '0.9860'),
+        ('2016-02-25', '0.9894'),
+        ('2016-02-26', '0.9967'),
+        ('2016-02-29', '0.9960'),
+        ('2016-03-01', '0.9994'),
+        ('2016-03-02', '0.9981'),
+        ('2016-03-03', '0.9920'),
+        ('2016-03-04', '0.9926'),
+        ('2016-03-07', '0.9969'),
+        ('2016-03-08', '0.9939'),
+        ('2016-03-09', '0.9957'),
+        ('2016-03-10', '0.9857'),
+        ('2016-03-11', '0.9813'),
+        ('2016-03-14', '0.9860'),
+        ('2016-03-15', '0.9852'),
+        ('2016-03-16', '0.9879'),
+        ('2016-03-17', '0.9680'),
+        ('2016-03-18', '0.9682'),
+        ('2016-03-21', '0.9678'),
+        ('2016-03-22', '0.9705'),
+        ('2016-03-23', '0.9731'),
+        ('2016-03-24', '0.9766'),
+        ('2016-03-25', '0.9780'),
+        ('2016-03-28', '0.9732'),
+        ('2016-03-29', '0.9744'),
+        ('2016-03-30', '0.9636'),
+        ('2016-03-31', '0.9583'),
+        ('2016-04-01', '0.9588'),
+        ('2016-04-04', '0.9597'),
+        ('2016-04-05', '0.9572'),
+        ('2016-04-06', '0.9537'),
+        ('2016-04-07', '0.9552'),
+        ('2016-04-08', '0.9536'),
+        ('2016-04-11', '0.9538'),
+        ('2016-04-12', '0.9546'),
+        ('2016-04-13', '0.9651'),
+        ('2016-04-14', '0.9667'),
+        ('2016-04-15', '0.9670'),
+        ('2016-04-18', '0.9636'),
+        ('2016-04-19', '0.9599'),
+        ('2016-04-20', '0.9671'),
+        ('2016-04-21', '0.9722'),
+        ('2016-04-22', '0.9774'),
+        ('2016-04-25', '0.9742'),
+        ('2016-04-26', '0.9713'),
+        ('2016-04-27', '0.9710'),
+        ('2016-04-28', '0.9687'),
+        ('2016-04-29', '0.9598'),
+        ('2016-05-02', '0.9556'),
+        ('2016-05-03', '0.9541'),
+        ('2016-05-04', '0.9574'),
+        ('2016-05-05', '0.9686'),
+        ('2016-05-06', '0.9697'),
+        ('2016-05-09', '0.9698'),
+        ('2016-05-10', '0.9746'),
+        ('2016-05-11', '0.9699'),
+        ('2016-05-12', '0.9693'),
+        ('2016-05-13', '0.9756'),
+        ('2016-05-16', '0.9772'),
+        ('2016-05-17', '0.9761'),
+        ('2016-05-18', '0.9822'),
+        ('2016-05-19', '0.9894'),
+        ('2016-05-20', '0.9920'),
+        ('2016-05-23', '0.9919'),
+        ('2016-05-24', '0.9930'),
+        ('2016-05-25', '0.9908'),
+        ('2016-05-26', '0.9898'),
+        ('2016-05-27', '0.9916'),
+        ('2016-05-30', NULL),
+        ('2016-05-31', '0.9934'),
+        ('2016-06-01', '0.9892'),
+        ('2016-06-02', '0.9902'),
+        ('2016-06-03', '0.9781'),
+        ('2016-06-06', '0.9720'),
+        ('2016-06-07', '0.9663'),
+        ('2016-06-08', '0.9594'),
+        ('2016-06-09', '0.9639'),
+        ('2016-06-10', '0.9632'),
+        ('2016-06-13', '0.9662'),
+        ('2016-06-14', '0.9640'),
+        ('2016-06-15', '0.9647'),
+        ('2016-06-16', '0.9662'),
+        ('2016-06-17', '0.9610'),
+        ('2016-06-20', '0.9616'),
+        ('2016-06-21', '0.9596'),
+        ('2016-06-22', '0.9584'),
+        ('2016-06-23', '0.9566'),
+        ('2016-06-24', '0.9702'),
+        ('2016-06-27', '0.9787'),
+        ('2016-06-28', '0.9812'),
+        ('2016-06-29', '0.9794'),
+        ('2016-06-30', '0.9792'),
+        ('2016-07-01', '0.9730'),
+        ('2016-07-04', NULL),
+        ('2016-07-05', '0.9768'),
+        ('2016-07-06', '0.9778'),
+        ('2016-07-07', '0.9781'),
+        ('2016-07-08', '0.9843'),
+        ('2016-07-11', '0.9830'),
+        ('2016-07-12', '0.9869'),
+        ('2016-07-13', '0.9834'),
+        ('2016-07-14', '0.9806'),
+        ('2016-07-15', '0.9834'),
+        ('2016-07-18', '0.9822'),
+        ('2016-07-19', '0.9850'),
+        ('2016-07-20', '0.9872'),
+        ('2016-07-21', '0.9869'),
+        ('2016-07-22', '0.9888'),
+        ('2016-07-25', '0.9869'),
+        ('2016-07-26', '0.9924'),
+        ('2016-07-27', '0.9936'),
+        ('2016-07-28', '0.9806'),
+        ('2016-07-29', '0.9690'),
+        ('2016-08-01', '0.9671'),
+        ('2016-08-02', '0.9643'),
+        ('2016-08-03', '0.9716'),
+        ('2016-08-04', '0.9738'),
+        ('2016-08-05', '0.9796'),
+        ('2016-08-08', '0.9838'),
+        ('2016-08-09', '0.9826'),
+        ('2016-08-10', '0.9759'),
+        ('2016-08-11', '0.9722'),
+        ('2016-08-12', '0.9738'),
+        ('2016-08-15', '0.9714'),
+        ('2016-08-16', '0.9622'),
+        ('2016-08-17', '0.9640'),
+        ('2016-08-18', '0.9576'),
+        ('2016-08-19', '0.9594'),
+        ('2016-08-22', '0.9625'),
+        ('2016-08-23', '0.9622'),
+        ('2016-08-24', '0.9673'),
+        ('2016-08-25', '0.9684'),
+        ('2016-08-26', '0.9718'),
+        ('2016-08-29', '0.9780'),
+        ('2016-08-30', '0.9822'),
+        ('2016-08-31', '0.9830'),
+        ('2016-09-01', '0.9792'),
+        ('2016-09-02', '0.9804'),
+        ('2016-09-05', NULL),
+        ('2016-09-06', '0.9718'),
+        ('2016-09-07', '0.9702'),
+        ('2016-09-08', '0.9726'),
+        ('2016-09-09', '0.9770'),
+        ('2016-09-12', '0.9736'),
+        ('2016-09-13', '0.9740'),
+        ('2016-09-14', '0.9708'),
+        ('2016-09-15', '0.9714'),
+        ('2016-09-16', '0.9794'),
+        ('2016-09-19', '0.9798'),
+        ('2016-09-20', '0.9781'),
+        ('2016-09-21', '0.9758'),
+        ('2016-09-22', '0.9678'),
+        ('2016-09-23', '0.9702'),
+        ('2016-09-26', '0.9668'),
+        ('2016-09-27', '0.9718'),
+        ('2016-09-28', '0.9724'),
+        ('2016-09-29', '0.9655'),
+        ('2016-09-30', '0.9694'),
+        ('2016-10-03', '0.9740'),
+        ('2016-10-04', '0.9780'),
+        ('2016-10-05', '0.9770'),
+        ('2016-10-06', '0.9798'),
+        ('2016-10-07', '0.9806'),
+        ('2016-10-10', NULL),
+        ('2016-10-11', '0.9878'),
+        ('2016-10-12', '0.9902'),
+        ('2016-10-13', '0.9870'),
+        ('2016-10-14', '0.9902'),
+        ('2016-10-17', '0.9880'),
+        ('2016-10-18', '0.9886'),
+        ('2016-10-19', '0.9894'),
+        ('2016-10-20', '0.9916'),
+        ('2016-10-21', '0.9951'),
+        ('2016-10-24', '0.9920'),
+        ('2016-10-25', '0.9940'),
+        ('2016-10-26', '0.9927'),
+        ('2016-10-27', '0.9940'),
+        ('2016-10-28', '0.9932'),
+        ('2016-10-31', '0.9890'),
+        ('2016-11-01', '0.9790'),
+        ('2016-11-02', '0.9701'),
+        ('2016-11-03', '0.9746'),
+        ('2016-11-04', '0.9682'),
+        ('2016-11-07', '0.9758'),
+        ('2016-11-08', '0.9757'),
+        ('2016-11-09', '0.9812'),
+        ('2016-11-10', '0.9878'),
+        ('2016-11-11', NULL),
+        ('2016-11-14', '0.9978'),
+        ('2016-11-15', '1.0015'),
+        ('2016-11-16', '1.0019'),
+        ('2016-11-17', '1.0048'),
+        ('2016-11-18', '1.0094'),
+        ('2016-11-21', '1.0112'),
+        ('2016-11-22', '1.0100'),
+        ('2016-11-23', '1.0156'),
+        ('2016-11-24', NULL),
+        ('2016-11-25', '1.0136'),
+        ('2016-11-28', '1.0158'),
+        ('2016-11-29', '1.0132'),
+        ('2016-11-30', '1.0187'),
+        ('2016-12-01', '1.0126'),
+        ('2016-12-02', '1.0110'),
+        ('2016-12-05', '1.0103'),
+        ('2016-12-06', '1.0093'),
+        ('2016-12-07', '1.0065'),
+        ('2016-12-08', '1.0154'),
+        ('2016-12-09', '1.0184'),
+        ('2016-12-12', '1.0157'),
+        ('2016-12-13', '1.0118'),
+        ('2016-12-14', '1.0099'),
+        ('2016-12-15', '1.0334'),
+        ('2016-12-16', '1.0258'),
+        ('2016-12-19', '1.0226'),
+        ('2016-12-20', '1.0282'),
+        ('2016-12-21', '1.0262'),
+        ('2016-12-22', '1.0246'),
+        ('2016-12-23', '1.0268'),
+        ('2016-12-26', NULL),
+        ('2016-12-27', '1.0284'),
+        ('2016-12-28', '1.0313'),
+        ('2016-12-29', '1.0230'),
+        ('2016-12-30', '1.0160'),
+        ('2017-01-02', NULL),
+        ('2017-01-03', '1.0266'),
+        ('2017-01-04', '1.0228'),
+        ('2017-01-05', '1.0107'),
+        ('2017-01-06', '1.0150'),
+        ('2017-01-09', '1.0148'),
+        ('2017-01-10', '1.0158'),
+        ('2017-01-11', '1.0203'),
+        ('2017-01-12', '1.0066'),
+        ('2017-01-13', '1.0098'),
+        ('2017-01-16', NULL),
+        ('2017-01-17', '1.0028'),
+        ('2017-01-18', '1.0028'),
+        ('2017-01-19', '1.0094'),
+        ('2017-01-20', NULL),
+        ('2017-01-23', '0.9990'),
+        ('2017-01-24', '0.9998'),
+        ('2017-01-25', '0.9996'),
+        ('2017-01-26', '1.0011'),
+        ('2017-01-27', '0.9992'),
+        ('2017-01-30', '0.9976'),
+        ('2017-01-31', '0.9888'),
+        ('2017-02-01', '0.9930'),
+        ('2017-02-02', '0.9894'),
+        ('2017-02-03', '0.9910'),
+        ('2017-02-06', '0.9934'),
+        ('2017-02-07', '0.9970'),
+        ('2017-02-08', '0.9934'),
+        ('2017-02-09', '1.0013'),
+        ('2017-02-10', '1.0028'),
+        ('2017-02-13', '1.0054'),
+        ('2017-02-14', '1.0072'),
+        ('2017-02-15', '1.0062'),
+        ('2017-02-16', '0.9992'),
+        ('2017-02-17', '1.0022'),
+        ('2017-02-20', NULL),
+        ('2017-02-21', '1.0083'),
+        ('2017-02-22', '1.0109'),
+        ('2017-02-23', '1.0062'),
+        ('2017-02-24', '1.0055'),
+        ('2017-02-27', '1.0051'),
+        ('2017-02-28', '1.0022'),
+        ('2017-03-01', '1.0072'),
+        ('2017-03-02', '1.0120'),
+        ('2017-03-03', '1.0118'),
+        ('2017-03-06', '1.0114'),
+        ('2017-03-07', '1.0130'),
+        ('2017-03-08', '1.0146'),
+        ('2017-03-09', '1.0131'),
+        ('2017-03-10', '1.0104'),
+        ('2017-03-13', '1.0068'),
+        ('2017-03-14', '1.0078'),
+        ('2017-03-15', '1.0080'),
+        ('2017-03-16', '0.9953'),
+        ('2017-03-17', '0.9978'),
+        ('2017-03-20', '0.9966'),
+        ('2017-03-21', '0.9936'),
+        ('2017-03-22', '0.9900'),
+        ('2017-03-23', '0.9924'),
+        ('2017-03-24', '0.9910'),
+        ('2017-03-27', '0.9830'),
+        ('2017-03-28', '0.9850'),
+        ('2017-03-29', '0.9966'),
+        ('2017-03-30', '0.9969'),
+        ('2017-03-31', '0.9998'),
+        ('2017-04-03', '1.0029'),
+        ('2017-04-04', '1.0022'),
+        ('2017-04-05', '1.0050'),
+        ('2017-04-06', '1.0046'),
+        ('2017-04-07', '1.0066'),
+        ('2017-04-10', '1.0077'),
+        ('2017-04-11', '1.0072'),
+        ('2017-04-12', '1.0054'),
+        ('2017-04-13', '1.0047'),
+        ('2017-04-14', '1.0038'),
+        ('2017-04-17', '1.0022'),
+        ('2017-04-18', '0.9978'),
+        ('2017-04-19', '0.9986'),
+        ('2017-04-20', '0.9960'),
+        ('2017-04-21', '0.9987'),
+        ('2017-04-24', '0.9964'),
+        ('2017-04-25', '0.9925'),
+        ('2017-04-26', '0.9957'),
+        ('2017-04-27', '0.9948'),
+        ('2017-04-28', '0.9944'),
+        ('2017-05-01', '0.9955'),
+        ('2017-05-02', '0.9913'),
+        ('2017-05-03', '0.9913'),
+        ('2017-05-04', '0.9890'),
+        ('2017-05-05', '0.9876'),
+        ('2017-05-08', '0.9979'),
+        ('2017-05-09', '1.0074'),
+        ('2017-05-10', '1.0088'),
+        ('2017-05-11', '1.0061'),
+        ('2017-05-12', '1.0008'),
+        ('2017-05-15', '0.9964'),
+        ('2017-05-16', '0.9878'),
+        ('2017-05-17', '0.9808'),
+        ('2017-05-18', '0.9763'),
+        ('2017-05-19', '0.9754'),
+        ('2017-05-22', '0.9736'),
+        ('2017-05-23', '0.9740'),
+        ('2017-05-24', '0.9769'),
+        ('2017-05-25', '0.9720'),
+        ('2017-05-26', '0.9753'),
+        ('2017-05-29', NULL),
+        ('2017-05-30', '0.9744'),
+        ('2017-05-31', '0.9684'),
+        ('2017-06-01', '0.9712'),
+        ('2017-06-02', '0.9642'),
+        ('2017-06-05', '0.9657'),
+        ('2017-06-06', '0.9628'),
+        ('2017-06-07', '0.9657'),
+        ('2017-06-08', '0.9675'),
+        ('2017-06-09', '0.9700'),
+        ('2017-06-12', '0.9688'),
+        ('2017-06-13', '0.9692'),
+        ('2017-06-14', '0.9662'),
+        ('2017-06-15', '0.9749'),
+        ('2017-06-16', '0.9739'),
+        ('2017-06-19', '0.9745'),
+        ('2017-06-20', '0.9759'),
+        ('2017-06-21', '0.9744'),
+        ('2017-06-22', '0.9728'),
+        ('2017-06-23', '0.9692'),
+        ('2017-06-26', '0.9706'),
+        ('2017-06-27', '0.9638'),
+        ('2017-06-28', '0.9608'),
+        ('2017-06-29', '0.9569'),
+        ('2017-06-30', '0.9586'),
+        ('2017-07-03', '0.9632'),
+        ('2017-07-04', NULL),
+        ('2017-07-05', '0.9652'),
+        ('2017-07-06', '0.9634'),
+        ('2017-07-07', '0.9644'),
+        ('2017-07-10', '0.9658'),
+        ('2017-07-11', '0.9672'),
+        ('2017-07-12', '0.9648'),
+        ('2017-07-13', '0.9685'),
+        ('2017-07-14', '0.9654'),
+        ('2017-07-17', '0.9627'),
+        ('2017-07-18', '0.9526'),
+        ('2017-07-19', '0.9548'),
+        ('2017-07-20', '0.9507'),
+        ('2017-07-21', '0.9468'),
+        ('2017-07-24', '0.9456'),
+        ('2017-07-25', '0.9496'),
+        ('2017-07-26', '0.9580'),
+        ('2017-07-27', '0.9656'),
+        ('2017-07-28', '0.9685'),
+        ('2017-07-31', '0.9650'),
+        ('2017-08-01', '0.9655'),
+        ('2017-08-02', '0.9684'),
+        ('2017-08-03', '0.9690'),
+        ('2017-08-04', '0.9721'),
+        ('2017-08-07', '0.9728'),
+        ('2017-08-08', '0.9766'),
+        ('2017-08-09', '0.9649'),
+        ('2017-08-10', '0.9647'),
+        ('2017-08-11', '0.9620'),
+        ('2017-08-14', '0.9714'),
+        ('2017-08-15', '0.9718'),
+        ('2017-08-16', '0.9744'),
+        ('2017-08-17', '0.9633'),
+        ('2017-08-18', '0.9642'),
+        ('2017-08-21', '0.9629'),
+        ('2017-08-22', '0.9668'),
+        ('2017-08-23', '0.9667'),
+        ('2017-08-24', '0.9646'),
+        ('2017-08-25', '0.9574'),
+        ('2017-08-28', '0.9554'),
+        ('2017-08-29', '0.9480'),
+        ('2017-08-30', '0.9583'),
+        ('2017-08-31', '0.9610'),
+        ('2017-09-01', '0.9626'),
+        ('2017-09-04', NULL),
+        ('2017-09-05', '0.9577'),
+        ('2017-09-06', '0.9537'),
+        ('2017-09-07', '0.9498'),
+        ('2017-09-08', '0.9456'),
+        ('2017-09-11', '0.9533'),
+        ('2017-09-12', '0.9596'),
+        ('2017-09-13', '0.9639'),
+        ('2017-09-14', '0.9660'),
+        ('2017-09-15', '0.9594'),
+        ('2017-09-18', '0.9630'),
+        ('2017-09-19', '0.9628'),
+        ('2017-09-20', '0.9617'),
+        ('2017-09-21', '0.9692'),
+        ('2017-09-22', '0.9694'),
+        ('2017-09-25', '0.9682'),
+        ('2017-09-26', '0.9706'),
+        ('2017-09-27', '0.9745'),
+        ('2017-09-28', '0.9710'),
+        ('2017-09-29', '0.9688'),
+        ('2017-10-02', '0.9737'),
+        ('2017-10-03', '0.9734'),
+        ('2017-10-04', '0.9756'),
+        ('2017-10-05', '0.9792'),
+        ('2017-10-06', '0.9777'),
+        ('2017-10-09', NULL),
+        ('2017-10-10', '0.9744'),
+        ('2017-10-11', '0.9732'),
+        ('2017-10-12', '0.9760'),
+        ('2017-10-13', '0.9745'),
+        ('2017-10-16', '0.9735'),
+        ('2017-10-17', '0.9804'),
+        ('2017-10-18', '0.9817'),
+        ('2017-10-19', '0.9751'),
+        ('2017-10-20', '0.9844'),
+        ('2017-10-23', '0.9862'),
+        ('2017-10-24', '0.9890'),
+        ('2017-10-25', '0.9888'),
+        ('2017-10-26', '0.9950'),
+        ('2017-10-27', '0.9990'),
+        ('2017-10-30', '0.9969'),
+        ('2017-10-31', '0.9968'),
+        ('2017-11-01', '1.0014'),
+        ('2017-11-02', '0.9977'),
+        ('2017-11-03', '1.0002'),
+        ('2017-11-06', '0.9990'),
+        ('2017-11-07', '0.9996'),
+        ('2017-11-08', '0.9997'),
+        ('2017-11-09', '0.9958'),
+        ('2017-11-10', NULL),
+        ('2017-11-13', '0.9975'),
+        ('2017-11-14', '0.9914'),
+        ('2017-11-15', '0.9902'),
+        ('2017-11-16', '0.9926'),
+        ('2017-11-17', '0.9882'),
+        ('2017-11-20', '0.9923'),
+        ('2017-11-21', '0.9916'),
+        ('2017-11-22', '0.9838'),
+        ('2017-11-23', NULL),
+        ('2017-11-24', '0.9788'),
+        ('2017-11-27', '0.9804'),
+        ('2017-11-28', '0.9831'),
+        ('2017-11-29', '0.9837'),
+        ('2017-11-30', '0.9838'),
+        ('2017-12-01', '0.9762'),
+        ('2017-12-04', '0.9858'),
+        ('2017-12-05', '0.9868'),
+        ('2017-12-06', '0.9904'),
+        ('2017-12-07', '0.9921'),
+        ('2017-12-08', '0.9928'),
+        ('2017-12-11', '0.9900'),
+        ('2017-12-12', '0.9926'),
+        ('2017-12-13', '0.9894'),
+        ('2017-12-14', '0.9900'),
+        ('2017-12-15', '0.9903'),
+        ('2017-12-18', '0.9856'),
+        ('2017-12-19', '0.9857'),
+        ('2017-12-20', '0.9859'),
+        ('2017-12-21', '0.9890'),
+        ('2017-12-22', '0.9898'),
+        ('2017-12-25', NULL),
+        ('2017-12-26', '0.9891'),
+        ('2017-12-27', '0.9870'),
+        ('2017-12-28', '0.9774'),
+        ('2017-12-29', '0.9738'),
+        ('2018-01-01', NULL),
+        ('2018-01-02', '0.9718'),
+        ('2018-01-03', '0.9762'),
+        ('2018-01-04', '0.9753'),
+        ('2018-01-05', '0.9752'),
+        ('2018-01-08', '0.9762'),
+        ('2018-01-09', '0.9832'),
+        ('2018-01-10', '0.9780'),
+        ('2018-01-11', '0.9748'),
+        ('2018-01-12', '0.9713'),
+        ('2018-01-15', NULL),
+        ('2018-01-16', '0.9618'),
+        ('2018-01-17', '0.9616'),
+        ('2018-01-18', '0.9586'),
+        ('2018-01-19', '0.9612'),
+        ('2018-01-22', '0.9636'),
+        ('2018-01-23', '0.9598'),
+        ('2018-01-24', '0.9452'),
+        ('2018-01-25', '0.9357'),
+        ('2018-01-26', '0.9342'),
+        ('2018-01-29', '0.9380'),
+        ('2018-01-30', '0.9355'),
+        ('2018-01-31', '0.9321'),
+        ('2018-02-01', '0.9288'),
+        ('2018-02-02', '0.9320'),
+        ('2018-02-05', '0.9366'),
+        ('2018-02-06', '0.9368'),
+        ('2018-02-07', '0.9438'),
+        ('2018-02-08', '0.9386'),
+        ('2018-02-09', '0.9393'),
+        ('2018-02-12', '0.9392'),
+        ('2018-02-13', '0.9335'),
+        ('2018-02-14', '0.9319'),
+        ('2018-02-15', '0.9232'),
+        ('2018-02-16', '0.9258'),
+        ('2018-02-19', NULL),
+        ('2018-02-20', '0.9354'),
+        ('2018-02-21', '0.9375'),
+        ('2018-02-22', '0.9345'),
+        ('2018-02-23', '0.9356'),
+        ('2018-02-26', '0.9388'),
+        ('2018-02-27', '0.9398'),
+        ('2018-02-28', '0.943'),
+        ('2018-03-01', '0.9461'),
+        ('2018-03-02', '0.9378'),
+        ('2018-03-05', '0.9397'),
+        ('2018-03-06', '0.9385'),
+        ('2018-03-07', '0.9426'),
+        ('2018-03-08', '0.9499'),
+        ('2018-03-09', '0.9493'),
+        ('2018-03-12', '0.9489'),
+        ('2018-03-13', '0.9437'),
+        ('2018-03-14', '0.9461'),
+        ('2018-03-15', '0.9502'),
+        ('2018-03-16', '0.9532'),
+        ('2018-03-19', '0.9509'),
+        ('2018-03-20', '0.9549'),
+        ('2018-03-21', '0.954'),
+        ('2018-03-22', '0.9491'),
+        ('2018-03-23', '0.9465'),
+        ('2018-03-26', '0.9442'),
+        ('2018-03-27', '0.9474'),
+        ('2018-03-28', '0.9538'),
+        ('2018-03-29', '0.956'),
+        ('2018-03-30', '0.9532'),
+        ('2018-04-02', '0.9558'),
+        ('2018-04-03', '0.959'),
+        ('2018-04-04', '0.9595'),
+        ('2018-04-05', '0.9633'),
+        ('2018-04-06', '0.9602'),
+        ('2018-04-09', '0.9574'),
+        ('2018-04-10', '0.9574'),
+        ('2018-04-11', '0.9578'),
+        ('2018-04-12', '0.9625'),
+        ('2018-04-13', '0.9631'),
+        ('2018-04-16', '0.9596'),
+        ('2018-04-17', '0.9666'),
+        ('2018-04-18', '0.967'),
+        ('2018-04-19', '0.9714'),
+        ('2018-04-20', '0.9744'),
+        ('2018-04-23', '0.9776'),
+        ('2018-04-24', '0.9804'),
+        ('2018-04-25', '0.9828'),
+        ('2018-04-26', '0.988'),
+        ('2018-04-27', '0.9884'),
+        ('2018-04-30', '0.9911'),
+        ('2018-05-01', '0.9954'),
+        ('2018-05-02', '0.9975'),
+        ('2018-05-03', '0.9978'),
+        ('2018-05-04', '1.0005'),
+        ('2018-05-07', '1.0023'),
+        ('2018-05-08', '1.0016'),
+        ('2018-05-09', '1.0042'),
+        ('2018-05-10', '1.0036'),
+        ('2018-05-11', '0.9996'),
+        ('2018-05-14', '0.9976'),
+        ('2018-05-15', '0.9998'),
+        ('2018-05-16', '1.0004'),
+        ('2018-05-17', '1.0014'),
+        ('2018-05-18', '0.997'),
+        ('2018-05-21', '0.9978'),
+        ('2018-05-22', '0.9924'),
+        ('2018-05-23', '0.9952'),
+        ('2018-05-24', '0.9916'),
+        ('2018-05-25', '0.9912'),
+        ('2018-05-28', NULL),
+        ('2018-05-29', '0.9924'),
+        ('2018-05-30', '0.989'),
+        ('2018-05-31', '0.9844'),
+        ('2018-06-01', '0.9867'),
+        ('2018-06-04', '0.9884'),
+        ('2018-06-05', '0.986'),
+        ('2018-06-06', '0.9844'),
+        ('2018-06-07', '0.9822'),
+        ('2018-06-08', '0.986'),
+        ('2018-06-11', '0.9848'),
+        ('2018-06-12', '0.9841'),
+        ('2018-06-13', '0.9844'),
+        ('2018-06-14', '0.9942'),
+        ('2018-06-15', '0.9962'),
+        ('2018-06-18', '0.9952'),
+        ('2018-06-19', '0.9951'),
+        ('2018-06-20', '0.9956'),
+        ('2018-06-21', '0.9921'),
+        ('2018-06-22', '0.9902'),
+        ('2018-06-25', '0.9874'),
+        ('2018-06-26', '0.9901'),
+        ('2018-06-27', '0.9962'),
+        ('2018-06-28', '0.9979'),
+        ('2018-06-29', '0.9922'),
+        ('2018-07-02', '0.9946'),
+        ('2018-07-03', '0.9922'),
+        ('2018-07-04', NULL),
+        ('2018-07-05', '0.9924'),
+        ('2018-07-06', '0.9901'),
+        ('2018-07-09', '0.992'),
+        ('2018-07-10', '0.9926'),
+        ('2018-07-11', '0.9932'),
+        ('2018-07-12', '1.0009'),
+        ('2018-07-13', '1.0028'),
+        ('2018-07-16', '0.9974'),
+        ('2018-07-17', '0.9992'),
+        ('2018-07-18', '0.9994'),
+        ('2018-07-19', '1.0016'),
+        ('2018-07-20', '0.9944'),
+        ('2018-07-23', '0.9927'),
+        ('2018-07-24', '0.9944'),
+        ('2018-07-25', '0.9942'),
+        ('2018-07-26', '0.9938'),
+        ('2018-07-27', '0.994'),
+        ('2018-07-30', '0.9885'),
+        ('2018-07-31', '0.9895'),
+        ('2018-08-01', '0.9923'),
+        ('2018-08-02', '0.9943'),
+        ('2018-08-03', '0.9926'),
+        ('2018-08-06', '0.9963'),
+        ('2018-08-07', '0.9944'),
+        ('2018-08-08', '0.9943'),
+        ('2018-08-09', '0.9906'),
+        ('2018-08-10', '0.9954'),
+        ('2018-08-13', '0.9934'),
+        ('2018-08-14', '0.993'),
+        ('2018-08-15', '0.9942'),
+        ('2018-08-16', '0.9962'),
+        ('2018-08-17', '0.9948'),
+        ('2018-08-20', '0.9943'),
+        ('2018-08-21', '0.9876'),
+        ('2018-08-22', '0.9836'),
+        ('2018-08-23', '0.9848'),
+        ('2018-08-24', '0.9824'),
+        ('2018-08-27', '0.9802'),
+        ('2018-08-28', '0.9752'),
+        ('2018-08-29', '0.9732'),
+        ('2018-08-30', '0.9709'),
+        ('2018-08-31', '0.9698'),
+        ('2018-09-03', NULL),
+        ('2018-09-04', '0.9753'),
+        ('2018-09-05', '0.9716'),
+        ('2018-09-06', '0.9682'),
+        ('2018-09-07', '0.9684'),
+        ('2018-09-10', '0.974'),
+        ('2018-09-11', '0.9737'),
+        ('2018-09-12', '0.9704'),
+        ('2018-09-13', '0.968'),
+        ('2018-09-14', '0.9665'),
+        ('2018-09-17', '0.962'),
+        ('2018-09-18', '0.962'),
+        ('2018-09-19', '0.967'),
+        ('2018-09-20', '0.9624'),
+        ('2018-09-21', '0.9593'),
+        ('2018-09-24', '0.962'),
+        ('2018-09-25', '0.9646'),
+        ('2018-09-26', '0.9681'),
+        ('2018-09-27', '0.9776'),
+        ('2018-09-28', '0.9758'),
+        ('2018-10-01', '0.984'),
+        ('2018-10-02', '0.9844'),
+        ('2018-10-03', '0.9894'),
+        ('2018-10-04', '0.9925'),
+        ('2018-10-05', '0.9922'),
+        ('2018-10-08', NULL),
+        ('2018-10-09', '0.9928'),
+        ('2018-10-10', '0.9908'),
+        ('2018-10-11', '0.992'),
+        ('2018-10-12', '0.9916'),
+        ('2018-10-15', '0.9868'),
+        ('2018-10-16', '0.9894'),
+        ('2018-10-17', '0.9926'),
+        ('2018-10-18', '0.994'),
+        ('2018-10-19', '0.9968'),
+        ('2018-10-22', '0.9963'),
+        ('2018-10-23', '0.9943'),
+        ('2018-10-24', '0.9982'),
+        ('2018-10-25', '1.0009'),
+        ('2018-10-26', '0.998'),
+        ('2018-10-29', '1.0006'),
+        ('2018-10-30', '1.0046'),
+        ('2018-10-31', '1.0057'),
+        ('2018-11-01', '1.0033'),
+        ('2018-11-02', '1.0036'),
+        ('2018-11-05', '1.0055'),
+        ('2018-11-06', '1.0042'),
+        ('2018-11-07', '0.9998'),
+        ('2018-11-08', '1.0033'),
+        ('2018-11-09', '1.0062'),
+        ('2018-11-12', NULL),
+        ('2018-11-13', '1.0083'),
+        ('2018-11-14', '1.0067'),
+        ('2018-11-15', '1.0058'),
+        ('2018-11-16', '1.0012'),
+        ('2018-11-19', '0.9938'),
+        ('2018-11-20', '0.9944'),
+        ('2018-11-21', '0.9952'),
+        ('2018-11-22', NULL),
+        ('2018-11-23', '0.9976'),
+        ('2018-11-26', '0.9984'),
+        ('2018-11-27', '0.9998'),
+        ('2018-11-28', '0.9994'),
+        ('2018-11-29', '0.9966'),
+        ('2018-11-30', '0.9987'),
+        ('2018-12-03', '0.9975'),
+        ('2018-12-04', '0.9975'),
+        ('2018-12-05', NULL),
+        ('2018-12-06', '0.9926'),
+        ('2018-12-07', '0.9914'),
+        ('2018-12-10', '0.9896'),
+        ('2018-12-11', '0.9931'),
+        ('2018-12-12', '0.992'),
+        ('2018-12-13', '0.9938'),
+        ('2018-12-14', '0.997'),
+        ('2018-12-17', '0.9934'),
+        ('2018-12-18', '0.993'),
+        ('2018-12-19', '0.9918'),
+        ('2018-12-20', '0.9918'),
+        ('2018-12-21', '0.9914'),
+        ('2018-12-24', NULL),
+        ('2018-12-25', NULL),
+        ('2018-12-26', '0.9908'),
+        ('2018-12-27', '0.9902'),
+        ('2018-12-28', '0.9838'),
+        ('2018-12-31', '0.9832'),
+        ('2019-01-01', NULL),
+        ('2019-01-02', '0.9884'),
+        ('2019-01-03', '0.9889'),
+        ('2019-01-04', '0.9859'),
+        ('2019-01-07', '0.981'),
+        ('2019-01-08', '0.981'),
+        ('2019-01-09', '0.9767'),
+        ('2019-01-10', '0.9817'),
+        ('2019-01-11', '0.9829'),
+        ('2019-01-14', NULL),
+        ('2019-01-15', '0.9885'),
+        ('2019-01-16', '0.9899'),
+        ('2019-01-17', '0.9944'),
+        ('2019-01-18', '0.9954'),
+        ('2019-01-21', NULL),
+        ('2019-01-22', '0.9972'),
+        ('2019-01-23', '0.9946'),
+        ('2019-01-24', '0.9965'),
+        ('2019-01-25', '0.993'),
+        ('2019-01-28', '0.991'),
+        ('2019-01-29', '0.995'),
+        ('2019-01-30', '0.9988'),
+        ('2019-01-31', '0.9938'),
+        ('2019-02-01', '0.994'),
+        ('2019-02-04', '0.9974'),
+        ('2019-02-05', '1.0005'),
+        ('2019-02-06', '1.0009'),
+        ('2019-02-07', '1.001'),
+        ('2019-02-08', '1.0'),
+        ('2019-02-11', '1.004'),
+        ('2019-02-12', '1.0068'),
+        ('2019-02-13', '1.0073'),
+        ('2019-02-14', '1.0064'),
+        ('2019-02-15', '1.0063'),
+        ('2019-02-18', NULL),
+        ('2019-02-19', '1.0018'),
+        ('2019-02-20', NULL),
+        ('2019-02-21', '1.001'),
+        ('2019-02-22', '0.9998'),
+        ('2019-02-25', '1.0006'),
+        ('2019-02-26', '1.0002'),
+        ('2019-02-27', '1.0003'),
+        ('2019-02-28', '0.9974'),
+        ('2019-03-01', '0.9987'),
+        ('2019-03-04', '0.9994'),
+        ('2019-03-05', '1.0045'),
+        ('2019-03-06', '1.0038'),
+        ('2019-03-07', '1.0106'),
+        ('2019-03-08', '1.0074'),
+        ('2019-03-11', '1.011'),
+        ('2019-03-12', '1.0078'),
+        ('2019-03-13', '1.0046'),
+        ('2019-03-14', '1.0044'),
+        ('2019-03-15', '1.0026'),
+        ('2019-03-18', '1.0004'),
+        ('2019-03-19', '1.0001'),
+        ('2019-03-20', '0.9974'),
+        ('2019-03-21', '0.9924'),
+        ('2019-03-22', '0.994'),
+        ('2019-03-25', '0.9918'),
+        ('2019-03-26', '0.994'),
+        ('2019-03-27', '0.9946'),
+        ('2019-03-28', '0.9952'),
+        ('2019-03-29', '0.9962'),
+        ('2019-04-01', '0.9988'),
+        ('2019-04-02', '0.9986'),
+        ('2019-04-03', '0.997'),
+        ('2019-04-04', '1.0002'),
+        ('2019-04-05', '1.0003'),
+        ('2019-04-08', '0.9994'),
+        ('2019-04-09', '0.9999'),
+        ('2019-04-10', '1.0019'),
+        ('2019-04-11', '1.0027'),
+        ('2019-04-12', '1.0017'),
+        ('2019-04-15', '1.0039'),
+        ('2019-04-16', '1.0066'),
+        ('2019-04-17', '1.0099'),
+        ('2019-04-18', '1.0154'),
+        ('2019-04-19', '1.0144'),
+        ('2019-04-22', '1.0155'),
+        ('2019-04-23', '1.0218'),
+        ('2019-04-24', '1.0184'),
+        ('2019-04-25', '1.0199'),
+        ('2019-04-26', '1.019'),
+        ('2019-04-29', '1.0201'),
+        ('2019-04-30', '1.02'),
+        ('2019-05-01', '1.0137'),
+        ('2019-05-02', '1.018'),
+        ('2019-05-03', '1.0178'),
+        ('2019-05-06', '1.0179'),
+        ('2019-05-07', '1.0215'),
+        ('2019-05-08', '1.0181'),
+        ('2019-05-09', '1.0133'),
+        ('2019-05-10', '1.0109'),
+        ('2019-05-13', '1.0064'),
+        ('2019-05-14', '1.0088'),
+        ('2019-05-15', '1.009'),
+        ('2019-05-16', '1.0101'),
+        ('2019-05-17', '1.0108'),
+        ('2019-05-20', '1.0082'),
+        ('2019-05-21', '1.0104'),
+        ('2019-05-22', '1.009'),
+        ('2019-05-23', '1.004'),
+        ('2019-05-24', '1.0021'),
+        ('2019-05-27', NULL),
+        ('2019-05-28', '1.0086'),
+        ('2019-05-29', '1.0066'),
+        ('2019-05-30', '1.0074'),
+        ('2019-05-31', '1.0034'),
+        ('2019-06-03', '0.9994'),
+        ('2019-06-04', '0.994'),
+        ('2019-06-05', '0.9919'),
+        ('2019-06-06', '0.9886'),
+        ('2019-06-07', '0.9877'),
+        ('2019-06-10', '0.9898'),
+        ('2019-06-11', '0.9925'),
+        ('2019-06-12', '0.9944'),
+        ('2019-06-13', '0.9946'),
+        ('2019-06-14', '0.999'),
+        ('2019-06-17', '0.9982'),
+        ('2019-06-18', '0.9994'),
+        ('2019-06-19', '0.996'),
+        ('2019-06-20', '0.9821'),
+        ('2019-06-21', '0.9789'),
+        ('2019-06-24', '0.972'),
+        ('2019-06-25', '0.9726'),
+        ('2019-06-26', '0.976'),
+        ('2019-06-27', '0.9766'),
+        ('2019-06-28', '0.9758'),
+        ('2019-07-01', '0.9864'),
+        ('2019-07-02', '0.986'),
+        ('2019-07-03', '0.9864'),
+        ('2019-07-04', NULL),
+        ('2019-07-05', '0.9923'),
+        ('2019-07-08', '0.9938'),
+        ('2019-07-09', '0.9928'),
+        ('2019-07-10', '0.9892'),
+        ('2019-07-11', '0.9886'),
+        ('2019-07-12', '0.9845'),
+        ('2019-07-15', '0.9847'),
+        ('2019-07-16', '0.989'),
+        ('2019-07-17', '0.987'),
+        ('2019-07-18', '0.9862'),
+        ('2019-07-19', '0.9823'),
+        ('2019-07-22', '0.9815'),
+        ('2019-07-23', '0.9848'),
+        ('2019-07-24', '0.9854'),
+        ('2019-07-25', '0.9899'),
+        ('2019-07-26', '0.9933'),
+        ('2019-07-29', '0.9916'),
+        ('2019-07-30', '0.9908'),
+        ('2019-07-31', '0.9902'),
+        ('2019-08-01', '0.9938'),
+        ('2019-08-02', '0.982'),
+        ('2019-08-05', '0.9721'),
+        ('2019-08-06', '0.9782'),
+        ('2019-08-07', '0.9708'),
+        ('2019-08-08', '0.9751'),
+        ('2019-08-09', '0.971'),
+        ('2019-08-12', '0.9696'),
+        ('2019-08-13', '0.9744'),
+        ('2019-08-14', '0.9736'),
+        ('2019-08-15', '0.9762'),
+        ('2019-08-16', '0.98'),
+        ('2019-08-19', '0.9808'),
+        ('2019-08-20', '0.9792'),
+        ('2019-08-21', '0.9816'),
+        ('2019-08-22', '0.9834'),
+        ('2019-08-23', '0.9748'),
+        ('2019-08-26', '0.9782'),
+        ('2019-08-27', '0.9815'),
+        ('2019-08-28', '0.9807'),
+        ('2019-08-29', '0.9853'),
+        ('2019-08-30', '0.9899'),
+        ('2019-09-02', NULL),
+        ('2019-09-03', '0.9872'),
+        ('2019-09-04', '0.9817'),
+        ('2019-09-05', '0.9868'),
+        ('2019-09-06', '0.9866'),
+        ('2019-09-09', '0.991'),
+        ('2019-09-10', '0.9908'),
+        ('2019-09-11', '0.9933'),
+        ('2019-09-12', '0.9891'),
+        ('2019-09-13', '0.9898'),
+        ('2019-09-16', '0.9919'),
+        ('2019-09-17', '0.9925'),
+        ('2019-09-18', '0.995'),
+        ('2019-09-19', '0.9927'),
+        ('2019-09-20', '0.993'),
+        ('2019-09-23', '0.9894'),
+        ('2019-09-24', '0.9872'),
+        ('2019-09-25', '0.9909'),
+        ('2019-09-26', '0.9928'),
+        ('2019-09-27', '0.9919'),
+        ('2019-09-30', '0.9978'),
+        ('2019-10-01', '0.9936'),
+        ('2019-10-02', '0.9975'),
+        ('2019-10-03', '0.9974'),
+        ('2019-10-04', '0.9958'),
+        ('2019-10-07', '0.9947'),
+        ('2019-10-08', '0.994'),
+        ('2019-10-09', '0.995'),
+        ('2019-10-10', '0.9954'),
+        ('2019-10-11', '0.9967'),
+        ('2019-10-14', NULL),
+        ('2019-10-15', '0.9982'),
+        ('2019-10-16', '0.9959'),
+        ('2019-10-17', '0.987'),
+        ('2019-10-18', '0.9856'),
+        ('2019-10-21', '0.9865'),
+        ('2019-10-22', '0.9889'),
+        ('2019-10-23', '0.9907'),
+        ('2019-10-24', '0.9923'),
+        ('2019-10-25', '0.9945'),
+        ('2019-10-28', '0.996'),
+        ('2019-10-29', '0.9936'),
+        ('2019-10-30', '0.9909'),
+        ('2019-10-31', '0.9868'),
+        ('2019-11-01', '0.9855'),
+        ('2019-11-04', '0.9871'),
+        ('2019-11-05', '0.9932'),
+        ('2019-11-06', '0.992'),
+        ('2019-11-07', '0.9954'),
+        ('2019-11-08', '0.9971'),
+        ('2019-11-11', NULL),
+        ('2019-11-12', '0.9929'),
+        ('2019-11-13', '0.9892'),
+        ('2019-11-14', '0.9878'),
+        ('2019-11-15', '0.9903'),
+        ('2019-11-18', '0.9879'),
+        ('2019-11-19', '0.9912'),
+        ('2019-11-20', '0.9921'),
+        ('2019-11-21', '0.992'),
+        ('2019-11-22', '0.9967'),
+        ('2019-11-25', '0.9964'),
+        ('2019-11-26', '0.9978'),
+        ('2019-11-27', '0.9996'),
+        ('2019-11-28', NULL),
+        ('2019-11-29', '1.0001'),
+        ('2019-12-02', '0.992'),
+        ('2019-12-03', '0.9862'),
+        ('2019-12-04', '0.9893'),
+        ('2019-12-05', '0.9872'),
+        ('2019-12-06', '0.9909'),
+        ('2019-12-09', '0.9879'),
+        ('2019-12-10', '0.9852'),
+        ('2019-12-11', '0.9856'),
+        ('2019-12-12', '0.986'),
+        ('2019-12-13', '0.9838'),
+        ('2019-12-16', '0.9839'),
+        ('2019-12-17', '0.9811'),
+        ('2019-12-18', '0.9819'),
+        ('2019-12-19', '0.9777'),
+        ('2019-12-20', '0.9824'),
+        ('2019-12-23', '0.982'),
+        ('2019-12-24', '0.9807'),
+        ('2019-12-25', NULL),
+        ('2019-12-26', '0.9808'),
+        ('2019-12-27', '0.9741'),
+        ('2019-12-30', '0.9677'),
+        ('2019-12-31', '0.9677'),
+        ('2020-01-01', NULL),
+        ('2020-01-02', '0.9721'),
+        ('2020-01-03', '0.9703'),
+        ('2020-01-06', '0.9688'),
+        ('2020-01-07', '0.9716'),
+        ('2020-01-08', '0.9725'),
+        ('2020-01-09', '0.9724'),
+        ('2020-01-10', '0.9729'),
+        ('2020-01-13', '0.9705'),
+        ('2020-01-14', '0.9677'),
+        ('2020-01-15', '0.9634'),
+        ('2020-01-16', '0.965'),
+        ('2020-01-17', '0.9683'),
+        ('2020-01-20', NULL),
+        ('2020-01-21', '0.9681'),
+        ('2020-01-22', '0.9696'),
+        ('2020-01-23', '0.9698'),
+        ('2020-01-24', '0.9712'),
+        ('2020-01-27', '0.9695'),
+        ('2020-01-28', '0.9738'),
+        ('2020-01-29', '0.9747'),
+        ('2020-01-30', '0.9692'),
+        ('2020-01-31', '0.9645'),
+        ('2020-02-03', '0.9655'),
+        ('2020-02-04', '0.9693'),
+        ('2020-02-05', '0.9729'),
+        ('2020-02-06', '0.975'),
+        ('2020-02-07', '0.9777'),
+        ('2020-02-10', '0.9778'),
+        ('2020-02-11', '0.9761'),
+        ('2020-02-12', '0.9767'),
+        ('2020-02-13', '0.9788'),
+        ('2020-02-14', '0.9818'),
+        ('2020-02-17', NULL),
+        ('2020-02-18', '0.9814'),
+        ('2020-02-19', '0.9839'),
+        ('2020-02-20', '0.9831'),
+        ('2020-02-21', '0.9781'),
+        ('2020-02-24', '0.9776'),
+        ('2020-02-25', '0.9765'),
+        ('2020-02-26', '0.9759'),
+        ('2020-02-27', '0.9718'),
+        ('2020-02-28', '0.9671'),
+        ('2020-03-02', '0.9577'),
+        ('2020-03-03', '0.9563'),
+        ('2020-03-04', '0.9554'),
+        ('2020-03-05', '0.9487'),
+        ('2020-03-06', '0.9365'),
+        ('2020-03-09', '0.9296'),
+        ('2020-03-10', '0.933'),
+        ('2020-03-11', '0.9381'),
+        ('2020-03-12', '0.954'),
+        ('2020-03-13', '0.9541'),
+        ('2020-03-16', '0.9467'),
+        ('2020-03-17', '0.9647'),
+        ('2020-03-18', '0.9727'),
+        ('2020-03-19', '0.9847'),
+        ('2020-03-20', '0.9866'),
+        ('2020-03-23', '0.9824'),
+        ('2020-03-24', '0.9813'),
+        ('2020-03-25', '0.9781'),
+        ('2020-03-26', '0.9645'),
+        ('2020-03-27', '0.9558'),
+        ('2020-03-30', '0.9606'),
+        ('2020-03-31', '0.9627'),
+        ('2020-04-01', '0.9675'),
+        ('2020-04-02', '0.9727'),
+        ('2020-04-03', '0.9777'),
+        ('2020-04-06', '0.9768'),
+        ('2020-04-07', '0.9707'),
+        ('2020-04-08', '0.971'),
+        ('2020-04-09', '0.966'),
+        ('2020-04-10', '0.9648'),
+        ('2020-04-13', '0.9674'),
+        ('2020-04-14', '0.9606'),
+        ('2020-04-15', '0.9647'),
+        ('2020-04-16', '0.9695'),
+        ('2020-04-17', '0.9662'),
+        ('2020-04-20', '0.967'),
+        ('2020-04-21', '0.9691'),
+        ('2020-04-22', '0.9711'),
+        ('2020-04-23', '0.9731'),
+        ('2020-04-24', '0.975'),
+        ('2020-04-27', '0.9756'),
+        ('2020-04-28', '0.9743'),
+        ('2020-04-29', '0.9748'),
+        ('2020-04-30', '0.9669'),
+        ('2020-05-01', '0.9599'),
+        ('2020-05-04', '0.9647'),
+        ('2020-05-05', '0.9721'),
+        ('2020-05-06', '0.9745'),
+        ('2020-05-07', '0.9746'),
+        ('2020-05-08', '0.9699'),
+        ('2020-05-11', '0.9722'),
+        ('2020-05-12', '0.968'),
+        ('2020-05-13', '0.9704'),
+        ('2020-05-14', '0.9727'),
+        ('2020-05-15', '0.9726'),
+        ('2020-05-18', '0.9699'),
+        ('2020-05-19', '0.9725'),
+        ('2020-05-20', '0.9649'),
+        ('2020-05-21', '0.9703'),
+        ('2020-05-22', '0.9722'),
+        ('2020-05-25', NULL),
+        ('2020-05-26', '0.9668'),
+        ('2020-05-27', '0.9696'),
+        ('2020-05-28', '0.9642'),
+        ('2020-05-29', '0.9618'),
+        ('2020-06-01', '0.9613'),
+        ('2020-06-02', '0.9623'),
+        ('2020-06-03', '0.9613'),
+        ('2020-06-04', '0.9565'),
+        ('2020-06-05', '0.9622'),
+        ('2020-06-08', '0.9567'),
+        ('2020-06-09', '0.9487'),
+        ('2020-06-10', '0.945'),
+        ('2020-06-11', '0.9382'),
+        ('2020-06-12', '0.9515'),
+        ('2020-06-15', '0.9503'),
+        ('2020-06-16', '0.9494'),
+        ('2020-06-17', '0.9512'),
+        ('2020-06-18', '0.951'),
+        ('2020-06-19', '0.9519'),
+        ('2020-06-22', '0.9475'),
+        ('2020-06-23', '0.9438'),
+        ('2020-06-24', '0.9471'),
+        ('2020-06-25', '0.9485'),
+        ('2020-06-26', '0.948'),
+        ('2020-06-29', '0.9504'),
+        ('2020-06-30', '0.9467'),
+        ('2020-07-01', '0.9455'),
+        ('2020-07-02', '0.9455'),
+        ('2020-07-03', NULL),
+        ('2020-07-06', '0.9416'),
+        ('2020-07-07', '0.941'),
+        ('2020-07-08', '0.9382'),
+        ('2020-07-09', '0.9397'),
+        ('2020-07-10', '0.9395'),
+        ('2020-07-13', '0.941'),
+        ('2020-07-14', '0.9385'),
+        ('2020-07-15', '0.9439'),
+        ('2020-07-16', '0.9432'),
+        ('2020-07-17', '0.9388'),
+        ('2020-07-20', '0.9389'),
+        ('2020-07-21', '0.934'),
+        ('2020-07-22', '0.9288'),
+        ('2020-07-23', '0.925'),
+        ('2020-07-24', '0.9222'),
+        ('2020-07-27', '0.9204'),
+        ('2020-07-28', '0.9169'),
+        ('2020-07-29', '0.9141'),
+        ('2020-07-30', '0.912'),
+        ('2020-07-31', '0.9113'),
+        ('2020-08-03', '0.9194'),
+        ('2020-08-04', '0.9154'),
+        ('2020-08-05', '0.9053'),
+        ('2020-08-06', '0.9101'),
+        ('2020-08-07', '0.914'),
+        ('2020-08-10', '0.9154'),
+        ('2020-08-11', '0.9163'),
+        ('2020-08-12', '0.9109'),
+        ('2020-08-13', '0.9097'),
+        ('2020-08-14', '0.9091'),
+        ('2020-08-17', '0.9058'),
+        ('2020-08-18', '0.9029'),
+        ('2020-08-19', '0.9114'),
+        ('2020-08-20', '0.9082'),
+        ('2020-08-21', '0.9131'),
+        ('2020-08-24', '0.9109'),
+        ('2020-08-25', '0.9089'),
+        ('2020-08-26', '0.9086'),
+        ('2020-08-27', '0.9077'),
+        ('2020-08-28', '0.9041'),
+        ('2020-08-31', '0.9012'),
+        ('2020-09-01', '0.9072'),
+        ('2020-09-02', '0.9107'),
+        ('2020-09-03', '0.9104'),
+        ('2020-09-04', '0.9147'),
+        ('2020-09-07', NULL),
+        ('2020-09-08', '0.9166'),
+        ('2020-09-09', '0.9131'),
+        ('2020-09-10', '0.9077'),
+        ('2020-09-11', '0.9092'),
+        ('2020-09-14', '0.9071'),
+        ('2020-09-15', '0.9085'),
+        ('2020-09-16', '0.908'),
+        ('2020-09-17', '0.9098'),
+        ('2020-09-18', '0.9096'),
+        ('2020-09-21', '0.9163'),
+        ('2020-09-22', '0.92'),
+        ('2020-09-23', '0.9233'),
+        ('2020-09-24', '0.9264'),
+        ('2020-09-25', '0.9291'),
+        ('2020-09-28', '0.9247'),
+        ('2020-09-29', '0.9215'),
+        ('2020-09-30', '0.9188'),
+        ('2020-10-01', '0.9179'),
+        ('2020-10-02', '0.9204'),
+        ('2020-10-05', '0.9153'),
+        ('2020-10-06', '0.9153'),
+        ('2020-10-07', '0.9168'),
+        ('2020-10-08', '0.9176'),
+        ('2020-10-09', '0.9103'),
+        ('2020-10-12', NULL),
+        ('2020-10-13', '0.9147'),
+        ('2020-10-14', '0.9127'),
+        ('2020-10-15', '0.9143'),
+        ('2020-10-16', '0.9153'),
+        ('2020-10-19', '0.9092'),
+        ('2020-10-20', '0.9071'),
+        ('2020-10-21', '0.9042'),
+        ('2020-10-22', '0.9074'),
+        ('2020-10-23', '0.9055'),
+        ('2020-10-26', '0.9073'),
+        ('2020-10-27', '0.9066'),
+        ('2020-10-28', '0.9097'),
+        ('2020-10-29', '0.9171'),
+        ('2020-10-30', '0.9165'),
+        ('2020-11-02', '0.92'),
+        ('2020-11-03', '0.9126'),
+        ('2020-11-04', '0.9107'),
+        ('2020-11-05', '0.9063'),
+        ('2020-11-06', '0.8986'),
+        ('2020-11-09', '0.9127'),
+        ('2020-11-10', '0.9136'),
+        ('2020-11-11', NULL),
+        ('2020-11-12', '0.9136'),
+        ('2020-11-13', '0.9133'),
+        ('2020-11-16', '0.9121'),
+        ('2020-11-17', '0.9109'),
+        ('2020-11-18', '0.9096'),
+        ('2020-11-19', '0.9119'),
+        ('2020-11-20', '0.9118'),
+        ('2020-11-23', '0.9131'),
+        ('2020-11-24', '0.9121'),
+        ('2020-11-25', '0.909'),
+        ('2020-11-26', NULL),
+        ('2020-11-27', NULL),
+        ('2020-11-30', '0.906'),
+        ('2020-12-01', '0.9005'),
+        ('2020-12-02', '0.8961'),
+        ('2020-12-03', '0.8906'),
+        ('2020-12-04', '0.8908'),
+        ('2020-12-07', '0.8892'),
+        ('2020-12-08', '0.8886'),
+        ('2020-12-09', '0.8901'),
+        ('2020-12-10', '0.8878'),
+        ('2020-12-11', '0.8902'),
+        ('2020-12-14', '0.8872'),
+        ('2020-12-15', '0.8859'),
+        ('2020-12-16', '0.8861'),
+        ('2020-12-17', '0.8841'),
+        ('2020-12-18', '0.885'),
+        ('2020-12-21', '0.8859'),
+        ('2020-12-22', '0.8889'),
+        ('2020-12-23', '0.8882'),
+        ('2020-12-24', NULL),
+        ('2020-12-25', NULL),
+        ('2020-12-28', '0.8898'),
+        ('2020-12-29', '0.8838'),
+        ('2020-12-30', '0.8832'),
+        ('2020-12-31', '0.8841'),
+        ('2021-01-01', NULL),
+        ('2021-01-04', '0.8811'),
+        ('2021-01-05', '0.8784'),
+        ('2021-01-06', '0.881'),
+        ('2021-01-07', '0.8841'),
+        ('2021-01-08', '0.8843'),
+        ('2021-01-11', '0.8897'),
+        ('2021-01-12', '0.8892'),
+        ('2021-01-13', '0.8874'),
+        ('2021-01-14', '0.887'),
+        ('2021-01-15', '0.8894'),
+        ('2021-01-18', NULL),
+        ('2021-01-19', '0.8888'),
+        ('2021-01-20', NULL),
+        ('2021-01-21', '0.887'),
+        ('2021-01-22', '0.8853'),
+        ('2021-01-25', '0.8882'),
+        ('2021-01-26', '0.888'),
+        ('2021-01-27', '0.889'),
+        ('2021-01-28', '0.8887'),
+        ('2021-01-29', '0.8905')
+)
+INSERT INTO
+    exchange_rates (source_currency_id, destination_currency_id, date, rate)
+SELECT
+    source.id,
+    destination.id,
+    CAST(rates.date AS DATE),
+    CAST(rates.rate AS NUMERIC)
+FROM
+    rates,
+    currencies_codes source,
+    currencies_codes destination
+WHERE
+    source.code = 'CHF'
+    AND destination.code = 'USD'
+ON CONFLICT DO NOTHING;
+
+-- CNYUSD.csv
+WITH rates (date, rate) AS (
+    VALUES
+        ('2016-01-29', '6.5752'),
+        ('2016-02-01', '6.5778'),
+        ('2016-02-02', '6.5795'),
+        ('2016-02-03', '6.5765'),
+        ('2016-02-04', '6.5638'),
+        ('2016-02-05', '6.5710'),
+        ('2016-02-08', '6.5710'),
+        ('2016-02-09', '6.5710'),
+        ('2016-02-10', '6.5710'),
+        ('2016-02-11', '6.5710'),
+        ('2016-02-12', '6.5710'),
+        ('2016-02-15', NULL),
+        ('2016-02-16', '6.5154'),
+        ('2016-02-17', '6.5270'),
+        ('2016-02-18', '6.5163'),
+        ('2016-02-19', '6.5202'),
+        ('2016-02-22', '6.5197'),
+        ('2016-02-23', '6.5263'),
+        ('2016-02-24', '6.5290'),
+        ('2016-02-25', '6.5320'),
+        ('2016-02-26', '6.5390'),
+        ('2016-02-29', '6.5525'),
+        ('2016-03-01', '6.5500'),
+        ('2016-03-02', '6.5499'),
+        ('2016-03-03', '6.5312'),
+        ('2016-03-04', '6.5064'),
+        ('2016-03-07', '6.5155'),
+        ('2016-03-08', '6.5044'),
+        ('2016-03-09', '6.5105'),
+        ('2016-03-10', '6.5070'),
+        ('2016-03-11', '6.4940'),
+        ('2016-03-14', '6.4984'),
+        ('2016-03-15', '6.5110'),
+        ('2016-03-16', '6.5189'),
+        ('2016-03-17', '6.4745'),
+        ('2016-03-18', '6.4682'),
+        ('2016-03-21', '6.4812'),
+        ('2016-03-22', '6.4876'),
+        ('2016-03-23', '6.5035'),
+        ('2016-03-24', '6.5124'),
+        ('2016-03-25', '6.5131'),
+        ('2016-03-28', '6.5051'),
+        ('2016-03-29', '6.5063'),
+        ('2016-03-30', '6.4648'),
+        ('2016-03-31', '6.4480'),
+        ('2016-04-01', '6.4776'),
+        ('2016-04-04', '6.4776'),
+        ('2016-04-05', '6.4760'),
+        ('2016-04-06', '6.4780'),
+        ('2016-04-07', '6.4599'),
+        ('2016-04-08', '6.4628'),
+        ('2016-04-11', '6.4580'),
+        ('2016-04-12', '6.4639'),
+        ('2016-04-13', '6.4768'),
+        ('2016-04-14', '6.4810'),
+        ('2016-04-15', '6.4730'),
+        ('2016-04-18', '6.4717'),
+        ('2016-04-19', '6.4571'),
+        ('2016-04-20', '6.4675'),
+        ('2016-04-21', '6.4810'),
+        ('2016-04-22', '6.5004'),
+        ('2016-04-25', '6.4900'),
+        ('2016-04-26', '6.4905'),
+        ('2016-04-27', '6.4940'),
+        ('2016-04-28', '6.4735'),
+        ('2016-04-29', '6.4738'),
+        ('2016-05-02', '6.4738'),
+        ('2016-05-03', '6.4900'),
+        ('2016-05-04', '6.4945'),
+        ('2016-05-05', '6.5032'),
+        ('2016-05-06', '6.4970'),
+        ('2016-05-09', '6.5140'),
+        ('2016-05-10', '6.5150'),
+        ('2016-05-11', '6.4910'),
+        ('2016-05-12', '6.5140'),
+        ('2016-05-13', '6.5285'),
+        ('2016-05-16', '6.5184'),
+        ('2016-05-17', '6.5170'),
+        ('2016-05-18', '6.5370'),
+        ('2016-05-19', '6.5440'),
+        ('2016-05-20', '6.5485'),
+        ('2016-05-23', '6.5533'),
+        ('2016-05-24', '6.5552'),
+        ('2016-05-25', '6.5538'),
+        ('2016-05-26', '6.5540'),
+        ('2016-05-27', '6.5615'),
+        ('2016-05-30', NULL),
+        ('2016-05-31', '6.5798'),
+        ('2016-06-01', '6.5748'),
+        ('2016-06-02', '6.5815'),
+        ('2016-06-03', '6.5638'),
+        ('2016-06-06', '6.5611'),
+        ('2016-06-07', '6.5710'),
+        ('2016-06-08', '6.5600'),
+        ('2016-06-09', '6.5590'),
+        ('2016-06-10', '6.5590'),
+        ('2016-06-13', '6.5823'),
+        ('2016-06-14', '6.5930'),
+        ('2016-06-15', '6.5785'),
+        ('2016-06-16', '6.5899'),
+        ('2016-06-17', '6.5849'),
+        ('2016-06-20', '6.5768'),
+        ('2016-06-21', '6.5893'),
+        ('2016-06-22', '6.5750'),
+        ('2016-06-23', '6.5740'),
+        ('2016-06-24', '6.6150'),
+        ('2016-06-27', '6.6481'),
+        ('2016-06-28', '6.6450'),
+        ('2016-06-29', '6.6350'),
+        ('2016-06-30', '6.6459'),
+        ('2016-07-01', '6.6547'),
+        ('2016-07-04', NULL),
+        ('2016-07-05', '6.6770'),
+        ('2016-07-06', '6.6900'),
+        ('2016-07-07', '6.6790'),
+        ('2016-07-08', '6.6878'),
+        ('2016-07-11', '6.6917'),
+        ('2016-07-12', '6.6860'),
+        ('2016-07-13', '6.6832'),
+        ('2016-07-14', '6.6801'),
+        ('2016-07-15', '6.6865'),
+        ('2016-07-18', '6.7013'),
+        ('2016-07-19', '6.6931'),
+        ('2016-07-20', '6.6760'),
+        ('2016-07-21', '6.6735'),
+        ('2016-07-22', '6.6760'),
+        ('2016-07-25', '6.6783'),
+        ('2016-07-26', '6.6690'),
+        ('2016-07-27', '6.6680'),
+        ('2016-07-28', '6.6538'),
+        ('2016-07-29', '6.6371'),
+        ('2016-08-01', '6.6421'),
+        ('2016-08-02', '6.6239'),
+        ('2016-08-03', '6.6320'),
+        ('2016-08-04', '6.6388'),
+        ('2016-08-05', '6.6530'),
+        ('2016-08-08', '6.6597'),
+        ('2016-08-09', '6.6530'),
+        ('2016-08-10', '6.6345'),
+        ('2016-08-11', '6.6274'),
+        ('2016-08-12', '6.6324'),
+        ('2016-08-15', '6.6370'),
+        ('2016-08-16', '6.6240'),
+        ('2016-08-17', '6.6328'),
+        ('2016-08-18', '6.6297'),
+        ('2016-08-19', '6.6515'),
+        ('2016-08-22', '6.6475'),
+        ('2016-08-23', '6.6378'),
+        ('2016-08-24', '6.6562'),
+        ('2016-08-25', '6.6560'),
+        ('2016-08-26', '6.6690'),
+        ('2016-08-29', '6.6775'),
+        ('2016-08-30', '6.6778'),
+        ('2016-08-31', '6.6776'),
+        ('2016-09-01', '6.6713'),
+        ('2016-09-02', '6.6790'),
+        ('2016-09-05', NULL),
+        ('2016-09-06', '6.6722'),
+        ('2016-09-07', '6.6600'),
+        ('2016-09-08', '6.6640'),
+        ('2016-09-09', '6.6778'),
+        ('2016-09-12', '6.6788'),
+        ('2016-09-13', '6.6782'),
+        ('2016-09-14', '6.6707'),
+        ('2016-09-15', '6.6707'),
+        ('2016-09-16', '6.6707'),
+        ('2016-09-19', '6.6650'),
+        ('2016-09-20', '6.6700'),
+        ('2016-09-21', '6.6695'),
+        ('2016-09-22', '6.6630'),
+        ('2016-09-23', '6.6690'),
+        ('2016-09-26', '6.6683'),
+        ('2016-09-27', '6.6691'),
+        ('2016-09-28', '6.6730'),
+        ('2016-09-29', '6.6650'),
+        ('2016-09-30', '6.6685'),
+        ('2016-10-03', '6.6685'),
+        ('2016-10-04', '6.6685'),
+        ('2016-10-05', '6.6685'),
+        ('2016-10-06', '6.6685'),
+        ('2016-10-07', '6.6685'),
+        ('2016-10-10', NULL),
+        ('2016-10-11', '6.7190'),
+        ('2016-10-12', '6.7180'),
+        ('2016-10-13', '6.7248'),
+        ('2016-10-14', '6.7277'),
+        ('2016-10-17', '6.7350'),
+        ('2016-10-18', '6.7401'),
+        ('2016-10-19', '6.7370'),
+        ('2016-10-20', '6.7439'),
+        ('2016-10-21', '6.7654'),
+        ('2016-10-24', '6.7728'),
+        ('2016-10-25', '6.7780'),
+        ('2016-10-26', '6.7706'),
+        ('2016-10-27', '6.7819'),
+        ('2016-10-28', '6.7760'),
+        ('2016-10-31', '6.7735'),
+        ('2016-11-01', '6.7630'),
+        ('2016-11-02', '6.7606'),
+        ('2016-11-03', '6.7612'),
+        ('2016-11-04', '6.7534'),
+        ('2016-11-07', '6.7740'),
+        ('2016-11-08', '6.7840'),
+        ('2016-11-09', '6.7769'),
+        ('2016-11-10', '6.7987'),
+        ('2016-11-11', NULL),
+        ('2016-11-14', '6.8447'),
+        ('2016-11-15', '6.8556'),
+        ('2016-11-16', '6.8770'),
+        ('2016-11-17', '6.8674'),
+        ('2016-11-18', '6.8873'),
+        ('2016-11-21', '6.8918'),
+        ('2016-11-22', '6.8880'),
+        ('2016-11-23', '6.9195'),
+        ('2016-11-24', NULL),
+        ('2016-11-25', '6.9176'),
+        ('2016-11-28', '6.9055'),
+        ('2016-11-29', '6.8933'),
+        ('2016-11-30', '6.8837'),
+        ('2016-12-01', '6.8830'),
+        ('2016-12-02', '6.8830'),
+        ('2016-12-05', '6.8775'),
+        ('2016-12-06', '6.8790'),
+        ('2016-12-07', '6.8780'),
+        ('2016-12-08', '6.8771'),
+        ('2016-12-09', '6.9042'),
+        ('2016-12-12', '6.9045'),
+        ('2016-12-13', '6.8984'),
+        ('2016-12-14', '6.9025'),
+        ('2016-12-15', '6.9410'),
+        ('2016-12-16', '6.9580'),
+        ('2016-12-19', '6.9506'),
+        ('2016-12-20', '6.9470'),
+        ('2016-12-21', '6.9390'),
+        ('2016-12-22', '6.9473'),
+        ('2016-12-23', '6.9432'),
+        ('2016-12-26', NULL),
+        ('2016-12-27', '6.9491'),
+        ('2016-12-28', '6.9559'),
+        ('2016-12-29', '6.9535'),
+        ('2016-12-30', '6.9430'),
+        ('2017-01-02', NULL),
+        ('2017-01-03', '6.9575'),
+        ('2017-01-04', '6.9322'),
+        ('2017-01-05', '6.8870'),
+        ('2017-01-06', '6.9176'),
+        ('2017-01-09', '6.9350'),
+        ('2017-01-10', '6.9180'),
+        ('2017-01-11', '6.9365'),
+        ('2017-01-12', '6.8890'),
+        ('2017-01-13', '6.8985'),
+        ('2017-01-16', NULL),
+        ('2017-01-17', '6.8520'),
+        ('2017-01-18', '6.8360'),
+        ('2017-01-19', '6.8680'),
+        ('2017-01-20', NULL),
+        ('2017-01-23', '6.8514'),
+        ('2017-01-24', '6.8555'),
+        ('2017-01-25', '6.8812'),
+        ('2017-01-26', '6.8768'),
+        ('2017-01-27', '6.8768'),
+        ('2017-01-30', '6.8768'),
+        ('2017-01-31', '6.8768'),
+        ('2017-02-01', '6.8768'),
+        ('2017-02-02', '6.8768'),
+        ('2017-02-03', '6.8650'),
+        ('2017-02-06', '6.8600'),
+        ('2017-02-07', '6.8821'),
+        ('2017-02-08', '6.8700'),
+        ('2017-02-09', '6.8660'),
+        ('2017-02-10', '6.8775'),
+        ('2017-02-13', '6.8776'),
+        ('2017-02-14', '6.8649'),
+        ('2017-02-15', '6.8685'),
+        ('2017-02-16', '6.8517'),
+        ('2017-02-17', '6.8640'),
+        ('2017-02-20', NULL),
+        ('2017-02-21', '6.8810'),
+        ('2017-02-22', '6.8765'),
+        ('2017-02-23', '6.8622'),
+        ('2017-02-24', '6.8645'),
+        ('2017-02-27', '6.8675'),
+        ('2017-02-28', '6.8665'),
+        ('2017-03-01', '6.8794'),
+        ('2017-03-02', '6.8868'),
+        ('2017-03-03', '6.8954'),
+        ('2017-03-06', '6.8955'),
+        ('2017-03-07', '6.9000'),
+        ('2017-03-08', '6.9113'),
+        ('2017-03-09', '6.9086'),
+        ('2017-03-10', '6.9050'),
+        ('2017-03-13', '6.9050'),
+        ('2017-03-14', '6.9130'),
+        ('2017-03-15', '6.9132'),
+        ('2017-03-16', '6.9130'),
+        ('2017-03-17', '6.9000'),
+        ('2017-03-20', '6.9050'),
+        ('2017-03-21', '6.8830'),
+        ('2017-03-22', '6.8785'),
+        ('2017-03-23', '6.8843'),
+        ('2017-03-24', '6.8803'),
+        ('2017-03-27', '6.8687'),
+        ('2017-03-28', '6.8779'),
+        ('2017-03-29', '6.8879'),
+        ('2017-03-30', '6.8870'),
+        ('2017-03-31', '6.8832'),
+        ('2017-04-03', '6.8832'),
+        ('2017-04-04', '6.8832'),
+        ('2017-04-05', '6.8920'),
+        ('2017-04-06', '6.8955'),
+        ('2017-04-07', '6.8978'),
+        ('2017-04-10', '6.8988'),
+        ('2017-04-11', '6.8893'),
+        ('2017-04-12', '6.8890'),
+        ('2017-04-13', '6.8865'),
+        ('2017-04-14', '6.8835'),
+        ('2017-04-17', '6.8800'),
+        ('2017-04-18', '6.8778'),
+        ('2017-04-19', '6.8860'),
+        ('2017-04-20', '6.8800'),
+        ('2017-04-21', '6.8845'),
+        ('2017-04-24', '6.8840'),
+        ('2017-04-25', '6.8824'),
+        ('2017-04-26', '6.8918'),
+        ('2017-04-27', '6.8958'),
+        ('2017-04-28', '6.8900'),
+        ('2017-05-01', '6.8900'),
+        ('2017-05-02', '6.8949'),
+        ('2017-05-03', '6.8968'),
+        ('2017-05-04', '6.8959'),
+        ('2017-05-05', '6.9021'),
+        ('2017-05-08', '6.9040'),
+        ('2017-05-09', '6.9060'),
+        ('2017-05-10', '6.9011'),
+        ('2017-05-11', '6.9033'),
+        ('2017-05-12', '6.8972'),
+        ('2017-05-15', '6.8900'),
+        ('2017-05-16', '6.8840'),
+        ('2017-05-17', '6.8773'),
+        ('2017-05-18', '6.8880'),
+        ('2017-05-19', '6.8830'),
+        ('2017-05-22', '6.8828'),
+        ('2017-05-23', '6.8880'),
+        ('2017-05-24', '6.8883'),
+        ('2017-05-25', '6.8672'),
+        ('2017-05-26', '6.8525'),
+        ('2017-05-29', NULL),
+        ('2017-05-30', '6.8525'),
+        ('2017-05-31', '6.8098'),
+        ('2017-06-01', '6.8029'),
+        ('2017-06-02', '6.8085'),
+        ('2017-06-05', '6.8030'),
+        ('2017-06-06', '6.7945'),
+        ('2017-06-07', '6.7935'),
+        ('2017-06-08', '6.7990'),
+        ('2017-06-09', '6.7970'),
+        ('2017-06-12', '6.7974'),
+        ('2017-06-13', '6.7981'),
+        ('2017-06-14', '6.7888'),
+        ('2017-06-15', '6.8061'),
+        ('2017-06-16', '6.8097'),
+        ('2017-06-19', '6.8180'),
+        ('2017-06-20', '6.8285'),
+        ('2017-06-21', '6.8275'),
+        ('2017-06-22', '6.8275'),
+        ('2017-06-23', '6.8345'),
+        ('2017-06-26', '6.8382'),
+        ('2017-06-27', '6.8100'),
+        ('2017-06-28', '6.7984'),
+        ('2017-06-29', '6.7840'),
+        ('2017-06-30', '6.7793'),
+        ('2017-07-03', '6.7984'),
+        ('2017-07-04', NULL),
+        ('2017-07-05', '6.7995'),
+        ('2017-07-06', '6.7990'),
+        ('2017-07-07', '6.8039'),
+        ('2017-07-10', '6.8026'),
+        ('2017-07-11', '6.8010'),
+        ('2017-07-12', '6.7865'),
+        ('2017-07-13', '6.7825'),
+        ('2017-07-14', '6.7741'),
+        ('2017-07-17', '6.7690'),
+        ('2017-07-18', '6.7451'),
+        ('2017-07-19', '6.7511'),
+        ('2017-07-20', '6.7570'),
+        ('2017-07-21', '6.7663'),
+        ('2017-07-24', '6.7488'),
+        ('2017-07-25', '6.7495'),
+        ('2017-07-26', '6.7530'),
+        ('2017-07-27', '6.7410'),
+        ('2017-07-28', '6.7362'),
+        ('2017-07-31', '6.7240'),
+        ('2017-08-01', '6.7170'),
+        ('2017-08-02', '6.7200'),
+        ('2017-08-03', '6.7150'),
+        ('2017-08-04', '6.7272'),
+        ('2017-08-07', '6.7207'),
+        ('2017-08-08', '6.7049'),
+        ('2017-08-09', '6.6720'),
+        ('2017-08-10', '6.6460'),
+        ('2017-08-11', '6.6612'),
+        ('2017-08-14', '6.6695'),
+        ('2017-08-15', '6.6845'),
+        ('2017-08-16', '6.6900'),
+        ('2017-08-17', '6.6730'),
+        ('2017-08-18', '6.6700'),
+        ('2017-08-21', '6.6630'),
+        ('2017-08-22', '6.6600'),
+        ('2017-08-23', '6.6569'),
+        ('2017-08-24', '6.6600'),
+        ('2017-08-25', '6.6465'),
+        ('2017-08-28', '6.6084'),
+        ('2017-08-29', '6.5945'),
+        ('2017-08-30', '6.5918'),
+        ('2017-08-31', '6.5888'),
+        ('2017-09-01', '6.5552'),
+        ('2017-09-04', NULL),
+        ('2017-09-05', '6.5345'),
+        ('2017-09-06', '6.5221'),
+        ('2017-09-07', '6.4830'),
+        ('2017-09-08', '6.4773'),
+        ('2017-09-11', '6.5254'),
+        ('2017-09-12', '6.5334'),
+        ('2017-09-13', '6.5400'),
+        ('2017-09-14', '6.5520'),
+        ('2017-09-15', '6.5500'),
+        ('2017-09-18', '6.5725'),
+        ('2017-09-19', '6.5800'),
+        ('2017-09-20', '6.5700'),
+        ('2017-09-21', '6.5861'),
+        ('2017-09-22', '6.5899'),
+        ('2017-09-25', '6.6189'),
+        ('2017-09-26', '6.6371'),
+        ('2017-09-27', '6.6400'),
+        ('2017-09-28', '6.6591'),
+        ('2017-09-29', '6.6533'),
+        ('2017-10-02', '6.6533'),
+        ('2017-10-03', '6.6533'),
+        ('2017-10-04', '6.6533'),
+        ('2017-10-05', '6.6533'),
+        ('2017-10-06', '6.6533'),
+        ('2017-10-09', NULL),
+        ('2017-10-10', '6.5712'),
+        ('2017-10-11', '6.5870'),
+        ('2017-10-12', '6.5855'),
+        ('2017-10-13', '6.5785'),
+        ('2017-10-16', '6.5885'),
+        ('2017-10-17', '6.6220'),
+        ('2017-10-18', '6.6250'),
+        ('2017-10-19', '6.6126'),
+        ('2017-10-20', '6.6185'),
+        ('2017-10-23', '6.6380'),
+        ('2017-10-24', '6.6335'),
+        ('2017-10-25', '6.6350'),
+        ('2017-10-26', '6.6392'),
+        ('2017-10-27', '6.6498'),
+        ('2017-10-30', '6.6498'),
+        ('2017-10-31', '6.6328'),
+        ('2017-11-01', '6.6018'),
+        ('2017-11-02', '6.6068'),
+        ('2017-11-03', '6.6360'),
+        ('2017-11-06', '6.6311'),
+        ('2017-11-07', '6.6380'),
+        ('2017-11-08', '6.6249'),
+        ('2017-11-09', '6.6378'),
+        ('2017-11-10', NULL),
+        ('2017-11-13', '6.6385'),
+        ('2017-11-14', '6.6350'),
+        ('2017-11-15', '6.6200'),
+        ('2017-11-16', '6.6273'),
+        ('2017-11-17', '6.6245'),
+        ('2017-11-20', '6.6245'),
+        ('2017-11-21', '6.6265'),
+        ('2017-11-22', '6.6086'),
+        ('2017-11-23', NULL),
+        ('2017-11-24', '6.5998'),
+        ('2017-11-27', '6.5967'),
+        ('2017-11-28', '6.6044'),
+        ('2017-11-29', '6.6091'),
+        ('2017-11-30', '6.6090'),
+        ('2017-12-01', '6.6137'),
+        ('2017-12-04', '6.6185'),
+        ('2017-12-05', '6.6170'),
+        ('2017-12-06', '6.6136'),
+        ('2017-12-07', '6.6173'),
+        ('2017-12-08', '6.6199'),
+        ('2017-12-11', '6.6175'),
+        ('2017-12-12', '6.6210'),
+        ('2017-12-13', '6.6171'),
+        ('2017-12-14', '6.6091'),
+        ('2017-12-15', '6.6088'),
+        ('2017-12-18', '6.6088'),
+        ('2017-12-19', '6.6088'),
+        ('2017-12-20', '6.5771'),
+        ('2017-12-21', '6.5833'),
+        ('2017-12-22', '6.5750'),
+        ('2017-12-25', NULL),
+        ('2017-12-26', '6.5429'),
+        ('2017-12-27', '6.5560'),
+        ('2017-12-28', '6.5322'),
+        ('2017-12-29', '6.5063'),
+        ('2018-01-01', NULL),
+        ('2018-01-02', '6.4910'),
+        ('2018-01-03', '6.5010'),
+        ('2018-01-04', '6.4915'),
+        ('2018-01-05', '6.4875'),
+        ('2018-01-08', '6.4970'),
+        ('2018-01-09', '6.5263'),
+        ('2018-01-10', '6.5055'),
+        ('2018-01-11', '6.4956'),
+        ('2018-01-12', '6.4607'),
+        ('2018-01-15', NULL),
+        ('2018-01-16', '6.4395'),
+        ('2018-01-17', '6.4330'),
+        ('2018-01-18', '6.4175'),
+        ('2018-01-19', '6.3990'),
+        ('2018-01-22', '6.4035'),
+        ('2018-01-23', '6.4000'),
+        ('2018-01-24', '6.3650'),
+        ('2018-01-25', '6.3189'),
+        ('2018-01-26', '6.3199'),
+        ('2018-01-29', '6.3328'),
+        ('2018-01-30', '6.3199'),
+        ('2018-01-31', '6.2841'),
+        ('2018-02-01', '6.2969'),
+        ('2018-02-02', '6.2984'),
+        ('2018-02-05', '6.2850'),
+        ('2018-02-06', '6.2830'),
+        ('2018-02-07', '6.2649'),
+        ('2018-02-08', '6.3222'),
+        ('2018-02-09', '6.2966'),
+        ('2018-02-12', '6.3250'),
+        ('2018-02-13', '6.3380'),
+        ('2018-02-14', '6.3438'),
+        ('2018-02-15', '6.3438'),
+        ('2018-02-16', '6.3438'),
+        ('2018-02-19', NULL),
+        ('2018-02-20', '6.3351'),
+        ('2018-02-21', '6.3351'),
+        ('2018-02-22', '6.3471'),
+        ('2018-02-23', '6.3329'),
+        ('2018-02-26', '6.3115'),
+        ('2018-02-27', '6.3169'),
+        ('2018-02-28', '6.328'),
+        ('2018-03-01', '6.3565'),
+        ('2018-03-02', '6.3428'),
+        ('2018-03-05', '6.3491'),
+        ('2018-03-06', '6.3093'),
+        ('2018-03-07', '6.3225'),
+        ('2018-03-08', '6.3389'),
+        ('2018-03-09', '6.3285'),
+        ('2018-03-12', '6.327'),
+        ('2018-03-13', '6.318'),
+        ('2018-03-14', '6.314'),
+        ('2018-03-15', '6.3202'),
+        ('2018-03-16', '6.33'),
+        ('2018-03-19', '6.3291'),
+        ('2018-03-20', '6.3328'),
+        ('2018-03-21', '6.32'),
+        ('2018-03-22', '6.331'),
+        ('2018-03-23', '6.311'),
+        ('2018-03-26', '6.2685'),
+        ('2018-03-27', '6.2781'),
+        ('2018-03-28', '6.2946'),
+        ('2018-03-29', '6.2876'),
+        ('2018-03-30', '6.2726'),
+        ('2018-04-02', '6.2785'),
+        ('2018-04-03', '6.2881'),
+        ('2018-04-04', '6.3045'),
+        ('2018-04-05', '6.3045'),
+        ('2018-04-06', '6.3045'),
+        ('2018-04-09', '6.3035'),
+        ('2018-04-10', '6.2798'),
+        ('2018-04-11', '6.2655'),
+        ('2018-04-12', '6.2876'),
+        ('2018-04-13', '6.2725'),
+        ('2018-04-16', '6.2752'),
+        ('2018-04-17', '6.2804'),
+        ('2018-04-18', '6.2722'),
+        ('2018-04-19', '6.2765'),
+        ('2018-04-20', '6.2945'),
+        ('2018-04-23', '6.3158'),
+        ('2018-04-24', '6.3035'),
+        ('2018-04-25', '6.3236'),
+        ('2018-04-26', '6.334'),
+        ('2018-04-27', '6.3325'),
+        ('2018-04-30', '6.3325'),
+        ('2018-05-01', '6.3325'),
+        ('2018-05-02', '6.361'),
+        ('2018-05-03', '6.352'),
+        ('2018-05-04', '6.3589'),
+        ('2018-05-07', '6.364'),
+        ('2018-05-08', '6.369'),
+        ('2018-05-09', '6.3585'),
+        ('2018-05-10', '6.344'),
+        ('2018-05-11', '6.3333'),
+        ('2018-05-14', '6.336'),
+        ('2018-05-15', '6.376'),
+        ('2018-05-16', '6.3706'),
+        ('2018-05-17', '6.3645'),
+        ('2018-05-18', '6.3768'),
+        ('2018-05-21', '6.3815'),
+        ('2018-05-22', '6.366'),
+        ('2018-05-23', '6.3875'),
+        ('2018-05-24', '6.3757'),
+        ('2018-05-25', '6.3903'),
+        ('2018-05-28', NULL),
+        ('2018-05-29', '6.4162'),
+        ('2018-05-30', '6.4175'),
+        ('2018-05-31', '6.4096'),
+        ('2018-06-01', '6.418'),
+        ('2018-06-04', '6.405'),
+        ('2018-06-05', '6.4042'),
+        ('2018-06-06', '6.385'),
+        ('2018-06-07', '6.389'),
+        ('2018-06-08', '6.4031'),
+        ('2018-06-11', '6.4'),
+        ('2018-06-12', '6.3992'),
+        ('2018-06-13', '6.3944'),
+        ('2018-06-14', '6.398'),
+        ('2018-06-15', '6.4379'),
+        ('2018-06-18', '6.4379'),
+        ('2018-06-19', '6.4802'),
+        ('2018-06-20', '6.4713'),
+        ('2018-06-21', '6.49'),
+        ('2018-06-22', '6.5027'),
+        ('2018-06-25', '6.536'),
+        ('2018-06-26', '6.5759'),
+        ('2018-06-27', '6.5992'),
+        ('2018-06-28', '6.6235'),
+        ('2018-06-29', '6.6171'),
+        ('2018-07-02', '6.6632'),
+        ('2018-07-03', '6.6368'),
+        ('2018-07-04', NULL),
+        ('2018-07-05', '6.6341'),
+        ('2018-07-06', '6.6396'),
+        ('2018-07-09', '6.6123'),
+        ('2018-07-10', '6.6296'),
+        ('2018-07-11', '6.6764'),
+        ('2018-07-12', '6.663'),
+        ('2018-07-13', '6.69'),
+        ('2018-07-16', '6.6866'),
+        ('2018-07-17', '6.7018'),
+        ('2018-07-18', '6.7161'),
+        ('2018-07-19', '6.7701'),
+        ('2018-07-20', '6.7659'),
+        ('2018-07-23', '6.7927'),
+        ('2018-07-24', '6.7912'),
+        ('2018-07-25', '6.7658'),
+        ('2018-07-26', '6.7865'),
+        ('2018-07-27', '6.8097'),
+        ('2018-07-30', '6.8102'),
+        ('2018-07-31', '6.8038'),
+        ('2018-08-01', '6.8154'),
+        ('2018-08-02', '6.838'),
+        ('2018-08-03', '6.8309'),
+        ('2018-08-06', '6.85'),
+        ('2018-08-07', '6.828'),
+        ('2018-08-08', '6.8344'),
+        ('2018-08-09', '6.8199'),
+        ('2018-08-10', '6.8458'),
+        ('2018-08-13', '6.888'),
+        ('2018-08-14', '6.8809'),
+        ('2018-08-15', '6.933'),
+        ('2018-08-16', '6.8792'),
+        ('2018-08-17', '6.874'),
+        ('2018-08-20', '6.8546'),
+        ('2018-08-21', '6.8444'),
+        ('2018-08-22', '6.84'),
+        ('2018-08-23', '6.8748'),
+        ('2018-08-24', '6.803'),
+        ('2018-08-27', '6.8145'),
+        ('2018-08-28', '6.8018'),
+        ('2018-08-29', '6.819'),
+        ('2018-08-30', '6.8415'),
+        ('2018-08-31', '6.83'),
+        ('2018-09-03', NULL),
+        ('2018-09-04', '6.8427'),
+        ('2018-09-05', '6.827'),
+        ('2018-09-06', '6.8322'),
+        ('2018-09-07', '6.8419'),
+        ('2018-09-10', '6.8545'),
+        ('2018-09-11', '6.8704'),
+        ('2018-09-12', '6.8574'),
+        ('2018-09-13', '6.8407'),
+        ('2018-09-14', '6.8673'),
+        ('2018-09-17', '6.854'),
+        ('2018-09-18', '6.8591'),
+        ('2018-09-19', '6.8466'),
+        ('2018-09-20', '6.8455'),
+        ('2018-09-21', '6.8559'),
+        ('2018-09-24', '6.8559'),
+        ('2018-09-25', '6.8632'),
+        ('2018-09-26', '6.8763'),
+        ('2018-09-27', '6.888'),
+        ('2018-09-28', '6.868'),
+        ('2018-10-01', '6.868'),
+        ('2018-10-02', '6.868'),
+        ('2018-10-03', '6.868'),
+        ('2018-10-04', '6.868'),
+        ('2018-10-05', '6.868'),
+        ('2018-10-08', NULL),
+        ('2018-10-09', '6.9217'),
+        ('2018-10-10', '6.9224'),
+        ('2018-10-11', '6.888'),
+        ('2018-10-12', '6.9182'),
+        ('2018-10-15', '6.9131'),
+        ('2018-10-16', '6.9102'),
+        ('2018-10-17', '6.9263'),
+        ('2018-10-18', '6.9367'),
+        ('2018-10-19', '6.9291'),
+        ('2018-10-22', '6.9446'),
+        ('2018-10-23', '6.9373'),
+        ('2018-10-24', '6.942'),
+        ('2018-10-25', '6.9477'),
+        ('2018-10-26', '6.9425'),
+        ('2018-10-29', '6.9605'),
+        ('2018-10-30', '6.9663'),
+        ('2018-10-31', '6.9737'),
+        ('2018-11-01', '6.9205'),
+        ('2018-11-02', '6.8894'),
+        ('2018-11-05', '6.9249'),
+        ('2018-11-06', '6.9172'),
+        ('2018-11-07', '6.9174'),
+        ('2018-11-08', '6.9318'),
+        ('2018-11-09', '6.9553'),
+        ('2018-11-12', NULL),
+        ('2018-11-13', '6.9546'),
+        ('2018-11-14', '6.95'),
+        ('2018-11-15', '6.938'),
+        ('2018-11-16', '6.9367'),
+        ('2018-11-19', '6.9398'),
+        ('2018-11-20', '6.9448'),
+        ('2018-11-21', '6.9248'),
+        ('2018-11-22', NULL),
+        ('2018-11-23', '6.9477'),
+        ('2018-11-26', '6.9397'),
+        ('2018-11-27', '6.9498'),
+        ('2018-11-28', '6.9534'),
+        ('2018-11-29', '6.9419'),
+        ('2018-11-30', '6.9558'),
+        ('2018-12-03', '6.8798'),
+        ('2018-12-04', '6.8343'),
+        ('2018-12-05', NULL),
+        ('2018-12-06', '6.8816'),
+        ('2018-12-07', '6.8733'),
+        ('2018-12-10', '6.9077'),
+        ('2018-12-11', '6.8968'),
+        ('2018-12-12', '6.8778'),
+        ('2018-12-13', '6.8798'),
+        ('2018-12-14', '6.905'),
+        ('2018-12-17', '6.8962'),
+        ('2018-12-18', '6.8939'),
+        ('2018-12-19', '6.8918'),
+        ('2018-12-20', '6.8834'),
+        ('2018-12-21', '6.9048'),
+        ('2018-12-24', NULL),
+        ('2018-12-25', NULL),
+        ('2018-12-26', '6.8847'),
+        ('2018-12-27', '6.8649'),
+        ('2018-12-28', '6.8755'),
+        ('2018-12-31', '6.8755'),
+        ('2019-01-01', NULL),
+        ('2019-01-02', '6.8597'),
+        ('2019-01-03', '6.8708'),
+        ('2019-01-04', '6.8663'),
+        ('2019-01-07', '6.8501'),
+        ('2019-01-08', '6.8519'),
+        ('2019-01-09', '6.8117'),
+        ('2019-01-10', '6.7875'),
+        ('2019-01-11', '6.7596'),
+        ('2019-01-14', NULL),
+        ('2019-01-15', '6.7595'),
+        ('2019-01-16', '6.7532'),
+        ('2019-01-17', '6.7735'),
+        ('2019-01-18', '6.7765'),
+        ('2019-01-21', NULL),
+        ('2019-01-22', '6.8072'),
+        ('2019-01-23', '6.7897'),
+        ('2019-01-24', '6.7835'),
+        ('2019-01-25', '6.7448'),
+        ('2019-01-28', '6.7437'),
+        ('2019-01-29', '6.7304'),
+        ('2019-01-30', '6.7115'),
+        ('2019-01-31', '6.6958'),
+        ('2019-02-01', '6.7426'),
+        ('2019-02-04', '6.7426'),
+        ('2019-02-05', '6.7426'),
+        ('2019-02-06', '6.7426'),
+        ('2019-02-07', '6.7426'),
+        ('2019-02-08', '6.7426'),
+        ('2019-02-11', '6.7907'),
+        ('2019-02-12', '6.7726'),
+        ('2019-02-13', '6.7598'),
+        ('2019-02-14', '6.7711'),
+        ('2019-02-15', '6.768'),
+        ('2019-02-18', NULL),
+        ('2019-02-19', '6.756'),
+        ('2019-02-20', NULL),
+        ('2019-02-21', '6.7195'),
+        ('2019-02-22', '6.7112'),
+        ('2019-02-25', '6.6844'),
+        ('2019-02-26', '6.698'),
+        ('2019-02-27', '6.6822'),
+        ('2019-02-28', '6.6912'),
+        ('2019-03-01', '6.7048'),
+        ('2019-03-04', '6.7045'),
+        ('2019-03-05', '6.706'),
+        ('2019-03-06', '6.7099'),
+        ('2019-03-07', '6.7132'),
+        ('2019-03-08', '6.7201'),
+        ('2019-03-11', '6.7215'),
+        ('2019-03-12', '6.707'),
+        ('2019-03-13', '6.7064'),
+        ('2019-03-14', '6.7209'),
+        ('2019-03-15', '6.7129'),
+        ('2019-03-18', '6.7121'),
+        ('2019-03-19', '6.7106'),
+        ('2019-03-20', '6.6916'),
+        ('2019-03-21', '6.6952'),
+        ('2019-03-22', '6.7162'),
+        ('2019-03-25', '6.7079'),
+        ('2019-03-26', '6.7145'),
+        ('2019-03-27', '6.7262'),
+        ('2019-03-28', '6.7381'),
+        ('2019-03-29', '6.7112'),
+        ('2019-04-01', '6.7102'),
+        ('2019-04-02', '6.7223'),
+        ('2019-04-03', '6.7098'),
+        ('2019-04-04', '6.7167'),
+        ('2019-04-05', '6.7182'),
+        ('2019-04-08', '6.7158'),
+        ('2019-04-09', '6.7111'),
+        ('2019-04-10', '6.7153'),
+        ('2019-04-11', '6.7188'),
+        ('2019-04-12', '6.7039'),
+        ('2019-04-15', '6.7072'),
+        ('2019-04-16', '6.7112'),
+        ('2019-04-17', '6.687'),
+        ('2019-04-18', '6.7081'),
+        ('2019-04-19', '6.7032'),
+        ('2019-04-22', '6.7112'),
+        ('2019-04-23', '6.7249'),
+        ('2019-04-24', '6.7207'),
+        ('2019-04-25', '6.7418'),
+        ('2019-04-26', '6.7282'),
+        ('2019-04-29', '6.7332'),
+        ('2019-04-30', '6.7347'),
+        ('2019-05-01', '6.7319'),
+        ('2019-05-02', '6.7337'),
+        ('2019-05-03', '6.7337'),
+        ('2019-05-06', '6.7602'),
+        ('2019-05-07', '6.7755'),
+        ('2019-05-08', '6.7818'),
+        ('2019-05-09', '6.8256'),
+        ('2019-05-10', '6.8217'),
+        ('2019-05-13', '6.8765'),
+        ('2019-05-14', '6.8737'),
+        ('2019-05-15', '6.8742'),
+        ('2019-05-16', '6.8832'),
+        ('2019-05-17', '6.9182'),
+        ('2019-05-20', '6.9111'),
+        ('2019-05-21', '6.9007'),
+        ('2019-05-22', '6.9054'),
+        ('2019-05-23', '6.9087'),
+        ('2019-05-24', '6.8997'),
+        ('2019-05-27', NULL),
+        ('2019-05-28', '6.9078'),
+        ('2019-05-29', '6.9138'),
+        ('2019-05-30', '6.9011'),
+        ('2019-05-31', '6.9027'),
+        ('2019-06-03', '6.9028'),
+        ('2019-06-04', '6.9072'),
+        ('2019-06-05', '6.9084'),
+        ('2019-06-06', '6.9082'),
+        ('2019-06-07', '6.9057'),
+        ('2019-06-10', '6.9298'),
+        ('2019-06-11', '6.9107'),
+        ('2019-06-12', '6.9161'),
+        ('2019-06-13', '6.9212'),
+        ('2019-06-14', '6.9247'),
+        ('2019-06-17', '6.9251'),
+        ('2019-06-18', '6.9002'),
+        ('2019-06-19', '6.8996'),
+        ('2019-06-20', '6.851'),
+        ('2019-06-21', '6.8686'),
+        ('2019-06-24', '6.8758'),
+        ('2019-06-25', '6.8791'),
+        ('2019-06-26', '6.8781'),
+        ('2019-06-27', '6.8762'),
+        ('2019-06-28', '6.865'),
+        ('2019-07-01', '6.8487'),
+        ('2019-07-02', '6.8717'),
+        ('2019-07-03', '6.8806'),
+        ('2019-07-04', NULL),
+        ('2019-07-05', '6.8925'),
+        ('2019-07-08', '6.8808'),
+        ('2019-07-09', '6.8866'),
+        ('2019-07-10', '6.8705'),
+        ('2019-07-11', '6.8682'),
+        ('2019-07-12', '6.8796'),
+        ('2019-07-15', '6.8769'),
+        ('2019-07-16', '6.8753'),
+        ('2019-07-17', '6.8731'),
+        ('2019-07-18', '6.8788'),
+        ('2019-07-19', '6.8812'),
+        ('2019-07-22', '6.8803'),
+        ('2019-07-23', '6.8787'),
+        ('2019-07-24', '6.8717'),
+        ('2019-07-25', '6.8714'),
+        ('2019-07-26', '6.8785'),
+        ('2019-07-29', '6.8927'),
+        ('2019-07-30', '6.8833'),
+        ('2019-07-31', '6.8833'),
+        ('2019-08-01', '6.8972'),
+        ('2019-08-02', '6.9387'),
+        ('2019-08-05', '7.0481'),
+        ('2019-08-06', '7.0192'),
+        ('2019-08-07', '7.0592'),
+        ('2019-08-08', '7.044'),
+        ('2019-08-09', '7.0613'),
+        ('2019-08-12', '7.0578'),
+        ('2019-08-13', '7.0423'),
+        ('2019-08-14', '7.0224'),
+        ('2019-08-15', '7.0327'),
+        ('2019-08-16', '7.0413'),
+        ('2019-08-19', '7.0499'),
+        ('2019-08-20', '7.0597'),
+        ('2019-08-21', '7.0623'),
+        ('2019-08-22', '7.0829'),
+        ('2019-08-23', '7.0928'),
+        ('2019-08-26', '7.1503'),
+        ('2019-08-27', '7.1603'),
+        ('2019-08-28', '7.1628'),
+        ('2019-08-29', '7.1434'),
+        ('2019-08-30', '7.1543'),
+        ('2019-09-02', NULL),
+        ('2019-09-03', '7.1786'),
+        ('2019-09-04', '7.144'),
+        ('2019-09-05', '7.1468'),
+        ('2019-09-06', '7.1131'),
+        ('2019-09-09', '7.121'),
+        ('2019-09-10', '7.1121'),
+        ('2019-09-11', '7.1153'),
+        ('2019-09-12', '7.0787'),
+        ('2019-09-13', '7.0754'),
+        ('2019-09-16', '7.0659'),
+        ('2019-09-17', '7.0905'),
+        ('2019-09-18', '7.0849'),
+        ('2019-09-19', '7.0959'),
+        ('2019-09-20', '7.0909'),
+        ('2019-09-23', '7.1168'),
+        ('2019-09-24', '7.1138'),
+        ('2019-09-25', '7.1313'),
+        ('2019-09-26', '7.13'),
+        ('2019-09-27', '7.1218'),
+        ('2019-09-30', '7.1477'),
+        ('2019-10-01', '7.1473'),
+        ('2019-10-02', '7.1473'),
+        ('2019-10-03', '7.1473'),
+        ('2019-10-04', '7.1473'),
+        ('2019-10-07', '7.1473'),
+        ('2019-10-08', '7.1422'),
+        ('2019-10-09', '7.1316'),
+        ('2019-10-10', '7.1153'),
+        ('2019-10-11', '7.0868'),
+        ('2019-10-14', NULL),
+        ('2019-10-15', '7.0814'),
+        ('2019-10-16', '7.092'),
+        ('2019-10-17', '7.0766'),
+        ('2019-10-18', '7.0805'),
+        ('2019-10-21', '7.0748'),
+        ('2019-10-22', '7.0761'),
+        ('2019-10-23', '7.0624'),
+        ('2019-10-24', '7.0684'),
+        ('2019-10-25', '7.0647'),
+        ('2019-10-28', '7.067'),
+        ('2019-10-29', '7.0655'),
+        ('2019-10-30', '7.0541'),
+        ('2019-10-31', '7.0379'),
+        ('2019-11-01', '7.0368'),
+        ('2019-11-04', '7.03'),
+        ('2019-11-05', '7.0082'),
+        ('2019-11-06', '6.9968'),
+        ('2019-11-07', '6.9766'),
+        ('2019-11-08', '6.9954'),
+        ('2019-11-11', NULL),
+        ('2019-11-12', '7.0066'),
+        ('2019-11-13', '7.0223'),
+        ('2019-11-14', '7.0195'),
+        ('2019-11-15', '7.0075'),
+        ('2019-11-18', '7.0246'),
+        ('2019-11-19', '7.0263'),
+        ('2019-11-20', '7.0344'),
+        ('2019-11-21', '7.0285'),
+        ('2019-11-22', '7.0389'),
+        ('2019-11-25', '7.0349'),
+        ('2019-11-26', '7.0319'),
+        ('2019-11-27', '7.028'),
+        ('2019-11-28', NULL),
+        ('2019-11-29', '7.0308'),
+        ('2019-12-02', '7.038'),
+        ('2019-12-03', '7.0609'),
+        ('2019-12-04', '7.0489'),
+        ('2019-12-05', '7.0445'),
+        ('2019-12-06', '7.0348'),
+        ('2019-12-09', '7.0389'),
+        ('2019-12-10', '7.0328'),
+        ('2019-12-11', '7.0384'),
+        ('2019-12-12', '6.9925'),
+        ('2019-12-13', '6.9925'),
+        ('2019-12-16', '6.9921'),
+        ('2019-12-17', '6.9967'),
+        ('2019-12-18', '7.0029'),
+        ('2019-12-19', '7.0097'),
+        ('2019-12-20', '7.0063'),
+        ('2019-12-23', '7.0124'),
+        ('2019-12-24', '7.0064'),
+        ('2019-12-25', NULL),
+        ('2019-12-26', '6.9949'),
+        ('2019-12-27', '6.9954'),
+        ('2019-12-30', '6.9864'),
+        ('2019-12-31', '6.9618'),
+        ('2020-01-01', NULL),
+        ('2020-01-02', '6.9642'),
+        ('2020-01-03', '6.9649'),
+        ('2020-01-06', '6.9749'),
+        ('2020-01-07', '6.9443'),
+        ('2020-01-08', '6.9447'),
+        ('2020-01-09', '6.9309'),
+        ('2020-01-10', '6.9178'),
+        ('2020-01-13', '6.8924'),
+        ('2020-01-14', '6.8837'),
+        ('2020-01-15', '6.8899'),
+        ('2020-01-16', '6.8769'),
+        ('2020-01-17', '6.8589'),
+        ('2020-01-20', NULL),
+        ('2020-01-21', '6.9049'),
+        ('2020-01-22', '6.904'),
+        ('2020-01-23', '6.9364'),
+        ('2020-01-24', '6.9161'),
+        ('2020-01-27', '6.9161'),
+        ('2020-01-28', '6.9161'),
+        ('2020-01-29', '6.9161'),
+        ('2020-01-30', '6.9161'),
+        ('2020-01-31', '6.9161'),
+        ('2020-02-03', '7.0208'),
+        ('2020-02-04', '6.9979'),
+        ('2020-02-05', '6.972'),
+        ('2020-02-06', '6.9694'),
+        ('2020-02-07', '7.0003'),
+        ('2020-02-10', '6.982'),
+        ('2020-02-11', '6.965'),
+        ('2020-02-12', '6.9708'),
+        ('2020-02-13', '6.9759'),
+        ('2020-02-14', '6.9859'),
+        ('2020-02-17', NULL),
+        ('2020-02-18', '6.9949'),
+        ('2020-02-19', '6.9975'),
+        ('2020-02-20', '7.0225'),
+        ('2020-02-21', '7.0255'),
+        ('2020-02-24', '7.0286'),
+        ('2020-02-25', '7.0128'),
+        ('2020-02-26', '7.0214'),
+        ('2020-02-27', '7.0036'),
+        ('2020-02-28', '6.9906'),
+        ('2020-03-02', '6.9595'),
+        ('2020-03-03', '6.9579'),
+        ('2020-03-04', '6.9244'),
+        ('2020-03-05', '6.9366'),
+        ('2020-03-06', '6.9298'),
+        ('2020-03-09', '6.9448'),
+        ('2020-03-10', '6.9549'),
+        ('2020-03-11', '6.9595'),
+        ('2020-03-12', '7.0282'),
+        ('2020-03-13', '7.0079'),
+        ('2020-03-16', '6.9913'),
+        ('2020-03-17', '7.003'),
+        ('2020-03-18', '7.0459'),
+        ('2020-03-19', '7.1058'),
+        ('2020-03-20', '7.095'),
+        ('2020-03-23', '7.09'),
+        ('2020-03-24', '7.061'),
+        ('2020-03-25', '7.1099'),
+        ('2020-03-26', '7.0709'),
+        ('2020-03-27', '7.0942'),
+        ('2020-03-30', '7.0986'),
+        ('2020-03-31', '7.0808'),
+        ('2020-04-01', '7.0989'),
+        ('2020-04-02', '7.0857'),
+        ('2020-04-03', '7.0908'),
+        ('2020-04-06', '7.0895'),
+        ('2020-04-07', '7.0448'),
+        ('2020-04-08', '7.0649'),
+        ('2020-04-09', '7.0428'),
+        ('2020-04-10', '7.0341'),
+        ('2020-04-13', '7.0501'),
+        ('2020-04-14', '7.0479'),
+        ('2020-04-15', '7.0664'),
+        ('2020-04-16', '7.0778'),
+        ('2020-04-17', '7.0711'),
+        ('2020-04-20', '7.0721'),
+        ('2020-04-21', '7.0914'),
+        ('2020-04-22', '7.0831'),
+        ('2020-04-23', '7.0654'),
+        ('2020-04-24', '7.0813'),
+        ('2020-04-27', '7.0848'),
+        ('2020-04-28', '7.0776'),
+        ('2020-04-29', '7.0755'),
+        ('2020-04-30', '7.0622'),
+        ('2020-05-01', '7.0622'),
+        ('2020-05-04', '7.0622'),
+        ('2020-05-05', '7.0622'),
+        ('2020-05-06', '7.1031'),
+        ('2020-05-07', '7.0825'),
+        ('2020-05-08', '7.0732'),
+        ('2020-05-11', '7.0972'),
+        ('2020-05-12', '7.0816'),
+        ('2020-05-13', '7.0911'),
+        ('2020-05-14', '7.0935'),
+        ('2020-05-15', '7.1013'),
+        ('2020-05-18', '7.1086'),
+        ('2020-05-19', '7.098'),
+        ('2020-05-20', '7.0928'),
+        ('2020-05-21', '7.1138'),
+        ('2020-05-22', '7.1269'),
+        ('2020-05-25', NULL),
+        ('2020-05-26', '7.134'),
+        ('2020-05-27', '7.1681'),
+        ('2020-05-28', '7.1447'),
+        ('2020-05-29', '7.1348'),
+        ('2020-06-01', '7.1263'),
+        ('2020-06-02', '7.0992'),
+        ('2020-06-03', '7.1115'),
+        ('2020-06-04', '7.1075'),
+        ('2020-06-05', '7.0808'),
+        ('2020-06-08', '7.07'),
+        ('2020-06-09', '7.0765'),
+        ('2020-06-10', '7.0599'),
+        ('2020-06-11', '7.064'),
+        ('2020-06-12', '7.0825'),
+        ('2020-06-15', '7.0887'),
+        ('2020-06-16', '7.0854'),
+        ('2020-06-17', '7.084'),
+        ('2020-06-18', '7.0869'),
+        ('2020-06-19', '7.0707'),
+        ('2020-06-22', '7.0678'),
+        ('2020-06-23', '7.0575'),
+        ('2020-06-24', '7.077'),
+        ('2020-06-25', '7.0768'),
+        ('2020-06-26', '7.0768'),
+        ('2020-06-29', '7.0794'),
+        ('2020-06-30', '7.0651'),
+        ('2020-07-01', '7.0703'),
+        ('2020-07-02', '7.066'),
+        ('2020-07-03', NULL),
+        ('2020-07-06', '7.0159'),
+        ('2020-07-07', '7.0127'),
+        ('2020-07-08', '7.0041'),
+        ('2020-07-09', '6.9923'),
+        ('2020-07-10', '6.999'),
+        ('2020-07-13', '6.9959'),
+        ('2020-07-14', '7.006'),
+        ('2020-07-15', '6.9885'),
+        ('2020-07-16', '6.9881'),
+        ('2020-07-17', '6.9912'),
+        ('2020-07-20', '6.9829'),
+        ('2020-07-21', '6.98'),
+        ('2020-07-22', '6.9988'),
+        ('2020-07-23', '7.0034'),
+        ('2020-07-24', '7.0168'),
+        ('2020-07-27', '6.995'),
+        ('2020-07-28', '6.9998'),
+        ('2020-07-29', '7.0014'),
+        ('2020-07-30', '7.008'),
+        ('2020-07-31', '6.9744'),
+        ('2020-08-03', '6.9799'),
+        ('2020-08-04', '6.972'),
+        ('2020-08-05', '6.9353'),
+        ('2020-08-06', '6.952'),
+        ('2020-08-07', '6.967'),
+        ('2020-08-10', '6.9611'),
+        ('2020-08-11', '6.9451'),
+        ('2020-08-12', '6.9363'),
+        ('2020-08-13', '6.944'),
+        ('2020-08-14', '6.9501'),
+        ('2020-08-17', '6.9318'),
+        ('2020-08-18', '6.9215'),
+        ('2020-08-19', '6.9192'),
+        ('2020-08-20', '6.9143'),
+        ('2020-08-21', '6.9179'),
+        ('2020-08-24', '6.918'),
+        ('2020-08-25', '6.9116'),
+        ('2020-08-26', '6.8855'),
+        ('2020-08-27', '6.8925'),
+        ('2020-08-28', '6.8647'),
+        ('2020-08-31', '6.8474'),
+        ('2020-09-01', '6.8272'),
+        ('2020-09-02', '6.8375'),
+        ('2020-09-03', '6.8474'),
+        ('2020-09-04', '6.8409'),
+        ('2020-09-07', NULL),
+        ('2020-09-08', '6.8435'),
+        ('2020-09-09', '6.8308'),
+        ('2020-09-10', '6.8335'),
+        ('2020-09-11', '6.833'),
+        ('2020-09-14', '6.808'),
+        ('2020-09-15', '6.7819'),
+        ('2020-09-16', '6.7529'),
+        ('2020-09-17', '6.7628'),
+        ('2020-09-18', '6.7675'),
+        ('2020-09-21', '6.8043'),
+        ('2020-09-22', '6.7779'),
+        ('2020-09-23', '6.8089'),
+        ('2020-09-24', '6.8273'),
+        ('2020-09-25', '6.822'),
+        ('2020-09-28', '6.8106'),
+        ('2020-09-29', '6.815'),
+        ('2020-09-30', '6.7896'),
+        ('2020-10-01', '6.7898'),
+        ('2020-10-02', '6.7898'),
+        ('2020-10-05', '6.7896'),
+        ('2020-10-06', '6.7896'),
+        ('2020-10-07', '6.7896'),
+        ('2020-10-08', '6.7896'),
+        ('2020-10-09', '6.6933'),
+        ('2020-10-12', NULL),
+        ('2020-10-13', '6.7452'),
+        ('2020-10-14', '6.7136'),
+        ('2020-10-15', '6.7233'),
+        ('2020-10-16', '6.6962'),
+        ('2020-10-19', '6.6803'),
+        ('2020-10-20', '6.6754'),
+        ('2020-10-21', '6.6503'),
+        ('2020-10-22', '6.6821'),
+        ('2020-10-23', '6.6861'),
+        ('2020-10-26', '6.7119'),
+        ('2020-10-27', '6.7044'),
+        ('2020-10-28', '6.7279'),
+        ('2020-10-29', '6.714'),
+        ('2020-10-30', '6.6919'),
+        ('2020-11-02', '6.6899'),
+        ('2020-11-03', '6.6759'),
+        ('2020-11-04', '6.6504'),
+        ('2020-11-05', '6.6027'),
+        ('2020-11-06', '6.608'),
+        ('2020-11-09', '6.628'),
+        ('2020-11-10', '6.6146'),
+        ('2020-11-11', NULL),
+        ('2020-11-12', '6.6114'),
+        ('2020-11-13', '6.6039'),
+        ('2020-11-16', '6.583'),
+        ('2020-11-17', '6.5556'),
+        ('2020-11-18', '6.5588'),
+        ('2020-11-19', '6.5823'),
+        ('2020-11-20', '6.5608'),
+        ('2020-11-23', '6.585'),
+        ('2020-11-24', '6.59'),
+        ('2020-11-25', '6.575'),
+        ('2020-11-26', NULL),
+        ('2020-11-27', NULL),
+        ('2020-11-30', '6.576'),
+        ('2020-12-01', '6.5705'),
+        ('2020-12-02', '6.5622'),
+        ('2020-12-03', '6.5418'),
+        ('2020-12-04', '6.5301'),
+        ('2020-12-07', '6.5295'),
+        ('2020-12-08', '6.5307'),
+        ('2020-12-09', '6.541'),
+        ('2020-12-10', '6.5419'),
+        ('2020-12-11', '6.5445'),
+        ('2020-12-14', '6.548'),
+        ('2020-12-15', '6.5388'),
+        ('2020-12-16', '6.5323'),
+        ('2020-12-17', '6.5321'),
+        ('2020-12-18', '6.5395'),
+        ('2020-12-21', '6.5493'),
+        ('2020-12-22', '6.5426'),
+        ('2020-12-23', '6.54'),
+        ('2020-12-24', NULL),
+        ('2020-12-25', NULL),
+        ('2020-12-28', '6.5346'),
+        ('2020-12-29', '6.53'),
+        ('2020-12-30', '6.5208'),
+        ('2020-12-31', '6.525'),
+        ('2021-01-01', NULL),
+        ('2021-01-04', '6.4601'),
+        ('2021-01-05', '6.455'),
+        ('2021-01-06', '6.4617'),
+        ('2021-01-07', '6.476'),
+        ('2021-01-08', '6.475'),
+        ('2021-01-11', '6.4795'),
+        ('2021-01-12', '6.461'),
+        ('2021-01-13', '6.4677'),
+        ('2021-01-14', '6.4736'),
+        ('2021-01-15', '6.48'),
+        ('2021-01-18', NULL),
+        ('2021-01-19', '6.4777'),
+        ('2021-01-20', NULL),
+        ('2021-01-21', '6.4595'),
+        ('2021-01-22', '6.481'),
+        ('2021-01-25', '6.4784'),
+        ('2021-01-26', '6.4635'),
+        ('2021-01-27', '6.4822'),
+        ('2021-01-28', '6.4491'),
+        ('2021-01-29', '6.4282')
+)
+INSERT INTO
+    exchange_rates (source_currency_id, destination_currency_id, date, rate)
+SELECT
+    source.id,
+    destination.id,
+    CAST(rates.date AS DATE),
+    CAST(rates.rate AS NUMERIC)
+FROM
+    rates,
+    currencies_codes source,
+    currencies_codes destination
+WHERE
+    source.code = 'CNY'
+    AND destination.code = 'USD'
+ON CONFLICT DO NOTHING;
+
+-- JPYUSD.csv
+WITH rates (date, rate) AS (
+    VALUES
+        ('2016-01-29', '121.0500'),
+        ('2016-02-01', '121.0600'),
+        ('2016-02-02', '120.2700'),
+        ('2016-02-03', '117.9200'),
+        ('2016-02-04', '116.7500'),
+        ('2016-02-05', '116.9900'),
+        ('2016-02-08', '115.7000'),
+        ('2016-02-09', '114.8000'),
+        ('2016-02-10', '114.0300'),
+        ('2016-02-11', '111.7200'),
+        ('2016-02-12', '112.9600'),
+        ('2016-02-15', NULL),
+        ('2016-02-16', '113.9000'),
+        ('2016-02-17', '114.1400'),
+        ('2016-02-18', '113.6200'),
+        ('2016-02-19', '112.4200'),
+        ('2016-02-22', '113.1200'),
+        ('2016-02-23', '112.1700'),
+        ('2016-02-24', '111.3600'),
+        ('2016-02-25', '112.7000'),
+        ('2016-02-26', '113.7800'),
+        ('2016-02-29', '112.9000'),
+        ('2016-03-01', '113.9400'),
+        ('2016-03-02', '113.8100'),
+        ('2016-03-03', '113.5300'),
+        ('2016-03-04', '113.9100'),
+        ('2016-03-07', '113.6000'),
+        ('2016-03-08', '112.5800'),
+        ('2016-03-09', '113.0600'),
+        ('2016-03-10', '113.1000'),
+        ('2016-03-11', '113.6600'),
+        ('2016-03-14', '113.5900'),
+        ('2016-03-15', '112.8800'),
+        ('2016-03-16', '113.6900'),
+        ('2016-03-17', '111.3000'),
+        ('2016-03-18', '111.3800'),
+        ('2016-03-21', '111.6200'),
+        ('2016-03-22', '111.8200'),
+        ('2016-03-23', '112.6300'),
+        ('2016-03-24', '112.6400'),
+        ('2016-03-25', '113.0500'),
+        ('2016-03-28', '113.2900'),
+        ('2016-03-29', '113.3300'),
+        ('2016-03-30', '112.6000'),
+        ('2016-03-31', '112.4200'),
+        ('2016-04-01', '112.0600'),
+        ('2016-04-04', '111.1800'),
+        ('2016-04-05', '110.2600'),
+        ('2016-04-06', '109.6300'),
+        ('2016-04-07', '107.9800'),
+        ('2016-04-08', '108.3600'),
+        ('2016-04-11', '107.9600'),
+        ('2016-04-12', '108.5400'),
+        ('2016-04-13', '109.2100'),
+        ('2016-04-14', '109.2000'),
+        ('2016-04-15', '108.7600'),
+        ('2016-04-18', '108.8500'),
+        ('2016-04-19', '109.1600'),
+        ('2016-04-20', '109.5100'),
+        ('2016-04-21', '109.4100'),
+        ('2016-04-22', '111.5000'),
+        ('2016-04-25', '111.0800'),
+        ('2016-04-26', '111.2300'),
+        ('2016-04-27', '111.2600'),
+        ('2016-04-28', '108.5500'),
+        ('2016-04-29', '106.9000'),
+        ('2016-05-02', '106.4800'),
+        ('2016-05-03', '106.3400'),
+        ('2016-05-04', '107.1200'),
+        ('2016-05-05', '107.3200'),
+        ('2016-05-06', '106.6600'),
+        ('2016-05-09', '108.3900'),
+        ('2016-05-10', '109.2200'),
+        ('2016-05-11', '108.4800'),
+        ('2016-05-12', '108.7300'),
+        ('2016-05-13', '109.1400'),
+        ('2016-05-16', '108.8900'),
+        ('2016-05-17', '109.0200'),
+        ('2016-05-18', '109.6500'),
+        ('2016-05-19', '109.8100'),
+        ('2016-05-20', '110.5200'),
+        ('2016-05-23', '109.4700'),
+        ('2016-05-24', '110.0000'),
+        ('2016-05-25', '110.2200'),
+        ('2016-05-26', '109.7400'),
+        ('2016-05-27', '109.8600'),
+        ('2016-05-30', NULL),
+        ('2016-05-31', '110.7500'),
+        ('2016-06-01', '109.5500'),
+        ('2016-06-02', '108.7500'),
+        ('2016-06-03', '106.8800'),
+        ('2016-06-06', '107.3300'),
+        ('2016-06-07', '107.3000'),
+        ('2016-06-08', '106.6800'),
+        ('2016-06-09', '106.5400'),
+        ('2016-06-10', '107.0600'),
+        ('2016-06-13', '106.0700'),
+        ('2016-06-14', '106.0200'),
+        ('2016-06-15', '105.8700'),
+        ('2016-06-16', '104.0500'),
+        ('2016-06-17', '104.2000'),
+        ('2016-06-20', '104.3200'),
+        ('2016-06-21', '104.5600'),
+        ('2016-06-22', '104.5600'),
+        ('2016-06-23', '105.9000'),
+        ('2016-06-24', '102.2600'),
+        ('2016-06-27', '101.6600'),
+        ('2016-06-28', '102.7100'),
+        ('2016-06-29', '102.6800'),
+        ('2016-06-30', '102.7700'),
+        ('2016-07-01', '102.5500'),
+        ('2016-07-04', NULL),
+        ('2016-07-05', '101.5800'),
+        ('2016-07-06', '101.1200'),
+        ('2016-07-07', '100.7400'),
+        ('2016-07-08', '100.6500'),
+        ('2016-07-11', '102.6600'),
+        ('2016-07-12', '104.7200'),
+        ('2016-07-13', '104.1000'),
+        ('2016-07-14', '105.4000'),
+        ('2016-07-15', '105.6400'),
+        ('2016-07-18', '106.0000'),
+        ('2016-07-19', '106.1300'),
+        ('2016-07-20', '106.6500'),
+        ('2016-07-21', '105.9800'),
+        ('2016-07-22', '106.2200'),
+        ('2016-07-25', '106.0200'),
+        ('2016-07-26', '104.8300'),
+        ('2016-07-27', '105.6500'),
+        ('2016-07-28', '104.8600'),
+        ('2016-07-29', '102.3200'),
+        ('2016-08-01', '102.2600'),
+        ('2016-08-02', '100.8200'),
+        ('2016-08-03', '101.3400'),
+        ('2016-08-04', '101.0800'),
+        ('2016-08-05', '101.7400'),
+        ('2016-08-08', '102.5300'),
+        ('2016-08-09', '101.9600'),
+        ('2016-08-10', '101.2700'),
+        ('2016-08-11', '101.4600'),
+        ('2016-08-12', '101.0100'),
+        ('2016-08-15', '101.0200'),
+        ('2016-08-16', '100.2800'),
+        ('2016-08-17', '100.2400'),
+        ('2016-08-18', '100.0700'),
+        ('2016-08-19', '100.2400'),
+        ('2016-08-22', '100.2500'),
+        ('2016-08-23', '100.2000'),
+        ('2016-08-24', '100.5000'),
+        ('2016-08-25', '100.5800'),
+        ('2016-08-26', '101.2000'),
+        ('2016-08-29', '102.1300'),
+        ('2016-08-30', '102.9200'),
+        ('2016-08-31', '103.3800'),
+        ('2016-09-01', '103.2200'),
+        ('2016-09-02', '104.1800'),
+        ('2016-09-05', NULL),
+        ('2016-09-06', '102.2800'),
+        ('2016-09-07', '101.7200'),
+        ('2016-09-08', '102.2800'),
+        ('2016-09-09', '102.8600'),
+        ('2016-09-12', '101.9600'),
+        ('2016-09-13', '102.1400'),
+        ('2016-09-14', '102.3300'),
+        ('2016-09-15', '102.2400'),
+        ('2016-09-16', '102.3600'),
+        ('2016-09-19', '101.8200'),
+        ('2016-09-20', '101.6600'),
+        ('2016-09-21', '100.6200'),
+        ('2016-09-22', '100.8000'),
+        ('2016-09-23', '101.0400'),
+        ('2016-09-26', '100.3400'),
+        ('2016-09-27', '100.3600'),
+        ('2016-09-28', '100.5700'),
+        ('2016-09-29', '101.4800'),
+        ('2016-09-30', '101.2100'),
+        ('2016-10-03', '101.5400'),
+        ('2016-10-04', '102.6600'),
+        ('2016-10-05', '103.5300'),
+        ('2016-10-06', '104.0200'),
+        ('2016-10-07', '103.4200'),
+        ('2016-10-10', NULL),
+        ('2016-10-11', '103.3600'),
+        ('2016-10-12', '104.4800'),
+        ('2016-10-13', '103.6200'),
+        ('2016-10-14', '104.1000'),
+        ('2016-10-17', '103.9600'),
+        ('2016-10-18', '103.8000'),
+        ('2016-10-19', '103.3000'),
+        ('2016-10-20', '103.9200'),
+        ('2016-10-21', '103.9600'),
+        ('2016-10-24', '104.2000'),
+        ('2016-10-25', '104.2500'),
+        ('2016-10-26', '104.4200'),
+        ('2016-10-27', '105.1400'),
+        ('2016-10-28', '105.4000'),
+        ('2016-10-31', '105.0700'),
+        ('2016-11-01', '104.5900'),
+        ('2016-11-02', '103.2200'),
+        ('2016-11-03', '103.1700'),
+        ('2016-11-04', '103.0200'),
+        ('2016-11-07', '104.6000'),
+        ('2016-11-08', '104.9800'),
+        ('2016-11-09', '104.8400'),
+        ('2016-11-10', '106.5600'),
+        ('2016-11-11', NULL),
+        ('2016-11-14', '108.3000'),
+        ('2016-11-15', '108.9400'),
+        ('2016-11-16', '109.1600'),
+        ('2016-11-17', '109.6400'),
+        ('2016-11-18', '110.5100'),
+        ('2016-11-21', '111.1200'),
+        ('2016-11-22', '110.9800'),
+        ('2016-11-23', '112.5800'),
+        ('2016-11-24', NULL),
+        ('2016-11-25', '113.1700'),
+        ('2016-11-28', '112.3600'),
+        ('2016-11-29', '112.7800'),
+        ('2016-11-30', '114.3400'),
+        ('2016-12-01', '114.3400'),
+        ('2016-12-02', '113.7600'),
+        ('2016-12-05', '114.1000'),
+        ('2016-12-06', '113.9800'),
+        ('2016-12-07', '113.5000'),
+        ('2016-12-08', '114.1600'),
+        ('2016-12-09', '115.1700'),
+        ('2016-12-12', '115.3400'),
+        ('2016-12-13', '115.2600'),
+        ('2016-12-14', '115.0600'),
+        ('2016-12-15', '118.3200'),
+        ('2016-12-16', '117.7400'),
+        ('2016-12-19', '116.6000'),
+        ('2016-12-20', '117.8600'),
+        ('2016-12-21', '117.7900'),
+        ('2016-12-22', '117.4800'),
+        ('2016-12-23', '117.2200'),
+        ('2016-12-26', NULL),
+        ('2016-12-27', '117.5200'),
+        ('2016-12-28', '117.6600'),
+        ('2016-12-29', '116.3200'),
+        ('2016-12-30', '116.7800'),
+        ('2017-01-02', NULL),
+        ('2017-01-03', '117.6800'),
+        ('2017-01-04', '117.3800'),
+        ('2017-01-05', '115.4600'),
+        ('2017-01-06', '116.8500'),
+        ('2017-01-09', '116.0700'),
+        ('2017-01-10', '115.7400'),
+        ('2017-01-11', '116.2600'),
+        ('2017-01-12', '113.8700'),
+        ('2017-01-13', '115.0300'),
+        ('2017-01-16', NULL),
+        ('2017-01-17', '112.9600'),
+        ('2017-01-18', '113.4000'),
+        ('2017-01-19', '115.3000'),
+        ('2017-01-20', NULL),
+        ('2017-01-23', '112.9000'),
+        ('2017-01-24', '113.6300'),
+        ('2017-01-25', '113.6000'),
+        ('2017-01-26', '114.7000'),
+        ('2017-01-27', '115.1600'),
+        ('2017-01-30', '113.8600'),
+        ('2017-01-31', '112.7200'),
+        ('2017-02-01', '113.2900'),
+        ('2017-02-02', '112.4800'),
+        ('2017-02-03', '112.4200'),
+        ('2017-02-06', '112.3200'),
+        ('2017-02-07', '112.1400'),
+        ('2017-02-08', '111.7400'),
+        ('2017-02-09', '113.2400'),
+        ('2017-02-10', '113.2200'),
+        ('2017-02-13', '113.7900'),
+        ('2017-02-14', '114.3400'),
+        ('2017-02-15', '114.1700'),
+        ('2017-02-16', '113.3600'),
+        ('2017-02-17', '112.8600'),
+        ('2017-02-20', NULL),
+        ('2017-02-21', '113.4800'),
+        ('2017-02-22', '113.4600'),
+        ('2017-02-23', '112.6800'),
+        ('2017-02-24', '112.2600'),
+        ('2017-02-27', '112.0100'),
+        ('2017-02-28', '112.0600'),
+        ('2017-03-01', '113.6600'),
+        ('2017-03-02', '114.4500'),
+        ('2017-03-03', '114.6000'),
+        ('2017-03-06', '113.9200'),
+        ('2017-03-07', '113.9900'),
+        ('2017-03-08', '114.5800'),
+        ('2017-03-09', '114.7100'),
+        ('2017-03-10', '115.0200'),
+        ('2017-03-13', '114.6100'),
+        ('2017-03-14', '114.6300'),
+        ('2017-03-15', '114.6600'),
+        ('2017-03-16', '113.0200'),
+        ('2017-03-17', '112.6700'),
+        ('2017-03-20', '112.5900'),
+        ('2017-03-21', '111.9000'),
+        ('2017-03-22', '111.0600'),
+        ('2017-03-23', '111.1200'),
+        ('2017-03-24', '111.0300'),
+        ('2017-03-27', '110.4800'),
+        ('2017-03-28', '110.5600'),
+        ('2017-03-29', '111.0500'),
+        ('2017-03-30', '111.3600'),
+        ('2017-03-31', '111.4100'),
+        ('2017-04-03', '110.9600'),
+        ('2017-04-04', '110.7400'),
+        ('2017-04-05', '111.2000'),
+        ('2017-04-06', '111.0200'),
+        ('2017-04-07', '110.7600'),
+        ('2017-04-10', '110.9500'),
+        ('2017-04-11', '109.7400'),
+        ('2017-04-12', '109.5400'),
+        ('2017-04-13', '109.2300'),
+        ('2017-04-14', '108.6300'),
+        ('2017-04-17', '108.4600'),
+        ('2017-04-18', '108.4000'),
+        ('2017-04-19', '109.0600'),
+        ('2017-04-20', '109.3400'),
+        ('2017-04-21', '109.0800'),
+        ('2017-04-24', '109.7400'),
+        ('2017-04-25', '110.8800'),
+        ('2017-04-26', '111.5200'),
+        ('2017-04-27', '111.1300'),
+        ('2017-04-28', '111.4400'),
+        ('2017-05-01', '111.7800'),
+        ('2017-05-02', '112.0600'),
+        ('2017-05-03', '112.3400'),
+        ('2017-05-04', '112.7000'),
+        ('2017-05-05', '112.6300'),
+        ('2017-05-08', '112.8400'),
+        ('2017-05-09', '114.1900'),
+        ('2017-05-10', '114.0200'),
+        ('2017-05-11', '113.7700'),
+        ('2017-05-12', '113.3000'),
+        ('2017-05-15', '113.5400'),
+        ('2017-05-16', '113.2400'),
+        ('2017-05-17', '111.4600'),
+        ('2017-05-18', '110.8200'),
+        ('2017-05-19', '111.4700'),
+        ('2017-05-22', '111.1500'),
+        ('2017-05-23', '111.6400'),
+        ('2017-05-24', '112.0200'),
+        ('2017-05-25', '111.7000'),
+        ('2017-05-26', '111.3000'),
+        ('2017-05-29', NULL),
+        ('2017-05-30', '110.6800'),
+        ('2017-05-31', '110.7100'),
+        ('2017-06-01', '111.2400'),
+        ('2017-06-02', '110.4900'),
+        ('2017-06-05', '110.4900'),
+        ('2017-06-06', '109.3400'),
+        ('2017-06-07', '109.5400'),
+        ('2017-06-08', '110.1000'),
+        ('2017-06-09', '110.6100'),
+        ('2017-06-12', '109.7200'),
+        ('2017-06-13', '110.1200'),
+        ('2017-06-14', '109.1600'),
+        ('2017-06-15', '110.6600'),
+        ('2017-06-16', '110.8400'),
+        ('2017-06-19', '111.3400'),
+        ('2017-06-20', '111.4600'),
+        ('2017-06-21', '111.5800'),
+        ('2017-06-22', '111.3600'),
+        ('2017-06-23', '111.2600'),
+        ('2017-06-26', '111.4800'),
+        ('2017-06-27', '112.4200'),
+        ('2017-06-28', '112.1800'),
+        ('2017-06-29', '112.3200'),
+        ('2017-06-30', '112.4000'),
+        ('2017-07-03', '113.3200'),
+        ('2017-07-04', NULL),
+        ('2017-07-05', '113.2000'),
+        ('2017-07-06', '113.2800'),
+        ('2017-07-07', '114.1000'),
+        ('2017-07-10', '114.0800'),
+        ('2017-07-11', '114.2000'),
+        ('2017-07-12', '113.1900'),
+        ('2017-07-13', '113.3900'),
+        ('2017-07-14', '112.6600'),
+        ('2017-07-17', '112.7600'),
+        ('2017-07-18', '111.8900'),
+        ('2017-07-19', '111.7700'),
+        ('2017-07-20', '111.6800'),
+        ('2017-07-21', '111.1800'),
+        ('2017-07-24', '111.1000'),
+        ('2017-07-25', '111.5700'),
+        ('2017-07-26', '112.1000'),
+        ('2017-07-27', '111.6200'),
+        ('2017-07-28', '110.8700'),
+        ('2017-07-31', '110.3800'),
+        ('2017-08-01', '110.3300'),
+        ('2017-08-02', '110.4300'),
+        ('2017-08-03', '110.1200'),
+        ('2017-08-04', '110.7600'),
+        ('2017-08-07', '110.7600'),
+        ('2017-08-08', '110.7400'),
+        ('2017-08-09', '110.0400'),
+        ('2017-08-10', '109.3700'),
+        ('2017-08-11', '109.0600'),
+        ('2017-08-14', '109.4800'),
+        ('2017-08-15', '110.4200'),
+        ('2017-08-16', '110.8000'),
+        ('2017-08-17', '109.8800'),
+        ('2017-08-18', '109.3200'),
+        ('2017-08-21', '108.8900'),
+        ('2017-08-22', '109.4000'),
+        ('2017-08-23', '109.1400'),
+        ('2017-08-24', '109.3400'),
+        ('2017-08-25', '109.2400'),
+        ('2017-08-28', '109.2200'),
+        ('2017-08-29', '109.0200'),
+        ('2017-08-30', '110.2000'),
+        ('2017-08-31', '110.0600'),
+        ('2017-09-01', '110.0900'),
+        ('2017-09-04', NULL),
+        ('2017-09-05', '108.9400'),
+        ('2017-09-06', '108.7500'),
+        ('2017-09-07', '108.2600'),
+        ('2017-09-08', '107.7200'),
+        ('2017-09-11', '109.0300'),
+        ('2017-09-12', '110.0100'),
+        ('2017-09-13', '110.5800'),
+        ('2017-09-14', '110.6600'),
+        ('2017-09-15', '110.8400'),
+        ('2017-09-18', '111.4800'),
+        ('2017-09-19', '111.4900'),
+        ('2017-09-20', '111.5000'),
+        ('2017-09-21', '112.3000'),
+        ('2017-09-22', '112.0100'),
+        ('2017-09-25', '111.6400'),
+        ('2017-09-26', '112.1600'),
+        ('2017-09-27', '112.7600'),
+        ('2017-09-28', '112.6600'),
+        ('2017-09-29', '112.6400'),
+        ('2017-10-02', '112.6000'),
+        ('2017-10-03', '112.8000'),
+        ('2017-10-04', '112.8400'),
+        ('2017-10-05', '112.7400'),
+        ('2017-10-06', '112.6800'),
+        ('2017-10-09', NULL),
+        ('2017-10-10', '112.1500'),
+        ('2017-10-11', '112.3500'),
+        ('2017-10-12', '112.3800'),
+        ('2017-10-13', '111.9400'),
+        ('2017-10-16', '111.7200'),
+        ('2017-10-17', '112.3200'),
+        ('2017-10-18', '112.9400'),
+        ('2017-10-19', '112.5400'),
+        ('2017-10-20', '113.5000'),
+        ('2017-10-23', '113.6600'),
+        ('2017-10-24', '113.9200'),
+        ('2017-10-25', '113.7000'),
+        ('2017-10-26', '113.7200'),
+        ('2017-10-27', '113.8200'),
+        ('2017-10-30', '113.2600'),
+        ('2017-10-31', '113.6300'),
+        ('2017-11-01', '114.0400'),
+        ('2017-11-02', '113.8400'),
+        ('2017-11-03', '114.2500'),
+        ('2017-11-06', '113.9000'),
+        ('2017-11-07', '113.9000'),
+        ('2017-11-08', '113.6200'),
+        ('2017-11-09', '113.4000'),
+        ('2017-11-10', NULL),
+        ('2017-11-13', '113.6200'),
+        ('2017-11-14', '113.5000'),
+        ('2017-11-15', '113.1500'),
+        ('2017-11-16', '112.8900'),
+        ('2017-11-17', '111.9800'),
+        ('2017-11-20', '112.4900'),
+        ('2017-11-21', '112.4600'),
+        ('2017-11-22', '111.6200'),
+        ('2017-11-23', NULL),
+        ('2017-11-24', '111.5400'),
+        ('2017-11-27', '111.0000'),
+        ('2017-11-28', '111.1300'),
+        ('2017-11-29', '111.7500'),
+        ('2017-11-30', '112.3000'),
+        ('2017-12-01', '111.8800'),
+        ('2017-12-04', '112.8000'),
+        ('2017-12-05', '112.7600'),
+        ('2017-12-06', '112.3200'),
+        ('2017-12-07', '112.7000'),
+        ('2017-12-08', '113.5500'),
+        ('2017-12-11', '113.3400'),
+        ('2017-12-12', '113.6200'),
+        ('2017-12-13', '113.1900'),
+        ('2017-12-14', '112.5600'),
+        ('2017-12-15', '112.6800'),
+        ('2017-12-18', '112.4000'),
+        ('2017-12-19', '112.9800'),
+        ('2017-12-20', '113.2900'),
+        ('2017-12-21', '113.4200'),
+        ('2017-12-22', '113.3000'),
+        ('2017-12-25', NULL),
+        ('2017-12-26', '113.2000'),
+        ('2017-12-27', '113.2800'),
+        ('2017-12-28', '112.8500'),
+        ('2017-12-29', '112.6900'),
+        ('2018-01-01', NULL),
+        ('2018-01-02', '112.1800'),
+        ('2018-01-03', '112.2800'),
+        ('2018-01-04', '112.7800'),
+        ('2018-01-05', '113.1800'),
+        ('2018-01-08', '113.0800'),
+        ('2018-01-09', '112.5700'),
+        ('2018-01-10', '111.4800'),
+        ('2018-01-11', '111.3500'),
+        ('2018-01-12', '111.2300'),
+        ('2018-01-15', NULL),
+        ('2018-01-16', '110.7400'),
+        ('2018-01-17', '110.8100'),
+        ('2018-01-18', '110.8800'),
+        ('2018-01-19', '110.5600'),
+        ('2018-01-22', '111.1500'),
+        ('2018-01-23', '110.4600'),
+        ('2018-01-24', '109.1500'),
+        ('2018-01-25', '108.7000'),
+        ('2018-01-26', '108.3800'),
+        ('2018-01-29', '109.1200'),
+        ('2018-01-30', '108.9000'),
+        ('2018-01-31', '109.3100'),
+        ('2018-02-01', '109.5000'),
+        ('2018-02-02', '110.4000'),
+        ('2018-02-05', '110.0900'),
+        ('2018-02-06', '109.1800'),
+        ('2018-02-07', '109.5300'),
+        ('2018-02-08', '109.0000'),
+        ('2018-02-09', '108.4400'),
+        ('2018-02-12', '108.7200'),
+        ('2018-02-13', '107.5900'),
+        ('2018-02-14', '107.0000'),
+        ('2018-02-15', '106.3600'),
+        ('2018-02-16', '106.1000'),
+        ('2018-02-19', NULL),
+        ('2018-02-20', '107.2300'),
+        ('2018-02-21', '107.6400'),
+        ('2018-02-22', '106.9600'),
+        ('2018-02-23', '106.6400'),
+        ('2018-02-26', '106.96'),
+        ('2018-02-27', '107.47'),
+        ('2018-02-28', '106.62'),
+        ('2018-03-01', '106.91'),
+        ('2018-03-02', '105.65'),
+        ('2018-03-05', '105.97'),
+        ('2018-03-06', '106.03'),
+        ('2018-03-07', '105.94'),
+        ('2018-03-08', '106.08'),
+        ('2018-03-09', '106.74'),
+        ('2018-03-12', '106.52'),
+        ('2018-03-13', '106.64'),
+        ('2018-03-14', '106.17'),
+        ('2018-03-15', '106.07'),
+        ('2018-03-16', '106.1'),
+        ('2018-03-19', '105.83'),
+        ('2018-03-20', '106.42'),
+        ('2018-03-21', '106.3'),
+        ('2018-03-22', '105.34'),
+        ('2018-03-23', '104.83'),
+        ('2018-03-26', '105.0'),
+        ('2018-03-27', '105.72'),
+        ('2018-03-28', '106.3'),
+        ('2018-03-29', '106.27'),
+        ('2018-03-30', '106.2'),
+        ('2018-04-02', '105.99'),
+        ('2018-04-03', '106.45'),
+        ('2018-04-04', '106.56'),
+        ('2018-04-05', '107.48'),
+        ('2018-04-06', '107.03'),
+        ('2018-04-09', '107.06'),
+        ('2018-04-10', '107.3'),
+        ('2018-04-11', '106.78'),
+        ('2018-04-12', '107.3'),
+        ('2018-04-13', '107.52'),
+        ('2018-04-16', '107.26'),
+        ('2018-04-17', '107.09'),
+        ('2018-04-18', '107.26'),
+        ('2018-04-19', '107.42'),
+        ('2018-04-20', '107.6'),
+        ('2018-04-23', '108.62'),
+        ('2018-04-24', '109.03'),
+        ('2018-04-25', '109.33'),
+        ('2018-04-26', '109.3'),
+        ('2018-04-27', '109.12'),
+        ('2018-04-30', '109.28'),
+        ('2018-05-01', '109.69'),
+        ('2018-05-02', '109.89'),
+        ('2018-05-03', '109.06'),
+        ('2018-05-04', '109.16'),
+        ('2018-05-07', '109.14'),
+        ('2018-05-08', '109.16'),
+        ('2018-05-09', '109.68'),
+        ('2018-05-10', '109.53'),
+        ('2018-05-11', '109.26'),
+        ('2018-05-14', '109.52'),
+        ('2018-05-15', '110.25'),
+        ('2018-05-16', '110.22'),
+        ('2018-05-17', '110.76'),
+        ('2018-05-18', '110.71'),
+        ('2018-05-21', '111.08'),
+        ('2018-05-22', '110.94'),
+        ('2018-05-23', '110.05'),
+        ('2018-05-24', '109.3'),
+        ('2018-05-25', '109.35'),
+        ('2018-05-28', NULL),
+        ('2018-05-29', '108.62'),
+        ('2018-05-30', '109.04'),
+        ('2018-05-31', '108.73'),
+        ('2018-06-01', '109.49'),
+        ('2018-06-04', '109.66'),
+        ('2018-06-05', '109.63'),
+        ('2018-06-06', '110.0'),
+        ('2018-06-07', '109.92'),
+        ('2018-06-08', '109.45'),
+        ('2018-06-11', '109.94'),
+        ('2018-06-12', '110.22'),
+        ('2018-06-13', '110.41'),
+        ('2018-06-14', '110.44'),
+        ('2018-06-15', '110.58'),
+        ('2018-06-18', '110.44'),
+        ('2018-06-19', '110.02'),
+        ('2018-06-20', '110.14'),
+        ('2018-06-21', '110.05'),
+        ('2018-06-22', '109.98'),
+        ('2018-06-25', '109.62'),
+        ('2018-06-26', '109.92'),
+        ('2018-06-27', '110.34'),
+        ('2018-06-28', '110.38'),
+        ('2018-06-29', '110.71'),
+        ('2018-07-02', '110.73'),
+        ('2018-07-03', '110.54'),
+        ('2018-07-04', NULL),
+        ('2018-07-05', '110.59'),
+        ('2018-07-06', '110.48'),
+        ('2018-07-09', '110.78'),
+        ('2018-07-10', '111.24'),
+        ('2018-07-11', '111.52'),
+        ('2018-07-12', '112.41'),
+        ('2018-07-13', '112.38'),
+        ('2018-07-16', '112.29'),
+        ('2018-07-17', '112.79'),
+        ('2018-07-18', '112.82'),
+        ('2018-07-19', '112.98'),
+        ('2018-07-20', '111.74'),
+        ('2018-07-23', '111.36'),
+        ('2018-07-24', '111.27'),
+        ('2018-07-25', '111.02'),
+        ('2018-07-26', '111.2'),
+        ('2018-07-27', '110.94'),
+        ('2018-07-30', '110.98'),
+        ('2018-07-31', '111.88'),
+        ('2018-08-01', '111.72'),
+        ('2018-08-02', '111.48'),
+        ('2018-08-03', '111.12'),
+        ('2018-08-06', '111.43'),
+        ('2018-08-07', '111.19'),
+        ('2018-08-08', '110.96'),
+        ('2018-08-09', '110.9'),
+        ('2018-08-10', '110.66'),
+        ('2018-08-13', '110.65'),
+        ('2018-08-14', '110.92'),
+        ('2018-08-15', '110.62'),
+        ('2018-08-16', '110.89'),
+        ('2018-08-17', '110.54'),
+        ('2018-08-20', '110.38'),
+        ('2018-08-21', '110.48'),
+        ('2018-08-22', '110.54'),
+        ('2018-08-23', '111.14'),
+        ('2018-08-24', '111.22'),
+        ('2018-08-27', '111.09'),
+        ('2018-08-28', '111.04'),
+        ('2018-08-29', '111.8'),
+        ('2018-08-30', '111.17'),
+        ('2018-08-31', '110.98'),
+        ('2018-09-03', NULL),
+        ('2018-09-04', '111.44'),
+        ('2018-09-05', '111.47'),
+        ('2018-09-06', '110.87'),
+        ('2018-09-07', '111.18'),
+        ('2018-09-10', '111.09'),
+        ('2018-09-11', '111.54'),
+        ('2018-09-12', '111.29'),
+        ('2018-09-13', '111.92'),
+        ('2018-09-14', '112.09'),
+        ('2018-09-17', '111.97'),
+        ('2018-09-18', '112.3'),
+        ('2018-09-19', '112.21'),
+        ('2018-09-20', '112.58'),
+        ('2018-09-21', '112.62'),
+        ('2018-09-24', '112.62'),
+        ('2018-09-25', '112.88'),
+        ('2018-09-26', '112.92'),
+        ('2018-09-27', '113.38'),
+        ('2018-09-28', '113.48'),
+        ('2018-10-01', '113.96'),
+        ('2018-10-02', '113.66'),
+        ('2018-10-03', '114.19'),
+        ('2018-10-04', '113.79'),
+        ('2018-10-05', '113.56'),
+        ('2018-10-08', NULL),
+        ('2018-10-09', '113.13'),
+        ('2018-10-10', '112.71'),
+        ('2018-10-11', '112.26'),
+        ('2018-10-12', '112.02'),
+        ('2018-10-15', '111.82'),
+        ('2018-10-16', '112.16'),
+        ('2018-10-17', '112.21'),
+        ('2018-10-18', '112.11'),
+        ('2018-10-19', '112.52'),
+        ('2018-10-22', '112.78'),
+        ('2018-10-23', '112.12'),
+        ('2018-10-24', '112.58'),
+        ('2018-10-25', '112.54'),
+        ('2018-10-26', '111.65'),
+        ('2018-10-29', '112.49'),
+        ('2018-10-30', '112.76'),
+        ('2018-10-31', '112.86'),
+        ('2018-11-01', '112.79'),
+        ('2018-11-02', '113.09'),
+        ('2018-11-05', '113.22'),
+        ('2018-11-06', '113.38'),
+        ('2018-11-07', '113.32'),
+        ('2018-11-08', '113.87'),
+        ('2018-11-09', '113.77'),
+        ('2018-11-12', NULL),
+        ('2018-11-13', '113.97'),
+        ('2018-11-14', '113.7'),
+        ('2018-11-15', '113.46'),
+        ('2018-11-16', '112.76'),
+        ('2018-11-19', '112.54'),
+        ('2018-11-20', '112.66'),
+        ('2018-11-21', '113.06'),
+        ('2018-11-22', NULL),
+        ('2018-11-23', '112.83'),
+        ('2018-11-26', '113.58'),
+        ('2018-11-27', '113.84'),
+        ('2018-11-28', '113.96'),
+        ('2018-11-29', '113.42'),
+        ('2018-11-30', '113.54'),
+        ('2018-12-03', '113.54'),
+        ('2018-12-04', '112.93'),
+        ('2018-12-05', NULL),
+        ('2018-12-06', '112.46'),
+        ('2018-12-07', '112.6'),
+        ('2018-12-10', '112.96'),
+        ('2018-12-11', '113.41'),
+        ('2018-12-12', '113.28'),
+        ('2018-12-13', '113.66'),
+        ('2018-12-14', '113.35'),
+        ('2018-12-17', '112.96'),
+        ('2018-12-18', '112.54'),
+        ('2018-12-19', '112.15'),
+        ('2018-12-20', '111.34'),
+        ('2018-12-21', '111.14'),
+        ('2018-12-24', NULL),
+        ('2018-12-25', NULL),
+        ('2018-12-26', '110.45'),
+        ('2018-12-27', '110.78'),
+        ('2018-12-28', '110.34'),
+        ('2018-12-31', '109.7'),
+        ('2019-01-01', NULL),
+        ('2019-01-02', '109.22'),
+        ('2019-01-03', '108.07'),
+        ('2019-01-04', '108.29'),
+        ('2019-01-07', '108.62'),
+        ('2019-01-08', '108.57'),
+        ('2019-01-09', '108.38'),
+        ('2019-01-10', '108.29'),
+        ('2019-01-11', '108.34'),
+        ('2019-01-14', NULL),
+        ('2019-01-15', '108.72'),
+        ('2019-01-16', '108.84'),
+        ('2019-01-17', '109.01'),
+        ('2019-01-18', '109.79'),
+        ('2019-01-21', NULL),
+        ('2019-01-22', '109.34'),
+        ('2019-01-23', '109.44'),
+        ('2019-01-24', '109.72'),
+        ('2019-01-25', '109.6'),
+        ('2019-01-28', '109.2'),
+        ('2019-01-29', '109.36'),
+        ('2019-01-30', '109.57'),
+        ('2019-01-31', '108.84'),
+        ('2019-02-01', '109.55'),
+        ('2019-02-04', '109.96'),
+        ('2019-02-05', '109.99'),
+        ('2019-02-06', '109.75'),
+        ('2019-02-07', '109.7'),
+        ('2019-02-08', '109.76'),
+        ('2019-02-11', '110.4'),
+        ('2019-02-12', '110.5'),
+        ('2019-02-13', '110.86'),
+        ('2019-02-14', '110.68'),
+        ('2019-02-15', '110.54'),
+        ('2019-02-18', NULL),
+        ('2019-02-19', '110.68'),
+        ('2019-02-20', NULL),
+        ('2019-02-21', '110.72'),
+        ('2019-02-22', '110.7'),
+        ('2019-02-25', '111.07'),
+        ('2019-02-26', '110.76'),
+        ('2019-02-27', '110.92'),
+        ('2019-02-28', '111.38'),
+        ('2019-03-01', '111.89'),
+        ('2019-03-04', '111.68'),
+        ('2019-03-05', '111.98'),
+        ('2019-03-06', '111.66'),
+        ('2019-03-07', '111.6'),
+        ('2019-03-08', '111.11'),
+        ('2019-03-11', '111.21'),
+        ('2019-03-12', '111.38'),
+        ('2019-03-13', '111.23'),
+        ('2019-03-14', '111.71'),
+        ('2019-03-15', '111.57'),
+        ('2019-03-18', '111.41'),
+        ('2019-03-19', '111.38'),
+        ('2019-03-20', '111.41'),
+        ('2019-03-21', '110.86'),
+        ('2019-03-22', '109.76'),
+        ('2019-03-25', '110.09'),
+        ('2019-03-26', '110.5'),
+        ('2019-03-27', '110.38'),
+        ('2019-03-28', '110.54'),
+        ('2019-03-29', '110.68'),
+        ('2019-04-01', '111.29'),
+        ('2019-04-02', '111.32'),
+        ('2019-04-03', '111.48'),
+        ('2019-04-04', '111.55'),
+        ('2019-04-05', '111.71'),
+        ('2019-04-08', '111.47'),
+        ('2019-04-09', '111.08'),
+        ('2019-04-10', '110.92'),
+        ('2019-04-11', '111.48'),
+        ('2019-04-12', '112.0'),
+        ('2019-04-15', '112.0'),
+        ('2019-04-16', '111.95'),
+        ('2019-04-17', '112.0'),
+        ('2019-04-18', '111.94'),
+        ('2019-04-19', '111.92'),
+        ('2019-04-22', '111.94'),
+        ('2019-04-23', '111.86'),
+        ('2019-04-24', '111.81'),
+        ('2019-04-25', '111.59'),
+        ('2019-04-26', '111.6'),
+        ('2019-04-29', '111.8'),
+        ('2019-04-30', '111.4'),
+        ('2019-05-01', '111.18'),
+        ('2019-05-02', '111.39'),
+        ('2019-05-03', '111.28'),
+        ('2019-05-06', '110.86'),
+        ('2019-05-07', '110.34'),
+        ('2019-05-08', '110.12'),
+        ('2019-05-09', '109.55'),
+        ('2019-05-10', '109.56'),
+        ('2019-05-13', '109.18'),
+        ('2019-05-14', '109.68'),
+        ('2019-05-15', '109.58'),
+        ('2019-05-16', '109.89'),
+        ('2019-05-17', '109.9'),
+        ('2019-05-20', '109.96'),
+        ('2019-05-21', '110.6'),
+        ('2019-05-22', '110.28'),
+        ('2019-05-23', '109.58'),
+        ('2019-05-24', '109.38'),
+        ('2019-05-27', NULL),
+        ('2019-05-28', '109.49'),
+        ('2019-05-29', '109.24'),
+        ('2019-05-30', '109.67'),
+        ('2019-05-31', '108.66'),
+        ('2019-06-03', '108.39'),
+        ('2019-06-04', '108.3'),
+        ('2019-06-05', '108.2'),
+        ('2019-06-06', '108.08'),
+        ('2019-06-07', '108.15'),
+        ('2019-06-10', '108.56'),
+        ('2019-06-11', '108.52'),
+        ('2019-06-12', '108.42'),
+        ('2019-06-13', '108.44'),
+        ('2019-06-14', '108.44'),
+        ('2019-06-17', '108.56'),
+        ('2019-06-18', '108.39'),
+        ('2019-06-19', '108.36'),
+        ('2019-06-20', '107.52'),
+        ('2019-06-21', '107.55'),
+        ('2019-06-24', '107.36'),
+        ('2019-06-25', '106.92'),
+        ('2019-06-26', '107.64'),
+        ('2019-06-27', '107.73'),
+        ('2019-06-28', '107.84'),
+        ('2019-07-01', '108.42'),
+        ('2019-07-02', '107.98'),
+        ('2019-07-03', '107.84'),
+        ('2019-07-04', NULL),
+        ('2019-07-05', '108.55'),
+        ('2019-07-08', '108.72'),
+        ('2019-07-09', '108.78'),
+        ('2019-07-10', '108.44'),
+        ('2019-07-11', '108.29'),
+        ('2019-07-12', '108.0'),
+        ('2019-07-15', '107.89'),
+        ('2019-07-16', '108.35'),
+        ('2019-07-17', '108.1'),
+        ('2019-07-18', '107.74'),
+        ('2019-07-19', '107.78'),
+        ('2019-07-22', '107.91'),
+        ('2019-07-23', '108.06'),
+        ('2019-07-24', '108.08'),
+        ('2019-07-25', '108.58'),
+        ('2019-07-26', '108.69'),
+        ('2019-07-29', '108.86'),
+        ('2019-07-30', '108.66'),
+        ('2019-07-31', '108.58'),
+        ('2019-08-01', '108.28'),
+        ('2019-08-02', '106.64'),
+        ('2019-08-05', '106.02'),
+        ('2019-08-06', '106.45'),
+        ('2019-08-07', '105.62'),
+        ('2019-08-08', '106.04'),
+        ('2019-08-09', '105.3'),
+        ('2019-08-12', '105.38'),
+        ('2019-08-13', '106.6'),
+        ('2019-08-14', '105.79'),
+        ('2019-08-15', '106.12'),
+        ('2019-08-16', '106.34'),
+        ('2019-08-19', '106.5'),
+        ('2019-08-20', '106.35'),
+        ('2019-08-21', '106.52'),
+        ('2019-08-22', '106.44'),
+        ('2019-08-23', '105.3'),
+        ('2019-08-26', '106.0'),
+        ('2019-08-27', '105.8'),
+        ('2019-08-28', '105.86'),
+        ('2019-08-29', '106.5'),
+        ('2019-08-30', '106.3'),
+        ('2019-09-02', NULL),
+        ('2019-09-03', '105.88'),
+        ('2019-09-04', '106.28'),
+        ('2019-09-05', '106.96'),
+        ('2019-09-06', '106.8'),
+        ('2019-09-09', '107.14'),
+        ('2019-09-10', '107.34'),
+        ('2019-09-11', '107.73'),
+        ('2019-09-12', '107.97'),
+        ('2019-09-13', '108.12'),
+        ('2019-09-16', '107.94'),
+        ('2019-09-17', '108.12'),
+        ('2019-09-18', '108.17'),
+        ('2019-09-19', '108.0'),
+        ('2019-09-20', '107.95'),
+        ('2019-09-23', '107.47'),
+        ('2019-09-24', '107.32'),
+        ('2019-09-25', '107.78'),
+        ('2019-09-26', '107.67'),
+        ('2019-09-27', '108.05'),
+        ('2019-09-30', '108.11'),
+        ('2019-10-01', '107.7'),
+        ('2019-10-02', '107.21'),
+        ('2019-10-03', '106.76'),
+        ('2019-10-04', '106.91'),
+        ('2019-10-07', '107.06'),
+        ('2019-10-08', '107.06'),
+        ('2019-10-09', '107.43'),
+        ('2019-10-10', '107.91'),
+        ('2019-10-11', '108.52'),
+        ('2019-10-14', NULL),
+        ('2019-10-15', '108.83'),
+        ('2019-10-16', '108.71'),
+        ('2019-10-17', '108.51'),
+        ('2019-10-18', '108.44'),
+        ('2019-10-21', '108.57'),
+        ('2019-10-22', '108.56'),
+        ('2019-10-23', '108.64'),
+        ('2019-10-24', '108.62'),
+        ('2019-10-25', '108.75'),
+        ('2019-10-28', '109.02'),
+        ('2019-10-29', '108.83'),
+        ('2019-10-30', '108.88'),
+        ('2019-10-31', '108.09'),
+        ('2019-11-01', '108.16'),
+        ('2019-11-04', '108.56'),
+        ('2019-11-05', '109.13'),
+        ('2019-11-06', '108.86'),
+        ('2019-11-07', '109.42'),
+        ('2019-11-08', '109.14'),
+        ('2019-11-11', NULL),
+        ('2019-11-12', '109.09'),
+        ('2019-11-13', '108.83'),
+        ('2019-11-14', '108.33'),
+        ('2019-11-15', '108.8'),
+        ('2019-11-18', '108.61'),
+        ('2019-11-19', '108.59'),
+        ('2019-11-20', '108.67'),
+        ('2019-11-21', '108.53'),
+        ('2019-11-22', '108.67'),
+        ('2019-11-25', '108.95'),
+        ('2019-11-26', '109.11'),
+        ('2019-11-27', '109.38'),
+        ('2019-11-28', NULL),
+        ('2019-11-29', '109.47'),
+        ('2019-12-02', '109.09'),
+        ('2019-12-03', '108.53'),
+        ('2019-12-04', '108.87'),
+        ('2019-12-05', '108.69'),
+        ('2019-12-06', '108.66'),
+        ('2019-12-09', '108.59'),
+        ('2019-12-10', '108.73'),
+        ('2019-12-11', '108.67'),
+        ('2019-12-12', '109.16'),
+        ('2019-12-13', '109.28'),
+        ('2019-12-16', '109.64'),
+        ('2019-12-17', '109.52'),
+        ('2019-12-18', '109.58'),
+        ('2019-12-19', '109.23'),
+        ('2019-12-20', '109.45'),
+        ('2019-12-23', '109.39'),
+        ('2019-12-24', '109.38'),
+        ('2019-12-25', NULL),
+        ('2019-12-26', '109.67'),
+        ('2019-12-27', '109.47'),
+        ('2019-12-30', '108.85'),
+        ('2019-12-31', '108.67'),
+        ('2020-01-01', NULL),
+        ('2020-01-02', '108.43'),
+        ('2020-01-03', '107.94'),
+        ('2020-01-06', '108.36'),
+        ('2020-01-07', '108.53'),
+        ('2020-01-08', '109.03'),
+        ('2020-01-09', '109.47'),
+        ('2020-01-10', '109.5'),
+        ('2020-01-13', '109.93'),
+        ('2020-01-14', '110.05'),
+        ('2020-01-15', '109.94'),
+        ('2020-01-16', '110.16'),
+        ('2020-01-17', '110.16'),
+        ('2020-01-20', NULL),
+        ('2020-01-21', '109.95'),
+        ('2020-01-22', '109.93'),
+        ('2020-01-23', '109.39'),
+        ('2020-01-24', '109.31'),
+        ('2020-01-27', '108.95'),
+        ('2020-01-28', '109.17'),
+        ('2020-01-29', '109.15'),
+        ('2020-01-30', '108.75'),
+        ('2020-01-31', '108.5'),
+        ('2020-02-03', '108.59'),
+        ('2020-02-04', '109.41'),
+        ('2020-02-05', '109.74'),
+        ('2020-02-06', '109.89'),
+        ('2020-02-07', '109.81'),
+        ('2020-02-10', '109.77'),
+        ('2020-02-11', '109.78'),
+        ('2020-02-12', '110.0'),
+        ('2020-02-13', '109.81'),
+        ('2020-02-14', '109.77'),
+        ('2020-02-17', NULL),
+        ('2020-02-18', '109.8'),
+        ('2020-02-19', '111.17'),
+        ('2020-02-20', '111.86'),
+        ('2020-02-21', '111.74'),
+        ('2020-02-24', '110.56'),
+        ('2020-02-25', '110.23'),
+        ('2020-02-26', '110.36'),
+        ('2020-02-27', '110.15'),
+        ('2020-02-28', '108.12'),
+        ('2020-03-02', '108.02'),
+        ('2020-03-03', '107.28'),
+        ('2020-03-04', '107.34'),
+        ('2020-03-05', '106.56'),
+        ('2020-03-06', '105.31'),
+        ('2020-03-09', '102.52'),
+        ('2020-03-10', '103.71'),
+        ('2020-03-11', '104.81'),
+        ('2020-03-12', '105.67'),
+        ('2020-03-13', '107.15'),
+        ('2020-03-16', '105.64'),
+        ('2020-03-17', '107.41'),
+        ('2020-03-18', '108.45'),
+        ('2020-03-19', '110.08'),
+        ('2020-03-20', '111.3'),
+        ('2020-03-23', '111.36'),
+        ('2020-03-24', '111.44'),
+        ('2020-03-25', '111.43'),
+        ('2020-03-26', '109.45'),
+        ('2020-03-27', '108.16'),
+        ('2020-03-30', '108.06'),
+        ('2020-03-31', '107.53'),
+        ('2020-04-01', '107.05'),
+        ('2020-04-02', '107.85'),
+        ('2020-04-03', '108.53'),
+        ('2020-04-06', '109.11'),
+        ('2020-04-07', '108.97'),
+        ('2020-04-08', '108.7'),
+        ('2020-04-09', '108.38'),
+        ('2020-04-10', '108.39'),
+        ('2020-04-13', '107.59'),
+        ('2020-04-14', '107.08'),
+        ('2020-04-15', '107.42'),
+        ('2020-04-16', '107.72'),
+        ('2020-04-17', '107.52'),
+        ('2020-04-20', '107.69'),
+        ('2020-04-21', '107.69'),
+        ('2020-04-22', '107.81'),
+        ('2020-04-23', '107.65'),
+        ('2020-04-24', '107.44'),
+        ('2020-04-27', '107.22'),
+        ('2020-04-28', '106.83'),
+        ('2020-04-29', '106.67'),
+        ('2020-04-30', '106.94'),
+        ('2020-05-01', '106.76'),
+        ('2020-05-04', '106.82'),
+        ('2020-05-05', '106.52'),
+        ('2020-05-06', '106.07'),
+        ('2020-05-07', '106.36'),
+        ('2020-05-08', '106.5'),
+        ('2020-05-11', '107.7'),
+        ('2020-05-12', '107.33'),
+        ('2020-05-13', '106.92'),
+        ('2020-05-14', '107.09'),
+        ('2020-05-15', '107.27'),
+        ('2020-05-18', '107.25'),
+        ('2020-05-19', '107.89'),
+        ('2020-05-20', '107.46'),
+        ('2020-05-21', '107.69'),
+        ('2020-05-22', '107.5'),
+        ('2020-05-25', NULL),
+        ('2020-05-26', '107.64'),
+        ('2020-05-27', '107.79'),
+        ('2020-05-28', '107.67'),
+        ('2020-05-29', '107.77'),
+        ('2020-06-01', '107.56'),
+        ('2020-06-02', '108.6'),
+        ('2020-06-03', '108.88'),
+        ('2020-06-04', '109.0'),
+        ('2020-06-05', '109.68'),
+        ('2020-06-08', '108.46'),
+        ('2020-06-09', '107.66'),
+        ('2020-06-10', '107.25'),
+        ('2020-06-11', '106.67'),
+        ('2020-06-12', '107.36'),
+        ('2020-06-15', '107.33'),
+        ('2020-06-16', '107.35'),
+        ('2020-06-17', '107.26'),
+        ('2020-06-18', '106.78'),
+        ('2020-06-19', '106.89'),
+        ('2020-06-22', '106.84'),
+        ('2020-06-23', '106.44'),
+        ('2020-06-24', '106.83'),
+        ('2020-06-25', '107.21'),
+        ('2020-06-26', '107.19'),
+        ('2020-06-29', '107.71'),
+        ('2020-06-30', '107.77'),
+        ('2020-07-01', '107.5'),
+        ('2020-07-02', '107.55'),
+        ('2020-07-03', NULL),
+        ('2020-07-06', '107.5'),
+        ('2020-07-07', '107.53'),
+        ('2020-07-08', '107.39'),
+        ('2020-07-09', '107.27'),
+        ('2020-07-10', '106.77'),
+        ('2020-07-13', '107.22'),
+        ('2020-07-14', '107.2'),
+        ('2020-07-15', '106.92'),
+        ('2020-07-16', '107.05'),
+        ('2020-07-17', '107.06'),
+        ('2020-07-20', '107.21'),
+        ('2020-07-21', '106.82'),
+        ('2020-07-22', '107.18'),
+        ('2020-07-23', '106.83'),
+        ('2020-07-24', '105.79'),
+        ('2020-07-27', '105.29'),
+        ('2020-07-28', '105.03'),
+        ('2020-07-29', '105.06'),
+        ('2020-07-30', '105.05'),
+        ('2020-07-31', '105.78'),
+        ('2020-08-03', '106.11'),
+        ('2020-08-04', '105.87'),
+        ('2020-08-05', '105.44'),
+        ('2020-08-06', '105.52'),
+        ('2020-08-07', '105.92'),
+        ('2020-08-10', '105.85'),
+        ('2020-08-11', '106.56'),
+        ('2020-08-12', '106.85'),
+        ('2020-08-13', '106.89'),
+        ('2020-08-14', '106.49'),
+        ('2020-08-17', '105.97'),
+        ('2020-08-18', '105.41'),
+        ('2020-08-19', '105.71'),
+        ('2020-08-20', '105.81'),
+        ('2020-08-21', '105.88'),
+        ('2020-08-24', '105.9'),
+        ('2020-08-25', '106.4'),
+        ('2020-08-26', '106.13'),
+        ('2020-08-27', '106.42'),
+        ('2020-08-28', '105.3'),
+        ('2020-08-31', '105.84'),
+        ('2020-09-01', '106.0'),
+        ('2020-09-02', '106.15'),
+        ('2020-09-03', '106.14'),
+        ('2020-09-04', '106.34'),
+        ('2020-09-07', NULL),
+        ('2020-09-08', '105.97'),
+        ('2020-09-09', '106.23'),
+        ('2020-09-10', '106.19'),
+        ('2020-09-11', '106.17'),
+        ('2020-09-14', '105.68'),
+        ('2020-09-15', '105.5'),
+        ('2020-09-16', '104.94'),
+        ('2020-09-17', '104.77'),
+        ('2020-09-18', '104.44'),
+        ('2020-09-21', '104.69'),
+        ('2020-09-22', '105.05'),
+        ('2020-09-23', '105.34'),
+        ('2020-09-24', '105.42'),
+        ('2020-09-25', '105.59'),
+        ('2020-09-28', '105.5'),
+        ('2020-09-29', '105.68'),
+        ('2020-09-30', '105.58'),
+        ('2020-10-01', '105.53'),
+        ('2020-10-02', '105.36'),
+        ('2020-10-05', '105.7'),
+        ('2020-10-06', '105.64'),
+        ('2020-10-07', '105.97'),
+        ('2020-10-08', '105.98'),
+        ('2020-10-09', '105.72'),
+        ('2020-10-12', NULL),
+        ('2020-10-13', '105.55'),
+        ('2020-10-14', '105.08'),
+        ('2020-10-15', '105.38'),
+        ('2020-10-16', '105.41'),
+        ('2020-10-19', '105.42'),
+        ('2020-10-20', '105.56'),
+        ('2020-10-21', '104.58'),
+        ('2020-10-22', '104.88'),
+        ('2020-10-23', '104.78'),
+        ('2020-10-26', '104.86'),
+        ('2020-10-27', '104.44'),
+        ('2020-10-28', '104.33'),
+        ('2020-10-29', '104.69'),
+        ('2020-10-30', '104.54'),
+        ('2020-11-02', '104.8'),
+        ('2020-11-03', '104.5'),
+        ('2020-11-04', '104.39'),
+        ('2020-11-05', '103.67'),
+        ('2020-11-06', '103.32'),
+        ('2020-11-09', '105.58'),
+        ('2020-11-10', '105.36'),
+        ('2020-11-11', NULL),
+        ('2020-11-12', '105.16'),
+        ('2020-11-13', '104.61'),
+        ('2020-11-16', '104.54'),
+        ('2020-11-17', '104.2'),
+        ('2020-11-18', '103.72'),
+        ('2020-11-19', '103.85'),
+        ('2020-11-20', '103.81'),
+        ('2020-11-23', '104.42'),
+        ('2020-11-24', '104.61'),
+        ('2020-11-25', '104.39'),
+        ('2020-11-26', NULL),
+        ('2020-11-27', NULL),
+        ('2020-11-30', '104.38'),
+        ('2020-12-01', '104.4'),
+        ('2020-12-02', '104.52'),
+        ('2020-12-03', '103.77'),
+        ('2020-12-04', '104.16'),
+        ('2020-12-07', '104.01'),
+        ('2020-12-08', '104.14'),
+        ('2020-12-09', '104.28'),
+        ('2020-12-10', '104.3'),
+        ('2020-12-11', '103.88'),
+        ('2020-12-14', '104.06'),
+        ('2020-12-15', '103.69'),
+        ('2020-12-16', '103.59'),
+        ('2020-12-17', '103.13'),
+        ('2020-12-18', '103.35'),
+        ('2020-12-21', '103.44'),
+        ('2020-12-22', '103.62'),
+        ('2020-12-23', '103.52'),
+        ('2020-12-24', NULL),
+        ('2020-12-25', NULL),
+        ('2020-12-28', '103.84'),
+        ('2020-12-29', '103.5'),
+        ('2020-12-30', '103.31'),
+        ('2020-12-31', '103.19'),
+        ('2021-01-01', NULL),
+        ('2021-01-04', '103.19'),
+        ('2021-01-05', '102.7'),
+        ('2021-01-06', '103.25'),
+        ('2021-01-07', '103.84'),
+        ('2021-01-08', '103.89'),
+        ('2021-01-11', '104.16'),
+        ('2021-01-12', '104.09'),
+        ('2021-01-13', '103.91'),
+        ('2021-01-14', '103.66'),
+        ('2021-01-15', '103.8'),
+        ('2021-01-18', NULL),
+        ('2021-01-19', '103.86'),
+        ('2021-01-20', NULL),
+        ('2021-01-21', '103.57'),
+        ('2021-01-22', '103.76'),
+        ('2021-01-25', '103.78'),
+        ('2021-01-26', '103.69'),
+        ('2021-01-27', '104.09'),
+        ('2021-01-28', '104.31'),
+        ('2021-01-29', '104.64')
+)
+INSERT INTO
+    exchange_rates (source_currency_id, destination_currency_id, date, rate)
+SELECT
+    source.id,
+    destination.id,
+    CAST(rates.date AS DATE),
+    CAST(rates.rate AS NUMERIC)
+FROM
+    rates,
+    currencies_codes source,
+    currencies_codes destination
+WHERE
+    source.code = 'JPY'
+    AND destination.code = 'USD'
+ON CONFLICT DO NOTHING;
+
+-- KRWUSD.csv
+WITH rates (date, rate) AS (
+    VALUES
+        ('2016-01-29', '1210.0400'),
+        ('2016-02-01', '1203.1900'),
+        ('2016-02-02', '1212.0100'),
+        ('2016-02-03', '1211.3300'),
+        ('2016-02-04', '1186.1100'),
+        ('2016-02-05', '1204.6900'),
+        ('2016-02-08', '1205.1000'),
+        ('2016-02-09', '1200.3900'),
+        ('2016-02-10', '1189.6900'),
+        ('2016-02-11', '1196.8900'),
+        ('2016-02-12', '1205.1300'),
+        ('2016-02-15', NULL),
+        ('2016-02-16', '1219.4400'),
+        ('2016-02-17', '1222.3200'),
+        ('2016-02-18', '1231.1500'),
+        ('2016-02-19', '1231.0900'),
+        ('2016-02-22', '1223.3200'),
+        ('2016-02-23', '1230.0500'),
+        ('2016-02-24', '1234.6000'),
+        ('2016-02-25', '1237.3900'),
+        ('2016-02-26', '1242.5900'),
+        ('2016-02-29', '1238.0900'),
+        ('2016-03-01', '1229.5600'),
+        ('2016-03-02', '1227.5900'),
+        ('2016-03-03', '1209.5500'),
+        ('2016-03-04', '1195.0900'),
+        ('2016-03-07', '1200.0800'),
+        ('2016-03-08', '1206.1900'),
+        ('2016-03-09', '1206.8200'),
+        ('2016-03-10', '1204.0900'),
+        ('2016-03-11', '1184.5600'),
+        ('2016-03-14', '1187.3900'),
+        ('2016-03-15', '1194.9900'),
+        ('2016-03-16', '1190.5900'),
+        ('2016-03-17', '1155.8300'),
+        ('2016-03-18', '1160.1100'),
+        ('2016-03-21', '1157.3300'),
+        ('2016-03-22', '1154.5800'),
+        ('2016-03-23', '1166.5900'),
+        ('2016-03-24', '1168.0900'),
+        ('2016-03-25', '1168.1900'),
+        ('2016-03-28', '1163.3900'),
+        ('2016-03-29', '1164.6900'),
+        ('2016-03-30', '1142.0200'),
+        ('2016-03-31', '1138.8600'),
+        ('2016-04-01', '1147.1500'),
+        ('2016-04-04', '1151.3500'),
+        ('2016-04-05', '1158.3900'),
+        ('2016-04-06', '1155.2800'),
+        ('2016-04-07', '1156.8100'),
+        ('2016-04-08', '1148.8900'),
+        ('2016-04-11', '1141.9700'),
+        ('2016-04-12', '1144.5300'),
+        ('2016-04-13', '1146.2900'),
+        ('2016-04-14', '1151.9900'),
+        ('2016-04-15', '1144.8300'),
+        ('2016-04-18', '1144.4800'),
+        ('2016-04-19', '1126.0100'),
+        ('2016-04-20', '1130.4800'),
+        ('2016-04-21', '1135.4900'),
+        ('2016-04-22', '1147.8500'),
+        ('2016-04-25', '1147.7600'),
+        ('2016-04-26', '1146.6600'),
+        ('2016-04-27', '1151.5000'),
+        ('2016-04-28', '1139.9300'),
+        ('2016-04-29', '1144.0900'),
+        ('2016-05-02', '1138.4500'),
+        ('2016-05-03', '1148.6900'),
+        ('2016-05-04', '1164.5900'),
+        ('2016-05-05', '1165.9300'),
+        ('2016-05-06', '1167.2900'),
+        ('2016-05-09', '1174.2500'),
+        ('2016-05-10', '1169.8900'),
+        ('2016-05-11', '1162.4700'),
+        ('2016-05-12', '1168.7000'),
+        ('2016-05-13', '1176.8500'),
+        ('2016-05-16', '1177.2100'),
+        ('2016-05-17', '1173.6700'),
+        ('2016-05-18', '1185.7700'),
+        ('2016-05-19', '1189.2500'),
+        ('2016-05-20', '1186.9300'),
+        ('2016-05-23', '1183.3900'),
+        ('2016-05-24', '1186.3900'),
+        ('2016-05-25', '1182.2100'),
+        ('2016-05-26', '1178.0900'),
+        ('2016-05-27', '1180.5900'),
+        ('2016-05-30', NULL),
+        ('2016-05-31', '1188.9900'),
+        ('2016-06-01', '1192.0900'),
+        ('2016-06-02', '1186.6900'),
+        ('2016-06-03', '1167.7900'),
+        ('2016-06-06', '1162.2800'),
+        ('2016-06-07', '1154.8000'),
+        ('2016-06-08', '1153.8300'),
+        ('2016-06-09', '1159.2900'),
+        ('2016-06-10', '1167.6100'),
+        ('2016-06-13', '1169.7400'),
+        ('2016-06-14', '1176.7800'),
+        ('2016-06-15', '1167.5300'),
+        ('2016-06-16', '1176.4900'),
+        ('2016-06-17', '1171.7700'),
+        ('2016-06-20', '1156.8000'),
+        ('2016-06-21', '1154.3500'),
+        ('2016-06-22', '1147.9900'),
+        ('2016-06-23', '1143.2700'),
+        ('2016-06-24', '1167.5600'),
+        ('2016-06-27', '1181.2400'),
+        ('2016-06-28', '1169.3100'),
+        ('2016-06-29', '1153.0900'),
+        ('2016-06-30', '1154.1500'),
+        ('2016-07-01', '1146.4900'),
+        ('2016-07-04', NULL),
+        ('2016-07-05', '1156.7900'),
+        ('2016-07-06', '1160.5900'),
+        ('2016-07-07', '1154.8900'),
+        ('2016-07-08', '1148.7100'),
+        ('2016-07-11', '1148.9700'),
+        ('2016-07-12', '1146.4700'),
+        ('2016-07-13', '1145.0100'),
+        ('2016-07-14', '1130.6500'),
+        ('2016-07-15', '1135.4900'),
+        ('2016-07-18', '1134.3900'),
+        ('2016-07-19', '1140.5600'),
+        ('2016-07-20', '1142.3300'),
+        ('2016-07-21', '1137.3100'),
+        ('2016-07-22', '1137.4900'),
+        ('2016-07-25', '1140.8000'),
+        ('2016-07-26', '1137.5900'),
+        ('2016-07-27', '1135.0900'),
+        ('2016-07-28', '1124.7900'),
+        ('2016-07-29', '1112.8900'),
+        ('2016-08-01', '1106.1500'),
+        ('2016-08-02', '1108.3500'),
+        ('2016-08-03', '1116.2100'),
+        ('2016-08-04', '1111.9900'),
+        ('2016-08-05', '1114.5400'),
+        ('2016-08-08', '1108.2400'),
+        ('2016-08-09', '1101.4500'),
+        ('2016-08-10', '1095.8400'),
+        ('2016-08-11', '1097.3900'),
+        ('2016-08-12', '1100.7900'),
+        ('2016-08-15', '1095.8300'),
+        ('2016-08-16', '1093.9500'),
+        ('2016-08-17', '1114.2500'),
+        ('2016-08-18', '1107.4100'),
+        ('2016-08-19', '1116.3700'),
+        ('2016-08-22', '1120.9300'),
+        ('2016-08-23', '1116.9100'),
+        ('2016-08-24', '1121.3400'),
+        ('2016-08-25', '1115.2300'),
+        ('2016-08-26', '1111.4700'),
+        ('2016-08-29', '1121.5300'),
+        ('2016-08-30', '1118.2700'),
+        ('2016-08-31', '1115.9800'),
+        ('2016-09-01', '1116.8900'),
+        ('2016-09-02', '1114.8700'),
+        ('2016-09-05', NULL),
+        ('2016-09-06', '1093.0400'),
+        ('2016-09-07', '1090.0300'),
+        ('2016-09-08', '1095.2700'),
+        ('2016-09-09', '1106.8300'),
+        ('2016-09-12', '1112.0300'),
+        ('2016-09-13', '1124.6100'),
+        ('2016-09-14', '1123.8100'),
+        ('2016-09-15', '1122.8300'),
+        ('2016-09-16', '1127.3700'),
+        ('2016-09-19', '1117.4400'),
+        ('2016-09-20', '1117.3400'),
+        ('2016-09-21', '1112.1700'),
+        ('2016-09-22', '1100.5900'),
+        ('2016-09-23', '1102.2100'),
+        ('2016-09-26', '1106.9900'),
+        ('2016-09-27', '1099.2200'),
+        ('2016-09-28', '1096.7300'),
+        ('2016-09-29', '1097.3900'),
+        ('2016-09-30', '1097.9800'),
+        ('2016-10-03', '1104.7900'),
+        ('2016-10-04', '1110.7700'),
+        ('2016-10-05', '1114.5300'),
+        ('2016-10-06', '1114.5300'),
+        ('2016-10-07', '1115.9500'),
+        ('2016-10-10', NULL),
+        ('2016-10-11', '1124.2100'),
+        ('2016-10-12', '1127.2300'),
+        ('2016-10-13', '1133.3100'),
+        ('2016-10-14', '1133.1300'),
+        ('2016-10-17', '1137.6100'),
+        ('2016-10-18', '1123.7500'),
+        ('2016-10-19', '1120.0100'),
+        ('2016-10-20', '1128.3900'),
+        ('2016-10-21', '1137.1700'),
+        ('2016-10-24', '1135.4000'),
+        ('2016-10-25', '1129.8900'),
+        ('2016-10-26', '1134.9400'),
+        ('2016-10-27', '1145.8500'),
+        ('2016-10-28', '1146.4900'),
+        ('2016-10-31', '1145.3700'),
+        ('2016-11-01', '1142.6800'),
+        ('2016-11-02', '1148.3900'),
+        ('2016-11-03', '1142.6800'),
+        ('2016-11-04', '1142.6800'),
+        ('2016-11-07', '1141.2700'),
+        ('2016-11-08', '1131.3900'),
+        ('2016-11-09', '1150.6400'),
+        ('2016-11-10', '1162.1100'),
+        ('2016-11-11', NULL),
+        ('2016-11-14', '1171.2500'),
+        ('2016-11-15', '1170.2100'),
+        ('2016-11-16', '1172.4100'),
+        ('2016-11-17', '1176.0300'),
+        ('2016-11-18', '1177.7100'),
+        ('2016-11-21', '1178.5100'),
+        ('2016-11-22', '1173.2500'),
+        ('2016-11-23', '1181.5700'),
+        ('2016-11-24', NULL),
+        ('2016-11-25', '1174.9900'),
+        ('2016-11-28', '1170.5300'),
+        ('2016-11-29', '1169.9900'),
+        ('2016-11-30', '1175.8700'),
+        ('2016-12-01', '1167.3100'),
+        ('2016-12-02', '1167.7000'),
+        ('2016-12-05', '1168.1100'),
+        ('2016-12-06', '1171.5000'),
+        ('2016-12-07', '1161.6700'),
+        ('2016-12-08', '1162.4900'),
+        ('2016-12-09', '1171.8800'),
+        ('2016-12-12', '1164.3700'),
+        ('2016-12-13', '1164.6500'),
+        ('2016-12-14', '1165.1300'),
+        ('2016-12-15', '1185.3500'),
+        ('2016-12-16', '1183.7200'),
+        ('2016-12-19', '1187.7100'),
+        ('2016-12-20', '1192.1300'),
+        ('2016-12-21', '1193.9100'),
+        ('2016-12-22', '1204.6500'),
+        ('2016-12-23', '1201.5100'),
+        ('2016-12-26', NULL),
+        ('2016-12-27', '1207.6800'),
+        ('2016-12-28', '1212.2200'),
+        ('2016-12-29', '1207.0500'),
+        ('2016-12-30', '1203.7300'),
+        ('2017-01-02', NULL),
+        ('2017-01-03', '1207.2300'),
+        ('2017-01-04', '1198.0700'),
+        ('2017-01-05', '1182.4600'),
+        ('2017-01-06', '1198.8500'),
+        ('2017-01-09', '1200.5100'),
+        ('2017-01-10', '1196.9600'),
+        ('2017-01-11', '1197.5900'),
+        ('2017-01-12', '1170.9300'),
+        ('2017-01-13', '1177.8100'),
+        ('2017-01-16', NULL),
+        ('2017-01-17', '1161.6100'),
+        ('2017-01-18', '1170.7300'),
+        ('2017-01-19', '1177.0300'),
+        ('2017-01-20', NULL),
+        ('2017-01-23', '1165.8200'),
+        ('2017-01-24', '1165.2500'),
+        ('2017-01-25', '1164.1300'),
+        ('2017-01-26', '1169.6100'),
+        ('2017-01-27', '1175.7300'),
+        ('2017-01-30', '1171.2700'),
+        ('2017-01-31', '1151.4500'),
+        ('2017-02-01', '1154.4500'),
+        ('2017-02-02', '1139.9000'),
+        ('2017-02-03', '1134.6500'),
+        ('2017-02-06', '1134.8700'),
+        ('2017-02-07', '1142.8900'),
+        ('2017-02-08', '1143.2500'),
+        ('2017-02-09', '1149.8800'),
+        ('2017-02-10', '1146.7800'),
+        ('2017-02-13', '1150.1600'),
+        ('2017-02-14', '1139.3300'),
+        ('2017-02-15', '1136.4600'),
+        ('2017-02-16', '1140.5700'),
+        ('2017-02-17', '1150.7300'),
+        ('2017-02-20', NULL),
+        ('2017-02-21', '1143.6300'),
+        ('2017-02-22', '1142.1500'),
+        ('2017-02-23', '1129.8400'),
+        ('2017-02-24', '1130.4100'),
+        ('2017-02-27', '1130.1400'),
+        ('2017-02-28', '1129.1700'),
+        ('2017-03-01', '1140.7500'),
+        ('2017-03-02', '1146.9000'),
+        ('2017-03-03', '1156.7700'),
+        ('2017-03-06', '1154.3600'),
+        ('2017-03-07', '1149.8700'),
+        ('2017-03-08', '1154.6900'),
+        ('2017-03-09', '1158.1200'),
+        ('2017-03-10', '1150.3700'),
+        ('2017-03-13', '1150.3700'),
+        ('2017-03-14', '1148.5400'),
+        ('2017-03-15', '1144.1600'),
+        ('2017-03-16', '1148.5400'),
+        ('2017-03-17', '1128.6700'),
+        ('2017-03-20', '1112.2900'),
+        ('2017-03-21', '1118.2300'),
+        ('2017-03-22', '1117.9100'),
+        ('2017-03-23', '1117.9100'),
+        ('2017-03-24', '1116.6900'),
+        ('2017-03-27', '1108.3000'),
+        ('2017-03-28', '1111.5100'),
+        ('2017-03-29', '1112.2800'),
+        ('2017-03-30', '1114.1100'),
+        ('2017-03-31', '1117.4800'),
+        ('2017-04-03', '1117.6500'),
+        ('2017-04-04', '1122.6100'),
+        ('2017-04-05', '1126.5800'),
+        ('2017-04-06', '1131.2100'),
+        ('2017-04-07', '1133.8900'),
+        ('2017-04-10', '1141.2400'),
+        ('2017-04-11', '1147.7700'),
+        ('2017-04-12', '1138.8300'),
+        ('2017-04-13', '1133.3200'),
+        ('2017-04-14', '1136.6700'),
+        ('2017-04-17', '1134.2500'),
+        ('2017-04-18', '1143.8900'),
+        ('2017-04-19', '1142.3700'),
+        ('2017-04-20', '1136.2900'),
+        ('2017-04-21', '1134.2200'),
+        ('2017-04-24', '1132.1900'),
+        ('2017-04-25', '1126.5400'),
+        ('2017-04-26', '1133.1700'),
+        ('2017-04-27', '1133.8300'),
+        ('2017-04-28', '1136.9900'),
+        ('2017-05-01', '1134.8700'),
+        ('2017-05-02', '1129.1600'),
+        ('2017-05-03', '1131.2500'),
+        ('2017-05-04', '1134.2000'),
+        ('2017-05-05', '1136.4900'),
+        ('2017-05-08', '1132.9100'),
+        ('2017-05-09', '1134.8400'),
+        ('2017-05-10', '1132.1300'),
+        ('2017-05-11', '1127.5100'),
+        ('2017-05-12', '1121.6800'),
+        ('2017-05-15', '1115.0100'),
+        ('2017-05-16', '1115.0000'),
+        ('2017-05-17', '1118.6700'),
+        ('2017-05-18', '1129.1700'),
+        ('2017-05-19', '1116.7100'),
+        ('2017-05-22', '1114.7100'),
+        ('2017-05-23', '1125.0200'),
+        ('2017-05-24', '1122.8300'),
+        ('2017-05-25', '1119.7100'),
+        ('2017-05-26', '1118.6700'),
+        ('2017-05-29', NULL),
+        ('2017-05-30', '1122.8700'),
+        ('2017-05-31', '1119.5800'),
+        ('2017-06-01', '1120.8500'),
+        ('2017-06-02', '1117.4900'),
+        ('2017-06-05', '1118.4400'),
+        ('2017-06-06', '1118.5300'),
+        ('2017-06-07', '1124.1600'),
+        ('2017-06-08', '1122.7300'),
+        ('2017-06-09', '1123.5100'),
+        ('2017-06-12', '1130.5500'),
+        ('2017-06-13', '1127.7900'),
+        ('2017-06-14', '1116.4500'),
+        ('2017-06-15', '1129.9100'),
+        ('2017-06-16', '1133.2000'),
+        ('2017-06-19', '1134.6900'),
+        ('2017-06-20', '1141.3300'),
+        ('2017-06-21', '1143.0100'),
+        ('2017-06-22', '1143.0100'),
+        ('2017-06-23', '1132.9900'),
+        ('2017-06-26', '1132.4800'),
+        ('2017-06-27', '1137.7700'),
+        ('2017-06-28', '1141.9100'),
+        ('2017-06-29', '1144.0700'),
+        ('2017-06-30', '1143.7500'),
+        ('2017-07-03', '1149.2600'),
+        ('2017-07-04', NULL),
+        ('2017-07-05', '1153.0600'),
+        ('2017-07-06', '1156.5500'),
+        ('2017-07-07', '1153.8500'),
+        ('2017-07-10', '1149.3500'),
+        ('2017-07-11', '1149.8300'),
+        ('2017-07-12', '1138.3200'),
+        ('2017-07-13', '1137.2200'),
+        ('2017-07-14', '1127.7900'),
+        ('2017-07-17', '1127.7400'),
+        ('2017-07-18', '1123.6900'),
+        ('2017-07-19', '1122.9200'),
+        ('2017-07-20', '1120.7700'),
+        ('2017-07-21', '1117.4700'),
+        ('2017-07-24', '1113.8200'),
+        ('2017-07-25', '1117.0200'),
+        ('2017-07-26', '1117.7600'),
+        ('2017-07-27', '1115.1500'),
+        ('2017-07-28', '1121.4900'),
+        ('2017-07-31', '1121.8600'),
+        ('2017-08-01', '1121.0900'),
+        ('2017-08-02', '1121.5500'),
+        ('2017-08-03', '1127.7300'),
+        ('2017-08-04', '1128.0200'),
+        ('2017-08-07', '1127.3900'),
+        ('2017-08-08', '1125.6000'),
+        ('2017-08-09', '1138.6900'),
+        ('2017-08-10', '1144.3600'),
+        ('2017-08-11', '1141.5700'),
+        ('2017-08-14', '1137.2200'),
+        ('2017-08-15', '1135.4700'),
+        ('2017-08-16', '1137.7500'),
+        ('2017-08-17', '1138.2300'),
+        ('2017-08-18', '1139.8300'),
+        ('2017-08-21', '1137.1400'),
+        ('2017-08-22', '1131.7900'),
+        ('2017-08-23', '1128.5900'),
+        ('2017-08-24', '1127.8000'),
+        ('2017-08-25', '1121.7300'),
+        ('2017-08-28', '1117.7500'),
+        ('2017-08-29', '1122.5000'),
+        ('2017-08-30', '1122.0500'),
+        ('2017-08-31', '1122.3600'),
+        ('2017-09-01', '1119.1200'),
+        ('2017-09-04', NULL),
+        ('2017-09-05', '1130.0100'),
+        ('2017-09-06', '1132.4900'),
+        ('2017-09-07', '1125.4900'),
+        ('2017-09-08', '1133.0800'),
+        ('2017-09-11', '1129.7500'),
+        ('2017-09-12', '1126.5600'),
+        ('2017-09-13', '1129.3700'),
+        ('2017-09-14', '1132.5900'),
+        ('2017-09-15', '1130.6800'),
+        ('2017-09-18', '1127.0700'),
+        ('2017-09-19', '1128.8300'),
+        ('2017-09-20', '1125.5300'),
+        ('2017-09-21', '1130.8700'),
+        ('2017-09-22', '1130.3900'),
+        ('2017-09-25', '1134.5900'),
+        ('2017-09-26', '1136.2900'),
+        ('2017-09-27', '1141.6200'),
+        ('2017-09-28', '1145.0800'),
+        ('2017-09-29', '1142.5700'),
+        ('2017-10-02', '1146.1800'),
+        ('2017-10-03', '1140.5000'),
+        ('2017-10-04', '1140.4400'),
+        ('2017-10-05', '1139.0300'),
+        ('2017-10-06', '1143.4600'),
+        ('2017-10-09', NULL),
+        ('2017-10-10', '1132.0800'),
+        ('2017-10-11', '1131.0400'),
+        ('2017-10-12', '1129.7100'),
+        ('2017-10-13', '1125.0100'),
+        ('2017-10-16', '1127.7200'),
+        ('2017-10-17', '1129.8600'),
+        ('2017-10-18', '1130.9400'),
+        ('2017-10-19', '1129.0600'),
+        ('2017-10-20', '1130.6900'),
+        ('2017-10-23', '1129.7300'),
+        ('2017-10-24', '1128.3600'),
+        ('2017-10-25', '1126.4900'),
+        ('2017-10-26', '1123.0000'),
+        ('2017-10-27', '1124.7900'),
+        ('2017-10-30', '1124.7900'),
+        ('2017-10-31', '1115.6800'),
+        ('2017-11-01', '1110.0300'),
+        ('2017-11-02', '1111.5400'),
+        ('2017-11-03', '1114.8600'),
+        ('2017-11-06', '1110.0900'),
+        ('2017-11-07', '1113.1000'),
+        ('2017-11-08', '1111.1400'),
+        ('2017-11-09', '1118.1300'),
+        ('2017-11-10', NULL),
+        ('2017-11-13', '1119.9600'),
+        ('2017-11-14', '1113.6500'),
+        ('2017-11-15', '1103.9000'),
+        ('2017-11-16', '1094.4900'),
+        ('2017-11-17', '1093.1500'),
+        ('2017-11-20', '1093.1500'),
+        ('2017-11-21', '1088.1000'),
+        ('2017-11-22', '1085.6600'),
+        ('2017-11-23', NULL),
+        ('2017-11-24', '1082.5900'),
+        ('2017-11-27', '1088.3600'),
+        ('2017-11-28', '1079.8700'),
+        ('2017-11-29', '1079.3300'),
+        ('2017-11-30', '1084.7900'),
+        ('2017-12-01', '1082.3600'),
+        ('2017-12-04', '1082.3600'),
+        ('2017-12-05', '1086.8500'),
+        ('2017-12-06', '1094.5500'),
+        ('2017-12-07', '1090.6800'),
+        ('2017-12-08', '1092.0300'),
+        ('2017-12-11', '1092.0300'),
+        ('2017-12-12', '1090.0900'),
+        ('2017-12-13', '1088.0100'),
+        ('2017-12-14', '1088.0900'),
+        ('2017-12-15', '1086.3900'),
+        ('2017-12-18', '1084.2800'),
+        ('2017-12-19', '1084.2900'),
+        ('2017-12-20', '1078.1400'),
+        ('2017-12-21', '1078.0800'),
+        ('2017-12-22', '1076.8700'),
+        ('2017-12-25', NULL),
+        ('2017-12-26', '1073.7500'),
+        ('2017-12-27', '1073.1300'),
+        ('2017-12-28', '1068.6400'),
+        ('2017-12-29', '1067.4200'),
+        ('2018-01-01', NULL),
+        ('2018-01-02', '1059.3900'),
+        ('2018-01-03', '1063.1100'),
+        ('2018-01-04', '1061.5000'),
+        ('2018-01-05', '1060.0700'),
+        ('2018-01-08', '1066.2600'),
+        ('2018-01-09', '1068.8000'),
+        ('2018-01-10', '1067.5500'),
+        ('2018-01-11', '1062.6700'),
+        ('2018-01-12', '1062.0900'),
+        ('2018-01-15', NULL),
+        ('2018-01-16', '1064.1900'),
+        ('2018-01-17', '1066.7200'),
+        ('2018-01-18', '1069.3500'),
+        ('2018-01-19', '1065.8100'),
+        ('2018-01-22', '1068.0600'),
+        ('2018-01-23', '1072.5900'),
+        ('2018-01-24', '1065.3900'),
+        ('2018-01-25', '1057.5700'),
+        ('2018-01-26', '1064.1200'),
+        ('2018-01-29', '1071.2800'),
+        ('2018-01-30', '1073.6100'),
+        ('2018-01-31', '1068.3300'),
+        ('2018-02-01', '1069.3300'),
+        ('2018-02-02', '1086.2100'),
+        ('2018-02-05', '1086.7900'),
+        ('2018-02-06', '1081.2000'),
+        ('2018-02-07', '1082.5600'),
+        ('2018-02-08', '1093.0400'),
+        ('2018-02-09', '1087.9900'),
+        ('2018-02-12', '1082.2700'),
+        ('2018-02-13', '1083.0700'),
+        ('2018-02-14', '1076.7100'),
+        ('2018-02-15', '1065.6900'),
+        ('2018-02-16', '1065.2700'),
+        ('2018-02-19', NULL),
+        ('2018-02-20', '1072.8500'),
+        ('2018-02-21', '1076.1100'),
+        ('2018-02-22', '1077.6100'),
+        ('2018-02-23', '1075.7700'),
+        ('2018-02-26', '1069.0'),
+        ('2018-02-27', '1077.43'),
+        ('2018-02-28', '1082.12'),
+        ('2018-03-01', '1081.33'),
+        ('2018-03-02', '1080.25'),
+        ('2018-03-05', '1076.75'),
+        ('2018-03-06', '1061.53'),
+        ('2018-03-07', '1067.93'),
+        ('2018-03-08', '1074.21'),
+        ('2018-03-09', '1066.59'),
+        ('2018-03-12', '1063.7'),
+        ('2018-03-13', '1062.14'),
+        ('2018-03-14', '1063.38'),
+        ('2018-03-15', '1065.89'),
+        ('2018-03-16', '1068.4'),
+        ('2018-03-19', '1072.29'),
+        ('2018-03-20', '1070.61'),
+        ('2018-03-21', '1070.51'),
+        ('2018-03-22', '1079.51'),
+        ('2018-03-23', '1081.29'),
+        ('2018-03-26', '1076.87'),
+        ('2018-03-27', '1071.21'),
+        ('2018-03-28', '1063.03'),
+        ('2018-03-29', '1060.31'),
+        ('2018-03-30', '1060.99'),
+        ('2018-04-02', '1056.03'),
+        ('2018-04-03', '1054.63'),
+        ('2018-04-04', '1059.5'),
+        ('2018-04-05', '1061.56'),
+        ('2018-04-06', '1068.73'),
+        ('2018-04-09', '1067.11'),
+        ('2018-04-10', '1064.53'),
+        ('2018-04-11', '1066.76'),
+        ('2018-04-12', '1069.84'),
+        ('2018-04-13', '1070.55'),
+        ('2018-04-16', '1071.59'),
+        ('2018-04-17', '1066.56'),
+        ('2018-04-18', '1064.51'),
+        ('2018-04-19', '1065.68'),
+        ('2018-04-20', '1071.0'),
+        ('2018-04-23', '1077.72'),
+        ('2018-04-24', '1074.8'),
+        ('2018-04-25', '1082.99'),
+        ('2018-04-26', '1078.2'),
+        ('2018-04-27', '1067.59'),
+        ('2018-04-30', '1069.07'),
+        ('2018-05-01', '1071.91'),
+        ('2018-05-02', '1076.52'),
+        ('2018-05-03', '1078.33'),
+        ('2018-05-04', '1074.09'),
+        ('2018-05-07', '1079.72'),
+        ('2018-05-08', '1078.35'),
+        ('2018-05-09', '1078.22'),
+        ('2018-05-10', '1065.41'),
+        ('2018-05-11', '1067.14'),
+        ('2018-05-14', '1068.83'),
+        ('2018-05-15', '1076.46'),
+        ('2018-05-16', '1076.66'),
+        ('2018-05-17', '1082.37'),
+        ('2018-05-18', '1080.91'),
+        ('2018-05-21', '1079.33'),
+        ('2018-05-22', '1073.3'),
+        ('2018-05-23', '1078.88'),
+        ('2018-05-24', '1082.77'),
+        ('2018-05-25', '1076.35'),
+        ('2018-05-28', NULL),
+        ('2018-05-29', '1082.59'),
+        ('2018-05-30', '1077.62'),
+        ('2018-05-31', '1080.75'),
+        ('2018-06-01', '1071.27'),
+        ('2018-06-04', '1070.13'),
+        ('2018-06-05', '1071.86'),
+        ('2018-06-06', '1065.9'),
+        ('2018-06-07', '1071.11'),
+        ('2018-06-08', '1075.0'),
+        ('2018-06-11', '1075.08'),
+        ('2018-06-12', '1077.14'),
+        ('2018-06-13', '1082.59'),
+        ('2018-06-14', '1084.06'),
+        ('2018-06-15', '1101.89'),
+        ('2018-06-18', '1105.07'),
+        ('2018-06-19', '1110.64'),
+        ('2018-06-20', '1107.42'),
+        ('2018-06-21', '1110.01'),
+        ('2018-06-22', '1114.49'),
+        ('2018-06-25', '1114.4'),
+        ('2018-06-26', '1118.08'),
+        ('2018-06-27', '1121.57'),
+        ('2018-06-28', '1121.96'),
+        ('2018-06-29', '1111.79'),
+        ('2018-07-02', '1119.31'),
+        ('2018-07-03', '1114.41'),
+        ('2018-07-04', NULL),
+        ('2018-07-05', '1118.2'),
+        ('2018-07-06', '1114.98'),
+        ('2018-07-09', '1111.38'),
+        ('2018-07-10', '1113.81'),
+        ('2018-07-11', '1122.1'),
+        ('2018-07-12', '1121.38'),
+        ('2018-07-13', '1130.3'),
+        ('2018-07-16', '1127.81'),
+        ('2018-07-17', '1125.63'),
+        ('2018-07-18', '1130.76'),
+        ('2018-07-19', '1136.38'),
+        ('2018-07-20', '1128.16'),
+        ('2018-07-23', '1134.45'),
+        ('2018-07-24', '1127.39'),
+        ('2018-07-25', '1122.59'),
+        ('2018-07-26', '1121.72'),
+        ('2018-07-27', '1115.77'),
+        ('2018-07-30', '1116.98'),
+        ('2018-07-31', '1112.75'),
+        ('2018-08-01', '1118.15'),
+        ('2018-08-02', '1126.91'),
+        ('2018-08-03', '1122.68'),
+        ('2018-08-06', '1125.9'),
+        ('2018-08-07', '1118.0'),
+        ('2018-08-08', '1119.01'),
+        ('2018-08-09', '1119.0'),
+        ('2018-08-10', '1130.19'),
+        ('2018-08-13', '1133.19'),
+        ('2018-08-14', '1128.9'),
+        ('2018-08-15', '1135.3'),
+        ('2018-08-16', '1125.35'),
+        ('2018-08-17', '1123.2'),
+        ('2018-08-20', '1121.3'),
+        ('2018-08-21', '1117.41'),
+        ('2018-08-22', '1117.0'),
+        ('2018-08-23', '1123.37'),
+        ('2018-08-24', '1113.3'),
+        ('2018-08-27', '1107.69'),
+        ('2018-08-28', '1105.14'),
+        ('2018-08-29', '1109.2'),
+        ('2018-08-30', '1113.13'),
+        ('2018-08-31', '1116.5'),
+        ('2018-09-03', NULL),
+        ('2018-09-04', '1117.5'),
+        ('2018-09-05', '1121.0'),
+        ('2018-09-06', '1122.4'),
+        ('2018-09-07', '1123.5'),
+        ('2018-09-10', '1127.9'),
+        ('2018-09-11', '1126.17'),
+        ('2018-09-12', '1121.62'),
+        ('2018-09-13', '1120.9'),
+        ('2018-09-14', '1119.0'),
+        ('2018-09-17', '1124.71'),
+        ('2018-09-18', '1122.18'),
+        ('2018-09-19', '1120.61'),
+        ('2018-09-20', '1119.58'),
+        ('2018-09-21', '1114.3'),
+        ('2018-09-24', '1119.28'),
+        ('2018-09-25', '1118.12'),
+        ('2018-09-26', '1114.64'),
+        ('2018-09-27', '1113.41'),
+        ('2018-09-28', '1109.76'),
+        ('2018-10-01', '1112.53'),
+        ('2018-10-02', '1112.43'),
+        ('2018-10-03', '1121.42'),
+        ('2018-10-04', '1130.78'),
+        ('2018-10-05', '1131.25'),
+        ('2018-10-08', NULL),
+        ('2018-10-09', '1132.87'),
+        ('2018-10-10', '1138.9'),
+        ('2018-10-11', '1134.16'),
+        ('2018-10-12', '1130.82'),
+        ('2018-10-15', '1129.3'),
+        ('2018-10-16', '1123.88'),
+        ('2018-10-17', '1126.2'),
+        ('2018-10-18', '1138.06'),
+        ('2018-10-19', '1131.13'),
+        ('2018-10-22', '1132.7'),
+        ('2018-10-23', '1137.53'),
+        ('2018-10-24', '1136.66'),
+        ('2018-10-25', '1134.9'),
+        ('2018-10-26', '1141.74'),
+        ('2018-10-29', '1139.81'),
+        ('2018-10-30', '1137.68'),
+        ('2018-10-31', '1140.78'),
+        ('2018-11-01', '1126.19'),
+        ('2018-11-02', '1117.72'),
+        ('2018-11-05', '1122.13'),
+        ('2018-11-06', '1121.31'),
+        ('2018-11-07', '1117.52'),
+        ('2018-11-08', '1118.99'),
+        ('2018-11-09', '1131.4'),
+        ('2018-11-12', NULL),
+        ('2018-11-13', '1132.29'),
+        ('2018-11-14', '1132.1'),
+        ('2018-11-15', '1127.2'),
+        ('2018-11-16', '1124.81'),
+        ('2018-11-19', '1126.08'),
+        ('2018-11-20', '1128.53'),
+        ('2018-11-21', '1127.3'),
+        ('2018-11-22', NULL),
+        ('2018-11-23', '1130.9'),
+        ('2018-11-26', '1126.69'),
+        ('2018-11-27', '1129.41'),
+        ('2018-11-28', '1127.81'),
+        ('2018-11-29', '1119.91'),
+        ('2018-11-30', '1118.61'),
+        ('2018-12-03', '1110.2'),
+        ('2018-12-04', '1108.82'),
+        ('2018-12-05', NULL),
+        ('2018-12-06', '1122.07'),
+        ('2018-12-07', '1121.8'),
+        ('2018-12-10', '1128.3'),
+        ('2018-12-11', '1128.6'),
+        ('2018-12-12', '1124.51'),
+        ('2018-12-13', '1124.73'),
+        ('2018-12-14', '1133.2'),
+        ('2018-12-17', '1130.17'),
+        ('2018-12-18', '1127.76'),
+        ('2018-12-19', '1120.96'),
+        ('2018-12-20', '1123.87'),
+        ('2018-12-21', '1125.3'),
+        ('2018-12-24', NULL),
+        ('2018-12-25', NULL),
+        ('2018-12-26', '1124.23'),
+        ('2018-12-27', '1121.37'),
+        ('2018-12-28', '1114.9'),
+        ('2018-12-31', '1112.85'),
+        ('2019-01-01', NULL),
+        ('2019-01-02', '1119.11'),
+        ('2019-01-03', '1124.64'),
+        ('2019-01-04', '1116.73'),
+        ('2019-01-07', '1117.5'),
+        ('2019-01-08', '1122.93'),
+        ('2019-01-09', '1117.7'),
+        ('2019-01-10', '1116.1'),
+        ('2019-01-11', '1117.18'),
+        ('2019-01-14', NULL),
+        ('2019-01-15', '1122.59'),
+        ('2019-01-16', '1119.1'),
+        ('2019-01-17', '1123.34'),
+        ('2019-01-18', '1122.8'),
+        ('2019-01-21', NULL),
+        ('2019-01-22', '1129.52'),
+        ('2019-01-23', '1127.89'),
+        ('2019-01-24', '1126.33'),
+        ('2019-01-25', '1117.58'),
+        ('2019-01-28', '1118.7'),
+        ('2019-01-29', '1117.75'),
+        ('2019-01-30', '1117.29'),
+        ('2019-01-31', '1111.82'),
+        ('2019-02-01', '1118.31'),
+        ('2019-02-04', '1119.15'),
+        ('2019-02-05', '1117.2'),
+        ('2019-02-06', '1119.35'),
+        ('2019-02-07', '1124.11'),
+        ('2019-02-08', '1123.14'),
+        ('2019-02-11', '1126.05'),
+        ('2019-02-12', '1122.27'),
+        ('2019-02-13', '1123.32'),
+        ('2019-02-14', '1128.0'),
+        ('2019-02-15', '1125.59'),
+        ('2019-02-18', NULL),
+        ('2019-02-19', '1124.54'),
+        ('2019-02-20', NULL),
+        ('2019-02-21', '1125.53'),
+        ('2019-02-22', '1121.07'),
+        ('2019-02-25', '1116.1'),
+        ('2019-02-26', '1116.88'),
+        ('2019-02-27', '1117.74'),
+        ('2019-02-28', '1124.65'),
+        ('2019-03-01', '1126.76'),
+        ('2019-03-04', '1126.73'),
+        ('2019-03-05', '1126.46'),
+        ('2019-03-06', '1127.18'),
+        ('2019-03-07', '1133.18'),
+        ('2019-03-08', '1131.74'),
+        ('2019-03-11', '1133.05'),
+        ('2019-03-12', '1127.89'),
+        ('2019-03-13', '1130.47'),
+        ('2019-03-14', '1135.05'),
+        ('2019-03-15', '1134.44'),
+        ('2019-03-18', '1131.74'),
+        ('2019-03-19', '1129.0'),
+        ('2019-03-20', '1128.25'),
+        ('2019-03-21', '1128.4'),
+        ('2019-03-22', '1136.8'),
+        ('2019-03-25', '1131.88'),
+        ('2019-03-26', '1133.55'),
+        ('2019-03-27', '1138.09'),
+        ('2019-03-28', '1136.65'),
+        ('2019-03-29', '1136.3'),
+        ('2019-04-01', '1133.0'),
+        ('2019-04-02', '1137.16'),
+        ('2019-04-03', '1134.29'),
+        ('2019-04-04', '1136.05'),
+        ('2019-04-05', '1136.87'),
+        ('2019-04-08', '1143.25'),
+        ('2019-04-09', '1140.25'),
+        ('2019-04-10', '1137.26'),
+        ('2019-04-11', '1140.73'),
+        ('2019-04-12', '1133.0'),
+        ('2019-04-15', '1133.6'),
+        ('2019-04-16', '1136.13'),
+        ('2019-04-17', '1131.7'),
+        ('2019-04-18', '1136.2'),
+        ('2019-04-19', '1135.4'),
+        ('2019-04-22', '1140.52'),
+        ('2019-04-23', '1143.22'),
+        ('2019-04-24', '1155.65'),
+        ('2019-04-25', '1160.15'),
+        ('2019-04-26', '1158.1'),
+        ('2019-04-29', '1160.0'),
+        ('2019-04-30', '1165.1'),
+        ('2019-05-01', '1161.61'),
+        ('2019-05-02', '1166.62'),
+        ('2019-05-03', '1165.95'),
+        ('2019-05-06', '1169.2'),
+        ('2019-05-07', '1172.5'),
+        ('2019-05-08', '1170.2'),
+        ('2019-05-09', '1183.1'),
+        ('2019-05-10', '1176.62'),
+        ('2019-05-13', '1186.94'),
+        ('2019-05-14', '1186.94'),
+        ('2019-05-15', '1188.74'),
+        ('2019-05-16', '1190.52'),
+        ('2019-05-17', '1195.07'),
+        ('2019-05-20', '1193.81'),
+        ('2019-05-21', '1194.31'),
+        ('2019-05-22', '1194.36'),
+        ('2019-05-23', '1189.31'),
+        ('2019-05-24', '1186.2'),
+        ('2019-05-27', NULL),
+        ('2019-05-28', '1186.66'),
+        ('2019-05-29', '1186.66'),
+        ('2019-05-30', '1189.14'),
+        ('2019-05-31', '1190.5'),
+        ('2019-06-03', '1180.97'),
+        ('2019-06-04', '1180.58'),
+        ('2019-06-05', '1178.24'),
+        ('2019-06-06', '1179.51'),
+        ('2019-06-07', '1181.27'),
+        ('2019-06-10', '1185.01'),
+        ('2019-06-11', '1178.88'),
+        ('2019-06-12', '1182.82'),
+        ('2019-06-13', '1183.23'),
+        ('2019-06-14', '1184.7'),
+        ('2019-06-17', '1186.53'),
+        ('2019-06-18', '1185.37'),
+        ('2019-06-19', '1185.37'),
+        ('2019-06-20', '1159.05'),
+        ('2019-06-21', '1163.25'),
+        ('2019-06-24', '1156.14'),
+        ('2019-06-25', '1155.97'),
+        ('2019-06-26', '1156.36'),
+        ('2019-06-27', '1157.86'),
+        ('2019-06-28', '1154.58'),
+        ('2019-07-01', '1158.5'),
+        ('2019-07-02', '1166.31'),
+        ('2019-07-03', '1171.14'),
+        ('2019-07-04', NULL),
+        ('2019-07-05', '1170.26'),
+        ('2019-07-08', '1181.31'),
+        ('2019-07-09', '1180.2'),
+        ('2019-07-10', '1181.58'),
+        ('2019-07-11', '1173.35'),
+        ('2019-07-12', '1178.71'),
+        ('2019-07-15', '1179.04'),
+        ('2019-07-16', '1177.31'),
+        ('2019-07-17', '1181.09'),
+        ('2019-07-18', '1178.29'),
+        ('2019-07-19', '1173.92'),
+        ('2019-07-22', '1178.17'),
+        ('2019-07-23', '1178.72'),
+        ('2019-07-24', '1177.54'),
+        ('2019-07-25', '1181.17'),
+        ('2019-07-26', '1184.51'),
+        ('2019-07-29', '1183.36'),
+        ('2019-07-30', '1181.94'),
+        ('2019-07-31', '1182.74'),
+        ('2019-08-01', '1187.0'),
+        ('2019-08-02', '1196.59'),
+        ('2019-08-05', '1215.5'),
+        ('2019-08-06', '1214.83'),
+        ('2019-08-07', '1214.46'),
+        ('2019-08-08', '1208.77'),
+        ('2019-08-09', '1214.15'),
+        ('2019-08-12', '1215.7'),
+        ('2019-08-13', '1220.7'),
+        ('2019-08-14', '1212.44'),
+        ('2019-08-15', '1214.34'),
+        ('2019-08-16', '1205.75'),
+        ('2019-08-19', '1210.6'),
+        ('2019-08-20', '1207.93'),
+        ('2019-08-21', '1202.0'),
+        ('2019-08-22', '1206.96'),
+        ('2019-08-23', '1210.5'),
+        ('2019-08-26', '1217.51'),
+        ('2019-08-27', '1211.38'),
+        ('2019-08-28', '1213.75'),
+        ('2019-08-29', '1216.14'),
+        ('2019-08-30', '1211.32'),
+        ('2019-09-02', NULL),
+        ('2019-09-03', '1212.44'),
+        ('2019-09-04', '1207.84'),
+        ('2019-09-05', '1200.18'),
+        ('2019-09-06', '1196.81'),
+        ('2019-09-09', '1192.33'),
+        ('2019-09-10', '1191.22'),
+        ('2019-09-11', '1191.51'),
+        ('2019-09-12', '1180.7'),
+        ('2019-09-13', '1176.62'),
+        ('2019-09-16', '1182.96'),
+        ('2019-09-17', '1186.1'),
+        ('2019-09-18', '1191.51'),
+        ('2019-09-19', '1193.87'),
+        ('2019-09-20', '1188.16'),
+        ('2019-09-23', '1195.48'),
+        ('2019-09-24', '1196.52'),
+        ('2019-09-25', '1200.17'),
+        ('2019-09-26', '1201.99'),
+        ('2019-09-27', '1200.03'),
+        ('2019-09-30', '1195.85'),
+        ('2019-10-01', '1198.96'),
+        ('2019-10-02', '1205.47'),
+        ('2019-10-03', '1204.67'),
+        ('2019-10-04', '1192.93'),
+        ('2019-10-07', '1196.49'),
+        ('2019-10-08', '1193.24'),
+        ('2019-10-09', '1197.29'),
+        ('2019-10-10', '1190.06'),
+        ('2019-10-11', '1195.61'),
+        ('2019-10-14', NULL),
+        ('2019-10-15', '1184.67'),
+        ('2019-10-16', '1186.38'),
+        ('2019-10-17', '1179.05'),
+        ('2019-10-18', '1181.06'),
+        ('2019-10-21', '1172.57'),
+        ('2019-10-22', '1170.49'),
+        ('2019-10-23', '1172.07'),
+        ('2019-10-24', '1173.19'),
+        ('2019-10-25', '1171.58'),
+        ('2019-10-28', '1170.17'),
+        ('2019-10-29', '1163.03'),
+        ('2019-10-30', '1167.76'),
+        ('2019-10-31', '1169.1'),
+        ('2019-11-01', '1165.45'),
+        ('2019-11-04', '1159.29'),
+        ('2019-11-05', '1157.64'),
+        ('2019-11-06', '1156.73'),
+        ('2019-11-07', '1154.44'),
+        ('2019-11-08', '1157.14'),
+        ('2019-11-11', NULL),
+        ('2019-11-12', '1160.8'),
+        ('2019-11-13', '1167.76'),
+        ('2019-11-14', '1169.82'),
+        ('2019-11-15', '1166.98'),
+        ('2019-11-18', '1163.76'),
+        ('2019-11-19', '1167.13'),
+        ('2019-11-20', '1169.8'),
+        ('2019-11-21', '1175.71'),
+        ('2019-11-22', '1179.33'),
+        ('2019-11-25', '1173.09'),
+        ('2019-11-26', '1175.59'),
+        ('2019-11-27', '1176.89'),
+        ('2019-11-28', NULL),
+        ('2019-11-29', '1181.33'),
+        ('2019-12-02', '1182.84'),
+        ('2019-12-03', '1190.31'),
+        ('2019-12-04', '1194.45'),
+        ('2019-12-05', '1189.23'),
+        ('2019-12-06', '1189.86'),
+        ('2019-12-09', '1189.45'),
+        ('2019-12-10', '1191.01'),
+        ('2019-12-11', '1192.2'),
+        ('2019-12-12', '1186.41'),
+        ('2019-12-13', '1171.97'),
+        ('2019-12-16', '1171.8'),
+        ('2019-12-17', '1165.81'),
+        ('2019-12-18', '1168.02'),
+        ('2019-12-19', '1165.04'),
+        ('2019-12-20', '1160.3'),
+        ('2019-12-23', '1163.64'),
+        ('2019-12-24', '1163.21'),
+        ('2019-12-25', NULL),
+        ('2019-12-26', '1161.18'),
+        ('2019-12-27', '1160.87'),
+        ('2019-12-30', '1155.75'),
+        ('2019-12-31', '1155.46'),
+        ('2020-01-01', NULL),
+        ('2020-01-02', '1157.95'),
+        ('2020-01-03', '1165.15'),
+        ('2020-01-06', '1167.49'),
+        ('2020-01-07', '1166.21'),
+        ('2020-01-08', '1170.61'),
+        ('2020-01-09', '1158.63'),
+        ('2020-01-10', '1161.18'),
+        ('2020-01-13', '1156.25'),
+        ('2020-01-14', '1156.28'),
+        ('2020-01-15', '1156.84'),
+        ('2020-01-16', '1160.86'),
+        ('2020-01-17', '1159.08'),
+        ('2020-01-20', NULL),
+        ('2020-01-21', '1166.56'),
+        ('2020-01-22', '1165.68'),
+        ('2020-01-23', '1168.38'),
+        ('2020-01-24', '1166.91'),
+        ('2020-01-27', '1176.2'),
+        ('2020-01-28', '1176.23'),
+        ('2020-01-29', '1176.98'),
+        ('2020-01-30', '1191.94'),
+        ('2020-01-31', '1191.3'),
+        ('2020-02-03', '1194.8'),
+        ('2020-02-04', '1184.51'),
+        ('2020-02-05', '1187.25'),
+        ('2020-02-06', '1179.64'),
+        ('2020-02-07', '1186.24'),
+        ('2020-02-10', '1186.96'),
+        ('2020-02-11', '1181.32'),
+        ('2020-02-12', '1178.98'),
+        ('2020-02-13', '1182.02'),
+        ('2020-02-14', '1183.2'),
+        ('2020-02-17', NULL),
+        ('2020-02-18', '1190.6'),
+        ('2020-02-19', '1191.1'),
+        ('2020-02-20', '1198.34'),
+        ('2020-02-21', '1207.91'),
+        ('2020-02-24', '1218.5'),
+        ('2020-02-25', '1214.45'),
+        ('2020-02-26', '1213.61'),
+        ('2020-02-27', '1217.03'),
+        ('2020-02-28', '1214.92'),
+        ('2020-03-02', '1190.89'),
+        ('2020-03-03', '1194.66'),
+        ('2020-03-04', '1187.54'),
+        ('2020-03-05', '1181.11'),
+        ('2020-03-06', '1192.0'),
+        ('2020-03-09', '1203.75'),
+        ('2020-03-10', '1193.1'),
+        ('2020-03-11', '1194.16'),
+        ('2020-03-12', '1206.19'),
+        ('2020-03-13', '1211.7'),
+        ('2020-03-16', '1225.33'),
+        ('2020-03-17', '1240.98'),
+        ('2020-03-18', '1245.19'),
+        ('2020-03-19', '1250.86'),
+        ('2020-03-20', '1250.55'),
+        ('2020-03-23', '1267.25'),
+        ('2020-03-24', '1249.88'),
+        ('2020-03-25', '1228.15'),
+        ('2020-03-26', '1233.47'),
+        ('2020-03-27', '1210.49'),
+        ('2020-03-30', '1224.11'),
+        ('2020-03-31', '1218.92'),
+        ('2020-04-01', '1229.65'),
+        ('2020-04-02', '1229.89'),
+        ('2020-04-03', '1230.56'),
+        ('2020-04-06', '1224.6'),
+        ('2020-04-07', '1220.43'),
+        ('2020-04-08', '1220.77'),
+        ('2020-04-09', '1219.71'),
+        ('2020-04-10', '1211.71'),
+        ('2020-04-13', '1217.72'),
+        ('2020-04-14', '1216.85'),
+        ('2020-04-15', '1224.55'),
+        ('2020-04-16', '1228.21'),
+        ('2020-04-17', '1217.81'),
+        ('2020-04-20', '1217.29'),
+        ('2020-04-21', '1229.28'),
+        ('2020-04-22', '1234.79'),
+        ('2020-04-23', '1229.04'),
+        ('2020-04-24', '1233.88'),
+        ('2020-04-27', '1224.16'),
+        ('2020-04-28', '1224.83'),
+        ('2020-04-29', '1219.2'),
+        ('2020-04-30', '1203.95'),
+        ('2020-05-01', '1223.2'),
+        ('2020-05-04', '1228.81'),
+        ('2020-05-05', '1220.13'),
+        ('2020-05-06', '1222.03'),
+        ('2020-05-07', '1224.76'),
+        ('2020-05-08', '1216.47'),
+        ('2020-05-11', '1220.2'),
+        ('2020-05-12', '1222.0'),
+        ('2020-05-13', '1223.86'),
+        ('2020-05-14', '1227.76'),
+        ('2020-05-15', '1230.71'),
+        ('2020-05-18', '1232.32'),
+        ('2020-05-19', '1225.19'),
+        ('2020-05-20', '1230.02'),
+        ('2020-05-21', '1230.53'),
+        ('2020-05-22', '1236.53'),
+        ('2020-05-25', NULL),
+        ('2020-05-26', '1234.24'),
+        ('2020-05-27', '1238.03'),
+        ('2020-05-28', '1239.28'),
+        ('2020-05-29', '1236.61'),
+        ('2020-06-01', '1224.79'),
+        ('2020-06-02', '1225.07'),
+        ('2020-06-03', '1216.08'),
+        ('2020-06-04', '1218.77'),
+        ('2020-06-05', '1202.79'),
+        ('2020-06-08', '1197.84'),
+        ('2020-06-09', '1197.04'),
+        ('2020-06-10', '1189.9'),
+        ('2020-06-11', '1196.34'),
+        ('2020-06-12', '1204.52'),
+        ('2020-06-15', '1212.21'),
+        ('2020-06-16', '1207.26'),
+        ('2020-06-17', '1215.02'),
+        ('2020-06-18', '1211.65'),
+        ('2020-06-19', '1208.38'),
+        ('2020-06-22', '1210.73'),
+        ('2020-06-23', '1206.43'),
+        ('2020-06-24', '1199.55'),
+        ('2020-06-25', '1203.59'),
+        ('2020-06-26', '1203.44'),
+        ('2020-06-29', '1200.91'),
+        ('2020-06-30', '1200.5'),
+        ('2020-07-01', '1201.84'),
+        ('2020-07-02', '1199.84'),
+        ('2020-07-03', NULL),
+        ('2020-07-06', '1192.66'),
+        ('2020-07-07', '1194.43'),
+        ('2020-07-08', '1194.63'),
+        ('2020-07-09', '1197.84'),
+        ('2020-07-10', '1204.34'),
+        ('2020-07-13', '1200.13'),
+        ('2020-07-14', '1205.24'),
+        ('2020-07-15', '1201.97'),
+        ('2020-07-16', '1202.97'),
+        ('2020-07-17', '1203.86'),
+        ('2020-07-20', '1202.26'),
+        ('2020-07-21', '1194.64'),
+        ('2020-07-22', '1194.83'),
+        ('2020-07-23', '1198.91'),
+        ('2020-07-24', '1203.17'),
+        ('2020-07-27', '1195.94'),
+        ('2020-07-28', '1198.15'),
+        ('2020-07-29', '1192.98'),
+        ('2020-07-30', '1196.3'),
+        ('2020-07-31', '1192.68'),
+        ('2020-08-03', '1194.31'),
+        ('2020-08-04', '1194.53'),
+        ('2020-08-05', '1188.93'),
+        ('2020-08-06', '1185.29'),
+        ('2020-08-07', '1189.25'),
+        ('2020-08-10', '1186.28'),
+        ('2020-08-11', '1184.84'),
+        ('2020-08-12', '1184.09'),
+        ('2020-08-13', '1183.25'),
+        ('2020-08-14', '1186.53'),
+        ('2020-08-17', '1184.17'),
+        ('2020-08-18', '1184.74'),
+        ('2020-08-19', '1181.06'),
+        ('2020-08-20', '1187.66'),
+        ('2020-08-21', '1191.84'),
+        ('2020-08-24', '1189.11'),
+        ('2020-08-25', '1188.18'),
+        ('2020-08-26', '1185.6'),
+        ('2020-08-27', '1185.22'),
+        ('2020-08-28', '1181.62'),
+        ('2020-08-31', '1187.48'),
+        ('2020-09-01', '1182.94'),
+        ('2020-09-02', '1189.98'),
+        ('2020-09-03', '1188.04'),
+        ('2020-09-04', '1190.6'),
+        ('2020-09-07', NULL),
+        ('2020-09-08', '1188.69'),
+        ('2020-09-09', '1185.12'),
+        ('2020-09-10', '1184.41'),
+        ('2020-09-11', '1186.55'),
+        ('2020-09-14', '1181.27'),
+        ('2020-09-15', '1179.99'),
+        ('2020-09-16', '1176.0'),
+        ('2020-09-17', '1174.25'),
+        ('2020-09-18', '1163.2'),
+        ('2020-09-21', '1166.75'),
+        ('2020-09-22', '1164.83'),
+        ('2020-09-23', '1164.56'),
+        ('2020-09-24', '1170.49'),
+        ('2020-09-25', '1172.71'),
+        ('2020-09-28', '1168.08'),
+        ('2020-09-29', '1169.67'),
+        ('2020-09-30', '1165.97'),
+        ('2020-10-01', '1163.05'),
+        ('2020-10-02', '1166.08'),
+        ('2020-10-05', '1157.77'),
+        ('2020-10-06', '1162.0'),
+        ('2020-10-07', '1158.41'),
+        ('2020-10-08', '1152.44'),
+        ('2020-10-09', '1143.46'),
+        ('2020-10-12', NULL),
+        ('2020-10-13', '1147.4'),
+        ('2020-10-14', '1146.58'),
+        ('2020-10-15', '1143.03'),
+        ('2020-10-16', '1146.82'),
+        ('2020-10-19', '1141.76'),
+        ('2020-10-20', '1138.65'),
+        ('2020-10-21', '1132.11'),
+        ('2020-10-22', '1135.26'),
+        ('2020-10-23', '1129.77'),
+        ('2020-10-26', '1127.77'),
+        ('2020-10-27', '1127.9'),
+        ('2020-10-28', '1133.76'),
+        ('2020-10-29', '1131.12'),
+        ('2020-10-30', '1133.99'),
+        ('2020-11-02', '1134.62'),
+        ('2020-11-03', '1134.3'),
+        ('2020-11-04', '1137.37'),
+        ('2020-11-05', '1122.96'),
+        ('2020-11-06', '1121.0'),
+        ('2020-11-09', '1113.14'),
+        ('2020-11-10', '1117.63'),
+        ('2020-11-11', NULL),
+        ('2020-11-12', '1112.95'),
+        ('2020-11-13', '1109.47'),
+        ('2020-11-16', '1106.88'),
+        ('2020-11-17', '1105.33'),
+        ('2020-11-18', '1105.72'),
+        ('2020-11-19', '1114.93'),
+        ('2020-11-20', '1114.48'),
+        ('2020-11-23', '1115.42'),
+        ('2020-11-24', '1110.93'),
+        ('2020-11-25', '1106.84'),
+        ('2020-11-26', NULL),
+        ('2020-11-27', NULL),
+        ('2020-11-30', '1105.79'),
+        ('2020-12-01', '1107.33'),
+        ('2020-12-02', '1100.15'),
+        ('2020-12-03', '1096.28'),
+        ('2020-12-04', '1081.85'),
+        ('2020-12-07', '1082.22'),
+        ('2020-12-08', '1085.4'),
+        ('2020-12-09', '1083.78'),
+        ('2020-12-10', '1086.83'),
+        ('2020-12-11', '1091.23'),
+        ('2020-12-14', '1093.19'),
+        ('2020-12-15', '1092.22'),
+        ('2020-12-16', '1093.71'),
+        ('2020-12-17', '1092.96'),
+        ('2020-12-18', '1099.37'),
+        ('2020-12-21', '1107.03'),
+        ('2020-12-22', '1107.12'),
+        ('2020-12-23', '1106.92'),
+        ('2020-12-24', NULL),
+        ('2020-12-25', NULL),
+        ('2020-12-28', '1096.67'),
+        ('2020-12-29', '1092.55'),
+        ('2020-12-30', '1086.62'),
+        ('2020-12-31', '1086.11'),
+        ('2021-01-01', NULL),
+        ('2021-01-04', '1081.62'),
+        ('2021-01-05', '1087.42'),
+        ('2021-01-06', '1085.71'),
+        ('2021-01-07', '1086.78'),
+        ('2021-01-08', '1089.48'),
+        ('2021-01-11', '1097.39'),
+        ('2021-01-12', '1099.57'),
+        ('2021-01-13', '1098.31'),
+        ('2021-01-14', '1098.37'),
+        ('2021-01-15', '1099.39'),
+        ('2021-01-18', NULL),
+        ('2021-01-19', '1102.14'),
+        ('2021-01-20', NULL),
+        ('2021-01-21', '1097.91'),
+        ('2021-01-22', '1105.5'),
+        ('2021-01-25', '1101.02'),
+        ('2021-01-26', '1103.26'),
+        ('2021-01-27', '1104.09'),
+        ('2021-01-28', '1111.95'),
+        ('2021-01-29', '1118.35')
+)
+INSERT INTO
+    exchange_rates (source_currency_id, destination_currency_id, date, rate)
+SELECT
+    source.id,
+    destination.id,
+    CAST(rates.date AS DATE),
+    CAST(rates.rate AS NUMERIC)
+FROM
+    rates,
+    currencies_codes source,
+    currencies_codes destination
+WHERE
+    source.code = 'KRW'
+    AND destination.code = 'USD'
+ON CONFLICT DO NOTHING;
+
+-- NOKUSD.csv
+WITH rates (date, rate) AS (
+    VALUES
+        ('2016-01-29', '8.7071'),
+        ('2016-02-01', '8.7104'),
+        ('2016-02-02', '8.7184'),
+        ('2016-02-03', '8.6074'),
+        ('2016-02-04', '8.4820'),
+        ('2016-02-05', '8.6002'),
+        ('2016-02-08', '8.5956'),
+        ('2016-02-09', '8.5929'),
+        ('2016-02-10', '8.5613'),
+        ('2016-02-11', '8.5465'),
+        ('2016-02-12', '8.5917'),
+        ('2016-02-15', NULL),
+        ('2016-02-16', '8.6203'),
+        ('2016-02-17', '8.5696'),
+        ('2016-02-18', '8.5961'),
+        ('2016-02-19', '8.5654'),
+        ('2016-02-22', '8.5997'),
+        ('2016-02-23', '8.5984'),
+        ('2016-02-24', '8.6713'),
+        ('2016-02-25', '8.6532'),
+        ('2016-02-26', '8.6826'),
+        ('2016-02-29', '8.6919'),
+        ('2016-03-01', '8.6574'),
+        ('2016-03-02', '8.7023'),
+        ('2016-03-03', '8.5932'),
+        ('2016-03-04', '8.5072'),
+        ('2016-03-07', '8.4984'),
+        ('2016-03-08', '8.5397'),
+        ('2016-03-09', '8.5066'),
+        ('2016-03-10', '8.5309'),
+        ('2016-03-11', '8.3974'),
+        ('2016-03-14', '8.4710'),
+        ('2016-03-15', '8.5487'),
+        ('2016-03-16', '8.5798'),
+        ('2016-03-17', '8.3467'),
+        ('2016-03-18', '8.3382'),
+        ('2016-03-21', '8.3938'),
+        ('2016-03-22', '8.4002'),
+        ('2016-03-23', '8.4854'),
+        ('2016-03-24', '8.4995'),
+        ('2016-03-25', '8.4853'),
+        ('2016-03-28', '8.4195'),
+        ('2016-03-29', '8.4597'),
+        ('2016-03-30', '8.3215'),
+        ('2016-03-31', '8.2520'),
+        ('2016-04-01', '8.3127'),
+        ('2016-04-04', '8.3098'),
+        ('2016-04-05', '8.3486'),
+        ('2016-04-06', '8.2844'),
+        ('2016-04-07', '8.3254'),
+        ('2016-04-08', '8.2230'),
+        ('2016-04-11', '8.1972'),
+        ('2016-04-12', '8.1703'),
+        ('2016-04-13', '8.2299'),
+        ('2016-04-14', '8.2241'),
+        ('2016-04-15', '8.2278'),
+        ('2016-04-18', '8.2129'),
+        ('2016-04-19', '8.1119'),
+        ('2016-04-20', '8.1050'),
+        ('2016-04-21', '8.1741'),
+        ('2016-04-22', '8.2170'),
+        ('2016-04-25', '8.1893'),
+        ('2016-04-26', '8.1360'),
+        ('2016-04-27', '8.1573'),
+        ('2016-04-28', '8.1507'),
+        ('2016-04-29', '8.0643'),
+        ('2016-05-02', '8.0361'),
+        ('2016-05-03', '8.0891'),
+        ('2016-05-04', '8.1466'),
+        ('2016-05-05', '8.1613'),
+        ('2016-05-06', '8.1868'),
+        ('2016-05-09', '8.2147'),
+        ('2016-05-10', '8.2055'),
+        ('2016-05-11', '8.1339'),
+        ('2016-05-12', '8.1522'),
+        ('2016-05-13', '8.2157'),
+        ('2016-05-16', '8.1476'),
+        ('2016-05-17', '8.1578'),
+        ('2016-05-18', '8.2253'),
+        ('2016-05-19', '8.3497'),
+        ('2016-05-20', '8.3272'),
+        ('2016-05-23', '8.3650'),
+        ('2016-05-24', '8.3515'),
+        ('2016-05-25', '8.3389'),
+        ('2016-05-26', '8.2705'),
+        ('2016-05-27', '8.3221'),
+        ('2016-05-30', NULL),
+        ('2016-05-31', '8.3678'),
+        ('2016-06-01', '8.3099'),
+        ('2016-06-02', '8.3355'),
+        ('2016-06-03', '8.2017'),
+        ('2016-06-06', '8.1761'),
+        ('2016-06-07', '8.1332'),
+        ('2016-06-08', '8.1101'),
+        ('2016-06-09', '8.1486'),
+        ('2016-06-10', '8.2325'),
+        ('2016-06-13', '8.2787'),
+        ('2016-06-14', '8.3513'),
+        ('2016-06-15', '8.3130'),
+        ('2016-06-16', '8.4505'),
+        ('2016-06-17', '8.3841'),
+        ('2016-06-20', '8.2614'),
+        ('2016-06-21', '8.3092'),
+        ('2016-06-22', '8.2902'),
+        ('2016-06-23', '8.1661'),
+        ('2016-06-24', '8.4412'),
+        ('2016-06-27', '8.5699'),
+        ('2016-06-28', '8.5082'),
+        ('2016-06-29', '8.3763'),
+        ('2016-06-30', '8.3931'),
+        ('2016-07-01', '8.3279'),
+        ('2016-07-04', NULL),
+        ('2016-07-05', '8.4065'),
+        ('2016-07-06', '8.4724'),
+        ('2016-07-07', '8.4940'),
+        ('2016-07-08', '8.5421'),
+        ('2016-07-11', '8.4990'),
+        ('2016-07-12', '8.4157'),
+        ('2016-07-13', '8.4159'),
+        ('2016-07-14', '8.3653'),
+        ('2016-07-15', '8.4520'),
+        ('2016-07-18', '8.4489'),
+        ('2016-07-19', '8.5075'),
+        ('2016-07-20', '8.4929'),
+        ('2016-07-21', '8.4697'),
+        ('2016-07-22', '8.5524'),
+        ('2016-07-25', '8.5847'),
+        ('2016-07-26', '8.5798'),
+        ('2016-07-27', '8.5910'),
+        ('2016-07-28', '8.5468'),
+        ('2016-07-29', '8.4250'),
+        ('2016-08-01', '8.4750'),
+        ('2016-08-02', '8.4295'),
+        ('2016-08-03', '8.4277'),
+        ('2016-08-04', '8.4283'),
+        ('2016-08-05', '8.4981'),
+        ('2016-08-08', '8.4772'),
+        ('2016-08-09', '8.3952'),
+        ('2016-08-10', '8.2804'),
+        ('2016-08-11', '8.2219'),
+        ('2016-08-12', '8.1874'),
+        ('2016-08-15', '8.2086'),
+        ('2016-08-16', '8.1940'),
+        ('2016-08-17', '8.2419'),
+        ('2016-08-18', '8.1562'),
+        ('2016-08-19', '8.2137'),
+        ('2016-08-22', '8.2256'),
+        ('2016-08-23', '8.2110'),
+        ('2016-08-24', '8.2249'),
+        ('2016-08-25', '8.2223'),
+        ('2016-08-26', '8.2343'),
+        ('2016-08-29', '8.2881'),
+        ('2016-08-30', '8.3362'),
+        ('2016-08-31', '8.3438'),
+        ('2016-09-01', '8.3256'),
+        ('2016-09-02', '8.3206'),
+        ('2016-09-05', NULL),
+        ('2016-09-06', '8.1862'),
+        ('2016-09-07', '8.1839'),
+        ('2016-09-08', '8.1934'),
+        ('2016-09-09', '8.2573'),
+        ('2016-09-12', '8.2301'),
+        ('2016-09-13', '8.2491'),
+        ('2016-09-14', '8.2232'),
+        ('2016-09-15', '8.2309'),
+        ('2016-09-16', '8.3020'),
+        ('2016-09-19', '8.2822'),
+        ('2016-09-20', '8.2961'),
+        ('2016-09-21', '8.2941'),
+        ('2016-09-22', '8.1070'),
+        ('2016-09-23', '8.1099'),
+        ('2016-09-26', '8.0889'),
+        ('2016-09-27', '8.1532'),
+        ('2016-09-28', '8.0828'),
+        ('2016-09-29', '8.0040'),
+        ('2016-09-30', '7.9897'),
+        ('2016-10-03', '7.9725'),
+        ('2016-10-04', '7.9934'),
+        ('2016-10-05', '8.0317'),
+        ('2016-10-06', '8.0431'),
+        ('2016-10-07', '8.1198'),
+        ('2016-10-10', NULL),
+        ('2016-10-11', '8.1872'),
+        ('2016-10-12', '8.2290'),
+        ('2016-10-13', '8.1870'),
+        ('2016-10-14', '8.2257'),
+        ('2016-10-17', '8.1820'),
+        ('2016-10-18', '8.1468'),
+        ('2016-10-19', '8.1423'),
+        ('2016-10-20', '8.1841'),
+        ('2016-10-21', '8.2636'),
+        ('2016-10-24', '8.2463'),
+        ('2016-10-25', '8.2593'),
+        ('2016-10-26', '8.2620'),
+        ('2016-10-27', '8.2536'),
+        ('2016-10-28', '8.2647'),
+        ('2016-10-31', '8.2501'),
+        ('2016-11-01', '8.1986'),
+        ('2016-11-02', '8.1826'),
+        ('2016-11-03', '8.1660'),
+        ('2016-11-04', '8.1751'),
+        ('2016-11-07', '8.2355'),
+        ('2016-11-08', '8.2277'),
+        ('2016-11-09', '8.2885'),
+        ('2016-11-10', '8.3883'),
+        ('2016-11-11', NULL),
+        ('2016-11-14', '8.4973'),
+        ('2016-11-15', '8.4359'),
+        ('2016-11-16', '8.4915'),
+        ('2016-11-17', '8.5061'),
+        ('2016-11-18', '8.5850'),
+        ('2016-11-21', '8.5630'),
+        ('2016-11-22', '8.5354'),
+        ('2016-11-23', '8.6025'),
+        ('2016-11-24', NULL),
+        ('2016-11-25', '8.5757'),
+        ('2016-11-28', '8.5702'),
+        ('2016-11-29', '8.5460'),
+        ('2016-11-30', '8.5223'),
+        ('2016-12-01', '8.4446'),
+        ('2016-12-02', '8.4152'),
+        ('2016-12-05', '8.3801'),
+        ('2016-12-06', '8.3907'),
+        ('2016-12-07', '8.3641'),
+        ('2016-12-08', '8.4326'),
+        ('2016-12-09', '8.5199'),
+        ('2016-12-12', '8.4506'),
+        ('2016-12-13', '8.4178'),
+        ('2016-12-14', '8.4628'),
+        ('2016-12-15', '8.6916'),
+        ('2016-12-16', '8.6706'),
+        ('2016-12-19', '8.6555'),
+        ('2016-12-20', '8.6995'),
+        ('2016-12-21', '8.6671'),
+        ('2016-12-22', '8.7014'),
+        ('2016-12-23', '8.7043'),
+        ('2016-12-26', NULL),
+        ('2016-12-27', '8.7092'),
+        ('2016-12-28', '8.7485'),
+        ('2016-12-29', '8.6598'),
+        ('2016-12-30', '8.6107'),
+        ('2017-01-02', NULL),
+        ('2017-01-03', '8.6489'),
+        ('2017-01-04', '8.5925'),
+        ('2017-01-05', '8.4944'),
+        ('2017-01-06', '8.5193'),
+        ('2017-01-09', '8.5454'),
+        ('2017-01-10', '8.5949'),
+        ('2017-01-11', '8.6240'),
+        ('2017-01-12', '8.4988'),
+        ('2017-01-13', '8.5060'),
+        ('2017-01-16', NULL),
+        ('2017-01-17', '8.4667'),
+        ('2017-01-18', '8.4536'),
+        ('2017-01-19', '8.4648'),
+        ('2017-01-20', NULL),
+        ('2017-01-23', '8.3693'),
+        ('2017-01-24', '8.3274'),
+        ('2017-01-25', '8.3213'),
+        ('2017-01-26', '8.3583'),
+        ('2017-01-27', '8.3346'),
+        ('2017-01-30', '8.3181'),
+        ('2017-01-31', '8.2444'),
+        ('2017-02-01', '8.2492'),
+        ('2017-02-02', '8.2157'),
+        ('2017-02-03', '8.2061'),
+        ('2017-02-06', '8.2552'),
+        ('2017-02-07', '8.3121'),
+        ('2017-02-08', '8.3037'),
+        ('2017-02-09', '8.3380'),
+        ('2017-02-10', '8.3656'),
+        ('2017-02-13', '8.3858'),
+        ('2017-02-14', '8.4049'),
+        ('2017-02-15', '8.3425'),
+        ('2017-02-16', '8.2965'),
+        ('2017-02-17', '8.3382'),
+        ('2017-02-20', NULL),
+        ('2017-02-21', '8.3553'),
+        ('2017-02-22', '8.3638'),
+        ('2017-02-23', '8.3317'),
+        ('2017-02-24', '8.3375'),
+        ('2017-02-27', '8.3400'),
+        ('2017-02-28', '8.3667'),
+        ('2017-03-01', '8.3996'),
+        ('2017-03-02', '8.4667'),
+        ('2017-03-03', '8.4754'),
+        ('2017-03-06', '8.4835'),
+        ('2017-03-07', '8.4489'),
+        ('2017-03-08', '8.5094'),
+        ('2017-03-09', '8.5684'),
+        ('2017-03-10', '8.6034'),
+        ('2017-03-13', '8.5456'),
+        ('2017-03-14', '8.5977'),
+        ('2017-03-15', '8.5983'),
+        ('2017-03-16', '8.5170'),
+        ('2017-03-17', '8.4633'),
+        ('2017-03-20', '8.4648'),
+        ('2017-03-21', '8.4609'),
+        ('2017-03-22', '8.4659'),
+        ('2017-03-23', '8.4811'),
+        ('2017-03-24', '8.4926'),
+        ('2017-03-27', '8.4662'),
+        ('2017-03-28', '8.4915'),
+        ('2017-03-29', '8.5214'),
+        ('2017-03-30', '8.5484'),
+        ('2017-03-31', '8.5756'),
+        ('2017-04-03', '8.5918'),
+        ('2017-04-04', '8.5918'),
+        ('2017-04-05', '8.5984'),
+        ('2017-04-06', '8.6163'),
+        ('2017-04-07', '8.6268'),
+        ('2017-04-10', '8.6175'),
+        ('2017-04-11', '8.5961'),
+        ('2017-04-12', '8.5817'),
+        ('2017-04-13', '8.5506'),
+        ('2017-04-14', '8.5741'),
+        ('2017-04-17', '8.5355'),
+        ('2017-04-18', '8.5192'),
+        ('2017-04-19', '8.5757'),
+        ('2017-04-20', '8.5861'),
+        ('2017-04-21', '8.6779'),
+        ('2017-04-24', '8.5628'),
+        ('2017-04-25', '8.5518'),
+        ('2017-04-26', '8.5902'),
+        ('2017-04-27', '8.5935'),
+        ('2017-04-28', '8.5726'),
+        ('2017-05-01', '8.5838'),
+        ('2017-05-02', '8.5941'),
+        ('2017-05-03', '8.6191'),
+        ('2017-05-04', '8.6774'),
+        ('2017-05-05', '8.6022'),
+        ('2017-05-08', '8.6445'),
+        ('2017-05-09', '8.6802'),
+        ('2017-05-10', '8.6080'),
+        ('2017-05-11', '8.5924'),
+        ('2017-05-12', '8.5713'),
+        ('2017-05-15', '8.5416'),
+        ('2017-05-16', '8.4664'),
+        ('2017-05-17', '8.3977'),
+        ('2017-05-18', '8.4431'),
+        ('2017-05-19', '8.3866'),
+        ('2017-05-22', '8.3464'),
+        ('2017-05-23', '8.3694'),
+        ('2017-05-24', '8.3928'),
+        ('2017-05-25', '8.3531'),
+        ('2017-05-26', '8.3935'),
+        ('2017-05-29', NULL),
+        ('2017-05-30', '8.4304'),
+        ('2017-05-31', '8.4361'),
+        ('2017-06-01', '8.4392'),
+        ('2017-06-02', '8.4237'),
+        ('2017-06-05', '8.4585'),
+        ('2017-06-06', '8.4671'),
+        ('2017-06-07', '8.4912'),
+        ('2017-06-08', '8.4850'),
+        ('2017-06-09', '8.4968'),
+        ('2017-06-12', '8.4655'),
+        ('2017-06-13', '8.4350'),
+        ('2017-06-14', '8.3662'),
+        ('2017-06-15', '8.5007'),
+        ('2017-06-16', '8.4555'),
+        ('2017-06-19', '8.4780'),
+        ('2017-06-20', '8.5613'),
+        ('2017-06-21', '8.5336'),
+        ('2017-06-22', '8.4952'),
+        ('2017-06-23', '8.4471'),
+        ('2017-06-26', '8.4667'),
+        ('2017-06-27', '8.4107'),
+        ('2017-06-28', '8.4498'),
+        ('2017-06-29', '8.4024'),
+        ('2017-06-30', '8.3781'),
+        ('2017-07-03', '8.3647'),
+        ('2017-07-04', NULL),
+        ('2017-07-05', '8.4027'),
+        ('2017-07-06', '8.3543'),
+        ('2017-07-07', '8.3713'),
+        ('2017-07-10', '8.3304'),
+        ('2017-07-11', '8.3265'),
+        ('2017-07-12', '8.2812'),
+        ('2017-07-13', '8.2682'),
+        ('2017-07-14', '8.1977'),
+        ('2017-07-17', '8.1513'),
+        ('2017-07-18', '8.0632'),
+        ('2017-07-19', '8.0645'),
+        ('2017-07-20', '8.0243'),
+        ('2017-07-21', '8.0279'),
+        ('2017-07-24', '8.0136'),
+        ('2017-07-25', '7.9765'),
+        ('2017-07-26', '7.9595'),
+        ('2017-07-27', '7.9578'),
+        ('2017-07-28', '7.9174'),
+        ('2017-07-31', '7.8922'),
+        ('2017-08-01', '7.9235'),
+        ('2017-08-02', '7.8677'),
+        ('2017-08-03', '7.8803'),
+        ('2017-08-04', '7.9550'),
+        ('2017-08-07', '7.9453'),
+        ('2017-08-08', '7.9578'),
+        ('2017-08-09', '7.9602'),
+        ('2017-08-10', '7.9446'),
+        ('2017-08-11', '7.9482'),
+        ('2017-08-14', '7.9370'),
+        ('2017-08-15', '7.9603'),
+        ('2017-08-16', '7.9530'),
+        ('2017-08-17', '7.9504'),
+        ('2017-08-18', '7.9074'),
+        ('2017-08-21', '7.8975'),
+        ('2017-08-22', '7.9137'),
+        ('2017-08-23', '7.8776'),
+        ('2017-08-24', '7.8415'),
+        ('2017-08-25', '7.7775'),
+        ('2017-08-28', '7.7583'),
+        ('2017-08-29', '7.7233'),
+        ('2017-08-30', '7.7834'),
+        ('2017-08-31', '7.7723'),
+        ('2017-09-01', '7.7916'),
+        ('2017-09-04', NULL),
+        ('2017-09-05', '7.7775'),
+        ('2017-09-06', '7.7713'),
+        ('2017-09-07', '7.7479'),
+        ('2017-09-08', '7.7414'),
+        ('2017-09-11', '7.8436'),
+        ('2017-09-12', '7.8267'),
+        ('2017-09-13', '7.8898'),
+        ('2017-09-14', '7.8986'),
+        ('2017-09-15', '7.8427'),
+        ('2017-09-18', '7.8294'),
+        ('2017-09-19', '7.8067'),
+        ('2017-09-20', '7.8066'),
+        ('2017-09-21', '7.8006'),
+        ('2017-09-22', '7.7833'),
+        ('2017-09-25', '7.8325'),
+        ('2017-09-26', '7.9091'),
+        ('2017-09-27', '7.9495'),
+        ('2017-09-28', '7.9530'),
+        ('2017-09-29', '7.9629'),
+        ('2017-10-02', '7.9990'),
+        ('2017-10-03', '7.9695'),
+        ('2017-10-04', '7.9419'),
+        ('2017-10-05', '7.9948'),
+        ('2017-10-06', '8.0051'),
+        ('2017-10-09', NULL),
+        ('2017-10-10', '7.9390'),
+        ('2017-10-11', '7.9212'),
+        ('2017-10-12', '7.8928'),
+        ('2017-10-13', '7.8789'),
+        ('2017-10-16', '7.8818'),
+        ('2017-10-17', '7.9507'),
+        ('2017-10-18', '7.9675'),
+        ('2017-10-19', '7.9498'),
+        ('2017-10-20', '7.9941'),
+        ('2017-10-23', '7.9849'),
+        ('2017-10-24', '7.9927'),
+        ('2017-10-25', '8.0304'),
+        ('2017-10-26', '8.1356'),
+        ('2017-10-27', '8.1811'),
+        ('2017-10-30', '8.1623'),
+        ('2017-10-31', '8.1634'),
+        ('2017-11-01', '8.1596'),
+        ('2017-11-02', '8.1392'),
+        ('2017-11-03', '8.1796'),
+        ('2017-11-06', '8.1482'),
+        ('2017-11-07', '8.1820'),
+        ('2017-11-08', '8.1670'),
+        ('2017-11-09', '8.1290'),
+        ('2017-11-10', NULL),
+        ('2017-11-13', '8.1774'),
+        ('2017-11-14', '8.2016'),
+        ('2017-11-15', '8.2538'),
+        ('2017-11-16', '8.2095'),
+        ('2017-11-17', '8.2431'),
+        ('2017-11-20', '8.2813'),
+        ('2017-11-21', '8.2128'),
+        ('2017-11-22', '8.1866'),
+        ('2017-11-23', NULL),
+        ('2017-11-24', '8.1288'),
+        ('2017-11-27', '8.1470'),
+        ('2017-11-28', '8.2190'),
+        ('2017-11-29', '8.2328'),
+        ('2017-11-30', '8.2929'),
+        ('2017-12-01', '8.2777'),
+        ('2017-12-04', '8.3306'),
+        ('2017-12-05', '8.2819'),
+        ('2017-12-06', '8.2801'),
+        ('2017-12-07', '8.2961'),
+        ('2017-12-08', '8.3082'),
+        ('2017-12-11', '8.3625'),
+        ('2017-12-12', '8.3675'),
+        ('2017-12-13', '8.3761'),
+        ('2017-12-14', '8.2876'),
+        ('2017-12-15', '8.3751'),
+        ('2017-12-18', '8.3358'),
+        ('2017-12-19', '8.3733'),
+        ('2017-12-20', '8.3807'),
+        ('2017-12-21', '8.3773'),
+        ('2017-12-22', '8.3387'),
+        ('2017-12-25', NULL),
+        ('2017-12-26', '8.3148'),
+        ('2017-12-27', '8.2720'),
+        ('2017-12-28', '8.2381'),
+        ('2017-12-29', '8.1819'),
+        ('2018-01-01', NULL),
+        ('2018-01-02', '8.1304'),
+        ('2018-01-03', '8.1100'),
+        ('2018-01-04', '8.0731'),
+        ('2018-01-05', '8.0480'),
+        ('2018-01-08', '8.0712'),
+        ('2018-01-09', '8.1147'),
+        ('2018-01-10', '8.0718'),
+        ('2018-01-11', '8.0135'),
+        ('2018-01-12', '7.9561'),
+        ('2018-01-15', NULL),
+        ('2018-01-16', '7.8682'),
+        ('2018-01-17', '7.8536'),
+        ('2018-01-18', '7.8285'),
+        ('2018-01-19', '7.8528'),
+        ('2018-01-22', '7.8748'),
+        ('2018-01-23', '7.8416'),
+        ('2018-01-24', '7.7679'),
+        ('2018-01-25', '7.6678'),
+        ('2018-01-26', '7.6863'),
+        ('2018-01-29', '7.7270'),
+        ('2018-01-30', '7.7218'),
+        ('2018-01-31', '7.7019'),
+        ('2018-02-01', '7.6671'),
+        ('2018-02-02', '7.7396'),
+        ('2018-02-05', '7.7832'),
+        ('2018-02-06', '7.8253'),
+        ('2018-02-07', '7.8744'),
+        ('2018-02-08', '7.9252'),
+        ('2018-02-09', '7.9867'),
+        ('2018-02-12', '7.9236'),
+        ('2018-02-13', '7.8792'),
+        ('2018-02-14', '7.8429'),
+        ('2018-02-15', '7.7926'),
+        ('2018-02-16', '7.7556'),
+        ('2018-02-19', NULL),
+        ('2018-02-20', '7.8331'),
+        ('2018-02-21', '7.8338'),
+        ('2018-02-22', '7.8656'),
+        ('2018-02-23', '7.8415'),
+        ('2018-02-26', '7.8328'),
+        ('2018-02-27', '7.8669'),
+        ('2018-02-28', '7.8798'),
+        ('2018-03-01', '7.8612'),
+        ('2018-03-02', '7.8037'),
+        ('2018-03-05', '7.8249'),
+        ('2018-03-06', '7.7782'),
+        ('2018-03-07', '7.818'),
+        ('2018-03-08', '7.8409'),
+        ('2018-03-09', '7.7702'),
+        ('2018-03-12', '7.7555'),
+        ('2018-03-13', '7.7226'),
+        ('2018-03-14', '7.737'),
+        ('2018-03-15', '7.7064'),
+        ('2018-03-16', '7.7155'),
+        ('2018-03-19', '7.7293'),
+        ('2018-03-20', '7.7296'),
+        ('2018-03-21', '7.7401'),
+        ('2018-03-22', '7.752'),
+        ('2018-03-23', '7.7462'),
+        ('2018-03-26', '7.6858'),
+        ('2018-03-27', '7.6896'),
+        ('2018-03-28', '7.8342'),
+        ('2018-03-29', '7.8511'),
+        ('2018-03-30', '7.8518'),
+        ('2018-04-02', '7.8859'),
+        ('2018-04-03', '7.8586'),
+        ('2018-04-04', '7.8259'),
+        ('2018-04-05', '7.8396'),
+        ('2018-04-06', '7.8281'),
+        ('2018-04-09', '7.7777'),
+        ('2018-04-10', '7.8101'),
+        ('2018-04-11', '7.759'),
+        ('2018-04-12', '7.7812'),
+        ('2018-04-13', '7.7621'),
+        ('2018-04-16', '7.784'),
+        ('2018-04-17', '7.7765'),
+        ('2018-04-18', '7.7536'),
+        ('2018-04-19', '7.7874'),
+        ('2018-04-20', '7.8223'),
+        ('2018-04-23', '7.8777'),
+        ('2018-04-24', '7.8998'),
+        ('2018-04-25', '7.9701'),
+        ('2018-04-26', '7.9941'),
+        ('2018-04-27', '7.9714'),
+        ('2018-04-30', '8.0232'),
+        ('2018-05-01', '8.0834'),
+        ('2018-05-02', '8.115'),
+        ('2018-05-03', '8.0621'),
+        ('2018-05-04', '8.0575'),
+        ('2018-05-07', '8.0474'),
+        ('2018-05-08', '8.1388'),
+        ('2018-05-09', '8.0832'),
+        ('2018-05-10', '8.0206'),
+        ('2018-05-11', '7.9948'),
+        ('2018-05-14', '7.9914'),
+        ('2018-05-15', '8.0953'),
+        ('2018-05-16', '8.0946'),
+        ('2018-05-17', '8.1234'),
+        ('2018-05-18', '8.1132'),
+        ('2018-05-21', '8.0722'),
+        ('2018-05-22', '8.0358'),
+        ('2018-05-23', '8.1085'),
+        ('2018-05-24', '8.0859'),
+        ('2018-05-25', '8.1478'),
+        ('2018-05-28', NULL),
+        ('2018-05-29', '8.2907'),
+        ('2018-05-30', '8.173'),
+        ('2018-05-31', '8.1849'),
+        ('2018-06-01', '8.1646'),
+        ('2018-06-04', '8.1147'),
+        ('2018-06-05', '8.1344'),
+        ('2018-06-06', '8.0772'),
+        ('2018-06-07', '8.0419'),
+        ('2018-06-08', '8.0574'),
+        ('2018-06-11', '8.0283'),
+        ('2018-06-12', '8.013'),
+        ('2018-06-13', '8.0076'),
+        ('2018-06-14', '8.0944'),
+        ('2018-06-15', '8.1373'),
+        ('2018-06-18', '8.1441'),
+        ('2018-06-19', '8.1891'),
+        ('2018-06-20', '8.1488'),
+        ('2018-06-21', '8.1367'),
+        ('2018-06-22', '8.1108'),
+        ('2018-06-25', '8.1182'),
+        ('2018-06-26', '8.1089'),
+        ('2018-06-27', '8.1544'),
+        ('2018-06-28', '8.1895'),
+        ('2018-06-29', '8.1478'),
+        ('2018-07-02', '8.1896'),
+        ('2018-07-03', '8.1355'),
+        ('2018-07-04', NULL),
+        ('2018-07-05', '8.0501'),
+        ('2018-07-06', '8.0302'),
+        ('2018-07-09', '8.0155'),
+        ('2018-07-10', '8.0322'),
+        ('2018-07-11', '8.059'),
+        ('2018-07-12', '8.0925'),
+        ('2018-07-13', '8.1298'),
+        ('2018-07-16', '8.1021'),
+        ('2018-07-17', '8.134'),
+        ('2018-07-18', '8.1738'),
+        ('2018-07-19', '8.2566'),
+        ('2018-07-20', '8.1888'),
+        ('2018-07-23', '8.181'),
+        ('2018-07-24', '8.1752'),
+        ('2018-07-25', '8.168'),
+        ('2018-07-26', '8.1693'),
+        ('2018-07-27', '8.1807'),
+        ('2018-07-30', '8.1337'),
+        ('2018-07-31', '8.1466'),
+        ('2018-08-01', '8.174'),
+        ('2018-08-02', '8.2235'),
+        ('2018-08-03', '8.2361'),
+        ('2018-08-06', '8.236'),
+        ('2018-08-07', '8.1943'),
+        ('2018-08-08', '8.2315'),
+        ('2018-08-09', '8.2848'),
+        ('2018-08-10', '8.3682'),
+        ('2018-08-13', '8.3835'),
+        ('2018-08-14', '8.3991'),
+        ('2018-08-15', '8.4749'),
+        ('2018-08-16', '8.4592'),
+        ('2018-08-17', '8.4691'),
+        ('2018-08-20', '8.4706'),
+        ('2018-08-21', '8.4242'),
+        ('2018-08-22', '8.3493'),
+        ('2018-08-23', '8.369'),
+        ('2018-08-24', '8.3299'),
+        ('2018-08-27', '8.3195'),
+        ('2018-08-28', '8.3326'),
+        ('2018-08-29', '8.3436'),
+        ('2018-08-30', '8.3546'),
+        ('2018-08-31', '8.3872'),
+        ('2018-09-03', NULL),
+        ('2018-09-04', '8.4102'),
+        ('2018-09-05', '8.4096'),
+        ('2018-09-06', '8.4094'),
+        ('2018-09-07', '8.4381'),
+        ('2018-09-10', '8.377'),
+        ('2018-09-11', '8.3778'),
+        ('2018-09-12', '8.3454'),
+        ('2018-09-13', '8.2557'),
+        ('2018-09-14', '8.235'),
+        ('2018-09-17', '8.173'),
+        ('2018-09-18', '8.1463'),
+        ('2018-09-19', '8.1503'),
+        ('2018-09-20', '8.1666'),
+        ('2018-09-21', '8.156'),
+        ('2018-09-24', '8.1304'),
+        ('2018-09-25', '8.1263'),
+        ('2018-09-26', '8.1049'),
+        ('2018-09-27', '8.137'),
+        ('2018-09-28', '8.1327'),
+        ('2018-10-01', '8.1595'),
+        ('2018-10-02', '8.1637'),
+        ('2018-10-03', '8.2007'),
+        ('2018-10-04', '8.2436'),
+        ('2018-10-05', '8.2755'),
+        ('2018-10-08', NULL),
+        ('2018-10-09', '8.2795'),
+        ('2018-10-10', '8.2035'),
+        ('2018-10-11', '8.2262'),
+        ('2018-10-12', '8.1769'),
+        ('2018-10-15', '8.1481'),
+        ('2018-10-16', '8.1288'),
+        ('2018-10-17', '8.1903'),
+        ('2018-10-18', '8.2469'),
+        ('2018-10-19', '8.2169'),
+        ('2018-10-22', '8.2558'),
+        ('2018-10-23', '8.2978'),
+        ('2018-10-24', '8.3312'),
+        ('2018-10-25', '8.3336'),
+        ('2018-10-26', '8.3423'),
+        ('2018-10-29', '8.3711'),
+        ('2018-10-30', '8.3962'),
+        ('2018-10-31', '8.41'),
+        ('2018-11-01', '8.41'),
+        ('2018-11-02', '8.3721'),
+        ('2018-11-05', '8.3566'),
+        ('2018-11-06', '8.3781'),
+        ('2018-11-07', '8.3273'),
+        ('2018-11-08', '8.3432'),
+        ('2018-11-09', '8.4385'),
+        ('2018-11-12', NULL),
+        ('2018-11-13', '8.4736'),
+        ('2018-11-14', '8.5'),
+        ('2018-11-15', '8.4767'),
+        ('2018-11-16', '8.4435'),
+        ('2018-11-19', '8.4767'),
+        ('2018-11-20', '8.5529'),
+        ('2018-11-21', '8.5197'),
+        ('2018-11-22', NULL),
+        ('2018-11-23', '8.5994'),
+        ('2018-11-26', '8.5864'),
+        ('2018-11-27', '8.6199'),
+        ('2018-11-28', '8.5994'),
+        ('2018-11-29', '8.5325'),
+        ('2018-11-30', '8.5872'),
+        ('2018-12-03', '8.5345'),
+        ('2018-12-04', '8.4941'),
+        ('2018-12-05', NULL),
+        ('2018-12-06', '8.5323'),
+        ('2018-12-07', '8.4844'),
+        ('2018-12-10', '8.5442'),
+        ('2018-12-11', '8.5471'),
+        ('2018-12-12', '8.5649'),
+        ('2018-12-13', '8.5648'),
+        ('2018-12-14', '8.6256'),
+        ('2018-12-17', '8.6279'),
+        ('2018-12-18', '8.7208'),
+        ('2018-12-19', '8.6639'),
+        ('2018-12-20', '8.6961'),
+        ('2018-12-21', '8.7407'),
+        ('2018-12-24', NULL),
+        ('2018-12-25', NULL),
+        ('2018-12-26', '8.7725'),
+        ('2018-12-27', '8.7884'),
+        ('2018-12-28', '8.7191'),
+        ('2018-12-31', '8.6519'),
+        ('2019-01-01', NULL),
+        ('2019-01-02', '8.6976'),
+        ('2019-01-03', '8.694'),
+        ('2019-01-04', '8.6109'),
+        ('2019-01-07', '8.5387'),
+        ('2019-01-08', '8.5505'),
+        ('2019-01-09', '8.4745'),
+        ('2019-01-10', '8.4702'),
+        ('2019-01-11', '8.5245'),
+        ('2019-01-14', NULL),
+        ('2019-01-15', '8.5545'),
+        ('2019-01-16', '8.5305'),
+        ('2019-01-17', '8.5554'),
+        ('2019-01-18', '8.5509'),
+        ('2019-01-21', NULL),
+        ('2019-01-22', '8.5961'),
+        ('2019-01-23', '8.5848'),
+        ('2019-01-24', '8.5756'),
+        ('2019-01-25', '8.5193'),
+        ('2019-01-28', '8.5147'),
+        ('2019-01-29', '8.5008'),
+        ('2019-01-30', '8.4725'),
+        ('2019-01-31', '8.4359'),
+        ('2019-02-01', '8.4316'),
+        ('2019-02-04', '8.47'),
+        ('2019-02-05', '8.4813'),
+        ('2019-02-06', '8.5235'),
+        ('2019-02-07', '8.5805'),
+        ('2019-02-08', '8.6335'),
+        ('2019-02-11', '8.7232'),
+        ('2019-02-12', '8.6525'),
+        ('2019-02-13', '8.6426'),
+        ('2019-02-14', '8.6572'),
+        ('2019-02-15', '8.6473'),
+        ('2019-02-18', NULL),
+        ('2019-02-19', '8.5829'),
+        ('2019-02-20', NULL),
+        ('2019-02-21', '8.6254'),
+        ('2019-02-22', '8.6022'),
+        ('2019-02-25', '8.6093'),
+        ('2019-02-26', '8.5781'),
+        ('2019-02-27', '8.5433'),
+        ('2019-02-28', '8.5538'),
+        ('2019-03-01', '8.5778'),
+        ('2019-03-04', '8.6555'),
+        ('2019-03-05', '8.6739'),
+        ('2019-03-06', '8.664'),
+        ('2019-03-07', '8.7499'),
+        ('2019-03-08', '8.7639'),
+        ('2019-03-11', '8.6885'),
+        ('2019-03-12', '8.634'),
+        ('2019-03-13', '8.5768'),
+        ('2019-03-14', '8.5812'),
+        ('2019-03-15', '8.5377'),
+        ('2019-03-18', '8.5571'),
+        ('2019-03-19', '8.521'),
+        ('2019-03-20', '8.5405'),
+        ('2019-03-21', '8.4699'),
+        ('2019-03-22', '8.5816'),
+        ('2019-03-25', '8.5239'),
+        ('2019-03-26', '8.5356'),
+        ('2019-03-27', '8.6349'),
+        ('2019-03-28', '8.6408'),
+        ('2019-03-29', '8.642'),
+        ('2019-04-01', '8.59'),
+        ('2019-04-02', '8.6205'),
+        ('2019-04-03', '8.5516'),
+        ('2019-04-04', '8.5898'),
+        ('2019-04-05', '8.6155'),
+        ('2019-04-08', '8.5469'),
+        ('2019-04-09', '8.5392'),
+        ('2019-04-10', '8.4993'),
+        ('2019-04-11', '8.5353'),
+        ('2019-04-12', '8.4956'),
+        ('2019-04-15', '8.4957'),
+        ('2019-04-16', '8.4791'),
+        ('2019-04-17', '8.4793'),
+        ('2019-04-18', '8.513'),
+        ('2019-04-19', '8.5155'),
+        ('2019-04-22', '8.495'),
+        ('2019-04-23', '8.5507'),
+        ('2019-04-24', '8.6015'),
+        ('2019-04-25', '8.6631'),
+        ('2019-04-26', '8.6739'),
+        ('2019-04-29', '8.6766'),
+        ('2019-04-30', '8.6434'),
+        ('2019-05-01', '8.6371'),
+        ('2019-05-02', '8.7627'),
+        ('2019-05-03', '8.7056'),
+        ('2019-05-06', '8.7287'),
+        ('2019-05-07', '8.7509'),
+        ('2019-05-08', '8.7591'),
+        ('2019-05-09', '8.7785'),
+        ('2019-05-10', '8.7273'),
+        ('2019-05-13', '8.7556'),
+        ('2019-05-14', '8.758'),
+        ('2019-05-15', '8.7169'),
+        ('2019-05-16', '8.7184'),
+        ('2019-05-17', '8.7836'),
+        ('2019-05-20', '8.7791'),
+        ('2019-05-21', '8.7718'),
+        ('2019-05-22', '8.7495'),
+        ('2019-05-23', '8.7499'),
+        ('2019-05-24', '8.7079'),
+        ('2019-05-27', NULL),
+        ('2019-05-28', '8.6962'),
+        ('2019-05-29', '8.6982'),
+        ('2019-05-30', '8.7602'),
+        ('2019-05-31', '8.7559'),
+        ('2019-06-03', '8.7213'),
+        ('2019-06-04', '8.7109'),
+        ('2019-06-05', '8.7271'),
+        ('2019-06-06', '8.7291'),
+        ('2019-06-07', '8.6273'),
+        ('2019-06-10', '8.6481'),
+        ('2019-06-11', '8.6327'),
+        ('2019-06-12', '8.6421'),
+        ('2019-06-13', '8.6785'),
+        ('2019-06-14', '8.7165'),
+        ('2019-06-17', '8.7135'),
+        ('2019-06-18', '8.7411'),
+        ('2019-06-19', '8.7303'),
+        ('2019-06-20', '8.5728'),
+        ('2019-06-21', '8.5434'),
+        ('2019-06-24', '8.4882'),
+        ('2019-06-25', '8.5088'),
+        ('2019-06-26', '8.4798'),
+        ('2019-06-27', '8.5184'),
+        ('2019-06-28', '8.5286'),
+        ('2019-07-01', '8.5733'),
+        ('2019-07-02', '8.5623'),
+        ('2019-07-03', '8.539'),
+        ('2019-07-04', NULL),
+        ('2019-07-05', '8.6226'),
+        ('2019-07-08', '8.6415'),
+        ('2019-07-09', '8.6416'),
+        ('2019-07-10', '8.5814'),
+        ('2019-07-11', '8.543'),
+        ('2019-07-12', '8.5472'),
+        ('2019-07-15', '8.542'),
+        ('2019-07-16', '8.5583'),
+        ('2019-07-17', '8.5659'),
+        ('2019-07-18', '8.5888'),
+        ('2019-07-19', '8.5696'),
+        ('2019-07-22', '8.6028'),
+        ('2019-07-23', '8.684'),
+        ('2019-07-24', '8.6413'),
+        ('2019-07-25', '8.6676'),
+        ('2019-07-26', '8.7195'),
+        ('2019-07-29', '8.7007'),
+        ('2019-07-30', '8.7571'),
+        ('2019-07-31', '8.7808'),
+        ('2019-08-01', '8.8706'),
+        ('2019-08-02', '8.9135'),
+        ('2019-08-05', '8.9183'),
+        ('2019-08-06', '8.9087'),
+        ('2019-08-07', '8.965'),
+        ('2019-08-08', '8.9215'),
+        ('2019-08-09', '8.8778'),
+        ('2019-08-12', '8.9123'),
+        ('2019-08-13', '8.8756'),
+        ('2019-08-14', '8.9735'),
+        ('2019-08-15', '9.0116'),
+        ('2019-08-16', '8.9899'),
+        ('2019-08-19', '8.9716'),
+        ('2019-08-20', '9.0008'),
+        ('2019-08-21', '8.9474'),
+        ('2019-08-22', '8.9715'),
+        ('2019-08-23', '8.9644'),
+        ('2019-08-26', '8.9888'),
+        ('2019-08-27', '9.0225'),
+        ('2019-08-28', '9.0337'),
+        ('2019-08-29', '9.091'),
+        ('2019-08-30', '9.1192'),
+        ('2019-09-02', NULL),
+        ('2019-09-03', '9.1034'),
+        ('2019-09-04', '9.0368'),
+        ('2019-09-05', '8.9955'),
+        ('2019-09-06', '8.9668'),
+        ('2019-09-09', '8.9255'),
+        ('2019-09-10', '8.951'),
+        ('2019-09-11', '8.9762'),
+        ('2019-09-12', '8.9668'),
+        ('2019-09-13', '8.9765'),
+        ('2019-09-16', '8.9607'),
+        ('2019-09-17', '8.9357'),
+        ('2019-09-18', '8.9331'),
+        ('2019-09-19', '8.9781'),
+        ('2019-09-20', '9.0461'),
+        ('2019-09-23', '9.0552'),
+        ('2019-09-24', '9.0005'),
+        ('2019-09-25', '9.0513'),
+        ('2019-09-26', '9.0813'),
+        ('2019-09-27', '9.0822'),
+        ('2019-09-30', '9.0834'),
+        ('2019-10-01', '9.1192'),
+        ('2019-10-02', '9.1412'),
+        ('2019-10-03', '9.1065'),
+        ('2019-10-04', '9.1025'),
+        ('2019-10-07', '9.129'),
+        ('2019-10-08', '9.1735'),
+        ('2019-10-09', '9.1649'),
+        ('2019-10-10', '9.1184'),
+        ('2019-10-11', '9.0664'),
+        ('2019-10-14', NULL),
+        ('2019-10-15', '9.1194'),
+        ('2019-10-16', '9.1701'),
+        ('2019-10-17', '9.182'),
+        ('2019-10-18', '9.1756'),
+        ('2019-10-21', '9.1375'),
+        ('2019-10-22', '9.1407'),
+        ('2019-10-23', '9.1512'),
+        ('2019-10-24', '9.1372'),
+        ('2019-10-25', '9.1942'),
+        ('2019-10-28', '9.2076'),
+        ('2019-10-29', '9.2304'),
+        ('2019-10-30', '9.2122'),
+        ('2019-10-31', '9.1818'),
+        ('2019-11-01', '9.09'),
+        ('2019-11-04', '9.1226'),
+        ('2019-11-05', '9.1922'),
+        ('2019-11-06', '9.1664'),
+        ('2019-11-07', '9.1255'),
+        ('2019-11-08', '9.1476'),
+        ('2019-11-11', NULL),
+        ('2019-11-12', '9.1438'),
+        ('2019-11-13', '9.189'),
+        ('2019-11-14', '9.1751'),
+        ('2019-11-15', '9.0857'),
+        ('2019-11-18', '9.1032'),
+        ('2019-11-19', '9.1262'),
+        ('2019-11-20', '9.1425'),
+        ('2019-11-21', '9.1348'),
+        ('2019-11-22', '9.1585'),
+        ('2019-11-25', '9.1934'),
+        ('2019-11-26', '9.1725'),
+        ('2019-11-27', '9.1659'),
+        ('2019-11-28', NULL),
+        ('2019-11-29', '9.2156'),
+        ('2019-12-02', '9.1554'),
+        ('2019-12-03', '9.1772'),
+        ('2019-12-04', '9.1729'),
+        ('2019-12-05', '9.1317'),
+        ('2019-12-06', '9.1347'),
+        ('2019-12-09', '9.1445'),
+        ('2019-12-10', '9.172'),
+        ('2019-12-11', '9.1524'),
+        ('2019-12-12', '9.1001'),
+        ('2019-12-13', '9.048'),
+        ('2019-12-16', '9.0134'),
+        ('2019-12-17', '9.0159'),
+        ('2019-12-18', '9.0203'),
+        ('2019-12-19', '8.9619'),
+        ('2019-12-20', '8.9673'),
+        ('2019-12-23', '8.947'),
+        ('2019-12-24', '8.9142'),
+        ('2019-12-25', NULL),
+        ('2019-12-26', '8.8799'),
+        ('2019-12-27', '8.8291'),
+        ('2019-12-30', '8.7839'),
+        ('2019-12-31', '8.7823'),
+        ('2020-01-01', NULL),
+        ('2020-01-02', '8.8074'),
+        ('2020-01-03', '8.8181'),
+        ('2020-01-06', '8.8034'),
+        ('2020-01-07', '8.8588'),
+        ('2020-01-08', '8.8695'),
+        ('2020-01-09', '8.8769'),
+        ('2020-01-10', '8.8857'),
+        ('2020-01-13', '8.8912'),
+        ('2020-01-14', '8.871'),
+        ('2020-01-15', '8.8575'),
+        ('2020-01-16', '8.8821'),
+        ('2020-01-17', '8.9133'),
+        ('2020-01-20', NULL),
+        ('2020-01-21', '8.9698'),
+        ('2020-01-22', '8.9846'),
+        ('2020-01-23', '9.0158'),
+        ('2020-01-24', '9.0393'),
+        ('2020-01-27', '9.1438'),
+        ('2020-01-28', '9.1325'),
+        ('2020-01-29', '9.1646'),
+        ('2020-01-30', '9.2067'),
+        ('2020-01-31', '9.1985'),
+        ('2020-02-03', '9.2815'),
+        ('2020-02-04', '9.2123'),
+        ('2020-02-05', '9.2125'),
+        ('2020-02-06', '9.2342'),
+        ('2020-02-07', '9.2888'),
+        ('2020-02-10', '9.2961'),
+        ('2020-02-11', '9.2546'),
+        ('2020-02-12', '9.2148'),
+        ('2020-02-13', '9.2602'),
+        ('2020-02-14', '9.2479'),
+        ('2020-02-17', NULL),
+        ('2020-02-18', '9.3092'),
+        ('2020-02-19', '9.2762'),
+        ('2020-02-20', '9.3194'),
+        ('2020-02-21', '9.2782'),
+        ('2020-02-24', '9.33'),
+        ('2020-02-25', '9.3743'),
+        ('2020-02-26', '9.3868'),
+        ('2020-02-27', '9.3926'),
+        ('2020-02-28', '9.4491'),
+        ('2020-03-02', '9.3057'),
+        ('2020-03-03', '9.2372'),
+        ('2020-03-04', '9.285'),
+        ('2020-03-05', '9.2792'),
+        ('2020-03-06', '9.2473'),
+        ('2020-03-09', '9.4723'),
+        ('2020-03-10', '9.6008'),
+        ('2020-03-11', '9.6262'),
+        ('2020-03-12', '10.1959'),
+        ('2020-03-13', '10.0904'),
+        ('2020-03-16', '10.2664'),
+        ('2020-03-17', '10.4332'),
+        ('2020-03-18', '11.0912'),
+        ('2020-03-19', '11.2584'),
+        ('2020-03-20', '11.6842'),
+        ('2020-03-23', '11.2963'),
+        ('2020-03-24', '11.0926'),
+        ('2020-03-25', '10.9069'),
+        ('2020-03-26', '10.401'),
+        ('2020-03-27', '10.5017'),
+        ('2020-03-30', '10.6095'),
+        ('2020-03-31', '10.4059'),
+        ('2020-04-01', '10.4593'),
+        ('2020-04-02', '10.3592'),
+        ('2020-04-03', '10.5712'),
+        ('2020-04-06', '10.4341'),
+        ('2020-04-07', '10.2449'),
+        ('2020-04-08', '10.2676'),
+        ('2020-04-09', '10.1992'),
+        ('2020-04-10', '10.175'),
+        ('2020-04-13', '10.3121'),
+        ('2020-04-14', '10.3037'),
+        ('2020-04-15', '10.5356'),
+        ('2020-04-16', '10.4691'),
+        ('2020-04-17', '10.3099'),
+        ('2020-04-20', '10.3634'),
+        ('2020-04-21', '10.5984'),
+        ('2020-04-22', '10.764'),
+        ('2020-04-23', '10.5957'),
+        ('2020-04-24', '10.68'),
+        ('2020-04-27', '10.5772'),
+        ('2020-04-28', '10.4079'),
+        ('2020-04-29', '10.3548'),
+        ('2020-04-30', '10.2553'),
+        ('2020-05-01', '10.3348'),
+        ('2020-05-04', '10.3445'),
+        ('2020-05-05', '10.2603'),
+        ('2020-05-06', '10.2799'),
+        ('2020-05-07', '10.2274'),
+        ('2020-05-08', '10.1864'),
+        ('2020-05-11', '10.285'),
+        ('2020-05-12', '10.1585'),
+        ('2020-05-13', '10.1418'),
+        ('2020-05-14', '10.2232'),
+        ('2020-05-15', '10.2422'),
+        ('2020-05-18', '10.0317'),
+        ('2020-05-19', '9.9461'),
+        ('2020-05-20', '9.9089'),
+        ('2020-05-21', '9.951'),
+        ('2020-05-22', '10.0159'),
+        ('2020-05-25', NULL),
+        ('2020-05-26', '9.907'),
+        ('2020-05-27', '9.9041'),
+        ('2020-05-28', '9.7729'),
+        ('2020-05-29', '9.7306'),
+        ('2020-06-01', '9.6403'),
+        ('2020-06-02', '9.5632'),
+        ('2020-06-03', '9.4544'),
+        ('2020-06-04', '9.3616'),
+        ('2020-06-05', '9.2955'),
+        ('2020-06-08', '9.2754'),
+        ('2020-06-09', '9.2866'),
+        ('2020-06-10', '9.3445'),
+        ('2020-06-11', '9.5249'),
+        ('2020-06-12', '9.6331'),
+        ('2020-06-15', '9.6138'),
+        ('2020-06-16', '9.5735'),
+        ('2020-06-17', '9.5397'),
+        ('2020-06-18', '9.5402'),
+        ('2020-06-19', '9.6154'),
+        ('2020-06-22', '9.5965'),
+        ('2020-06-23', '9.4813'),
+        ('2020-06-24', '9.6145'),
+        ('2020-06-25', '9.6892'),
+        ('2020-06-26', '9.7166'),
+        ('2020-06-29', '9.7074'),
+        ('2020-06-30', '9.6577'),
+        ('2020-07-01', '9.5139'),
+        ('2020-07-02', '9.5357'),
+        ('2020-07-03', NULL),
+        ('2020-07-06', '9.407'),
+        ('2020-07-07', '9.4318'),
+        ('2020-07-08', '9.416'),
+        ('2020-07-09', '9.4554'),
+        ('2020-07-10', '9.4213'),
+        ('2020-07-13', '9.3667'),
+        ('2020-07-14', '9.3875'),
+        ('2020-07-15', '9.3149'),
+        ('2020-07-16', '9.2516'),
+        ('2020-07-17', '9.2712'),
+        ('2020-07-20', '9.2486'),
+        ('2020-07-21', '9.1262'),
+        ('2020-07-22', '9.1262'),
+        ('2020-07-23', '9.1544'),
+        ('2020-07-24', '9.1907'),
+        ('2020-07-27', '9.1115'),
+        ('2020-07-28', '9.0953'),
+        ('2020-07-29', '9.0647'),
+        ('2020-07-30', '9.1302'),
+        ('2020-07-31', '9.0889'),
+        ('2020-08-03', '9.1382'),
+        ('2020-08-04', '9.1165'),
+        ('2020-08-05', '8.9435'),
+        ('2020-08-06', '8.9802'),
+        ('2020-08-07', '9.0623'),
+        ('2020-08-10', '9.0288'),
+        ('2020-08-11', '8.9875'),
+        ('2020-08-12', '8.9387'),
+        ('2020-08-13', '8.8831'),
+        ('2020-08-14', '8.8945'),
+        ('2020-08-17', '8.8633'),
+        ('2020-08-18', '8.8387'),
+        ('2020-08-19', '8.8504'),
+        ('2020-08-20', '8.9437'),
+        ('2020-08-21', '9.0457'),
+        ('2020-08-24', '8.9953'),
+        ('2020-08-25', '8.9755'),
+        ('2020-08-26', '8.9201'),
+        ('2020-08-27', '8.8878'),
+        ('2020-08-28', '8.788'),
+        ('2020-08-31', '8.7043'),
+        ('2020-09-01', '8.748'),
+        ('2020-09-02', '8.8481'),
+        ('2020-09-03', '8.9253'),
+        ('2020-09-04', '8.95'),
+        ('2020-09-07', NULL),
+        ('2020-09-08', '9.0929'),
+        ('2020-09-09', '9.0271'),
+        ('2020-09-10', '8.9975'),
+        ('2020-09-11', '9.0548'),
+        ('2020-09-14', '9.0191'),
+        ('2020-09-15', '9.04'),
+        ('2020-09-16', '9.0109'),
+        ('2020-09-17', '9.0901'),
+        ('2020-09-18', '9.062'),
+        ('2020-09-21', '9.2999'),
+        ('2020-09-22', '9.3559'),
+        ('2020-09-23', '9.4414'),
+        ('2020-09-24', '9.534'),
+        ('2020-09-25', '9.5938'),
+        ('2020-09-28', '9.4839'),
+        ('2020-09-29', '9.4636'),
+        ('2020-09-30', '9.3666'),
+        ('2020-10-01', '9.3095'),
+        ('2020-10-02', '9.3346'),
+        ('2020-10-05', '9.2312'),
+        ('2020-10-06', '9.2845'),
+        ('2020-10-07', '9.3064'),
+        ('2020-10-08', '9.2768'),
+        ('2020-10-09', '9.1531'),
+        ('2020-10-12', NULL),
+        ('2020-10-13', '9.2129'),
+        ('2020-10-14', '9.2179'),
+        ('2020-10-15', '9.3691'),
+        ('2020-10-16', '9.358'),
+        ('2020-10-19', '9.3002'),
+        ('2020-10-20', '9.2666'),
+        ('2020-10-21', '9.2033'),
+        ('2020-10-22', '9.2425'),
+        ('2020-10-23', '9.2637'),
+        ('2020-10-26', '9.2479'),
+        ('2020-10-27', '9.1346'),
+        ('2020-10-28', '9.3456'),
+        ('2020-10-29', '9.5865'),
+        ('2020-10-30', '9.5617'),
+        ('2020-11-02', '9.5665'),
+        ('2020-11-03', '9.3807'),
+        ('2020-11-04', '9.2933'),
+        ('2020-11-05', '9.1882'),
+        ('2020-11-06', '9.1552'),
+        ('2020-11-09', '9.0415'),
+        ('2020-11-10', '9.0318'),
+        ('2020-11-11', NULL),
+        ('2020-11-12', '9.1156'),
+        ('2020-11-13', '9.1685'),
+        ('2020-11-16', '9.0702'),
+        ('2020-11-17', '9.0661'),
+        ('2020-11-18', '8.9914'),
+        ('2020-11-19', '9.0294'),
+        ('2020-11-20', '9.0056'),
+        ('2020-11-23', '9.0577'),
+        ('2020-11-24', '8.9184'),
+        ('2020-11-25', '8.8408'),
+        ('2020-11-26', NULL),
+        ('2020-11-27', NULL),
+        ('2020-11-30', '8.8781'),
+        ('2020-12-01', '8.8296'),
+        ('2020-12-02', '8.8197'),
+        ('2020-12-03', '8.7585'),
+        ('2020-12-04', '8.7883'),
+        ('2020-12-07', '8.733'),
+        ('2020-12-08', '8.7644'),
+        ('2020-12-09', '8.8281'),
+        ('2020-12-10', '8.7745'),
+        ('2020-12-11', '8.8235'),
+        ('2020-12-14', '8.7463'),
+        ('2020-12-15', '8.7221'),
+        ('2020-12-16', '8.6896'),
+        ('2020-12-17', '8.5731'),
+        ('2020-12-18', '8.5959'),
+        ('2020-12-21', '8.653'),
+        ('2020-12-22', '8.7197'),
+        ('2020-12-23', '8.6307'),
+        ('2020-12-24', NULL),
+        ('2020-12-25', NULL),
+        ('2020-12-28', '8.6671'),
+        ('2020-12-29', '8.6017'),
+        ('2020-12-30', '8.5536'),
+        ('2020-12-31', '8.5757'),
+        ('2021-01-01', NULL),
+        ('2021-01-04', '8.566'),
+        ('2021-01-05', '8.4901'),
+        ('2021-01-06', '8.4206'),
+        ('2021-01-07', '8.4384'),
+        ('2021-01-08', '8.4074'),
+        ('2021-01-11', '8.5192'),
+        ('2021-01-12', '8.5085'),
+        ('2021-01-13', '8.4851'),
+        ('2021-01-14', '8.4594'),
+        ('2021-01-15', '8.537'),
+        ('2021-01-18', NULL),
+        ('2021-01-19', '8.5326'),
+        ('2021-01-20', NULL),
+        ('2021-01-21', '8.4321'),
+        ('2021-01-22', '8.482'),
+        ('2021-01-25', '8.5568'),
+        ('2021-01-26', '8.5518'),
+        ('2021-01-27', '8.6341'),
+        ('2021-01-28', '8.606'),
+        ('2021-01-29', '8.5454')
+)
+INSERT INTO
+    exchange_rates (source_currency_id, destination_currency_id, date, rate)
+SELECT
+    source.id,
+    destination.id,
+    CAST(rates.date AS DATE),
+    CAST(rates.rate AS NUMERIC)
+FROM
+    rates,
+    currencies_codes source,
+    currencies_codes destination
+WHERE
+    source.code = 'NOK'
+    AND destination.code = 'USD'
+ON CONFLICT DO NOTHING;
+
+-- SEKUSD.csv
+WITH rates (date, rate) AS (
+    VALUES
+        ('2016-01-29', '8.5709'),
+        ('2016-02-01', '8.5385'),
+        ('2016-02-02', '8.5749'),
+        ('2016-02-03', '8.4745'),
+        ('2016-02-04', '8.3983'),
+        ('2016-02-05', '8.4802'),
+        ('2016-02-08', '8.4593'),
+        ('2016-02-09', '8.4061'),
+        ('2016-02-10', '8.4612'),
+        ('2016-02-11', '8.3440'),
+        ('2016-02-12', '8.4337'),
+        ('2016-02-15', NULL),
+        ('2016-02-16', '8.4959'),
+        ('2016-02-17', '8.5104'),
+        ('2016-02-18', '8.4758'),
+        ('2016-02-19', '8.4426'),
+        ('2016-02-22', '8.5023'),
+        ('2016-02-23', '8.4829'),
+        ('2016-02-24', '8.4859'),
+        ('2016-02-25', '8.5157'),
+        ('2016-02-26', '8.5557'),
+        ('2016-02-29', '8.5709'),
+        ('2016-03-01', '8.6312'),
+        ('2016-03-02', '8.6321'),
+        ('2016-03-03', '8.5418'),
+        ('2016-03-04', '8.4779'),
+        ('2016-03-07', '8.4752'),
+        ('2016-03-08', '8.4776'),
+        ('2016-03-09', '8.4238'),
+        ('2016-03-10', '8.3712'),
+        ('2016-03-11', '8.3339'),
+        ('2016-03-14', '8.3326'),
+        ('2016-03-15', '8.3031'),
+        ('2016-03-16', '8.3168'),
+        ('2016-03-17', '8.1867'),
+        ('2016-03-18', '8.2090'),
+        ('2016-03-21', '8.2166'),
+        ('2016-03-22', '8.2188'),
+        ('2016-03-23', '8.2672'),
+        ('2016-03-24', '8.3021'),
+        ('2016-03-25', '8.3066'),
+        ('2016-03-28', '8.2808'),
+        ('2016-03-29', '8.2546'),
+        ('2016-03-30', '8.1500'),
+        ('2016-03-31', '8.0962'),
+        ('2016-04-01', '8.1164'),
+        ('2016-04-04', '8.1239'),
+        ('2016-04-05', '8.1408'),
+        ('2016-04-06', '8.1378'),
+        ('2016-04-07', '8.1520'),
+        ('2016-04-08', '8.1453'),
+        ('2016-04-11', '8.1112'),
+        ('2016-04-12', '8.0701'),
+        ('2016-04-13', '8.1415'),
+        ('2016-04-14', '8.1264'),
+        ('2016-04-15', '8.1234'),
+        ('2016-04-18', '8.1214'),
+        ('2016-04-19', '8.0740'),
+        ('2016-04-20', '8.0890'),
+        ('2016-04-21', '8.1199'),
+        ('2016-04-22', '8.1244'),
+        ('2016-04-25', '8.1269'),
+        ('2016-04-26', '8.0938'),
+        ('2016-04-27', '8.0814'),
+        ('2016-04-28', '8.0851'),
+        ('2016-04-29', '8.0267'),
+        ('2016-05-02', '7.9761'),
+        ('2016-05-03', '8.0272'),
+        ('2016-05-04', '8.0679'),
+        ('2016-05-05', '8.1073'),
+        ('2016-05-06', '8.1254'),
+        ('2016-05-09', '8.1546'),
+        ('2016-05-10', '8.1466'),
+        ('2016-05-11', '8.1207'),
+        ('2016-05-12', '8.1880'),
+        ('2016-05-13', '8.2562'),
+        ('2016-05-16', '8.2416'),
+        ('2016-05-17', '8.2281'),
+        ('2016-05-18', '8.2868'),
+        ('2016-05-19', '8.3375'),
+        ('2016-05-20', '8.3308'),
+        ('2016-05-23', '8.3599'),
+        ('2016-05-24', '8.3219'),
+        ('2016-05-25', '8.3028'),
+        ('2016-05-26', '8.2847'),
+        ('2016-05-27', '8.3224'),
+        ('2016-05-30', NULL),
+        ('2016-05-31', '8.3360'),
+        ('2016-06-01', '8.3030'),
+        ('2016-06-02', '8.3278'),
+        ('2016-06-03', '8.1742'),
+        ('2016-06-06', '8.1258'),
+        ('2016-06-07', '8.1404'),
+        ('2016-06-08', '8.1102'),
+        ('2016-06-09', '8.1685'),
+        ('2016-06-10', '8.2743'),
+        ('2016-06-13', '8.2548'),
+        ('2016-06-14', '8.3033'),
+        ('2016-06-15', '8.3230'),
+        ('2016-06-16', '8.4216'),
+        ('2016-06-17', '8.3406'),
+        ('2016-06-20', '8.2374'),
+        ('2016-06-21', '8.2575'),
+        ('2016-06-22', '8.2727'),
+        ('2016-06-23', '8.1654'),
+        ('2016-06-24', '8.4345'),
+        ('2016-06-27', '8.5598'),
+        ('2016-06-28', '8.5372'),
+        ('2016-06-29', '8.4602'),
+        ('2016-06-30', '8.5028'),
+        ('2016-07-01', '8.4301'),
+        ('2016-07-04', NULL),
+        ('2016-07-05', '8.5280'),
+        ('2016-07-06', '8.5276'),
+        ('2016-07-07', '8.5724'),
+        ('2016-07-08', '8.5984'),
+        ('2016-07-11', '8.5840'),
+        ('2016-07-12', '8.5203'),
+        ('2016-07-13', '8.4751'),
+        ('2016-07-14', '8.4823'),
+        ('2016-07-15', '8.5455'),
+        ('2016-07-18', '8.5505'),
+        ('2016-07-19', '8.6114'),
+        ('2016-07-20', '8.6003'),
+        ('2016-07-21', '8.5947'),
+        ('2016-07-22', '8.6603'),
+        ('2016-07-25', '8.6513'),
+        ('2016-07-26', '8.6568'),
+        ('2016-07-27', '8.6797'),
+        ('2016-07-28', '8.6243'),
+        ('2016-07-29', '8.5503'),
+        ('2016-08-01', '8.5701'),
+        ('2016-08-02', '8.5105'),
+        ('2016-08-03', '8.5207'),
+        ('2016-08-04', '8.5214'),
+        ('2016-08-05', '8.5634'),
+        ('2016-08-08', '8.5867'),
+        ('2016-08-09', '8.5314'),
+        ('2016-08-10', '8.4686'),
+        ('2016-08-11', '8.4306'),
+        ('2016-08-12', '8.4400'),
+        ('2016-08-15', '8.4542'),
+        ('2016-08-16', '8.4091'),
+        ('2016-08-17', '8.4384'),
+        ('2016-08-18', '8.3580'),
+        ('2016-08-19', '8.3781'),
+        ('2016-08-22', '8.3826'),
+        ('2016-08-23', '8.3781'),
+        ('2016-08-24', '8.4068'),
+        ('2016-08-25', '8.4132'),
+        ('2016-08-26', '8.4464'),
+        ('2016-08-29', '8.4767'),
+        ('2016-08-30', '8.5389'),
+        ('2016-08-31', '8.5689'),
+        ('2016-09-01', '8.5504'),
+        ('2016-09-02', '8.5886'),
+        ('2016-09-05', NULL),
+        ('2016-09-06', '8.4765'),
+        ('2016-09-07', '8.4443'),
+        ('2016-09-08', '8.4483'),
+        ('2016-09-09', '8.4995'),
+        ('2016-09-12', '8.4992'),
+        ('2016-09-13', '8.5072'),
+        ('2016-09-14', '8.4628'),
+        ('2016-09-15', '8.4753'),
+        ('2016-09-16', '8.5761'),
+        ('2016-09-19', '8.5483'),
+        ('2016-09-20', '8.5801'),
+        ('2016-09-21', '8.5831'),
+        ('2016-09-22', '8.5322'),
+        ('2016-09-23', '8.5457'),
+        ('2016-09-26', '8.5175'),
+        ('2016-09-27', '8.6120'),
+        ('2016-09-28', '8.5889'),
+        ('2016-09-29', '8.5435'),
+        ('2016-09-30', '8.5726'),
+        ('2016-10-03', '8.5641'),
+        ('2016-10-04', '8.5838'),
+        ('2016-10-05', '8.5989'),
+        ('2016-10-06', '8.6246'),
+        ('2016-10-07', '8.6616'),
+        ('2016-10-10', NULL),
+        ('2016-10-11', '8.8172'),
+        ('2016-10-12', '8.8306'),
+        ('2016-10-13', '8.8050'),
+        ('2016-10-14', '8.8353'),
+        ('2016-10-17', '8.8135'),
+        ('2016-10-18', '8.8087'),
+        ('2016-10-19', '8.8431'),
+        ('2016-10-20', '8.8577'),
+        ('2016-10-21', '8.9297'),
+        ('2016-10-24', '8.9039'),
+        ('2016-10-25', '8.9224'),
+        ('2016-10-26', '8.8994'),
+        ('2016-10-27', '9.0646'),
+        ('2016-10-28', '9.0353'),
+        ('2016-10-31', '9.0207'),
+        ('2016-11-01', '8.9479'),
+        ('2016-11-02', '8.8938'),
+        ('2016-11-03', '8.9318'),
+        ('2016-11-04', '8.9543'),
+        ('2016-11-07', '9.0272'),
+        ('2016-11-08', '9.0102'),
+        ('2016-11-09', '9.0293'),
+        ('2016-11-10', '9.0874'),
+        ('2016-11-11', NULL),
+        ('2016-11-14', '9.1669'),
+        ('2016-11-15', '9.1814'),
+        ('2016-11-16', '9.1849'),
+        ('2016-11-17', '9.2191'),
+        ('2016-11-18', '9.2515'),
+        ('2016-11-21', '9.2494'),
+        ('2016-11-22', '9.2267'),
+        ('2016-11-23', '9.2686'),
+        ('2016-11-24', NULL),
+        ('2016-11-25', '9.2289'),
+        ('2016-11-28', '9.2275'),
+        ('2016-11-29', '9.1902'),
+        ('2016-11-30', '9.2548'),
+        ('2016-12-01', '9.2479'),
+        ('2016-12-02', '9.1936'),
+        ('2016-12-05', '9.1554'),
+        ('2016-12-06', '9.1214'),
+        ('2016-12-07', '9.0784'),
+        ('2016-12-08', '9.1273'),
+        ('2016-12-09', '9.1968'),
+        ('2016-12-12', '9.1874'),
+        ('2016-12-13', '9.1553'),
+        ('2016-12-14', '9.1535'),
+        ('2016-12-15', '9.4207'),
+        ('2016-12-16', '9.3581'),
+        ('2016-12-19', '9.3427'),
+        ('2016-12-20', '9.3557'),
+        ('2016-12-21', '9.2280'),
+        ('2016-12-22', '9.1911'),
+        ('2016-12-23', '9.1980'),
+        ('2016-12-26', NULL),
+        ('2016-12-27', '9.2277'),
+        ('2016-12-28', '9.2060'),
+        ('2016-12-29', '9.1213'),
+        ('2016-12-30', '9.0803'),
+        ('2017-01-02', NULL),
+        ('2017-01-03', '9.1583'),
+        ('2017-01-04', '9.1108'),
+        ('2017-01-05', '8.9981'),
+        ('2017-01-06', '9.0421'),
+        ('2017-01-09', '9.0441'),
+        ('2017-01-10', '9.0643'),
+        ('2017-01-11', '9.1003'),
+        ('2017-01-12', '8.9121'),
+        ('2017-01-13', '8.9143'),
+        ('2017-01-16', NULL),
+        ('2017-01-17', '8.9119'),
+        ('2017-01-18', '8.9134'),
+        ('2017-01-19', '8.9770'),
+        ('2017-01-20', NULL),
+        ('2017-01-23', '8.8427'),
+        ('2017-01-24', '8.8411'),
+        ('2017-01-25', '8.8254'),
+        ('2017-01-26', '8.8582'),
+        ('2017-01-27', '8.8499'),
+        ('2017-01-30', '8.8396'),
+        ('2017-01-31', '8.7525'),
+        ('2017-02-01', '8.7643'),
+        ('2017-02-02', '8.7176'),
+        ('2017-02-03', '8.7681'),
+        ('2017-02-06', '8.8343'),
+        ('2017-02-07', '8.8625'),
+        ('2017-02-08', '8.8331'),
+        ('2017-02-09', '8.9006'),
+        ('2017-02-10', '8.9000'),
+        ('2017-02-13', '8.9362'),
+        ('2017-02-14', '8.9400'),
+        ('2017-02-15', '8.9191'),
+        ('2017-02-16', '8.8612'),
+        ('2017-02-17', '8.9175'),
+        ('2017-02-20', NULL),
+        ('2017-02-21', '8.9677'),
+        ('2017-02-22', '8.9820'),
+        ('2017-02-23', '8.9860'),
+        ('2017-02-24', '8.9994'),
+        ('2017-02-27', '9.0134'),
+        ('2017-02-28', '9.0098'),
+        ('2017-03-01', '9.0293'),
+        ('2017-03-02', '9.0664'),
+        ('2017-03-03', '9.0372'),
+        ('2017-03-06', '9.0115'),
+        ('2017-03-07', '8.9966'),
+        ('2017-03-08', '9.0444'),
+        ('2017-03-09', '9.0305'),
+        ('2017-03-10', '9.0139'),
+        ('2017-03-13', '8.9466'),
+        ('2017-03-14', '8.9471'),
+        ('2017-03-15', '8.9571'),
+        ('2017-03-16', '8.8241'),
+        ('2017-03-17', '8.8167'),
+        ('2017-03-20', '8.8247'),
+        ('2017-03-21', '8.7890'),
+        ('2017-03-22', '8.7984'),
+        ('2017-03-23', '8.8139'),
+        ('2017-03-24', '8.8111'),
+        ('2017-03-27', '8.7701'),
+        ('2017-03-28', '8.7864'),
+        ('2017-03-29', '8.8801'),
+        ('2017-03-30', '8.9092'),
+        ('2017-03-31', '8.9349'),
+        ('2017-04-03', '8.9639'),
+        ('2017-04-04', '9.0014'),
+        ('2017-04-05', '8.9780'),
+        ('2017-04-06', '9.0076'),
+        ('2017-04-07', '9.0503'),
+        ('2017-04-10', '9.0635'),
+        ('2017-04-11', '9.0450'),
+        ('2017-04-12', '9.0165'),
+        ('2017-04-13', '9.0255'),
+        ('2017-04-14', '9.0289'),
+        ('2017-04-17', '8.9864'),
+        ('2017-04-18', '8.9737'),
+        ('2017-04-19', '8.9820'),
+        ('2017-04-20', '8.9546'),
+        ('2017-04-21', '9.0155'),
+        ('2017-04-24', '8.8732'),
+        ('2017-04-25', '8.7675'),
+        ('2017-04-26', '8.7855'),
+        ('2017-04-27', '8.8499'),
+        ('2017-04-28', '8.8635'),
+        ('2017-05-01', '8.8281'),
+        ('2017-05-02', '8.8291'),
+        ('2017-05-03', '8.8148'),
+        ('2017-05-04', '8.8160'),
+        ('2017-05-05', '8.7941'),
+        ('2017-05-08', '8.8372'),
+        ('2017-05-09', '8.8876'),
+        ('2017-05-10', '8.9130'),
+        ('2017-05-11', '8.8799'),
+        ('2017-05-12', '8.8439'),
+        ('2017-05-15', '8.8190'),
+        ('2017-05-16', '8.7982'),
+        ('2017-05-17', '8.7533'),
+        ('2017-05-18', '8.7755'),
+        ('2017-05-19', '8.7398'),
+        ('2017-05-22', '8.6994'),
+        ('2017-05-23', '8.7069'),
+        ('2017-05-24', '8.7107'),
+        ('2017-05-25', '8.6731'),
+        ('2017-05-26', '8.6926'),
+        ('2017-05-29', NULL),
+        ('2017-05-30', '8.7256'),
+        ('2017-05-31', '8.6788'),
+        ('2017-06-01', '8.6915'),
+        ('2017-06-02', '8.6323'),
+        ('2017-06-05', '8.6621'),
+        ('2017-06-06', '8.6733'),
+        ('2017-06-07', '8.6945'),
+        ('2017-06-08', '8.7159'),
+        ('2017-06-09', '8.7178'),
+        ('2017-06-12', '8.7412'),
+        ('2017-06-13', '8.7035'),
+        ('2017-06-14', '8.6421'),
+        ('2017-06-15', '8.7503'),
+        ('2017-06-16', '8.7035'),
+        ('2017-06-19', '8.7339'),
+        ('2017-06-20', '8.7941'),
+        ('2017-06-21', '8.7619'),
+        ('2017-06-22', '8.7530'),
+        ('2017-06-23', '8.7139'),
+        ('2017-06-26', '8.7130'),
+        ('2017-06-27', '8.6147'),
+        ('2017-06-28', '8.5665'),
+        ('2017-06-29', '8.4950'),
+        ('2017-06-30', '8.4400'),
+        ('2017-07-03', '8.4742'),
+        ('2017-07-04', NULL),
+        ('2017-07-05', '8.5031'),
+        ('2017-07-06', '8.4454'),
+        ('2017-07-07', '8.4373'),
+        ('2017-07-10', '8.4368'),
+        ('2017-07-11', '8.4259'),
+        ('2017-07-12', '8.4176'),
+        ('2017-07-13', '8.3662'),
+        ('2017-07-14', '8.3298'),
+        ('2017-07-17', '8.3016'),
+        ('2017-07-18', '8.2742'),
+        ('2017-07-19', '8.2893'),
+        ('2017-07-20', '8.2314'),
+        ('2017-07-21', '8.2491'),
+        ('2017-07-24', '8.2205'),
+        ('2017-07-25', '8.2025'),
+        ('2017-07-26', '8.2301'),
+        ('2017-07-27', '8.2297'),
+        ('2017-07-28', '8.1177'),
+        ('2017-07-31', '8.0752'),
+        ('2017-08-01', '8.1124'),
+        ('2017-08-02', '8.0958'),
+        ('2017-08-03', '8.0922'),
+        ('2017-08-04', '8.1615'),
+        ('2017-08-07', '8.1597'),
+        ('2017-08-08', '8.1928'),
+        ('2017-08-09', '8.1879'),
+        ('2017-08-10', '8.1475'),
+        ('2017-08-11', '8.1405'),
+        ('2017-08-14', '8.1107'),
+        ('2017-08-15', '8.0847'),
+        ('2017-08-16', '8.1177'),
+        ('2017-08-17', '8.1152'),
+        ('2017-08-18', '8.1082'),
+        ('2017-08-21', '8.0693'),
+        ('2017-08-22', '8.1034'),
+        ('2017-08-23', '8.0753'),
+        ('2017-08-24', '8.0584'),
+        ('2017-08-25', '7.9963'),
+        ('2017-08-28', '7.9591'),
+        ('2017-08-29', '7.9132'),
+        ('2017-08-30', '7.9635'),
+        ('2017-08-31', '7.9550'),
+        ('2017-09-01', '7.9911'),
+        ('2017-09-04', NULL),
+        ('2017-09-05', '7.9640'),
+        ('2017-09-06', '7.9506'),
+        ('2017-09-07', '7.9435'),
+        ('2017-09-08', '7.9361'),
+        ('2017-09-11', '8.0063'),
+        ('2017-09-12', '7.9619'),
+        ('2017-09-13', '8.0295'),
+        ('2017-09-14', '8.0116'),
+        ('2017-09-15', '7.9589'),
+        ('2017-09-18', '7.9673'),
+        ('2017-09-19', '7.9585'),
+        ('2017-09-20', '7.9472'),
+        ('2017-09-21', '7.9705'),
+        ('2017-09-22', '7.9536'),
+        ('2017-09-25', '8.0486'),
+        ('2017-09-26', '8.1471'),
+        ('2017-09-27', '8.1508'),
+        ('2017-09-28', '8.1411'),
+        ('2017-09-29', '8.1538'),
+        ('2017-10-02', '8.1595'),
+        ('2017-10-03', '8.1252'),
+        ('2017-10-04', '8.0959'),
+        ('2017-10-05', '8.1354'),
+        ('2017-10-06', '8.1198'),
+        ('2017-10-09', NULL),
+        ('2017-10-10', '8.0743'),
+        ('2017-10-11', '8.0534'),
+        ('2017-10-12', '8.1051'),
+        ('2017-10-13', '8.0990'),
+        ('2017-10-16', '8.1015'),
+        ('2017-10-17', '8.1608'),
+        ('2017-10-18', '8.1522'),
+        ('2017-10-19', '8.1393'),
+        ('2017-10-20', '8.1729'),
+        ('2017-10-23', '8.1885'),
+        ('2017-10-24', '8.2044'),
+        ('2017-10-25', '8.2228'),
+        ('2017-10-26', '8.3177'),
+        ('2017-10-27', '8.3803'),
+        ('2017-10-30', '8.3602'),
+        ('2017-10-31', '8.3612'),
+        ('2017-11-01', '8.4017'),
+        ('2017-11-02', '8.3849'),
+        ('2017-11-03', '8.4340'),
+        ('2017-11-06', '8.4036'),
+        ('2017-11-07', '8.4021'),
+        ('2017-11-08', '8.3826'),
+        ('2017-11-09', '8.3683'),
+        ('2017-11-10', NULL),
+        ('2017-11-13', '8.3942'),
+        ('2017-11-14', '8.4188'),
+        ('2017-11-15', '8.4436'),
+        ('2017-11-16', '8.4038'),
+        ('2017-11-17', '8.4375'),
+        ('2017-11-20', '8.4803'),
+        ('2017-11-21', '8.4297'),
+        ('2017-11-22', '8.3785'),
+        ('2017-11-23', NULL),
+        ('2017-11-24', '8.2769'),
+        ('2017-11-27', '8.2971'),
+        ('2017-11-28', '8.3377'),
+        ('2017-11-29', '8.3510'),
+        ('2017-11-30', '8.3599'),
+        ('2017-12-01', '8.3623'),
+        ('2017-12-04', '8.4238'),
+        ('2017-12-05', '8.3763'),
+        ('2017-12-06', '8.4298'),
+        ('2017-12-07', '8.4676'),
+        ('2017-12-08', '8.4696'),
+        ('2017-12-11', '8.4962'),
+        ('2017-12-12', '8.4465'),
+        ('2017-12-13', '8.4464'),
+        ('2017-12-14', '8.4288'),
+        ('2017-12-15', '8.5053'),
+        ('2017-12-18', '8.4169'),
+        ('2017-12-19', '8.4195'),
+        ('2017-12-20', '8.3783'),
+        ('2017-12-21', '8.3935'),
+        ('2017-12-22', '8.3580'),
+        ('2017-12-25', NULL),
+        ('2017-12-26', '8.3451'),
+        ('2017-12-27', '8.2684'),
+        ('2017-12-28', '8.2438'),
+        ('2017-12-29', '8.1732'),
+        ('2018-01-01', NULL),
+        ('2018-01-02', '8.1724'),
+        ('2018-01-03', '8.1708'),
+        ('2018-01-04', '8.1370'),
+        ('2018-01-05', '8.1331'),
+        ('2018-01-08', '8.1986'),
+        ('2018-01-09', '8.2413'),
+        ('2018-01-10', '8.1973'),
+        ('2018-01-11', '8.1325'),
+        ('2018-01-12', '8.0933'),
+        ('2018-01-15', NULL),
+        ('2018-01-16', '8.0402'),
+        ('2018-01-17', '8.0309'),
+        ('2018-01-18', '8.0151'),
+        ('2018-01-19', '8.0348'),
+        ('2018-01-22', '8.0412'),
+        ('2018-01-23', '8.0289'),
+        ('2018-01-24', '7.9408'),
+        ('2018-01-25', '7.8549'),
+        ('2018-01-26', '7.8723'),
+        ('2018-01-29', '7.9063'),
+        ('2018-01-30', '7.8925'),
+        ('2018-01-31', '7.8736'),
+        ('2018-02-01', '7.8588'),
+        ('2018-02-02', '7.9092'),
+        ('2018-02-05', '7.9258'),
+        ('2018-02-06', '7.9540'),
+        ('2018-02-07', '8.0396'),
+        ('2018-02-08', '8.1225'),
+        ('2018-02-09', '8.1069'),
+        ('2018-02-12', '8.0868'),
+        ('2018-02-13', '8.0257'),
+        ('2018-02-14', '8.0051'),
+        ('2018-02-15', '7.9596'),
+        ('2018-02-16', '7.9440'),
+        ('2018-02-19', NULL),
+        ('2018-02-20', '8.0775'),
+        ('2018-02-21', '8.0766'),
+        ('2018-02-22', '8.1228'),
+        ('2018-02-23', '8.1611'),
+        ('2018-02-26', '8.1609'),
+        ('2018-02-27', '8.2235'),
+        ('2018-02-28', '8.2694'),
+        ('2018-03-01', '8.2603'),
+        ('2018-03-02', '8.259'),
+        ('2018-03-05', '8.2558'),
+        ('2018-03-06', '8.209'),
+        ('2018-03-07', '8.2539'),
+        ('2018-03-08', '8.2705'),
+        ('2018-03-09', '8.2307'),
+        ('2018-03-12', '8.2461'),
+        ('2018-03-13', '8.2013'),
+        ('2018-03-14', '8.1973'),
+        ('2018-03-15', '8.1808'),
+        ('2018-03-16', '8.196'),
+        ('2018-03-19', '8.1707'),
+        ('2018-03-20', '8.2043'),
+        ('2018-03-21', '8.223'),
+        ('2018-03-22', '8.2448'),
+        ('2018-03-23', '8.2472'),
+        ('2018-03-26', '8.1941'),
+        ('2018-03-27', '8.2118'),
+        ('2018-03-28', '8.3133'),
+        ('2018-03-29', '8.3619'),
+        ('2018-03-30', '8.3619'),
+        ('2018-04-02', '8.39'),
+        ('2018-04-03', '8.3973'),
+        ('2018-04-04', '8.4029'),
+        ('2018-04-05', '8.4306'),
+        ('2018-04-06', '8.3906'),
+        ('2018-04-09', '8.3617'),
+        ('2018-04-10', '8.3157'),
+        ('2018-04-11', '8.3274'),
+        ('2018-04-12', '8.4237'),
+        ('2018-04-13', '8.446'),
+        ('2018-04-16', '8.4279'),
+        ('2018-04-17', '8.4183'),
+        ('2018-04-18', '8.3922'),
+        ('2018-04-19', '8.407'),
+        ('2018-04-20', '8.4453'),
+        ('2018-04-23', '8.501'),
+        ('2018-04-24', '8.5138'),
+        ('2018-04-25', '8.5597'),
+        ('2018-04-26', '8.6604'),
+        ('2018-04-27', '8.6769'),
+        ('2018-04-30', '8.763'),
+        ('2018-05-01', '8.8386'),
+        ('2018-05-02', '8.8974'),
+        ('2018-05-03', '8.8417'),
+        ('2018-05-04', '8.8033'),
+        ('2018-05-07', '8.8184'),
+        ('2018-05-08', '8.8136'),
+        ('2018-05-09', '8.7044'),
+        ('2018-05-10', '8.6631'),
+        ('2018-05-11', '8.5912'),
+        ('2018-05-14', '8.6003'),
+        ('2018-05-15', '8.6668'),
+        ('2018-05-16', '8.6664'),
+        ('2018-05-17', '8.7446'),
+        ('2018-05-18', '8.7504'),
+        ('2018-05-21', '8.7015'),
+        ('2018-05-22', '8.6708'),
+        ('2018-05-23', '8.7691'),
+        ('2018-05-24', '8.7539'),
+        ('2018-05-25', '8.7432'),
+        ('2018-05-28', NULL),
+        ('2018-05-29', '8.9564'),
+        ('2018-05-30', '8.8025'),
+        ('2018-05-31', '8.8233'),
+        ('2018-06-01', '8.8205'),
+        ('2018-06-04', '8.753'),
+        ('2018-06-05', '8.7774'),
+        ('2018-06-06', '8.7279'),
+        ('2018-06-07', '8.6793'),
+        ('2018-06-08', '8.716'),
+        ('2018-06-11', '8.6511'),
+        ('2018-06-12', '8.6059'),
+        ('2018-06-13', '8.6179'),
+        ('2018-06-14', '8.6839'),
+        ('2018-06-15', '8.7822'),
+        ('2018-06-18', '8.8301'),
+        ('2018-06-19', '8.9066'),
+        ('2018-06-20', '8.8703'),
+        ('2018-06-21', '8.9077'),
+        ('2018-06-22', '8.8698'),
+        ('2018-06-25', '8.8699'),
+        ('2018-06-26', '8.8558'),
+        ('2018-06-27', '8.9352'),
+        ('2018-06-28', '9.0091'),
+        ('2018-06-29', '8.9511'),
+        ('2018-07-02', '8.9988'),
+        ('2018-07-03', '8.8262'),
+        ('2018-07-04', NULL),
+        ('2018-07-05', '8.767'),
+        ('2018-07-06', '8.7275'),
+        ('2018-07-09', '8.7241'),
+        ('2018-07-10', '8.7474'),
+        ('2018-07-11', '8.7769'),
+        ('2018-07-12', '8.8454'),
+        ('2018-07-13', '8.904'),
+        ('2018-07-16', '8.8205'),
+        ('2018-07-17', '8.825'),
+        ('2018-07-18', '8.8646'),
+        ('2018-07-19', '8.9426'),
+        ('2018-07-20', '8.8671'),
+        ('2018-07-23', '8.8522'),
+        ('2018-07-24', '8.828'),
+        ('2018-07-25', '8.8035'),
+        ('2018-07-26', '8.8052'),
+        ('2018-07-27', '8.8373'),
+        ('2018-07-30', '8.7522'),
+        ('2018-07-31', '8.7856'),
+        ('2018-08-01', '8.8033'),
+        ('2018-08-02', '8.8729'),
+        ('2018-08-03', '8.9009'),
+        ('2018-08-06', '8.9208'),
+        ('2018-08-07', '8.9106'),
+        ('2018-08-08', '8.9601'),
+        ('2018-08-09', '8.9786'),
+        ('2018-08-10', '9.1367'),
+        ('2018-08-13', '9.1325'),
+        ('2018-08-14', '9.1308'),
+        ('2018-08-15', '9.2228'),
+        ('2018-08-16', '9.1874'),
+        ('2018-08-17', '9.1726'),
+        ('2018-08-20', '9.1778'),
+        ('2018-08-21', '9.1198'),
+        ('2018-08-22', '9.0668'),
+        ('2018-08-23', '9.1208'),
+        ('2018-08-24', '9.1138'),
+        ('2018-08-27', '9.0953'),
+        ('2018-08-28', '9.1154'),
+        ('2018-08-29', '9.1518'),
+        ('2018-08-30', '9.1388'),
+        ('2018-08-31', '9.1566'),
+        ('2018-09-03', NULL),
+        ('2018-09-04', '9.1118'),
+        ('2018-09-05', '9.0702'),
+        ('2018-09-06', '9.0697'),
+        ('2018-09-07', '9.0813'),
+        ('2018-09-10', '9.0357'),
+        ('2018-09-11', '9.0354'),
+        ('2018-09-12', '9.0653'),
+        ('2018-09-13', '8.988'),
+        ('2018-09-14', '9.0299'),
+        ('2018-09-17', '8.9141'),
+        ('2018-09-18', '8.8993'),
+        ('2018-09-19', '8.868'),
+        ('2018-09-20', '8.7931'),
+        ('2018-09-21', '8.7938'),
+        ('2018-09-24', '8.7953'),
+        ('2018-09-25', '8.7939'),
+        ('2018-09-26', '8.8082'),
+        ('2018-09-27', '8.8202'),
+        ('2018-09-28', '8.878'),
+        ('2018-10-01', '8.9488'),
+        ('2018-10-02', '8.9855'),
+        ('2018-10-03', '9.029'),
+        ('2018-10-04', '9.0479'),
+        ('2018-10-05', '9.0857'),
+        ('2018-10-08', NULL),
+        ('2018-10-09', '9.115'),
+        ('2018-10-10', '9.0955'),
+        ('2018-10-11', '9.0146'),
+        ('2018-10-12', '8.9654'),
+        ('2018-10-15', '8.9405'),
+        ('2018-10-16', '8.886'),
+        ('2018-10-17', '8.949'),
+        ('2018-10-18', '9.0182'),
+        ('2018-10-19', '8.9864'),
+        ('2018-10-22', '8.9967'),
+        ('2018-10-23', '9.035'),
+        ('2018-10-24', '9.0959'),
+        ('2018-10-25', '9.1089'),
+        ('2018-10-26', '9.1256'),
+        ('2018-10-29', '9.1278'),
+        ('2018-10-30', '9.1567'),
+        ('2018-10-31', '9.1462'),
+        ('2018-11-01', '9.1462'),
+        ('2018-11-02', '9.0777'),
+        ('2018-11-05', '9.0722'),
+        ('2018-11-06', '9.0716'),
+        ('2018-11-07', '9.0028'),
+        ('2018-11-08', '8.9832'),
+        ('2018-11-09', '9.0716'),
+        ('2018-11-12', NULL),
+        ('2018-11-13', '9.1207'),
+        ('2018-11-14', '9.0863'),
+        ('2018-11-15', '9.0535'),
+        ('2018-11-16', '9.0151'),
+        ('2018-11-19', '9.0025'),
+        ('2018-11-20', '9.0638'),
+        ('2018-11-21', '9.0278'),
+        ('2018-11-22', NULL),
+        ('2018-11-23', '9.0909'),
+        ('2018-11-26', '9.0929'),
+        ('2018-11-27', '9.1149'),
+        ('2018-11-28', '9.1007'),
+        ('2018-11-29', '9.0599'),
+        ('2018-11-30', '9.0982'),
+        ('2018-12-03', '9.0234'),
+        ('2018-12-04', '9.0086'),
+        ('2018-12-05', NULL),
+        ('2018-12-06', '9.0037'),
+        ('2018-12-07', '9.0318'),
+        ('2018-12-10', '9.0804'),
+        ('2018-12-11', '9.0819'),
+        ('2018-12-12', '9.0916'),
+        ('2018-12-13', '9.054'),
+        ('2018-12-14', '9.0758'),
+        ('2018-12-17', '9.0447'),
+        ('2018-12-18', '9.0711'),
+        ('2018-12-19', '9.0572'),
+        ('2018-12-20', '8.9922'),
+        ('2018-12-21', '9.0227'),
+        ('2018-12-24', NULL),
+        ('2018-12-25', NULL),
+        ('2018-12-26', '9.0704'),
+        ('2018-12-27', '9.0101'),
+        ('2018-12-28', '8.9595'),
+        ('2018-12-31', '8.8654'),
+        ('2019-01-01', NULL),
+        ('2019-01-02', '8.9931'),
+        ('2019-01-03', '9.0004'),
+        ('2019-01-04', '8.9488'),
+        ('2019-01-07', '8.9013'),
+        ('2019-01-08', '8.9281'),
+        ('2019-01-09', '8.8836'),
+        ('2019-01-10', '8.8829'),
+        ('2019-01-11', '8.9305'),
+        ('2019-01-14', NULL),
+        ('2019-01-15', '8.9763'),
+        ('2019-01-16', '8.9846'),
+        ('2019-01-17', '9.027'),
+        ('2019-01-18', '9.0187'),
+        ('2019-01-21', NULL),
+        ('2019-01-22', '9.0263'),
+        ('2019-01-23', '9.0118'),
+        ('2019-01-24', '9.0729'),
+        ('2019-01-25', '9.0518'),
+        ('2019-01-28', '9.0527'),
+        ('2019-01-29', '9.0726'),
+        ('2019-01-30', '9.0908'),
+        ('2019-01-31', '9.0465'),
+        ('2019-02-01', '9.0384'),
+        ('2019-02-04', '9.0968'),
+        ('2019-02-05', '9.1098'),
+        ('2019-02-06', '9.1721'),
+        ('2019-02-07', '9.238'),
+        ('2019-02-08', '9.265'),
+        ('2019-02-11', '9.3008'),
+        ('2019-02-12', '9.2598'),
+        ('2019-02-13', '9.2425'),
+        ('2019-02-14', '9.3032'),
+        ('2019-02-15', '9.2867'),
+        ('2019-02-18', NULL),
+        ('2019-02-19', '9.3256'),
+        ('2019-02-20', NULL),
+        ('2019-02-21', '9.3519'),
+        ('2019-02-22', '9.351'),
+        ('2019-02-25', '9.3218'),
+        ('2019-02-26', '9.3018'),
+        ('2019-02-27', '9.2671'),
+        ('2019-02-28', '9.2341'),
+        ('2019-03-01', '9.2607'),
+        ('2019-03-04', '9.3444'),
+        ('2019-03-05', '9.3244'),
+        ('2019-03-06', '9.288'),
+        ('2019-03-07', '9.4392'),
+        ('2019-03-08', '9.4369'),
+        ('2019-03-11', '9.4168'),
+        ('2019-03-12', '9.3647'),
+        ('2019-03-13', '9.3031'),
+        ('2019-03-14', '9.3143'),
+        ('2019-03-15', '9.2399'),
+        ('2019-03-18', '9.2351'),
+        ('2019-03-19', '9.202'),
+        ('2019-03-20', '9.1839'),
+        ('2019-03-21', '9.1783'),
+        ('2019-03-22', '9.2947'),
+        ('2019-03-25', '9.2282'),
+        ('2019-03-26', '9.232'),
+        ('2019-03-27', '9.2772'),
+        ('2019-03-28', '9.2962'),
+        ('2019-03-29', '9.2949'),
+        ('2019-04-01', '9.2821'),
+        ('2019-04-02', '9.3349'),
+        ('2019-04-03', '9.2636'),
+        ('2019-04-04', '9.2768'),
+        ('2019-04-05', '9.2875'),
+        ('2019-04-08', '9.2523'),
+        ('2019-04-09', '9.2437'),
+        ('2019-04-10', '9.2637'),
+        ('2019-04-11', '9.2745'),
+        ('2019-04-12', '9.2636'),
+        ('2019-04-15', '9.2573'),
+        ('2019-04-16', '9.2445'),
+        ('2019-04-17', '9.245'),
+        ('2019-04-18', '9.3007'),
+        ('2019-04-19', '9.3027'),
+        ('2019-04-22', '9.3024'),
+        ('2019-04-23', '9.3665'),
+        ('2019-04-24', '9.3982'),
+        ('2019-04-25', '9.5287'),
+        ('2019-04-26', '9.4979'),
+        ('2019-04-29', '9.5242'),
+        ('2019-04-30', '9.5041'),
+        ('2019-05-01', '9.4968'),
+        ('2019-05-02', '9.5663'),
+        ('2019-05-03', '9.5483'),
+        ('2019-05-06', '9.5618'),
+        ('2019-05-07', '9.5996'),
+        ('2019-05-08', '9.5894'),
+        ('2019-05-09', '9.6466'),
+        ('2019-05-10', '9.6173'),
+        ('2019-05-13', '9.6251'),
+        ('2019-05-14', '9.626'),
+        ('2019-05-15', '9.6003'),
+        ('2019-05-16', '9.6018'),
+        ('2019-05-17', '9.6408'),
+        ('2019-05-20', '9.6552'),
+        ('2019-05-21', '9.66'),
+        ('2019-05-22', '9.6331'),
+        ('2019-05-23', '9.624'),
+        ('2019-05-24', '9.5539'),
+        ('2019-05-27', NULL),
+        ('2019-05-28', '9.5673'),
+        ('2019-05-29', '9.5692'),
+        ('2019-05-30', '9.5312'),
+        ('2019-05-31', '9.513'),
+        ('2019-06-03', '9.4729'),
+        ('2019-06-04', '9.4561'),
+        ('2019-06-05', '9.4394'),
+        ('2019-06-06', '9.4418'),
+        ('2019-06-07', '9.4001'),
+        ('2019-06-10', '9.4173'),
+        ('2019-06-11', '9.4424'),
+        ('2019-06-12', '9.4516'),
+        ('2019-06-13', '9.4878'),
+        ('2019-06-14', '9.4858'),
+        ('2019-06-17', '9.4705'),
+        ('2019-06-18', '9.5197'),
+        ('2019-06-19', '9.5197'),
+        ('2019-06-20', '9.4176'),
+        ('2019-06-21', '9.3861'),
+        ('2019-06-24', '9.2946'),
+        ('2019-06-25', '9.2585'),
+        ('2019-06-26', '9.2506'),
+        ('2019-06-27', '9.2713'),
+        ('2019-06-28', '9.2869'),
+        ('2019-07-01', '9.3364'),
+        ('2019-07-02', '9.3219'),
+        ('2019-07-03', '9.3155'),
+        ('2019-07-04', NULL),
+        ('2019-07-05', '9.4413'),
+        ('2019-07-08', '9.4618'),
+        ('2019-07-09', '9.4701'),
+        ('2019-07-10', '9.4105'),
+        ('2019-07-11', '9.3845'),
+        ('2019-07-12', '9.3763'),
+        ('2019-07-15', '9.3568'),
+        ('2019-07-16', '9.3801'),
+        ('2019-07-17', '9.3537'),
+        ('2019-07-18', '9.351'),
+        ('2019-07-19', '9.3764'),
+        ('2019-07-22', '9.4079'),
+        ('2019-07-23', '9.4649'),
+        ('2019-07-24', '9.4284'),
+        ('2019-07-25', '9.439'),
+        ('2019-07-26', '9.4974'),
+        ('2019-07-29', '9.4853'),
+        ('2019-07-30', '9.5678'),
+        ('2019-07-31', '9.5929'),
+        ('2019-08-01', '9.6492'),
+        ('2019-08-02', '9.6397'),
+        ('2019-08-05', '9.6109'),
+        ('2019-08-06', '9.588'),
+        ('2019-08-07', '9.6311'),
+        ('2019-08-08', '9.5777'),
+        ('2019-08-09', '9.5384'),
+        ('2019-08-12', '9.5709'),
+        ('2019-08-13', '9.5513'),
+        ('2019-08-14', '9.6326'),
+        ('2019-08-15', '9.6393'),
+        ('2019-08-16', '9.6643'),
+        ('2019-08-19', '9.6668'),
+        ('2019-08-20', '9.7057'),
+        ('2019-08-21', '9.6202'),
+        ('2019-08-22', '9.6641'),
+        ('2019-08-23', '9.6278'),
+        ('2019-08-26', '9.6712'),
+        ('2019-08-27', '9.6623'),
+        ('2019-08-28', '9.719'),
+        ('2019-08-29', '9.7695'),
+        ('2019-08-30', '9.8277'),
+        ('2019-09-02', NULL),
+        ('2019-09-03', '9.8378'),
+        ('2019-09-04', '9.7519'),
+        ('2019-09-05', '9.6796'),
+        ('2019-09-06', '9.6179'),
+        ('2019-09-09', '9.6489'),
+        ('2019-09-10', '9.6885'),
+        ('2019-09-11', '9.6871'),
+        ('2019-09-12', '9.6312'),
+        ('2019-09-13', '9.6095'),
+        ('2019-09-16', '9.6649'),
+        ('2019-09-17', '9.6711'),
+        ('2019-09-18', '9.6955'),
+        ('2019-09-19', '9.7018'),
+        ('2019-09-20', '9.7313'),
+        ('2019-09-23', '9.7217'),
+        ('2019-09-24', '9.6905'),
+        ('2019-09-25', '9.7538'),
+        ('2019-09-26', '9.757'),
+        ('2019-09-27', '9.8006'),
+        ('2019-09-30', '9.8307'),
+        ('2019-10-01', '9.8791'),
+        ('2019-10-02', '9.8791'),
+        ('2019-10-03', '9.8375'),
+        ('2019-10-04', '9.8512'),
+        ('2019-10-07', '9.8999'),
+        ('2019-10-08', '9.9471'),
+        ('2019-10-09', '9.9576'),
+        ('2019-10-10', '9.8406'),
+        ('2019-10-11', '9.8406'),
+        ('2019-10-14', NULL),
+        ('2019-10-15', '9.7916'),
+        ('2019-10-16', '9.7807'),
+        ('2019-10-17', '9.7088'),
+        ('2019-10-18', '9.6665'),
+        ('2019-10-21', '9.629'),
+        ('2019-10-22', '9.6328'),
+        ('2019-10-23', '9.6592'),
+        ('2019-10-24', '9.6481'),
+        ('2019-10-25', '9.6901'),
+        ('2019-10-28', '9.7045'),
+        ('2019-10-29', '9.7176'),
+        ('2019-10-30', '9.6903'),
+        ('2019-10-31', '9.6334'),
+        ('2019-11-01', '9.5639'),
+        ('2019-11-04', '9.603'),
+        ('2019-11-05', '9.6698'),
+        ('2019-11-06', '9.6179'),
+        ('2019-11-07', '9.6369'),
+        ('2019-11-08', '9.7095'),
+        ('2019-11-11', NULL),
+        ('2019-11-12', '9.71'),
+        ('2019-11-13', '9.7303'),
+        ('2019-11-14', '9.6964'),
+        ('2019-11-15', '9.6467'),
+        ('2019-11-18', '9.6139'),
+        ('2019-11-19', '9.6399'),
+        ('2019-11-20', '9.6334'),
+        ('2019-11-21', '9.6344'),
+        ('2019-11-22', '9.6235'),
+        ('2019-11-25', '9.6468'),
+        ('2019-11-26', '9.5976'),
+        ('2019-11-27', '9.5794'),
+        ('2019-11-28', NULL),
+        ('2019-11-29', '9.57'),
+        ('2019-12-02', '9.5384'),
+        ('2019-12-03', '9.5254'),
+        ('2019-12-04', '9.5217'),
+        ('2019-12-05', '9.4811'),
+        ('2019-12-06', '9.4929'),
+        ('2019-12-09', '9.5292'),
+        ('2019-12-10', '9.4971'),
+        ('2019-12-11', '9.4401'),
+        ('2019-12-12', '9.406'),
+        ('2019-12-13', '9.4002'),
+        ('2019-12-16', '9.3699'),
+        ('2019-12-17', '9.3754'),
+        ('2019-12-18', '9.4203'),
+        ('2019-12-19', '9.4211'),
+        ('2019-12-20', '9.4111'),
+        ('2019-12-23', '9.4295'),
+        ('2019-12-24', '9.4133'),
+        ('2019-12-25', NULL),
+        ('2019-12-26', '9.4108'),
+        ('2019-12-27', '9.3405'),
+        ('2019-12-30', '9.3145'),
+        ('2019-12-31', '9.3425'),
+        ('2020-01-01', NULL),
+        ('2020-01-02', '9.3689'),
+        ('2020-01-03', '9.3844'),
+        ('2020-01-06', '9.4132'),
+        ('2020-01-07', '9.4504'),
+        ('2020-01-08', '9.4514'),
+        ('2020-01-09', '9.4877'),
+        ('2020-01-10', '9.5037'),
+        ('2020-01-13', '9.4679'),
+        ('2020-01-14', '9.4548'),
+        ('2020-01-15', '9.4441'),
+        ('2020-01-16', '9.4876'),
+        ('2020-01-17', '9.5141'),
+        ('2020-01-20', NULL),
+        ('2020-01-21', '9.5099'),
+        ('2020-01-22', '9.5151'),
+        ('2020-01-23', '9.5506'),
+        ('2020-01-24', '9.565'),
+        ('2020-01-27', '9.6207'),
+        ('2020-01-28', '9.6213'),
+        ('2020-01-29', '9.6224'),
+        ('2020-01-30', '9.6387'),
+        ('2020-01-31', '9.6353'),
+        ('2020-02-03', '9.6541'),
+        ('2020-02-04', '9.5939'),
+        ('2020-02-05', '9.5951'),
+        ('2020-02-06', '9.6282'),
+        ('2020-02-07', '9.6493'),
+        ('2020-02-10', '9.6648'),
+        ('2020-02-11', '9.6335'),
+        ('2020-02-12', '9.6235'),
+        ('2020-02-13', '9.6758'),
+        ('2020-02-14', '9.7176'),
+        ('2020-02-17', NULL),
+        ('2020-02-18', '9.7715'),
+        ('2020-02-19', '9.8041'),
+        ('2020-02-20', '9.8168'),
+        ('2020-02-21', '9.7269'),
+        ('2020-02-24', '9.7277'),
+        ('2020-02-25', '9.7283'),
+        ('2020-02-26', '9.7263'),
+        ('2020-02-27', '9.6568'),
+        ('2020-02-28', '9.6802'),
+        ('2020-03-02', '9.4936'),
+        ('2020-03-03', '9.4301'),
+        ('2020-03-04', '9.5'),
+        ('2020-03-05', '9.4508'),
+        ('2020-03-06', '9.3651'),
+        ('2020-03-09', '9.4147'),
+        ('2020-03-10', '9.5002'),
+        ('2020-03-11', '9.4951'),
+        ('2020-03-12', '9.8319'),
+        ('2020-03-13', '9.8113'),
+        ('2020-03-16', '9.8405'),
+        ('2020-03-17', '9.9453'),
+        ('2020-03-18', '10.2426'),
+        ('2020-03-19', '10.3827'),
+        ('2020-03-20', '10.3349'),
+        ('2020-03-23', '10.3085'),
+        ('2020-03-24', '10.1627'),
+        ('2020-03-25', '10.1783'),
+        ('2020-03-26', '9.9501'),
+        ('2020-03-27', '9.9481'),
+        ('2020-03-30', '10.0596'),
+        ('2020-03-31', '9.9145'),
+        ('2020-04-01', '10.0468'),
+        ('2020-04-02', '10.1075'),
+        ('2020-04-03', '10.1865'),
+        ('2020-04-06', '10.1237'),
+        ('2020-04-07', '10.0318'),
+        ('2020-04-08', '10.057'),
+        ('2020-04-09', '9.9551'),
+        ('2020-04-10', '9.9204'),
+        ('2020-04-13', '9.9931'),
+        ('2020-04-14', '9.9606'),
+        ('2020-04-15', '10.0391'),
+        ('2020-04-16', '10.0384'),
+        ('2020-04-17', '9.9801'),
+        ('2020-04-20', '10.0102'),
+        ('2020-04-21', '10.105'),
+        ('2020-04-22', '10.0949'),
+        ('2020-04-23', '10.0467'),
+        ('2020-04-24', '10.0732'),
+        ('2020-04-27', '10.0473'),
+        ('2020-04-28', '9.8868'),
+        ('2020-04-29', '9.8824'),
+        ('2020-04-30', '9.7751'),
+        ('2020-05-01', '9.8316'),
+        ('2020-05-04', '9.8856'),
+        ('2020-05-05', '9.8296'),
+        ('2020-05-06', '9.8486'),
+        ('2020-05-07', '9.8128'),
+        ('2020-05-08', '9.759'),
+        ('2020-05-11', '9.8649'),
+        ('2020-05-12', '9.7394'),
+        ('2020-05-13', '9.7939'),
+        ('2020-05-14', '9.8438'),
+        ('2020-05-15', '9.866'),
+        ('2020-05-18', '9.7192'),
+        ('2020-05-19', '9.6594'),
+        ('2020-05-20', '9.5831'),
+        ('2020-05-21', '9.6318'),
+        ('2020-05-22', '9.6838'),
+        ('2020-05-25', NULL),
+        ('2020-05-26', '9.6426'),
+        ('2020-05-27', '9.6477'),
+        ('2020-05-28', '9.504'),
+        ('2020-05-29', '9.4248'),
+        ('2020-06-01', '9.386'),
+        ('2020-06-02', '9.3538'),
+        ('2020-06-03', '9.26'),
+        ('2020-06-04', '9.196'),
+        ('2020-06-05', '9.1806'),
+        ('2020-06-08', '9.2138'),
+        ('2020-06-09', '9.1774'),
+        ('2020-06-10', '9.2273'),
+        ('2020-06-11', '9.2524'),
+        ('2020-06-12', '9.3322'),
+        ('2020-06-15', '9.3404'),
+        ('2020-06-16', '9.3804'),
+        ('2020-06-17', '9.3589'),
+        ('2020-06-18', '9.4211'),
+        ('2020-06-19', '9.4542'),
+        ('2020-06-22', '9.3703'),
+        ('2020-06-23', '9.2806'),
+        ('2020-06-24', '9.3315'),
+        ('2020-06-25', '9.3234'),
+        ('2020-06-26', '9.3582'),
+        ('2020-06-29', '9.3254'),
+        ('2020-06-30', '9.3071'),
+        ('2020-07-01', '9.29'),
+        ('2020-07-02', '9.3226'),
+        ('2020-07-03', NULL),
+        ('2020-07-06', '9.2691'),
+        ('2020-07-07', '9.2255'),
+        ('2020-07-08', '9.1916'),
+        ('2020-07-09', '9.2164'),
+        ('2020-07-10', '9.1764'),
+        ('2020-07-13', '9.1381'),
+        ('2020-07-14', '9.0986'),
+        ('2020-07-15', '9.0732'),
+        ('2020-07-16', '9.0348'),
+        ('2020-07-17', '9.0241'),
+        ('2020-07-20', '8.9752'),
+        ('2020-07-21', '8.9005'),
+        ('2020-07-22', '8.8498'),
+        ('2020-07-23', '8.8326'),
+        ('2020-07-24', '8.8533'),
+        ('2020-07-27', '8.7379'),
+        ('2020-07-28', '8.7536'),
+        ('2020-07-29', '8.7534'),
+        ('2020-07-30', '8.7473'),
+        ('2020-07-31', '8.7487'),
+        ('2020-08-03', '8.7591'),
+        ('2020-08-04', '8.7452'),
+        ('2020-08-05', '8.6532'),
+        ('2020-08-06', '8.6973'),
+        ('2020-08-07', '8.7518'),
+        ('2020-08-10', '8.7419'),
+        ('2020-08-11', '8.7392'),
+        ('2020-08-12', '8.6888'),
+        ('2020-08-13', '8.6839'),
+        ('2020-08-14', '8.6984'),
+        ('2020-08-17', '8.6918'),
+        ('2020-08-18', '8.6481'),
+        ('2020-08-19', '8.6574'),
+        ('2020-08-20', '8.706'),
+        ('2020-08-21', '8.7988'),
+        ('2020-08-24', '8.7819'),
+        ('2020-08-25', '8.7721'),
+        ('2020-08-26', '8.7389'),
+        ('2020-08-27', '8.7099'),
+        ('2020-08-28', '8.627'),
+        ('2020-08-31', '8.6249'),
+        ('2020-09-01', '8.6717'),
+        ('2020-09-02', '8.731'),
+        ('2020-09-03', '8.7494'),
+        ('2020-09-04', '8.7642'),
+        ('2020-09-07', NULL),
+        ('2020-09-08', '8.8122'),
+        ('2020-09-09', '8.7628'),
+        ('2020-09-10', '8.7364'),
+        ('2020-09-11', '8.7848'),
+        ('2020-09-14', '8.7657'),
+        ('2020-09-15', '8.7882'),
+        ('2020-09-16', '8.7894'),
+        ('2020-09-17', '8.807'),
+        ('2020-09-18', '8.7539'),
+        ('2020-09-21', '8.8829'),
+        ('2020-09-22', '8.9081'),
+        ('2020-09-23', '8.981'),
+        ('2020-09-24', '9.1146'),
+        ('2020-09-25', '9.1347'),
+        ('2020-09-28', '9.0615'),
+        ('2020-09-29', '9.0009'),
+        ('2020-09-30', '8.9627'),
+        ('2020-10-01', '8.9197'),
+        ('2020-10-02', '8.9317'),
+        ('2020-10-05', '8.8936'),
+        ('2020-10-06', '8.9171'),
+        ('2020-10-07', '8.8934'),
+        ('2020-10-08', '8.872'),
+        ('2020-10-09', '8.7918'),
+        ('2020-10-12', NULL),
+        ('2020-10-13', '8.8146'),
+        ('2020-10-14', '8.823'),
+        ('2020-10-15', '8.8685'),
+        ('2020-10-16', '8.837'),
+        ('2020-10-19', '8.8054'),
+        ('2020-10-20', '8.747'),
+        ('2020-10-21', '8.7195'),
+        ('2020-10-22', '8.7794'),
+        ('2020-10-23', '8.7688'),
+        ('2020-10-26', '8.7517'),
+        ('2020-10-27', '8.7022'),
+        ('2020-10-28', '8.8458'),
+        ('2020-10-29', '8.9427'),
+        ('2020-10-30', '8.9009'),
+        ('2020-11-02', '8.9179'),
+        ('2020-11-03', '8.8377'),
+        ('2020-11-04', '8.7718'),
+        ('2020-11-05', '8.7167'),
+        ('2020-11-06', '8.6339'),
+        ('2020-11-09', '8.6335'),
+        ('2020-11-10', '8.6152'),
+        ('2020-11-11', NULL),
+        ('2020-11-12', '8.6229'),
+        ('2020-11-13', '8.6947'),
+        ('2020-11-16', '8.6326'),
+        ('2020-11-17', '8.6281'),
+        ('2020-11-18', '8.5813'),
+        ('2020-11-19', '8.6288'),
+        ('2020-11-20', '8.6171'),
+        ('2020-11-23', '8.6496'),
+        ('2020-11-24', '8.5678'),
+        ('2020-11-25', '8.5169'),
+        ('2020-11-26', NULL),
+        ('2020-11-27', NULL),
+        ('2020-11-30', '8.5576'),
+        ('2020-12-01', '8.5082'),
+        ('2020-12-02', '8.4986'),
+        ('2020-12-03', '8.4415'),
+        ('2020-12-04', '8.4494'),
+        ('2020-12-07', '8.3864'),
+        ('2020-12-08', '8.472'),
+        ('2020-12-09', '8.4914'),
+        ('2020-12-10', '8.4499'),
+        ('2020-12-11', '8.4717'),
+        ('2020-12-14', '8.4133'),
+        ('2020-12-15', '8.3759'),
+        ('2020-12-16', '8.3645'),
+        ('2020-12-17', '8.2689'),
+        ('2020-12-18', '8.2786'),
+        ('2020-12-21', '8.2576'),
+        ('2020-12-22', '8.3303'),
+        ('2020-12-23', '8.2841'),
+        ('2020-12-24', NULL),
+        ('2020-12-25', NULL),
+        ('2020-12-28', '8.2854'),
+        ('2020-12-29', '8.1906'),
+        ('2020-12-30', '8.1972'),
+        ('2020-12-31', '8.2095'),
+        ('2021-01-01', NULL),
+        ('2021-01-04', '8.2486'),
+        ('2021-01-05', '8.1856'),
+        ('2021-01-06', '8.1855'),
+        ('2021-01-07', '8.1992'),
+        ('2021-01-08', '8.2085'),
+        ('2021-01-11', '8.281'),
+        ('2021-01-12', '8.2864'),
+        ('2021-01-13', '8.3373'),
+        ('2021-01-14', '8.309'),
+        ('2021-01-15', '8.3715'),
+        ('2021-01-18', NULL),
+        ('2021-01-19', '8.348'),
+        ('2021-01-20', NULL),
+        ('2021-01-21', '8.2948'),
+        ('2021-01-22', '8.282'),
+        ('2021-01-25', '8.3046'),
+        ('2021-01-26', '8.3025'),
+        ('2021-01-27', '8.3511'),
+        ('2021-01-28', '8.3301'),
+        ('2021-01-29', '8.3357')
+)
+INSERT INTO
+    exchange_rates (source_currency_id, destination_currency_id, date, rate)
+SELECT
+    source.id,
+    destination.id,
+    CAST(rates.date AS DATE),
+    CAST(rates.rate AS NUMERIC)
+FROM
+    rates,
+    currencies_codes source,
+    currencies_codes destination
+WHERE
+    source.code = 'SEK'
+    AND destination.code = 'USD'
+ON CONFLICT DO NOTHING;
+
+-- THBUSD.csv
+WITH rates (date, rate) AS (
+    VALUES
+        ('2016-01-29', '35.6700'),
+        ('2016-02-01', '35.5800'),
+        ('2016-02-02', '35.7500'),
+        ('2016-02-03', '35.7700'),
+        ('2016-02-04', '35.5500'),
+        ('2016-02-05', '35.5600'),
+        ('2016-02-08', '35.5100'),
+        ('2016-02-09', '35.3900'),
+        ('2016-02-10', '35.3100'),
+        ('2016-02-11', '35.2200'),
+        ('2016-02-12', '35.5900'),
+        ('2016-02-15', NULL),
+        ('2016-02-16', '35.6500'),
+        ('2016-02-17', '35.6000'),
+        ('2016-02-18', '35.5800'),
+        ('2016-02-19', '35.7300'),
+        ('2016-02-22', '35.7300'),
+        ('2016-02-23', '35.7100'),
+        ('2016-02-24', '35.6800'),
+        ('2016-02-25', '35.6600'),
+        ('2016-02-26', '35.7300'),
+        ('2016-02-29', '35.6100'),
+        ('2016-03-01', '35.5900'),
+        ('2016-03-02', '35.5400'),
+        ('2016-03-03', '35.4300'),
+        ('2016-03-04', '35.3400'),
+        ('2016-03-07', '35.4100'),
+        ('2016-03-08', '35.3600'),
+        ('2016-03-09', '35.2600'),
+        ('2016-03-10', '35.2200'),
+        ('2016-03-11', '35.0500'),
+        ('2016-03-14', '35.1000'),
+        ('2016-03-15', '35.1100'),
+        ('2016-03-16', '35.0500'),
+        ('2016-03-17', '34.7600'),
+        ('2016-03-18', '34.8800'),
+        ('2016-03-21', '34.9000'),
+        ('2016-03-22', '34.9500'),
+        ('2016-03-23', '35.2600'),
+        ('2016-03-24', '35.3200'),
+        ('2016-03-25', '35.2800'),
+        ('2016-03-28', '35.3400'),
+        ('2016-03-29', '35.3900'),
+        ('2016-03-30', '35.2400'),
+        ('2016-03-31', '35.0900'),
+        ('2016-04-01', '35.1800'),
+        ('2016-04-04', '35.2300'),
+        ('2016-04-05', '35.2800'),
+        ('2016-04-06', '35.2200'),
+        ('2016-04-07', '35.1800'),
+        ('2016-04-08', '35.1000'),
+        ('2016-04-11', '35.0400'),
+        ('2016-04-12', '34.9700'),
+        ('2016-04-13', '35.0300'),
+        ('2016-04-14', '35.0900'),
+        ('2016-04-15', '35.0100'),
+        ('2016-04-18', '34.9800'),
+        ('2016-04-19', '34.8300'),
+        ('2016-04-20', '34.8400'),
+        ('2016-04-21', '35.0600'),
+        ('2016-04-22', '35.0600'),
+        ('2016-04-25', '35.0700'),
+        ('2016-04-26', '35.1200'),
+        ('2016-04-27', '35.1200'),
+        ('2016-04-28', '35.0000'),
+        ('2016-04-29', '34.9100'),
+        ('2016-05-02', '34.8400'),
+        ('2016-05-03', '34.9400'),
+        ('2016-05-04', '35.1100'),
+        ('2016-05-05', '35.1700'),
+        ('2016-05-06', '35.1300'),
+        ('2016-05-09', '35.2000'),
+        ('2016-05-10', '35.2100'),
+        ('2016-05-11', '35.2100'),
+        ('2016-05-12', '35.3200'),
+        ('2016-05-13', '35.4700'),
+        ('2016-05-16', '35.3900'),
+        ('2016-05-17', '35.5000'),
+        ('2016-05-18', '35.6000'),
+        ('2016-05-19', '35.7200'),
+        ('2016-05-20', '35.7100'),
+        ('2016-05-23', '35.6800'),
+        ('2016-05-24', '35.7300'),
+        ('2016-05-25', '35.6800'),
+        ('2016-05-26', '35.5900'),
+        ('2016-05-27', '35.6800'),
+        ('2016-05-30', NULL),
+        ('2016-05-31', '35.6800'),
+        ('2016-06-01', '35.7000'),
+        ('2016-06-02', '35.6200'),
+        ('2016-06-03', '35.3400'),
+        ('2016-06-06', '35.2700'),
+        ('2016-06-07', '35.2100'),
+        ('2016-06-08', '35.1400'),
+        ('2016-06-09', '35.1400'),
+        ('2016-06-10', '35.1800'),
+        ('2016-06-13', '35.1700'),
+        ('2016-06-14', '35.3000'),
+        ('2016-06-15', '35.2700'),
+        ('2016-06-16', '35.3200'),
+        ('2016-06-17', '35.2300'),
+        ('2016-06-20', '35.1900'),
+        ('2016-06-21', '35.2500'),
+        ('2016-06-22', '35.1600'),
+        ('2016-06-23', '35.1500'),
+        ('2016-06-24', '35.3200'),
+        ('2016-06-27', '35.3400'),
+        ('2016-06-28', '35.2600'),
+        ('2016-06-29', '35.1600'),
+        ('2016-06-30', '35.1500'),
+        ('2016-07-01', '35.0300'),
+        ('2016-07-04', NULL),
+        ('2016-07-05', '35.1600'),
+        ('2016-07-06', '35.2200'),
+        ('2016-07-07', '35.2100'),
+        ('2016-07-08', '35.2000'),
+        ('2016-07-11', '35.1500'),
+        ('2016-07-12', '35.2200'),
+        ('2016-07-13', '35.2000'),
+        ('2016-07-14', '35.0100'),
+        ('2016-07-15', '34.9400'),
+        ('2016-07-18', '34.9400'),
+        ('2016-07-19', '35.0200'),
+        ('2016-07-20', '35.0400'),
+        ('2016-07-21', '34.9600'),
+        ('2016-07-22', '34.9400'),
+        ('2016-07-25', '35.0300'),
+        ('2016-07-26', '34.9800'),
+        ('2016-07-27', '34.9400'),
+        ('2016-07-28', '34.8500'),
+        ('2016-07-29', '34.7800'),
+        ('2016-08-01', '34.7500'),
+        ('2016-08-02', '34.7600'),
+        ('2016-08-03', '34.9600'),
+        ('2016-08-04', '34.8900'),
+        ('2016-08-05', '35.0400'),
+        ('2016-08-08', '35.0100'),
+        ('2016-08-09', '34.8900'),
+        ('2016-08-10', '34.7600'),
+        ('2016-08-11', '34.7000'),
+        ('2016-08-12', '34.7400'),
+        ('2016-08-15', '34.6000'),
+        ('2016-08-16', '34.6200'),
+        ('2016-08-17', '34.6900'),
+        ('2016-08-18', '34.5500'),
+        ('2016-08-19', '34.6400'),
+        ('2016-08-22', '34.6600'),
+        ('2016-08-23', '34.5800'),
+        ('2016-08-24', '34.6400'),
+        ('2016-08-25', '34.5400'),
+        ('2016-08-26', '34.6100'),
+        ('2016-08-29', '34.5500'),
+        ('2016-08-30', '34.5800'),
+        ('2016-08-31', '34.6200'),
+        ('2016-09-01', '34.5900'),
+        ('2016-09-02', '34.5800'),
+        ('2016-09-05', NULL),
+        ('2016-09-06', '34.5900'),
+        ('2016-09-07', '34.6300'),
+        ('2016-09-08', '34.7700'),
+        ('2016-09-09', '34.8300'),
+        ('2016-09-12', '34.8800'),
+        ('2016-09-13', '34.9300'),
+        ('2016-09-14', '34.8700'),
+        ('2016-09-15', '34.9000'),
+        ('2016-09-16', '34.9200'),
+        ('2016-09-19', '34.7700'),
+        ('2016-09-20', '34.7600'),
+        ('2016-09-21', '34.7000'),
+        ('2016-09-22', '34.5900'),
+        ('2016-09-23', '34.6300'),
+        ('2016-09-26', '34.6100'),
+        ('2016-09-27', '34.5900'),
+        ('2016-09-28', '34.6000'),
+        ('2016-09-29', '34.6400'),
+        ('2016-09-30', '34.6000'),
+        ('2016-10-03', '34.6500'),
+        ('2016-10-04', '34.7500'),
+        ('2016-10-05', '34.8400'),
+        ('2016-10-06', '34.8600'),
+        ('2016-10-07', '34.9200'),
+        ('2016-10-10', NULL),
+        ('2016-10-11', '35.4300'),
+        ('2016-10-12', '35.6200'),
+        ('2016-10-13', '35.3900'),
+        ('2016-10-14', '35.3100'),
+        ('2016-10-17', '35.2600'),
+        ('2016-10-18', '35.0200'),
+        ('2016-10-19', '34.8700'),
+        ('2016-10-20', '34.9600'),
+        ('2016-10-21', '35.1400'),
+        ('2016-10-24', '35.0400'),
+        ('2016-10-25', '34.9000'),
+        ('2016-10-26', '34.9900'),
+        ('2016-10-27', '35.0900'),
+        ('2016-10-28', '35.0600'),
+        ('2016-10-31', '34.9900'),
+        ('2016-11-01', '35.0100'),
+        ('2016-11-02', '34.9500'),
+        ('2016-11-03', '34.9500'),
+        ('2016-11-04', '35.0100'),
+        ('2016-11-07', '35.0100'),
+        ('2016-11-08', '34.9600'),
+        ('2016-11-09', '34.9400'),
+        ('2016-11-10', '35.1900'),
+        ('2016-11-11', NULL),
+        ('2016-11-14', '35.5000'),
+        ('2016-11-15', '35.4400'),
+        ('2016-11-16', '35.4700'),
+        ('2016-11-17', '35.4500'),
+        ('2016-11-18', '35.5400'),
+        ('2016-11-21', '35.5200'),
+        ('2016-11-22', '35.4900'),
+        ('2016-11-23', '35.6900'),
+        ('2016-11-24', NULL),
+        ('2016-11-25', '35.6100'),
+        ('2016-11-28', '35.6000'),
+        ('2016-11-29', '35.6600'),
+        ('2016-11-30', '35.7000'),
+        ('2016-12-01', '35.6700'),
+        ('2016-12-02', '35.6100'),
+        ('2016-12-05', '35.6500'),
+        ('2016-12-06', '35.6100'),
+        ('2016-12-07', '35.6100'),
+        ('2016-12-08', '35.6300'),
+        ('2016-12-09', '35.6800'),
+        ('2016-12-12', '35.5900'),
+        ('2016-12-13', '35.5800'),
+        ('2016-12-14', '35.5700'),
+        ('2016-12-15', '35.8000'),
+        ('2016-12-16', '35.8100'),
+        ('2016-12-19', '35.8500'),
+        ('2016-12-20', '36.0000'),
+        ('2016-12-21', '36.0000'),
+        ('2016-12-22', '36.0100'),
+        ('2016-12-23', '35.9600'),
+        ('2016-12-26', NULL),
+        ('2016-12-27', '36.0000'),
+        ('2016-12-28', '36.0000'),
+        ('2016-12-29', '35.8900'),
+        ('2016-12-30', '35.8100'),
+        ('2017-01-02', NULL),
+        ('2017-01-03', '35.8900'),
+        ('2017-01-04', '35.8200'),
+        ('2017-01-05', '35.6700'),
+        ('2017-01-06', '35.7200'),
+        ('2017-01-09', '35.6400'),
+        ('2017-01-10', '35.5300'),
+        ('2017-01-11', '35.5500'),
+        ('2017-01-12', '35.2900'),
+        ('2017-01-13', '35.4200'),
+        ('2017-01-16', NULL),
+        ('2017-01-17', '35.3000'),
+        ('2017-01-18', '35.3000'),
+        ('2017-01-19', '35.4200'),
+        ('2017-01-20', NULL),
+        ('2017-01-23', '35.2600'),
+        ('2017-01-24', '35.2200'),
+        ('2017-01-25', '35.2200'),
+        ('2017-01-26', '35.3200'),
+        ('2017-01-27', '35.2800'),
+        ('2017-01-30', '35.2100'),
+        ('2017-01-31', '35.0900'),
+        ('2017-02-01', '35.1200'),
+        ('2017-02-02', '35.0500'),
+        ('2017-02-03', '35.0100'),
+        ('2017-02-06', '35.0100'),
+        ('2017-02-07', '35.0200'),
+        ('2017-02-08', '34.9900'),
+        ('2017-02-09', '35.0600'),
+        ('2017-02-10', '35.0600'),
+        ('2017-02-13', '35.0900'),
+        ('2017-02-14', '35.0500'),
+        ('2017-02-15', '35.0100'),
+        ('2017-02-16', '34.9800'),
+        ('2017-02-17', '34.9800'),
+        ('2017-02-20', NULL),
+        ('2017-02-21', '35.0300'),
+        ('2017-02-22', '35.0000'),
+        ('2017-02-23', '34.9600'),
+        ('2017-02-24', '34.8500'),
+        ('2017-02-27', '34.8200'),
+        ('2017-02-28', '34.8900'),
+        ('2017-03-01', '34.9600'),
+        ('2017-03-02', '35.0300'),
+        ('2017-03-03', '35.0700'),
+        ('2017-03-06', '35.0600'),
+        ('2017-03-07', '35.0900'),
+        ('2017-03-08', '35.3100'),
+        ('2017-03-09', '35.3700'),
+        ('2017-03-10', '35.3500'),
+        ('2017-03-13', '35.3500'),
+        ('2017-03-14', '35.2900'),
+        ('2017-03-15', '35.2500'),
+        ('2017-03-16', '35.2900'),
+        ('2017-03-17', '34.8600'),
+        ('2017-03-20', '34.6100'),
+        ('2017-03-21', '34.6600'),
+        ('2017-03-22', '34.6000'),
+        ('2017-03-23', '34.5800'),
+        ('2017-03-24', '34.5500'),
+        ('2017-03-27', '34.3500'),
+        ('2017-03-28', '34.3600'),
+        ('2017-03-29', '34.4200'),
+        ('2017-03-30', '34.4000'),
+        ('2017-03-31', '34.3200'),
+        ('2017-04-03', '34.3500'),
+        ('2017-04-04', '34.4500'),
+        ('2017-04-05', '34.5500'),
+        ('2017-04-06', '34.6400'),
+        ('2017-04-07', '34.5700'),
+        ('2017-04-10', '34.6100'),
+        ('2017-04-11', '34.5500'),
+        ('2017-04-12', '34.4500'),
+        ('2017-04-13', '34.3100'),
+        ('2017-04-14', '34.3400'),
+        ('2017-04-17', '34.2500'),
+        ('2017-04-18', '34.3400'),
+        ('2017-04-19', '34.3900'),
+        ('2017-04-20', '34.3700'),
+        ('2017-04-21', '34.3700'),
+        ('2017-04-24', '34.3700'),
+        ('2017-04-25', '34.4000'),
+        ('2017-04-26', '34.4800'),
+        ('2017-04-27', '34.6200'),
+        ('2017-04-28', '34.5800'),
+        ('2017-05-01', '34.5800'),
+        ('2017-05-02', '34.4700'),
+        ('2017-05-03', '34.5100'),
+        ('2017-05-04', '34.6300'),
+        ('2017-05-05', '34.6700'),
+        ('2017-05-08', '34.6200'),
+        ('2017-05-09', '34.7700'),
+        ('2017-05-10', '34.7300'),
+        ('2017-05-11', '34.7200'),
+        ('2017-05-12', '34.6500'),
+        ('2017-05-15', '34.5300'),
+        ('2017-05-16', '34.4700'),
+        ('2017-05-17', '34.4700'),
+        ('2017-05-18', '34.5000'),
+        ('2017-05-19', '34.3300'),
+        ('2017-05-22', '34.3200'),
+        ('2017-05-23', '34.4200'),
+        ('2017-05-24', '34.3800'),
+        ('2017-05-25', '34.1100'),
+        ('2017-05-26', '34.0400'),
+        ('2017-05-29', NULL),
+        ('2017-05-30', '34.1100'),
+        ('2017-05-31', '34.0300'),
+        ('2017-06-01', '34.1600'),
+        ('2017-06-02', '34.1100'),
+        ('2017-06-05', '34.0500'),
+        ('2017-06-06', '33.9600'),
+        ('2017-06-07', '34.0200'),
+        ('2017-06-08', '34.0600'),
+        ('2017-06-09', '34.0500'),
+        ('2017-06-12', '34.0600'),
+        ('2017-06-13', '33.9200'),
+        ('2017-06-14', '33.8300'),
+        ('2017-06-15', '33.9500'),
+        ('2017-06-16', '33.9500'),
+        ('2017-06-19', '33.9400'),
+        ('2017-06-20', '34.0100'),
+        ('2017-06-21', '33.9900'),
+        ('2017-06-22', '33.9900'),
+        ('2017-06-23', '33.9500'),
+        ('2017-06-26', '33.9700'),
+        ('2017-06-27', '33.9400'),
+        ('2017-06-28', '33.9500'),
+        ('2017-06-29', '33.9800'),
+        ('2017-06-30', '33.9200'),
+        ('2017-07-03', '33.9900'),
+        ('2017-07-04', NULL),
+        ('2017-07-05', '34.0300'),
+        ('2017-07-06', '34.0700'),
+        ('2017-07-07', '34.0900'),
+        ('2017-07-10', '34.1300'),
+        ('2017-07-11', '34.1000'),
+        ('2017-07-12', '33.9400'),
+        ('2017-07-13', '33.9600'),
+        ('2017-07-14', '33.7600'),
+        ('2017-07-17', '33.6300'),
+        ('2017-07-18', '33.5700'),
+        ('2017-07-19', '33.6000'),
+        ('2017-07-20', '33.5700'),
+        ('2017-07-21', '33.4500'),
+        ('2017-07-24', '33.3900'),
+        ('2017-07-25', '33.4400'),
+        ('2017-07-26', '33.4800'),
+        ('2017-07-27', '33.3300'),
+        ('2017-07-28', '33.3700'),
+        ('2017-07-31', '33.2800'),
+        ('2017-08-01', '33.2800'),
+        ('2017-08-02', '33.2500'),
+        ('2017-08-03', '33.2500'),
+        ('2017-08-04', '33.2900'),
+        ('2017-08-07', '33.2900'),
+        ('2017-08-08', '33.2800'),
+        ('2017-08-09', '33.2300'),
+        ('2017-08-10', '33.2500'),
+        ('2017-08-11', '33.2200'),
+        ('2017-08-14', '33.2400'),
+        ('2017-08-15', '33.2800'),
+        ('2017-08-16', '33.2700'),
+        ('2017-08-17', '33.2400'),
+        ('2017-08-18', '33.2000'),
+        ('2017-08-21', '33.2400'),
+        ('2017-08-22', '33.2400'),
+        ('2017-08-23', '33.3400'),
+        ('2017-08-24', '33.3400'),
+        ('2017-08-25', '33.2500'),
+        ('2017-08-28', '33.1800'),
+        ('2017-08-29', '33.1700'),
+        ('2017-08-30', '33.1800'),
+        ('2017-08-31', '33.1700'),
+        ('2017-09-01', '33.1500'),
+        ('2017-09-04', NULL),
+        ('2017-09-05', '33.1500'),
+        ('2017-09-06', '33.1400'),
+        ('2017-09-07', '33.0700'),
+        ('2017-09-08', '33.1100'),
+        ('2017-09-11', '33.1100'),
+        ('2017-09-12', '33.1100'),
+        ('2017-09-13', '33.1200'),
+        ('2017-09-14', '33.0700'),
+        ('2017-09-15', '33.0700'),
+        ('2017-09-18', '33.1000'),
+        ('2017-09-19', '33.0600'),
+        ('2017-09-20', '33.0500'),
+        ('2017-09-21', '33.1000'),
+        ('2017-09-22', '33.0600'),
+        ('2017-09-25', '33.1000'),
+        ('2017-09-26', '33.2100'),
+        ('2017-09-27', '33.2900'),
+        ('2017-09-28', '33.4000'),
+        ('2017-09-29', '33.3000'),
+        ('2017-10-02', '33.4000'),
+        ('2017-10-03', '33.3900'),
+        ('2017-10-04', '33.3600'),
+        ('2017-10-05', '33.3800'),
+        ('2017-10-06', '33.4300'),
+        ('2017-10-09', NULL),
+        ('2017-10-10', '33.2200'),
+        ('2017-10-11', '33.1700'),
+        ('2017-10-12', '33.1000'),
+        ('2017-10-13', '33.0600'),
+        ('2017-10-16', '33.0400'),
+        ('2017-10-17', '33.1100'),
+        ('2017-10-18', '33.1400'),
+        ('2017-10-19', '33.1200'),
+        ('2017-10-20', '33.1900'),
+        ('2017-10-23', '33.1900'),
+        ('2017-10-24', '33.1700'),
+        ('2017-10-25', '33.1800'),
+        ('2017-10-26', '33.1900'),
+        ('2017-10-27', '33.2600'),
+        ('2017-10-30', '33.2600'),
+        ('2017-10-31', '33.2300'),
+        ('2017-11-01', '33.1100'),
+        ('2017-11-02', '33.0800'),
+        ('2017-11-03', '33.1700'),
+        ('2017-11-06', '33.1100'),
+        ('2017-11-07', '33.1200'),
+        ('2017-11-08', '33.1000'),
+        ('2017-11-09', '33.0900'),
+        ('2017-11-10', NULL),
+        ('2017-11-13', '33.0600'),
+        ('2017-11-14', '33.0500'),
+        ('2017-11-15', '33.0000'),
+        ('2017-11-16', '32.9100'),
+        ('2017-11-17', '32.8100'),
+        ('2017-11-20', '32.8100'),
+        ('2017-11-21', '32.7400'),
+        ('2017-11-22', '32.6900'),
+        ('2017-11-23', NULL),
+        ('2017-11-24', '32.6500'),
+        ('2017-11-27', '32.6100'),
+        ('2017-11-28', '32.5500'),
+        ('2017-11-29', '32.5700'),
+        ('2017-11-30', '32.5800'),
+        ('2017-12-01', '32.5900'),
+        ('2017-12-04', '32.5900'),
+        ('2017-12-05', '32.6100'),
+        ('2017-12-06', '32.6400'),
+        ('2017-12-07', '32.6000'),
+        ('2017-12-08', '32.6200'),
+        ('2017-12-11', '32.6200'),
+        ('2017-12-12', '32.6000'),
+        ('2017-12-13', '32.5300'),
+        ('2017-12-14', '32.4900'),
+        ('2017-12-15', '32.5000'),
+        ('2017-12-18', '32.6200'),
+        ('2017-12-19', '32.5000'),
+        ('2017-12-20', '32.7000'),
+        ('2017-12-21', '32.7200'),
+        ('2017-12-22', '32.7300'),
+        ('2017-12-25', NULL),
+        ('2017-12-26', '32.7500'),
+        ('2017-12-27', '32.7700'),
+        ('2017-12-28', '32.5900'),
+        ('2017-12-29', '32.5600'),
+        ('2018-01-01', NULL),
+        ('2018-01-02', '32.4400'),
+        ('2018-01-03', '32.2600'),
+        ('2018-01-04', '32.2100'),
+        ('2018-01-05', '32.1900'),
+        ('2018-01-08', '32.2100'),
+        ('2018-01-09', '32.2600'),
+        ('2018-01-10', '32.0900'),
+        ('2018-01-11', '31.9700'),
+        ('2018-01-12', '31.9500'),
+        ('2018-01-15', NULL),
+        ('2018-01-16', '31.9500'),
+        ('2018-01-17', '31.9500'),
+        ('2018-01-18', '31.9100'),
+        ('2018-01-19', '31.8400'),
+        ('2018-01-22', '31.8400'),
+        ('2018-01-23', '31.7600'),
+        ('2018-01-24', '31.5400'),
+        ('2018-01-25', '31.3300'),
+        ('2018-01-26', '31.3600'),
+        ('2018-01-29', '31.4500'),
+        ('2018-01-30', '31.4300'),
+        ('2018-01-31', '31.3300'),
+        ('2018-02-01', '31.3100'),
+        ('2018-02-02', '31.4300'),
+        ('2018-02-05', '31.5200'),
+        ('2018-02-06', '31.5900'),
+        ('2018-02-07', '31.6100'),
+        ('2018-02-08', '31.8400'),
+        ('2018-02-09', '31.7900'),
+        ('2018-02-12', '31.6700'),
+        ('2018-02-13', '31.4800'),
+        ('2018-02-14', '31.3700'),
+        ('2018-02-15', '31.2700'),
+        ('2018-02-16', '31.2900'),
+        ('2018-02-19', NULL),
+        ('2018-02-20', '31.5000'),
+        ('2018-02-21', '31.5000'),
+        ('2018-02-22', '31.4800'),
+        ('2018-02-23', '31.4200'),
+        ('2018-02-26', '31.31'),
+        ('2018-02-27', '31.47'),
+        ('2018-02-28', '31.46'),
+        ('2018-03-01', '31.54'),
+        ('2018-03-02', '31.45'),
+        ('2018-03-05', '31.4'),
+        ('2018-03-06', '31.27'),
+        ('2018-03-07', '31.33'),
+        ('2018-03-08', '31.37'),
+        ('2018-03-09', '31.31'),
+        ('2018-03-12', '31.3'),
+        ('2018-03-13', '31.2'),
+        ('2018-03-14', '31.12'),
+        ('2018-03-15', '31.18'),
+        ('2018-03-16', '31.18'),
+        ('2018-03-19', '31.18'),
+        ('2018-03-20', '31.19'),
+        ('2018-03-21', '31.18'),
+        ('2018-03-22', '31.28'),
+        ('2018-03-23', '31.17'),
+        ('2018-03-26', '31.12'),
+        ('2018-03-27', '31.18'),
+        ('2018-03-28', '31.26'),
+        ('2018-03-29', '31.24'),
+        ('2018-03-30', '31.19'),
+        ('2018-04-02', '31.21'),
+        ('2018-04-03', '31.21'),
+        ('2018-04-04', '31.23'),
+        ('2018-04-05', '31.26'),
+        ('2018-04-06', '31.28'),
+        ('2018-04-09', '31.26'),
+        ('2018-04-10', '31.18'),
+        ('2018-04-11', '31.11'),
+        ('2018-04-12', '31.19'),
+        ('2018-04-13', '31.16'),
+        ('2018-04-16', '31.19'),
+        ('2018-04-17', '31.19'),
+        ('2018-04-18', '31.19'),
+        ('2018-04-19', '31.23'),
+        ('2018-04-20', '31.32'),
+        ('2018-04-23', '31.54'),
+        ('2018-04-24', '31.45'),
+        ('2018-04-25', '31.57'),
+        ('2018-04-26', '31.62'),
+        ('2018-04-27', '31.52'),
+        ('2018-04-30', '31.57'),
+        ('2018-05-01', '31.67'),
+        ('2018-05-02', '31.74'),
+        ('2018-05-03', '31.67'),
+        ('2018-05-04', '31.72'),
+        ('2018-05-07', '31.84'),
+        ('2018-05-08', '31.95'),
+        ('2018-05-09', '32.07'),
+        ('2018-05-10', '31.98'),
+        ('2018-05-11', '31.88'),
+        ('2018-05-14', '31.83'),
+        ('2018-05-15', '32.12'),
+        ('2018-05-16', '32.06'),
+        ('2018-05-17', '32.11'),
+        ('2018-05-18', '32.18'),
+        ('2018-05-21', '32.17'),
+        ('2018-05-22', '31.98'),
+        ('2018-05-23', '32.15'),
+        ('2018-05-24', '32.01'),
+        ('2018-05-25', '31.9'),
+        ('2018-05-28', NULL),
+        ('2018-05-29', '32.1'),
+        ('2018-05-30', '32.02'),
+        ('2018-05-31', '32.06'),
+        ('2018-06-01', '32.02'),
+        ('2018-06-04', '31.99'),
+        ('2018-06-05', '31.96'),
+        ('2018-06-06', '31.89'),
+        ('2018-06-07', '31.99'),
+        ('2018-06-08', '32.05'),
+        ('2018-06-11', '32.04'),
+        ('2018-06-12', '32.07'),
+        ('2018-06-13', '32.12'),
+        ('2018-06-14', '32.17'),
+        ('2018-06-15', '32.66'),
+        ('2018-06-18', '32.71'),
+        ('2018-06-19', '32.76'),
+        ('2018-06-20', '32.83'),
+        ('2018-06-21', '32.88'),
+        ('2018-06-22', '32.93'),
+        ('2018-06-25', '32.94'),
+        ('2018-06-26', '33.0'),
+        ('2018-06-27', '33.03'),
+        ('2018-06-28', '33.14'),
+        ('2018-06-29', '33.04'),
+        ('2018-07-02', '33.17'),
+        ('2018-07-03', '33.15'),
+        ('2018-07-04', NULL),
+        ('2018-07-05', '33.19'),
+        ('2018-07-06', '33.15'),
+        ('2018-07-09', '33.09'),
+        ('2018-07-10', '33.14'),
+        ('2018-07-11', '33.29'),
+        ('2018-07-12', '33.18'),
+        ('2018-07-13', '33.33'),
+        ('2018-07-16', '33.26'),
+        ('2018-07-17', '33.31'),
+        ('2018-07-18', '33.3'),
+        ('2018-07-19', '33.44'),
+        ('2018-07-20', '33.36'),
+        ('2018-07-23', '33.43'),
+        ('2018-07-24', '33.36'),
+        ('2018-07-25', '33.26'),
+        ('2018-07-26', '33.41'),
+        ('2018-07-27', '33.36'),
+        ('2018-07-30', '33.28'),
+        ('2018-07-31', '33.17'),
+        ('2018-08-01', '33.13'),
+        ('2018-08-02', '33.27'),
+        ('2018-08-03', '33.24'),
+        ('2018-08-06', '33.34'),
+        ('2018-08-07', '33.21'),
+        ('2018-08-08', '33.21'),
+        ('2018-08-09', '33.17'),
+        ('2018-08-10', '33.3'),
+        ('2018-08-13', '33.37'),
+        ('2018-08-14', '33.29'),
+        ('2018-08-15', '33.34'),
+        ('2018-08-16', '33.15'),
+        ('2018-08-17', '33.21'),
+        ('2018-08-20', '32.96'),
+        ('2018-08-21', '32.66'),
+        ('2018-08-22', '32.66'),
+        ('2018-08-23', '32.82'),
+        ('2018-08-24', '32.62'),
+        ('2018-08-27', '32.53'),
+        ('2018-08-28', '32.54'),
+        ('2018-08-29', '32.72'),
+        ('2018-08-30', '32.77'),
+        ('2018-08-31', '32.79'),
+        ('2018-09-03', NULL),
+        ('2018-09-04', '32.81'),
+        ('2018-09-05', '32.82'),
+        ('2018-09-06', '32.78'),
+        ('2018-09-07', '32.8'),
+        ('2018-09-10', '32.8'),
+        ('2018-09-11', '32.8'),
+        ('2018-09-12', '32.7'),
+        ('2018-09-13', '32.62'),
+        ('2018-09-14', '32.64'),
+        ('2018-09-17', '32.55'),
+        ('2018-09-18', '32.55'),
+        ('2018-09-19', '32.38'),
+        ('2018-09-20', '32.37'),
+        ('2018-09-21', '32.44'),
+        ('2018-09-24', '32.39'),
+        ('2018-09-25', '32.4'),
+        ('2018-09-26', '32.45'),
+        ('2018-09-27', '32.39'),
+        ('2018-09-28', '32.3'),
+        ('2018-10-01', '32.28'),
+        ('2018-10-02', '32.34'),
+        ('2018-10-03', '32.43'),
+        ('2018-10-04', '32.67'),
+        ('2018-10-05', '32.83'),
+        ('2018-10-08', NULL),
+        ('2018-10-09', '33.01'),
+        ('2018-10-10', '32.93'),
+        ('2018-10-11', '32.68'),
+        ('2018-10-12', '32.76'),
+        ('2018-10-15', '32.62'),
+        ('2018-10-16', '32.47'),
+        ('2018-10-17', '32.45'),
+        ('2018-10-18', '32.58'),
+        ('2018-10-19', '32.58'),
+        ('2018-10-22', '32.79'),
+        ('2018-10-23', '32.88'),
+        ('2018-10-24', '32.93'),
+        ('2018-10-25', '32.92'),
+        ('2018-10-26', '33.07'),
+        ('2018-10-29', '33.22'),
+        ('2018-10-30', '33.26'),
+        ('2018-10-31', '33.1'),
+        ('2018-11-01', '32.93'),
+        ('2018-11-02', '32.85'),
+        ('2018-11-05', '32.96'),
+        ('2018-11-06', '32.92'),
+        ('2018-11-07', '32.81'),
+        ('2018-11-08', '32.94'),
+        ('2018-11-09', '33.09'),
+        ('2018-11-12', NULL),
+        ('2018-11-13', '32.96'),
+        ('2018-11-14', '32.96'),
+        ('2018-11-15', '32.96'),
+        ('2018-11-16', '32.82'),
+        ('2018-11-19', '32.97'),
+        ('2018-11-20', '32.95'),
+        ('2018-11-21', '32.89'),
+        ('2018-11-22', NULL),
+        ('2018-11-23', '33.05'),
+        ('2018-11-26', '33.0'),
+        ('2018-11-27', '33.03'),
+        ('2018-11-28', '33.03'),
+        ('2018-11-29', '32.93'),
+        ('2018-11-30', '32.92'),
+        ('2018-12-03', '32.78'),
+        ('2018-12-04', '32.75'),
+        ('2018-12-05', NULL),
+        ('2018-12-06', '32.9'),
+        ('2018-12-07', '32.85'),
+        ('2018-12-10', '32.88'),
+        ('2018-12-11', '32.82'),
+        ('2018-12-12', '32.74'),
+        ('2018-12-13', '32.74'),
+        ('2018-12-14', '32.82'),
+        ('2018-12-17', '32.79'),
+        ('2018-12-18', '32.76'),
+        ('2018-12-19', '32.69'),
+        ('2018-12-20', '32.66'),
+        ('2018-12-21', '32.62'),
+        ('2018-12-24', NULL),
+        ('2018-12-25', NULL),
+        ('2018-12-26', '32.56'),
+        ('2018-12-27', '32.53'),
+        ('2018-12-28', '32.54'),
+        ('2018-12-31', '32.31'),
+        ('2019-01-01', NULL),
+        ('2019-01-02', '32.26'),
+        ('2019-01-03', '32.19'),
+        ('2019-01-04', '32.0'),
+        ('2019-01-07', '31.96'),
+        ('2019-01-08', '32.04'),
+        ('2019-01-09', '31.97'),
+        ('2019-01-10', '31.91'),
+        ('2019-01-11', '31.93'),
+        ('2019-01-14', NULL),
+        ('2019-01-15', '31.9'),
+        ('2019-01-16', '31.68'),
+        ('2019-01-17', '31.74'),
+        ('2019-01-18', '31.74'),
+        ('2019-01-21', NULL),
+        ('2019-01-22', '31.78'),
+        ('2019-01-23', '31.72'),
+        ('2019-01-24', '31.68'),
+        ('2019-01-25', '31.52'),
+        ('2019-01-28', '31.51'),
+        ('2019-01-29', '31.47'),
+        ('2019-01-30', '31.33'),
+        ('2019-01-31', '31.22'),
+        ('2019-02-01', '31.28'),
+        ('2019-02-04', '31.3'),
+        ('2019-02-05', '31.24'),
+        ('2019-02-06', '31.23'),
+        ('2019-02-07', '31.23'),
+        ('2019-02-08', '31.51'),
+        ('2019-02-11', '31.42'),
+        ('2019-02-12', '31.51'),
+        ('2019-02-13', '31.33'),
+        ('2019-02-14', '31.32'),
+        ('2019-02-15', '31.23'),
+        ('2019-02-18', NULL),
+        ('2019-02-19', '31.15'),
+        ('2019-02-20', NULL),
+        ('2019-02-21', '31.22'),
+        ('2019-02-22', '31.3'),
+        ('2019-02-25', '31.27'),
+        ('2019-02-26', '31.33'),
+        ('2019-02-27', '31.45'),
+        ('2019-02-28', '31.57'),
+        ('2019-03-01', '31.8'),
+        ('2019-03-04', '31.86'),
+        ('2019-03-05', '31.77'),
+        ('2019-03-06', '31.85'),
+        ('2019-03-07', '31.86'),
+        ('2019-03-08', '31.73'),
+        ('2019-03-11', '31.7'),
+        ('2019-03-12', '31.66'),
+        ('2019-03-13', '31.59'),
+        ('2019-03-14', '31.78'),
+        ('2019-03-15', '31.68'),
+        ('2019-03-18', '31.69'),
+        ('2019-03-19', '31.67'),
+        ('2019-03-20', '31.74'),
+        ('2019-03-21', '31.72'),
+        ('2019-03-22', '31.73'),
+        ('2019-03-25', '31.55'),
+        ('2019-03-26', '31.62'),
+        ('2019-03-27', '31.87'),
+        ('2019-03-28', '31.87'),
+        ('2019-03-29', '31.7'),
+        ('2019-04-01', '31.71'),
+        ('2019-04-02', '31.78'),
+        ('2019-04-03', '31.73'),
+        ('2019-04-04', '31.79'),
+        ('2019-04-05', '31.91'),
+        ('2019-04-08', '31.9'),
+        ('2019-04-09', '31.75'),
+        ('2019-04-10', '31.75'),
+        ('2019-04-11', '31.85'),
+        ('2019-04-12', '31.79'),
+        ('2019-04-15', '31.78'),
+        ('2019-04-16', '31.82'),
+        ('2019-04-17', '31.8'),
+        ('2019-04-18', '31.83'),
+        ('2019-04-19', '31.81'),
+        ('2019-04-22', '31.86'),
+        ('2019-04-23', '31.99'),
+        ('2019-04-24', '32.02'),
+        ('2019-04-25', '32.06'),
+        ('2019-04-26', '31.91'),
+        ('2019-04-29', '31.92'),
+        ('2019-04-30', '31.89'),
+        ('2019-05-01', '31.91'),
+        ('2019-05-02', '32.04'),
+        ('2019-05-03', '31.92'),
+        ('2019-05-06', '31.88'),
+        ('2019-05-07', '31.91'),
+        ('2019-05-08', '31.77'),
+        ('2019-05-09', '31.82'),
+        ('2019-05-10', '31.55'),
+        ('2019-05-13', '31.62'),
+        ('2019-05-14', '31.62'),
+        ('2019-05-15', '31.54'),
+        ('2019-05-16', '31.63'),
+        ('2019-05-17', '31.83'),
+        ('2019-05-20', '31.89'),
+        ('2019-05-21', '31.95'),
+        ('2019-05-22', '31.92'),
+        ('2019-05-23', '31.92'),
+        ('2019-05-24', '31.83'),
+        ('2019-05-27', NULL),
+        ('2019-05-28', '31.82'),
+        ('2019-05-29', '31.82'),
+        ('2019-05-30', '31.77'),
+        ('2019-05-31', '31.56'),
+        ('2019-06-03', '31.34'),
+        ('2019-06-04', '31.35'),
+        ('2019-06-05', '31.4'),
+        ('2019-06-06', '31.4'),
+        ('2019-06-07', '31.25'),
+        ('2019-06-10', '31.35'),
+        ('2019-06-11', '31.28'),
+        ('2019-06-12', '31.26'),
+        ('2019-06-13', '31.19'),
+        ('2019-06-14', '31.21'),
+        ('2019-06-17', '31.35'),
+        ('2019-06-18', '31.21'),
+        ('2019-06-19', '31.23'),
+        ('2019-06-20', '30.86'),
+        ('2019-06-21', '30.78'),
+        ('2019-06-24', '30.66'),
+        ('2019-06-25', '30.71'),
+        ('2019-06-26', '30.73'),
+        ('2019-06-27', '30.78'),
+        ('2019-06-28', '30.68'),
+        ('2019-07-01', '30.64'),
+        ('2019-07-02', '30.56'),
+        ('2019-07-03', '30.59'),
+        ('2019-07-04', NULL),
+        ('2019-07-05', '30.78'),
+        ('2019-07-08', '30.8'),
+        ('2019-07-09', '30.8'),
+        ('2019-07-10', '30.69'),
+        ('2019-07-11', '30.7'),
+        ('2019-07-12', '30.93'),
+        ('2019-07-15', '30.89'),
+        ('2019-07-16', '30.91'),
+        ('2019-07-17', '30.87'),
+        ('2019-07-18', '30.81'),
+        ('2019-07-19', '30.83'),
+        ('2019-07-22', '30.85'),
+        ('2019-07-23', '30.91'),
+        ('2019-07-24', '30.9'),
+        ('2019-07-25', '30.92'),
+        ('2019-07-26', '30.87'),
+        ('2019-07-29', '30.85'),
+        ('2019-07-30', '30.79'),
+        ('2019-07-31', '30.7'),
+        ('2019-08-01', '30.83'),
+        ('2019-08-02', '30.71'),
+        ('2019-08-05', '30.82'),
+        ('2019-08-06', '30.72'),
+        ('2019-08-07', '30.8'),
+        ('2019-08-08', '30.75'),
+        ('2019-08-09', '30.79'),
+        ('2019-08-12', '30.85'),
+        ('2019-08-13', '30.78'),
+        ('2019-08-14', '30.84'),
+        ('2019-08-15', '30.82'),
+        ('2019-08-16', '30.91'),
+        ('2019-08-19', '30.85'),
+        ('2019-08-20', '30.76'),
+        ('2019-08-21', '30.77'),
+        ('2019-08-22', '30.79'),
+        ('2019-08-23', '30.6'),
+        ('2019-08-26', '30.58'),
+        ('2019-08-27', '30.57'),
+        ('2019-08-28', '30.61'),
+        ('2019-08-29', '30.61'),
+        ('2019-08-30', '30.61'),
+        ('2019-09-02', NULL),
+        ('2019-09-03', '30.6'),
+        ('2019-09-04', '30.56'),
+        ('2019-09-05', '30.68'),
+        ('2019-09-06', '30.62'),
+        ('2019-09-09', '30.63'),
+        ('2019-09-10', '30.6'),
+        ('2019-09-11', '30.58'),
+        ('2019-09-12', '30.4'),
+        ('2019-09-13', '30.47'),
+        ('2019-09-16', '30.51'),
+        ('2019-09-17', '30.51'),
+        ('2019-09-18', '30.51'),
+        ('2019-09-19', '30.53'),
+        ('2019-09-20', '30.49'),
+        ('2019-09-23', '30.46'),
+        ('2019-09-24', '30.54'),
+        ('2019-09-25', '30.61'),
+        ('2019-09-26', '30.64'),
+        ('2019-09-27', '30.63'),
+        ('2019-09-30', '30.59'),
+        ('2019-10-01', '30.61'),
+        ('2019-10-02', '30.6'),
+        ('2019-10-03', '30.53'),
+        ('2019-10-04', '30.42'),
+        ('2019-10-07', '30.45'),
+        ('2019-10-08', '30.4'),
+        ('2019-10-09', '30.31'),
+        ('2019-10-10', '30.39'),
+        ('2019-10-11', '30.39'),
+        ('2019-10-14', NULL),
+        ('2019-10-15', '30.42'),
+        ('2019-10-16', '30.36'),
+        ('2019-10-17', '30.27'),
+        ('2019-10-18', '30.28'),
+        ('2019-10-21', '30.27'),
+        ('2019-10-22', '30.3'),
+        ('2019-10-23', '30.27'),
+        ('2019-10-24', '30.25'),
+        ('2019-10-25', '30.16'),
+        ('2019-10-28', '30.23'),
+        ('2019-10-29', '30.24'),
+        ('2019-10-30', '30.24'),
+        ('2019-10-31', '30.18'),
+        ('2019-11-01', '30.17'),
+        ('2019-11-04', '30.2'),
+        ('2019-11-05', '30.24'),
+        ('2019-11-06', '30.29'),
+        ('2019-11-07', '30.43'),
+        ('2019-11-08', '30.37'),
+        ('2019-11-11', NULL),
+        ('2019-11-12', '30.34'),
+        ('2019-11-13', '30.22'),
+        ('2019-11-14', '30.2'),
+        ('2019-11-15', '30.26'),
+        ('2019-11-18', '30.19'),
+        ('2019-11-19', '30.19'),
+        ('2019-11-20', '30.19'),
+        ('2019-11-21', '30.21'),
+        ('2019-11-22', '30.2'),
+        ('2019-11-25', '30.19'),
+        ('2019-11-26', '30.23'),
+        ('2019-11-27', '30.23'),
+        ('2019-11-28', NULL),
+        ('2019-11-29', '30.22'),
+        ('2019-12-02', '30.27'),
+        ('2019-12-03', '30.26'),
+        ('2019-12-04', '30.31'),
+        ('2019-12-05', '30.36'),
+        ('2019-12-06', '30.35'),
+        ('2019-12-09', '30.31'),
+        ('2019-12-10', '30.3'),
+        ('2019-12-11', '30.25'),
+        ('2019-12-12', '30.16'),
+        ('2019-12-13', '30.21'),
+        ('2019-12-16', '30.22'),
+        ('2019-12-17', '30.24'),
+        ('2019-12-18', '30.2'),
+        ('2019-12-19', '30.19'),
+        ('2019-12-20', '30.19'),
+        ('2019-12-23', '30.16'),
+        ('2019-12-24', '30.15'),
+        ('2019-12-25', NULL),
+        ('2019-12-26', '30.15'),
+        ('2019-12-27', '30.14'),
+        ('2019-12-30', '29.94'),
+        ('2019-12-31', '29.75'),
+        ('2020-01-01', NULL),
+        ('2020-01-02', '30.15'),
+        ('2020-01-03', '30.18'),
+        ('2020-01-06', '30.16'),
+        ('2020-01-07', '30.31'),
+        ('2020-01-08', '30.37'),
+        ('2020-01-09', '30.25'),
+        ('2020-01-10', '30.22'),
+        ('2020-01-13', '30.18'),
+        ('2020-01-14', '30.25'),
+        ('2020-01-15', '30.29'),
+        ('2020-01-16', '30.39'),
+        ('2020-01-17', '30.38'),
+        ('2020-01-20', NULL),
+        ('2020-01-21', '30.39'),
+        ('2020-01-22', '30.4'),
+        ('2020-01-23', '30.57'),
+        ('2020-01-24', '30.56'),
+        ('2020-01-27', '30.69'),
+        ('2020-01-28', '30.81'),
+        ('2020-01-29', '30.95'),
+        ('2020-01-30', '31.18'),
+        ('2020-01-31', '31.19'),
+        ('2020-02-03', '31.06'),
+        ('2020-02-04', '30.97'),
+        ('2020-02-05', '30.97'),
+        ('2020-02-06', '31.15'),
+        ('2020-02-07', '31.34'),
+        ('2020-02-10', '31.3'),
+        ('2020-02-11', '31.25'),
+        ('2020-02-12', '31.1'),
+        ('2020-02-13', '31.1'),
+        ('2020-02-14', '31.21'),
+        ('2020-02-17', NULL),
+        ('2020-02-18', '31.16'),
+        ('2020-02-19', '31.21'),
+        ('2020-02-20', '31.43'),
+        ('2020-02-21', '31.55'),
+        ('2020-02-24', '31.66'),
+        ('2020-02-25', '31.73'),
+        ('2020-02-26', '31.83'),
+        ('2020-02-27', '31.58'),
+        ('2020-02-28', '31.53'),
+        ('2020-03-02', '31.41'),
+        ('2020-03-03', '31.34'),
+        ('2020-03-04', '31.34'),
+        ('2020-03-05', '31.56'),
+        ('2020-03-06', '31.49'),
+        ('2020-03-09', '31.46'),
+        ('2020-03-10', '31.47'),
+        ('2020-03-11', '31.45'),
+        ('2020-03-12', '31.72'),
+        ('2020-03-13', '31.95'),
+        ('2020-03-16', '31.98'),
+        ('2020-03-17', '32.18'),
+        ('2020-03-18', '32.5'),
+        ('2020-03-19', '32.57'),
+        ('2020-03-20', '32.69'),
+        ('2020-03-23', '32.86'),
+        ('2020-03-24', '32.82'),
+        ('2020-03-25', '32.77'),
+        ('2020-03-26', '32.6'),
+        ('2020-03-27', '32.54'),
+        ('2020-03-30', '32.68'),
+        ('2020-03-31', '32.7'),
+        ('2020-04-01', '33.04'),
+        ('2020-04-02', '32.93'),
+        ('2020-04-03', '33.03'),
+        ('2020-04-06', '32.78'),
+        ('2020-04-07', '32.72'),
+        ('2020-04-08', '32.72'),
+        ('2020-04-09', '32.66'),
+        ('2020-04-10', '32.69'),
+        ('2020-04-13', '32.7'),
+        ('2020-04-14', '32.62'),
+        ('2020-04-15', '32.7'),
+        ('2020-04-16', '32.65'),
+        ('2020-04-17', '32.49'),
+        ('2020-04-20', '32.46'),
+        ('2020-04-21', '32.51'),
+        ('2020-04-22', '32.33'),
+        ('2020-04-23', '32.31'),
+        ('2020-04-24', '32.42'),
+        ('2020-04-27', '32.47'),
+        ('2020-04-28', '32.43'),
+        ('2020-04-29', '32.4'),
+        ('2020-04-30', '32.42'),
+        ('2020-05-01', '32.52'),
+        ('2020-05-04', '32.4'),
+        ('2020-05-05', '32.37'),
+        ('2020-05-06', '32.41'),
+        ('2020-05-07', '32.38'),
+        ('2020-05-08', '32.17'),
+        ('2020-05-11', '32.17'),
+        ('2020-05-12', '32.08'),
+        ('2020-05-13', '32.07'),
+        ('2020-05-14', '32.08'),
+        ('2020-05-15', '32.06'),
+        ('2020-05-18', '32.01'),
+        ('2020-05-19', '31.88'),
+        ('2020-05-20', '31.81'),
+        ('2020-05-21', '31.84'),
+        ('2020-05-22', '31.9'),
+        ('2020-05-25', NULL),
+        ('2020-05-26', '31.88'),
+        ('2020-05-27', '31.91'),
+        ('2020-05-28', '31.85'),
+        ('2020-05-29', '31.82'),
+        ('2020-06-01', '31.65'),
+        ('2020-06-02', '31.53'),
+        ('2020-06-03', '31.59'),
+        ('2020-06-04', '31.55'),
+        ('2020-06-05', '31.5'),
+        ('2020-06-08', '31.41'),
+        ('2020-06-09', '31.31'),
+        ('2020-06-10', '31.14'),
+        ('2020-06-11', '30.92'),
+        ('2020-06-12', '30.97'),
+        ('2020-06-15', '31.05'),
+        ('2020-06-16', '31.1'),
+        ('2020-06-17', '31.13'),
+        ('2020-06-18', '31.07'),
+        ('2020-06-19', '30.98'),
+        ('2020-06-22', '30.95'),
+        ('2020-06-23', '30.87'),
+        ('2020-06-24', '30.84'),
+        ('2020-06-25', '30.9'),
+        ('2020-06-26', '30.9'),
+        ('2020-06-29', '30.89'),
+        ('2020-06-30', '30.88'),
+        ('2020-07-01', '31.02'),
+        ('2020-07-02', '31.06'),
+        ('2020-07-03', NULL),
+        ('2020-07-06', '31.06'),
+        ('2020-07-07', '31.18'),
+        ('2020-07-08', '31.22'),
+        ('2020-07-09', '31.26'),
+        ('2020-07-10', '31.31'),
+        ('2020-07-13', '31.36'),
+        ('2020-07-14', '31.53'),
+        ('2020-07-15', '31.57'),
+        ('2020-07-16', '31.69'),
+        ('2020-07-17', '31.68'),
+        ('2020-07-20', '31.8'),
+        ('2020-07-21', '31.57'),
+        ('2020-07-22', '31.6'),
+        ('2020-07-23', '31.69'),
+        ('2020-07-24', '31.61'),
+        ('2020-07-27', '31.48'),
+        ('2020-07-28', '31.47'),
+        ('2020-07-29', '31.41'),
+        ('2020-07-30', '31.37'),
+        ('2020-07-31', '31.23'),
+        ('2020-08-03', '31.21'),
+        ('2020-08-04', '31.05'),
+        ('2020-08-05', '31.01'),
+        ('2020-08-06', '31.13'),
+        ('2020-08-07', '31.2'),
+        ('2020-08-10', '31.11'),
+        ('2020-08-11', '31.1'),
+        ('2020-08-12', '31.07'),
+        ('2020-08-13', '31.05'),
+        ('2020-08-14', '31.09'),
+        ('2020-08-17', '31.15'),
+        ('2020-08-18', '31.16'),
+        ('2020-08-19', '31.25'),
+        ('2020-08-20', '31.41'),
+        ('2020-08-21', '31.56'),
+        ('2020-08-24', '31.48'),
+        ('2020-08-25', '31.44'),
+        ('2020-08-26', '31.27'),
+        ('2020-08-27', '31.28'),
+        ('2020-08-28', '31.09'),
+        ('2020-08-31', '31.06'),
+        ('2020-09-01', '31.18'),
+        ('2020-09-02', '31.3'),
+        ('2020-09-03', '31.43'),
+        ('2020-09-04', '31.41'),
+        ('2020-09-07', NULL),
+        ('2020-09-08', '31.43'),
+        ('2020-09-09', '31.27'),
+        ('2020-09-10', '31.31'),
+        ('2020-09-11', '31.26'),
+        ('2020-09-14', '31.27'),
+        ('2020-09-15', '31.21'),
+        ('2020-09-16', '31.06'),
+        ('2020-09-17', '31.17'),
+        ('2020-09-18', '31.09'),
+        ('2020-09-21', '31.37'),
+        ('2020-09-22', '31.4'),
+        ('2020-09-23', '31.57'),
+        ('2020-09-24', '31.6'),
+        ('2020-09-25', '31.64'),
+        ('2020-09-28', '31.64'),
+        ('2020-09-29', '31.64'),
+        ('2020-09-30', '31.57'),
+        ('2020-10-01', '31.56'),
+        ('2020-10-02', '31.56'),
+        ('2020-10-05', '31.29'),
+        ('2020-10-06', '31.19'),
+        ('2020-10-07', '31.22'),
+        ('2020-10-08', '31.21'),
+        ('2020-10-09', '31.02'),
+        ('2020-10-12', NULL),
+        ('2020-10-13', '31.24'),
+        ('2020-10-14', '31.1'),
+        ('2020-10-15', '31.22'),
+        ('2020-10-16', '31.16'),
+        ('2020-10-19', '31.2'),
+        ('2020-10-20', '31.26'),
+        ('2020-10-21', '31.19'),
+        ('2020-10-22', '31.31'),
+        ('2020-10-23', '31.31'),
+        ('2020-10-26', '31.27'),
+        ('2020-10-27', '31.2'),
+        ('2020-10-28', '31.26'),
+        ('2020-10-29', '31.25'),
+        ('2020-10-30', '31.17'),
+        ('2020-11-02', '31.13'),
+        ('2020-11-03', '31.02'),
+        ('2020-11-04', '31.0'),
+        ('2020-11-05', '30.79'),
+        ('2020-11-06', '30.57'),
+        ('2020-11-09', '30.58'),
+        ('2020-11-10', '30.37'),
+        ('2020-11-11', NULL),
+        ('2020-11-12', '30.21'),
+        ('2020-11-13', '30.18'),
+        ('2020-11-16', '30.19'),
+        ('2020-11-17', '30.19'),
+        ('2020-11-18', '30.28'),
+        ('2020-11-19', '30.38'),
+        ('2020-11-20', '30.25'),
+        ('2020-11-23', '30.36'),
+        ('2020-11-24', '30.33'),
+        ('2020-11-25', '30.3'),
+        ('2020-11-26', NULL),
+        ('2020-11-27', NULL),
+        ('2020-11-30', '30.28'),
+        ('2020-12-01', '30.27'),
+        ('2020-12-02', '30.21'),
+        ('2020-12-03', '30.17'),
+        ('2020-12-04', '30.16'),
+        ('2020-12-07', '30.16'),
+        ('2020-12-08', '30.02'),
+        ('2020-12-09', '30.05'),
+        ('2020-12-10', '30.06'),
+        ('2020-12-11', '30.09'),
+        ('2020-12-14', '30.09'),
+        ('2020-12-15', '30.04'),
+        ('2020-12-16', '30.01'),
+        ('2020-12-17', '29.79'),
+        ('2020-12-18', '29.84'),
+        ('2020-12-21', '30.14'),
+        ('2020-12-22', '30.21'),
+        ('2020-12-23', '30.21'),
+        ('2020-12-24', NULL),
+        ('2020-12-25', NULL),
+        ('2020-12-28', '30.16'),
+        ('2020-12-29', '30.04'),
+        ('2020-12-30', '29.95'),
+        ('2020-12-31', '30.02'),
+        ('2021-01-01', NULL),
+        ('2021-01-04', '29.92'),
+        ('2021-01-05', '29.92'),
+        ('2021-01-06', '29.93'),
+        ('2021-01-07', '30.07'),
+        ('2021-01-08', '30.09'),
+        ('2021-01-11', '30.14'),
+        ('2021-01-12', '30.12'),
+        ('2021-01-13', '30.02'),
+        ('2021-01-14', '29.97'),
+        ('2021-01-15', '30.07'),
+        ('2021-01-18', NULL),
+        ('2021-01-19', '29.99'),
+        ('2021-01-20', NULL),
+        ('2021-01-21', '29.93'),
+        ('2021-01-22', '29.99'),
+        ('2021-01-25', '29.99'),
+        ('2021-01-26', '29.95'),
+        ('2021-01-27', '30.0'),
+        ('2021-01-28', '29.96'),
+        ('2021-01-29', '29.89')
+)
+INSERT INTO
+    exchange_rates (source_currency_id, destination_currency_id, date, rate)
+SELECT
+    source.id,
+    destination.id,
+    CAST(rates.date AS DATE),
+    CAST(rates.rate AS NUMERIC)
+FROM
+    rates,
+    currencies_codes source,
+    currencies_codes destination
+WHERE
+    source.code = 'THB'
+    AND destination.code = 'USD'
+ON CONFLICT DO NOTHING;
+
+-- TWDUSD.csv
+WITH rates (date, rate) AS (
+    VALUES
+        ('2016-01-29', '33.4300'),
+        ('2016-02-01', '33.4100'),
+        ('2016-02-02', '33.5100'),
+        ('2016-02-03', '33.4200'),
+        ('2016-02-04', '33.0000'),
+        ('2016-02-05', '33.3700'),
+        ('2016-02-08', '33.4100'),
+        ('2016-02-09', '33.2300'),
+        ('2016-02-10', '33.0000'),
+        ('2016-02-11', '32.9500'),
+        ('2016-02-12', '33.0400'),
+        ('2016-02-15', NULL),
+        ('2016-02-16', '33.2100'),
+        ('2016-02-17', '33.2500'),
+        ('2016-02-18', '33.2600'),
+        ('2016-02-19', '33.3000'),
+        ('2016-02-22', '33.1500'),
+        ('2016-02-23', '33.2600'),
+        ('2016-02-24', '33.3000'),
+        ('2016-02-25', '33.1900'),
+        ('2016-02-26', '33.2900'),
+        ('2016-02-29', '33.2200'),
+        ('2016-03-01', '33.0400'),
+        ('2016-03-02', '33.0900'),
+        ('2016-03-03', '32.9200'),
+        ('2016-03-04', '32.4900'),
+        ('2016-03-07', '32.5400'),
+        ('2016-03-08', '32.7600'),
+        ('2016-03-09', '32.7500'),
+        ('2016-03-10', '32.8700'),
+        ('2016-03-11', '32.5800'),
+        ('2016-03-14', '32.6800'),
+        ('2016-03-15', '32.8600'),
+        ('2016-03-16', '32.7700'),
+        ('2016-03-17', '32.2400'),
+        ('2016-03-18', '32.3000'),
+        ('2016-03-21', '32.3300'),
+        ('2016-03-22', '32.3400'),
+        ('2016-03-23', '32.4900'),
+        ('2016-03-24', '32.5000'),
+        ('2016-03-25', '32.4900'),
+        ('2016-03-28', '32.5600'),
+        ('2016-03-29', '32.5700'),
+        ('2016-03-30', '32.1600'),
+        ('2016-03-31', '32.1800'),
+        ('2016-04-01', '32.2600'),
+        ('2016-04-04', '32.3800'),
+        ('2016-04-05', '32.4400'),
+        ('2016-04-06', '32.3800'),
+        ('2016-04-07', '32.4000'),
+        ('2016-04-08', '32.4000'),
+        ('2016-04-11', '32.3300'),
+        ('2016-04-12', '32.3300'),
+        ('2016-04-13', '32.3500'),
+        ('2016-04-14', '32.4100'),
+        ('2016-04-15', '32.3200'),
+        ('2016-04-18', '32.3200'),
+        ('2016-04-19', '32.1100'),
+        ('2016-04-20', '32.1800'),
+        ('2016-04-21', '32.2700'),
+        ('2016-04-22', '32.3600'),
+        ('2016-04-25', '32.3400'),
+        ('2016-04-26', '32.2700'),
+        ('2016-04-27', '32.3600'),
+        ('2016-04-28', '32.2300'),
+        ('2016-04-29', '32.2800'),
+        ('2016-05-02', '32.2200'),
+        ('2016-05-03', '32.3200'),
+        ('2016-05-04', '32.3900'),
+        ('2016-05-05', '32.4000'),
+        ('2016-05-06', '32.3800'),
+        ('2016-05-09', '32.5400'),
+        ('2016-05-10', '32.5400'),
+        ('2016-05-11', '32.4900'),
+        ('2016-05-12', '32.5900'),
+        ('2016-05-13', '32.6600'),
+        ('2016-05-16', '32.6500'),
+        ('2016-05-17', '32.5600'),
+        ('2016-05-18', '32.7600'),
+        ('2016-05-19', '32.7500'),
+        ('2016-05-20', '32.6900'),
+        ('2016-05-23', '32.5800'),
+        ('2016-05-24', '32.6400'),
+        ('2016-05-25', '32.5500'),
+        ('2016-05-26', '32.4900'),
+        ('2016-05-27', '32.5400'),
+        ('2016-05-30', NULL),
+        ('2016-05-31', '32.5800'),
+        ('2016-06-01', '32.6200'),
+        ('2016-06-02', '32.6000'),
+        ('2016-06-03', '32.2800'),
+        ('2016-06-06', '32.2500'),
+        ('2016-06-07', '32.1600'),
+        ('2016-06-08', '32.1300'),
+        ('2016-06-09', '32.2700'),
+        ('2016-06-10', '32.2900'),
+        ('2016-06-13', '32.3700'),
+        ('2016-06-14', '32.3900'),
+        ('2016-06-15', '32.3100'),
+        ('2016-06-16', '32.3700'),
+        ('2016-06-17', '32.3400'),
+        ('2016-06-20', '32.2200'),
+        ('2016-06-21', '32.1500'),
+        ('2016-06-22', '32.0700'),
+        ('2016-06-23', '31.9900'),
+        ('2016-06-24', '32.3600'),
+        ('2016-06-27', '32.5300'),
+        ('2016-06-28', '32.4100'),
+        ('2016-06-29', '32.2700'),
+        ('2016-06-30', '32.2200'),
+        ('2016-07-01', '32.2000'),
+        ('2016-07-04', NULL),
+        ('2016-07-05', '32.3300'),
+        ('2016-07-06', '32.3600'),
+        ('2016-07-07', '32.3200'),
+        ('2016-07-08', '32.2300'),
+        ('2016-07-11', '32.2100'),
+        ('2016-07-12', '32.1300'),
+        ('2016-07-13', '32.1600'),
+        ('2016-07-14', '31.9400'),
+        ('2016-07-15', '31.9200'),
+        ('2016-07-18', '31.9300'),
+        ('2016-07-19', '32.0600'),
+        ('2016-07-20', '32.0700'),
+        ('2016-07-21', '31.9800'),
+        ('2016-07-22', '32.0300'),
+        ('2016-07-25', '32.2000'),
+        ('2016-07-26', '32.1100'),
+        ('2016-07-27', '32.0600'),
+        ('2016-07-28', '31.9600'),
+        ('2016-07-29', '31.8200'),
+        ('2016-08-01', '31.5500'),
+        ('2016-08-02', '31.5700'),
+        ('2016-08-03', '31.6600'),
+        ('2016-08-04', '31.6200'),
+        ('2016-08-05', '31.5400'),
+        ('2016-08-08', '31.4500'),
+        ('2016-08-09', '31.2800'),
+        ('2016-08-10', '31.0500'),
+        ('2016-08-11', '31.2500'),
+        ('2016-08-12', '31.3800'),
+        ('2016-08-15', '31.3100'),
+        ('2016-08-16', '31.2600'),
+        ('2016-08-17', '31.5300'),
+        ('2016-08-18', '31.4000'),
+        ('2016-08-19', '31.6400'),
+        ('2016-08-22', '31.8000'),
+        ('2016-08-23', '31.7800'),
+        ('2016-08-24', '31.7900'),
+        ('2016-08-25', '31.6800'),
+        ('2016-08-26', '31.6400'),
+        ('2016-08-29', '31.7000'),
+        ('2016-08-30', '31.7400'),
+        ('2016-08-31', '31.7400'),
+        ('2016-09-01', '31.7200'),
+        ('2016-09-02', '31.6600'),
+        ('2016-09-05', NULL),
+        ('2016-09-06', '31.1800'),
+        ('2016-09-07', '31.2400'),
+        ('2016-09-08', '31.3200'),
+        ('2016-09-09', '31.5800'),
+        ('2016-09-12', '31.7000'),
+        ('2016-09-13', '31.7700'),
+        ('2016-09-14', '31.6800'),
+        ('2016-09-15', '31.6800'),
+        ('2016-09-16', '31.7000'),
+        ('2016-09-19', '31.3700'),
+        ('2016-09-20', '31.3100'),
+        ('2016-09-21', '31.2800'),
+        ('2016-09-22', '31.3000'),
+        ('2016-09-23', '31.3400'),
+        ('2016-09-26', '31.4100'),
+        ('2016-09-27', '31.3700'),
+        ('2016-09-28', '31.3500'),
+        ('2016-09-29', '31.3500'),
+        ('2016-09-30', '31.2700'),
+        ('2016-10-03', '31.3600'),
+        ('2016-10-04', '31.4300'),
+        ('2016-10-05', '31.4500'),
+        ('2016-10-06', '31.5000'),
+        ('2016-10-07', '31.4700'),
+        ('2016-10-10', NULL),
+        ('2016-10-11', '31.6400'),
+        ('2016-10-12', '31.6300'),
+        ('2016-10-13', '31.7400'),
+        ('2016-10-14', '31.7600'),
+        ('2016-10-17', '31.7900'),
+        ('2016-10-18', '31.5300'),
+        ('2016-10-19', '31.4400'),
+        ('2016-10-20', '31.5800'),
+        ('2016-10-21', '31.7500'),
+        ('2016-10-24', '31.6800'),
+        ('2016-10-25', '31.5300'),
+        ('2016-10-26', '31.5900'),
+        ('2016-10-27', '31.7000'),
+        ('2016-10-28', '31.6100'),
+        ('2016-10-31', '31.5400'),
+        ('2016-11-01', '31.5400'),
+        ('2016-11-02', '31.5200'),
+        ('2016-11-03', '31.5400'),
+        ('2016-11-04', '31.5400'),
+        ('2016-11-07', '31.4500'),
+        ('2016-11-08', '31.4100'),
+        ('2016-11-09', '31.5300'),
+        ('2016-11-10', '31.7700'),
+        ('2016-11-11', NULL),
+        ('2016-11-14', '31.9900'),
+        ('2016-11-15', '31.8300'),
+        ('2016-11-16', '31.8500'),
+        ('2016-11-17', '31.9300'),
+        ('2016-11-18', '32.0100'),
+        ('2016-11-21', '31.8900'),
+        ('2016-11-22', '31.8500'),
+        ('2016-11-23', '31.9900'),
+        ('2016-11-24', NULL),
+        ('2016-11-25', '31.8500'),
+        ('2016-11-28', '31.7600'),
+        ('2016-11-29', '31.8200'),
+        ('2016-11-30', '31.9200'),
+        ('2016-12-01', '31.9300'),
+        ('2016-12-02', '31.8800'),
+        ('2016-12-05', '32.0100'),
+        ('2016-12-06', '31.9200'),
+        ('2016-12-07', '31.7200'),
+        ('2016-12-08', '31.7300'),
+        ('2016-12-09', '31.8000'),
+        ('2016-12-12', '31.7700'),
+        ('2016-12-13', '31.7700'),
+        ('2016-12-14', '31.7300'),
+        ('2016-12-15', '32.0000'),
+        ('2016-12-16', '31.9400'),
+        ('2016-12-19', '31.9800'),
+        ('2016-12-20', '32.0600'),
+        ('2016-12-21', '32.0500'),
+        ('2016-12-22', '32.1900'),
+        ('2016-12-23', '32.1800'),
+        ('2016-12-26', NULL),
+        ('2016-12-27', '32.2700'),
+        ('2016-12-28', '32.4200'),
+        ('2016-12-29', '32.2600'),
+        ('2016-12-30', '32.4000'),
+        ('2017-01-02', NULL),
+        ('2017-01-03', '32.3700'),
+        ('2017-01-04', '32.1600'),
+        ('2017-01-05', '31.7800'),
+        ('2017-01-06', '32.0000'),
+        ('2017-01-09', '32.0200'),
+        ('2017-01-10', '31.9200'),
+        ('2017-01-11', '31.9000'),
+        ('2017-01-12', '31.4900'),
+        ('2017-01-13', '31.6000'),
+        ('2017-01-16', NULL),
+        ('2017-01-17', '31.3800'),
+        ('2017-01-18', '31.5300'),
+        ('2017-01-19', '31.6000'),
+        ('2017-01-20', NULL),
+        ('2017-01-23', '31.4100'),
+        ('2017-01-24', '31.3100'),
+        ('2017-01-25', '31.3100'),
+        ('2017-01-26', '31.4200'),
+        ('2017-01-27', '31.5000'),
+        ('2017-01-30', '31.3800'),
+        ('2017-01-31', '31.1900'),
+        ('2017-02-01', '31.1700'),
+        ('2017-02-02', '31.0000'),
+        ('2017-02-03', '30.7900'),
+        ('2017-02-06', '30.8900'),
+        ('2017-02-07', '31.0600'),
+        ('2017-02-08', '31.0300'),
+        ('2017-02-09', '31.0600'),
+        ('2017-02-10', '30.9400'),
+        ('2017-02-13', '30.9600'),
+        ('2017-02-14', '30.7800'),
+        ('2017-02-15', '30.7300'),
+        ('2017-02-16', '30.7500'),
+        ('2017-02-17', '30.9000'),
+        ('2017-02-20', NULL),
+        ('2017-02-21', '30.8000'),
+        ('2017-02-22', '30.7700'),
+        ('2017-02-23', '30.6100'),
+        ('2017-02-24', '30.6400'),
+        ('2017-02-27', '30.7200'),
+        ('2017-02-28', '30.6400'),
+        ('2017-03-01', '30.7600'),
+        ('2017-03-02', '30.8800'),
+        ('2017-03-03', '31.0300'),
+        ('2017-03-06', '30.9500'),
+        ('2017-03-07', '30.9200'),
+        ('2017-03-08', '30.9300'),
+        ('2017-03-09', '31.0100'),
+        ('2017-03-10', '30.9900'),
+        ('2017-03-13', '30.9900'),
+        ('2017-03-14', '30.9400'),
+        ('2017-03-15', '30.8300'),
+        ('2017-03-16', '30.9400'),
+        ('2017-03-17', '30.5200'),
+        ('2017-03-20', '30.3800'),
+        ('2017-03-21', '30.4300'),
+        ('2017-03-22', '30.4500'),
+        ('2017-03-23', '30.4600'),
+        ('2017-03-24', '30.3200'),
+        ('2017-03-27', '30.1400'),
+        ('2017-03-28', '30.1700'),
+        ('2017-03-29', '30.2100'),
+        ('2017-03-30', '30.2900'),
+        ('2017-03-31', '30.3800'),
+        ('2017-04-03', '30.3300'),
+        ('2017-04-04', '30.4700'),
+        ('2017-04-05', '30.4000'),
+        ('2017-04-06', '30.5100'),
+        ('2017-04-07', '30.5600'),
+        ('2017-04-10', '30.5700'),
+        ('2017-04-11', '30.6300'),
+        ('2017-04-12', '30.5500'),
+        ('2017-04-13', '30.3200'),
+        ('2017-04-14', '30.3100'),
+        ('2017-04-17', '30.3200'),
+        ('2017-04-18', '30.3900'),
+        ('2017-04-19', '30.4000'),
+        ('2017-04-20', '30.3700'),
+        ('2017-04-21', '30.3400'),
+        ('2017-04-24', '30.2300'),
+        ('2017-04-25', '30.0600'),
+        ('2017-04-26', '30.1000'),
+        ('2017-04-27', '30.1200'),
+        ('2017-04-28', '30.1900'),
+        ('2017-05-01', '30.1600'),
+        ('2017-05-02', '30.0200'),
+        ('2017-05-03', '30.0500'),
+        ('2017-05-04', '30.1200'),
+        ('2017-05-05', '30.1800'),
+        ('2017-05-08', '30.1300'),
+        ('2017-05-09', '30.2400'),
+        ('2017-05-10', '30.2000'),
+        ('2017-05-11', '30.1800'),
+        ('2017-05-12', '30.1500'),
+        ('2017-05-15', '30.0400'),
+        ('2017-05-16', '30.0900'),
+        ('2017-05-17', '30.1000'),
+        ('2017-05-18', '30.3000'),
+        ('2017-05-19', '30.0200'),
+        ('2017-05-22', '30.0100'),
+        ('2017-05-23', '30.1600'),
+        ('2017-05-24', '30.1500'),
+        ('2017-05-25', '30.0800'),
+        ('2017-05-26', '30.1800'),
+        ('2017-05-29', NULL),
+        ('2017-05-30', '30.1500'),
+        ('2017-05-31', '30.1100'),
+        ('2017-06-01', '30.0900'),
+        ('2017-06-02', '30.0900'),
+        ('2017-06-05', '30.0700'),
+        ('2017-06-06', '30.0900'),
+        ('2017-06-07', '30.0900'),
+        ('2017-06-08', '30.1100'),
+        ('2017-06-09', '30.1200'),
+        ('2017-06-12', '30.2200'),
+        ('2017-06-13', '30.2300'),
+        ('2017-06-14', '30.0800'),
+        ('2017-06-15', '30.3500'),
+        ('2017-06-16', '30.3100'),
+        ('2017-06-19', '30.3700'),
+        ('2017-06-20', '30.4500'),
+        ('2017-06-21', '30.4400'),
+        ('2017-06-22', '30.4400'),
+        ('2017-06-23', '30.2900'),
+        ('2017-06-26', '30.3000'),
+        ('2017-06-27', '30.3200'),
+        ('2017-06-28', '30.4100'),
+        ('2017-06-29', '30.4600'),
+        ('2017-06-30', '30.3800'),
+        ('2017-07-03', '30.4900'),
+        ('2017-07-04', NULL),
+        ('2017-07-05', '30.5600'),
+        ('2017-07-06', '30.6100'),
+        ('2017-07-07', '30.5600'),
+        ('2017-07-10', '30.5600'),
+        ('2017-07-11', '30.5300'),
+        ('2017-07-12', '30.3800'),
+        ('2017-07-13', '30.3600'),
+        ('2017-07-14', '30.2600'),
+        ('2017-07-17', '30.3700'),
+        ('2017-07-18', '30.3400'),
+        ('2017-07-19', '30.3700'),
+        ('2017-07-20', '30.3800'),
+        ('2017-07-21', '30.4100'),
+        ('2017-07-24', '30.3400'),
+        ('2017-07-25', '30.3200'),
+        ('2017-07-26', '30.3600'),
+        ('2017-07-27', '30.1800'),
+        ('2017-07-28', '30.2600'),
+        ('2017-07-31', '30.2000'),
+        ('2017-08-01', '30.2400'),
+        ('2017-08-02', '30.2200'),
+        ('2017-08-03', '30.1900'),
+        ('2017-08-04', '30.1800'),
+        ('2017-08-07', '30.2100'),
+        ('2017-08-08', '30.1800'),
+        ('2017-08-09', '30.2400'),
+        ('2017-08-10', '30.3400'),
+        ('2017-08-11', '30.3500'),
+        ('2017-08-14', '30.2800'),
+        ('2017-08-15', '30.3200'),
+        ('2017-08-16', '30.3200'),
+        ('2017-08-17', '30.3200'),
+        ('2017-08-18', '30.3300'),
+        ('2017-08-21', '30.3100'),
+        ('2017-08-22', '30.2800'),
+        ('2017-08-23', '30.2600'),
+        ('2017-08-24', '30.2400'),
+        ('2017-08-25', '30.0900'),
+        ('2017-08-28', '30.1300'),
+        ('2017-08-29', '30.0700'),
+        ('2017-08-30', '30.1300'),
+        ('2017-08-31', '30.1300'),
+        ('2017-09-01', '30.0600'),
+        ('2017-09-04', NULL),
+        ('2017-09-05', '30.0500'),
+        ('2017-09-06', '30.0800'),
+        ('2017-09-07', '29.9300'),
+        ('2017-09-08', '30.0500'),
+        ('2017-09-11', '30.0100'),
+        ('2017-09-12', '30.0400'),
+        ('2017-09-13', '30.0500'),
+        ('2017-09-14', '30.1000'),
+        ('2017-09-15', '30.0600'),
+        ('2017-09-18', '30.1400'),
+        ('2017-09-19', '30.1200'),
+        ('2017-09-20', '30.0800'),
+        ('2017-09-21', '30.1900'),
+        ('2017-09-22', '30.1600'),
+        ('2017-09-25', '30.2500'),
+        ('2017-09-26', '30.2300'),
+        ('2017-09-27', '30.3100'),
+        ('2017-09-28', '30.3700'),
+        ('2017-09-29', '30.3300'),
+        ('2017-10-02', '30.3800'),
+        ('2017-10-03', '30.4400'),
+        ('2017-10-04', '30.4300'),
+        ('2017-10-05', '30.3300'),
+        ('2017-10-06', '30.3600'),
+        ('2017-10-09', NULL),
+        ('2017-10-10', '30.2800'),
+        ('2017-10-11', '30.2300'),
+        ('2017-10-12', '30.1700'),
+        ('2017-10-13', '30.1300'),
+        ('2017-10-16', '30.1400'),
+        ('2017-10-17', '30.1800'),
+        ('2017-10-18', '30.2000'),
+        ('2017-10-19', '30.2300'),
+        ('2017-10-20', '30.2200'),
+        ('2017-10-23', '30.2500'),
+        ('2017-10-24', '30.2800'),
+        ('2017-10-25', '30.2400'),
+        ('2017-10-26', '30.2200'),
+        ('2017-10-27', '30.2300'),
+        ('2017-10-30', '30.2300'),
+        ('2017-10-31', '30.1200'),
+        ('2017-11-01', '30.1300'),
+        ('2017-11-02', '30.1900'),
+        ('2017-11-03', '30.2100'),
+        ('2017-11-06', '30.1600'),
+        ('2017-11-07', '30.1900'),
+        ('2017-11-08', '30.1500'),
+        ('2017-11-09', '30.2000'),
+        ('2017-11-10', NULL),
+        ('2017-11-13', '30.1800'),
+        ('2017-11-14', '30.1300'),
+        ('2017-11-15', '30.0600'),
+        ('2017-11-16', '30.0500'),
+        ('2017-11-17', '30.0500'),
+        ('2017-11-20', '30.0500'),
+        ('2017-11-21', '29.9700'),
+        ('2017-11-22', '29.9700'),
+        ('2017-11-23', NULL),
+        ('2017-11-24', '29.9800'),
+        ('2017-11-27', '30.0200'),
+        ('2017-11-28', '29.9800'),
+        ('2017-11-29', '30.0200'),
+        ('2017-11-30', '29.9800'),
+        ('2017-12-01', '30.0200'),
+        ('2017-12-04', '29.9800'),
+        ('2017-12-05', '30.0300'),
+        ('2017-12-06', '30.0400'),
+        ('2017-12-07', '30.0300'),
+        ('2017-12-08', '30.0200'),
+        ('2017-12-11', '30.0200'),
+        ('2017-12-12', '30.0500'),
+        ('2017-12-13', '30.0100'),
+        ('2017-12-14', '30.0000'),
+        ('2017-12-15', '29.9500'),
+        ('2017-12-18', '29.9500'),
+        ('2017-12-19', '29.9500'),
+        ('2017-12-20', '29.9600'),
+        ('2017-12-21', '29.9300'),
+        ('2017-12-22', '29.8900'),
+        ('2017-12-25', NULL),
+        ('2017-12-26', '29.8900'),
+        ('2017-12-27', '29.9500'),
+        ('2017-12-28', '29.7800'),
+        ('2017-12-29', '29.6400'),
+        ('2018-01-01', NULL),
+        ('2018-01-02', '29.5800'),
+        ('2018-01-03', '29.5700'),
+        ('2018-01-04', '29.6000'),
+        ('2018-01-05', '29.4700'),
+        ('2018-01-08', '29.5200'),
+        ('2018-01-09', '29.6100'),
+        ('2018-01-10', '29.5800'),
+        ('2018-01-11', '29.5800'),
+        ('2018-01-12', '29.6000'),
+        ('2018-01-15', NULL),
+        ('2018-01-16', '29.5700'),
+        ('2018-01-17', '29.5700'),
+        ('2018-01-18', '29.4800'),
+        ('2018-01-19', '29.3100'),
+        ('2018-01-22', '29.2500'),
+        ('2018-01-23', '29.2300'),
+        ('2018-01-24', '29.1100'),
+        ('2018-01-25', '29.0500'),
+        ('2018-01-26', '29.1200'),
+        ('2018-01-29', '29.1900'),
+        ('2018-01-30', '29.2600'),
+        ('2018-01-31', '29.1600'),
+        ('2018-02-01', '29.2100'),
+        ('2018-02-02', '29.3200'),
+        ('2018-02-05', '29.3200'),
+        ('2018-02-06', '29.2700'),
+        ('2018-02-07', '29.2400'),
+        ('2018-02-08', '29.4200'),
+        ('2018-02-09', '29.3500'),
+        ('2018-02-12', '29.3400'),
+        ('2018-02-13', '29.2900'),
+        ('2018-02-14', '29.1300'),
+        ('2018-02-15', '29.0300'),
+        ('2018-02-16', '29.0800'),
+        ('2018-02-19', NULL),
+        ('2018-02-20', '29.1300'),
+        ('2018-02-21', '29.1900'),
+        ('2018-02-22', '29.2500'),
+        ('2018-02-23', '29.2700'),
+        ('2018-02-26', '29.22'),
+        ('2018-02-27', '29.29'),
+        ('2018-02-28', '29.32'),
+        ('2018-03-01', '29.31'),
+        ('2018-03-02', '29.29'),
+        ('2018-03-05', '29.27'),
+        ('2018-03-06', '29.21'),
+        ('2018-03-07', '29.29'),
+        ('2018-03-08', '29.35'),
+        ('2018-03-09', '29.24'),
+        ('2018-03-12', '29.25'),
+        ('2018-03-13', '29.22'),
+        ('2018-03-14', '29.23'),
+        ('2018-03-15', '29.15'),
+        ('2018-03-16', '29.13'),
+        ('2018-03-19', '29.17'),
+        ('2018-03-20', '29.16'),
+        ('2018-03-21', '29.16'),
+        ('2018-03-22', '29.19'),
+        ('2018-03-23', '29.18'),
+        ('2018-03-26', '29.1'),
+        ('2018-03-27', '29.13'),
+        ('2018-03-28', '29.12'),
+        ('2018-03-29', '29.13'),
+        ('2018-03-30', '29.1'),
+        ('2018-04-02', '29.14'),
+        ('2018-04-03', '29.16'),
+        ('2018-04-04', '29.23'),
+        ('2018-04-05', '29.24'),
+        ('2018-04-06', '29.29'),
+        ('2018-04-09', '29.24'),
+        ('2018-04-10', '29.2'),
+        ('2018-04-11', '29.2'),
+        ('2018-04-12', '29.31'),
+        ('2018-04-13', '29.32'),
+        ('2018-04-16', '29.35'),
+        ('2018-04-17', '29.36'),
+        ('2018-04-18', '29.31'),
+        ('2018-04-19', '29.39'),
+        ('2018-04-20', '29.48'),
+        ('2018-04-23', '29.58'),
+        ('2018-04-24', '29.57'),
+        ('2018-04-25', '29.72'),
+        ('2018-04-26', '29.68'),
+        ('2018-04-27', '29.54'),
+        ('2018-04-30', '29.6'),
+        ('2018-05-01', '29.68'),
+        ('2018-05-02', '29.76'),
+        ('2018-05-03', '29.71'),
+        ('2018-05-04', '29.7'),
+        ('2018-05-07', '29.82'),
+        ('2018-05-08', '29.8'),
+        ('2018-05-09', '29.92'),
+        ('2018-05-10', '29.75'),
+        ('2018-05-11', '29.77'),
+        ('2018-05-14', '29.77'),
+        ('2018-05-15', '29.89'),
+        ('2018-05-16', '29.87'),
+        ('2018-05-17', '29.93'),
+        ('2018-05-18', '29.95'),
+        ('2018-05-21', '29.98'),
+        ('2018-05-22', '29.84'),
+        ('2018-05-23', '29.98'),
+        ('2018-05-24', '29.97'),
+        ('2018-05-25', '29.94'),
+        ('2018-05-28', NULL),
+        ('2018-05-29', '30.09'),
+        ('2018-05-30', '29.97'),
+        ('2018-05-31', '29.99'),
+        ('2018-06-01', '29.82'),
+        ('2018-06-04', '29.77'),
+        ('2018-06-05', '29.84'),
+        ('2018-06-06', '29.7'),
+        ('2018-06-07', '29.75'),
+        ('2018-06-08', '29.87'),
+        ('2018-06-11', '29.82'),
+        ('2018-06-12', '29.83'),
+        ('2018-06-13', '29.82'),
+        ('2018-06-14', '29.91'),
+        ('2018-06-15', '30.13'),
+        ('2018-06-18', '30.18'),
+        ('2018-06-19', '30.16'),
+        ('2018-06-20', '30.18'),
+        ('2018-06-21', '30.33'),
+        ('2018-06-22', '30.35'),
+        ('2018-06-25', '30.39'),
+        ('2018-06-26', '30.41'),
+        ('2018-06-27', '30.51'),
+        ('2018-06-28', '30.58'),
+        ('2018-06-29', '30.43'),
+        ('2018-07-02', '30.56'),
+        ('2018-07-03', '30.52'),
+        ('2018-07-04', NULL),
+        ('2018-07-05', '30.53'),
+        ('2018-07-06', '30.46'),
+        ('2018-07-09', '30.36'),
+        ('2018-07-10', '30.38'),
+        ('2018-07-11', '30.56'),
+        ('2018-07-12', '30.46'),
+        ('2018-07-13', '30.58'),
+        ('2018-07-16', '30.54'),
+        ('2018-07-17', '30.53'),
+        ('2018-07-18', '30.61'),
+        ('2018-07-19', '30.69'),
+        ('2018-07-20', '30.59'),
+        ('2018-07-23', '30.64'),
+        ('2018-07-24', '30.63'),
+        ('2018-07-25', '30.51'),
+        ('2018-07-26', '30.58'),
+        ('2018-07-27', '30.56'),
+        ('2018-07-30', '30.57'),
+        ('2018-07-31', '30.54'),
+        ('2018-08-01', '30.63'),
+        ('2018-08-02', '30.71'),
+        ('2018-08-03', '30.57'),
+        ('2018-08-06', '30.63'),
+        ('2018-08-07', '30.56'),
+        ('2018-08-08', '30.6'),
+        ('2018-08-09', '30.64'),
+        ('2018-08-10', '30.77'),
+        ('2018-08-13', '30.83'),
+        ('2018-08-14', '30.83'),
+        ('2018-08-15', '30.85'),
+        ('2018-08-16', '30.76'),
+        ('2018-08-17', '30.76'),
+        ('2018-08-20', '30.76'),
+        ('2018-08-21', '30.7'),
+        ('2018-08-22', '30.66'),
+        ('2018-08-23', '30.81'),
+        ('2018-08-24', '30.66'),
+        ('2018-08-27', '30.68'),
+        ('2018-08-28', '30.64'),
+        ('2018-08-29', '30.71'),
+        ('2018-08-30', '30.73'),
+        ('2018-08-31', '30.7'),
+        ('2018-09-03', NULL),
+        ('2018-09-04', '30.79'),
+        ('2018-09-05', '30.79'),
+        ('2018-09-06', '30.78'),
+        ('2018-09-07', '30.77'),
+        ('2018-09-10', '30.84'),
+        ('2018-09-11', '30.81'),
+        ('2018-09-12', '30.74'),
+        ('2018-09-13', '30.79'),
+        ('2018-09-14', '30.77'),
+        ('2018-09-17', '30.77'),
+        ('2018-09-18', '30.79'),
+        ('2018-09-19', '30.75'),
+        ('2018-09-20', '30.76'),
+        ('2018-09-21', '30.65'),
+        ('2018-09-24', '30.69'),
+        ('2018-09-25', '30.66'),
+        ('2018-09-26', '30.65'),
+        ('2018-09-27', '30.52'),
+        ('2018-09-28', '30.46'),
+        ('2018-10-01', '30.54'),
+        ('2018-10-02', '30.55'),
+        ('2018-10-03', '30.72'),
+        ('2018-10-04', '30.84'),
+        ('2018-10-05', '30.91'),
+        ('2018-10-08', NULL),
+        ('2018-10-09', '30.97'),
+        ('2018-10-10', '30.99'),
+        ('2018-10-11', '31.0'),
+        ('2018-10-12', '30.89'),
+        ('2018-10-15', '30.85'),
+        ('2018-10-16', '30.82'),
+        ('2018-10-17', '30.83'),
+        ('2018-10-18', '30.98'),
+        ('2018-10-19', '30.88'),
+        ('2018-10-22', '30.92'),
+        ('2018-10-23', '30.97'),
+        ('2018-10-24', '30.99'),
+        ('2018-10-25', '30.93'),
+        ('2018-10-26', '31.0'),
+        ('2018-10-29', '30.96'),
+        ('2018-10-30', '30.93'),
+        ('2018-10-31', '30.94'),
+        ('2018-11-01', '30.75'),
+        ('2018-11-02', '30.57'),
+        ('2018-11-05', '30.7'),
+        ('2018-11-06', '30.79'),
+        ('2018-11-07', '30.64'),
+        ('2018-11-08', '30.68'),
+        ('2018-11-09', '30.79'),
+        ('2018-11-12', NULL),
+        ('2018-11-13', '30.88'),
+        ('2018-11-14', '30.88'),
+        ('2018-11-15', '30.81'),
+        ('2018-11-16', '30.8'),
+        ('2018-11-19', '30.89'),
+        ('2018-11-20', '30.94'),
+        ('2018-11-21', '30.84'),
+        ('2018-11-22', NULL),
+        ('2018-11-23', '30.93'),
+        ('2018-11-26', '30.85'),
+        ('2018-11-27', '30.9'),
+        ('2018-11-28', '30.86'),
+        ('2018-11-29', '30.79'),
+        ('2018-11-30', '30.83'),
+        ('2018-12-03', '30.67'),
+        ('2018-12-04', '30.71'),
+        ('2018-12-05', NULL),
+        ('2018-12-06', '30.91'),
+        ('2018-12-07', '30.92'),
+        ('2018-12-10', '30.9'),
+        ('2018-12-11', '30.86'),
+        ('2018-12-12', '30.79'),
+        ('2018-12-13', '30.84'),
+        ('2018-12-14', '30.86'),
+        ('2018-12-17', '30.8'),
+        ('2018-12-18', '30.81'),
+        ('2018-12-19', '30.72'),
+        ('2018-12-20', '30.78'),
+        ('2018-12-21', '30.74'),
+        ('2018-12-24', NULL),
+        ('2018-12-25', NULL),
+        ('2018-12-26', '30.81'),
+        ('2018-12-27', '30.8'),
+        ('2018-12-28', '30.55'),
+        ('2018-12-31', '30.61'),
+        ('2019-01-01', NULL),
+        ('2019-01-02', '30.78'),
+        ('2019-01-03', '30.86'),
+        ('2019-01-04', '30.8'),
+        ('2019-01-07', '30.8'),
+        ('2019-01-08', '30.84'),
+        ('2019-01-09', '30.77'),
+        ('2019-01-10', '30.79'),
+        ('2019-01-11', '30.79'),
+        ('2019-01-14', NULL),
+        ('2019-01-15', '30.82'),
+        ('2019-01-16', '30.82'),
+        ('2019-01-17', '30.86'),
+        ('2019-01-18', '30.86'),
+        ('2019-01-21', NULL),
+        ('2019-01-22', '30.89'),
+        ('2019-01-23', '30.86'),
+        ('2019-01-24', '30.87'),
+        ('2019-01-25', '30.71'),
+        ('2019-01-28', '30.78'),
+        ('2019-01-29', '30.8'),
+        ('2019-01-30', '30.77'),
+        ('2019-01-31', '30.69'),
+        ('2019-02-01', '30.78'),
+        ('2019-02-04', '30.77'),
+        ('2019-02-05', '30.77'),
+        ('2019-02-06', '30.78'),
+        ('2019-02-07', '30.81'),
+        ('2019-02-08', '30.82'),
+        ('2019-02-11', '30.88'),
+        ('2019-02-12', '30.82'),
+        ('2019-02-13', '30.84'),
+        ('2019-02-14', '30.86'),
+        ('2019-02-15', '30.82'),
+        ('2019-02-18', NULL),
+        ('2019-02-19', '30.81'),
+        ('2019-02-20', NULL),
+        ('2019-02-21', '30.8'),
+        ('2019-02-22', '30.71'),
+        ('2019-02-25', '30.74'),
+        ('2019-02-26', '30.76'),
+        ('2019-02-27', '30.78'),
+        ('2019-02-28', '30.83'),
+        ('2019-03-01', '30.84'),
+        ('2019-03-04', '30.83'),
+        ('2019-03-05', '30.84'),
+        ('2019-03-06', '30.84'),
+        ('2019-03-07', '30.91'),
+        ('2019-03-08', '30.88'),
+        ('2019-03-11', '30.91'),
+        ('2019-03-12', '30.91'),
+        ('2019-03-13', '30.9'),
+        ('2019-03-14', '30.92'),
+        ('2019-03-15', '30.85'),
+        ('2019-03-18', '30.78'),
+        ('2019-03-19', '30.8'),
+        ('2019-03-20', '30.8'),
+        ('2019-03-21', '30.82'),
+        ('2019-03-22', '30.87'),
+        ('2019-03-25', '30.82'),
+        ('2019-03-26', '30.84'),
+        ('2019-03-27', '30.89'),
+        ('2019-03-28', '30.88'),
+        ('2019-03-29', '30.86'),
+        ('2019-04-01', '30.8'),
+        ('2019-04-02', '30.84'),
+        ('2019-04-03', '30.8'),
+        ('2019-04-04', '30.81'),
+        ('2019-04-05', '30.82'),
+        ('2019-04-08', '30.82'),
+        ('2019-04-09', '30.83'),
+        ('2019-04-10', '30.83'),
+        ('2019-04-11', '30.85'),
+        ('2019-04-12', '30.84'),
+        ('2019-04-15', '30.85'),
+        ('2019-04-16', '30.85'),
+        ('2019-04-17', '30.79'),
+        ('2019-04-18', '30.83'),
+        ('2019-04-19', '30.82'),
+        ('2019-04-22', '30.84'),
+        ('2019-04-23', '30.86'),
+        ('2019-04-24', '30.89'),
+        ('2019-04-25', '30.94'),
+        ('2019-04-26', '30.9'),
+        ('2019-04-29', '30.91'),
+        ('2019-04-30', '30.91'),
+        ('2019-05-01', '30.89'),
+        ('2019-05-02', '30.92'),
+        ('2019-05-03', '30.87'),
+        ('2019-05-06', '30.92'),
+        ('2019-05-07', '30.95'),
+        ('2019-05-08', '30.93'),
+        ('2019-05-09', '31.01'),
+        ('2019-05-10', '30.95'),
+        ('2019-05-13', '31.16'),
+        ('2019-05-14', '31.07'),
+        ('2019-05-15', '31.11'),
+        ('2019-05-16', '31.11'),
+        ('2019-05-17', '31.31'),
+        ('2019-05-20', '31.4'),
+        ('2019-05-21', '31.48'),
+        ('2019-05-22', '31.52'),
+        ('2019-05-23', '31.52'),
+        ('2019-05-24', '31.52'),
+        ('2019-05-27', NULL),
+        ('2019-05-28', '31.47'),
+        ('2019-05-29', '31.58'),
+        ('2019-05-30', '31.6'),
+        ('2019-05-31', '31.59'),
+        ('2019-06-03', '31.41'),
+        ('2019-06-04', '31.46'),
+        ('2019-06-05', '31.35'),
+        ('2019-06-06', '31.32'),
+        ('2019-06-07', '31.42'),
+        ('2019-06-10', '31.43'),
+        ('2019-06-11', '31.39'),
+        ('2019-06-12', '31.41'),
+        ('2019-06-13', '31.48'),
+        ('2019-06-14', '31.51'),
+        ('2019-06-17', '31.51'),
+        ('2019-06-18', '31.35'),
+        ('2019-06-19', '31.51'),
+        ('2019-06-20', '30.99'),
+        ('2019-06-21', '31.02'),
+        ('2019-06-24', '31.03'),
+        ('2019-06-25', '31.09'),
+        ('2019-06-26', '31.05'),
+        ('2019-06-27', '31.05'),
+        ('2019-06-28', '31.01'),
+        ('2019-07-01', '30.97'),
+        ('2019-07-02', '31.04'),
+        ('2019-07-03', '31.08'),
+        ('2019-07-04', NULL),
+        ('2019-07-05', '31.18'),
+        ('2019-07-08', '31.17'),
+        ('2019-07-09', '31.17'),
+        ('2019-07-10', '31.13'),
+        ('2019-07-11', '31.02'),
+        ('2019-07-12', '31.07'),
+        ('2019-07-15', '31.04'),
+        ('2019-07-16', '31.04'),
+        ('2019-07-17', '31.07'),
+        ('2019-07-18', '31.04'),
+        ('2019-07-19', '31.04'),
+        ('2019-07-22', '31.07'),
+        ('2019-07-23', '31.07'),
+        ('2019-07-24', '31.08'),
+        ('2019-07-25', '31.08'),
+        ('2019-07-26', '31.08'),
+        ('2019-07-29', '31.1'),
+        ('2019-07-30', '31.06'),
+        ('2019-07-31', '31.09'),
+        ('2019-08-01', '31.15'),
+        ('2019-08-02', '31.38'),
+        ('2019-08-05', '31.63'),
+        ('2019-08-06', '31.48'),
+        ('2019-08-07', '31.51'),
+        ('2019-08-08', '31.34'),
+        ('2019-08-09', '31.41'),
+        ('2019-08-12', '31.4'),
+        ('2019-08-13', '31.5'),
+        ('2019-08-14', '31.36'),
+        ('2019-08-15', '31.33'),
+        ('2019-08-16', '31.3'),
+        ('2019-08-19', '31.36'),
+        ('2019-08-20', '31.39'),
+        ('2019-08-21', '31.33'),
+        ('2019-08-22', '31.36'),
+        ('2019-08-23', '31.43'),
+        ('2019-08-26', '31.41'),
+        ('2019-08-27', '31.39'),
+        ('2019-08-28', '31.43'),
+        ('2019-08-29', '31.34'),
+        ('2019-08-30', '31.32'),
+        ('2019-09-02', NULL),
+        ('2019-09-03', '31.38'),
+        ('2019-09-04', '31.3'),
+        ('2019-09-05', '31.26'),
+        ('2019-09-06', '31.22'),
+        ('2019-09-09', '31.21'),
+        ('2019-09-10', '31.22'),
+        ('2019-09-11', '31.13'),
+        ('2019-09-12', '31.03'),
+        ('2019-09-13', '30.88'),
+        ('2019-09-16', '30.9'),
+        ('2019-09-17', '30.95'),
+        ('2019-09-18', '30.95'),
+        ('2019-09-19', '30.98'),
+        ('2019-09-20', '30.93'),
+        ('2019-09-23', '30.98'),
+        ('2019-09-24', '31.01'),
+        ('2019-09-25', '31.04'),
+        ('2019-09-26', '31.02'),
+        ('2019-09-27', '31.05'),
+        ('2019-09-30', '31.05'),
+        ('2019-10-01', '31.07'),
+        ('2019-10-02', '31.06'),
+        ('2019-10-03', '30.97'),
+        ('2019-10-04', '30.92'),
+        ('2019-10-07', '30.89'),
+        ('2019-10-08', '30.81'),
+        ('2019-10-09', '30.83'),
+        ('2019-10-10', '30.75'),
+        ('2019-10-11', '30.75'),
+        ('2019-10-14', NULL),
+        ('2019-10-15', '30.62'),
+        ('2019-10-16', '30.69'),
+        ('2019-10-17', '30.63'),
+        ('2019-10-18', '30.59'),
+        ('2019-10-21', '30.56'),
+        ('2019-10-22', '30.58'),
+        ('2019-10-23', '30.6'),
+        ('2019-10-24', '30.57'),
+        ('2019-10-25', '30.59'),
+        ('2019-10-28', '30.56'),
+        ('2019-10-29', '30.52'),
+        ('2019-10-30', '30.43'),
+        ('2019-10-31', '30.44'),
+        ('2019-11-01', '30.45'),
+        ('2019-11-04', '30.42'),
+        ('2019-11-05', '30.39'),
+        ('2019-11-06', '30.39'),
+        ('2019-11-07', '30.35'),
+        ('2019-11-08', '30.37'),
+        ('2019-11-11', NULL),
+        ('2019-11-12', '30.41'),
+        ('2019-11-13', '30.5'),
+        ('2019-11-14', '30.55'),
+        ('2019-11-15', '30.5'),
+        ('2019-11-18', '30.5'),
+        ('2019-11-19', '30.5'),
+        ('2019-11-20', '30.5'),
+        ('2019-11-21', '30.52'),
+        ('2019-11-22', '30.51'),
+        ('2019-11-25', '30.51'),
+        ('2019-11-26', '30.51'),
+        ('2019-11-27', '30.5'),
+        ('2019-11-28', NULL),
+        ('2019-11-29', '30.5'),
+        ('2019-12-02', '30.51'),
+        ('2019-12-03', '30.5'),
+        ('2019-12-04', '30.5'),
+        ('2019-12-05', '30.48'),
+        ('2019-12-06', '30.48'),
+        ('2019-12-09', '30.47'),
+        ('2019-12-10', '30.47'),
+        ('2019-12-11', '30.45'),
+        ('2019-12-12', '30.22'),
+        ('2019-12-13', '30.24'),
+        ('2019-12-16', '30.11'),
+        ('2019-12-17', '30.13'),
+        ('2019-12-18', '30.15'),
+        ('2019-12-19', '30.16'),
+        ('2019-12-20', '30.14'),
+        ('2019-12-23', '30.14'),
+        ('2019-12-24', '30.14'),
+        ('2019-12-25', NULL),
+        ('2019-12-26', '30.11'),
+        ('2019-12-27', '30.09'),
+        ('2019-12-30', '30.04'),
+        ('2019-12-31', '29.91'),
+        ('2020-01-01', NULL),
+        ('2020-01-02', '29.99'),
+        ('2020-01-03', '30.03'),
+        ('2020-01-06', '30.06'),
+        ('2020-01-07', '30.05'),
+        ('2020-01-08', '30.02'),
+        ('2020-01-09', '29.95'),
+        ('2020-01-10', '29.97'),
+        ('2020-01-13', '29.88'),
+        ('2020-01-14', '29.89'),
+        ('2020-01-15', '29.91'),
+        ('2020-01-16', '29.93'),
+        ('2020-01-17', '29.93'),
+        ('2020-01-20', NULL),
+        ('2020-01-21', '29.98'),
+        ('2020-01-22', '29.97'),
+        ('2020-01-23', '30.04'),
+        ('2020-01-24', '30.03'),
+        ('2020-01-27', '30.1'),
+        ('2020-01-28', '30.1'),
+        ('2020-01-29', '30.1'),
+        ('2020-01-30', '30.26'),
+        ('2020-01-31', '30.23'),
+        ('2020-02-03', '30.29'),
+        ('2020-02-04', '30.12'),
+        ('2020-02-05', '30.0'),
+        ('2020-02-06', '30.04'),
+        ('2020-02-07', '30.1'),
+        ('2020-02-10', '30.08'),
+        ('2020-02-11', '30.02'),
+        ('2020-02-12', '29.98'),
+        ('2020-02-13', '29.98'),
+        ('2020-02-14', '30.02'),
+        ('2020-02-17', NULL),
+        ('2020-02-18', '30.11'),
+        ('2020-02-19', '30.11'),
+        ('2020-02-20', '30.26'),
+        ('2020-02-21', '30.4'),
+        ('2020-02-24', '30.45'),
+        ('2020-02-25', '30.34'),
+        ('2020-02-26', '30.28'),
+        ('2020-02-27', '30.23'),
+        ('2020-02-28', '30.18'),
+        ('2020-03-02', '29.88'),
+        ('2020-03-03', '29.82'),
+        ('2020-03-04', '29.92'),
+        ('2020-03-05', '29.91'),
+        ('2020-03-06', '29.94'),
+        ('2020-03-09', '29.97'),
+        ('2020-03-10', '29.91'),
+        ('2020-03-11', '30.07'),
+        ('2020-03-12', '30.18'),
+        ('2020-03-13', '30.13'),
+        ('2020-03-16', '30.19'),
+        ('2020-03-17', '30.21'),
+        ('2020-03-18', '30.37'),
+        ('2020-03-19', '30.4'),
+        ('2020-03-20', '30.3'),
+        ('2020-03-23', '30.33'),
+        ('2020-03-24', '30.16'),
+        ('2020-03-25', '30.29'),
+        ('2020-03-26', '30.19'),
+        ('2020-03-27', '30.21'),
+        ('2020-03-30', '30.24'),
+        ('2020-03-31', '30.25'),
+        ('2020-04-01', '30.29'),
+        ('2020-04-02', '30.2'),
+        ('2020-04-03', '30.26'),
+        ('2020-04-06', '30.21'),
+        ('2020-04-07', '30.08'),
+        ('2020-04-08', '30.11'),
+        ('2020-04-09', '30.01'),
+        ('2020-04-10', '30.06'),
+        ('2020-04-13', '30.06'),
+        ('2020-04-14', '30.07'),
+        ('2020-04-15', '30.04'),
+        ('2020-04-16', '30.11'),
+        ('2020-04-17', '30.07'),
+        ('2020-04-20', '30.04'),
+        ('2020-04-21', '30.1'),
+        ('2020-04-22', '30.1'),
+        ('2020-04-23', '30.08'),
+        ('2020-04-24', '30.1'),
+        ('2020-04-27', '30.02'),
+        ('2020-04-28', '29.99'),
+        ('2020-04-29', '29.89'),
+        ('2020-04-30', '29.72'),
+        ('2020-05-01', '29.72'),
+        ('2020-05-04', '29.82'),
+        ('2020-05-05', '29.84'),
+        ('2020-05-06', '29.9'),
+        ('2020-05-07', '29.9'),
+        ('2020-05-08', '29.87'),
+        ('2020-05-11', '29.87'),
+        ('2020-05-12', '29.88'),
+        ('2020-05-13', '29.91'),
+        ('2020-05-14', '29.91'),
+        ('2020-05-15', '29.94'),
+        ('2020-05-18', '29.96'),
+        ('2020-05-19', '29.94'),
+        ('2020-05-20', '29.97'),
+        ('2020-05-21', '29.91'),
+        ('2020-05-22', '30.08'),
+        ('2020-05-25', NULL),
+        ('2020-05-26', '29.97'),
+        ('2020-05-27', '30.04'),
+        ('2020-05-28', '29.97'),
+        ('2020-05-29', '30.01'),
+        ('2020-06-01', '29.91'),
+        ('2020-06-02', '29.91'),
+        ('2020-06-03', '29.88'),
+        ('2020-06-04', '29.9'),
+        ('2020-06-05', '29.78'),
+        ('2020-06-08', '29.75'),
+        ('2020-06-09', '29.68'),
+        ('2020-06-10', '29.54'),
+        ('2020-06-11', '29.65'),
+        ('2020-06-12', '29.63'),
+        ('2020-06-15', '29.67'),
+        ('2020-06-16', '29.65'),
+        ('2020-06-17', '29.64'),
+        ('2020-06-18', '29.59'),
+        ('2020-06-19', '29.59'),
+        ('2020-06-22', '29.6'),
+        ('2020-06-23', '29.54'),
+        ('2020-06-24', '29.52'),
+        ('2020-06-25', '29.52'),
+        ('2020-06-26', '29.51'),
+        ('2020-06-29', '29.45'),
+        ('2020-06-30', '29.44'),
+        ('2020-07-01', '29.43'),
+        ('2020-07-02', '29.45'),
+        ('2020-07-03', NULL),
+        ('2020-07-06', '29.4'),
+        ('2020-07-07', '29.45'),
+        ('2020-07-08', '29.43'),
+        ('2020-07-09', '29.42'),
+        ('2020-07-10', '29.45'),
+        ('2020-07-13', '29.42'),
+        ('2020-07-14', '29.45'),
+        ('2020-07-15', '29.5'),
+        ('2020-07-16', '29.44'),
+        ('2020-07-17', '29.46'),
+        ('2020-07-20', '29.45'),
+        ('2020-07-21', '29.4'),
+        ('2020-07-22', '29.41'),
+        ('2020-07-23', '29.41'),
+        ('2020-07-24', '29.45'),
+        ('2020-07-27', '29.31'),
+        ('2020-07-28', '29.3'),
+        ('2020-07-29', '29.26'),
+        ('2020-07-30', '29.32'),
+        ('2020-07-31', '29.34'),
+        ('2020-08-03', '29.35'),
+        ('2020-08-04', '29.38'),
+        ('2020-08-05', '29.34'),
+        ('2020-08-06', '29.37'),
+        ('2020-08-07', '29.39'),
+        ('2020-08-10', '29.39'),
+        ('2020-08-11', '29.39'),
+        ('2020-08-12', '29.37'),
+        ('2020-08-13', '29.43'),
+        ('2020-08-14', '29.38'),
+        ('2020-08-17', '29.38'),
+        ('2020-08-18', '29.39'),
+        ('2020-08-19', '29.37'),
+        ('2020-08-20', '29.42'),
+        ('2020-08-21', '29.4'),
+        ('2020-08-24', '29.38'),
+        ('2020-08-25', '29.38'),
+        ('2020-08-26', '29.34'),
+        ('2020-08-27', '29.36'),
+        ('2020-08-28', '29.34'),
+        ('2020-08-31', '29.37'),
+        ('2020-09-01', '29.36'),
+        ('2020-09-02', '29.36'),
+        ('2020-09-03', '29.35'),
+        ('2020-09-04', '29.32'),
+        ('2020-09-07', NULL),
+        ('2020-09-08', '29.25'),
+        ('2020-09-09', '29.29'),
+        ('2020-09-10', '29.27'),
+        ('2020-09-11', '29.3'),
+        ('2020-09-14', '29.23'),
+        ('2020-09-15', '29.23'),
+        ('2020-09-16', '29.21'),
+        ('2020-09-17', '29.14'),
+        ('2020-09-18', '29.02'),
+        ('2020-09-21', '28.99'),
+        ('2020-09-22', '29.05'),
+        ('2020-09-23', '29.15'),
+        ('2020-09-24', '29.31'),
+        ('2020-09-25', '29.25'),
+        ('2020-09-28', '29.08'),
+        ('2020-09-29', '29.03'),
+        ('2020-09-30', '28.95'),
+        ('2020-10-01', '28.86'),
+        ('2020-10-02', '28.91'),
+        ('2020-10-05', '28.75'),
+        ('2020-10-06', '28.79'),
+        ('2020-10-07', '28.66'),
+        ('2020-10-08', '28.65'),
+        ('2020-10-09', '28.62'),
+        ('2020-10-12', NULL),
+        ('2020-10-13', '28.74'),
+        ('2020-10-14', '28.76'),
+        ('2020-10-15', '28.77'),
+        ('2020-10-16', '28.75'),
+        ('2020-10-19', '28.73'),
+        ('2020-10-20', '28.7'),
+        ('2020-10-21', '28.67'),
+        ('2020-10-22', '28.68'),
+        ('2020-10-23', '28.65'),
+        ('2020-10-26', '28.62'),
+        ('2020-10-27', '28.56'),
+        ('2020-10-28', '28.57'),
+        ('2020-10-29', '28.59'),
+        ('2020-10-30', '28.57'),
+        ('2020-11-02', '28.55'),
+        ('2020-11-03', '28.61'),
+        ('2020-11-04', '28.6'),
+        ('2020-11-05', '28.54'),
+        ('2020-11-06', '28.59'),
+        ('2020-11-09', '28.58'),
+        ('2020-11-10', '28.55'),
+        ('2020-11-11', NULL),
+        ('2020-11-12', '28.5'),
+        ('2020-11-13', '28.51'),
+        ('2020-11-16', '28.52'),
+        ('2020-11-17', '28.53'),
+        ('2020-11-18', '28.5'),
+        ('2020-11-19', '28.52'),
+        ('2020-11-20', '28.52'),
+        ('2020-11-23', '28.52'),
+        ('2020-11-24', '28.5'),
+        ('2020-11-25', '28.48'),
+        ('2020-11-26', NULL),
+        ('2020-11-27', NULL),
+        ('2020-11-30', '28.55'),
+        ('2020-12-01', '28.54'),
+        ('2020-12-02', '28.49'),
+        ('2020-12-03', '28.39'),
+        ('2020-12-04', '28.21'),
+        ('2020-12-07', '28.25'),
+        ('2020-12-08', '28.24'),
+        ('2020-12-09', '28.19'),
+        ('2020-12-10', '28.23'),
+        ('2020-12-11', '28.17'),
+        ('2020-12-14', '28.14'),
+        ('2020-12-15', '28.12'),
+        ('2020-12-16', '28.15'),
+        ('2020-12-17', '28.13'),
+        ('2020-12-18', '28.14'),
+        ('2020-12-21', '28.16'),
+        ('2020-12-22', '28.18'),
+        ('2020-12-23', '28.14'),
+        ('2020-12-24', NULL),
+        ('2020-12-25', NULL),
+        ('2020-12-28', '28.11'),
+        ('2020-12-29', '28.1'),
+        ('2020-12-30', '28.1'),
+        ('2020-12-31', '28.08'),
+        ('2021-01-01', NULL),
+        ('2021-01-04', '28.08'),
+        ('2021-01-05', '27.99'),
+        ('2021-01-06', '28.0'),
+        ('2021-01-07', '28.02'),
+        ('2021-01-08', '27.98'),
+        ('2021-01-11', '28.0'),
+        ('2021-01-12', '28.0'),
+        ('2021-01-13', '28.01'),
+        ('2021-01-14', '28.01'),
+        ('2021-01-15', '28.02'),
+        ('2021-01-18', NULL),
+        ('2021-01-19', '28.02'),
+        ('2021-01-20', NULL),
+        ('2021-01-21', '27.97'),
+        ('2021-01-22', '27.96'),
+        ('2021-01-25', '27.99'),
+        ('2021-01-26', '28.02'),
+        ('2021-01-27', '28.0'),
+        ('2021-01-28', '28.03'),
+        ('2021-01-29', '28.01')
+)
+INSERT INTO
+    exchange_rates (source_currency_id, destination_currency_id, date, rate)
+SELECT
+    source.id,
+    destination.id,
+    CAST(rates.date AS DATE),
+    CAST(rates.rate AS NUMERIC)
+FROM
+    rates,
+    currencies_codes source,
+    currencies_codes destination
+WHERE
+    source.code = 'TWD'
+    AND destination.code = 'USD'
+ON CONFLICT DO NOTHING;
@@ -0,0 +1,15 @@
+CREATE TABLE ingestion_runs (
+    id serial PRIMARY KEY,
+    provider TEXT NOT NULL,
+    started_at TIMESTAMP NOT NULL,
+    finished_at TIMESTAMP NOT NULL,
+    fetched INT NOT NULL,
+    inserted INT NOT NULL,
+    updated INT NOT NULL,
+    skipped INT NOT NULL,
+    rejected INT NOT NULL,
+    -- empty when run succeeded
+    error_message TEXT NOT NULL DEFAULT ''
+);
+
+CREATE INDEX ingestion_runs_started_at_idx ON ingestion_runs (started_at);
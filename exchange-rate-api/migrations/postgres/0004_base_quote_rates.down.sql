@@ -0,0 +1,30 @@
+-- Swaps pairs back, rates are amount of source currency for one unit of destination currency again.
+CREATE TEMPORARY TABLE swapped_exchange_rates AS
+SELECT
+    destination_currency_id AS source_currency_id,
+    source_currency_id AS destination_currency_id,
+    date,
+    rate
+FROM
+    exchange_rates;
+
+DELETE FROM exchange_rates;
+
+INSERT INTO
+    exchange_rates (source_currency_id, destination_currency_id, date, rate)
+SELECT
+    source_currency_id,
+    destination_currency_id,
+    date,
+    rate
+FROM
+    swapped_exchange_rates;
+
+DROP TABLE swapped_exchange_rates;
+
+-- thresholds of alert rules are compared with rates of their pair, so rules follow the swapped pairs
+UPDATE
+    alert_rules
+SET
+    source_currency_id = destination_currency_id,
+    destination_currency_id = source_currency_id;
@@ -0,0 +1,65 @@
+-- Generated by tools/seedgen from data/*.csv, do not edit.
+
+-- USDCHF.csv
+DELETE FROM
+    exchange_rates
+WHERE
+    source_currency_id = (SELECT id FROM currencies_codes WHERE code = 'USD')
+    AND destination_currency_id = (SELECT id FROM currencies_codes WHERE code = 'CHF')
+    AND date BETWEEN '2016-01-29' AND '2021-01-29';
+
+-- USDCNY.csv
+DELETE FROM
+    exchange_rates
+WHERE
+    source_currency_id = (SELECT id FROM currencies_codes WHERE code = 'USD')
+    AND destination_currency_id = (SELECT id FROM currencies_codes WHERE code = 'CNY')
+    AND date BETWEEN '2016-01-29' AND '2021-01-29';
+
+-- USDJPY.csv
+DELETE FROM
+    exchange_rates
+WHERE
+    source_currency_id = (SELECT id FROM currencies_codes WHERE code = 'USD')
+    AND destination_currency_id = (SELECT id FROM currencies_codes WHERE code = 'JPY')
+    AND date BETWEEN '2016-01-29' AND '2021-01-29';
+
+-- USDKRW.csv
+DELETE FROM
+    exchange_rates
+WHERE
+    source_currency_id = (SELECT id FROM currencies_codes WHERE code = 'USD')
+    AND destination_currency_id = (SELECT id FROM currencies_codes WHERE code = 'KRW')
+    AND date BETWEEN '2016-01-29' AND '2021-01-29';
+
+-- USDNOK.csv
+DELETE FROM
+    exchange_rates
+WHERE
+    source_currency_id = (SELECT id FROM currencies_codes WHERE code = 'USD')
+    AND destination_currency_id = (SELECT id FROM currencies_codes WHERE code = 'NOK')
+    AND date BETWEEN '2016-01-29' AND '2021-01-29';
+
+-- USDSEK.csv
+DELETE FROM
+    exchange_rates
+WHERE
+    source_currency_id = (SELECT id FROM currencies_codes WHERE code = 'USD')
+    AND destination_currency_id = (SELECT id FROM currencies_codes WHERE code = 'SEK')
+    AND date BETWEEN '2016-01-29' AND '2021-01-29';
+
+-- USDTHB.csv
+DELETE FROM
+    exchange_rates
+WHERE
+    source_currency_id = (SELECT id FROM currencies_codes WHERE code = 'USD')
+    AND destination_currency_id = (SELECT id FROM currencies_codes WHERE code = 'THB')
+    AND date BETWEEN '2016-01-29' AND '2021-01-29';
+
+-- USDTWD.csv
+DELETE FROM
+    exchange_rates
+WHERE
+    source_currency_id = (SELECT id FROM currencies_codes WHERE code = 'USD')
+    AND destination_currency_id = (SELECT id FROM currencies_codes WHERE code = 'TWD')
+    AND date BETWEEN '2016-01-29' AND '2021-01-29';
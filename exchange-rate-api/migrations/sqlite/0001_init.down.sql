@@ -0,0 +1,3 @@
+DROP TABLE exchange_rates;
+
+DROP TABLE currencies_codes;
@@ -1,7 +1,6 @@
-CREATE USER exchange_rate_api_user WITH PASSWORD '123';
-
+-- Schema of postgres 0001_init limited to exchange rates.
 CREATE TABLE currencies_codes (
-    id serial PRIMARY KEY,
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
     code VARCHAR(3) UNIQUE NOT NULL
 );
 
@@ -18,11 +17,12 @@ VALUES
     ('THB'),
     ('TWD');
 
+-- rate is kept as text, so decimals are not converted to floating point numbers
 CREATE TABLE exchange_rates (
     source_currency_id INT NOT NULL,
     destination_currency_id INT NOT NULL,
     date TIMESTAMP NOT NULL,
-    rate NUMERIC(15, 6),
+    rate TEXT,
     PRIMARY KEY (
         source_currency_id,
         destination_currency_id,
@@ -30,4 +30,4 @@ CREATE TABLE exchange_rates (
     ),
     FOREIGN KEY (source_currency_id) REFERENCES currencies_codes (id),
     FOREIGN KEY (destination_currency_id) REFERENCES currencies_codes (id)
-);
\ No newline at end of file
+);
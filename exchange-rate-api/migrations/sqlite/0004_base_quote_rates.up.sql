@@ -0,0 +1,26 @@
+-- Rates were stored as amount of source currency for one unit of destination currency, e.g. CHF/USD 1.0226 francs
+-- for one dollar. Pairs are swapped, so rates are base/quote: one unit of source currency is rate of destination
+-- currency, e.g. USD/CHF 1.0226. Values are not changed, so no precision is lost.
+-- Rows are swapped through temporary table, as pair stored in both directions would violate primary key in between.
+CREATE TEMPORARY TABLE swapped_exchange_rates AS
+SELECT
+    destination_currency_id AS source_currency_id,
+    source_currency_id AS destination_currency_id,
+    date,
+    rate
+FROM
+    exchange_rates;
+
+DELETE FROM exchange_rates;
+
+INSERT INTO
+    exchange_rates (source_currency_id, destination_currency_id, date, rate)
+SELECT
+    source_currency_id,
+    destination_currency_id,
+    date,
+    rate
+FROM
+    swapped_exchange_rates;
+
+DROP TABLE swapped_exchange_rates;
@@ -0,0 +1,61 @@
+package models
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// AlertRule fires when exchange rate crosses Threshold or when it changes by more than ChangePercent
+// compared to the previous known rate. Exactly one of them has to be set.
+type AlertRule struct {
+	Id            int              `json:"id"`
+	Source        string           `json:"source" binding:"required" example:"CHF"`
+	Destination   string           `json:"destination" binding:"required" example:"USD"`
+	Threshold     *decimal.Decimal `json:"threshold,omitempty" example:"1.00"`
+	ChangePercent *decimal.Decimal `json:"changePercent,omitempty" example:"2"`
+	CallbackUrl   string           `json:"callbackUrl" binding:"required,url" example:"https://example.com/hooks/rates"`
+	// Secret used to sign webhooks, returned only when rule is created.
+	Secret    string    `json:"secret,omitempty"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+type DbAlertRule struct {
+	Id            int
+	Source        int `gorm:"column:source_currency_id"`
+	Destination   int `gorm:"column:destination_currency_id"`
+	Threshold     *decimal.Decimal
+	ChangePercent *decimal.Decimal
+	CallbackUrl   string
+	Secret        string
+	CreatedAt     time.Time
+}
+
+func (DbAlertRule) TableName() string {
+	return "alert_rules"
+}
+
+// AlertEvent is payload of the webhook.
+type AlertEvent struct {
+	AlertRuleId      int              `json:"alertRuleId"`
+	ExchangeRate     ExchangeRate     `json:"exchangeRate"`
+	PreviousRate     *decimal.Decimal `json:"previousRate"`
+	PreviousRateDate *time.Time       `json:"previousRateDate"`
+	Threshold        *decimal.Decimal `json:"threshold,omitempty"`
+	ChangePercent    *decimal.Decimal `json:"changePercent,omitempty"`
+}
+
+// WebhookDelivery is a single attempt of webhook delivery.
+type WebhookDelivery struct {
+	Id          int       `json:"id"`
+	AlertRuleId int       `json:"alertRuleId"`
+	Payload     string    `json:"payload"`
+	Attempt     int       `json:"attempt"`
+	StatusCode  *int      `json:"statusCode"`
+	Error       *string   `json:"error"`
+	CreatedAt   time.Time `json:"createdAt"`
+}
+
+func (WebhookDelivery) TableName() string {
+	return "webhook_deliveries"
+}
@@ -0,0 +1,53 @@
+package models
+
+import (
+	"strings"
+	"time"
+)
+
+// ApiKey grants its scopes to requests sending it in X-API-Key header. Only hash of the key is stored.
+type ApiKey struct {
+	Id     int      `json:"id"`
+	Name   string   `json:"name" binding:"required" example:"rates importer"`
+	Scopes []string `json:"scopes" binding:"required,min=1,dive,oneof=read write admin" example:"read,write"`
+	// Prefix identifies key in listings without revealing it.
+	Prefix string `json:"prefix"`
+	// Key is returned only when key is issued.
+	Key       string     `json:"key,omitempty"`
+	CreatedAt time.Time  `json:"createdAt"`
+	RevokedAt *time.Time `json:"revokedAt,omitempty"`
+}
+
+type DbApiKey struct {
+	Id        int
+	Name      string
+	Prefix    string
+	KeyHash   string
+	Scopes    string
+	CreatedAt time.Time
+	RevokedAt *time.Time
+}
+
+func (DbApiKey) TableName() string {
+	return "api_keys"
+}
+
+// Scopes are stored space separated, as in OAuth scope claim.
+func JoinScopes(scopes []string) string {
+	return strings.Join(scopes, " ")
+}
+
+func SplitScopes(scopes string) []string {
+	return strings.Fields(scopes)
+}
+
+func (k DbApiKey) ToApiKey() ApiKey {
+	return ApiKey{
+		Id:        k.Id,
+		Name:      k.Name,
+		Scopes:    SplitScopes(k.Scopes),
+		Prefix:    k.Prefix,
+		CreatedAt: k.CreatedAt,
+		RevokedAt: k.RevokedAt,
+	}
+}
@@ -0,0 +1,42 @@
+package models
+
+import (
+	"github.com/shopspring/decimal"
+)
+
+type ConversionItem struct {
+	Amount   decimal.Decimal `json:"amount" example:"125.50"`
+	Currency string          `json:"currency" binding:"required" example:"CHF"`
+	// Formatted as YYYY-MM-DD
+	Date string `json:"date" binding:"required" example:"2017-05-01"`
+	// Default is USD
+	Target string `json:"target" example:"USD"`
+}
+
+type BatchConversionRequest struct {
+	Items []ConversionItem `json:"items" binding:"required,min=1,max=1000,dive"`
+	// Converted amounts are rounded to minor units of target currency, default is half-even
+	Rounding string `json:"rounding" enums:"half-even,half-up,up,down,ceiling,floor" example:"half-even"`
+	// Returns also amounts before rounding
+	IncludeUnrounded bool `json:"includeUnrounded"`
+}
+
+type ConvertedItem struct {
+	ConversionItem
+	// Rounded to minor units of target currency
+	ConvertedAmount *decimal.Decimal `json:"convertedAmount"`
+	UnroundedAmount *decimal.Decimal `json:"unroundedAmount,omitempty"`
+	// Amount of target currency for one unit of currency
+	Rate *decimal.Decimal `json:"rate"`
+	// Date of the rate used, can be before date of the item when there was no rate for that day
+	RateDate *string `json:"rateDate" example:"2017-05-01"`
+	Error    string  `json:"error,omitempty"`
+}
+
+type BatchConversionResponse struct {
+	Items []ConvertedItem `json:"items"`
+	// Sum of converted amounts per target currency, items which couldn't be converted are skipped
+	// Sum of rounded amounts, so totals match the items
+	Totals          map[string]decimal.Decimal `json:"totals"`
+	UnroundedTotals map[string]decimal.Decimal `json:"unroundedTotals,omitempty"`
+}
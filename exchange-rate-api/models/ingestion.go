@@ -0,0 +1,25 @@
+package models
+
+import "time"
+
+// IngestionRun is outcome of one fetch of rates from provider.
+type IngestionRun struct {
+	Id         int       `json:"id"`
+	Provider   string    `json:"provider" example:"http"`
+	StartedAt  time.Time `json:"startedAt"`
+	FinishedAt time.Time `json:"finishedAt"`
+	// Fetched counts rates of configured pairs returned by provider.
+	Fetched  int `json:"fetched"`
+	Inserted int `json:"inserted"`
+	Updated  int `json:"updated"`
+	// Skipped rates were already stored.
+	Skipped int `json:"skipped"`
+	// Rejected rates failed validation, e.g. with unknown currency.
+	Rejected int `json:"rejected"`
+	// ErrorMessage is empty when run succeeded.
+	ErrorMessage string `json:"errorMessage,omitempty"`
+}
+
+func (IngestionRun) TableName() string {
+	return "ingestion_runs"
+}
@@ -6,11 +6,44 @@ import (
 	"github.com/shopspring/decimal"
 )
 
+// ExchangeRate is quoted as base/quote: one unit of Source (base) currency is Rate units of Destination (quote)
+// currency, e.g. USD/CHF 0.9774 is 0.9774 francs for one dollar. Every pair is stored in one direction only.
 type ExchangeRate struct {
-	Source      string           `json:"source" binding:"required" example:"USD"`
-	Destination string           `json:"destination" binding:"required" example:"CHF"`
-	Date        time.Time        `json:"date" binding:"required" example:"2022-05-01T00:00:00.00Z"`
-	Rate        *decimal.Decimal `json:"rate" example:"1.0456"`
+	// Base currency, one unit of it is worth rate of destination currency
+	Source string `json:"source" binding:"required" example:"USD"`
+	// Quote currency, rate is amount of it for one unit of source currency
+	Destination string    `json:"destination" binding:"required" example:"CHF"`
+	Date        time.Time `json:"date" binding:"required" example:"2022-05-01T00:00:00.00Z"`
+	// Amount of destination currency for one unit of source currency, positive
+	Rate *decimal.Decimal `json:"rate" example:"1.0456"`
+}
+
+// RateScale is the scale of NUMERIC(15, 6) rate column. Memory and sqlite repositories and inverted rates round to it.
+const RateScale = 6
+
+// Inverted returns rate of the pair quoted the other way round, e.g. CHF/USD 1.023123 of USD/CHF 0.9774.
+// Missing rate stays missing.
+func (e ExchangeRate) Inverted() ExchangeRate {
+	inverted := ExchangeRate{Source: e.Destination, Destination: e.Source, Date: e.Date}
+	if e.Rate != nil && !e.Rate.IsZero() {
+		rate := decimal.NewFromInt(1).DivRound(*e.Rate, RateScale)
+		inverted.Rate = &rate
+	}
+	return inverted
+}
+
+// ImportSummary counts rates of import, stored rates are skipped or updated.
+type ImportSummary struct {
+	Inserted int
+	Updated  int
+	Skipped  int
+	// Stored are indexes of inserted and updated rates in imported slice, e.g. to publish them.
+	Stored []int
+}
+
+type CurrencyPair struct {
+	SourceCurrencyId      int
+	DestinationCurrencyId int
 }
 
 type Currency struct {
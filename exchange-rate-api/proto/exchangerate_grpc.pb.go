@@ -0,0 +1,267 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: exchangerate.proto
+
+package exchangeratepb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	ExchangeRates_GetCurrencies_FullMethodName               = "/exchangerate.ExchangeRates/GetCurrencies"
+	ExchangeRates_GetLastExchangeRate_FullMethodName         = "/exchangerate.ExchangeRates/GetLastExchangeRate"
+	ExchangeRates_GetAllExchangeRatesFromDate_FullMethodName = "/exchangerate.ExchangeRates/GetAllExchangeRatesFromDate"
+	ExchangeRates_GetRangeExchangeRate_FullMethodName        = "/exchangerate.ExchangeRates/GetRangeExchangeRate"
+	ExchangeRates_InsertExchangeRate_FullMethodName          = "/exchangerate.ExchangeRates/InsertExchangeRate"
+)
+
+// ExchangeRatesClient is the client API for ExchangeRates service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ExchangeRatesClient interface {
+	// Returns list of all currencies.
+	GetCurrencies(ctx context.Context, in *GetCurrenciesRequest, opts ...grpc.CallOption) (*GetCurrenciesResponse, error)
+	// Returns most recent exchange rate which is not null for source - destination currencies.
+	GetLastExchangeRate(ctx context.Context, in *GetLastExchangeRateRequest, opts ...grpc.CallOption) (*ExchangeRate, error)
+	// Returns all exchange rates for the given date.
+	GetAllExchangeRatesFromDate(ctx context.Context, in *GetAllExchangeRatesFromDateRequest, opts ...grpc.CallOption) (*ExchangeRatesResponse, error)
+	// Returns exchange rates for currencies in the time period.
+	GetRangeExchangeRate(ctx context.Context, in *GetRangeExchangeRateRequest, opts ...grpc.CallOption) (*ExchangeRatesResponse, error)
+	// Inserts new exchange rate.
+	InsertExchangeRate(ctx context.Context, in *InsertExchangeRateRequest, opts ...grpc.CallOption) (*ExchangeRate, error)
+}
+
+type exchangeRatesClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewExchangeRatesClient(cc grpc.ClientConnInterface) ExchangeRatesClient {
+	return &exchangeRatesClient{cc}
+}
+
+func (c *exchangeRatesClient) GetCurrencies(ctx context.Context, in *GetCurrenciesRequest, opts ...grpc.CallOption) (*GetCurrenciesResponse, error) {
+	out := new(GetCurrenciesResponse)
+	err := c.cc.Invoke(ctx, ExchangeRates_GetCurrencies_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exchangeRatesClient) GetLastExchangeRate(ctx context.Context, in *GetLastExchangeRateRequest, opts ...grpc.CallOption) (*ExchangeRate, error) {
+	out := new(ExchangeRate)
+	err := c.cc.Invoke(ctx, ExchangeRates_GetLastExchangeRate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exchangeRatesClient) GetAllExchangeRatesFromDate(ctx context.Context, in *GetAllExchangeRatesFromDateRequest, opts ...grpc.CallOption) (*ExchangeRatesResponse, error) {
+	out := new(ExchangeRatesResponse)
+	err := c.cc.Invoke(ctx, ExchangeRates_GetAllExchangeRatesFromDate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exchangeRatesClient) GetRangeExchangeRate(ctx context.Context, in *GetRangeExchangeRateRequest, opts ...grpc.CallOption) (*ExchangeRatesResponse, error) {
+	out := new(ExchangeRatesResponse)
+	err := c.cc.Invoke(ctx, ExchangeRates_GetRangeExchangeRate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exchangeRatesClient) InsertExchangeRate(ctx context.Context, in *InsertExchangeRateRequest, opts ...grpc.CallOption) (*ExchangeRate, error) {
+	out := new(ExchangeRate)
+	err := c.cc.Invoke(ctx, ExchangeRates_InsertExchangeRate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ExchangeRatesServer is the server API for ExchangeRates service.
+// All implementations must embed UnimplementedExchangeRatesServer
+// for forward compatibility
+type ExchangeRatesServer interface {
+	// Returns list of all currencies.
+	GetCurrencies(context.Context, *GetCurrenciesRequest) (*GetCurrenciesResponse, error)
+	// Returns most recent exchange rate which is not null for source - destination currencies.
+	GetLastExchangeRate(context.Context, *GetLastExchangeRateRequest) (*ExchangeRate, error)
+	// Returns all exchange rates for the given date.
+	GetAllExchangeRatesFromDate(context.Context, *GetAllExchangeRatesFromDateRequest) (*ExchangeRatesResponse, error)
+	// Returns exchange rates for currencies in the time period.
+	GetRangeExchangeRate(context.Context, *GetRangeExchangeRateRequest) (*ExchangeRatesResponse, error)
+	// Inserts new exchange rate.
+	InsertExchangeRate(context.Context, *InsertExchangeRateRequest) (*ExchangeRate, error)
+	mustEmbedUnimplementedExchangeRatesServer()
+}
+
+// UnimplementedExchangeRatesServer must be embedded to have forward compatible implementations.
+type UnimplementedExchangeRatesServer struct {
+}
+
+func (UnimplementedExchangeRatesServer) GetCurrencies(context.Context, *GetCurrenciesRequest) (*GetCurrenciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCurrencies not implemented")
+}
+func (UnimplementedExchangeRatesServer) GetLastExchangeRate(context.Context, *GetLastExchangeRateRequest) (*ExchangeRate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLastExchangeRate not implemented")
+}
+func (UnimplementedExchangeRatesServer) GetAllExchangeRatesFromDate(context.Context, *GetAllExchangeRatesFromDateRequest) (*ExchangeRatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllExchangeRatesFromDate not implemented")
+}
+func (UnimplementedExchangeRatesServer) GetRangeExchangeRate(context.Context, *GetRangeExchangeRateRequest) (*ExchangeRatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRangeExchangeRate not implemented")
+}
+func (UnimplementedExchangeRatesServer) InsertExchangeRate(context.Context, *InsertExchangeRateRequest) (*ExchangeRate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InsertExchangeRate not implemented")
+}
+func (UnimplementedExchangeRatesServer) mustEmbedUnimplementedExchangeRatesServer() {}
+
+// UnsafeExchangeRatesServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ExchangeRatesServer will
+// result in compilation errors.
+type UnsafeExchangeRatesServer interface {
+	mustEmbedUnimplementedExchangeRatesServer()
+}
+
+func RegisterExchangeRatesServer(s grpc.ServiceRegistrar, srv ExchangeRatesServer) {
+	s.RegisterService(&ExchangeRates_ServiceDesc, srv)
+}
+
+func _ExchangeRates_GetCurrencies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCurrenciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExchangeRatesServer).GetCurrencies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExchangeRates_GetCurrencies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExchangeRatesServer).GetCurrencies(ctx, req.(*GetCurrenciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExchangeRates_GetLastExchangeRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLastExchangeRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExchangeRatesServer).GetLastExchangeRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExchangeRates_GetLastExchangeRate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExchangeRatesServer).GetLastExchangeRate(ctx, req.(*GetLastExchangeRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExchangeRates_GetAllExchangeRatesFromDate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllExchangeRatesFromDateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExchangeRatesServer).GetAllExchangeRatesFromDate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExchangeRates_GetAllExchangeRatesFromDate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExchangeRatesServer).GetAllExchangeRatesFromDate(ctx, req.(*GetAllExchangeRatesFromDateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExchangeRates_GetRangeExchangeRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRangeExchangeRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExchangeRatesServer).GetRangeExchangeRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExchangeRates_GetRangeExchangeRate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExchangeRatesServer).GetRangeExchangeRate(ctx, req.(*GetRangeExchangeRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExchangeRates_InsertExchangeRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InsertExchangeRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExchangeRatesServer).InsertExchangeRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExchangeRates_InsertExchangeRate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExchangeRatesServer).InsertExchangeRate(ctx, req.(*InsertExchangeRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ExchangeRates_ServiceDesc is the grpc.ServiceDesc for ExchangeRates service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ExchangeRates_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "exchangerate.ExchangeRates",
+	HandlerType: (*ExchangeRatesServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetCurrencies",
+			Handler:    _ExchangeRates_GetCurrencies_Handler,
+		},
+		{
+			MethodName: "GetLastExchangeRate",
+			Handler:    _ExchangeRates_GetLastExchangeRate_Handler,
+		},
+		{
+			MethodName: "GetAllExchangeRatesFromDate",
+			Handler:    _ExchangeRates_GetAllExchangeRatesFromDate_Handler,
+		},
+		{
+			MethodName: "GetRangeExchangeRate",
+			Handler:    _ExchangeRates_GetRangeExchangeRate_Handler,
+		},
+		{
+			MethodName: "InsertExchangeRate",
+			Handler:    _ExchangeRates_InsertExchangeRate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "exchangerate.proto",
+}
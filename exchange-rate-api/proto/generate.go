@@ -0,0 +1,4 @@
+package exchangeratepb
+
+// Requires buf, protoc-gen-go and protoc-gen-go-grpc to be installed.
+//go:generate buf generate
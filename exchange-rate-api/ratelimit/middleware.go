@@ -0,0 +1,106 @@
+package ratelimit
+
+import (
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/kolan92/exchange-rate-api/auth"
+	"github.com/kolan92/exchange-rate-api/config"
+	customerros "github.com/kolan92/exchange-rate-api/custom-erros"
+	"github.com/kolan92/exchange-rate-api/logging"
+	"go.uber.org/zap"
+)
+
+const (
+	GroupRead       = "read"
+	GroupWrite      = "write"
+	GroupConversion = "conversion"
+	GroupGraphql    = "graphql"
+	GroupAdmin      = "admin"
+	// GroupIp limits all requests of client ip before they are authenticated.
+	GroupIp = "ip"
+)
+
+// NewLimits returns limits of route groups from config, unlimited groups are left out.
+func NewLimits(cfg config.RateLimitConfig) map[string]Limit {
+	limits := make(map[string]Limit)
+	for group, rateLimit := range map[string]config.RateLimit{
+		GroupRead:       cfg.Read,
+		GroupWrite:      cfg.Write,
+		GroupConversion: cfg.Conversion,
+		GroupGraphql:    cfg.Graphql,
+		GroupAdmin:      cfg.Admin,
+		GroupIp:         cfg.Ip,
+	} {
+		if !rateLimit.IsUnlimited() {
+			limits[group] = Limit{Rate: float64(rateLimit.Requests) / rateLimit.Period.Seconds(), Burst: rateLimit.Burst}
+		}
+	}
+	return limits
+}
+
+// Middleware counts requests in a bucket of the route group per authenticated principal,
+// or per client ip when auth is disabled or request is anonymous. Requests over the limit are rejected with 429.
+// It has to be used after auth middleware.
+func Middleware(store Store, groupOf func(g *gin.Context) string, limits map[string]Limit) gin.HandlerFunc {
+	return func(g *gin.Context) {
+		group := groupOf(g)
+		limit, isLimited := limits[group]
+		if !isLimited {
+			g.Next()
+			return
+		}
+
+		take(g, store, group, clientKey(g), limit)
+	}
+}
+
+// IpMiddleware counts all requests per client ip in the bucket of GroupIp. It has to be used before auth middleware,
+// so also requests with invalid credentials are counted.
+func IpMiddleware(store Store, limits map[string]Limit) gin.HandlerFunc {
+	return func(g *gin.Context) {
+		limit, isLimited := limits[GroupIp]
+		if !isLimited {
+			g.Next()
+			return
+		}
+
+		take(g, store, GroupIp, "ip:"+g.ClientIP(), limit)
+	}
+}
+
+func take(g *gin.Context, store Store, group string, key string, limit Limit) {
+	ctx := g.Request.Context()
+	result, err := store.Take(ctx, group+":"+key, limit)
+	if err != nil {
+		logging.FromContext(ctx).Warn("Can't check rate limit, request is allowed", zap.String("group", group), zap.Error(err))
+		g.Next()
+		return
+	}
+
+	g.Header("RateLimit-Limit", strconv.Itoa(result.Limit))
+	g.Header("RateLimit-Remaining", strconv.Itoa(result.Remaining))
+	g.Header("RateLimit-Reset", seconds(result.Reset))
+	if !result.Allowed {
+		g.Header("Retry-After", seconds(result.RetryAfter))
+		customerros.Abort(g, customerros.Newf(customerros.CodeRateLimited, "rate limit of %s requests exceeded", group), logging.RequestId(ctx))
+		return
+	}
+
+	g.Next()
+}
+
+// clientKey relies on trusted proxies of the router, ip from X-Forwarded-For of other clients is ignored.
+func clientKey(g *gin.Context) string {
+	if principal := auth.PrincipalFromContext(g.Request.Context()); principal != nil && !principal.Anonymous {
+		return principal.Subject
+	}
+	return "ip:" + g.ClientIP()
+}
+
+// seconds rounds up, so clients retrying after the given number of seconds are not rejected again.
+func seconds(duration time.Duration) string {
+	return strconv.Itoa(int(math.Ceil(duration.Seconds())))
+}
@@ -0,0 +1,178 @@
+package ratelimit
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/kolan92/exchange-rate-api/auth"
+	"github.com/kolan92/exchange-rate-api/config"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	now   time.Time
+	store *MemoryStore
+)
+
+func setup() {
+	now = time.Date(2022, 04, 30, 10, 00, 00, 0, time.UTC)
+	store = newMemoryStore(func() time.Time { return now })
+}
+
+func TestTakeRejectsRequestsOverBurst(t *testing.T) {
+	setup()
+	limit := Limit{Rate: 1, Burst: 2}
+
+	first, _ := store.Take(context.Background(), "key", limit)
+	second, _ := store.Take(context.Background(), "key", limit)
+	third, _ := store.Take(context.Background(), "key", limit)
+
+	assert.True(t, first.Allowed)
+	assert.Equal(t, 1, first.Remaining)
+	assert.True(t, second.Allowed)
+	assert.False(t, third.Allowed)
+	assert.Equal(t, 0, third.Remaining)
+	assert.Equal(t, time.Second, third.RetryAfter)
+	assert.Equal(t, 2*time.Second, third.Reset)
+}
+
+func TestTakeRefillsBucket(t *testing.T) {
+	setup()
+	limit := Limit{Rate: 0.5, Burst: 1}
+	store.Take(context.Background(), "key", limit)
+
+	now = now.Add(time.Second)
+	rejected, _ := store.Take(context.Background(), "key", limit)
+	now = now.Add(time.Second)
+	allowed, _ := store.Take(context.Background(), "key", limit)
+
+	assert.False(t, rejected.Allowed)
+	assert.Equal(t, time.Second, rejected.RetryAfter)
+	assert.True(t, allowed.Allowed)
+}
+
+func TestTakeCountsKeysSeparately(t *testing.T) {
+	setup()
+	limit := Limit{Rate: 1, Burst: 1}
+	store.Take(context.Background(), "first", limit)
+
+	result, _ := store.Take(context.Background(), "second", limit)
+
+	assert.True(t, result.Allowed)
+}
+
+func TestSweepRemovesFullBuckets(t *testing.T) {
+	setup()
+	store.Take(context.Background(), "fast", Limit{Rate: 1, Burst: 1})
+	store.Take(context.Background(), "slow", Limit{Rate: 1.0 / 3600, Burst: 1})
+
+	now = now.Add(2 * sweepInterval)
+	store.Take(context.Background(), "other", Limit{Rate: 1, Burst: 1})
+
+	assert.NotContains(t, store.buckets, "fast")
+	assert.Contains(t, store.buckets, "slow")
+}
+
+func TestNewLimitsSkipsUnlimitedGroups(t *testing.T) {
+	limits := NewLimits(config.RateLimitConfig{
+		Read: config.RateLimit{Requests: 120, Period: time.Minute, Burst: 10},
+	})
+
+	assert.Equal(t, map[string]Limit{GroupRead: {Rate: 2, Burst: 10}}, limits)
+}
+
+func newRouter(limits map[string]Limit) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.SetTrustedProxies(nil)
+	router.Use(func(g *gin.Context) {
+		if subject := g.GetHeader("Subject"); subject != "" {
+			g.Request = g.Request.WithContext(auth.WithPrincipal(g.Request.Context(), &auth.Principal{Subject: subject}))
+		}
+	})
+	router.Use(Middleware(store, func(g *gin.Context) string { return GroupRead }, limits))
+	router.GET("/rates", func(g *gin.Context) { g.Status(http.StatusOK) })
+	return router
+}
+
+func get(router *gin.Engine, subject string) *httptest.ResponseRecorder {
+	return getForwardedFor(router, subject, "")
+}
+
+func getForwardedFor(router *gin.Engine, subject string, forwardedFor string) *httptest.ResponseRecorder {
+	recorder := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodGet, "/rates", nil)
+	request.Header.Set("Subject", subject)
+	if forwardedFor != "" {
+		request.Header.Set("X-Forwarded-For", forwardedFor)
+	}
+	router.ServeHTTP(recorder, request)
+	return recorder
+}
+
+func TestMiddlewareRejectsRequestsOverLimit(t *testing.T) {
+	setup()
+	router := newRouter(map[string]Limit{GroupRead: {Rate: 0.1, Burst: 1}})
+
+	allowed := get(router, "")
+	rejected := get(router, "")
+
+	assert.Equal(t, http.StatusOK, allowed.Code)
+	assert.Equal(t, "1", allowed.Header().Get("RateLimit-Limit"))
+	assert.Equal(t, "0", allowed.Header().Get("RateLimit-Remaining"))
+	assert.Equal(t, "10", allowed.Header().Get("RateLimit-Reset"))
+	assert.Equal(t, http.StatusTooManyRequests, rejected.Code)
+	assert.Equal(t, "10", rejected.Header().Get("Retry-After"))
+}
+
+func TestMiddlewareCountsPrincipalsSeparately(t *testing.T) {
+	setup()
+	router := newRouter(map[string]Limit{GroupRead: {Rate: 0.1, Burst: 1}})
+
+	get(router, "key:1")
+
+	assert.Equal(t, http.StatusOK, get(router, "key:2").Code)
+	assert.Equal(t, http.StatusOK, get(router, "").Code)
+	assert.Equal(t, http.StatusTooManyRequests, get(router, "key:1").Code)
+}
+
+func TestMiddlewareSkipsUnlimitedGroups(t *testing.T) {
+	setup()
+	router := newRouter(map[string]Limit{})
+
+	get(router, "")
+	recorder := get(router, "")
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Empty(t, recorder.Header().Get("RateLimit-Limit"))
+}
+
+func TestMiddlewareIgnoresForwardedIpOfUntrustedClient(t *testing.T) {
+	setup()
+	router := newRouter(map[string]Limit{GroupRead: {Rate: 0.1, Burst: 1}})
+
+	getForwardedFor(router, "", "203.0.113.1")
+	recorder := getForwardedFor(router, "", "203.0.113.2")
+
+	assert.Equal(t, http.StatusTooManyRequests, recorder.Code)
+}
+
+func TestIpMiddlewareCountsRequestsRejectedByAuthentication(t *testing.T) {
+	setup()
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.SetTrustedProxies(nil)
+	router.Use(IpMiddleware(store, map[string]Limit{GroupIp: {Rate: 0.1, Burst: 1}}))
+	router.Use(func(g *gin.Context) { g.AbortWithStatus(http.StatusUnauthorized) })
+	router.GET("/rates", func(g *gin.Context) { g.Status(http.StatusOK) })
+
+	unauthorized := get(router, "key:1")
+	rejected := get(router, "key:2")
+
+	assert.Equal(t, http.StatusUnauthorized, unauthorized.Code)
+	assert.Equal(t, http.StatusTooManyRequests, rejected.Code)
+}
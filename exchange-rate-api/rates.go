@@ -0,0 +1,172 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"time"
+
+	"github.com/kolan92/exchange-rate-api/cli"
+	"github.com/kolan92/exchange-rate-api/config"
+	"github.com/kolan92/exchange-rate-api/fred"
+	"github.com/kolan92/exchange-rate-api/logging"
+	"github.com/kolan92/exchange-rate-api/validators"
+	"github.com/shopspring/decimal"
+	"go.uber.org/zap"
+)
+
+const ratesUsage = `usage: exchange-rate-api rates <command> [flags] [arguments]
+
+commands:
+  import [-replace] FILE...                                  imports csv files, e.g. data/USDCHF.csv
+  import-ecb [-replace] FILE|URL                             imports ECB eurofxref xml in one transaction
+  import-fred -mapping FILE [-replace] FILE...               imports FRED series, e.g. DEXSZUS.csv, mapped to pairs
+  export [-from DATE] [-till DATE] [-format csv|json] PAIR...  exports rates of pairs, e.g. USD/CHF
+  gaps [-from DATE] [-till DATE] PAIR...                      lists weekdays without rate
+  insert -source CODE -destination CODE -date DATE [-rate RATE]
+  correct -source CODE -destination CODE -date DATE [-rate RATE]
+
+Flags of the api, e.g. -db-backend, are accepted too. Empty rate is stored as rate without value.`
+
+// rateOptions are flags of rates commands.
+type rateOptions struct {
+	replace     bool
+	mapping     string
+	from        string
+	till        string
+	format      string
+	output      string
+	source      string
+	destination string
+	date        string
+	rate        string
+}
+
+// runRates handles rates subcommand, which operates exchange rates with the same repository and validation as the api.
+func runRates(args []string) {
+	if len(args) == 0 {
+		log.Fatal(ratesUsage)
+	}
+	command := args[0]
+
+	options := rateOptions{}
+	defineFlags, isFound := map[string]func(*flag.FlagSet){
+		"import":     options.defineImportFlags,
+		"import-ecb": options.defineImportFlags,
+		"import-fred": func(flagSet *flag.FlagSet) {
+			options.defineImportFlags(flagSet)
+			flagSet.StringVar(&options.mapping, "mapping", "", "yaml file mapping series ids to pairs and quoting conventions, e.g. ../data/fred-series.yaml")
+		},
+		"export": func(flagSet *flag.FlagSet) {
+			options.defineRangeFlags(flagSet)
+			flagSet.StringVar(&options.format, "format", cli.FormatCsv, "csv or json")
+			flagSet.StringVar(&options.output, "output", "", "output file, stdout when empty")
+		},
+		"gaps":    options.defineRangeFlags,
+		"insert":  options.defineRateFlags,
+		"correct": options.defineRateFlags,
+	}[command]
+	if !isFound {
+		log.Fatal(ratesUsage)
+	}
+
+	cfg, arguments, err := config.LoadCommand("exchange-rate-api rates "+command, args[1:], defineFlags)
+	if errors.Is(err, flag.ErrHelp) {
+		return
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	logger, err := logging.NewCommandLogger(cfg.LogLevel)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer logger.Sync()
+	zap.ReplaceGlobals(logger)
+	decimal.MarshalJSONWithoutQuotes = true
+
+	if cfg.Database.Backend == config.BackendMemory {
+		logger.Fatal("Rates commands need postgres or sqlite backend, memory backend is lost on exit")
+	}
+	_, repo := openStorage(cfg.Database)
+
+	out := io.Writer(os.Stdout)
+	if options.output != "" {
+		file, err := os.Create(options.output)
+		if err != nil {
+			logger.Fatal("Can't create output file", zap.Error(err))
+		}
+		defer file.Close()
+		out = file
+	}
+	commands := cli.New(repo, out)
+
+	ctx := context.Background()
+	switch command {
+	case "import":
+		for _, path := range arguments {
+			summary, err := commands.Import(ctx, path, options.replace)
+			if err != nil {
+				logger.Fatal("Import failed", zap.String("file", path), zap.Error(err))
+			}
+			fmt.Printf("%s: inserted %d, updated %d, skipped %d\n", path, summary.Inserted, summary.Updated, summary.Skipped)
+		}
+	case "import-ecb":
+		if len(arguments) != 1 {
+			logger.Fatal("import-ecb needs one file or url, e.g. https://www.ecb.europa.eu/stats/eurofxref/eurofxref-hist.xml")
+		}
+		summary, err := commands.ImportEcb(ctx, arguments[0], options.replace)
+		if err != nil {
+			logger.Fatal("Import failed", zap.String("source", arguments[0]), zap.Error(err))
+		}
+		fmt.Printf("%s: inserted %d, updated %d, skipped %d\n", arguments[0], summary.Inserted, summary.Updated, summary.Skipped)
+	case "import-fred":
+		// mapping has no default, relative path would depend on working directory
+		if options.mapping == "" {
+			logger.Fatal("import-fred needs -mapping file, e.g. ../data/fred-series.yaml")
+		}
+		mapping, err := fred.LoadMapping(options.mapping)
+		if err != nil {
+			logger.Fatal("Can't load series mapping", zap.Error(err))
+		}
+		for _, path := range arguments {
+			summary, err := commands.ImportFred(ctx, mapping, path, options.replace)
+			if err != nil {
+				logger.Fatal("Import failed", zap.String("file", path), zap.Error(err))
+			}
+			fmt.Printf("%s: inserted %d, updated %d, skipped %d\n", path, summary.Inserted, summary.Updated, summary.Skipped)
+		}
+	case "export":
+		err = commands.Export(ctx, arguments, options.from, options.till, options.format)
+	case "gaps":
+		err = commands.Gaps(ctx, arguments, options.from, options.till)
+	case "insert":
+		err = commands.Insert(ctx, options.source, options.destination, options.date, options.rate)
+	case "correct":
+		err = commands.Correct(ctx, options.source, options.destination, options.date, options.rate)
+	}
+	if err != nil {
+		logger.Fatal("Command failed", zap.String("command", command), zap.Error(err))
+	}
+}
+
+func (o *rateOptions) defineImportFlags(flagSet *flag.FlagSet) {
+	flagSet.BoolVar(&o.replace, "replace", false, "corrects stored rates instead of skipping them")
+}
+
+func (o *rateOptions) defineRangeFlags(flagSet *flag.FlagSet) {
+	flagSet.StringVar(&o.from, "from", "1900-01-01", "from date, inclusive")
+	flagSet.StringVar(&o.till, "till", time.Now().UTC().AddDate(0, 0, 1).Format(validators.DateLayout), "till date, exclusive")
+}
+
+func (o *rateOptions) defineRateFlags(flagSet *flag.FlagSet) {
+	flagSet.StringVar(&o.source, "source", "", "source (base) currency")
+	flagSet.StringVar(&o.destination, "destination", validators.DefaultDestinationCurrency, "destination (quote) currency, rate is amount of it for one unit of source currency")
+	flagSet.StringVar(&o.date, "date", "", "date of rate, YYYY-MM-DD")
+	flagSet.StringVar(&o.rate, "rate", "", "rate, empty when there is no rate on the date")
+}
@@ -0,0 +1,124 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/kolan92/exchange-rate-api/models"
+	"gorm.io/gorm"
+)
+
+type AlertsRepository interface {
+	InsertAlertRule(ctx context.Context, alertRule *models.AlertRule) error
+	GetAlertRules(ctx context.Context) ([]models.AlertRule, error)
+	GetAlertRulesForCurrencies(ctx context.Context, sourceCurrencyCode, destinationCurrencyCode string) ([]models.AlertRule, error)
+	DeleteAlertRule(ctx context.Context, id int) error
+	InsertWebhookDelivery(ctx context.Context, delivery *models.WebhookDelivery) error
+	// GetWebhookDeliveries returns gorm.ErrRecordNotFound when alert rule doesn't exist.
+	GetWebhookDeliveries(ctx context.Context, alertRuleId int) ([]models.WebhookDelivery, error)
+}
+
+type PostgresAlertsRepository struct {
+	db *gorm.DB
+}
+
+func NewPostgresAlertsRepository(db *gorm.DB) AlertsRepository {
+	return &PostgresAlertsRepository{db}
+}
+
+// InsertAlertRule sets id and creation time of the inserted rule.
+// Currencies codes have to be validated before, as rule with unknown currency is not inserted.
+func (r *PostgresAlertsRepository) InsertAlertRule(ctx context.Context, alertRule *models.AlertRule) error {
+	const query string = `
+	INSERT INTO public.alert_rules (source_currency_id, destination_currency_id, threshold, change_percent, callback_url, secret)
+		SELECT source_code.id, destination_code.id, ?, ?, ?, ?
+		FROM public.currencies_codes source_code, public.currencies_codes destination_code
+		WHERE source_code.code = ?
+		AND destination_code.code = ?
+		RETURNING id, created_at
+	`
+
+	return r.db.WithContext(ctx).Raw(query,
+		alertRule.Threshold,
+		alertRule.ChangePercent,
+		alertRule.CallbackUrl,
+		alertRule.Secret,
+		alertRule.Source,
+		alertRule.Destination,
+	).Row().Scan(&alertRule.Id, &alertRule.CreatedAt)
+}
+
+func (r *PostgresAlertsRepository) GetAlertRules(ctx context.Context) ([]models.AlertRule, error) {
+	alertRules := []models.AlertRule{}
+
+	const query string = `
+	SELECT rules.id, source_code.code as source, destination_code.code as destination,
+		rules.threshold, rules.change_percent, rules.callback_url, rules.secret, rules.created_at
+		FROM public.alert_rules rules
+		JOIN public.currencies_codes source_code
+		ON rules.source_currency_id = source_code.id
+		JOIN public.currencies_codes destination_code
+		ON rules.destination_currency_id = destination_code.id
+		ORDER BY rules.id
+	`
+
+	if err := r.db.WithContext(ctx).Raw(query).Scan(&alertRules).Error; err != nil {
+		return nil, err
+	}
+
+	return alertRules, nil
+}
+
+func (r *PostgresAlertsRepository) GetAlertRulesForCurrencies(ctx context.Context, sourceCurrencyCode, destinationCurrencyCode string) ([]models.AlertRule, error) {
+	alertRules := []models.AlertRule{}
+
+	const query string = `
+	SELECT rules.id, source_code.code as source, destination_code.code as destination,
+		rules.threshold, rules.change_percent, rules.callback_url, rules.secret, rules.created_at
+		FROM public.alert_rules rules
+		JOIN public.currencies_codes source_code
+		ON rules.source_currency_id = source_code.id
+		JOIN public.currencies_codes destination_code
+		ON rules.destination_currency_id = destination_code.id
+		WHERE source_code.code = ?
+		AND destination_code.code = ?
+		ORDER BY rules.id
+	`
+
+	if err := r.db.WithContext(ctx).Raw(query, sourceCurrencyCode, destinationCurrencyCode).Scan(&alertRules).Error; err != nil {
+		return nil, err
+	}
+
+	return alertRules, nil
+}
+
+// DeleteAlertRule removes rule together with its delivery log.
+func (r *PostgresAlertsRepository) DeleteAlertRule(ctx context.Context, id int) error {
+	result := r.db.WithContext(ctx).Delete(&models.DbAlertRule{}, id)
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+func (r *PostgresAlertsRepository) InsertWebhookDelivery(ctx context.Context, delivery *models.WebhookDelivery) error {
+	return r.db.WithContext(ctx).Create(delivery).Error
+}
+
+func (r *PostgresAlertsRepository) GetWebhookDeliveries(ctx context.Context, alertRuleId int) ([]models.WebhookDelivery, error) {
+	deliveries := []models.WebhookDelivery{}
+
+	if err := r.db.WithContext(ctx).Select("id").First(&models.DbAlertRule{}, alertRuleId).Error; err != nil {
+		return nil, err
+	}
+
+	if err := r.db.WithContext(ctx).Where("alert_rule_id = ?", alertRuleId).Order("id DESC").Find(&deliveries).Error; err != nil {
+		return nil, err
+	}
+
+	return deliveries, nil
+}
@@ -0,0 +1,64 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/kolan92/exchange-rate-api/models"
+	"gorm.io/gorm"
+)
+
+type ApiKeysRepository interface {
+	InsertApiKey(ctx context.Context, apiKey *models.DbApiKey) error
+	GetApiKeys(ctx context.Context) ([]models.DbApiKey, error)
+	GetActiveApiKeyByHash(ctx context.Context, keyHash string) (*models.DbApiKey, error)
+	RevokeApiKey(ctx context.Context, id int) error
+}
+
+type PostgresApiKeysRepository struct {
+	db *gorm.DB
+}
+
+func NewPostgresApiKeysRepository(db *gorm.DB) ApiKeysRepository {
+	return &PostgresApiKeysRepository{db}
+}
+
+// InsertApiKey sets id and creation time of the inserted key.
+func (r *PostgresApiKeysRepository) InsertApiKey(ctx context.Context, apiKey *models.DbApiKey) error {
+	return r.db.WithContext(ctx).Create(apiKey).Error
+}
+
+func (r *PostgresApiKeysRepository) GetApiKeys(ctx context.Context) ([]models.DbApiKey, error) {
+	apiKeys := []models.DbApiKey{}
+
+	if err := r.db.WithContext(ctx).Order("id").Find(&apiKeys).Error; err != nil {
+		return nil, err
+	}
+
+	return apiKeys, nil
+}
+
+// GetActiveApiKeyByHash returns gorm.ErrRecordNotFound when key doesn't exist or was revoked.
+func (r *PostgresApiKeysRepository) GetActiveApiKeyByHash(ctx context.Context, keyHash string) (*models.DbApiKey, error) {
+	var apiKey models.DbApiKey
+
+	if err := r.db.WithContext(ctx).Where("key_hash = ? AND revoked_at IS NULL", keyHash).First(&apiKey).Error; err != nil {
+		return nil, err
+	}
+
+	return &apiKey, nil
+}
+
+// RevokeApiKey returns gorm.ErrRecordNotFound when there is no active key with the id.
+func (r *PostgresApiKeysRepository) RevokeApiKey(ctx context.Context, id int) error {
+	result := r.db.WithContext(ctx).Model(&models.DbApiKey{}).
+		Where("id = ? AND revoked_at IS NULL", id).
+		Update("revoked_at", time.Now().UTC())
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
@@ -1,77 +1,119 @@
 package repositories
 
 import (
+	"context"
 	"errors"
 	"fmt"
-	"log"
+	"sort"
 	"sync"
 	"time"
 
 	"github.com/jackc/pgconn"
+	"github.com/kolan92/exchange-rate-api/config"
 	customerros "github.com/kolan92/exchange-rate-api/custom-erros"
+	"github.com/kolan92/exchange-rate-api/logging"
 	"github.com/kolan92/exchange-rate-api/models"
+	"go.uber.org/zap"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
-// codesCurrenciesIdsMap can be initialized once, as it will be never changed.
-// If api would support adding new currencies, it should be cached and invalidated on cache miss
+// codesCurrenciesIdsMap is loaded once and reloaded after currencies are inserted. Loading is retried until currencies
+// are found, so api recovers when database is not available on startup.
 var (
-	currenciesCodesOnce   sync.Once
+	currenciesCodesMu     sync.Mutex
 	codesCurrenciesIdsMap map[string]int
 )
 
 type CurrenciesRepository interface {
-	GetCurrenciesCodesIdsMap() map[string]int
-	GetCurrenciesCodes() []string
-	GetLastExchangeRate(sourceCurrencyId, destinationCurrencyId int) (*models.ExchangeRate, error)
-	GetAllExchangeRatesFromDate(date time.Time) ([]models.ExchangeRate, error)
-	GetRangeExchangeRate(sourceCurrencyId, destinationCurrencyId int, from, till *time.Time) ([]models.ExchangeRate, error)
-	InsertExchangeRate(exchangeRate *models.ExchangeRate) error
+	GetCurrenciesCodesIdsMap(ctx context.Context) map[string]int
+	// InsertCurrenciesCodes inserts missing currencies, stored ones are kept.
+	InsertCurrenciesCodes(ctx context.Context, codes []string) error
+	GetCurrenciesCodes(ctx context.Context) []string
+	GetLastExchangeRate(ctx context.Context, sourceCurrencyId, destinationCurrencyId int) (*models.ExchangeRate, error)
+	GetLastExchangeRates(ctx context.Context, currencyPairs []models.CurrencyPair) ([]models.ExchangeRate, error)
+	GetAllExchangeRatesFromDate(ctx context.Context, date time.Time) ([]models.ExchangeRate, error)
+	GetRangeExchangeRate(ctx context.Context, sourceCurrencyId, destinationCurrencyId int, from, till *time.Time) ([]models.ExchangeRate, error)
+	GetRangeExchangeRates(ctx context.Context, currencyPairs []models.CurrencyPair, from, till *time.Time) ([]models.ExchangeRate, error)
+	GetNewestExchangeRateDate(ctx context.Context) (*time.Time, error)
+	GetNewestExchangeRates(ctx context.Context) ([]models.ExchangeRate, error)
+	// InsertExchangeRate rejects rate of pair stored the other way round with inverted_pair error,
+	// so every pair is quoted in one direction.
+	InsertExchangeRate(ctx context.Context, exchangeRate *models.ExchangeRate) error
+	UpdateExchangeRate(ctx context.Context, exchangeRate *models.ExchangeRate) error
+	// ImportExchangeRates stores all rates in one transaction, nothing is stored when it fails.
+	// Stored rates are skipped, or updated when replace is set. Missing currencies of the rates are inserted
+	// in the same transaction, pairs must not be stored the other way round.
+	ImportExchangeRates(ctx context.Context, exchangeRates []models.ExchangeRate, replace bool) (models.ImportSummary, error)
 }
 
 type PostgresCurrenciesRepository struct {
 	db *gorm.DB
 }
 
-func NewPostgresCurrenciesRepository(connectionString string) CurrenciesRepository {
-	db, err := gorm.Open(postgres.Open(connectionString))
+// ConnectPostgres opens connection pool shared by all postgres repositories.
+func ConnectPostgres(databaseConfig config.DatabaseConfig) *gorm.DB {
+	db, err := gorm.Open(postgres.Open(databaseConfig.ConnectionString()), &gorm.Config{Logger: gormLogger{}})
 	if err != nil {
 		panic(fmt.Sprintf("failed to connect to database: %v", err))
 	}
 
+	sqlDb, err := db.DB()
+	if err != nil {
+		panic(fmt.Sprintf("failed to get database connection pool: %v", err))
+	}
+	sqlDb.SetMaxOpenConns(databaseConfig.MaxOpenConns)
+	sqlDb.SetMaxIdleConns(databaseConfig.MaxIdleConns)
+	sqlDb.SetConnMaxLifetime(databaseConfig.ConnMaxLifetime)
+
+	return db
+}
+
+func NewPostgresCurrenciesRepository(db *gorm.DB) CurrenciesRepository {
 	return &PostgresCurrenciesRepository{db}
 }
 
-func (r *PostgresCurrenciesRepository) GetCurrenciesCodesIdsMap() map[string]int {
-	currenciesCodesOnce.Do((func() {
-		var dbCurrencies []models.Currency
-		if err := r.db.Find(&dbCurrencies).Error; err != nil {
-			log.Println("Can't find currencies")
-		}
+func (r *PostgresCurrenciesRepository) GetCurrenciesCodesIdsMap(ctx context.Context) map[string]int {
+	currenciesCodesMu.Lock()
+	defer currenciesCodesMu.Unlock()
 
-		currenciesCodesMap := make(map[string]int)
+	if len(codesCurrenciesIdsMap) > 0 {
+		return codesCurrenciesIdsMap
+	}
 
-		for _, currencyCode := range dbCurrencies {
-			currenciesCodesMap[currencyCode.Code] = currencyCode.Id
-		}
-		codesCurrenciesIdsMap = currenciesCodesMap
-	}))
+	currenciesCodesMap, err := loadCurrenciesCodesIdsMap(r.db.WithContext(ctx))
+	if err != nil {
+		logError(ctx, "GetCurrenciesCodesIdsMap", err)
+	}
+	codesCurrenciesIdsMap = currenciesCodesMap
 
 	return codesCurrenciesIdsMap
 }
 
-func (r *PostgresCurrenciesRepository) GetCurrenciesCodes() []string {
+func (r *PostgresCurrenciesRepository) InsertCurrenciesCodes(ctx context.Context, codes []string) error {
+	inserted, err := insertCurrenciesCodes(ctx, r.db, codes)
+	if err != nil || inserted == 0 {
+		return err
+	}
+
+	currenciesCodesMu.Lock()
+	defer currenciesCodesMu.Unlock()
+	codesCurrenciesIdsMap = nil
+	return nil
+}
+
+func (r *PostgresCurrenciesRepository) GetCurrenciesCodes(ctx context.Context) []string {
 
 	currencies := []string{}
 
-	for currencyCode := range r.GetCurrenciesCodesIdsMap() {
+	for currencyCode := range r.GetCurrenciesCodesIdsMap(ctx) {
 		currencies = append(currencies, currencyCode)
 	}
 	return currencies
 }
 
-func (r *PostgresCurrenciesRepository) GetLastExchangeRate(sourceCurrencyId, destinaionCurrencyId int) (*models.ExchangeRate, error) {
+func (r *PostgresCurrenciesRepository) GetLastExchangeRate(ctx context.Context, sourceCurrencyId, destinaionCurrencyId int) (*models.ExchangeRate, error) {
 	var exchangeRate models.ExchangeRate
 
 	const query string = `
@@ -88,14 +130,44 @@ func (r *PostgresCurrenciesRepository) GetLastExchangeRate(sourceCurrencyId, des
 		LIMIT 1
 	`
 
-	if err := r.db.Raw(query, sourceCurrencyId, destinaionCurrencyId).First(&exchangeRate).Error; err != nil {
+	if err := r.db.WithContext(ctx).Raw(query, sourceCurrencyId, destinaionCurrencyId).First(&exchangeRate).Error; err != nil {
+		logError(ctx, "GetLastExchangeRate", err, zap.Int("sourceCurrencyId", sourceCurrencyId), zap.Int("destinationCurrencyId", destinaionCurrencyId))
 		return nil, err
 	}
 
 	return &exchangeRate, nil
 }
 
-func (r *PostgresCurrenciesRepository) GetAllExchangeRatesFromDate(date time.Time) ([]models.ExchangeRate, error) {
+// GetLastExchangeRates returns most recent not null exchange rate for each of currency pairs in single query.
+// Pairs without any rate are skipped.
+func (r *PostgresCurrenciesRepository) GetLastExchangeRates(ctx context.Context, currencyPairs []models.CurrencyPair) ([]models.ExchangeRate, error) {
+	exchangeRates := []models.ExchangeRate{}
+	if len(currencyPairs) == 0 {
+		return exchangeRates, nil
+	}
+
+	const query string = `
+	SELECT DISTINCT ON (rates.source_currency_id, rates.destination_currency_id)
+		destination_code.code as destination, source_code.code as source, rates.date, rates.rate
+		FROM public.exchange_rates rates
+		JOIN public.currencies_codes source_code 
+		ON rates.source_currency_id = source_code.id
+		JOIN public.currencies_codes destination_code 
+		ON rates.destination_currency_id = destination_code.id
+		WHERE (rates.source_currency_id, rates.destination_currency_id) IN ?
+		AND rates.rate IS NOT NULL
+		ORDER BY rates.source_currency_id, rates.destination_currency_id, rates.date DESC
+	`
+
+	if err := r.db.WithContext(ctx).Raw(query, toIdsTuples(currencyPairs)).Scan(&exchangeRates).Error; err != nil {
+		logError(ctx, "GetLastExchangeRates", err, zap.Int("currencyPairs", len(currencyPairs)))
+		return nil, err
+	}
+
+	return exchangeRates, nil
+}
+
+func (r *PostgresCurrenciesRepository) GetAllExchangeRatesFromDate(ctx context.Context, date time.Time) ([]models.ExchangeRate, error) {
 	exchangeRates := []models.ExchangeRate{}
 
 	const query string = `
@@ -106,17 +178,18 @@ func (r *PostgresCurrenciesRepository) GetAllExchangeRatesFromDate(date time.Tim
 		JOIN public.currencies_codes destination_code 
 		ON rates.destination_currency_id = destination_code.id
 		WHERE rates.date = ?
-		ORDER BY rates.date DESC
+		ORDER BY rates.source_currency_id, rates.destination_currency_id
 	`
 
-	if err := r.db.Raw(query, date).Scan(&exchangeRates).Error; err != nil {
+	if err := r.db.WithContext(ctx).Raw(query, date).Scan(&exchangeRates).Error; err != nil {
+		logError(ctx, "GetAllExchangeRatesFromDate", err, zap.Time("date", date))
 		return nil, err
 	}
 
 	return exchangeRates, nil
 }
 
-func (r *PostgresCurrenciesRepository) GetRangeExchangeRate(sourceCurrencyId, destinationCurrencyId int, from, till *time.Time) ([]models.ExchangeRate, error) {
+func (r *PostgresCurrenciesRepository) GetRangeExchangeRate(ctx context.Context, sourceCurrencyId, destinationCurrencyId int, from, till *time.Time) ([]models.ExchangeRate, error) {
 	exchangeRates := []models.ExchangeRate{}
 
 	const query string = `
@@ -130,18 +203,83 @@ func (r *PostgresCurrenciesRepository) GetRangeExchangeRate(sourceCurrencyId, de
 		AND rates.destination_currency_id = ?
 		AND rates.date >= ?
 		AND rates.date < ?
-		ORDER BY rates.date DESC
+		ORDER BY rates.date DESC, rates.source_currency_id, rates.destination_currency_id
+	`
+
+	if err := r.db.WithContext(ctx).Raw(query, sourceCurrencyId, destinationCurrencyId, from, till).Scan(&exchangeRates).Error; err != nil {
+		logError(ctx, "GetRangeExchangeRate", err, zap.Int("sourceCurrencyId", sourceCurrencyId), zap.Int("destinationCurrencyId", destinationCurrencyId), zap.Timep("from", from), zap.Timep("till", till))
+		return nil, err
+	}
+
+	return exchangeRates, nil
+}
+
+// GetRangeExchangeRates returns exchange rates in the time period for all currency pairs in single query.
+func (r *PostgresCurrenciesRepository) GetRangeExchangeRates(ctx context.Context, currencyPairs []models.CurrencyPair, from, till *time.Time) ([]models.ExchangeRate, error) {
+	exchangeRates := []models.ExchangeRate{}
+	if len(currencyPairs) == 0 {
+		return exchangeRates, nil
+	}
+
+	const query string = `
+	SELECT destination_code.code as destination, source_code.code as source, rates.date, rates.rate
+		FROM public.exchange_rates rates
+		JOIN public.currencies_codes source_code 
+		ON rates.source_currency_id = source_code.id
+		JOIN public.currencies_codes destination_code 
+		ON rates.destination_currency_id = destination_code.id
+		WHERE (rates.source_currency_id, rates.destination_currency_id) IN ?
+		AND rates.date >= ?
+		AND rates.date < ?
+		ORDER BY rates.date DESC, rates.source_currency_id, rates.destination_currency_id
+	`
+
+	if err := r.db.WithContext(ctx).Raw(query, toIdsTuples(currencyPairs), from, till).Scan(&exchangeRates).Error; err != nil {
+		logError(ctx, "GetRangeExchangeRates", err, zap.Int("currencyPairs", len(currencyPairs)), zap.Timep("from", from), zap.Timep("till", till))
+		return nil, err
+	}
+
+	return exchangeRates, nil
+}
+
+// GetNewestExchangeRateDate returns date of the most recent exchange rate of any currency pair, nil when there are no rates.
+func (r *PostgresCurrenciesRepository) GetNewestExchangeRateDate(ctx context.Context) (*time.Time, error) {
+	var newestDate *time.Time
+
+	if err := r.db.WithContext(ctx).Raw("SELECT MAX(date) FROM public.exchange_rates").Scan(&newestDate).Error; err != nil {
+		logError(ctx, "GetNewestExchangeRateDate", err)
+		return nil, err
+	}
+
+	return newestDate, nil
+}
+
+// GetNewestExchangeRates returns the most recent exchange rate of every currency pair, including rates without value,
+// so it shows up to which date data was loaded.
+func (r *PostgresCurrenciesRepository) GetNewestExchangeRates(ctx context.Context) ([]models.ExchangeRate, error) {
+	exchangeRates := []models.ExchangeRate{}
+
+	const query string = `
+	SELECT DISTINCT ON (rates.source_currency_id, rates.destination_currency_id)
+		destination_code.code as destination, source_code.code as source, rates.date, rates.rate
+		FROM public.exchange_rates rates
+		JOIN public.currencies_codes source_code 
+		ON rates.source_currency_id = source_code.id
+		JOIN public.currencies_codes destination_code 
+		ON rates.destination_currency_id = destination_code.id
+		ORDER BY rates.source_currency_id, rates.destination_currency_id, rates.date DESC
 	`
 
-	if err := r.db.Raw(query, sourceCurrencyId, destinationCurrencyId, from, till).Scan(&exchangeRates).Error; err != nil {
+	if err := r.db.WithContext(ctx).Raw(query).Scan(&exchangeRates).Error; err != nil {
+		logError(ctx, "GetNewestExchangeRates", err)
 		return nil, err
 	}
 
 	return exchangeRates, nil
 }
 
-func (r *PostgresCurrenciesRepository) InsertExchangeRate(exchangeRate *models.ExchangeRate) error {
-	codesCurrenciesIdsMap := r.GetCurrenciesCodesIdsMap()
+func (r *PostgresCurrenciesRepository) InsertExchangeRate(ctx context.Context, exchangeRate *models.ExchangeRate) error {
+	codesCurrenciesIdsMap := r.GetCurrenciesCodesIdsMap(ctx)
 
 	dbExchangeRate := &models.DbExchangeRate{
 		Source:      codesCurrenciesIdsMap[exchangeRate.Source],
@@ -149,16 +287,272 @@ func (r *PostgresCurrenciesRepository) InsertExchangeRate(exchangeRate *models.E
 		Date:        exchangeRate.Date,
 		Rate:        exchangeRate.Rate,
 	}
-	if err := r.db.Create(dbExchangeRate).Error; err != nil {
-		if pgError := err.(*pgconn.PgError); errors.Is(err, pgError) {
-			switch pgError.Code {
-			case "23505":
-				return customerros.ErrDuplicateKeyViolation
-			default:
-				return err
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := rejectInvertedPairs(tx, lockPostgresPairs, codesCurrenciesIdsMap, []models.ExchangeRate{*exchangeRate}); err != nil {
+			return err
+		}
+		return tx.Create(dbExchangeRate).Error
+	})
+	if err != nil {
+		var pgError *pgconn.PgError
+		if errors.As(err, &pgError) && pgError.Code == "23505" {
+			return customerros.ErrDuplicateKeyViolation
+		}
+		if customerros.Code(err) == customerros.CodeInvertedPair {
+			return err
+		}
+
+		logError(ctx, "InsertExchangeRate", err,
+			zap.String("source", exchangeRate.Source),
+			zap.String("destination", exchangeRate.Destination),
+			zap.Time("date", exchangeRate.Date))
+		return err
+	}
+	return nil
+}
+
+// UpdateExchangeRate corrects rate of stored exchange rate, gorm.ErrRecordNotFound is returned when it is not stored.
+func (r *PostgresCurrenciesRepository) UpdateExchangeRate(ctx context.Context, exchangeRate *models.ExchangeRate) error {
+	codesCurrenciesIdsMap := r.GetCurrenciesCodesIdsMap(ctx)
+
+	result := r.db.WithContext(ctx).
+		Model(&models.DbExchangeRate{}).
+		Where("source_currency_id = ? AND destination_currency_id = ? AND date = ?",
+			codesCurrenciesIdsMap[exchangeRate.Source], codesCurrenciesIdsMap[exchangeRate.Destination], exchangeRate.Date).
+		Update("rate", exchangeRate.Rate)
+	if result.Error != nil {
+		logError(ctx, "UpdateExchangeRate", result.Error,
+			zap.String("source", exchangeRate.Source),
+			zap.String("destination", exchangeRate.Destination),
+			zap.Time("date", exchangeRate.Date))
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+func (r *PostgresCurrenciesRepository) ImportExchangeRates(ctx context.Context, exchangeRates []models.ExchangeRate, replace bool) (models.ImportSummary, error) {
+	summary, insertedCurrencies, err := importExchangeRates(ctx, r.db, exchangeRates, replace, lockPostgresPairs,
+		func(exchangeRate models.ExchangeRate, codesCurrenciesIdsMap map[string]int) models.DbExchangeRate {
+			return models.DbExchangeRate{
+				Source:      codesCurrenciesIdsMap[exchangeRate.Source],
+				Destination: codesCurrenciesIdsMap[exchangeRate.Destination],
+				Date:        exchangeRate.Date,
+				Rate:        exchangeRate.Rate,
+			}
+		})
+	if insertedCurrencies > 0 {
+		currenciesCodesMu.Lock()
+		defer currenciesCodesMu.Unlock()
+		codesCurrenciesIdsMap = nil
+	}
+	return summary, err
+}
+
+// insertCurrenciesCodes is shared by postgres and sqlite, both of them support ON CONFLICT clause.
+// It returns number of inserted currencies, cached ids are reloaded only when some were missing.
+func insertCurrenciesCodes(ctx context.Context, db *gorm.DB, codes []string) (int64, error) {
+	inserted, err := createCurrencies(db.WithContext(ctx), codes)
+	if err != nil {
+		logError(ctx, "InsertCurrenciesCodes", err, zap.Strings("codes", codes))
+		return 0, err
+	}
+	return inserted, nil
+}
+
+func createCurrencies(db *gorm.DB, codes []string) (int64, error) {
+	if len(codes) == 0 {
+		return 0, nil
+	}
+
+	currencies := make([]models.Currency, 0, len(codes))
+	for _, code := range codes {
+		currencies = append(currencies, models.Currency{Code: code})
+	}
+
+	result := db.Clauses(clause.OnConflict{DoNothing: true}).Create(&currencies)
+	return result.RowsAffected, result.Error
+}
+
+// loadCurrenciesCodesIdsMap reads ids of all currencies, it is shared by postgres and sqlite.
+func loadCurrenciesCodesIdsMap(db *gorm.DB) (map[string]int, error) {
+	var dbCurrencies []models.Currency
+	err := db.Find(&dbCurrencies).Error
+
+	currenciesCodesMap := make(map[string]int, len(dbCurrencies))
+	for _, currencyCode := range dbCurrencies {
+		currenciesCodesMap[currencyCode.Code] = currencyCode.Id
+	}
+	return currenciesCodesMap, err
+}
+
+// currenciesCodesOf returns distinct currencies of the rates.
+func currenciesCodesOf(exchangeRates []models.ExchangeRate) []string {
+	codes := []string{}
+	isAdded := make(map[string]bool)
+	for _, exchangeRate := range exchangeRates {
+		for _, code := range []string{exchangeRate.Source, exchangeRate.Destination} {
+			if !isAdded[code] {
+				isAdded[code] = true
+				codes = append(codes, code)
 			}
+		}
+	}
+	return codes
+}
+
+// pairsLock serializes checks of pairs stored the other way round within transaction, so rates of the same pair
+// inserted concurrently in both directions can't both pass the check.
+type pairsLock func(tx *gorm.DB, currencyPairs []models.CurrencyPair) error
+
+// lockPostgresPairs takes transaction advisory lock of every pair regardless of its direction. Locks are taken
+// in the same order by all transactions, so they don't deadlock.
+func lockPostgresPairs(tx *gorm.DB, currencyPairs []models.CurrencyPair) error {
+	keys := make([]models.CurrencyPair, 0, len(currencyPairs))
+	isAdded := make(map[models.CurrencyPair]bool)
+	for _, currencyPair := range currencyPairs {
+		key := currencyPair
+		if key.SourceCurrencyId > key.DestinationCurrencyId {
+			key = models.CurrencyPair{SourceCurrencyId: key.DestinationCurrencyId, DestinationCurrencyId: key.SourceCurrencyId}
+		}
+		if !isAdded[key] {
+			isAdded[key] = true
+			keys = append(keys, key)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].SourceCurrencyId != keys[j].SourceCurrencyId {
+			return keys[i].SourceCurrencyId < keys[j].SourceCurrencyId
+		}
+		return keys[i].DestinationCurrencyId < keys[j].DestinationCurrencyId
+	})
 
+	for _, key := range keys {
+		if err := tx.Exec("SELECT pg_advisory_xact_lock(?, ?)", key.SourceCurrencyId, key.DestinationCurrencyId).Error; err != nil {
+			return err
 		}
 	}
 	return nil
 }
+
+// rejectInvertedPairs is shared by postgres and sqlite, it checks every pair of the rates once.
+// It runs in the transaction which inserts the rates, after the pairs are locked.
+func rejectInvertedPairs(tx *gorm.DB, lockPairs pairsLock, codesCurrenciesIdsMap map[string]int, exchangeRates []models.ExchangeRate) error {
+	invertedPairs := []models.CurrencyPair{}
+	invertedPairsRates := make(map[models.CurrencyPair]models.ExchangeRate)
+	for _, exchangeRate := range exchangeRates {
+		invertedPair := models.CurrencyPair{
+			SourceCurrencyId:      codesCurrenciesIdsMap[exchangeRate.Destination],
+			DestinationCurrencyId: codesCurrenciesIdsMap[exchangeRate.Source],
+		}
+		if _, isAdded := invertedPairsRates[invertedPair]; !isAdded {
+			invertedPairsRates[invertedPair] = exchangeRate
+			invertedPairs = append(invertedPairs, invertedPair)
+		}
+	}
+
+	if err := lockPairs(tx, invertedPairs); err != nil {
+		return err
+	}
+
+	for _, invertedPair := range invertedPairs {
+		isStored := false
+		err := tx.Raw("SELECT EXISTS (SELECT 1 FROM exchange_rates WHERE source_currency_id = ? AND destination_currency_id = ?)",
+			invertedPair.SourceCurrencyId, invertedPair.DestinationCurrencyId).
+			Scan(&isStored).Error
+		if err != nil {
+			return err
+		}
+		if isStored {
+			return invertedPairError(invertedPairsRates[invertedPair])
+		}
+	}
+	return nil
+}
+
+// invertedPairError tells how to insert rate of pair which is stored the other way round.
+func invertedPairError(exchangeRate models.ExchangeRate) error {
+	return customerros.Newf(customerros.CodeInvertedPair, "%s/%s is quoted as %s/%s, insert amount of %s for one %s instead",
+		exchangeRate.Source, exchangeRate.Destination, exchangeRate.Destination, exchangeRate.Source, exchangeRate.Source, exchangeRate.Destination)
+}
+
+// importExchangeRates is shared by postgres and sqlite. Missing currencies are inserted first in the transaction,
+// their number is returned, so cached currencies can be reloaded. Conflicts are resolved with ON CONFLICT clause,
+// as failed statement aborts the whole postgres transaction.
+func importExchangeRates(ctx context.Context, db *gorm.DB, exchangeRates []models.ExchangeRate, replace bool, lockPairs pairsLock,
+	toDbExchangeRate func(exchangeRate models.ExchangeRate, codesCurrenciesIdsMap map[string]int) models.DbExchangeRate) (models.ImportSummary, int64, error) {
+	summary := models.ImportSummary{}
+	var insertedCurrencies int64
+
+	err := db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		summary = models.ImportSummary{}
+		var err error
+		insertedCurrencies, err = createCurrencies(tx, currenciesCodesOf(exchangeRates))
+		if err != nil {
+			return err
+		}
+		codesCurrenciesIdsMap, err := loadCurrenciesCodesIdsMap(tx)
+		if err != nil {
+			return err
+		}
+		if err := rejectInvertedPairs(tx, lockPairs, codesCurrenciesIdsMap, exchangeRates); err != nil {
+			return err
+		}
+
+		for i, exchangeRate := range exchangeRates {
+			dbExchangeRate := toDbExchangeRate(exchangeRate, codesCurrenciesIdsMap)
+			result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&dbExchangeRate)
+			if result.Error != nil {
+				return result.Error
+			}
+			if result.RowsAffected > 0 {
+				summary.Inserted++
+				summary.Stored = append(summary.Stored, i)
+				continue
+			}
+
+			if !replace {
+				summary.Skipped++
+				continue
+			}
+
+			err := tx.Model(&models.DbExchangeRate{}).
+				Where("source_currency_id = ? AND destination_currency_id = ? AND date = ?",
+					dbExchangeRate.Source, dbExchangeRate.Destination, dbExchangeRate.Date).
+				Update("rate", dbExchangeRate.Rate).Error
+			if err != nil {
+				return err
+			}
+			summary.Updated++
+			summary.Stored = append(summary.Stored, i)
+		}
+		return nil
+	})
+	if err != nil {
+		if customerros.Code(err) != customerros.CodeInvertedPair {
+			logError(ctx, "ImportExchangeRates", err, zap.Int("rates", len(exchangeRates)))
+		}
+		return models.ImportSummary{}, 0, err
+	}
+	return summary, insertedCurrencies, nil
+}
+
+// logError logs unexpected repository errors, not found records are regular result and are not logged.
+func logError(ctx context.Context, method string, err error, fields ...zap.Field) {
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return
+	}
+
+	fields = append([]zap.Field{zap.String("repository", "currencies"), zap.String("method", method), zap.Error(err)}, fields...)
+	logging.FromContext(ctx).Error("Repository query failed", fields...)
+}
+
+func toIdsTuples(currencyPairs []models.CurrencyPair) [][]interface{} {
+	tuples := make([][]interface{}, 0, len(currencyPairs))
+	for _, currencyPair := range currencyPairs {
+		tuples = append(tuples, []interface{}{currencyPair.SourceCurrencyId, currencyPair.DestinationCurrencyId})
+	}
+	return tuples
+}
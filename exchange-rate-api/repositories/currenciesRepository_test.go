@@ -0,0 +1,75 @@
+package repositories_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/kolan92/exchange-rate-api/config"
+	"github.com/kolan92/exchange-rate-api/migrations"
+	"github.com/kolan92/exchange-rate-api/repositories"
+	testhelpers "github.com/kolan92/exchange-rate-api/testHelpers"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestMemoryCurrenciesRepository(t *testing.T) {
+	testhelpers.RunCurrenciesRepositorySuite(t, func(t *testing.T) repositories.CurrenciesRepository {
+		return repositories.NewMemoryCurrenciesRepository(repositories.SeedCurrenciesCodes)
+	})
+}
+
+func TestSqliteCurrenciesRepository(t *testing.T) {
+	testhelpers.RunCurrenciesRepositorySuite(t, func(t *testing.T) repositories.CurrenciesRepository {
+		db := repositories.ConnectSqlite(filepath.Join(t.TempDir(), "rates.db"))
+		t.Cleanup(func() {
+			if sqlDb, err := db.DB(); err == nil {
+				sqlDb.Close()
+			}
+		})
+		migrate(t, db)
+		return repositories.NewSqliteCurrenciesRepository(db)
+	})
+}
+
+func TestSqliteRepositoryKeepsCachedCurrenciesWhenAllAreStored(t *testing.T) {
+	db := repositories.ConnectSqlite(filepath.Join(t.TempDir(), "rates.db"))
+	migrate(t, db)
+	repo := repositories.NewSqliteCurrenciesRepository(db)
+	repo.GetCurrenciesCodesIdsMap(context.Background())
+	assert.NoError(t, db.Exec("INSERT INTO currencies_codes (code) VALUES ('EUR')").Error)
+
+	err := repo.InsertCurrenciesCodes(context.Background(), []string{"USD", "CHF"})
+
+	assert.NoError(t, err)
+	assert.NotContains(t, repo.GetCurrenciesCodesIdsMap(context.Background()), "EUR")
+}
+
+// TestPostgresCurrenciesRepository runs against database given in TEST_DB_DSN, its schema is migrated.
+// Exchange rates are deleted before every test, so it must not be run against database with real data.
+func TestPostgresCurrenciesRepository(t *testing.T) {
+	dsn := os.Getenv("TEST_DB_DSN")
+	if testing.Short() || dsn == "" {
+		t.Skip("TEST_DB_DSN is not set")
+	}
+
+	db := repositories.ConnectPostgres(config.DatabaseConfig{Dsn: dsn})
+	migrate(t, db)
+	testhelpers.RunCurrenciesRepositorySuite(t, func(t *testing.T) repositories.CurrenciesRepository {
+		assert.NoError(t, db.Exec("DELETE FROM exchange_rates").Error)
+		assert.NoError(t, db.Exec("DELETE FROM currencies_codes WHERE code NOT IN ?", repositories.SeedCurrenciesCodes).Error)
+		repo := repositories.NewPostgresCurrenciesRepository(db)
+		// currencies deleted above may be cached by previous tests
+		repositories.ResetCurrenciesCodesCache()
+		return repo
+	})
+}
+
+func migrate(t *testing.T, db *gorm.DB) {
+	migrator, err := migrations.New(db, false)
+	if assert.NoError(t, err) {
+		_, err = migrator.Up(context.Background())
+		assert.NoError(t, err)
+	}
+}
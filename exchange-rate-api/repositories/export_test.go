@@ -0,0 +1,7 @@
+package repositories
+
+func ResetCurrenciesCodesCache() {
+	currenciesCodesMu.Lock()
+	defer currenciesCodesMu.Unlock()
+	codesCurrenciesIdsMap = nil
+}
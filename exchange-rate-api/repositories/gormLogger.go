@@ -0,0 +1,46 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/kolan92/exchange-rate-api/logging"
+	"go.uber.org/zap"
+	gormlogger "gorm.io/gorm/logger"
+)
+
+// slowQueryThreshold is duration above which queries are logged as slow.
+const slowQueryThreshold = 200 * time.Millisecond
+
+// gormLogger logs slow queries as structured lines with request id.
+// Failed queries are not logged here, repositories and their callers log them with more context.
+type gormLogger struct{}
+
+func (l gormLogger) LogMode(gormlogger.LogLevel) gormlogger.Interface {
+	return l
+}
+
+func (l gormLogger) Info(ctx context.Context, message string, args ...interface{}) {
+	logging.FromContext(ctx).Sugar().Infof(message, args...)
+}
+
+func (l gormLogger) Warn(ctx context.Context, message string, args ...interface{}) {
+	logging.FromContext(ctx).Sugar().Warnf(message, args...)
+}
+
+func (l gormLogger) Error(ctx context.Context, message string, args ...interface{}) {
+	logging.FromContext(ctx).Sugar().Errorf(message, args...)
+}
+
+func (l gormLogger) Trace(ctx context.Context, begin time.Time, fc func() (string, int64), err error) {
+	duration := time.Since(begin)
+	if duration < slowQueryThreshold {
+		return
+	}
+
+	sql, rows := fc()
+	logging.FromContext(ctx).Warn("Slow query",
+		zap.Duration("duration", duration),
+		zap.String("sql", sql),
+		zap.Int64("rows", rows))
+}
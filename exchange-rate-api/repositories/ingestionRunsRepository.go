@@ -0,0 +1,78 @@
+package repositories
+
+import (
+	"context"
+	"sync"
+
+	"github.com/kolan92/exchange-rate-api/models"
+	"gorm.io/gorm"
+)
+
+type IngestionRunsRepository interface {
+	InsertIngestionRun(ctx context.Context, run *models.IngestionRun) error
+	// GetIngestionRuns returns the most recent runs first.
+	GetIngestionRuns(ctx context.Context, limit int) ([]models.IngestionRun, error)
+}
+
+// SqlIngestionRunsRepository stores runs in postgres or sqlite, queries are the same for both of them.
+type SqlIngestionRunsRepository struct {
+	db *gorm.DB
+}
+
+func NewSqlIngestionRunsRepository(db *gorm.DB) IngestionRunsRepository {
+	return &SqlIngestionRunsRepository{db}
+}
+
+// InsertIngestionRun sets id of the inserted run.
+func (r *SqlIngestionRunsRepository) InsertIngestionRun(ctx context.Context, run *models.IngestionRun) error {
+	return r.db.WithContext(ctx).Create(run).Error
+}
+
+func (r *SqlIngestionRunsRepository) GetIngestionRuns(ctx context.Context, limit int) ([]models.IngestionRun, error) {
+	runs := []models.IngestionRun{}
+
+	if err := r.db.WithContext(ctx).Order("started_at DESC, id DESC").Limit(limit).Find(&runs).Error; err != nil {
+		return nil, err
+	}
+
+	return runs, nil
+}
+
+// memoryIngestionRunsLimit bounds number of runs kept in memory, older runs are dropped.
+const memoryIngestionRunsLimit = 1000
+
+type MemoryIngestionRunsRepository struct {
+	mutex sync.Mutex
+	runs  []models.IngestionRun
+}
+
+func NewMemoryIngestionRunsRepository() IngestionRunsRepository {
+	return &MemoryIngestionRunsRepository{}
+}
+
+func (r *MemoryIngestionRunsRepository) InsertIngestionRun(ctx context.Context, run *models.IngestionRun) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	run.Id = 1
+	if len(r.runs) > 0 {
+		run.Id = r.runs[len(r.runs)-1].Id + 1
+	}
+
+	r.runs = append(r.runs, *run)
+	if len(r.runs) > memoryIngestionRunsLimit {
+		r.runs = r.runs[len(r.runs)-memoryIngestionRunsLimit:]
+	}
+	return nil
+}
+
+func (r *MemoryIngestionRunsRepository) GetIngestionRuns(ctx context.Context, limit int) ([]models.IngestionRun, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	runs := []models.IngestionRun{}
+	for i := len(r.runs) - 1; i >= 0 && len(runs) < limit; i-- {
+		runs = append(runs, r.runs[i])
+	}
+	return runs, nil
+}
@@ -0,0 +1,43 @@
+package repositories_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/kolan92/exchange-rate-api/models"
+	"github.com/kolan92/exchange-rate-api/repositories"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIngestionRunsRepositoriesReturnNewestRunsFirst(t *testing.T) {
+	db := repositories.ConnectSqlite(filepath.Join(t.TempDir(), "rates.db"))
+	t.Cleanup(func() {
+		if sqlDb, err := db.DB(); err == nil {
+			sqlDb.Close()
+		}
+	})
+	migrate(t, db)
+
+	for name, runsRepo := range map[string]repositories.IngestionRunsRepository{
+		"memory": repositories.NewMemoryIngestionRunsRepository(),
+		"sqlite": repositories.NewSqlIngestionRunsRepository(db),
+	} {
+		ctx := context.Background()
+		startedAt := time.Date(2022, 05, 02, 10, 00, 00, 0, time.UTC)
+		for i := 0; i < 3; i++ {
+			run := &models.IngestionRun{Provider: "file", StartedAt: startedAt.Add(time.Duration(i) * time.Hour), FinishedAt: startedAt, Inserted: i}
+			assert.NoError(t, runsRepo.InsertIngestionRun(ctx, run), name)
+			assert.Equal(t, i+1, run.Id, name)
+		}
+
+		runs, err := runsRepo.GetIngestionRuns(ctx, 2)
+
+		assert.NoError(t, err, name)
+		assert.Len(t, runs, 2, name)
+		assert.Equal(t, 2, runs[0].Inserted, name)
+		assert.Equal(t, 1, runs[1].Inserted, name)
+		assert.True(t, startedAt.Add(2*time.Hour).Equal(runs[0].StartedAt), name)
+	}
+}
@@ -0,0 +1,124 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/kolan92/exchange-rate-api/models"
+	"gorm.io/gorm"
+)
+
+// InvertingCurrenciesRepository reads rates of pairs in both directions. Every pair is stored in one direction only,
+// so rates of pair requested the other way round are read from the stored pair and inverted,
+// e.g. CHF/USD is read from stored USD/CHF.
+type InvertingCurrenciesRepository struct {
+	CurrenciesRepository
+}
+
+func NewInvertingCurrenciesRepository(repo CurrenciesRepository) CurrenciesRepository {
+	return &InvertingCurrenciesRepository{repo}
+}
+
+func (r *InvertingCurrenciesRepository) GetLastExchangeRate(ctx context.Context, sourceCurrencyId, destinationCurrencyId int) (*models.ExchangeRate, error) {
+	exchangeRate, err := r.CurrenciesRepository.GetLastExchangeRate(ctx, sourceCurrencyId, destinationCurrencyId)
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return exchangeRate, err
+	}
+
+	storedRate, invertedErr := r.CurrenciesRepository.GetLastExchangeRate(ctx, destinationCurrencyId, sourceCurrencyId)
+	if invertedErr != nil {
+		return nil, err
+	}
+	inverted := storedRate.Inverted()
+	return &inverted, nil
+}
+
+func (r *InvertingCurrenciesRepository) GetLastExchangeRates(ctx context.Context, currencyPairs []models.CurrencyPair) ([]models.ExchangeRate, error) {
+	exchangeRates, err := r.CurrenciesRepository.GetLastExchangeRates(ctx, withInvertedPairs(currencyPairs))
+	if err != nil {
+		return nil, err
+	}
+	return r.ofRequestedPairs(ctx, currencyPairs, exchangeRates), nil
+}
+
+func (r *InvertingCurrenciesRepository) GetRangeExchangeRate(ctx context.Context, sourceCurrencyId, destinationCurrencyId int, from, till *time.Time) ([]models.ExchangeRate, error) {
+	exchangeRates, err := r.CurrenciesRepository.GetRangeExchangeRate(ctx, sourceCurrencyId, destinationCurrencyId, from, till)
+	if err != nil || len(exchangeRates) > 0 {
+		return exchangeRates, err
+	}
+
+	storedRates, err := r.CurrenciesRepository.GetRangeExchangeRate(ctx, destinationCurrencyId, sourceCurrencyId, from, till)
+	if err != nil {
+		return nil, err
+	}
+	return invertAll(storedRates), nil
+}
+
+func (r *InvertingCurrenciesRepository) GetRangeExchangeRates(ctx context.Context, currencyPairs []models.CurrencyPair, from, till *time.Time) ([]models.ExchangeRate, error) {
+	exchangeRates, err := r.CurrenciesRepository.GetRangeExchangeRates(ctx, withInvertedPairs(currencyPairs), from, till)
+	if err != nil {
+		return nil, err
+	}
+	return r.ofRequestedPairs(ctx, currencyPairs, exchangeRates), nil
+}
+
+// ofRequestedPairs returns rates of requested pairs in the order of the repository, rates of pairs
+// stored the other way round are inverted.
+func (r *InvertingCurrenciesRepository) ofRequestedPairs(ctx context.Context, currencyPairs []models.CurrencyPair, exchangeRates []models.ExchangeRate) []models.ExchangeRate {
+	isRequested := make(map[models.CurrencyPair]bool)
+	for _, currencyPair := range currencyPairs {
+		isRequested[currencyPair] = true
+	}
+
+	currencyCodesMap := r.GetCurrenciesCodesIdsMap(ctx)
+	ratesPairs := make([]models.CurrencyPair, len(exchangeRates))
+	isStored := make(map[models.CurrencyPair]bool)
+	for i, exchangeRate := range exchangeRates {
+		ratesPairs[i] = models.CurrencyPair{
+			SourceCurrencyId:      currencyCodesMap[exchangeRate.Source],
+			DestinationCurrencyId: currencyCodesMap[exchangeRate.Destination],
+		}
+		isStored[ratesPairs[i]] = true
+	}
+
+	requestedRates := []models.ExchangeRate{}
+	for i, exchangeRate := range exchangeRates {
+		if isRequested[ratesPairs[i]] {
+			requestedRates = append(requestedRates, exchangeRate)
+		}
+		if inverted := invertedPair(ratesPairs[i]); isRequested[inverted] && !isStored[inverted] {
+			requestedRates = append(requestedRates, exchangeRate.Inverted())
+		}
+	}
+	return requestedRates
+}
+
+func withInvertedPairs(currencyPairs []models.CurrencyPair) []models.CurrencyPair {
+	allPairs := make([]models.CurrencyPair, 0, 2*len(currencyPairs))
+	isAdded := make(map[models.CurrencyPair]bool)
+	for _, currencyPair := range currencyPairs {
+		for _, pair := range []models.CurrencyPair{currencyPair, invertedPair(currencyPair)} {
+			if !isAdded[pair] {
+				isAdded[pair] = true
+				allPairs = append(allPairs, pair)
+			}
+		}
+	}
+	return allPairs
+}
+
+func invertedPair(currencyPair models.CurrencyPair) models.CurrencyPair {
+	return models.CurrencyPair{
+		SourceCurrencyId:      currencyPair.DestinationCurrencyId,
+		DestinationCurrencyId: currencyPair.SourceCurrencyId,
+	}
+}
+
+func invertAll(exchangeRates []models.ExchangeRate) []models.ExchangeRate {
+	inverted := make([]models.ExchangeRate, len(exchangeRates))
+	for i, exchangeRate := range exchangeRates {
+		inverted[i] = exchangeRate.Inverted()
+	}
+	return inverted
+}
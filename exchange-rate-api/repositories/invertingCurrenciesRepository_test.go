@@ -0,0 +1,80 @@
+package repositories_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kolan92/exchange-rate-api/models"
+	"github.com/kolan92/exchange-rate-api/repositories"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func newInvertingRepository(t *testing.T) (repositories.CurrenciesRepository, map[string]int) {
+	ctx := context.Background()
+	repo := repositories.NewMemoryCurrenciesRepository(repositories.SeedCurrenciesCodes)
+	for day, value := range []string{"0.9774", "0.98"} {
+		rate := decimal.RequireFromString(value)
+		exchangeRate := &models.ExchangeRate{Source: "USD", Destination: "CHF", Date: time.Date(2022, 05, day+1, 0, 00, 00, 0, time.UTC), Rate: &rate}
+		assert.NoError(t, repo.InsertExchangeRate(ctx, exchangeRate))
+	}
+	return repositories.NewInvertingCurrenciesRepository(repo), repo.GetCurrenciesCodesIdsMap(ctx)
+}
+
+func TestInvertingRepositoryReadsStoredPair(t *testing.T) {
+	repo, codes := newInvertingRepository(t)
+
+	lastRate, err := repo.GetLastExchangeRate(context.Background(), codes["USD"], codes["CHF"])
+
+	assert.NoError(t, err)
+	assert.Equal(t, "USD", lastRate.Source)
+	assert.Equal(t, "0.98", lastRate.Rate.String())
+}
+
+func TestInvertingRepositoryInvertsLastRateOfPairStoredTheOtherWayRound(t *testing.T) {
+	repo, codes := newInvertingRepository(t)
+
+	lastRate, err := repo.GetLastExchangeRate(context.Background(), codes["CHF"], codes["USD"])
+	_, missingErr := repo.GetLastExchangeRate(context.Background(), codes["JPY"], codes["USD"])
+
+	assert.NoError(t, err)
+	assert.Equal(t, "CHF", lastRate.Source)
+	assert.Equal(t, "USD", lastRate.Destination)
+	assert.Equal(t, "1.020408", lastRate.Rate.String())
+	assert.ErrorIs(t, missingErr, gorm.ErrRecordNotFound)
+}
+
+func TestInvertingRepositoryInvertsRangeOfPairStoredTheOtherWayRound(t *testing.T) {
+	repo, codes := newInvertingRepository(t)
+	from, till := time.Date(2022, 05, 1, 0, 00, 00, 0, time.UTC), time.Date(2022, 05, 3, 0, 00, 00, 0, time.UTC)
+
+	exchangeRates, err := repo.GetRangeExchangeRate(context.Background(), codes["CHF"], codes["USD"], &from, &till)
+
+	assert.NoError(t, err)
+	if assert.Len(t, exchangeRates, 2) {
+		assert.Equal(t, "CHF", exchangeRates[0].Source)
+		assert.Equal(t, "1.020408", exchangeRates[0].Rate.String())
+		assert.Equal(t, "1.023123", exchangeRates[1].Rate.String())
+	}
+}
+
+func TestInvertingRepositoryReadsManyPairsInBothDirections(t *testing.T) {
+	repo, codes := newInvertingRepository(t)
+	currencyPairs := []models.CurrencyPair{
+		{SourceCurrencyId: codes["CHF"], DestinationCurrencyId: codes["USD"]},
+		{SourceCurrencyId: codes["USD"], DestinationCurrencyId: codes["CHF"]},
+		{SourceCurrencyId: codes["JPY"], DestinationCurrencyId: codes["USD"]},
+	}
+
+	lastRates, err := repo.GetLastExchangeRates(context.Background(), currencyPairs)
+
+	assert.NoError(t, err)
+	if assert.Len(t, lastRates, 2) {
+		assert.Equal(t, "USD", lastRates[0].Source)
+		assert.Equal(t, "0.98", lastRates[0].Rate.String())
+		assert.Equal(t, "CHF", lastRates[1].Source)
+		assert.Equal(t, "1.020408", lastRates[1].Rate.String())
+	}
+}
@@ -0,0 +1,342 @@
+package repositories
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	customerros "github.com/kolan92/exchange-rate-api/custom-erros"
+	"github.com/kolan92/exchange-rate-api/models"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+// SeedCurrenciesCodes are currencies inserted by the first migration, in the order of their ids.
+var SeedCurrenciesCodes = []string{"USD", "CHF", "CNY", "JPY", "KRW", "NOK", "SEK", "THB", "TWD"}
+
+type memoryRateKey struct {
+	sourceCurrencyId      int
+	destinationCurrencyId int
+	date                  int64
+}
+
+type memoryRate struct {
+	sourceCurrencyId      int
+	destinationCurrencyId int
+	date                  time.Time
+	rate                  *decimal.Decimal
+}
+
+// MemoryCurrenciesRepository keeps exchange rates in memory with the same semantics as postgres repository.
+// It is used to run api without database, data is lost on restart.
+type MemoryCurrenciesRepository struct {
+	mutex                 sync.RWMutex
+	codesCurrenciesIdsMap map[string]int
+	currenciesIdsCodesMap map[int]string
+	rates                 map[memoryRateKey]memoryRate
+	// pairs are stored pairs, so pair stored the other way round is found without scanning rates
+	pairs map[models.CurrencyPair]bool
+}
+
+// NewMemoryCurrenciesRepository creates repository with given currencies, ids are assigned from 1 in the given order.
+func NewMemoryCurrenciesRepository(currenciesCodes []string) CurrenciesRepository {
+	r := &MemoryCurrenciesRepository{
+		codesCurrenciesIdsMap: make(map[string]int),
+		currenciesIdsCodesMap: make(map[int]string),
+		rates:                 make(map[memoryRateKey]memoryRate),
+		pairs:                 make(map[models.CurrencyPair]bool),
+	}
+	for i, currencyCode := range currenciesCodes {
+		r.codesCurrenciesIdsMap[currencyCode] = i + 1
+		r.currenciesIdsCodesMap[i+1] = currencyCode
+	}
+	return r
+}
+
+func (r *MemoryCurrenciesRepository) GetCurrenciesCodesIdsMap(ctx context.Context) map[string]int {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	return r.codesCurrenciesIdsMap
+}
+
+// InsertCurrenciesCodes assigns ids following the last one. Maps are replaced rather than changed,
+// as returned map is read without lock.
+func (r *MemoryCurrenciesRepository) InsertCurrenciesCodes(ctx context.Context, codes []string) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.addCurrencies(codes)
+	return nil
+}
+
+// addCurrencies replaces maps of currencies with ones containing missing codes, mutex must be held.
+func (r *MemoryCurrenciesRepository) addCurrencies(codes []string) {
+	codesCurrenciesIdsMap := make(map[string]int, len(r.codesCurrenciesIdsMap)+len(codes))
+	currenciesIdsCodesMap := make(map[int]string, len(r.codesCurrenciesIdsMap)+len(codes))
+	for currencyCode, id := range r.codesCurrenciesIdsMap {
+		codesCurrenciesIdsMap[currencyCode] = id
+		currenciesIdsCodesMap[id] = currencyCode
+	}
+	for _, currencyCode := range codes {
+		if _, isFound := codesCurrenciesIdsMap[currencyCode]; !isFound {
+			id := len(codesCurrenciesIdsMap) + 1
+			codesCurrenciesIdsMap[currencyCode] = id
+			currenciesIdsCodesMap[id] = currencyCode
+		}
+	}
+
+	r.codesCurrenciesIdsMap = codesCurrenciesIdsMap
+	r.currenciesIdsCodesMap = currenciesIdsCodesMap
+}
+
+func (r *MemoryCurrenciesRepository) GetCurrenciesCodes(ctx context.Context) []string {
+	currencies := []string{}
+
+	for currencyCode := range r.GetCurrenciesCodesIdsMap(ctx) {
+		currencies = append(currencies, currencyCode)
+	}
+	return currencies
+}
+
+func (r *MemoryCurrenciesRepository) GetLastExchangeRate(ctx context.Context, sourceCurrencyId, destinationCurrencyId int) (*models.ExchangeRate, error) {
+	exchangeRates := r.lastExchangeRates([]models.CurrencyPair{{SourceCurrencyId: sourceCurrencyId, DestinationCurrencyId: destinationCurrencyId}})
+	if len(exchangeRates) == 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return &exchangeRates[0], nil
+}
+
+// GetLastExchangeRates returns most recent not null exchange rate for each of currency pairs.
+// Pairs without any rate are skipped.
+func (r *MemoryCurrenciesRepository) GetLastExchangeRates(ctx context.Context, currencyPairs []models.CurrencyPair) ([]models.ExchangeRate, error) {
+	return r.lastExchangeRates(currencyPairs), nil
+}
+
+func (r *MemoryCurrenciesRepository) lastExchangeRates(currencyPairs []models.CurrencyPair) []models.ExchangeRate {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	return r.newestPerPair(inPairs(currencyPairs), func(rate memoryRate) bool { return rate.rate != nil })
+}
+
+func (r *MemoryCurrenciesRepository) GetAllExchangeRatesFromDate(ctx context.Context, date time.Time) ([]models.ExchangeRate, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	return r.find(func(rate memoryRate) bool { return rate.date.Equal(date) }), nil
+}
+
+func (r *MemoryCurrenciesRepository) GetRangeExchangeRate(ctx context.Context, sourceCurrencyId, destinationCurrencyId int, from, till *time.Time) ([]models.ExchangeRate, error) {
+	return r.GetRangeExchangeRates(ctx, []models.CurrencyPair{{SourceCurrencyId: sourceCurrencyId, DestinationCurrencyId: destinationCurrencyId}}, from, till)
+}
+
+// GetRangeExchangeRates returns exchange rates of currency pairs from the from date inclusive, till the till date exclusive.
+func (r *MemoryCurrenciesRepository) GetRangeExchangeRates(ctx context.Context, currencyPairs []models.CurrencyPair, from, till *time.Time) ([]models.ExchangeRate, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	isInPairs := inPairs(currencyPairs)
+	return r.find(func(rate memoryRate) bool {
+		return isInPairs(rate) && !rate.date.Before(*from) && rate.date.Before(*till)
+	}), nil
+}
+
+// GetNewestExchangeRateDate returns date of the most recent exchange rate of any currency pair, nil when there are no rates.
+func (r *MemoryCurrenciesRepository) GetNewestExchangeRateDate(ctx context.Context) (*time.Time, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	var newestDate *time.Time
+	for _, rate := range r.rates {
+		if newestDate == nil || rate.date.After(*newestDate) {
+			date := rate.date
+			newestDate = &date
+		}
+	}
+	return newestDate, nil
+}
+
+// GetNewestExchangeRates returns the most recent exchange rate of every currency pair, including rates without value.
+func (r *MemoryCurrenciesRepository) GetNewestExchangeRates(ctx context.Context) ([]models.ExchangeRate, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	return r.newestPerPair(func(memoryRate) bool { return true }, func(memoryRate) bool { return true }), nil
+}
+
+// InsertExchangeRate stores the rate rounded to the scale of database column, rate of the same currencies and date
+// is rejected with ErrDuplicateKeyViolation.
+func (r *MemoryCurrenciesRepository) InsertExchangeRate(ctx context.Context, exchangeRate *models.ExchangeRate) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	key, err := r.key(exchangeRate)
+	if err != nil {
+		return err
+	}
+
+	if _, isFound := r.rates[key]; isFound {
+		return customerros.ErrDuplicateKeyViolation
+	}
+	if r.isInverted(key) {
+		return invertedPairError(*exchangeRate)
+	}
+	r.store(key, exchangeRate)
+
+	return nil
+}
+
+// UpdateExchangeRate corrects rate of stored exchange rate, gorm.ErrRecordNotFound is returned when it is not stored.
+func (r *MemoryCurrenciesRepository) UpdateExchangeRate(ctx context.Context, exchangeRate *models.ExchangeRate) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	key, _ := r.key(exchangeRate)
+	if _, isFound := r.rates[key]; !isFound {
+		return gorm.ErrRecordNotFound
+	}
+	r.store(key, exchangeRate)
+
+	return nil
+}
+
+// ImportExchangeRates adds missing currencies and checks pairs of all rates first, so nothing is stored
+// and previous currencies are restored when any of them is invalid.
+func (r *MemoryCurrenciesRepository) ImportExchangeRates(ctx context.Context, exchangeRates []models.ExchangeRate, replace bool) (models.ImportSummary, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	codesCurrenciesIdsMap, currenciesIdsCodesMap := r.codesCurrenciesIdsMap, r.currenciesIdsCodesMap
+	r.addCurrencies(currenciesCodesOf(exchangeRates))
+	keys := make([]memoryRateKey, 0, len(exchangeRates))
+	for i := range exchangeRates {
+		key, _ := r.key(&exchangeRates[i])
+		if r.isInverted(key) {
+			r.codesCurrenciesIdsMap, r.currenciesIdsCodesMap = codesCurrenciesIdsMap, currenciesIdsCodesMap
+			return models.ImportSummary{}, invertedPairError(exchangeRates[i])
+		}
+		keys = append(keys, key)
+	}
+
+	summary := models.ImportSummary{}
+	for i, key := range keys {
+		_, isFound := r.rates[key]
+		switch {
+		case !isFound:
+			summary.Inserted++
+		case replace:
+			summary.Updated++
+		default:
+			summary.Skipped++
+			continue
+		}
+		summary.Stored = append(summary.Stored, i)
+		r.store(key, &exchangeRates[i])
+	}
+	return summary, nil
+}
+
+// key identifies rate of the currencies and date, mutex must be held.
+func (r *MemoryCurrenciesRepository) key(exchangeRate *models.ExchangeRate) (memoryRateKey, error) {
+	sourceCurrencyId, isSourceFound := r.codesCurrenciesIdsMap[exchangeRate.Source]
+	destinationCurrencyId, isDestinationFound := r.codesCurrenciesIdsMap[exchangeRate.Destination]
+	if !isSourceFound || !isDestinationFound {
+		return memoryRateKey{}, customerros.Newf(customerros.CodeUnknownCurrency, "unknown %s or %s currency", exchangeRate.Source, exchangeRate.Destination)
+	}
+
+	return memoryRateKey{sourceCurrencyId, destinationCurrencyId, exchangeRate.Date.UTC().UnixNano()}, nil
+}
+
+// isInverted checks whether pair of the key is stored the other way round, mutex must be held.
+func (r *MemoryCurrenciesRepository) isInverted(key memoryRateKey) bool {
+	return r.pairs[models.CurrencyPair{SourceCurrencyId: key.destinationCurrencyId, DestinationCurrencyId: key.sourceCurrencyId}]
+}
+
+// store keeps the rate rounded to the scale of database column, mutex must be held.
+func (r *MemoryCurrenciesRepository) store(key memoryRateKey, exchangeRate *models.ExchangeRate) {
+	rate := memoryRate{sourceCurrencyId: key.sourceCurrencyId, destinationCurrencyId: key.destinationCurrencyId, date: exchangeRate.Date.UTC()}
+	if exchangeRate.Rate != nil {
+		roundedRate := exchangeRate.Rate.Round(models.RateScale)
+		rate.rate = &roundedRate
+	}
+	r.rates[key] = rate
+	r.pairs[models.CurrencyPair{SourceCurrencyId: key.sourceCurrencyId, DestinationCurrencyId: key.destinationCurrencyId}] = true
+}
+
+// find returns matching rates ordered by date descending, rates of the same date by currencies ids.
+func (r *MemoryCurrenciesRepository) find(isMatching func(memoryRate) bool) []models.ExchangeRate {
+	matchingRates := []memoryRate{}
+	for _, rate := range r.rates {
+		if isMatching(rate) {
+			matchingRates = append(matchingRates, rate)
+		}
+	}
+
+	sort.Slice(matchingRates, func(i, j int) bool {
+		if !matchingRates[i].date.Equal(matchingRates[j].date) {
+			return matchingRates[i].date.After(matchingRates[j].date)
+		}
+		return isPairBefore(matchingRates[i], matchingRates[j])
+	})
+
+	return r.toExchangeRates(matchingRates)
+}
+
+// newestPerPair returns the most recent of rates accepted by isValid for each pair accepted by isInPairs, ordered by currencies ids.
+func (r *MemoryCurrenciesRepository) newestPerPair(isInPairs, isValid func(memoryRate) bool) []models.ExchangeRate {
+	newestRates := make(map[models.CurrencyPair]memoryRate)
+	for _, rate := range r.rates {
+		if !isInPairs(rate) || !isValid(rate) {
+			continue
+		}
+
+		pair := models.CurrencyPair{SourceCurrencyId: rate.sourceCurrencyId, DestinationCurrencyId: rate.destinationCurrencyId}
+		if newestRate, isFound := newestRates[pair]; !isFound || rate.date.After(newestRate.date) {
+			newestRates[pair] = rate
+		}
+	}
+
+	rates := make([]memoryRate, 0, len(newestRates))
+	for _, rate := range newestRates {
+		rates = append(rates, rate)
+	}
+	sort.Slice(rates, func(i, j int) bool { return isPairBefore(rates[i], rates[j]) })
+
+	return r.toExchangeRates(rates)
+}
+
+func (r *MemoryCurrenciesRepository) toExchangeRates(rates []memoryRate) []models.ExchangeRate {
+	exchangeRates := make([]models.ExchangeRate, 0, len(rates))
+	for _, rate := range rates {
+		exchangeRate := models.ExchangeRate{
+			Source:      r.currenciesIdsCodesMap[rate.sourceCurrencyId],
+			Destination: r.currenciesIdsCodesMap[rate.destinationCurrencyId],
+			Date:        rate.date,
+		}
+		if rate.rate != nil {
+			value := *rate.rate
+			exchangeRate.Rate = &value
+		}
+		exchangeRates = append(exchangeRates, exchangeRate)
+	}
+	return exchangeRates
+}
+
+func inPairs(currencyPairs []models.CurrencyPair) func(memoryRate) bool {
+	pairs := make(map[models.CurrencyPair]bool, len(currencyPairs))
+	for _, currencyPair := range currencyPairs {
+		pairs[currencyPair] = true
+	}
+	return func(rate memoryRate) bool {
+		return pairs[models.CurrencyPair{SourceCurrencyId: rate.sourceCurrencyId, DestinationCurrencyId: rate.destinationCurrencyId}]
+	}
+}
+
+func isPairBefore(first, second memoryRate) bool {
+	if first.sourceCurrencyId != second.sourceCurrencyId {
+		return first.sourceCurrencyId < second.sourceCurrencyId
+	}
+	return first.destinationCurrencyId < second.destinationCurrencyId
+}
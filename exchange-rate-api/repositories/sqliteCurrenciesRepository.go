@@ -0,0 +1,341 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/glebarez/go-sqlite"
+	gormsqlite "github.com/glebarez/sqlite"
+	customerros "github.com/kolan92/exchange-rate-api/custom-erros"
+	"github.com/kolan92/exchange-rate-api/models"
+	"github.com/shopspring/decimal"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// sqliteConstraintPrimaryKey and sqliteConstraintUnique are extended result codes of violated keys.
+const (
+	sqliteConstraintPrimaryKey = 1555
+	sqliteConstraintUnique     = 2067
+)
+
+// ConnectSqlite opens database file, it is created when missing. Schema is created by migrations.
+func ConnectSqlite(path string) *gorm.DB {
+	dsn := path + "?_pragma=foreign_keys(1)&_pragma=busy_timeout(5000)&_pragma=journal_mode(WAL)"
+	db, err := gorm.Open(gormsqlite.Open(dsn), &gorm.Config{Logger: gormLogger{}})
+	if err != nil {
+		panic(fmt.Sprintf("failed to open sqlite database: %v", err))
+	}
+
+	return db
+}
+
+// SqliteCurrenciesRepository stores exchange rates in sqlite database with the same semantics as postgres repository.
+type SqliteCurrenciesRepository struct {
+	db                    *gorm.DB
+	currenciesCodesMu     sync.Mutex
+	codesCurrenciesIdsMap map[string]int
+}
+
+func NewSqliteCurrenciesRepository(db *gorm.DB) CurrenciesRepository {
+	return &SqliteCurrenciesRepository{db: db}
+}
+
+// GetCurrenciesCodesIdsMap loads currencies once, loading is retried until currencies are found.
+func (r *SqliteCurrenciesRepository) GetCurrenciesCodesIdsMap(ctx context.Context) map[string]int {
+	r.currenciesCodesMu.Lock()
+	defer r.currenciesCodesMu.Unlock()
+
+	if len(r.codesCurrenciesIdsMap) > 0 {
+		return r.codesCurrenciesIdsMap
+	}
+
+	currenciesCodesMap, err := loadCurrenciesCodesIdsMap(r.db.WithContext(ctx))
+	if err != nil {
+		logError(ctx, "GetCurrenciesCodesIdsMap", err)
+	}
+	r.codesCurrenciesIdsMap = currenciesCodesMap
+
+	return r.codesCurrenciesIdsMap
+}
+
+func (r *SqliteCurrenciesRepository) InsertCurrenciesCodes(ctx context.Context, codes []string) error {
+	inserted, err := insertCurrenciesCodes(ctx, r.db, codes)
+	if err != nil || inserted == 0 {
+		return err
+	}
+
+	r.currenciesCodesMu.Lock()
+	defer r.currenciesCodesMu.Unlock()
+	r.codesCurrenciesIdsMap = nil
+	return nil
+}
+
+func (r *SqliteCurrenciesRepository) GetCurrenciesCodes(ctx context.Context) []string {
+	currencies := []string{}
+
+	for currencyCode := range r.GetCurrenciesCodesIdsMap(ctx) {
+		currencies = append(currencies, currencyCode)
+	}
+	return currencies
+}
+
+const sqliteSelectRates = `
+	SELECT destination_code.code as destination, source_code.code as source, rates.date, rates.rate
+		FROM exchange_rates rates
+		JOIN currencies_codes source_code
+		ON rates.source_currency_id = source_code.id
+		JOIN currencies_codes destination_code
+		ON rates.destination_currency_id = destination_code.id
+`
+
+func (r *SqliteCurrenciesRepository) GetLastExchangeRate(ctx context.Context, sourceCurrencyId, destinationCurrencyId int) (*models.ExchangeRate, error) {
+	var exchangeRate models.ExchangeRate
+
+	const query string = sqliteSelectRates + `
+		WHERE rates.source_currency_id = ?
+		AND rates.destination_currency_id = ?
+		AND rates.rate IS NOT NULL
+		ORDER BY rates.date DESC
+		LIMIT 1
+	`
+
+	if err := r.db.WithContext(ctx).Raw(query, sourceCurrencyId, destinationCurrencyId).First(&exchangeRate).Error; err != nil {
+		logError(ctx, "GetLastExchangeRate", err, zap.Int("sourceCurrencyId", sourceCurrencyId), zap.Int("destinationCurrencyId", destinationCurrencyId))
+		return nil, err
+	}
+
+	return &exchangeRate, nil
+}
+
+// GetLastExchangeRates returns most recent not null exchange rate for each of currency pairs in single query.
+// Pairs without any rate are skipped.
+func (r *SqliteCurrenciesRepository) GetLastExchangeRates(ctx context.Context, currencyPairs []models.CurrencyPair) ([]models.ExchangeRate, error) {
+	exchangeRates := []models.ExchangeRate{}
+	if len(currencyPairs) == 0 {
+		return exchangeRates, nil
+	}
+
+	pairsCondition, pairsArgs := sqlitePairsCondition(currencyPairs)
+	query := `
+	SELECT destination, source, date, rate FROM (
+		SELECT destination_code.code as destination, source_code.code as source, rates.date, rates.rate,
+			rates.source_currency_id, rates.destination_currency_id,
+			ROW_NUMBER() OVER (PARTITION BY rates.source_currency_id, rates.destination_currency_id ORDER BY rates.date DESC) as position
+			FROM exchange_rates rates
+			JOIN currencies_codes source_code
+			ON rates.source_currency_id = source_code.id
+			JOIN currencies_codes destination_code
+			ON rates.destination_currency_id = destination_code.id
+			WHERE ` + pairsCondition + `
+			AND rates.rate IS NOT NULL
+	)
+	WHERE position = 1
+	ORDER BY source_currency_id, destination_currency_id
+	`
+
+	if err := r.db.WithContext(ctx).Raw(query, pairsArgs...).Scan(&exchangeRates).Error; err != nil {
+		logError(ctx, "GetLastExchangeRates", err, zap.Int("currencyPairs", len(currencyPairs)))
+		return nil, err
+	}
+
+	return exchangeRates, nil
+}
+
+func (r *SqliteCurrenciesRepository) GetAllExchangeRatesFromDate(ctx context.Context, date time.Time) ([]models.ExchangeRate, error) {
+	exchangeRates := []models.ExchangeRate{}
+
+	const query string = sqliteSelectRates + `
+		WHERE rates.date = ?
+		ORDER BY rates.source_currency_id, rates.destination_currency_id
+	`
+
+	if err := r.db.WithContext(ctx).Raw(query, date.UTC()).Scan(&exchangeRates).Error; err != nil {
+		logError(ctx, "GetAllExchangeRatesFromDate", err, zap.Time("date", date))
+		return nil, err
+	}
+
+	return exchangeRates, nil
+}
+
+func (r *SqliteCurrenciesRepository) GetRangeExchangeRate(ctx context.Context, sourceCurrencyId, destinationCurrencyId int, from, till *time.Time) ([]models.ExchangeRate, error) {
+	return r.GetRangeExchangeRates(ctx, []models.CurrencyPair{{SourceCurrencyId: sourceCurrencyId, DestinationCurrencyId: destinationCurrencyId}}, from, till)
+}
+
+// GetRangeExchangeRates returns exchange rates in the time period for all currency pairs in single query.
+func (r *SqliteCurrenciesRepository) GetRangeExchangeRates(ctx context.Context, currencyPairs []models.CurrencyPair, from, till *time.Time) ([]models.ExchangeRate, error) {
+	exchangeRates := []models.ExchangeRate{}
+	if len(currencyPairs) == 0 {
+		return exchangeRates, nil
+	}
+
+	pairsCondition, args := sqlitePairsCondition(currencyPairs)
+	query := sqliteSelectRates + `
+		WHERE ` + pairsCondition + `
+		AND rates.date >= ?
+		AND rates.date < ?
+		ORDER BY rates.date DESC, rates.source_currency_id, rates.destination_currency_id
+	`
+	args = append(args, from.UTC(), till.UTC())
+
+	if err := r.db.WithContext(ctx).Raw(query, args...).Scan(&exchangeRates).Error; err != nil {
+		logError(ctx, "GetRangeExchangeRates", err, zap.Int("currencyPairs", len(currencyPairs)), zap.Timep("from", from), zap.Timep("till", till))
+		return nil, err
+	}
+
+	return exchangeRates, nil
+}
+
+// GetNewestExchangeRateDate returns date of the most recent exchange rate of any currency pair, nil when there are no rates.
+// Date column is selected instead of MAX, as driver parses only values of columns declared as timestamps.
+func (r *SqliteCurrenciesRepository) GetNewestExchangeRateDate(ctx context.Context) (*time.Time, error) {
+	var newestDates []time.Time
+
+	if err := r.db.WithContext(ctx).Raw("SELECT date FROM exchange_rates ORDER BY date DESC LIMIT 1").Scan(&newestDates).Error; err != nil {
+		logError(ctx, "GetNewestExchangeRateDate", err)
+		return nil, err
+	}
+
+	if len(newestDates) == 0 {
+		return nil, nil
+	}
+	return &newestDates[0], nil
+}
+
+// GetNewestExchangeRates returns the most recent exchange rate of every currency pair, including rates without value.
+func (r *SqliteCurrenciesRepository) GetNewestExchangeRates(ctx context.Context) ([]models.ExchangeRate, error) {
+	exchangeRates := []models.ExchangeRate{}
+
+	const query string = `
+	SELECT destination, source, date, rate FROM (
+		SELECT destination_code.code as destination, source_code.code as source, rates.date, rates.rate,
+			rates.source_currency_id, rates.destination_currency_id,
+			ROW_NUMBER() OVER (PARTITION BY rates.source_currency_id, rates.destination_currency_id ORDER BY rates.date DESC) as position
+			FROM exchange_rates rates
+			JOIN currencies_codes source_code
+			ON rates.source_currency_id = source_code.id
+			JOIN currencies_codes destination_code
+			ON rates.destination_currency_id = destination_code.id
+	)
+	WHERE position = 1
+	ORDER BY source_currency_id, destination_currency_id
+	`
+
+	if err := r.db.WithContext(ctx).Raw(query).Scan(&exchangeRates).Error; err != nil {
+		logError(ctx, "GetNewestExchangeRates", err)
+		return nil, err
+	}
+
+	return exchangeRates, nil
+}
+
+// InsertExchangeRate stores the rate rounded to 6 decimal places, as NUMERIC(15, 6) column of postgres does.
+func (r *SqliteCurrenciesRepository) InsertExchangeRate(ctx context.Context, exchangeRate *models.ExchangeRate) error {
+	codesCurrenciesIdsMap := r.GetCurrenciesCodesIdsMap(ctx)
+
+	dbExchangeRate := &models.DbExchangeRate{
+		Source:      codesCurrenciesIdsMap[exchangeRate.Source],
+		Destination: codesCurrenciesIdsMap[exchangeRate.Destination],
+		Date:        exchangeRate.Date.UTC(),
+	}
+	if exchangeRate.Rate != nil {
+		roundedRate := exchangeRate.Rate.Round(models.RateScale)
+		dbExchangeRate.Rate = &roundedRate
+	}
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := rejectInvertedPairs(tx, lockSqlitePairs, codesCurrenciesIdsMap, []models.ExchangeRate{*exchangeRate}); err != nil {
+			return err
+		}
+		return tx.Create(dbExchangeRate).Error
+	})
+	if err != nil {
+		var sqliteError *sqlite.Error
+		if errors.As(err, &sqliteError) && (sqliteError.Code() == sqliteConstraintPrimaryKey || sqliteError.Code() == sqliteConstraintUnique) {
+			return customerros.ErrDuplicateKeyViolation
+		}
+		if customerros.Code(err) == customerros.CodeInvertedPair {
+			return err
+		}
+
+		logError(ctx, "InsertExchangeRate", err,
+			zap.String("source", exchangeRate.Source),
+			zap.String("destination", exchangeRate.Destination),
+			zap.Time("date", exchangeRate.Date))
+		return err
+	}
+	return nil
+}
+
+// UpdateExchangeRate corrects rate of stored exchange rate, gorm.ErrRecordNotFound is returned when it is not stored.
+func (r *SqliteCurrenciesRepository) UpdateExchangeRate(ctx context.Context, exchangeRate *models.ExchangeRate) error {
+	codesCurrenciesIdsMap := r.GetCurrenciesCodesIdsMap(ctx)
+
+	var rate *decimal.Decimal
+	if exchangeRate.Rate != nil {
+		roundedRate := exchangeRate.Rate.Round(models.RateScale)
+		rate = &roundedRate
+	}
+
+	result := r.db.WithContext(ctx).
+		Model(&models.DbExchangeRate{}).
+		Where("source_currency_id = ? AND destination_currency_id = ? AND date = ?",
+			codesCurrenciesIdsMap[exchangeRate.Source], codesCurrenciesIdsMap[exchangeRate.Destination], exchangeRate.Date.UTC()).
+		Update("rate", rate)
+	if result.Error != nil {
+		logError(ctx, "UpdateExchangeRate", result.Error,
+			zap.String("source", exchangeRate.Source),
+			zap.String("destination", exchangeRate.Destination),
+			zap.Time("date", exchangeRate.Date))
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// ImportExchangeRates stores rates rounded like InsertExchangeRate does.
+func (r *SqliteCurrenciesRepository) ImportExchangeRates(ctx context.Context, exchangeRates []models.ExchangeRate, replace bool) (models.ImportSummary, error) {
+	summary, insertedCurrencies, err := importExchangeRates(ctx, r.db, exchangeRates, replace, lockSqlitePairs,
+		func(exchangeRate models.ExchangeRate, codesCurrenciesIdsMap map[string]int) models.DbExchangeRate {
+			dbExchangeRate := models.DbExchangeRate{
+				Source:      codesCurrenciesIdsMap[exchangeRate.Source],
+				Destination: codesCurrenciesIdsMap[exchangeRate.Destination],
+				Date:        exchangeRate.Date.UTC(),
+			}
+			if exchangeRate.Rate != nil {
+				roundedRate := exchangeRate.Rate.Round(models.RateScale)
+				dbExchangeRate.Rate = &roundedRate
+			}
+			return dbExchangeRate
+		})
+	if insertedCurrencies > 0 {
+		r.currenciesCodesMu.Lock()
+		defer r.currenciesCodesMu.Unlock()
+		r.codesCurrenciesIdsMap = nil
+	}
+	return summary, err
+}
+
+// lockSqlitePairs takes write lock of the database with statement which doesn't change anything, like BEGIN IMMEDIATE
+// does. Sqlite has one writer at a time, so other transactions wait for commit before they check pairs. Transaction
+// which only read before taking write lock would fail with SQLITE_BUSY when other transaction writes in the meantime.
+func lockSqlitePairs(tx *gorm.DB, currencyPairs []models.CurrencyPair) error {
+	return tx.Exec("DELETE FROM exchange_rates WHERE 0 = 1").Error
+}
+
+// sqlitePairsCondition matches currency pairs with row values, sqlite doesn't accept list of tuples as IN argument.
+func sqlitePairsCondition(currencyPairs []models.CurrencyPair) (string, []interface{}) {
+	placeholders := make([]string, 0, len(currencyPairs))
+	args := make([]interface{}, 0, 2*len(currencyPairs))
+	for _, currencyPair := range currencyPairs {
+		placeholders = append(placeholders, "(?, ?)")
+		args = append(args, currencyPair.SourceCurrencyId, currencyPair.DestinationCurrencyId)
+	}
+	return "(rates.source_currency_id, rates.destination_currency_id) IN (VALUES " + strings.Join(placeholders, ", ") + ")", args
+}
@@ -0,0 +1,96 @@
+package rounding
+
+import (
+	customerros "github.com/kolan92/exchange-rate-api/custom-erros"
+	"github.com/shopspring/decimal"
+)
+
+type Mode string
+
+const (
+	// HalfEven rounds half to the nearest even digit, also known as bankers rounding.
+	HalfEven Mode = "half-even"
+	// HalfUp rounds half away from zero.
+	HalfUp Mode = "half-up"
+	// Up rounds away from zero.
+	Up Mode = "up"
+	// Down rounds towards zero.
+	Down    Mode = "down"
+	Ceiling Mode = "ceiling"
+	Floor   Mode = "floor"
+
+	DefaultMode = HalfEven
+
+	defaultMinorUnits = 2
+)
+
+// minorUnits lists ISO 4217 currencies which don't use two decimal places.
+var minorUnits = map[string]int32{
+	"BHD": 3,
+	"BIF": 0,
+	"CLF": 4,
+	"CLP": 0,
+	"DJF": 0,
+	"GNF": 0,
+	"IQD": 3,
+	"ISK": 0,
+	"JOD": 3,
+	"JPY": 0,
+	"KMF": 0,
+	"KRW": 0,
+	"KWD": 3,
+	"LYD": 3,
+	"OMR": 3,
+	"PYG": 0,
+	"RWF": 0,
+	"TND": 3,
+	"UGX": 0,
+	"UYI": 0,
+	"UYW": 4,
+	"VND": 0,
+	"VUV": 0,
+	"XAF": 0,
+	"XOF": 0,
+	"XPF": 0,
+}
+
+// ParseMode returns DefaultMode for empty value.
+func ParseMode(value string) (Mode, error) {
+	mode := Mode(value)
+	switch mode {
+	case "":
+		return DefaultMode, nil
+	case HalfEven, HalfUp, Up, Down, Ceiling, Floor:
+		return mode, nil
+	default:
+		return "", customerros.Newf(customerros.CodeValidationFailed, "unknown rounding mode %s", value)
+	}
+}
+
+// MinorUnits returns number of decimal places of the currency defined in ISO 4217.
+func MinorUnits(currencyCode string) int32 {
+	if places, isFound := minorUnits[currencyCode]; isFound {
+		return places
+	}
+	return defaultMinorUnits
+}
+
+// Round rounds amount to minor units of the currency.
+func Round(amount decimal.Decimal, currencyCode string, mode Mode) decimal.Decimal {
+	places := MinorUnits(currencyCode)
+
+	switch mode {
+	case HalfUp:
+		return amount.Round(places)
+	case Up:
+		return amount.RoundUp(places)
+	case Down:
+		return amount.RoundDown(places)
+	case Ceiling:
+		return amount.RoundCeil(places)
+	case Floor:
+		return amount.RoundFloor(places)
+	default:
+		return amount.RoundBank(places)
+	}
+}
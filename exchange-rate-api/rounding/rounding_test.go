@@ -0,0 +1,46 @@
+package rounding
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func round(amount, currencyCode string, mode Mode) string {
+	return Round(decimal.RequireFromString(amount), currencyCode, mode).String()
+}
+
+func TestRoundUsesMinorUnitsOfCurrency(t *testing.T) {
+	assert.Equal(t, "1235", round("1234.56", "JPY", HalfEven))
+	assert.Equal(t, "1235", round("1234.56", "KRW", HalfEven))
+	assert.Equal(t, "1234.57", round("1234.5678", "CHF", HalfEven))
+	assert.Equal(t, "1.235", round("1.23456", "KWD", HalfEven))
+}
+
+func TestRoundHalfEvenAndHalfUpDifferOnTies(t *testing.T) {
+	assert.Equal(t, "0.12", round("0.125", "USD", HalfEven))
+	assert.Equal(t, "0.13", round("0.125", "USD", HalfUp))
+	assert.Equal(t, "-0.13", round("-0.125", "USD", HalfUp))
+}
+
+func TestRoundDirectedModes(t *testing.T) {
+	assert.Equal(t, "0.13", round("0.121", "USD", Up))
+	assert.Equal(t, "-0.13", round("-0.121", "USD", Up))
+	assert.Equal(t, "0.12", round("0.129", "USD", Down))
+	assert.Equal(t, "-0.12", round("-0.121", "USD", Ceiling))
+	assert.Equal(t, "-0.13", round("-0.121", "USD", Floor))
+}
+
+func TestParseMode(t *testing.T) {
+	mode, err := ParseMode("")
+	assert.NoError(t, err)
+	assert.Equal(t, HalfEven, mode)
+
+	mode, err = ParseMode("half-up")
+	assert.NoError(t, err)
+	assert.Equal(t, HalfUp, mode)
+
+	_, err = ParseMode("nearest")
+	assert.Error(t, err)
+}
@@ -0,0 +1,253 @@
+// Package seed converts data/*.csv files into sql inserting their exchange rates. Currencies are referenced by code,
+// so sql doesn't depend on ids assigned by database, and missing currencies are inserted.
+package seed
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+const dateLayout = "2006-01-02"
+
+// Series are exchange rates of one data file.
+type Series struct {
+	File        string
+	Source      string
+	Destination string
+	Rates       []Rate
+}
+
+type Rate struct {
+	Date time.Time
+	// Value is decimal as written in file, empty when there is no rate on the date.
+	Value string
+}
+
+// Dialect formats dates and converts values of sql dialect.
+type Dialect struct {
+	Name       string
+	DateLayout string
+	DateColumn string
+	RateColumn string
+}
+
+var (
+	Postgres = Dialect{"postgres", dateLayout, "CAST(rates.date AS DATE)", "CAST(rates.rate AS NUMERIC)"}
+	// Sqlite dates are written in format of the driver, so they are compared with query parameters.
+	Sqlite = Dialect{"sqlite", "2006-01-02 15:04:05-07:00", "rates.date", "rates.rate"}
+)
+
+// ReadDir reads all csv files of directory, ordered by file name.
+func ReadDir(dir string) ([]Series, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.csv"))
+	if err != nil {
+		return nil, err
+	}
+	if len(paths) == 0 {
+		return nil, fmt.Errorf("no csv files in %s", dir)
+	}
+	sort.Strings(paths)
+
+	allSeries := make([]Series, 0, len(paths))
+	for _, path := range paths {
+		series, err := ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		allSeries = append(allSeries, series)
+	}
+	return allSeries, nil
+}
+
+// ReadFile reads file with DATE,<SOURCE><DESTINATION> header, e.g. DATE,USDCHF with amount of francs for one dollar.
+// Empty values and dots are missing rates.
+func ReadFile(path string) (Series, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return Series{}, err
+	}
+	defer file.Close()
+
+	series, err := read(file)
+	if err != nil {
+		return Series{}, fmt.Errorf("%s: %w", path, err)
+	}
+	series.File = filepath.Base(path)
+	return series, nil
+}
+
+func read(reader io.Reader) (Series, error) {
+	csvReader := csv.NewReader(reader)
+
+	header, err := csvReader.Read()
+	if err != nil {
+		return Series{}, fmt.Errorf("can't read header: %w", err)
+	}
+	if len(header) != 2 || !strings.EqualFold(header[0], "date") || len(header[1]) != 6 {
+		return Series{}, fmt.Errorf("header %s must be DATE,<SOURCE><DESTINATION>", strings.Join(header, ","))
+	}
+
+	pair := strings.ToUpper(header[1])
+	series := Series{Source: pair[:3], Destination: pair[3:]}
+	for {
+		record, err := csvReader.Read()
+		if err == io.EOF {
+			return series, nil
+		}
+		if err != nil {
+			return Series{}, err
+		}
+
+		line, _ := csvReader.FieldPos(0)
+		date, err := time.Parse(dateLayout, record[0])
+		if err != nil {
+			return Series{}, fmt.Errorf("line %d: date %s is not YYYY-MM-DD", line, record[0])
+		}
+
+		value := strings.TrimSpace(record[1])
+		if value == "." {
+			value = ""
+		}
+		if value != "" {
+			if _, err := decimal.NewFromString(value); err != nil {
+				return Series{}, fmt.Errorf("line %d: rate %s is not a decimal number", line, value)
+			}
+		}
+
+		series.Rates = append(series.Rates, Rate{date, value})
+	}
+}
+
+// WriteUp writes sql inserting currencies and rates of all series. Stored rates are kept. Rates of the pair stored
+// the other way round between the first and last date of series are deleted first, e.g. JPYUSD.csv rates loaded
+// before base/quote convention, which are the same rates as of USDJPY.csv, so every pair is stored in one direction.
+func WriteUp(writer io.Writer, dialect Dialect, allSeries []Series) error {
+	w := &errWriter{writer: writer}
+	w.printf("-- Generated by tools/seedgen from data/*.csv, do not edit.\n")
+
+	w.printf("\nINSERT INTO\n    currencies_codes (code)\nVALUES\n")
+	codes := currenciesCodes(allSeries)
+	for i, code := range codes {
+		w.printf("    (%s)%s\n", quote(code), separator(i, len(codes)))
+	}
+	w.printf("ON CONFLICT (code) DO NOTHING;\n")
+
+	for _, series := range allSeries {
+		if len(series.Rates) == 0 {
+			continue
+		}
+
+		w.printf("\n-- %s\n", series.File)
+		writeDeleteRange(w, dialect, series, series.Destination, series.Source)
+		w.printf("WITH rates (date, rate) AS (\n    VALUES\n")
+		for i, rate := range series.Rates {
+			value := "NULL"
+			if rate.Value != "" {
+				value = quote(rate.Value)
+			}
+			w.printf("        (%s, %s)%s\n", quote(rate.Date.Format(dialect.DateLayout)), value, separator(i, len(series.Rates)))
+		}
+		w.printf(`)
+INSERT INTO
+    exchange_rates (source_currency_id, destination_currency_id, date, rate)
+SELECT
+    source.id,
+    destination.id,
+    %s,
+    %s
+FROM
+    rates,
+    currencies_codes source,
+    currencies_codes destination
+WHERE
+    source.code = %s
+    AND destination.code = %s
+ON CONFLICT DO NOTHING;
+`, dialect.DateColumn, dialect.RateColumn, quote(series.Source), quote(series.Destination))
+	}
+	return w.err
+}
+
+// WriteDown writes sql deleting rates of series pairs between the first and last date of each series,
+// including rates inserted later for the same pairs and dates. Currencies are kept.
+func WriteDown(writer io.Writer, dialect Dialect, allSeries []Series) error {
+	w := &errWriter{writer: writer}
+	w.printf("-- Generated by tools/seedgen from data/*.csv, do not edit.\n")
+
+	for _, series := range allSeries {
+		if len(series.Rates) == 0 {
+			continue
+		}
+
+		w.printf("\n-- %s\n", series.File)
+		writeDeleteRange(w, dialect, series, series.Source, series.Destination)
+	}
+	return w.err
+}
+
+// writeDeleteRange writes sql deleting rates of the pair between the first and last date of series.
+func writeDeleteRange(w *errWriter, dialect Dialect, series Series, source, destination string) {
+	first, last := series.Rates[0].Date, series.Rates[0].Date
+	for _, rate := range series.Rates {
+		if rate.Date.Before(first) {
+			first = rate.Date
+		}
+		if rate.Date.After(last) {
+			last = rate.Date
+		}
+	}
+
+	w.printf(`DELETE FROM
+    exchange_rates
+WHERE
+    source_currency_id = (SELECT id FROM currencies_codes WHERE code = %s)
+    AND destination_currency_id = (SELECT id FROM currencies_codes WHERE code = %s)
+    AND date BETWEEN %s AND %s;
+`, quote(source), quote(destination), quote(first.Format(dialect.DateLayout)), quote(last.Format(dialect.DateLayout)))
+}
+
+func currenciesCodes(allSeries []Series) []string {
+	isAdded := make(map[string]bool)
+	codes := []string{}
+	for _, series := range allSeries {
+		for _, code := range []string{series.Source, series.Destination} {
+			if !isAdded[code] {
+				isAdded[code] = true
+				codes = append(codes, code)
+			}
+		}
+	}
+	sort.Strings(codes)
+	return codes
+}
+
+func quote(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", "''") + "'"
+}
+
+func separator(i, count int) string {
+	if i == count-1 {
+		return ""
+	}
+	return ","
+}
+
+// errWriter keeps the first write error, so sql can be written without checking every line.
+type errWriter struct {
+	writer io.Writer
+	err    error
+}
+
+func (w *errWriter) printf(format string, args ...interface{}) {
+	if w.err == nil {
+		_, w.err = fmt.Fprintf(w.writer, format, args...)
+	}
+}
@@ -0,0 +1,146 @@
+package seed
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kolan92/exchange-rate-api/migrations"
+	"github.com/kolan92/exchange-rate-api/models"
+	"github.com/kolan92/exchange-rate-api/repositories"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+var (
+	ctx context.Context
+	db  *gorm.DB
+)
+
+func setup(t *testing.T) {
+	ctx = context.Background()
+	db = repositories.ConnectSqlite(filepath.Join(t.TempDir(), "rates.db"))
+	t.Cleanup(func() {
+		if sqlDb, err := db.DB(); err == nil {
+			sqlDb.Close()
+		}
+	})
+
+	migrator, _ := migrations.New(db, false)
+	_, err := migrator.Up(ctx)
+	assert.NoError(t, err)
+}
+
+func day(day int) time.Time {
+	return time.Date(2022, 05, day, 0, 00, 00, 0, time.UTC)
+}
+
+func TestReadsMissingRatesAsEmpty(t *testing.T) {
+	series, err := read(strings.NewReader("DATE,CHFUSD\n2022-05-02,1.0100\n2022-05-03,.\n2022-05-04,\n"))
+
+	assert.NoError(t, err)
+	assert.Equal(t, Series{Source: "CHF", Destination: "USD", Rates: []Rate{
+		{day(2), "1.0100"},
+		{day(3), ""},
+		{day(4), ""},
+	}}, series)
+}
+
+func TestReadRejectsInvalidFiles(t *testing.T) {
+	for name, content := range map[string]string{
+		"header": "DATE,CHF\n2022-05-02,1.01\n",
+		"date":   "DATE,CHFUSD\n02.05.2022,1.01\n",
+		"rate":   "DATE,CHFUSD\n2022-05-02,abc\n",
+	} {
+		_, err := read(strings.NewReader(content))
+
+		assert.Error(t, err, name)
+	}
+}
+
+func TestReadDirOrdersFilesByName(t *testing.T) {
+	dir := t.TempDir()
+	os.WriteFile(filepath.Join(dir, "JPYUSD.csv"), []byte("DATE,JPYUSD\n2022-05-02,130\n"), 0o600)
+	os.WriteFile(filepath.Join(dir, "CHFUSD.csv"), []byte("DATE,CHFUSD\n2022-05-02,1.01\n"), 0o600)
+	os.WriteFile(filepath.Join(dir, "notes.txt"), []byte("not data"), 0o600)
+
+	allSeries, err := ReadDir(dir)
+
+	assert.NoError(t, err)
+	assert.Len(t, allSeries, 2)
+	assert.Equal(t, "CHFUSD.csv", allSeries[0].File)
+	assert.Equal(t, "JPYUSD.csv", allSeries[1].File)
+}
+
+func TestWrittenSqlInsertsRatesAndMissingCurrencies(t *testing.T) {
+	setup(t)
+	allSeries := []Series{
+		{File: "CHFUSD.csv", Source: "CHF", Destination: "USD", Rates: []Rate{{day(2), "1.01"}, {day(3), ""}}},
+		{File: "EURUSD.csv", Source: "EUR", Destination: "USD", Rates: []Rate{{day(2), "1.05"}}},
+	}
+	sql := &bytes.Buffer{}
+
+	assert.NoError(t, WriteUp(sql, Sqlite, allSeries))
+	assert.NoError(t, db.Exec(sql.String()).Error)
+	// stored rates are kept
+	assert.NoError(t, db.Exec(sql.String()).Error)
+
+	repo := repositories.NewSqliteCurrenciesRepository(db)
+	currencyCodesMap := repo.GetCurrenciesCodesIdsMap(ctx)
+	assert.Contains(t, currencyCodesMap, "EUR")
+	from, till := day(1), day(4)
+	exchangeRates, err := repo.GetRangeExchangeRates(ctx, []models.CurrencyPair{
+		{SourceCurrencyId: currencyCodesMap["CHF"], DestinationCurrencyId: currencyCodesMap["USD"]},
+		{SourceCurrencyId: currencyCodesMap["EUR"], DestinationCurrencyId: currencyCodesMap["USD"]},
+	}, &from, &till)
+	assert.NoError(t, err)
+	assert.Len(t, exchangeRates, 3)
+	assert.Nil(t, exchangeRates[0].Rate)
+	assert.Equal(t, "1.01", exchangeRates[1].Rate.String())
+	assert.Equal(t, "1.05", exchangeRates[2].Rate.String())
+}
+
+func TestWrittenSqlDeletesRatesOfPairStoredTheOtherWayRound(t *testing.T) {
+	setup(t)
+	repo := repositories.NewSqliteCurrenciesRepository(db)
+	for _, date := range []time.Time{day(2), day(9)} {
+		repo.InsertExchangeRate(ctx, &models.ExchangeRate{Source: "JPY", Destination: "USD", Date: date})
+	}
+	allSeries := []Series{{File: "USDJPY.csv", Source: "USD", Destination: "JPY", Rates: []Rate{{day(2), "130"}, {day(3), "131"}}}}
+	sql := &bytes.Buffer{}
+	WriteUp(sql, Sqlite, allSeries)
+
+	assert.NoError(t, db.Exec(sql.String()).Error)
+
+	from, till := day(1), day(10)
+	currencyCodesMap := repo.GetCurrenciesCodesIdsMap(ctx)
+	oldRates, _ := repo.GetRangeExchangeRate(ctx, currencyCodesMap["JPY"], currencyCodesMap["USD"], &from, &till)
+	seededRates, _ := repo.GetRangeExchangeRate(ctx, currencyCodesMap["USD"], currencyCodesMap["JPY"], &from, &till)
+	if assert.Len(t, oldRates, 1) {
+		assert.True(t, day(9).Equal(oldRates[0].Date))
+	}
+	assert.Len(t, seededRates, 2)
+}
+
+func TestWrittenDownSqlDeletesSeededRates(t *testing.T) {
+	setup(t)
+	allSeries := []Series{{File: "CHFUSD.csv", Source: "CHF", Destination: "USD", Rates: []Rate{{day(2), "1.01"}, {day(3), "1.02"}}}}
+	up, down := &bytes.Buffer{}, &bytes.Buffer{}
+	WriteUp(up, Sqlite, allSeries)
+	WriteDown(down, Sqlite, allSeries)
+	db.Exec(up.String())
+	repo := repositories.NewSqliteCurrenciesRepository(db)
+	repo.InsertExchangeRate(ctx, &models.ExchangeRate{Source: "CHF", Destination: "USD", Date: day(4)})
+
+	assert.NoError(t, db.Exec(down.String()).Error)
+
+	newestDate, _ := repo.GetNewestExchangeRateDate(ctx)
+	assert.True(t, day(4).Equal(*newestDate))
+	var count int64
+	db.Model(&models.DbExchangeRate{}).Count(&count)
+	assert.Equal(t, int64(1), count)
+}
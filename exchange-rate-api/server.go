@@ -0,0 +1,199 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/kolan92/exchange-rate-api/config"
+	"github.com/kolan92/exchange-rate-api/ecb"
+	"github.com/kolan92/exchange-rate-api/health"
+	"github.com/kolan92/exchange-rate-api/ingestion"
+	"github.com/kolan92/exchange-rate-api/ratelimit"
+	"github.com/kolan92/exchange-rate-api/repositories"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"gorm.io/gorm"
+)
+
+// server runs http and optional grpc server until the process is asked to stop.
+type server struct {
+	cfg        config.ServerConfig
+	httpServer *http.Server
+	grpcServer *grpc.Server
+	db         *gorm.DB
+}
+
+func newServer(cfg config.ServerConfig, handler http.Handler, grpcServer *grpc.Server, db *gorm.DB) *server {
+	httpServer := &http.Server{
+		Addr:         cfg.ListenAddress,
+		Handler:      handler,
+		ReadTimeout:  cfg.ReadTimeout,
+		WriteTimeout: cfg.WriteTimeout,
+		IdleTimeout:  cfg.IdleTimeout,
+	}
+
+	return &server{cfg, httpServer, grpcServer, db}
+}
+
+// onShutdown registers function called when shutdown starts, e.g. to end long running streams.
+func (s *server) onShutdown(f func()) {
+	s.httpServer.RegisterOnShutdown(f)
+}
+
+// run blocks until SIGINT or SIGTERM, then stops accepting connections, waits for in-flight requests
+// up to shutdown timeout and closes database connection pool. Error of failed http or grpc server is returned
+// after the shutdown, so the process can exit with failure.
+func (s *server) run() error {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	failed := make(chan error, 2)
+
+	go func() {
+		zap.L().Info("Starting http server", zap.String("address", s.cfg.ListenAddress))
+		if err := s.httpServer.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+			failed <- err
+		}
+	}()
+
+	if s.grpcServer != nil {
+		go func() {
+			listener, err := net.Listen("tcp", s.cfg.GrpcListenAddress)
+			if err != nil {
+				failed <- err
+				return
+			}
+
+			zap.L().Info("Starting grpc server", zap.String("address", s.cfg.GrpcListenAddress))
+			if err := s.grpcServer.Serve(listener); err != nil {
+				failed <- err
+			}
+		}()
+	}
+
+	var err error
+	select {
+	case <-ctx.Done():
+		zap.L().Info("Shutting down")
+	case err = <-failed:
+		zap.L().Error("Server failed, shutting down", zap.Error(err))
+	}
+
+	s.shutdown()
+	return err
+}
+
+func (s *server) shutdown() {
+	ctx, cancel := context.WithTimeout(context.Background(), s.cfg.ShutdownTimeout)
+	defer cancel()
+
+	if err := s.httpServer.Shutdown(ctx); err != nil {
+		zap.L().Warn("Http server didn't finish in-flight requests", zap.Error(err))
+	}
+
+	if s.grpcServer != nil {
+		stopped := make(chan struct{})
+		go func() {
+			s.grpcServer.GracefulStop()
+			close(stopped)
+		}()
+
+		select {
+		case <-stopped:
+		case <-ctx.Done():
+			zap.L().Warn("Grpc server didn't finish in-flight requests")
+			s.grpcServer.Stop()
+		}
+	}
+
+	if s.db != nil {
+		if sqlDb, err := s.db.DB(); err == nil {
+			if err := sqlDb.Close(); err != nil {
+				zap.L().Error("Can't close database connection pool", zap.Error(err))
+			}
+		}
+	}
+
+	zap.L().Info("Exchange rate api stopped")
+}
+
+// newReadinessChecker checks database connection, loaded currencies and age of the newest exchange rate.
+func newReadinessChecker(cfg config.ServerConfig, db *gorm.DB, repo repositories.CurrenciesRepository) *health.Checker {
+	checker := health.NewChecker(cfg.ReadinessTimeout)
+
+	if db != nil {
+		if sqlDb, err := db.DB(); err == nil {
+			checker.AddCheck("database", health.DatabaseCheck(sqlDb))
+		}
+	}
+	checker.AddCheck("currencies", health.CurrenciesCheck(repo))
+	checker.AddCheck("freshness", health.FreshnessCheck(repo, cfg.MaxRateAge, time.Now))
+
+	return checker
+}
+
+// openStorage connects to configured backend of exchange rates, db is nil when rates are kept in memory.
+// Sqlite is always migrated, postgres only with DB_AUTO_MIGRATE.
+func openStorage(cfg config.DatabaseConfig) (*gorm.DB, repositories.CurrenciesRepository) {
+	switch cfg.Backend {
+	case config.BackendMemory:
+		return nil, repositories.NewMemoryCurrenciesRepository(repositories.SeedCurrenciesCodes)
+	case config.BackendSqlite:
+		db := repositories.ConnectSqlite(cfg.SqlitePath)
+		migrateOnStart(db, cfg.MigrateData)
+		return db, repositories.NewSqliteCurrenciesRepository(db)
+	default:
+		db := repositories.ConnectPostgres(cfg)
+		if cfg.AutoMigrate {
+			migrateOnStart(db, cfg.MigrateData)
+		}
+		return db, repositories.NewPostgresCurrenciesRepository(db)
+	}
+}
+
+// newIngestionRunsRepository stores runs in the database, or in memory with memory backend.
+func newIngestionRunsRepository(db *gorm.DB) repositories.IngestionRunsRepository {
+	if db == nil {
+		return repositories.NewMemoryIngestionRunsRepository()
+	}
+	return repositories.NewSqlIngestionRunsRepository(db)
+}
+
+func newRateProvider(cfg config.IngestionConfig) ingestion.RateProvider {
+	switch cfg.Provider {
+	case config.ProviderFile:
+		return ingestion.NewFileProvider(cfg.Source)
+	case config.ProviderEcb:
+		return ecb.NewProvider(cfg.Source, &http.Client{Timeout: cfg.Timeout})
+	default:
+		return ingestion.NewHttpProvider(cfg.Source, &http.Client{Timeout: cfg.Timeout})
+	}
+}
+
+// rateLimitGroup assigns routes to groups limited by RATE_LIMIT_* settings.
+func rateLimitGroup(g *gin.Context) string {
+	route := g.FullPath()
+	switch {
+	case strings.HasSuffix(route, "/graphql"):
+		return ratelimit.GroupGraphql
+	case strings.Contains(route, "/admin/"):
+		return ratelimit.GroupAdmin
+	case strings.Contains(route, "/convert/"):
+		return ratelimit.GroupConversion
+	}
+
+	switch g.Request.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return ratelimit.GroupRead
+	default:
+		return ratelimit.GroupWrite
+	}
+}
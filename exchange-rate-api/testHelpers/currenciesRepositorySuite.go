@@ -0,0 +1,409 @@
+package testhelpers
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	customerros "github.com/kolan92/exchange-rate-api/custom-erros"
+	"github.com/kolan92/exchange-rate-api/models"
+	"github.com/kolan92/exchange-rate-api/repositories"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+// Ids of currencies inserted by the first migration.
+const (
+	usdId = 1
+	chfId = 2
+	jpyId = 4
+)
+
+type currenciesRepositorySuite struct {
+	t    *testing.T
+	ctx  context.Context
+	repo repositories.CurrenciesRepository
+}
+
+// RunCurrenciesRepositorySuite checks behaviour shared by all CurrenciesRepository implementations.
+// newRepository is called for every test and has to return repository with currencies of repositories.SeedCurrenciesCodes
+// and without exchange rates.
+func RunCurrenciesRepositorySuite(t *testing.T, newRepository func(t *testing.T) repositories.CurrenciesRepository) {
+	tests := map[string]func(s *currenciesRepositorySuite){
+		"LoadsCurrencies":                  testLoadsCurrencies,
+		"LastRateSkipsNulls":               testLastRateSkipsNulls,
+		"LastRateIsNotFound":               testLastRateIsNotFound,
+		"LastRatesOfManyPairs":             testLastRatesOfManyPairs,
+		"AllRatesFromDate":                 testAllRatesFromDate,
+		"RangeIncludesFromAndExcludesTill": testRangeIncludesFromAndExcludesTill,
+		"RangeOfManyPairsIsOrdered":        testRangeOfManyPairsIsOrdered,
+		"RejectsDuplicates":                testRejectsDuplicates,
+		"RejectsInvertedPairs":             testRejectsInvertedPairs,
+		"UpdatesRate":                      testUpdatesRate,
+		"UpdateOfMissingRateIsNotFound":    testUpdateOfMissingRateIsNotFound,
+		"RoundsRatesToSixDecimals":         testRoundsRatesToSixDecimals,
+		"NewestRates":                      testNewestRates,
+		"ConcurrentInserts":                testConcurrentInserts,
+		"ConcurrentInvertedInserts":        testConcurrentInvertedInserts,
+		"InsertsMissingCurrencies":         testInsertsMissingCurrencies,
+		"ImportsRates":                     testImportsRates,
+		"FailedImportStoresNothing":        testFailedImportStoresNothing,
+		"ImportInsertsMissingCurrencies":   testImportInsertsMissingCurrencies,
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			test(&currenciesRepositorySuite{t: t, ctx: context.Background(), repo: newRepository(t)})
+		})
+	}
+}
+
+func day(day int) time.Time {
+	return time.Date(2022, 05, day, 0, 00, 00, 0, time.UTC)
+}
+
+func rate(value string) *decimal.Decimal {
+	d := decimal.RequireFromString(value)
+	return &d
+}
+
+func pair(sourceCurrencyId, destinationCurrencyId int) models.CurrencyPair {
+	return models.CurrencyPair{SourceCurrencyId: sourceCurrencyId, DestinationCurrencyId: destinationCurrencyId}
+}
+
+func (s *currenciesRepositorySuite) insert(source, destination string, date time.Time, value *decimal.Decimal) {
+	err := s.repo.InsertExchangeRate(s.ctx, &models.ExchangeRate{Source: source, Destination: destination, Date: date, Rate: value})
+	assert.NoError(s.t, err)
+}
+
+// assertRates compares rates as source, destination, date and rate, so time zone and decimal exponent don't matter.
+func (s *currenciesRepositorySuite) assertRates(expected, actual []models.ExchangeRate) {
+	if !assert.Len(s.t, actual, len(expected)) {
+		return
+	}
+	for i := range expected {
+		assert.Equal(s.t, expected[i].Source, actual[i].Source, "source of rate %d", i)
+		assert.Equal(s.t, expected[i].Destination, actual[i].Destination, "destination of rate %d", i)
+		assert.True(s.t, expected[i].Date.Equal(actual[i].Date), "date of rate %d: expected %v, actual %v", i, expected[i].Date, actual[i].Date)
+		if expected[i].Rate == nil {
+			assert.Nil(s.t, actual[i].Rate, "rate %d", i)
+		} else if assert.NotNil(s.t, actual[i].Rate, "rate %d", i) {
+			assert.True(s.t, expected[i].Rate.Equal(*actual[i].Rate), "rate %d: expected %s, actual %s", i, expected[i].Rate, actual[i].Rate)
+		}
+	}
+}
+
+func testLoadsCurrencies(s *currenciesRepositorySuite) {
+	currencyCodesMap := s.repo.GetCurrenciesCodesIdsMap(s.ctx)
+
+	assert.Len(s.t, currencyCodesMap, len(repositories.SeedCurrenciesCodes))
+	for i, currencyCode := range repositories.SeedCurrenciesCodes {
+		assert.Equal(s.t, i+1, currencyCodesMap[currencyCode], currencyCode)
+	}
+	assert.ElementsMatch(s.t, repositories.SeedCurrenciesCodes, s.repo.GetCurrenciesCodes(s.ctx))
+}
+
+func testLastRateSkipsNulls(s *currenciesRepositorySuite) {
+	s.insert("CHF", "USD", day(1), rate("1.01"))
+	s.insert("CHF", "USD", day(2), rate("1.02"))
+	s.insert("CHF", "USD", day(3), nil)
+	s.insert("JPY", "USD", day(4), rate("0.0075"))
+
+	lastRate, err := s.repo.GetLastExchangeRate(s.ctx, chfId, usdId)
+
+	assert.NoError(s.t, err)
+	s.assertRates([]models.ExchangeRate{{Source: "CHF", Destination: "USD", Date: day(2), Rate: rate("1.02")}}, []models.ExchangeRate{*lastRate})
+}
+
+func testLastRateIsNotFound(s *currenciesRepositorySuite) {
+	s.insert("JPY", "USD", day(1), nil)
+
+	_, nullsErr := s.repo.GetLastExchangeRate(s.ctx, jpyId, usdId)
+	_, missingErr := s.repo.GetLastExchangeRate(s.ctx, chfId, usdId)
+
+	assert.ErrorIs(s.t, nullsErr, gorm.ErrRecordNotFound)
+	assert.ErrorIs(s.t, missingErr, gorm.ErrRecordNotFound)
+}
+
+func testLastRatesOfManyPairs(s *currenciesRepositorySuite) {
+	s.insert("JPY", "USD", day(1), rate("0.0075"))
+	s.insert("JPY", "USD", day(2), nil)
+	s.insert("CHF", "USD", day(1), rate("1.01"))
+	s.insert("CHF", "USD", day(2), rate("1.02"))
+	s.insert("CHF", "JPY", day(1), nil)
+	s.insert("CNY", "USD", day(3), rate("0.15"))
+
+	lastRates, err := s.repo.GetLastExchangeRates(s.ctx, []models.CurrencyPair{pair(jpyId, usdId), pair(chfId, jpyId), pair(chfId, usdId)})
+	assert.NoError(s.t, err)
+	s.assertRates([]models.ExchangeRate{
+		{Source: "CHF", Destination: "USD", Date: day(2), Rate: rate("1.02")},
+		{Source: "JPY", Destination: "USD", Date: day(1), Rate: rate("0.0075")},
+	}, lastRates)
+
+	noRates, err := s.repo.GetLastExchangeRates(s.ctx, []models.CurrencyPair{})
+	assert.NoError(s.t, err)
+	assert.NotNil(s.t, noRates)
+	assert.Empty(s.t, noRates)
+}
+
+func testAllRatesFromDate(s *currenciesRepositorySuite) {
+	s.insert("JPY", "USD", day(2), nil)
+	s.insert("CHF", "USD", day(2), rate("1.02"))
+	s.insert("CHF", "USD", day(1), rate("1.01"))
+	s.insert("CHF", "USD", day(3), rate("1.03"))
+
+	exchangeRates, err := s.repo.GetAllExchangeRatesFromDate(s.ctx, day(2))
+
+	assert.NoError(s.t, err)
+	s.assertRates([]models.ExchangeRate{
+		{Source: "CHF", Destination: "USD", Date: day(2), Rate: rate("1.02")},
+		{Source: "JPY", Destination: "USD", Date: day(2)},
+	}, exchangeRates)
+}
+
+func testRangeIncludesFromAndExcludesTill(s *currenciesRepositorySuite) {
+	s.insert("CHF", "USD", day(1), rate("1.01"))
+	s.insert("CHF", "USD", day(2), rate("1.02"))
+	s.insert("CHF", "USD", day(3), nil)
+	s.insert("CHF", "USD", day(4), rate("1.04"))
+	s.insert("JPY", "USD", day(2), rate("0.0075"))
+	from, till := day(2), day(4)
+
+	exchangeRates, err := s.repo.GetRangeExchangeRate(s.ctx, chfId, usdId, &from, &till)
+
+	assert.NoError(s.t, err)
+	s.assertRates([]models.ExchangeRate{
+		{Source: "CHF", Destination: "USD", Date: day(3)},
+		{Source: "CHF", Destination: "USD", Date: day(2), Rate: rate("1.02")},
+	}, exchangeRates)
+
+	emptyFrom, emptyTill := day(5), day(5)
+	noRates, err := s.repo.GetRangeExchangeRate(s.ctx, chfId, usdId, &emptyFrom, &emptyTill)
+	assert.NoError(s.t, err)
+	assert.Empty(s.t, noRates)
+}
+
+func testRangeOfManyPairsIsOrdered(s *currenciesRepositorySuite) {
+	s.insert("JPY", "USD", day(1), rate("0.0075"))
+	s.insert("JPY", "USD", day(2), rate("0.0076"))
+	s.insert("CHF", "USD", day(2), rate("1.02"))
+	s.insert("CHF", "USD", day(1), rate("1.01"))
+	s.insert("CNY", "USD", day(1), rate("0.15"))
+	from, till := day(1), day(3)
+
+	exchangeRates, err := s.repo.GetRangeExchangeRates(s.ctx, []models.CurrencyPair{pair(jpyId, usdId), pair(chfId, usdId)}, &from, &till)
+
+	assert.NoError(s.t, err)
+	s.assertRates([]models.ExchangeRate{
+		{Source: "CHF", Destination: "USD", Date: day(2), Rate: rate("1.02")},
+		{Source: "JPY", Destination: "USD", Date: day(2), Rate: rate("0.0076")},
+		{Source: "CHF", Destination: "USD", Date: day(1), Rate: rate("1.01")},
+		{Source: "JPY", Destination: "USD", Date: day(1), Rate: rate("0.0075")},
+	}, exchangeRates)
+}
+
+func testRejectsDuplicates(s *currenciesRepositorySuite) {
+	s.insert("CHF", "USD", day(1), rate("1.01"))
+
+	err := s.repo.InsertExchangeRate(s.ctx, &models.ExchangeRate{Source: "CHF", Destination: "USD", Date: day(1), Rate: rate("2")})
+	nullErr := s.repo.InsertExchangeRate(s.ctx, &models.ExchangeRate{Source: "CHF", Destination: "USD", Date: day(1)})
+
+	assert.ErrorIs(s.t, err, customerros.ErrDuplicateKeyViolation)
+	assert.ErrorIs(s.t, nullErr, customerros.ErrDuplicateKeyViolation)
+	s.insert("JPY", "USD", day(1), rate("0.0075"))
+	exchangeRates, _ := s.repo.GetAllExchangeRatesFromDate(s.ctx, day(1))
+	s.assertRates([]models.ExchangeRate{
+		{Source: "CHF", Destination: "USD", Date: day(1), Rate: rate("1.01")},
+		{Source: "JPY", Destination: "USD", Date: day(1), Rate: rate("0.0075")},
+	}, exchangeRates)
+}
+
+func testRejectsInvertedPairs(s *currenciesRepositorySuite) {
+	s.insert("CHF", "USD", day(1), rate("1.01"))
+
+	err := s.repo.InsertExchangeRate(s.ctx, &models.ExchangeRate{Source: "USD", Destination: "CHF", Date: day(2), Rate: rate("0.99")})
+	_, importErr := s.repo.ImportExchangeRates(s.ctx, []models.ExchangeRate{
+		{Source: "JPY", Destination: "USD", Date: day(2), Rate: rate("0.0075")},
+		{Source: "USD", Destination: "CHF", Date: day(2), Rate: rate("0.99")},
+	}, false)
+
+	assert.Equal(s.t, customerros.CodeInvertedPair, customerros.Code(err))
+	assert.Equal(s.t, customerros.CodeInvertedPair, customerros.Code(importErr))
+	exchangeRates, _ := s.repo.GetAllExchangeRatesFromDate(s.ctx, day(2))
+	assert.Empty(s.t, exchangeRates)
+}
+
+func testUpdatesRate(s *currenciesRepositorySuite) {
+	s.insert("CHF", "USD", day(1), nil)
+	s.insert("CHF", "USD", day(2), rate("1.01"))
+
+	err := s.repo.UpdateExchangeRate(s.ctx, &models.ExchangeRate{Source: "CHF", Destination: "USD", Date: day(1), Rate: rate("1.0012345678")})
+	nullErr := s.repo.UpdateExchangeRate(s.ctx, &models.ExchangeRate{Source: "CHF", Destination: "USD", Date: day(2)})
+
+	assert.NoError(s.t, err)
+	assert.NoError(s.t, nullErr)
+	from, till := day(1), day(3)
+	exchangeRates, _ := s.repo.GetRangeExchangeRate(s.ctx, chfId, usdId, &from, &till)
+	s.assertRates([]models.ExchangeRate{
+		{Source: "CHF", Destination: "USD", Date: day(2)},
+		{Source: "CHF", Destination: "USD", Date: day(1), Rate: rate("1.001235")},
+	}, exchangeRates)
+}
+
+func testUpdateOfMissingRateIsNotFound(s *currenciesRepositorySuite) {
+	s.insert("CHF", "USD", day(1), rate("1.01"))
+
+	err := s.repo.UpdateExchangeRate(s.ctx, &models.ExchangeRate{Source: "CHF", Destination: "USD", Date: day(2), Rate: rate("1.02")})
+
+	assert.ErrorIs(s.t, err, gorm.ErrRecordNotFound)
+}
+
+func testRoundsRatesToSixDecimals(s *currenciesRepositorySuite) {
+	s.insert("CHF", "USD", day(1), rate("1.0123456789"))
+
+	lastRate, err := s.repo.GetLastExchangeRate(s.ctx, chfId, usdId)
+
+	assert.NoError(s.t, err)
+	assert.Equal(s.t, "1.012346", lastRate.Rate.String())
+}
+
+func testNewestRates(s *currenciesRepositorySuite) {
+	newestDate, err := s.repo.GetNewestExchangeRateDate(s.ctx)
+	assert.NoError(s.t, err)
+	assert.Nil(s.t, newestDate)
+
+	s.insert("CHF", "USD", day(1), rate("1.01"))
+	s.insert("CHF", "USD", day(3), nil)
+	s.insert("JPY", "USD", day(2), rate("0.0075"))
+
+	newestDate, err = s.repo.GetNewestExchangeRateDate(s.ctx)
+	assert.NoError(s.t, err)
+	if assert.NotNil(s.t, newestDate) {
+		assert.True(s.t, day(3).Equal(*newestDate), "newest date %v", newestDate)
+	}
+
+	newestRates, err := s.repo.GetNewestExchangeRates(s.ctx)
+	assert.NoError(s.t, err)
+	s.assertRates([]models.ExchangeRate{
+		{Source: "CHF", Destination: "USD", Date: day(3)},
+		{Source: "JPY", Destination: "USD", Date: day(2), Rate: rate("0.0075")},
+	}, newestRates)
+}
+
+func testConcurrentInserts(s *currenciesRepositorySuite) {
+	var wg sync.WaitGroup
+	for i := 1; i <= 20; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			s.insert("JPY", "USD", day(i), rate("0.0075"))
+			s.repo.GetNewestExchangeRates(s.ctx)
+		}(i)
+	}
+	wg.Wait()
+
+	from, till := day(1), day(21)
+	exchangeRates, err := s.repo.GetRangeExchangeRate(s.ctx, jpyId, usdId, &from, &till)
+
+	assert.NoError(s.t, err)
+	assert.Len(s.t, exchangeRates, 20)
+}
+
+// testConcurrentInvertedInserts inserts every pair in both directions at once, only one direction may be stored.
+func testConcurrentInvertedInserts(s *currenciesRepositorySuite) {
+	pairs := [][2]string{{"CHF", "USD"}, {"JPY", "USD"}, {"CHF", "JPY"}, {"CNY", "USD"}}
+	var wg sync.WaitGroup
+	errs := make(chan error, 2*len(pairs))
+	for i, currencies := range pairs {
+		for _, exchangeRate := range []models.ExchangeRate{
+			{Source: currencies[0], Destination: currencies[1], Date: day(i + 1), Rate: rate("1.01")},
+			{Source: currencies[1], Destination: currencies[0], Date: day(i + 11), Rate: rate("0.99")},
+		} {
+			wg.Add(1)
+			go func(exchangeRate models.ExchangeRate) {
+				defer wg.Done()
+				errs <- s.repo.InsertExchangeRate(s.ctx, &exchangeRate)
+			}(exchangeRate)
+		}
+	}
+	wg.Wait()
+	close(errs)
+
+	invertedPairErrors := 0
+	for err := range errs {
+		if err != nil {
+			assert.Equal(s.t, customerros.CodeInvertedPair, customerros.Code(err), err.Error())
+			invertedPairErrors++
+		}
+	}
+	assert.Equal(s.t, len(pairs), invertedPairErrors)
+	exchangeRates, err := s.repo.GetNewestExchangeRates(s.ctx)
+	assert.NoError(s.t, err)
+	assert.Len(s.t, exchangeRates, len(pairs))
+}
+
+func testInsertsMissingCurrencies(s *currenciesRepositorySuite) {
+	err := s.repo.InsertCurrenciesCodes(s.ctx, []string{"CHF", "EUR"})
+
+	assert.NoError(s.t, err)
+	currencyCodesMap := s.repo.GetCurrenciesCodesIdsMap(s.ctx)
+	assert.Len(s.t, currencyCodesMap, len(repositories.SeedCurrenciesCodes)+1)
+	assert.Equal(s.t, chfId, currencyCodesMap["CHF"])
+	assert.Greater(s.t, currencyCodesMap["EUR"], len(repositories.SeedCurrenciesCodes))
+	s.insert("EUR", "USD", day(1), rate("1.05"))
+}
+
+func testImportsRates(s *currenciesRepositorySuite) {
+	s.insert("CHF", "USD", day(1), rate("1.01"))
+
+	skipped, err := s.repo.ImportExchangeRates(s.ctx, []models.ExchangeRate{
+		{Source: "CHF", Destination: "USD", Date: day(1), Rate: rate("1.5")},
+		{Source: "CHF", Destination: "USD", Date: day(2), Rate: rate("1.0212345678")},
+		{Source: "JPY", Destination: "USD", Date: day(2)},
+	}, false)
+	replaced, replaceErr := s.repo.ImportExchangeRates(s.ctx, []models.ExchangeRate{
+		{Source: "CHF", Destination: "USD", Date: day(1), Rate: rate("1.5")},
+	}, true)
+
+	assert.NoError(s.t, err)
+	assert.NoError(s.t, replaceErr)
+	assert.Equal(s.t, models.ImportSummary{Inserted: 2, Skipped: 1, Stored: []int{1, 2}}, skipped)
+	assert.Equal(s.t, models.ImportSummary{Updated: 1, Stored: []int{0}}, replaced)
+	from, till := day(1), day(3)
+	exchangeRates, _ := s.repo.GetRangeExchangeRates(s.ctx, []models.CurrencyPair{pair(chfId, usdId), pair(jpyId, usdId)}, &from, &till)
+	s.assertRates([]models.ExchangeRate{
+		{Source: "CHF", Destination: "USD", Date: day(2), Rate: rate("1.021235")},
+		{Source: "JPY", Destination: "USD", Date: day(2)},
+		{Source: "CHF", Destination: "USD", Date: day(1), Rate: rate("1.5")},
+	}, exchangeRates)
+}
+
+func testFailedImportStoresNothing(s *currenciesRepositorySuite) {
+	s.insert("USD", "JPY", day(1), rate("130"))
+
+	_, err := s.repo.ImportExchangeRates(s.ctx, []models.ExchangeRate{
+		{Source: "EUR", Destination: "USD", Date: day(2), Rate: rate("1.05")},
+		{Source: "JPY", Destination: "USD", Date: day(2), Rate: rate("0.0077")},
+	}, false)
+
+	assert.Equal(s.t, customerros.CodeInvertedPair, customerros.Code(err))
+	newestDate, _ := s.repo.GetNewestExchangeRateDate(s.ctx)
+	assert.Equal(s.t, day(1), newestDate.UTC())
+	assert.NotContains(s.t, s.repo.GetCurrenciesCodesIdsMap(s.ctx), "EUR")
+}
+
+func testImportInsertsMissingCurrencies(s *currenciesRepositorySuite) {
+	summary, err := s.repo.ImportExchangeRates(s.ctx, []models.ExchangeRate{
+		{Source: "EUR", Destination: "USD", Date: day(1), Rate: rate("1.05")},
+	}, false)
+
+	assert.NoError(s.t, err)
+	assert.Equal(s.t, 1, summary.Inserted)
+	assert.Contains(s.t, s.repo.GetCurrenciesCodesIdsMap(s.ctx), "EUR")
+	exchangeRate, err := s.repo.GetLastExchangeRate(s.ctx, s.repo.GetCurrenciesCodesIdsMap(s.ctx)["EUR"], usdId)
+	assert.NoError(s.t, err)
+	assert.Equal(s.t, "1.05", exchangeRate.Rate.String())
+}
@@ -0,0 +1,95 @@
+package testhelpers
+
+import (
+	"context"
+	"sync"
+
+	"github.com/kolan92/exchange-rate-api/models"
+	"gorm.io/gorm"
+)
+
+type MockAlertsRepository struct {
+	mu                   sync.Mutex
+	AlertRules           []models.AlertRule
+	AlertRulesError      error
+	WebhookDeliveries    []models.WebhookDelivery
+	DeleteAlertRuleError error
+}
+
+func NewMockAlertsRepository() *MockAlertsRepository {
+	return &MockAlertsRepository{}
+}
+
+func (m *MockAlertsRepository) InsertAlertRule(ctx context.Context, alertRule *models.AlertRule) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	alertRule.Id = len(m.AlertRules) + 1
+	m.AlertRules = append(m.AlertRules, *alertRule)
+	return nil
+}
+
+func (m *MockAlertsRepository) GetAlertRules(ctx context.Context) ([]models.AlertRule, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return append([]models.AlertRule{}, m.AlertRules...), m.AlertRulesError
+}
+
+func (m *MockAlertsRepository) GetAlertRulesForCurrencies(ctx context.Context, sourceCurrencyCode, destinationCurrencyCode string) ([]models.AlertRule, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	alertRules := []models.AlertRule{}
+	for _, alertRule := range m.AlertRules {
+		if alertRule.Source == sourceCurrencyCode && alertRule.Destination == destinationCurrencyCode {
+			alertRules = append(alertRules, alertRule)
+		}
+	}
+	return alertRules, m.AlertRulesError
+}
+
+func (m *MockAlertsRepository) DeleteAlertRule(ctx context.Context, id int) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.DeleteAlertRuleError != nil {
+		return m.DeleteAlertRuleError
+	}
+	for i, alertRule := range m.AlertRules {
+		if alertRule.Id == id {
+			m.AlertRules = append(m.AlertRules[:i], m.AlertRules[i+1:]...)
+			return nil
+		}
+	}
+	return gorm.ErrRecordNotFound
+}
+
+func (m *MockAlertsRepository) InsertWebhookDelivery(ctx context.Context, delivery *models.WebhookDelivery) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.WebhookDeliveries = append(m.WebhookDeliveries, *delivery)
+	return nil
+}
+
+func (m *MockAlertsRepository) GetWebhookDeliveries(ctx context.Context, alertRuleId int) ([]models.WebhookDelivery, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	isFound := false
+	for _, alertRule := range m.AlertRules {
+		isFound = isFound || alertRule.Id == alertRuleId
+	}
+	if !isFound {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	deliveries := []models.WebhookDelivery{}
+	for _, delivery := range m.WebhookDeliveries {
+		if delivery.AlertRuleId == alertRuleId {
+			deliveries = append(deliveries, delivery)
+		}
+	}
+	return deliveries, nil
+}
@@ -0,0 +1,66 @@
+package testhelpers
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/kolan92/exchange-rate-api/models"
+	"gorm.io/gorm"
+)
+
+type MockApiKeysRepository struct {
+	mu          sync.Mutex
+	ApiKeys     []models.DbApiKey
+	ApiKeyError error
+}
+
+func NewMockApiKeysRepository() *MockApiKeysRepository {
+	return &MockApiKeysRepository{}
+}
+
+func (m *MockApiKeysRepository) InsertApiKey(ctx context.Context, apiKey *models.DbApiKey) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	apiKey.Id = len(m.ApiKeys) + 1
+	apiKey.CreatedAt = time.Now().UTC()
+	m.ApiKeys = append(m.ApiKeys, *apiKey)
+	return nil
+}
+
+func (m *MockApiKeysRepository) GetApiKeys(ctx context.Context) ([]models.DbApiKey, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return append([]models.DbApiKey{}, m.ApiKeys...), m.ApiKeyError
+}
+
+func (m *MockApiKeysRepository) GetActiveApiKeyByHash(ctx context.Context, keyHash string) (*models.DbApiKey, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.ApiKeyError != nil {
+		return nil, m.ApiKeyError
+	}
+	for _, apiKey := range m.ApiKeys {
+		if apiKey.KeyHash == keyHash && apiKey.RevokedAt == nil {
+			return &apiKey, nil
+		}
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+func (m *MockApiKeysRepository) RevokeApiKey(ctx context.Context, id int) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for i := range m.ApiKeys {
+		if m.ApiKeys[i].Id == id && m.ApiKeys[i].RevokedAt == nil {
+			now := time.Now().UTC()
+			m.ApiKeys[i].RevokedAt = &now
+			return nil
+		}
+	}
+	return gorm.ErrRecordNotFound
+}
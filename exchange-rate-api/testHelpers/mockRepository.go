@@ -1,6 +1,7 @@
 package testhelpers
 
 import (
+	"context"
 	"time"
 
 	"github.com/kolan92/exchange-rate-api/models"
@@ -11,6 +12,16 @@ type MockRepository struct {
 	LatestExchangeRate                     *models.ExchangeRate
 	LatestExchangeRateError                error
 	SourceCurrencyId, DestinaionCurrencyId int
+	LatestExchangeRates                    []models.ExchangeRate
+	RangeExchangeRates                     []models.ExchangeRate
+	CurrencyPairsCalls                     [][]models.CurrencyPair
+	InsertExchangeRateError                error
+	UpdateExchangeRateError                error
+	ImportSummary                          models.ImportSummary
+	ImportExchangeRatesError               error
+	NewestExchangeRateDate                 *time.Time
+	NewestExchangeRateDateError            error
+	NewestExchangeRates                    []models.ExchangeRate
 }
 
 func NewMockRepository() *MockRepository {
@@ -19,34 +30,69 @@ func NewMockRepository() *MockRepository {
 	}
 }
 
-func (m *MockRepository) GetCurrenciesCodesIdsMap() map[string]int {
+func (m *MockRepository) GetCurrenciesCodesIdsMap(ctx context.Context) map[string]int {
 	return m.CodesCurrenciesIdsMap
 }
 
-func (m *MockRepository) GetCurrenciesCodes() []string {
+func (m *MockRepository) InsertCurrenciesCodes(ctx context.Context, codes []string) error {
+	for _, code := range codes {
+		if _, isFound := m.CodesCurrenciesIdsMap[code]; !isFound {
+			m.CodesCurrenciesIdsMap[code] = len(m.CodesCurrenciesIdsMap) + 1
+		}
+	}
+	return nil
+}
+
+func (m *MockRepository) GetCurrenciesCodes(ctx context.Context) []string {
 	currencies := []string{}
 
-	for currencyCode := range m.GetCurrenciesCodesIdsMap() {
+	for currencyCode := range m.GetCurrenciesCodesIdsMap(ctx) {
 		currencies = append(currencies, currencyCode)
 	}
 	return currencies
 }
 
-func (m *MockRepository) GetLastExchangeRate(sourceCurrencyId, destinationCurrencyId int) (*models.ExchangeRate, error) {
+func (m *MockRepository) GetLastExchangeRate(ctx context.Context, sourceCurrencyId, destinationCurrencyId int) (*models.ExchangeRate, error) {
 	m.SourceCurrencyId = sourceCurrencyId
 	m.DestinaionCurrencyId = destinationCurrencyId
 	return m.LatestExchangeRate, m.LatestExchangeRateError
 }
 
-func (m *MockRepository) GetAllExchangeRatesFromDate(date time.Time) ([]models.ExchangeRate, error) {
+func (m *MockRepository) GetLastExchangeRates(ctx context.Context, currencyPairs []models.CurrencyPair) ([]models.ExchangeRate, error) {
+	m.CurrencyPairsCalls = append(m.CurrencyPairsCalls, currencyPairs)
+	return m.LatestExchangeRates, m.LatestExchangeRateError
+}
+
+func (m *MockRepository) GetAllExchangeRatesFromDate(ctx context.Context, date time.Time) ([]models.ExchangeRate, error) {
 	return []models.ExchangeRate{}, nil
 }
 
-func (m *MockRepository) GetRangeExchangeRate(sourceCurrencyId, destinationCurrencyId int, from, till *time.Time) ([]models.ExchangeRate, error) {
+func (m *MockRepository) GetRangeExchangeRate(ctx context.Context, sourceCurrencyId, destinationCurrencyId int, from, till *time.Time) ([]models.ExchangeRate, error) {
 	return []models.ExchangeRate{}, nil
 }
 
-func (m *MockRepository) InsertExchangeRate(exchangeRate *models.ExchangeRate) error {
+func (m *MockRepository) GetRangeExchangeRates(ctx context.Context, currencyPairs []models.CurrencyPair, from, till *time.Time) ([]models.ExchangeRate, error) {
+	m.CurrencyPairsCalls = append(m.CurrencyPairsCalls, currencyPairs)
+	return m.RangeExchangeRates, nil
+}
 
-	return nil
+func (m *MockRepository) GetNewestExchangeRateDate(ctx context.Context) (*time.Time, error) {
+	return m.NewestExchangeRateDate, m.NewestExchangeRateDateError
+}
+
+func (m *MockRepository) GetNewestExchangeRates(ctx context.Context) ([]models.ExchangeRate, error) {
+	return m.NewestExchangeRates, m.NewestExchangeRateDateError
+}
+
+func (m *MockRepository) InsertExchangeRate(ctx context.Context, exchangeRate *models.ExchangeRate) error {
+
+	return m.InsertExchangeRateError
+}
+
+func (m *MockRepository) UpdateExchangeRate(ctx context.Context, exchangeRate *models.ExchangeRate) error {
+	return m.UpdateExchangeRateError
+}
+
+func (m *MockRepository) ImportExchangeRates(ctx context.Context, exchangeRates []models.ExchangeRate, replace bool) (models.ImportSummary, error) {
+	return m.ImportSummary, m.ImportExchangeRatesError
 }
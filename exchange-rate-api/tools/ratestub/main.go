@@ -0,0 +1,46 @@
+// Ratestub serves exchange rates like providers of ingestion, so ingestion and imports can be run offline.
+//
+//	go run . rates export -format json -output rates.json USD/CHF
+//	go run ./tools/ratestub -rates rates.json -ecb eurofxref-hist.xml -listen localhost:8090
+//	INGESTION_ENABLED=true INGESTION_SOURCE=http://localhost:8090/rates INGESTION_PAIRS=USD/CHF go run .
+//	go run . rates import-ecb http://localhost:8090/eurofxref
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"net/http"
+
+	"github.com/kolan92/exchange-rate-api/ingestion"
+)
+
+func main() {
+	ratesFile := flag.String("rates", "", "json array of exchange rates served on /rates, e.g. written by rates export -format json")
+	ecbFile := flag.String("ecb", "", "ECB eurofxref xml served on /eurofxref as it is")
+	listenAddress := flag.String("listen", "localhost:8090", "address of http server")
+	flag.Parse()
+
+	if *ratesFile == "" && *ecbFile == "" {
+		log.Fatal("set -rates or -ecb file")
+	}
+
+	if *ratesFile != "" {
+		exchangeRates, err := ingestion.NewFileProvider(*ratesFile).FetchRates(context.Background(), nil)
+		if err != nil {
+			log.Fatal(err)
+		}
+		http.Handle("/rates", ingestion.NewStubHandler(exchangeRates))
+		log.Printf("serving %d rates on http://%s/rates", len(exchangeRates), *listenAddress)
+	}
+
+	if *ecbFile != "" {
+		http.HandleFunc("/eurofxref", func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "text/xml")
+			http.ServeFile(w, r, *ecbFile)
+		})
+		log.Printf("serving %s on http://%s/eurofxref", *ecbFile, *listenAddress)
+	}
+
+	log.Fatal(http.ListenAndServe(*listenAddress, nil))
+}
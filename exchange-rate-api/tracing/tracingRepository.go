@@ -0,0 +1,158 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	customerros "github.com/kolan92/exchange-rate-api/custom-erros"
+	"github.com/kolan92/exchange-rate-api/models"
+	"github.com/kolan92/exchange-rate-api/repositories"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+)
+
+// TracingRepository wraps every method of the repository in a span, child of the span from context.
+type TracingRepository struct {
+	repo repositories.CurrenciesRepository
+}
+
+func NewTracingRepository(repo repositories.CurrenciesRepository) repositories.CurrenciesRepository {
+	return &TracingRepository{repo}
+}
+
+func start(ctx context.Context, method string, attributes ...attribute.KeyValue) (context.Context, trace.Span) {
+	return tracer().Start(ctx, "CurrenciesRepository."+method,
+		trace.WithSpanKind(trace.SpanKindInternal),
+		trace.WithAttributes(attributes...))
+}
+
+// end records unexpected errors, not found and duplicates are regular results of the repository.
+func end(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		if !errors.Is(err, gorm.ErrRecordNotFound) && !errors.Is(err, customerros.ErrDuplicateKeyViolation) {
+			span.SetStatus(codes.Error, err.Error())
+		}
+	}
+	span.End()
+}
+
+func pairAttributes(sourceCurrencyId, destinationCurrencyId int) []attribute.KeyValue {
+	return []attribute.KeyValue{
+		attribute.Int("exchange_rate.source_currency_id", sourceCurrencyId),
+		attribute.Int("exchange_rate.destination_currency_id", destinationCurrencyId),
+	}
+}
+
+func periodAttributes(from, till *time.Time) []attribute.KeyValue {
+	attributes := []attribute.KeyValue{}
+	if from != nil {
+		attributes = append(attributes, attribute.String("exchange_rate.from", from.Format(time.RFC3339)))
+	}
+	if till != nil {
+		attributes = append(attributes, attribute.String("exchange_rate.till", till.Format(time.RFC3339)))
+	}
+	return attributes
+}
+
+func (r *TracingRepository) GetCurrenciesCodesIdsMap(ctx context.Context) map[string]int {
+	ctx, span := start(ctx, "GetCurrenciesCodesIdsMap")
+	defer span.End()
+	return r.repo.GetCurrenciesCodesIdsMap(ctx)
+}
+
+func (r *TracingRepository) InsertCurrenciesCodes(ctx context.Context, codes []string) error {
+	ctx, span := start(ctx, "InsertCurrenciesCodes", attribute.StringSlice("currencies.codes", codes))
+	err := r.repo.InsertCurrenciesCodes(ctx, codes)
+	end(span, err)
+	return err
+}
+
+func (r *TracingRepository) GetCurrenciesCodes(ctx context.Context) []string {
+	ctx, span := start(ctx, "GetCurrenciesCodes")
+	defer span.End()
+	return r.repo.GetCurrenciesCodes(ctx)
+}
+
+func (r *TracingRepository) GetLastExchangeRate(ctx context.Context, sourceCurrencyId, destinationCurrencyId int) (*models.ExchangeRate, error) {
+	ctx, span := start(ctx, "GetLastExchangeRate", pairAttributes(sourceCurrencyId, destinationCurrencyId)...)
+	exchangeRate, err := r.repo.GetLastExchangeRate(ctx, sourceCurrencyId, destinationCurrencyId)
+	end(span, err)
+	return exchangeRate, err
+}
+
+func (r *TracingRepository) GetLastExchangeRates(ctx context.Context, currencyPairs []models.CurrencyPair) ([]models.ExchangeRate, error) {
+	ctx, span := start(ctx, "GetLastExchangeRates", attribute.Int("exchange_rate.pairs", len(currencyPairs)))
+	exchangeRates, err := r.repo.GetLastExchangeRates(ctx, currencyPairs)
+	end(span, err)
+	return exchangeRates, err
+}
+
+func (r *TracingRepository) GetAllExchangeRatesFromDate(ctx context.Context, date time.Time) ([]models.ExchangeRate, error) {
+	ctx, span := start(ctx, "GetAllExchangeRatesFromDate", attribute.String("exchange_rate.date", date.Format(time.RFC3339)))
+	exchangeRates, err := r.repo.GetAllExchangeRatesFromDate(ctx, date)
+	end(span, err)
+	return exchangeRates, err
+}
+
+func (r *TracingRepository) GetRangeExchangeRate(ctx context.Context, sourceCurrencyId, destinationCurrencyId int, from, till *time.Time) ([]models.ExchangeRate, error) {
+	ctx, span := start(ctx, "GetRangeExchangeRate",
+		append(pairAttributes(sourceCurrencyId, destinationCurrencyId), periodAttributes(from, till)...)...)
+	exchangeRates, err := r.repo.GetRangeExchangeRate(ctx, sourceCurrencyId, destinationCurrencyId, from, till)
+	end(span, err)
+	return exchangeRates, err
+}
+
+func (r *TracingRepository) GetRangeExchangeRates(ctx context.Context, currencyPairs []models.CurrencyPair, from, till *time.Time) ([]models.ExchangeRate, error) {
+	ctx, span := start(ctx, "GetRangeExchangeRates",
+		append(periodAttributes(from, till), attribute.Int("exchange_rate.pairs", len(currencyPairs)))...)
+	exchangeRates, err := r.repo.GetRangeExchangeRates(ctx, currencyPairs, from, till)
+	end(span, err)
+	return exchangeRates, err
+}
+
+func (r *TracingRepository) GetNewestExchangeRateDate(ctx context.Context) (*time.Time, error) {
+	ctx, span := start(ctx, "GetNewestExchangeRateDate")
+	newestDate, err := r.repo.GetNewestExchangeRateDate(ctx)
+	end(span, err)
+	return newestDate, err
+}
+
+func (r *TracingRepository) GetNewestExchangeRates(ctx context.Context) ([]models.ExchangeRate, error) {
+	ctx, span := start(ctx, "GetNewestExchangeRates")
+	exchangeRates, err := r.repo.GetNewestExchangeRates(ctx)
+	end(span, err)
+	return exchangeRates, err
+}
+
+func (r *TracingRepository) InsertExchangeRate(ctx context.Context, exchangeRate *models.ExchangeRate) error {
+	ctx, span := start(ctx, "InsertExchangeRate",
+		attribute.String("exchange_rate.source", exchangeRate.Source),
+		attribute.String("exchange_rate.destination", exchangeRate.Destination),
+		attribute.String("exchange_rate.date", exchangeRate.Date.Format(time.RFC3339)))
+	err := r.repo.InsertExchangeRate(ctx, exchangeRate)
+	end(span, err)
+	return err
+}
+
+func (r *TracingRepository) UpdateExchangeRate(ctx context.Context, exchangeRate *models.ExchangeRate) error {
+	ctx, span := start(ctx, "UpdateExchangeRate",
+		attribute.String("exchange_rate.source", exchangeRate.Source),
+		attribute.String("exchange_rate.destination", exchangeRate.Destination),
+		attribute.String("exchange_rate.date", exchangeRate.Date.Format(time.RFC3339)))
+	err := r.repo.UpdateExchangeRate(ctx, exchangeRate)
+	end(span, err)
+	return err
+}
+
+func (r *TracingRepository) ImportExchangeRates(ctx context.Context, exchangeRates []models.ExchangeRate, replace bool) (models.ImportSummary, error) {
+	ctx, span := start(ctx, "ImportExchangeRates",
+		attribute.Int("exchange_rates.count", len(exchangeRates)),
+		attribute.Bool("exchange_rates.replace", replace))
+	summary, err := r.repo.ImportExchangeRates(ctx, exchangeRates, replace)
+	end(span, err)
+	return summary, err
+}
@@ -0,0 +1,78 @@
+package tracing
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/kolan92/exchange-rate-api/models"
+	testhelpers "github.com/kolan92/exchange-rate-api/testHelpers"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+var (
+	repository *testhelpers.MockRepository
+	recorder   *tracetest.SpanRecorder
+	router     *gin.Engine
+)
+
+func setup() {
+	recorder = tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+
+	repository = testhelpers.NewMockRepository()
+	repo := NewTracingRepository(repository)
+
+	gin.SetMode(gin.TestMode)
+	router = gin.New()
+	router.Use(Middleware())
+	router.POST("/exchange-rate", func(c *gin.Context) {
+		if err := repo.InsertExchangeRate(c.Request.Context(), &models.ExchangeRate{Source: "CHF", Destination: "USD"}); err != nil {
+			c.Status(http.StatusInternalServerError)
+			return
+		}
+		c.Status(http.StatusAccepted)
+	})
+}
+
+func TestRepositorySpanIsChildOfRequestSpan(t *testing.T) {
+	setup()
+
+	router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/exchange-rate", nil))
+
+	spans := recorder.Ended()
+	assert.Len(t, spans, 2)
+	assert.Equal(t, "CurrenciesRepository.InsertExchangeRate", spans[0].Name())
+	assert.Equal(t, "POST /exchange-rate", spans[1].Name())
+	assert.Equal(t, spans[1].SpanContext().SpanID(), spans[0].Parent().SpanID())
+}
+
+func TestRequestContinuesTraceOfCaller(t *testing.T) {
+	setup()
+	const traceId = "4bf92f3577b34da6a3ce929d0e0e4736"
+	request := httptest.NewRequest(http.MethodPost, "/exchange-rate", nil)
+	request.Header.Set("traceparent", "00-"+traceId+"-00f067aa0ba902b7-01")
+	otel.SetTextMapPropagator(propagator())
+
+	router.ServeHTTP(httptest.NewRecorder(), request)
+
+	spans := recorder.Ended()
+	assert.Equal(t, traceId, spans[1].SpanContext().TraceID().String())
+}
+
+func TestFailedRequestIsMarkedAsError(t *testing.T) {
+	setup()
+	repository.InsertExchangeRateError = errors.New("connection refused")
+
+	router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/exchange-rate", nil))
+
+	spans := recorder.Ended()
+	assert.Equal(t, codes.Error, spans[0].Status().Code)
+	assert.Equal(t, codes.Error, spans[1].Status().Code)
+}
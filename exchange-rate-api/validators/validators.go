@@ -0,0 +1,123 @@
+package validators
+
+import (
+	"net/url"
+	"time"
+
+	customerros "github.com/kolan92/exchange-rate-api/custom-erros"
+	"github.com/kolan92/exchange-rate-api/models"
+)
+
+// Validation shared by all transports (REST and gRPC), so both of them accept and reject the same input.
+
+const (
+	DateLayout                 = "2006-01-02"
+	DefaultDestinationCurrency = "USD"
+)
+
+func GetCurrenciesIds(currencyCodesMap map[string]int, sourceCurrencyCode, destinationCurrencyCode string) (sourceCurrencyId, destinationCurrencyId int, err error) {
+	if len(sourceCurrencyCode) == 0 {
+		return 0, 0, customerros.New(customerros.CodeMissingParameter, "missing source currency")
+	}
+
+	if len(destinationCurrencyCode) == 0 {
+		destinationCurrencyCode = DefaultDestinationCurrency
+	}
+
+	if sourceCurrencyCode == destinationCurrencyCode {
+		return 0, 0, customerros.New(customerros.CodeSameCurrency, "source and destination currency are the same")
+	}
+
+	sourceCurrencyId, isFound := currencyCodesMap[sourceCurrencyCode]
+	if !isFound {
+		return 0, 0, customerros.Newf(customerros.CodeUnknownCurrency, "unknown %s source currency", sourceCurrencyCode)
+	}
+
+	destinationCurrencyId, isFound = currencyCodesMap[destinationCurrencyCode]
+	if !isFound {
+		return 0, 0, customerros.Newf(customerros.CodeUnknownCurrency, "unknown %s destination currency", destinationCurrencyCode)
+	}
+
+	return sourceCurrencyId, destinationCurrencyId, nil
+}
+
+func ParseDate(dateParam string) (time.Time, error) {
+	dateValue, err := time.Parse(DateLayout, dateParam)
+	if err != nil {
+		return time.Time{}, customerros.Newf(customerros.CodeInvalidDate, "date %s is in incorrect format, expected YYYY-MM-DD", dateParam)
+	}
+	return dateValue, nil
+}
+
+func ParseFromAndTillDates(fromParam, tillParam string) (from, till *time.Time, err error) {
+	fromValue, err := time.Parse(DateLayout, fromParam)
+	if err != nil {
+		return nil, nil, customerros.Newf(customerros.CodeInvalidDate, "from %s is in incorrect format, expected YYYY-MM-DD", fromParam)
+	}
+
+	tillValue, err := time.Parse(DateLayout, tillParam)
+	if err != nil {
+		return nil, nil, customerros.Newf(customerros.CodeInvalidDate, "till %s is in incorrect format, expected YYYY-MM-DD", tillParam)
+	}
+
+	if fromValue.After(tillValue) {
+		return nil, nil, customerros.New(customerros.CodeInvalidDateRange, "from must be before till")
+	}
+
+	return &fromValue, &tillValue, nil
+}
+
+// ValidateNewExchangeRate checks that exchange rate can be inserted, rate is amount of destination (quote) currency
+// for one unit of source (base) currency. Time part of the date is dropped, as rates are stored per day.
+// Rate of pair stored the other way round is rejected by repository.
+func ValidateNewExchangeRate(newExchangeRate *models.ExchangeRate, currencyCodesMap map[string]int) error {
+	if newExchangeRate.Date.IsZero() {
+		return customerros.New(customerros.CodeMissingParameter, "missing exchange rate date")
+	}
+
+	year, month, day := newExchangeRate.Date.Date()
+	newExchangeRate.Date = time.Date(year, month, day, 0, 00, 00, 0, time.UTC)
+
+	if _, isFound := currencyCodesMap[newExchangeRate.Source]; !isFound {
+		return customerros.Newf(customerros.CodeUnknownCurrency, "unknown %s source currency", newExchangeRate.Source)
+	}
+
+	if _, isFound := currencyCodesMap[newExchangeRate.Destination]; !isFound {
+		return customerros.Newf(customerros.CodeUnknownCurrency, "unknown %s destination currency", newExchangeRate.Destination)
+	}
+
+	if newExchangeRate.Destination == newExchangeRate.Source {
+		return customerros.New(customerros.CodeSameCurrency, "source and destination currency are the same")
+	}
+
+	if newExchangeRate.Rate != nil && !newExchangeRate.Rate.IsPositive() {
+		return customerros.Newf(customerros.CodeValidationFailed, "rate must be positive amount of %s for one %s", newExchangeRate.Destination, newExchangeRate.Source)
+	}
+
+	return nil
+}
+
+func ValidateNewAlertRule(alertRule *models.AlertRule, currencyCodesMap map[string]int) error {
+	if _, _, err := GetCurrenciesIds(currencyCodesMap, alertRule.Source, alertRule.Destination); err != nil {
+		return err
+	}
+
+	if (alertRule.Threshold == nil) == (alertRule.ChangePercent == nil) {
+		return customerros.New(customerros.CodeValidationFailed, "exactly one of threshold and changePercent must be set")
+	}
+
+	if alertRule.Threshold != nil && !alertRule.Threshold.IsPositive() {
+		return customerros.New(customerros.CodeValidationFailed, "threshold must be positive")
+	}
+
+	if alertRule.ChangePercent != nil && !alertRule.ChangePercent.IsPositive() {
+		return customerros.New(customerros.CodeValidationFailed, "changePercent must be positive")
+	}
+
+	callbackUrl, err := url.Parse(alertRule.CallbackUrl)
+	if err != nil || (callbackUrl.Scheme != "http" && callbackUrl.Scheme != "https") {
+		return customerros.New(customerros.CodeValidationFailed, "callbackUrl must be http or https url")
+	}
+
+	return nil
+}
@@ -1,1316 +0,0 @@
--- CHF 2
--- USD 1
-INSERT INTO
-    exchange_rates (
-        destination_currency_id,
-        source_currency_id,
-        date,
-        rate
-    )
-VALUES
-    (1, 2, '2016-01-29', 1.0226),
-    (1, 2, '2016-02-01', 1.0202),
-    (1, 2, '2016-02-02', 1.0181),
-    (1, 2, '2016-02-03', 1.0070),
-    (1, 2, '2016-02-04', 0.9926),
-    (1, 2, '2016-02-05', 0.9938),
-    (1, 2, '2016-02-08', 0.9885),
-    (1, 2, '2016-02-09', 0.9720),
-    (1, 2, '2016-02-10', 0.9779),
-    (1, 2, '2016-02-11', 0.9706),
-    (1, 2, '2016-02-12', 0.9768),
-    (1, 2, '2016-02-15', NULL),
-    (1, 2, '2016-02-16', 0.9884),
-    (1, 2, '2016-02-17', 0.9926),
-    (1, 2, '2016-02-18', 0.9940),
-    (1, 2, '2016-02-19', 0.9893),
-    (1, 2, '2016-02-22', 0.9986),
-    (1, 2, '2016-02-23', 0.9920),
-    (1, 2, '2016-02-24', 0.9860),
-    (1, 2, '2016-02-25', 0.9894),
-    (1, 2, '2016-02-26', 0.9967),
-    (1, 2, '2016-02-29', 0.9960),
-    (1, 2, '2016-03-01', 0.9994),
-    (1, 2, '2016-03-02', 0.9981),
-    (1, 2, '2016-03-03', 0.9920),
-    (1, 2, '2016-03-04', 0.9926),
-    (1, 2, '2016-03-07', 0.9969),
-    (1, 2, '2016-03-08', 0.9939),
-    (1, 2, '2016-03-09', 0.9957),
-    (1, 2, '2016-03-10', 0.9857),
-    (1, 2, '2016-03-11', 0.9813),
-    (1, 2, '2016-03-14', 0.9860),
-    (1, 2, '2016-03-15', 0.9852),
-    (1, 2, '2016-03-16', 0.9879),
-    (1, 2, '2016-03-17', 0.9680),
-    (1, 2, '2016-03-18', 0.9682),
-    (1, 2, '2016-03-21', 0.9678),
-    (1, 2, '2016-03-22', 0.9705),
-    (1, 2, '2016-03-23', 0.9731),
-    (1, 2, '2016-03-24', 0.9766),
-    (1, 2, '2016-03-25', 0.9780),
-    (1, 2, '2016-03-28', 0.9732),
-    (1, 2, '2016-03-29', 0.9744),
-    (1, 2, '2016-03-30', 0.9636),
-    (1, 2, '2016-03-31', 0.9583),
-    (1, 2, '2016-04-01', 0.9588),
-    (1, 2, '2016-04-04', 0.9597),
-    (1, 2, '2016-04-05', 0.9572),
-    (1, 2, '2016-04-06', 0.9537),
-    (1, 2, '2016-04-07', 0.9552),
-    (1, 2, '2016-04-08', 0.9536),
-    (1, 2, '2016-04-11', 0.9538),
-    (1, 2, '2016-04-12', 0.9546),
-    (1, 2, '2016-04-13', 0.9651),
-    (1, 2, '2016-04-14', 0.9667),
-    (1, 2, '2016-04-15', 0.9670),
-    (1, 2, '2016-04-18', 0.9636),
-    (1, 2, '2016-04-19', 0.9599),
-    (1, 2, '2016-04-20', 0.9671),
-    (1, 2, '2016-04-21', 0.9722),
-    (1, 2, '2016-04-22', 0.9774),
-    (1, 2, '2016-04-25', 0.9742),
-    (1, 2, '2016-04-26', 0.9713),
-    (1, 2, '2016-04-27', 0.9710),
-    (1, 2, '2016-04-28', 0.9687),
-    (1, 2, '2016-04-29', 0.9598),
-    (1, 2, '2016-05-02', 0.9556),
-    (1, 2, '2016-05-03', 0.9541),
-    (1, 2, '2016-05-04', 0.9574),
-    (1, 2, '2016-05-05', 0.9686),
-    (1, 2, '2016-05-06', 0.9697),
-    (1, 2, '2016-05-09', 0.9698),
-    (1, 2, '2016-05-10', 0.9746),
-    (1, 2, '2016-05-11', 0.9699),
-    (1, 2, '2016-05-12', 0.9693),
-    (1, 2, '2016-05-13', 0.9756),
-    (1, 2, '2016-05-16', 0.9772),
-    (1, 2, '2016-05-17', 0.9761),
-    (1, 2, '2016-05-18', 0.9822),
-    (1, 2, '2016-05-19', 0.9894),
-    (1, 2, '2016-05-20', 0.9920),
-    (1, 2, '2016-05-23', 0.9919),
-    (1, 2, '2016-05-24', 0.9930),
-    (1, 2, '2016-05-25', 0.9908),
-    (1, 2, '2016-05-26', 0.9898),
-    (1, 2, '2016-05-27', 0.9916),
-    (1, 2, '2016-05-30', NULL),
-    (1, 2, '2016-05-31', 0.9934),
-    (1, 2, '2016-06-01', 0.9892),
-    (1, 2, '2016-06-02', 0.9902),
-    (1, 2, '2016-06-03', 0.9781),
-    (1, 2, '2016-06-06', 0.9720),
-    (1, 2, '2016-06-07', 0.9663),
-    (1, 2, '2016-06-08', 0.9594),
-    (1, 2, '2016-06-09', 0.9639),
-    (1, 2, '2016-06-10', 0.9632),
-    (1, 2, '2016-06-13', 0.9662),
-    (1, 2, '2016-06-14', 0.9640),
-    (1, 2, '2016-06-15', 0.9647),
-    (1, 2, '2016-06-16', 0.9662),
-    (1, 2, '2016-06-17', 0.9610),
-    (1, 2, '2016-06-20', 0.9616),
-    (1, 2, '2016-06-21', 0.9596),
-    (1, 2, '2016-06-22', 0.9584),
-    (1, 2, '2016-06-23', 0.9566),
-    (1, 2, '2016-06-24', 0.9702),
-    (1, 2, '2016-06-27', 0.9787),
-    (1, 2, '2016-06-28', 0.9812),
-    (1, 2, '2016-06-29', 0.9794),
-    (1, 2, '2016-06-30', 0.9792),
-    (1, 2, '2016-07-01', 0.9730),
-    (1, 2, '2016-07-04', NULL),
-    (1, 2, '2016-07-05', 0.9768),
-    (1, 2, '2016-07-06', 0.9778),
-    (1, 2, '2016-07-07', 0.9781),
-    (1, 2, '2016-07-08', 0.9843),
-    (1, 2, '2016-07-11', 0.9830),
-    (1, 2, '2016-07-12', 0.9869),
-    (1, 2, '2016-07-13', 0.9834),
-    (1, 2, '2016-07-14', 0.9806),
-    (1, 2, '2016-07-15', 0.9834),
-    (1, 2, '2016-07-18', 0.9822),
-    (1, 2, '2016-07-19', 0.9850),
-    (1, 2, '2016-07-20', 0.9872),
-    (1, 2, '2016-07-21', 0.9869),
-    (1, 2, '2016-07-22', 0.9888),
-    (1, 2, '2016-07-25', 0.9869),
-    (1, 2, '2016-07-26', 0.9924),
-    (1, 2, '2016-07-27', 0.9936),
-    (1, 2, '2016-07-28', 0.9806),
-    (1, 2, '2016-07-29', 0.9690),
-    (1, 2, '2016-08-01', 0.9671),
-    (1, 2, '2016-08-02', 0.9643),
-    (1, 2, '2016-08-03', 0.9716),
-    (1, 2, '2016-08-04', 0.9738),
-    (1, 2, '2016-08-05', 0.9796),
-    (1, 2, '2016-08-08', 0.9838),
-    (1, 2, '2016-08-09', 0.9826),
-    (1, 2, '2016-08-10', 0.9759),
-    (1, 2, '2016-08-11', 0.9722),
-    (1, 2, '2016-08-12', 0.9738),
-    (1, 2, '2016-08-15', 0.9714),
-    (1, 2, '2016-08-16', 0.9622),
-    (1, 2, '2016-08-17', 0.9640),
-    (1, 2, '2016-08-18', 0.9576),
-    (1, 2, '2016-08-19', 0.9594),
-    (1, 2, '2016-08-22', 0.9625),
-    (1, 2, '2016-08-23', 0.9622),
-    (1, 2, '2016-08-24', 0.9673),
-    (1, 2, '2016-08-25', 0.9684),
-    (1, 2, '2016-08-26', 0.9718),
-    (1, 2, '2016-08-29', 0.9780),
-    (1, 2, '2016-08-30', 0.9822),
-    (1, 2, '2016-08-31', 0.9830),
-    (1, 2, '2016-09-01', 0.9792),
-    (1, 2, '2016-09-02', 0.9804),
-    (1, 2, '2016-09-05', NULL),
-    (1, 2, '2016-09-06', 0.9718),
-    (1, 2, '2016-09-07', 0.9702),
-    (1, 2, '2016-09-08', 0.9726),
-    (1, 2, '2016-09-09', 0.9770),
-    (1, 2, '2016-09-12', 0.9736),
-    (1, 2, '2016-09-13', 0.9740),
-    (1, 2, '2016-09-14', 0.9708),
-    (1, 2, '2016-09-15', 0.9714),
-    (1, 2, '2016-09-16', 0.9794),
-    (1, 2, '2016-09-19', 0.9798),
-    (1, 2, '2016-09-20', 0.9781),
-    (1, 2, '2016-09-21', 0.9758),
-    (1, 2, '2016-09-22', 0.9678),
-    (1, 2, '2016-09-23', 0.9702),
-    (1, 2, '2016-09-26', 0.9668),
-    (1, 2, '2016-09-27', 0.9718),
-    (1, 2, '2016-09-28', 0.9724),
-    (1, 2, '2016-09-29', 0.9655),
-    (1, 2, '2016-09-30', 0.9694),
-    (1, 2, '2016-10-03', 0.9740),
-    (1, 2, '2016-10-04', 0.9780),
-    (1, 2, '2016-10-05', 0.9770),
-    (1, 2, '2016-10-06', 0.9798),
-    (1, 2, '2016-10-07', 0.9806),
-    (1, 2, '2016-10-10', NULL),
-    (1, 2, '2016-10-11', 0.9878),
-    (1, 2, '2016-10-12', 0.9902),
-    (1, 2, '2016-10-13', 0.9870),
-    (1, 2, '2016-10-14', 0.9902),
-    (1, 2, '2016-10-17', 0.9880),
-    (1, 2, '2016-10-18', 0.9886),
-    (1, 2, '2016-10-19', 0.9894),
-    (1, 2, '2016-10-20', 0.9916),
-    (1, 2, '2016-10-21', 0.9951),
-    (1, 2, '2016-10-24', 0.9920),
-    (1, 2, '2016-10-25', 0.9940),
-    (1, 2, '2016-10-26', 0.9927),
-    (1, 2, '2016-10-27', 0.9940),
-    (1, 2, '2016-10-28', 0.9932),
-    (1, 2, '2016-10-31', 0.9890),
-    (1, 2, '2016-11-01', 0.9790),
-    (1, 2, '2016-11-02', 0.9701),
-    (1, 2, '2016-11-03', 0.9746),
-    (1, 2, '2016-11-04', 0.9682),
-    (1, 2, '2016-11-07', 0.9758),
-    (1, 2, '2016-11-08', 0.9757),
-    (1, 2, '2016-11-09', 0.9812),
-    (1, 2, '2016-11-10', 0.9878),
-    (1, 2, '2016-11-11', NULL),
-    (1, 2, '2016-11-14', 0.9978),
-    (1, 2, '2016-11-15', 1.0015),
-    (1, 2, '2016-11-16', 1.0019),
-    (1, 2, '2016-11-17', 1.0048),
-    (1, 2, '2016-11-18', 1.0094),
-    (1, 2, '2016-11-21', 1.0112),
-    (1, 2, '2016-11-22', 1.0100),
-    (1, 2, '2016-11-23', 1.0156),
-    (1, 2, '2016-11-24', NULL),
-    (1, 2, '2016-11-25', 1.0136),
-    (1, 2, '2016-11-28', 1.0158),
-    (1, 2, '2016-11-29', 1.0132),
-    (1, 2, '2016-11-30', 1.0187),
-    (1, 2, '2016-12-01', 1.0126),
-    (1, 2, '2016-12-02', 1.0110),
-    (1, 2, '2016-12-05', 1.0103),
-    (1, 2, '2016-12-06', 1.0093),
-    (1, 2, '2016-12-07', 1.0065),
-    (1, 2, '2016-12-08', 1.0154),
-    (1, 2, '2016-12-09', 1.0184),
-    (1, 2, '2016-12-12', 1.0157),
-    (1, 2, '2016-12-13', 1.0118),
-    (1, 2, '2016-12-14', 1.0099),
-    (1, 2, '2016-12-15', 1.0334),
-    (1, 2, '2016-12-16', 1.0258),
-    (1, 2, '2016-12-19', 1.0226),
-    (1, 2, '2016-12-20', 1.0282),
-    (1, 2, '2016-12-21', 1.0262),
-    (1, 2, '2016-12-22', 1.0246),
-    (1, 2, '2016-12-23', 1.0268),
-    (1, 2, '2016-12-26', NULL),
-    (1, 2, '2016-12-27', 1.0284),
-    (1, 2, '2016-12-28', 1.0313),
-    (1, 2, '2016-12-29', 1.0230),
-    (1, 2, '2016-12-30', 1.0160),
-    (1, 2, '2017-01-02', NULL),
-    (1, 2, '2017-01-03', 1.0266),
-    (1, 2, '2017-01-04', 1.0228),
-    (1, 2, '2017-01-05', 1.0107),
-    (1, 2, '2017-01-06', 1.0150),
-    (1, 2, '2017-01-09', 1.0148),
-    (1, 2, '2017-01-10', 1.0158),
-    (1, 2, '2017-01-11', 1.0203),
-    (1, 2, '2017-01-12', 1.0066),
-    (1, 2, '2017-01-13', 1.0098),
-    (1, 2, '2017-01-16', NULL),
-    (1, 2, '2017-01-17', 1.0028),
-    (1, 2, '2017-01-18', 1.0028),
-    (1, 2, '2017-01-19', 1.0094),
-    (1, 2, '2017-01-20', NULL),
-    (1, 2, '2017-01-23', 0.9990),
-    (1, 2, '2017-01-24', 0.9998),
-    (1, 2, '2017-01-25', 0.9996),
-    (1, 2, '2017-01-26', 1.0011),
-    (1, 2, '2017-01-27', 0.9992),
-    (1, 2, '2017-01-30', 0.9976),
-    (1, 2, '2017-01-31', 0.9888),
-    (1, 2, '2017-02-01', 0.9930),
-    (1, 2, '2017-02-02', 0.9894),
-    (1, 2, '2017-02-03', 0.9910),
-    (1, 2, '2017-02-06', 0.9934),
-    (1, 2, '2017-02-07', 0.9970),
-    (1, 2, '2017-02-08', 0.9934),
-    (1, 2, '2017-02-09', 1.0013),
-    (1, 2, '2017-02-10', 1.0028),
-    (1, 2, '2017-02-13', 1.0054),
-    (1, 2, '2017-02-14', 1.0072),
-    (1, 2, '2017-02-15', 1.0062),
-    (1, 2, '2017-02-16', 0.9992),
-    (1, 2, '2017-02-17', 1.0022),
-    (1, 2, '2017-02-20', NULL),
-    (1, 2, '2017-02-21', 1.0083),
-    (1, 2, '2017-02-22', 1.0109),
-    (1, 2, '2017-02-23', 1.0062),
-    (1, 2, '2017-02-24', 1.0055),
-    (1, 2, '2017-02-27', 1.0051),
-    (1, 2, '2017-02-28', 1.0022),
-    (1, 2, '2017-03-01', 1.0072),
-    (1, 2, '2017-03-02', 1.0120),
-    (1, 2, '2017-03-03', 1.0118),
-    (1, 2, '2017-03-06', 1.0114),
-    (1, 2, '2017-03-07', 1.0130),
-    (1, 2, '2017-03-08', 1.0146),
-    (1, 2, '2017-03-09', 1.0131),
-    (1, 2, '2017-03-10', 1.0104),
-    (1, 2, '2017-03-13', 1.0068),
-    (1, 2, '2017-03-14', 1.0078),
-    (1, 2, '2017-03-15', 1.0080),
-    (1, 2, '2017-03-16', 0.9953),
-    (1, 2, '2017-03-17', 0.9978),
-    (1, 2, '2017-03-20', 0.9966),
-    (1, 2, '2017-03-21', 0.9936),
-    (1, 2, '2017-03-22', 0.9900),
-    (1, 2, '2017-03-23', 0.9924),
-    (1, 2, '2017-03-24', 0.9910),
-    (1, 2, '2017-03-27', 0.9830),
-    (1, 2, '2017-03-28', 0.9850),
-    (1, 2, '2017-03-29', 0.9966),
-    (1, 2, '2017-03-30', 0.9969),
-    (1, 2, '2017-03-31', 0.9998),
-    (1, 2, '2017-04-03', 1.0029),
-    (1, 2, '2017-04-04', 1.0022),
-    (1, 2, '2017-04-05', 1.0050),
-    (1, 2, '2017-04-06', 1.0046),
-    (1, 2, '2017-04-07', 1.0066),
-    (1, 2, '2017-04-10', 1.0077),
-    (1, 2, '2017-04-11', 1.0072),
-    (1, 2, '2017-04-12', 1.0054),
-    (1, 2, '2017-04-13', 1.0047),
-    (1, 2, '2017-04-14', 1.0038),
-    (1, 2, '2017-04-17', 1.0022),
-    (1, 2, '2017-04-18', 0.9978),
-    (1, 2, '2017-04-19', 0.9986),
-    (1, 2, '2017-04-20', 0.9960),
-    (1, 2, '2017-04-21', 0.9987),
-    (1, 2, '2017-04-24', 0.9964),
-    (1, 2, '2017-04-25', 0.9925),
-    (1, 2, '2017-04-26', 0.9957),
-    (1, 2, '2017-04-27', 0.9948),
-    (1, 2, '2017-04-28', 0.9944),
-    (1, 2, '2017-05-01', 0.9955),
-    (1, 2, '2017-05-02', 0.9913),
-    (1, 2, '2017-05-03', 0.9913),
-    (1, 2, '2017-05-04', 0.9890),
-    (1, 2, '2017-05-05', 0.9876),
-    (1, 2, '2017-05-08', 0.9979),
-    (1, 2, '2017-05-09', 1.0074),
-    (1, 2, '2017-05-10', 1.0088),
-    (1, 2, '2017-05-11', 1.0061),
-    (1, 2, '2017-05-12', 1.0008),
-    (1, 2, '2017-05-15', 0.9964),
-    (1, 2, '2017-05-16', 0.9878),
-    (1, 2, '2017-05-17', 0.9808),
-    (1, 2, '2017-05-18', 0.9763),
-    (1, 2, '2017-05-19', 0.9754),
-    (1, 2, '2017-05-22', 0.9736),
-    (1, 2, '2017-05-23', 0.9740),
-    (1, 2, '2017-05-24', 0.9769),
-    (1, 2, '2017-05-25', 0.9720),
-    (1, 2, '2017-05-26', 0.9753),
-    (1, 2, '2017-05-29', NULL),
-    (1, 2, '2017-05-30', 0.9744),
-    (1, 2, '2017-05-31', 0.9684),
-    (1, 2, '2017-06-01', 0.9712),
-    (1, 2, '2017-06-02', 0.9642),
-    (1, 2, '2017-06-05', 0.9657),
-    (1, 2, '2017-06-06', 0.9628),
-    (1, 2, '2017-06-07', 0.9657),
-    (1, 2, '2017-06-08', 0.9675),
-    (1, 2, '2017-06-09', 0.9700),
-    (1, 2, '2017-06-12', 0.9688),
-    (1, 2, '2017-06-13', 0.9692),
-    (1, 2, '2017-06-14', 0.9662),
-    (1, 2, '2017-06-15', 0.9749),
-    (1, 2, '2017-06-16', 0.9739),
-    (1, 2, '2017-06-19', 0.9745),
-    (1, 2, '2017-06-20', 0.9759),
-    (1, 2, '2017-06-21', 0.9744),
-    (1, 2, '2017-06-22', 0.9728),
-    (1, 2, '2017-06-23', 0.9692),
-    (1, 2, '2017-06-26', 0.9706),
-    (1, 2, '2017-06-27', 0.9638),
-    (1, 2, '2017-06-28', 0.9608),
-    (1, 2, '2017-06-29', 0.9569),
-    (1, 2, '2017-06-30', 0.9586),
-    (1, 2, '2017-07-03', 0.9632),
-    (1, 2, '2017-07-04', NULL),
-    (1, 2, '2017-07-05', 0.9652),
-    (1, 2, '2017-07-06', 0.9634),
-    (1, 2, '2017-07-07', 0.9644),
-    (1, 2, '2017-07-10', 0.9658),
-    (1, 2, '2017-07-11', 0.9672),
-    (1, 2, '2017-07-12', 0.9648),
-    (1, 2, '2017-07-13', 0.9685),
-    (1, 2, '2017-07-14', 0.9654),
-    (1, 2, '2017-07-17', 0.9627),
-    (1, 2, '2017-07-18', 0.9526),
-    (1, 2, '2017-07-19', 0.9548),
-    (1, 2, '2017-07-20', 0.9507),
-    (1, 2, '2017-07-21', 0.9468),
-    (1, 2, '2017-07-24', 0.9456),
-    (1, 2, '2017-07-25', 0.9496),
-    (1, 2, '2017-07-26', 0.9580),
-    (1, 2, '2017-07-27', 0.9656),
-    (1, 2, '2017-07-28', 0.9685),
-    (1, 2, '2017-07-31', 0.9650),
-    (1, 2, '2017-08-01', 0.9655),
-    (1, 2, '2017-08-02', 0.9684),
-    (1, 2, '2017-08-03', 0.9690),
-    (1, 2, '2017-08-04', 0.9721),
-    (1, 2, '2017-08-07', 0.9728),
-    (1, 2, '2017-08-08', 0.9766),
-    (1, 2, '2017-08-09', 0.9649),
-    (1, 2, '2017-08-10', 0.9647),
-    (1, 2, '2017-08-11', 0.9620),
-    (1, 2, '2017-08-14', 0.9714),
-    (1, 2, '2017-08-15', 0.9718),
-    (1, 2, '2017-08-16', 0.9744),
-    (1, 2, '2017-08-17', 0.9633),
-    (1, 2, '2017-08-18', 0.9642),
-    (1, 2, '2017-08-21', 0.9629),
-    (1, 2, '2017-08-22', 0.9668),
-    (1, 2, '2017-08-23', 0.9667),
-    (1, 2, '2017-08-24', 0.9646),
-    (1, 2, '2017-08-25', 0.9574),
-    (1, 2, '2017-08-28', 0.9554),
-    (1, 2, '2017-08-29', 0.9480),
-    (1, 2, '2017-08-30', 0.9583),
-    (1, 2, '2017-08-31', 0.9610),
-    (1, 2, '2017-09-01', 0.9626),
-    (1, 2, '2017-09-04', NULL),
-    (1, 2, '2017-09-05', 0.9577),
-    (1, 2, '2017-09-06', 0.9537),
-    (1, 2, '2017-09-07', 0.9498),
-    (1, 2, '2017-09-08', 0.9456),
-    (1, 2, '2017-09-11', 0.9533),
-    (1, 2, '2017-09-12', 0.9596),
-    (1, 2, '2017-09-13', 0.9639),
-    (1, 2, '2017-09-14', 0.9660),
-    (1, 2, '2017-09-15', 0.9594),
-    (1, 2, '2017-09-18', 0.9630),
-    (1, 2, '2017-09-19', 0.9628),
-    (1, 2, '2017-09-20', 0.9617),
-    (1, 2, '2017-09-21', 0.9692),
-    (1, 2, '2017-09-22', 0.9694),
-    (1, 2, '2017-09-25', 0.9682),
-    (1, 2, '2017-09-26', 0.9706),
-    (1, 2, '2017-09-27', 0.9745),
-    (1, 2, '2017-09-28', 0.9710),
-    (1, 2, '2017-09-29', 0.9688),
-    (1, 2, '2017-10-02', 0.9737),
-    (1, 2, '2017-10-03', 0.9734),
-    (1, 2, '2017-10-04', 0.9756),
-    (1, 2, '2017-10-05', 0.9792),
-    (1, 2, '2017-10-06', 0.9777),
-    (1, 2, '2017-10-09', NULL),
-    (1, 2, '2017-10-10', 0.9744),
-    (1, 2, '2017-10-11', 0.9732),
-    (1, 2, '2017-10-12', 0.9760),
-    (1, 2, '2017-10-13', 0.9745),
-    (1, 2, '2017-10-16', 0.9735),
-    (1, 2, '2017-10-17', 0.9804),
-    (1, 2, '2017-10-18', 0.9817),
-    (1, 2, '2017-10-19', 0.9751),
-    (1, 2, '2017-10-20', 0.9844),
-    (1, 2, '2017-10-23', 0.9862),
-    (1, 2, '2017-10-24', 0.9890),
-    (1, 2, '2017-10-25', 0.9888),
-    (1, 2, '2017-10-26', 0.9950),
-    (1, 2, '2017-10-27', 0.9990),
-    (1, 2, '2017-10-30', 0.9969),
-    (1, 2, '2017-10-31', 0.9968),
-    (1, 2, '2017-11-01', 1.0014),
-    (1, 2, '2017-11-02', 0.9977),
-    (1, 2, '2017-11-03', 1.0002),
-    (1, 2, '2017-11-06', 0.9990),
-    (1, 2, '2017-11-07', 0.9996),
-    (1, 2, '2017-11-08', 0.9997),
-    (1, 2, '2017-11-09', 0.9958),
-    (1, 2, '2017-11-10', NULL),
-    (1, 2, '2017-11-13', 0.9975),
-    (1, 2, '2017-11-14', 0.9914),
-    (1, 2, '2017-11-15', 0.9902),
-    (1, 2, '2017-11-16', 0.9926),
-    (1, 2, '2017-11-17', 0.9882),
-    (1, 2, '2017-11-20', 0.9923),
-    (1, 2, '2017-11-21', 0.9916),
-    (1, 2, '2017-11-22', 0.9838),
-    (1, 2, '2017-11-23', NULL),
-    (1, 2, '2017-11-24', 0.9788),
-    (1, 2, '2017-11-27', 0.9804),
-    (1, 2, '2017-11-28', 0.9831),
-    (1, 2, '2017-11-29', 0.9837),
-    (1, 2, '2017-11-30', 0.9838),
-    (1, 2, '2017-12-01', 0.9762),
-    (1, 2, '2017-12-04', 0.9858),
-    (1, 2, '2017-12-05', 0.9868),
-    (1, 2, '2017-12-06', 0.9904),
-    (1, 2, '2017-12-07', 0.9921),
-    (1, 2, '2017-12-08', 0.9928),
-    (1, 2, '2017-12-11', 0.9900),
-    (1, 2, '2017-12-12', 0.9926),
-    (1, 2, '2017-12-13', 0.9894),
-    (1, 2, '2017-12-14', 0.9900),
-    (1, 2, '2017-12-15', 0.9903),
-    (1, 2, '2017-12-18', 0.9856),
-    (1, 2, '2017-12-19', 0.9857),
-    (1, 2, '2017-12-20', 0.9859),
-    (1, 2, '2017-12-21', 0.9890),
-    (1, 2, '2017-12-22', 0.9898),
-    (1, 2, '2017-12-25', NULL),
-    (1, 2, '2017-12-26', 0.9891),
-    (1, 2, '2017-12-27', 0.9870),
-    (1, 2, '2017-12-28', 0.9774),
-    (1, 2, '2017-12-29', 0.9738),
-    (1, 2, '2018-01-01', NULL),
-    (1, 2, '2018-01-02', 0.9718),
-    (1, 2, '2018-01-03', 0.9762),
-    (1, 2, '2018-01-04', 0.9753),
-    (1, 2, '2018-01-05', 0.9752),
-    (1, 2, '2018-01-08', 0.9762),
-    (1, 2, '2018-01-09', 0.9832),
-    (1, 2, '2018-01-10', 0.9780),
-    (1, 2, '2018-01-11', 0.9748),
-    (1, 2, '2018-01-12', 0.9713),
-    (1, 2, '2018-01-15', NULL),
-    (1, 2, '2018-01-16', 0.9618),
-    (1, 2, '2018-01-17', 0.9616),
-    (1, 2, '2018-01-18', 0.9586),
-    (1, 2, '2018-01-19', 0.9612),
-    (1, 2, '2018-01-22', 0.9636),
-    (1, 2, '2018-01-23', 0.9598),
-    (1, 2, '2018-01-24', 0.9452),
-    (1, 2, '2018-01-25', 0.9357),
-    (1, 2, '2018-01-26', 0.9342),
-    (1, 2, '2018-01-29', 0.9380),
-    (1, 2, '2018-01-30', 0.9355),
-    (1, 2, '2018-01-31', 0.9321),
-    (1, 2, '2018-02-01', 0.9288),
-    (1, 2, '2018-02-02', 0.9320),
-    (1, 2, '2018-02-05', 0.9366),
-    (1, 2, '2018-02-06', 0.9368),
-    (1, 2, '2018-02-07', 0.9438),
-    (1, 2, '2018-02-08', 0.9386),
-    (1, 2, '2018-02-09', 0.9393),
-    (1, 2, '2018-02-12', 0.9392),
-    (1, 2, '2018-02-13', 0.9335),
-    (1, 2, '2018-02-14', 0.9319),
-    (1, 2, '2018-02-15', 0.9232),
-    (1, 2, '2018-02-16', 0.9258),
-    (1, 2, '2018-02-19', NULL),
-    (1, 2, '2018-02-20', 0.9354),
-    (1, 2, '2018-02-21', 0.9375),
-    (1, 2, '2018-02-22', 0.9345),
-    (1, 2, '2018-02-23', 0.9356),
-    (1, 2, '2018-02-26', 0.9388),
-    (1, 2, '2018-02-27', 0.9398),
-    (1, 2, '2018-02-28', 0.943),
-    (1, 2, '2018-03-01', 0.9461),
-    (1, 2, '2018-03-02', 0.9378),
-    (1, 2, '2018-03-05', 0.9397),
-    (1, 2, '2018-03-06', 0.9385),
-    (1, 2, '2018-03-07', 0.9426),
-    (1, 2, '2018-03-08', 0.9499),
-    (1, 2, '2018-03-09', 0.9493),
-    (1, 2, '2018-03-12', 0.9489),
-    (1, 2, '2018-03-13', 0.9437),
-    (1, 2, '2018-03-14', 0.9461),
-    (1, 2, '2018-03-15', 0.9502),
-    (1, 2, '2018-03-16', 0.9532),
-    (1, 2, '2018-03-19', 0.9509),
-    (1, 2, '2018-03-20', 0.9549),
-    (1, 2, '2018-03-21', 0.954),
-    (1, 2, '2018-03-22', 0.9491),
-    (1, 2, '2018-03-23', 0.9465),
-    (1, 2, '2018-03-26', 0.9442),
-    (1, 2, '2018-03-27', 0.9474),
-    (1, 2, '2018-03-28', 0.9538),
-    (1, 2, '2018-03-29', 0.956),
-    (1, 2, '2018-03-30', 0.9532),
-    (1, 2, '2018-04-02', 0.9558),
-    (1, 2, '2018-04-03', 0.959),
-    (1, 2, '2018-04-04', 0.9595),
-    (1, 2, '2018-04-05', 0.9633),
-    (1, 2, '2018-04-06', 0.9602),
-    (1, 2, '2018-04-09', 0.9574),
-    (1, 2, '2018-04-10', 0.9574),
-    (1, 2, '2018-04-11', 0.9578),
-    (1, 2, '2018-04-12', 0.9625),
-    (1, 2, '2018-04-13', 0.9631),
-    (1, 2, '2018-04-16', 0.9596),
-    (1, 2, '2018-04-17', 0.9666),
-    (1, 2, '2018-04-18', 0.967),
-    (1, 2, '2018-04-19', 0.9714),
-    (1, 2, '2018-04-20', 0.9744),
-    (1, 2, '2018-04-23', 0.9776),
-    (1, 2, '2018-04-24', 0.9804),
-    (1, 2, '2018-04-25', 0.9828),
-    (1, 2, '2018-04-26', 0.988),
-    (1, 2, '2018-04-27', 0.9884),
-    (1, 2, '2018-04-30', 0.9911),
-    (1, 2, '2018-05-01', 0.9954),
-    (1, 2, '2018-05-02', 0.9975),
-    (1, 2, '2018-05-03', 0.9978),
-    (1, 2, '2018-05-04', 1.0005),
-    (1, 2, '2018-05-07', 1.0023),
-    (1, 2, '2018-05-08', 1.0016),
-    (1, 2, '2018-05-09', 1.0042),
-    (1, 2, '2018-05-10', 1.0036),
-    (1, 2, '2018-05-11', 0.9996),
-    (1, 2, '2018-05-14', 0.9976),
-    (1, 2, '2018-05-15', 0.9998),
-    (1, 2, '2018-05-16', 1.0004),
-    (1, 2, '2018-05-17', 1.0014),
-    (1, 2, '2018-05-18', 0.997),
-    (1, 2, '2018-05-21', 0.9978),
-    (1, 2, '2018-05-22', 0.9924),
-    (1, 2, '2018-05-23', 0.9952),
-    (1, 2, '2018-05-24', 0.9916),
-    (1, 2, '2018-05-25', 0.9912),
-    (1, 2, '2018-05-28', NULL),
-    (1, 2, '2018-05-29', 0.9924),
-    (1, 2, '2018-05-30', 0.989),
-    (1, 2, '2018-05-31', 0.9844),
-    (1, 2, '2018-06-01', 0.9867),
-    (1, 2, '2018-06-04', 0.9884),
-    (1, 2, '2018-06-05', 0.986),
-    (1, 2, '2018-06-06', 0.9844),
-    (1, 2, '2018-06-07', 0.9822),
-    (1, 2, '2018-06-08', 0.986),
-    (1, 2, '2018-06-11', 0.9848),
-    (1, 2, '2018-06-12', 0.9841),
-    (1, 2, '2018-06-13', 0.9844),
-    (1, 2, '2018-06-14', 0.9942),
-    (1, 2, '2018-06-15', 0.9962),
-    (1, 2, '2018-06-18', 0.9952),
-    (1, 2, '2018-06-19', 0.9951),
-    (1, 2, '2018-06-20', 0.9956),
-    (1, 2, '2018-06-21', 0.9921),
-    (1, 2, '2018-06-22', 0.9902),
-    (1, 2, '2018-06-25', 0.9874),
-    (1, 2, '2018-06-26', 0.9901),
-    (1, 2, '2018-06-27', 0.9962),
-    (1, 2, '2018-06-28', 0.9979),
-    (1, 2, '2018-06-29', 0.9922),
-    (1, 2, '2018-07-02', 0.9946),
-    (1, 2, '2018-07-03', 0.9922),
-    (1, 2, '2018-07-04', NULL),
-    (1, 2, '2018-07-05', 0.9924),
-    (1, 2, '2018-07-06', 0.9901),
-    (1, 2, '2018-07-09', 0.992),
-    (1, 2, '2018-07-10', 0.9926),
-    (1, 2, '2018-07-11', 0.9932),
-    (1, 2, '2018-07-12', 1.0009),
-    (1, 2, '2018-07-13', 1.0028),
-    (1, 2, '2018-07-16', 0.9974),
-    (1, 2, '2018-07-17', 0.9992),
-    (1, 2, '2018-07-18', 0.9994),
-    (1, 2, '2018-07-19', 1.0016),
-    (1, 2, '2018-07-20', 0.9944),
-    (1, 2, '2018-07-23', 0.9927),
-    (1, 2, '2018-07-24', 0.9944),
-    (1, 2, '2018-07-25', 0.9942),
-    (1, 2, '2018-07-26', 0.9938),
-    (1, 2, '2018-07-27', 0.994),
-    (1, 2, '2018-07-30', 0.9885),
-    (1, 2, '2018-07-31', 0.9895),
-    (1, 2, '2018-08-01', 0.9923),
-    (1, 2, '2018-08-02', 0.9943),
-    (1, 2, '2018-08-03', 0.9926),
-    (1, 2, '2018-08-06', 0.9963),
-    (1, 2, '2018-08-07', 0.9944),
-    (1, 2, '2018-08-08', 0.9943),
-    (1, 2, '2018-08-09', 0.9906),
-    (1, 2, '2018-08-10', 0.9954),
-    (1, 2, '2018-08-13', 0.9934),
-    (1, 2, '2018-08-14', 0.993),
-    (1, 2, '2018-08-15', 0.9942),
-    (1, 2, '2018-08-16', 0.9962),
-    (1, 2, '2018-08-17', 0.9948),
-    (1, 2, '2018-08-20', 0.9943),
-    (1, 2, '2018-08-21', 0.9876),
-    (1, 2, '2018-08-22', 0.9836),
-    (1, 2, '2018-08-23', 0.9848),
-    (1, 2, '2018-08-24', 0.9824),
-    (1, 2, '2018-08-27', 0.9802),
-    (1, 2, '2018-08-28', 0.9752),
-    (1, 2, '2018-08-29', 0.9732),
-    (1, 2, '2018-08-30', 0.9709),
-    (1, 2, '2018-08-31', 0.9698),
-    (1, 2, '2018-09-03', NULL),
-    (1, 2, '2018-09-04', 0.9753),
-    (1, 2, '2018-09-05', 0.9716),
-    (1, 2, '2018-09-06', 0.9682),
-    (1, 2, '2018-09-07', 0.9684),
-    (1, 2, '2018-09-10', 0.974),
-    (1, 2, '2018-09-11', 0.9737),
-    (1, 2, '2018-09-12', 0.9704),
-    (1, 2, '2018-09-13', 0.968),
-    (1, 2, '2018-09-14', 0.9665),
-    (1, 2, '2018-09-17', 0.962),
-    (1, 2, '2018-09-18', 0.962),
-    (1, 2, '2018-09-19', 0.967),
-    (1, 2, '2018-09-20', 0.9624),
-    (1, 2, '2018-09-21', 0.9593),
-    (1, 2, '2018-09-24', 0.962),
-    (1, 2, '2018-09-25', 0.9646),
-    (1, 2, '2018-09-26', 0.9681),
-    (1, 2, '2018-09-27', 0.9776),
-    (1, 2, '2018-09-28', 0.9758),
-    (1, 2, '2018-10-01', 0.984),
-    (1, 2, '2018-10-02', 0.9844),
-    (1, 2, '2018-10-03', 0.9894),
-    (1, 2, '2018-10-04', 0.9925),
-    (1, 2, '2018-10-05', 0.9922),
-    (1, 2, '2018-10-08', NULL),
-    (1, 2, '2018-10-09', 0.9928),
-    (1, 2, '2018-10-10', 0.9908),
-    (1, 2, '2018-10-11', 0.992),
-    (1, 2, '2018-10-12', 0.9916),
-    (1, 2, '2018-10-15', 0.9868),
-    (1, 2, '2018-10-16', 0.9894),
-    (1, 2, '2018-10-17', 0.9926),
-    (1, 2, '2018-10-18', 0.994),
-    (1, 2, '2018-10-19', 0.9968),
-    (1, 2, '2018-10-22', 0.9963),
-    (1, 2, '2018-10-23', 0.9943),
-    (1, 2, '2018-10-24', 0.9982),
-    (1, 2, '2018-10-25', 1.0009),
-    (1, 2, '2018-10-26', 0.998),
-    (1, 2, '2018-10-29', 1.0006),
-    (1, 2, '2018-10-30', 1.0046),
-    (1, 2, '2018-10-31', 1.0057),
-    (1, 2, '2018-11-01', 1.0033),
-    (1, 2, '2018-11-02', 1.0036),
-    (1, 2, '2018-11-05', 1.0055),
-    (1, 2, '2018-11-06', 1.0042),
-    (1, 2, '2018-11-07', 0.9998),
-    (1, 2, '2018-11-08', 1.0033),
-    (1, 2, '2018-11-09', 1.0062),
-    (1, 2, '2018-11-12', NULL),
-    (1, 2, '2018-11-13', 1.0083),
-    (1, 2, '2018-11-14', 1.0067),
-    (1, 2, '2018-11-15', 1.0058),
-    (1, 2, '2018-11-16', 1.0012),
-    (1, 2, '2018-11-19', 0.9938),
-    (1, 2, '2018-11-20', 0.9944),
-    (1, 2, '2018-11-21', 0.9952),
-    (1, 2, '2018-11-22', NULL),
-    (1, 2, '2018-11-23', 0.9976),
-    (1, 2, '2018-11-26', 0.9984),
-    (1, 2, '2018-11-27', 0.9998),
-    (1, 2, '2018-11-28', 0.9994),
-    (1, 2, '2018-11-29', 0.9966),
-    (1, 2, '2018-11-30', 0.9987),
-    (1, 2, '2018-12-03', 0.9975),
-    (1, 2, '2018-12-04', 0.9975),
-    (1, 2, '2018-12-05', NULL),
-    (1, 2, '2018-12-06', 0.9926),
-    (1, 2, '2018-12-07', 0.9914),
-    (1, 2, '2018-12-10', 0.9896),
-    (1, 2, '2018-12-11', 0.9931),
-    (1, 2, '2018-12-12', 0.992),
-    (1, 2, '2018-12-13', 0.9938),
-    (1, 2, '2018-12-14', 0.997),
-    (1, 2, '2018-12-17', 0.9934),
-    (1, 2, '2018-12-18', 0.993),
-    (1, 2, '2018-12-19', 0.9918),
-    (1, 2, '2018-12-20', 0.9918),
-    (1, 2, '2018-12-21', 0.9914),
-    (1, 2, '2018-12-24', NULL),
-    (1, 2, '2018-12-25', NULL),
-    (1, 2, '2018-12-26', 0.9908),
-    (1, 2, '2018-12-27', 0.9902),
-    (1, 2, '2018-12-28', 0.9838),
-    (1, 2, '2018-12-31', 0.9832),
-    (1, 2, '2019-01-01', NULL),
-    (1, 2, '2019-01-02', 0.9884),
-    (1, 2, '2019-01-03', 0.9889),
-    (1, 2, '2019-01-04', 0.9859),
-    (1, 2, '2019-01-07', 0.981),
-    (1, 2, '2019-01-08', 0.981),
-    (1, 2, '2019-01-09', 0.9767),
-    (1, 2, '2019-01-10', 0.9817),
-    (1, 2, '2019-01-11', 0.9829),
-    (1, 2, '2019-01-14', NULL),
-    (1, 2, '2019-01-15', 0.9885),
-    (1, 2, '2019-01-16', 0.9899),
-    (1, 2, '2019-01-17', 0.9944),
-    (1, 2, '2019-01-18', 0.9954),
-    (1, 2, '2019-01-21', NULL),
-    (1, 2, '2019-01-22', 0.9972),
-    (1, 2, '2019-01-23', 0.9946),
-    (1, 2, '2019-01-24', 0.9965),
-    (1, 2, '2019-01-25', 0.993),
-    (1, 2, '2019-01-28', 0.991),
-    (1, 2, '2019-01-29', 0.995),
-    (1, 2, '2019-01-30', 0.9988),
-    (1, 2, '2019-01-31', 0.9938),
-    (1, 2, '2019-02-01', 0.994),
-    (1, 2, '2019-02-04', 0.9974),
-    (1, 2, '2019-02-05', 1.0005),
-    (1, 2, '2019-02-06', 1.0009),
-    (1, 2, '2019-02-07', 1.001),
-    (1, 2, '2019-02-08', 1.0),
-    (1, 2, '2019-02-11', 1.004),
-    (1, 2, '2019-02-12', 1.0068),
-    (1, 2, '2019-02-13', 1.0073),
-    (1, 2, '2019-02-14', 1.0064),
-    (1, 2, '2019-02-15', 1.0063),
-    (1, 2, '2019-02-18', NULL),
-    (1, 2, '2019-02-19', 1.0018),
-    (1, 2, '2019-02-20', NULL),
-    (1, 2, '2019-02-21', 1.001),
-    (1, 2, '2019-02-22', 0.9998),
-    (1, 2, '2019-02-25', 1.0006),
-    (1, 2, '2019-02-26', 1.0002),
-    (1, 2, '2019-02-27', 1.0003),
-    (1, 2, '2019-02-28', 0.9974),
-    (1, 2, '2019-03-01', 0.9987),
-    (1, 2, '2019-03-04', 0.9994),
-    (1, 2, '2019-03-05', 1.0045),
-    (1, 2, '2019-03-06', 1.0038),
-    (1, 2, '2019-03-07', 1.0106),
-    (1, 2, '2019-03-08', 1.0074),
-    (1, 2, '2019-03-11', 1.011),
-    (1, 2, '2019-03-12', 1.0078),
-    (1, 2, '2019-03-13', 1.0046),
-    (1, 2, '2019-03-14', 1.0044),
-    (1, 2, '2019-03-15', 1.0026),
-    (1, 2, '2019-03-18', 1.0004),
-    (1, 2, '2019-03-19', 1.0001),
-    (1, 2, '2019-03-20', 0.9974),
-    (1, 2, '2019-03-21', 0.9924),
-    (1, 2, '2019-03-22', 0.994),
-    (1, 2, '2019-03-25', 0.9918),
-    (1, 2, '2019-03-26', 0.994),
-    (1, 2, '2019-03-27', 0.9946),
-    (1, 2, '2019-03-28', 0.9952),
-    (1, 2, '2019-03-29', 0.9962),
-    (1, 2, '2019-04-01', 0.9988),
-    (1, 2, '2019-04-02', 0.9986),
-    (1, 2, '2019-04-03', 0.997),
-    (1, 2, '2019-04-04', 1.0002),
-    (1, 2, '2019-04-05', 1.0003),
-    (1, 2, '2019-04-08', 0.9994),
-    (1, 2, '2019-04-09', 0.9999),
-    (1, 2, '2019-04-10', 1.0019),
-    (1, 2, '2019-04-11', 1.0027),
-    (1, 2, '2019-04-12', 1.0017),
-    (1, 2, '2019-04-15', 1.0039),
-    (1, 2, '2019-04-16', 1.0066),
-    (1, 2, '2019-04-17', 1.0099),
-    (1, 2, '2019-04-18', 1.0154),
-    (1, 2, '2019-04-19', 1.0144),
-    (1, 2, '2019-04-22', 1.0155),
-    (1, 2, '2019-04-23', 1.0218),
-    (1, 2, '2019-04-24', 1.0184),
-    (1, 2, '2019-04-25', 1.0199),
-    (1, 2, '2019-04-26', 1.019),
-    (1, 2, '2019-04-29', 1.0201),
-    (1, 2, '2019-04-30', 1.02),
-    (1, 2, '2019-05-01', 1.0137),
-    (1, 2, '2019-05-02', 1.018),
-    (1, 2, '2019-05-03', 1.0178),
-    (1, 2, '2019-05-06', 1.0179),
-    (1, 2, '2019-05-07', 1.0215),
-    (1, 2, '2019-05-08', 1.0181),
-    (1, 2, '2019-05-09', 1.0133),
-    (1, 2, '2019-05-10', 1.0109),
-    (1, 2, '2019-05-13', 1.0064),
-    (1, 2, '2019-05-14', 1.0088),
-    (1, 2, '2019-05-15', 1.009),
-    (1, 2, '2019-05-16', 1.0101),
-    (1, 2, '2019-05-17', 1.0108),
-    (1, 2, '2019-05-20', 1.0082),
-    (1, 2, '2019-05-21', 1.0104),
-    (1, 2, '2019-05-22', 1.009),
-    (1, 2, '2019-05-23', 1.004),
-    (1, 2, '2019-05-24', 1.0021),
-    (1, 2, '2019-05-27', NULL),
-    (1, 2, '2019-05-28', 1.0086),
-    (1, 2, '2019-05-29', 1.0066),
-    (1, 2, '2019-05-30', 1.0074),
-    (1, 2, '2019-05-31', 1.0034),
-    (1, 2, '2019-06-03', 0.9994),
-    (1, 2, '2019-06-04', 0.994),
-    (1, 2, '2019-06-05', 0.9919),
-    (1, 2, '2019-06-06', 0.9886),
-    (1, 2, '2019-06-07', 0.9877),
-    (1, 2, '2019-06-10', 0.9898),
-    (1, 2, '2019-06-11', 0.9925),
-    (1, 2, '2019-06-12', 0.9944),
-    (1, 2, '2019-06-13', 0.9946),
-    (1, 2, '2019-06-14', 0.999),
-    (1, 2, '2019-06-17', 0.9982),
-    (1, 2, '2019-06-18', 0.9994),
-    (1, 2, '2019-06-19', 0.996),
-    (1, 2, '2019-06-20', 0.9821),
-    (1, 2, '2019-06-21', 0.9789),
-    (1, 2, '2019-06-24', 0.972),
-    (1, 2, '2019-06-25', 0.9726),
-    (1, 2, '2019-06-26', 0.976),
-    (1, 2, '2019-06-27', 0.9766),
-    (1, 2, '2019-06-28', 0.9758),
-    (1, 2, '2019-07-01', 0.9864),
-    (1, 2, '2019-07-02', 0.986),
-    (1, 2, '2019-07-03', 0.9864),
-    (1, 2, '2019-07-04', NULL),
-    (1, 2, '2019-07-05', 0.9923),
-    (1, 2, '2019-07-08', 0.9938),
-    (1, 2, '2019-07-09', 0.9928),
-    (1, 2, '2019-07-10', 0.9892),
-    (1, 2, '2019-07-11', 0.9886),
-    (1, 2, '2019-07-12', 0.9845),
-    (1, 2, '2019-07-15', 0.9847),
-    (1, 2, '2019-07-16', 0.989),
-    (1, 2, '2019-07-17', 0.987),
-    (1, 2, '2019-07-18', 0.9862),
-    (1, 2, '2019-07-19', 0.9823),
-    (1, 2, '2019-07-22', 0.9815),
-    (1, 2, '2019-07-23', 0.9848),
-    (1, 2, '2019-07-24', 0.9854),
-    (1, 2, '2019-07-25', 0.9899),
-    (1, 2, '2019-07-26', 0.9933),
-    (1, 2, '2019-07-29', 0.9916),
-    (1, 2, '2019-07-30', 0.9908),
-    (1, 2, '2019-07-31', 0.9902),
-    (1, 2, '2019-08-01', 0.9938),
-    (1, 2, '2019-08-02', 0.982),
-    (1, 2, '2019-08-05', 0.9721),
-    (1, 2, '2019-08-06', 0.9782),
-    (1, 2, '2019-08-07', 0.9708),
-    (1, 2, '2019-08-08', 0.9751),
-    (1, 2, '2019-08-09', 0.971),
-    (1, 2, '2019-08-12', 0.9696),
-    (1, 2, '2019-08-13', 0.9744),
-    (1, 2, '2019-08-14', 0.9736),
-    (1, 2, '2019-08-15', 0.9762),
-    (1, 2, '2019-08-16', 0.98),
-    (1, 2, '2019-08-19', 0.9808),
-    (1, 2, '2019-08-20', 0.9792),
-    (1, 2, '2019-08-21', 0.9816),
-    (1, 2, '2019-08-22', 0.9834),
-    (1, 2, '2019-08-23', 0.9748),
-    (1, 2, '2019-08-26', 0.9782),
-    (1, 2, '2019-08-27', 0.9815),
-    (1, 2, '2019-08-28', 0.9807),
-    (1, 2, '2019-08-29', 0.9853),
-    (1, 2, '2019-08-30', 0.9899),
-    (1, 2, '2019-09-02', NULL),
-    (1, 2, '2019-09-03', 0.9872),
-    (1, 2, '2019-09-04', 0.9817),
-    (1, 2, '2019-09-05', 0.9868),
-    (1, 2, '2019-09-06', 0.9866),
-    (1, 2, '2019-09-09', 0.991),
-    (1, 2, '2019-09-10', 0.9908),
-    (1, 2, '2019-09-11', 0.9933),
-    (1, 2, '2019-09-12', 0.9891),
-    (1, 2, '2019-09-13', 0.9898),
-    (1, 2, '2019-09-16', 0.9919),
-    (1, 2, '2019-09-17', 0.9925),
-    (1, 2, '2019-09-18', 0.995),
-    (1, 2, '2019-09-19', 0.9927),
-    (1, 2, '2019-09-20', 0.993),
-    (1, 2, '2019-09-23', 0.9894),
-    (1, 2, '2019-09-24', 0.9872),
-    (1, 2, '2019-09-25', 0.9909),
-    (1, 2, '2019-09-26', 0.9928),
-    (1, 2, '2019-09-27', 0.9919),
-    (1, 2, '2019-09-30', 0.9978),
-    (1, 2, '2019-10-01', 0.9936),
-    (1, 2, '2019-10-02', 0.9975),
-    (1, 2, '2019-10-03', 0.9974),
-    (1, 2, '2019-10-04', 0.9958),
-    (1, 2, '2019-10-07', 0.9947),
-    (1, 2, '2019-10-08', 0.994),
-    (1, 2, '2019-10-09', 0.995),
-    (1, 2, '2019-10-10', 0.9954),
-    (1, 2, '2019-10-11', 0.9967),
-    (1, 2, '2019-10-14', NULL),
-    (1, 2, '2019-10-15', 0.9982),
-    (1, 2, '2019-10-16', 0.9959),
-    (1, 2, '2019-10-17', 0.987),
-    (1, 2, '2019-10-18', 0.9856),
-    (1, 2, '2019-10-21', 0.9865),
-    (1, 2, '2019-10-22', 0.9889),
-    (1, 2, '2019-10-23', 0.9907),
-    (1, 2, '2019-10-24', 0.9923),
-    (1, 2, '2019-10-25', 0.9945),
-    (1, 2, '2019-10-28', 0.996),
-    (1, 2, '2019-10-29', 0.9936),
-    (1, 2, '2019-10-30', 0.9909),
-    (1, 2, '2019-10-31', 0.9868),
-    (1, 2, '2019-11-01', 0.9855),
-    (1, 2, '2019-11-04', 0.9871),
-    (1, 2, '2019-11-05', 0.9932),
-    (1, 2, '2019-11-06', 0.992),
-    (1, 2, '2019-11-07', 0.9954),
-    (1, 2, '2019-11-08', 0.9971),
-    (1, 2, '2019-11-11', NULL),
-    (1, 2, '2019-11-12', 0.9929),
-    (1, 2, '2019-11-13', 0.9892),
-    (1, 2, '2019-11-14', 0.9878),
-    (1, 2, '2019-11-15', 0.9903),
-    (1, 2, '2019-11-18', 0.9879),
-    (1, 2, '2019-11-19', 0.9912),
-    (1, 2, '2019-11-20', 0.9921),
-    (1, 2, '2019-11-21', 0.992),
-    (1, 2, '2019-11-22', 0.9967),
-    (1, 2, '2019-11-25', 0.9964),
-    (1, 2, '2019-11-26', 0.9978),
-    (1, 2, '2019-11-27', 0.9996),
-    (1, 2, '2019-11-28', NULL),
-    (1, 2, '2019-11-29', 1.0001),
-    (1, 2, '2019-12-02', 0.992),
-    (1, 2, '2019-12-03', 0.9862),
-    (1, 2, '2019-12-04', 0.9893),
-    (1, 2, '2019-12-05', 0.9872),
-    (1, 2, '2019-12-06', 0.9909),
-    (1, 2, '2019-12-09', 0.9879),
-    (1, 2, '2019-12-10', 0.9852),
-    (1, 2, '2019-12-11', 0.9856),
-    (1, 2, '2019-12-12', 0.986),
-    (1, 2, '2019-12-13', 0.9838),
-    (1, 2, '2019-12-16', 0.9839),
-    (1, 2, '2019-12-17', 0.9811),
-    (1, 2, '2019-12-18', 0.9819),
-    (1, 2, '2019-12-19', 0.9777),
-    (1, 2, '2019-12-20', 0.9824),
-    (1, 2, '2019-12-23', 0.982),
-    (1, 2, '2019-12-24', 0.9807),
-    (1, 2, '2019-12-25', NULL),
-    (1, 2, '2019-12-26', 0.9808),
-    (1, 2, '2019-12-27', 0.9741),
-    (1, 2, '2019-12-30', 0.9677),
-    (1, 2, '2019-12-31', 0.9677),
-    (1, 2, '2020-01-01', NULL),
-    (1, 2, '2020-01-02', 0.9721),
-    (1, 2, '2020-01-03', 0.9703),
-    (1, 2, '2020-01-06', 0.9688),
-    (1, 2, '2020-01-07', 0.9716),
-    (1, 2, '2020-01-08', 0.9725),
-    (1, 2, '2020-01-09', 0.9724),
-    (1, 2, '2020-01-10', 0.9729),
-    (1, 2, '2020-01-13', 0.9705),
-    (1, 2, '2020-01-14', 0.9677),
-    (1, 2, '2020-01-15', 0.9634),
-    (1, 2, '2020-01-16', 0.965),
-    (1, 2, '2020-01-17', 0.9683),
-    (1, 2, '2020-01-20', NULL),
-    (1, 2, '2020-01-21', 0.9681),
-    (1, 2, '2020-01-22', 0.9696),
-    (1, 2, '2020-01-23', 0.9698),
-    (1, 2, '2020-01-24', 0.9712),
-    (1, 2, '2020-01-27', 0.9695),
-    (1, 2, '2020-01-28', 0.9738),
-    (1, 2, '2020-01-29', 0.9747),
-    (1, 2, '2020-01-30', 0.9692),
-    (1, 2, '2020-01-31', 0.9645),
-    (1, 2, '2020-02-03', 0.9655),
-    (1, 2, '2020-02-04', 0.9693),
-    (1, 2, '2020-02-05', 0.9729),
-    (1, 2, '2020-02-06', 0.975),
-    (1, 2, '2020-02-07', 0.9777),
-    (1, 2, '2020-02-10', 0.9778),
-    (1, 2, '2020-02-11', 0.9761),
-    (1, 2, '2020-02-12', 0.9767),
-    (1, 2, '2020-02-13', 0.9788),
-    (1, 2, '2020-02-14', 0.9818),
-    (1, 2, '2020-02-17', NULL),
-    (1, 2, '2020-02-18', 0.9814),
-    (1, 2, '2020-02-19', 0.9839),
-    (1, 2, '2020-02-20', 0.9831),
-    (1, 2, '2020-02-21', 0.9781),
-    (1, 2, '2020-02-24', 0.9776),
-    (1, 2, '2020-02-25', 0.9765),
-    (1, 2, '2020-02-26', 0.9759),
-    (1, 2, '2020-02-27', 0.9718),
-    (1, 2, '2020-02-28', 0.9671),
-    (1, 2, '2020-03-02', 0.9577),
-    (1, 2, '2020-03-03', 0.9563),
-    (1, 2, '2020-03-04', 0.9554),
-    (1, 2, '2020-03-05', 0.9487),
-    (1, 2, '2020-03-06', 0.9365),
-    (1, 2, '2020-03-09', 0.9296),
-    (1, 2, '2020-03-10', 0.933),
-    (1, 2, '2020-03-11', 0.9381),
-    (1, 2, '2020-03-12', 0.954),
-    (1, 2, '2020-03-13', 0.9541),
-    (1, 2, '2020-03-16', 0.9467),
-    (1, 2, '2020-03-17', 0.9647),
-    (1, 2, '2020-03-18', 0.9727),
-    (1, 2, '2020-03-19', 0.9847),
-    (1, 2, '2020-03-20', 0.9866),
-    (1, 2, '2020-03-23', 0.9824),
-    (1, 2, '2020-03-24', 0.9813),
-    (1, 2, '2020-03-25', 0.9781),
-    (1, 2, '2020-03-26', 0.9645),
-    (1, 2, '2020-03-27', 0.9558),
-    (1, 2, '2020-03-30', 0.9606),
-    (1, 2, '2020-03-31', 0.9627),
-    (1, 2, '2020-04-01', 0.9675),
-    (1, 2, '2020-04-02', 0.9727),
-    (1, 2, '2020-04-03', 0.9777),
-    (1, 2, '2020-04-06', 0.9768),
-    (1, 2, '2020-04-07', 0.9707),
-    (1, 2, '2020-04-08', 0.971),
-    (1, 2, '2020-04-09', 0.966),
-    (1, 2, '2020-04-10', 0.9648),
-    (1, 2, '2020-04-13', 0.9674),
-    (1, 2, '2020-04-14', 0.9606),
-    (1, 2, '2020-04-15', 0.9647),
-    (1, 2, '2020-04-16', 0.9695),
-    (1, 2, '2020-04-17', 0.9662),
-    (1, 2, '2020-04-20', 0.967),
-    (1, 2, '2020-04-21', 0.9691),
-    (1, 2, '2020-04-22', 0.9711),
-    (1, 2, '2020-04-23', 0.9731),
-    (1, 2, '2020-04-24', 0.975),
-    (1, 2, '2020-04-27', 0.9756),
-    (1, 2, '2020-04-28', 0.9743),
-    (1, 2, '2020-04-29', 0.9748),
-    (1, 2, '2020-04-30', 0.9669),
-    (1, 2, '2020-05-01', 0.9599),
-    (1, 2, '2020-05-04', 0.9647),
-    (1, 2, '2020-05-05', 0.9721),
-    (1, 2, '2020-05-06', 0.9745),
-    (1, 2, '2020-05-07', 0.9746),
-    (1, 2, '2020-05-08', 0.9699),
-    (1, 2, '2020-05-11', 0.9722),
-    (1, 2, '2020-05-12', 0.968),
-    (1, 2, '2020-05-13', 0.9704),
-    (1, 2, '2020-05-14', 0.9727),
-    (1, 2, '2020-05-15', 0.9726),
-    (1, 2, '2020-05-18', 0.9699),
-    (1, 2, '2020-05-19', 0.9725),
-    (1, 2, '2020-05-20', 0.9649),
-    (1, 2, '2020-05-21', 0.9703),
-    (1, 2, '2020-05-22', 0.9722),
-    (1, 2, '2020-05-25', NULL),
-    (1, 2, '2020-05-26', 0.9668),
-    (1, 2, '2020-05-27', 0.9696),
-    (1, 2, '2020-05-28', 0.9642),
-    (1, 2, '2020-05-29', 0.9618),
-    (1, 2, '2020-06-01', 0.9613),
-    (1, 2, '2020-06-02', 0.9623),
-    (1, 2, '2020-06-03', 0.9613),
-    (1, 2, '2020-06-04', 0.9565),
-    (1, 2, '2020-06-05', 0.9622),
-    (1, 2, '2020-06-08', 0.9567),
-    (1, 2, '2020-06-09', 0.9487),
-    (1, 2, '2020-06-10', 0.945),
-    (1, 2, '2020-06-11', 0.9382),
-    (1, 2, '2020-06-12', 0.9515),
-    (1, 2, '2020-06-15', 0.9503),
-    (1, 2, '2020-06-16', 0.9494),
-    (1, 2, '2020-06-17', 0.9512),
-    (1, 2, '2020-06-18', 0.951),
-    (1, 2, '2020-06-19', 0.9519),
-    (1, 2, '2020-06-22', 0.9475),
-    (1, 2, '2020-06-23', 0.9438),
-    (1, 2, '2020-06-24', 0.9471),
-    (1, 2, '2020-06-25', 0.9485),
-    (1, 2, '2020-06-26', 0.948),
-    (1, 2, '2020-06-29', 0.9504),
-    (1, 2, '2020-06-30', 0.9467),
-    (1, 2, '2020-07-01', 0.9455),
-    (1, 2, '2020-07-02', 0.9455),
-    (1, 2, '2020-07-03', NULL),
-    (1, 2, '2020-07-06', 0.9416),
-    (1, 2, '2020-07-07', 0.941),
-    (1, 2, '2020-07-08', 0.9382),
-    (1, 2, '2020-07-09', 0.9397),
-    (1, 2, '2020-07-10', 0.9395),
-    (1, 2, '2020-07-13', 0.941),
-    (1, 2, '2020-07-14', 0.9385),
-    (1, 2, '2020-07-15', 0.9439),
-    (1, 2, '2020-07-16', 0.9432),
-    (1, 2, '2020-07-17', 0.9388),
-    (1, 2, '2020-07-20', 0.9389),
-    (1, 2, '2020-07-21', 0.934),
-    (1, 2, '2020-07-22', 0.9288),
-    (1, 2, '2020-07-23', 0.925),
-    (1, 2, '2020-07-24', 0.9222),
-    (1, 2, '2020-07-27', 0.9204),
-    (1, 2, '2020-07-28', 0.9169),
-    (1, 2, '2020-07-29', 0.9141),
-    (1, 2, '2020-07-30', 0.912),
-    (1, 2, '2020-07-31', 0.9113),
-    (1, 2, '2020-08-03', 0.9194),
-    (1, 2, '2020-08-04', 0.9154),
-    (1, 2, '2020-08-05', 0.9053),
-    (1, 2, '2020-08-06', 0.9101),
-    (1, 2, '2020-08-07', 0.914),
-    (1, 2, '2020-08-10', 0.9154),
-    (1, 2, '2020-08-11', 0.9163),
-    (1, 2, '2020-08-12', 0.9109),
-    (1, 2, '2020-08-13', 0.9097),
-    (1, 2, '2020-08-14', 0.9091),
-    (1, 2, '2020-08-17', 0.9058),
-    (1, 2, '2020-08-18', 0.9029),
-    (1, 2, '2020-08-19', 0.9114),
-    (1, 2, '2020-08-20', 0.9082),
-    (1, 2, '2020-08-21', 0.9131),
-    (1, 2, '2020-08-24', 0.9109),
-    (1, 2, '2020-08-25', 0.9089),
-    (1, 2, '2020-08-26', 0.9086),
-    (1, 2, '2020-08-27', 0.9077),
-    (1, 2, '2020-08-28', 0.9041),
-    (1, 2, '2020-08-31', 0.9012),
-    (1, 2, '2020-09-01', 0.9072),
-    (1, 2, '2020-09-02', 0.9107),
-    (1, 2, '2020-09-03', 0.9104),
-    (1, 2, '2020-09-04', 0.9147),
-    (1, 2, '2020-09-07', NULL),
-    (1, 2, '2020-09-08', 0.9166),
-    (1, 2, '2020-09-09', 0.9131),
-    (1, 2, '2020-09-10', 0.9077),
-    (1, 2, '2020-09-11', 0.9092),
-    (1, 2, '2020-09-14', 0.9071),
-    (1, 2, '2020-09-15', 0.9085),
-    (1, 2, '2020-09-16', 0.908),
-    (1, 2, '2020-09-17', 0.9098),
-    (1, 2, '2020-09-18', 0.9096),
-    (1, 2, '2020-09-21', 0.9163),
-    (1, 2, '2020-09-22', 0.92),
-    (1, 2, '2020-09-23', 0.9233),
-    (1, 2, '2020-09-24', 0.9264),
-    (1, 2, '2020-09-25', 0.9291),
-    (1, 2, '2020-09-28', 0.9247),
-    (1, 2, '2020-09-29', 0.9215),
-    (1, 2, '2020-09-30', 0.9188),
-    (1, 2, '2020-10-01', 0.9179),
-    (1, 2, '2020-10-02', 0.9204),
-    (1, 2, '2020-10-05', 0.9153),
-    (1, 2, '2020-10-06', 0.9153),
-    (1, 2, '2020-10-07', 0.9168),
-    (1, 2, '2020-10-08', 0.9176),
-    (1, 2, '2020-10-09', 0.9103),
-    (1, 2, '2020-10-12', NULL),
-    (1, 2, '2020-10-13', 0.9147),
-    (1, 2, '2020-10-14', 0.9127),
-    (1, 2, '2020-10-15', 0.9143),
-    (1, 2, '2020-10-16', 0.9153),
-    (1, 2, '2020-10-19', 0.9092),
-    (1, 2, '2020-10-20', 0.9071),
-    (1, 2, '2020-10-21', 0.9042),
-    (1, 2, '2020-10-22', 0.9074),
-    (1, 2, '2020-10-23', 0.9055),
-    (1, 2, '2020-10-26', 0.9073),
-    (1, 2, '2020-10-27', 0.9066),
-    (1, 2, '2020-10-28', 0.9097),
-    (1, 2, '2020-10-29', 0.9171),
-    (1, 2, '2020-10-30', 0.9165),
-    (1, 2, '2020-11-02', 0.92),
-    (1, 2, '2020-11-03', 0.9126),
-    (1, 2, '2020-11-04', 0.9107),
-    (1, 2, '2020-11-05', 0.9063),
-    (1, 2, '2020-11-06', 0.8986),
-    (1, 2, '2020-11-09', 0.9127),
-    (1, 2, '2020-11-10', 0.9136),
-    (1, 2, '2020-11-11', NULL),
-    (1, 2, '2020-11-12', 0.9136),
-    (1, 2, '2020-11-13', 0.9133),
-    (1, 2, '2020-11-16', 0.9121),
-    (1, 2, '2020-11-17', 0.9109),
-    (1, 2, '2020-11-18', 0.9096),
-    (1, 2, '2020-11-19', 0.9119),
-    (1, 2, '2020-11-20', 0.9118),
-    (1, 2, '2020-11-23', 0.9131),
-    (1, 2, '2020-11-24', 0.9121),
-    (1, 2, '2020-11-25', 0.909),
-    (1, 2, '2020-11-26', NULL),
-    (1, 2, '2020-11-27', NULL),
-    (1, 2, '2020-11-30', 0.906),
-    (1, 2, '2020-12-01', 0.9005),
-    (1, 2, '2020-12-02', 0.8961),
-    (1, 2, '2020-12-03', 0.8906),
-    (1, 2, '2020-12-04', 0.8908),
-    (1, 2, '2020-12-07', 0.8892),
-    (1, 2, '2020-12-08', 0.8886),
-    (1, 2, '2020-12-09', 0.8901),
-    (1, 2, '2020-12-10', 0.8878),
-    (1, 2, '2020-12-11', 0.8902),
-    (1, 2, '2020-12-14', 0.8872),
-    (1, 2, '2020-12-15', 0.8859),
-    (1, 2, '2020-12-16', 0.8861),
-    (1, 2, '2020-12-17', 0.8841),
-    (1, 2, '2020-12-18', 0.885),
-    (1, 2, '2020-12-21', 0.8859),
-    (1, 2, '2020-12-22', 0.8889),
-    (1, 2, '2020-12-23', 0.8882),
-    (1, 2, '2020-12-24', NULL),
-    (1, 2, '2020-12-25', NULL),
-    (1, 2, '2020-12-28', 0.8898),
-    (1, 2, '2020-12-29', 0.8838),
-    (1, 2, '2020-12-30', 0.8832),
-    (1, 2, '2020-12-31', 0.8841),
-    (1, 2, '2021-01-01', NULL),
-    (1, 2, '2021-01-04', 0.8811),
-    (1, 2, '2021-01-05', 0.8784),
-    (1, 2, '2021-01-06', 0.881),
-    (1, 2, '2021-01-07', 0.8841),
-    (1, 2, '2021-01-08', 0.8843),
-    (1, 2, '2021-01-11', 0.8897),
-    (1, 2, '2021-01-12', 0.8892),
-    (1, 2, '2021-01-13', 0.8874),
-    (1, 2, '2021-01-14', 0.887),
-    (1, 2, '2021-01-15', 0.8894),
-    (1, 2, '2021-01-18', NULL),
-    (1, 2, '2021-01-19', 0.8888),
-    (1, 2, '2021-01-20', NULL),
-    (1, 2, '2021-01-21', 0.887),
-    (1, 2, '2021-01-22', 0.8853),
-    (1, 2, '2021-01-25', 0.8882),
-    (1, 2, '2021-01-26', 0.888),
-    (1, 2, '2021-01-27', 0.889),
-    (1, 2, '2021-01-28', 0.8887),
-    (1, 2, '2021-01-29', 0.8905);
\ No newline at end of file
@@ -1,1316 +0,0 @@
--- CNY 3
--- USD 1
-INSERT INTO
-    exchange_rates (
-        destination_currency_id,
-        source_currency_id,
-        date,
-        rate
-    )
-VALUES
-    (1, 3, '2016-01-29', 6.5752),
-    (1, 3, '2016-02-01', 6.5778),
-    (1, 3, '2016-02-02', 6.5795),
-    (1, 3, '2016-02-03', 6.5765),
-    (1, 3, '2016-02-04', 6.5638),
-    (1, 3, '2016-02-05', 6.5710),
-    (1, 3, '2016-02-08', 6.5710),
-    (1, 3, '2016-02-09', 6.5710),
-    (1, 3, '2016-02-10', 6.5710),
-    (1, 3, '2016-02-11', 6.5710),
-    (1, 3, '2016-02-12', 6.5710),
-    (1, 3, '2016-02-15', NULL),
-    (1, 3, '2016-02-16', 6.5154),
-    (1, 3, '2016-02-17', 6.5270),
-    (1, 3, '2016-02-18', 6.5163),
-    (1, 3, '2016-02-19', 6.5202),
-    (1, 3, '2016-02-22', 6.5197),
-    (1, 3, '2016-02-23', 6.5263),
-    (1, 3, '2016-02-24', 6.5290),
-    (1, 3, '2016-02-25', 6.5320),
-    (1, 3, '2016-02-26', 6.5390),
-    (1, 3, '2016-02-29', 6.5525),
-    (1, 3, '2016-03-01', 6.5500),
-    (1, 3, '2016-03-02', 6.5499),
-    (1, 3, '2016-03-03', 6.5312),
-    (1, 3, '2016-03-04', 6.5064),
-    (1, 3, '2016-03-07', 6.5155),
-    (1, 3, '2016-03-08', 6.5044),
-    (1, 3, '2016-03-09', 6.5105),
-    (1, 3, '2016-03-10', 6.5070),
-    (1, 3, '2016-03-11', 6.4940),
-    (1, 3, '2016-03-14', 6.4984),
-    (1, 3, '2016-03-15', 6.5110),
-    (1, 3, '2016-03-16', 6.5189),
-    (1, 3, '2016-03-17', 6.4745),
-    (1, 3, '2016-03-18', 6.4682),
-    (1, 3, '2016-03-21', 6.4812),
-    (1, 3, '2016-03-22', 6.4876),
-    (1, 3, '2016-03-23', 6.5035),
-    (1, 3, '2016-03-24', 6.5124),
-    (1, 3, '2016-03-25', 6.5131),
-    (1, 3, '2016-03-28', 6.5051),
-    (1, 3, '2016-03-29', 6.5063),
-    (1, 3, '2016-03-30', 6.4648),
-    (1, 3, '2016-03-31', 6.4480),
-    (1, 3, '2016-04-01', 6.4776),
-    (1, 3, '2016-04-04', 6.4776),
-    (1, 3, '2016-04-05', 6.4760),
-    (1, 3, '2016-04-06', 6.4780),
-    (1, 3, '2016-04-07', 6.4599),
-    (1, 3, '2016-04-08', 6.4628),
-    (1, 3, '2016-04-11', 6.4580),
-    (1, 3, '2016-04-12', 6.4639),
-    (1, 3, '2016-04-13', 6.4768),
-    (1, 3, '2016-04-14', 6.4810),
-    (1, 3, '2016-04-15', 6.4730),
-    (1, 3, '2016-04-18', 6.4717),
-    (1, 3, '2016-04-19', 6.4571),
-    (1, 3, '2016-04-20', 6.4675),
-    (1, 3, '2016-04-21', 6.4810),
-    (1, 3, '2016-04-22', 6.5004),
-    (1, 3, '2016-04-25', 6.4900),
-    (1, 3, '2016-04-26', 6.4905),
-    (1, 3, '2016-04-27', 6.4940),
-    (1, 3, '2016-04-28', 6.4735),
-    (1, 3, '2016-04-29', 6.4738),
-    (1, 3, '2016-05-02', 6.4738),
-    (1, 3, '2016-05-03', 6.4900),
-    (1, 3, '2016-05-04', 6.4945),
-    (1, 3, '2016-05-05', 6.5032),
-    (1, 3, '2016-05-06', 6.4970),
-    (1, 3, '2016-05-09', 6.5140),
-    (1, 3, '2016-05-10', 6.5150),
-    (1, 3, '2016-05-11', 6.4910),
-    (1, 3, '2016-05-12', 6.5140),
-    (1, 3, '2016-05-13', 6.5285),
-    (1, 3, '2016-05-16', 6.5184),
-    (1, 3, '2016-05-17', 6.5170),
-    (1, 3, '2016-05-18', 6.5370),
-    (1, 3, '2016-05-19', 6.5440),
-    (1, 3, '2016-05-20', 6.5485),
-    (1, 3, '2016-05-23', 6.5533),
-    (1, 3, '2016-05-24', 6.5552),
-    (1, 3, '2016-05-25', 6.5538),
-    (1, 3, '2016-05-26', 6.5540),
-    (1, 3, '2016-05-27', 6.5615),
-    (1, 3, '2016-05-30', NULL),
-    (1, 3, '2016-05-31', 6.5798),
-    (1, 3, '2016-06-01', 6.5748),
-    (1, 3, '2016-06-02', 6.5815),
-    (1, 3, '2016-06-03', 6.5638),
-    (1, 3, '2016-06-06', 6.5611),
-    (1, 3, '2016-06-07', 6.5710),
-    (1, 3, '2016-06-08', 6.5600),
-    (1, 3, '2016-06-09', 6.5590),
-    (1, 3, '2016-06-10', 6.5590),
-    (1, 3, '2016-06-13', 6.5823),
-    (1, 3, '2016-06-14', 6.5930),
-    (1, 3, '2016-06-15', 6.5785),
-    (1, 3, '2016-06-16', 6.5899),
-    (1, 3, '2016-06-17', 6.5849),
-    (1, 3, '2016-06-20', 6.5768),
-    (1, 3, '2016-06-21', 6.5893),
-    (1, 3, '2016-06-22', 6.5750),
-    (1, 3, '2016-06-23', 6.5740),
-    (1, 3, '2016-06-24', 6.6150),
-    (1, 3, '2016-06-27', 6.6481),
-    (1, 3, '2016-06-28', 6.6450),
-    (1, 3, '2016-06-29', 6.6350),
-    (1, 3, '2016-06-30', 6.6459),
-    (1, 3, '2016-07-01', 6.6547),
-    (1, 3, '2016-07-04', NULL),
-    (1, 3, '2016-07-05', 6.6770),
-    (1, 3, '2016-07-06', 6.6900),
-    (1, 3, '2016-07-07', 6.6790),
-    (1, 3, '2016-07-08', 6.6878),
-    (1, 3, '2016-07-11', 6.6917),
-    (1, 3, '2016-07-12', 6.6860),
-    (1, 3, '2016-07-13', 6.6832),
-    (1, 3, '2016-07-14', 6.6801),
-    (1, 3, '2016-07-15', 6.6865),
-    (1, 3, '2016-07-18', 6.7013),
-    (1, 3, '2016-07-19', 6.6931),
-    (1, 3, '2016-07-20', 6.6760),
-    (1, 3, '2016-07-21', 6.6735),
-    (1, 3, '2016-07-22', 6.6760),
-    (1, 3, '2016-07-25', 6.6783),
-    (1, 3, '2016-07-26', 6.6690),
-    (1, 3, '2016-07-27', 6.6680),
-    (1, 3, '2016-07-28', 6.6538),
-    (1, 3, '2016-07-29', 6.6371),
-    (1, 3, '2016-08-01', 6.6421),
-    (1, 3, '2016-08-02', 6.6239),
-    (1, 3, '2016-08-03', 6.6320),
-    (1, 3, '2016-08-04', 6.6388),
-    (1, 3, '2016-08-05', 6.6530),
-    (1, 3, '2016-08-08', 6.6597),
-    (1, 3, '2016-08-09', 6.6530),
-    (1, 3, '2016-08-10', 6.6345),
-    (1, 3, '2016-08-11', 6.6274),
-    (1, 3, '2016-08-12', 6.6324),
-    (1, 3, '2016-08-15', 6.6370),
-    (1, 3, '2016-08-16', 6.6240),
-    (1, 3, '2016-08-17', 6.6328),
-    (1, 3, '2016-08-18', 6.6297),
-    (1, 3, '2016-08-19', 6.6515),
-    (1, 3, '2016-08-22', 6.6475),
-    (1, 3, '2016-08-23', 6.6378),
-    (1, 3, '2016-08-24', 6.6562),
-    (1, 3, '2016-08-25', 6.6560),
-    (1, 3, '2016-08-26', 6.6690),
-    (1, 3, '2016-08-29', 6.6775),
-    (1, 3, '2016-08-30', 6.6778),
-    (1, 3, '2016-08-31', 6.6776),
-    (1, 3, '2016-09-01', 6.6713),
-    (1, 3, '2016-09-02', 6.6790),
-    (1, 3, '2016-09-05', NULL),
-    (1, 3, '2016-09-06', 6.6722),
-    (1, 3, '2016-09-07', 6.6600),
-    (1, 3, '2016-09-08', 6.6640),
-    (1, 3, '2016-09-09', 6.6778),
-    (1, 3, '2016-09-12', 6.6788),
-    (1, 3, '2016-09-13', 6.6782),
-    (1, 3, '2016-09-14', 6.6707),
-    (1, 3, '2016-09-15', 6.6707),
-    (1, 3, '2016-09-16', 6.6707),
-    (1, 3, '2016-09-19', 6.6650),
-    (1, 3, '2016-09-20', 6.6700),
-    (1, 3, '2016-09-21', 6.6695),
-    (1, 3, '2016-09-22', 6.6630),
-    (1, 3, '2016-09-23', 6.6690),
-    (1, 3, '2016-09-26', 6.6683),
-    (1, 3, '2016-09-27', 6.6691),
-    (1, 3, '2016-09-28', 6.6730),
-    (1, 3, '2016-09-29', 6.6650),
-    (1, 3, '2016-09-30', 6.6685),
-    (1, 3, '2016-10-03', 6.6685),
-    (1, 3, '2016-10-04', 6.6685),
-    (1, 3, '2016-10-05', 6.6685),
-    (1, 3, '2016-10-06', 6.6685),
-    (1, 3, '2016-10-07', 6.6685),
-    (1, 3, '2016-10-10', NULL),
-    (1, 3, '2016-10-11', 6.7190),
-    (1, 3, '2016-10-12', 6.7180),
-    (1, 3, '2016-10-13', 6.7248),
-    (1, 3, '2016-10-14', 6.7277),
-    (1, 3, '2016-10-17', 6.7350),
-    (1, 3, '2016-10-18', 6.7401),
-    (1, 3, '2016-10-19', 6.7370),
-    (1, 3, '2016-10-20', 6.7439),
-    (1, 3, '2016-10-21', 6.7654),
-    (1, 3, '2016-10-24', 6.7728),
-    (1, 3, '2016-10-25', 6.7780),
-    (1, 3, '2016-10-26', 6.7706),
-    (1, 3, '2016-10-27', 6.7819),
-    (1, 3, '2016-10-28', 6.7760),
-    (1, 3, '2016-10-31', 6.7735),
-    (1, 3, '2016-11-01', 6.7630),
-    (1, 3, '2016-11-02', 6.7606),
-    (1, 3, '2016-11-03', 6.7612),
-    (1, 3, '2016-11-04', 6.7534),
-    (1, 3, '2016-11-07', 6.7740),
-    (1, 3, '2016-11-08', 6.7840),
-    (1, 3, '2016-11-09', 6.7769),
-    (1, 3, '2016-11-10', 6.7987),
-    (1, 3, '2016-11-11', NULL),
-    (1, 3, '2016-11-14', 6.8447),
-    (1, 3, '2016-11-15', 6.8556),
-    (1, 3, '2016-11-16', 6.8770),
-    (1, 3, '2016-11-17', 6.8674),
-    (1, 3, '2016-11-18', 6.8873),
-    (1, 3, '2016-11-21', 6.8918),
-    (1, 3, '2016-11-22', 6.8880),
-    (1, 3, '2016-11-23', 6.9195),
-    (1, 3, '2016-11-24', NULL),
-    (1, 3, '2016-11-25', 6.9176),
-    (1, 3, '2016-11-28', 6.9055),
-    (1, 3, '2016-11-29', 6.8933),
-    (1, 3, '2016-11-30', 6.8837),
-    (1, 3, '2016-12-01', 6.8830),
-    (1, 3, '2016-12-02', 6.8830),
-    (1, 3, '2016-12-05', 6.8775),
-    (1, 3, '2016-12-06', 6.8790),
-    (1, 3, '2016-12-07', 6.8780),
-    (1, 3, '2016-12-08', 6.8771),
-    (1, 3, '2016-12-09', 6.9042),
-    (1, 3, '2016-12-12', 6.9045),
-    (1, 3, '2016-12-13', 6.8984),
-    (1, 3, '2016-12-14', 6.9025),
-    (1, 3, '2016-12-15', 6.9410),
-    (1, 3, '2016-12-16', 6.9580),
-    (1, 3, '2016-12-19', 6.9506),
-    (1, 3, '2016-12-20', 6.9470),
-    (1, 3, '2016-12-21', 6.9390),
-    (1, 3, '2016-12-22', 6.9473),
-    (1, 3, '2016-12-23', 6.9432),
-    (1, 3, '2016-12-26', NULL),
-    (1, 3, '2016-12-27', 6.9491),
-    (1, 3, '2016-12-28', 6.9559),
-    (1, 3, '2016-12-29', 6.9535),
-    (1, 3, '2016-12-30', 6.9430),
-    (1, 3, '2017-01-02', NULL),
-    (1, 3, '2017-01-03', 6.9575),
-    (1, 3, '2017-01-04', 6.9322),
-    (1, 3, '2017-01-05', 6.8870),
-    (1, 3, '2017-01-06', 6.9176),
-    (1, 3, '2017-01-09', 6.9350),
-    (1, 3, '2017-01-10', 6.9180),
-    (1, 3, '2017-01-11', 6.9365),
-    (1, 3, '2017-01-12', 6.8890),
-    (1, 3, '2017-01-13', 6.8985),
-    (1, 3, '2017-01-16', NULL),
-    (1, 3, '2017-01-17', 6.8520),
-    (1, 3, '2017-01-18', 6.8360),
-    (1, 3, '2017-01-19', 6.8680),
-    (1, 3, '2017-01-20', NULL),
-    (1, 3, '2017-01-23', 6.8514),
-    (1, 3, '2017-01-24', 6.8555),
-    (1, 3, '2017-01-25', 6.8812),
-    (1, 3, '2017-01-26', 6.8768),
-    (1, 3, '2017-01-27', 6.8768),
-    (1, 3, '2017-01-30', 6.8768),
-    (1, 3, '2017-01-31', 6.8768),
-    (1, 3, '2017-02-01', 6.8768),
-    (1, 3, '2017-02-02', 6.8768),
-    (1, 3, '2017-02-03', 6.8650),
-    (1, 3, '2017-02-06', 6.8600),
-    (1, 3, '2017-02-07', 6.8821),
-    (1, 3, '2017-02-08', 6.8700),
-    (1, 3, '2017-02-09', 6.8660),
-    (1, 3, '2017-02-10', 6.8775),
-    (1, 3, '2017-02-13', 6.8776),
-    (1, 3, '2017-02-14', 6.8649),
-    (1, 3, '2017-02-15', 6.8685),
-    (1, 3, '2017-02-16', 6.8517),
-    (1, 3, '2017-02-17', 6.8640),
-    (1, 3, '2017-02-20', NULL),
-    (1, 3, '2017-02-21', 6.8810),
-    (1, 3, '2017-02-22', 6.8765),
-    (1, 3, '2017-02-23', 6.8622),
-    (1, 3, '2017-02-24', 6.8645),
-    (1, 3, '2017-02-27', 6.8675),
-    (1, 3, '2017-02-28', 6.8665),
-    (1, 3, '2017-03-01', 6.8794),
-    (1, 3, '2017-03-02', 6.8868),
-    (1, 3, '2017-03-03', 6.8954),
-    (1, 3, '2017-03-06', 6.8955),
-    (1, 3, '2017-03-07', 6.9000),
-    (1, 3, '2017-03-08', 6.9113),
-    (1, 3, '2017-03-09', 6.9086),
-    (1, 3, '2017-03-10', 6.9050),
-    (1, 3, '2017-03-13', 6.9050),
-    (1, 3, '2017-03-14', 6.9130),
-    (1, 3, '2017-03-15', 6.9132),
-    (1, 3, '2017-03-16', 6.9130),
-    (1, 3, '2017-03-17', 6.9000),
-    (1, 3, '2017-03-20', 6.9050),
-    (1, 3, '2017-03-21', 6.8830),
-    (1, 3, '2017-03-22', 6.8785),
-    (1, 3, '2017-03-23', 6.8843),
-    (1, 3, '2017-03-24', 6.8803),
-    (1, 3, '2017-03-27', 6.8687),
-    (1, 3, '2017-03-28', 6.8779),
-    (1, 3, '2017-03-29', 6.8879),
-    (1, 3, '2017-03-30', 6.8870),
-    (1, 3, '2017-03-31', 6.8832),
-    (1, 3, '2017-04-03', 6.8832),
-    (1, 3, '2017-04-04', 6.8832),
-    (1, 3, '2017-04-05', 6.8920),
-    (1, 3, '2017-04-06', 6.8955),
-    (1, 3, '2017-04-07', 6.8978),
-    (1, 3, '2017-04-10', 6.8988),
-    (1, 3, '2017-04-11', 6.8893),
-    (1, 3, '2017-04-12', 6.8890),
-    (1, 3, '2017-04-13', 6.8865),
-    (1, 3, '2017-04-14', 6.8835),
-    (1, 3, '2017-04-17', 6.8800),
-    (1, 3, '2017-04-18', 6.8778),
-    (1, 3, '2017-04-19', 6.8860),
-    (1, 3, '2017-04-20', 6.8800),
-    (1, 3, '2017-04-21', 6.8845),
-    (1, 3, '2017-04-24', 6.8840),
-    (1, 3, '2017-04-25', 6.8824),
-    (1, 3, '2017-04-26', 6.8918),
-    (1, 3, '2017-04-27', 6.8958),
-    (1, 3, '2017-04-28', 6.8900),
-    (1, 3, '2017-05-01', 6.8900),
-    (1, 3, '2017-05-02', 6.8949),
-    (1, 3, '2017-05-03', 6.8968),
-    (1, 3, '2017-05-04', 6.8959),
-    (1, 3, '2017-05-05', 6.9021),
-    (1, 3, '2017-05-08', 6.9040),
-    (1, 3, '2017-05-09', 6.9060),
-    (1, 3, '2017-05-10', 6.9011),
-    (1, 3, '2017-05-11', 6.9033),
-    (1, 3, '2017-05-12', 6.8972),
-    (1, 3, '2017-05-15', 6.8900),
-    (1, 3, '2017-05-16', 6.8840),
-    (1, 3, '2017-05-17', 6.8773),
-    (1, 3, '2017-05-18', 6.8880),
-    (1, 3, '2017-05-19', 6.8830),
-    (1, 3, '2017-05-22', 6.8828),
-    (1, 3, '2017-05-23', 6.8880),
-    (1, 3, '2017-05-24', 6.8883),
-    (1, 3, '2017-05-25', 6.8672),
-    (1, 3, '2017-05-26', 6.8525),
-    (1, 3, '2017-05-29', NULL),
-    (1, 3, '2017-05-30', 6.8525),
-    (1, 3, '2017-05-31', 6.8098),
-    (1, 3, '2017-06-01', 6.8029),
-    (1, 3, '2017-06-02', 6.8085),
-    (1, 3, '2017-06-05', 6.8030),
-    (1, 3, '2017-06-06', 6.7945),
-    (1, 3, '2017-06-07', 6.7935),
-    (1, 3, '2017-06-08', 6.7990),
-    (1, 3, '2017-06-09', 6.7970),
-    (1, 3, '2017-06-12', 6.7974),
-    (1, 3, '2017-06-13', 6.7981),
-    (1, 3, '2017-06-14', 6.7888),
-    (1, 3, '2017-06-15', 6.8061),
-    (1, 3, '2017-06-16', 6.8097),
-    (1, 3, '2017-06-19', 6.8180),
-    (1, 3, '2017-06-20', 6.8285),
-    (1, 3, '2017-06-21', 6.8275),
-    (1, 3, '2017-06-22', 6.8275),
-    (1, 3, '2017-06-23', 6.8345),
-    (1, 3, '2017-06-26', 6.8382),
-    (1, 3, '2017-06-27', 6.8100),
-    (1, 3, '2017-06-28', 6.7984),
-    (1, 3, '2017-06-29', 6.7840),
-    (1, 3, '2017-06-30', 6.7793),
-    (1, 3, '2017-07-03', 6.7984),
-    (1, 3, '2017-07-04', NULL),
-    (1, 3, '2017-07-05', 6.7995),
-    (1, 3, '2017-07-06', 6.7990),
-    (1, 3, '2017-07-07', 6.8039),
-    (1, 3, '2017-07-10', 6.8026),
-    (1, 3, '2017-07-11', 6.8010),
-    (1, 3, '2017-07-12', 6.7865),
-    (1, 3, '2017-07-13', 6.7825),
-    (1, 3, '2017-07-14', 6.7741),
-    (1, 3, '2017-07-17', 6.7690),
-    (1, 3, '2017-07-18', 6.7451),
-    (1, 3, '2017-07-19', 6.7511),
-    (1, 3, '2017-07-20', 6.7570),
-    (1, 3, '2017-07-21', 6.7663),
-    (1, 3, '2017-07-24', 6.7488),
-    (1, 3, '2017-07-25', 6.7495),
-    (1, 3, '2017-07-26', 6.7530),
-    (1, 3, '2017-07-27', 6.7410),
-    (1, 3, '2017-07-28', 6.7362),
-    (1, 3, '2017-07-31', 6.7240),
-    (1, 3, '2017-08-01', 6.7170),
-    (1, 3, '2017-08-02', 6.7200),
-    (1, 3, '2017-08-03', 6.7150),
-    (1, 3, '2017-08-04', 6.7272),
-    (1, 3, '2017-08-07', 6.7207),
-    (1, 3, '2017-08-08', 6.7049),
-    (1, 3, '2017-08-09', 6.6720),
-    (1, 3, '2017-08-10', 6.6460),
-    (1, 3, '2017-08-11', 6.6612),
-    (1, 3, '2017-08-14', 6.6695),
-    (1, 3, '2017-08-15', 6.6845),
-    (1, 3, '2017-08-16', 6.6900),
-    (1, 3, '2017-08-17', 6.6730),
-    (1, 3, '2017-08-18', 6.6700),
-    (1, 3, '2017-08-21', 6.6630),
-    (1, 3, '2017-08-22', 6.6600),
-    (1, 3, '2017-08-23', 6.6569),
-    (1, 3, '2017-08-24', 6.6600),
-    (1, 3, '2017-08-25', 6.6465),
-    (1, 3, '2017-08-28', 6.6084),
-    (1, 3, '2017-08-29', 6.5945),
-    (1, 3, '2017-08-30', 6.5918),
-    (1, 3, '2017-08-31', 6.5888),
-    (1, 3, '2017-09-01', 6.5552),
-    (1, 3, '2017-09-04', NULL),
-    (1, 3, '2017-09-05', 6.5345),
-    (1, 3, '2017-09-06', 6.5221),
-    (1, 3, '2017-09-07', 6.4830),
-    (1, 3, '2017-09-08', 6.4773),
-    (1, 3, '2017-09-11', 6.5254),
-    (1, 3, '2017-09-12', 6.5334),
-    (1, 3, '2017-09-13', 6.5400),
-    (1, 3, '2017-09-14', 6.5520),
-    (1, 3, '2017-09-15', 6.5500),
-    (1, 3, '2017-09-18', 6.5725),
-    (1, 3, '2017-09-19', 6.5800),
-    (1, 3, '2017-09-20', 6.5700),
-    (1, 3, '2017-09-21', 6.5861),
-    (1, 3, '2017-09-22', 6.5899),
-    (1, 3, '2017-09-25', 6.6189),
-    (1, 3, '2017-09-26', 6.6371),
-    (1, 3, '2017-09-27', 6.6400),
-    (1, 3, '2017-09-28', 6.6591),
-    (1, 3, '2017-09-29', 6.6533),
-    (1, 3, '2017-10-02', 6.6533),
-    (1, 3, '2017-10-03', 6.6533),
-    (1, 3, '2017-10-04', 6.6533),
-    (1, 3, '2017-10-05', 6.6533),
-    (1, 3, '2017-10-06', 6.6533),
-    (1, 3, '2017-10-09', NULL),
-    (1, 3, '2017-10-10', 6.5712),
-    (1, 3, '2017-10-11', 6.5870),
-    (1, 3, '2017-10-12', 6.5855),
-    (1, 3, '2017-10-13', 6.5785),
-    (1, 3, '2017-10-16', 6.5885),
-    (1, 3, '2017-10-17', 6.6220),
-    (1, 3, '2017-10-18', 6.6250),
-    (1, 3, '2017-10-19', 6.6126),
-    (1, 3, '2017-10-20', 6.6185),
-    (1, 3, '2017-10-23', 6.6380),
-    (1, 3, '2017-10-24', 6.6335),
-    (1, 3, '2017-10-25', 6.6350),
-    (1, 3, '2017-10-26', 6.6392),
-    (1, 3, '2017-10-27', 6.6498),
-    (1, 3, '2017-10-30', 6.6498),
-    (1, 3, '2017-10-31', 6.6328),
-    (1, 3, '2017-11-01', 6.6018),
-    (1, 3, '2017-11-02', 6.6068),
-    (1, 3, '2017-11-03', 6.6360),
-    (1, 3, '2017-11-06', 6.6311),
-    (1, 3, '2017-11-07', 6.6380),
-    (1, 3, '2017-11-08', 6.6249),
-    (1, 3, '2017-11-09', 6.6378),
-    (1, 3, '2017-11-10', NULL),
-    (1, 3, '2017-11-13', 6.6385),
-    (1, 3, '2017-11-14', 6.6350),
-    (1, 3, '2017-11-15', 6.6200),
-    (1, 3, '2017-11-16', 6.6273),
-    (1, 3, '2017-11-17', 6.6245),
-    (1, 3, '2017-11-20', 6.6245),
-    (1, 3, '2017-11-21', 6.6265),
-    (1, 3, '2017-11-22', 6.6086),
-    (1, 3, '2017-11-23', NULL),
-    (1, 3, '2017-11-24', 6.5998),
-    (1, 3, '2017-11-27', 6.5967),
-    (1, 3, '2017-11-28', 6.6044),
-    (1, 3, '2017-11-29', 6.6091),
-    (1, 3, '2017-11-30', 6.6090),
-    (1, 3, '2017-12-01', 6.6137),
-    (1, 3, '2017-12-04', 6.6185),
-    (1, 3, '2017-12-05', 6.6170),
-    (1, 3, '2017-12-06', 6.6136),
-    (1, 3, '2017-12-07', 6.6173),
-    (1, 3, '2017-12-08', 6.6199),
-    (1, 3, '2017-12-11', 6.6175),
-    (1, 3, '2017-12-12', 6.6210),
-    (1, 3, '2017-12-13', 6.6171),
-    (1, 3, '2017-12-14', 6.6091),
-    (1, 3, '2017-12-15', 6.6088),
-    (1, 3, '2017-12-18', 6.6088),
-    (1, 3, '2017-12-19', 6.6088),
-    (1, 3, '2017-12-20', 6.5771),
-    (1, 3, '2017-12-21', 6.5833),
-    (1, 3, '2017-12-22', 6.5750),
-    (1, 3, '2017-12-25', NULL),
-    (1, 3, '2017-12-26', 6.5429),
-    (1, 3, '2017-12-27', 6.5560),
-    (1, 3, '2017-12-28', 6.5322),
-    (1, 3, '2017-12-29', 6.5063),
-    (1, 3, '2018-01-01', NULL),
-    (1, 3, '2018-01-02', 6.4910),
-    (1, 3, '2018-01-03', 6.5010),
-    (1, 3, '2018-01-04', 6.4915),
-    (1, 3, '2018-01-05', 6.4875),
-    (1, 3, '2018-01-08', 6.4970),
-    (1, 3, '2018-01-09', 6.5263),
-    (1, 3, '2018-01-10', 6.5055),
-    (1, 3, '2018-01-11', 6.4956),
-    (1, 3, '2018-01-12', 6.4607),
-    (1, 3, '2018-01-15', NULL),
-    (1, 3, '2018-01-16', 6.4395),
-    (1, 3, '2018-01-17', 6.4330),
-    (1, 3, '2018-01-18', 6.4175),
-    (1, 3, '2018-01-19', 6.3990),
-    (1, 3, '2018-01-22', 6.4035),
-    (1, 3, '2018-01-23', 6.4000),
-    (1, 3, '2018-01-24', 6.3650),
-    (1, 3, '2018-01-25', 6.3189),
-    (1, 3, '2018-01-26', 6.3199),
-    (1, 3, '2018-01-29', 6.3328),
-    (1, 3, '2018-01-30', 6.3199),
-    (1, 3, '2018-01-31', 6.2841),
-    (1, 3, '2018-02-01', 6.2969),
-    (1, 3, '2018-02-02', 6.2984),
-    (1, 3, '2018-02-05', 6.2850),
-    (1, 3, '2018-02-06', 6.2830),
-    (1, 3, '2018-02-07', 6.2649),
-    (1, 3, '2018-02-08', 6.3222),
-    (1, 3, '2018-02-09', 6.2966),
-    (1, 3, '2018-02-12', 6.3250),
-    (1, 3, '2018-02-13', 6.3380),
-    (1, 3, '2018-02-14', 6.3438),
-    (1, 3, '2018-02-15', 6.3438),
-    (1, 3, '2018-02-16', 6.3438),
-    (1, 3, '2018-02-19', NULL),
-    (1, 3, '2018-02-20', 6.3351),
-    (1, 3, '2018-02-21', 6.3351),
-    (1, 3, '2018-02-22', 6.3471),
-    (1, 3, '2018-02-23', 6.3329),
-    (1, 3, '2018-02-26', 6.3115),
-    (1, 3, '2018-02-27', 6.3169),
-    (1, 3, '2018-02-28', 6.328),
-    (1, 3, '2018-03-01', 6.3565),
-    (1, 3, '2018-03-02', 6.3428),
-    (1, 3, '2018-03-05', 6.3491),
-    (1, 3, '2018-03-06', 6.3093),
-    (1, 3, '2018-03-07', 6.3225),
-    (1, 3, '2018-03-08', 6.3389),
-    (1, 3, '2018-03-09', 6.3285),
-    (1, 3, '2018-03-12', 6.327),
-    (1, 3, '2018-03-13', 6.318),
-    (1, 3, '2018-03-14', 6.314),
-    (1, 3, '2018-03-15', 6.3202),
-    (1, 3, '2018-03-16', 6.33),
-    (1, 3, '2018-03-19', 6.3291),
-    (1, 3, '2018-03-20', 6.3328),
-    (1, 3, '2018-03-21', 6.32),
-    (1, 3, '2018-03-22', 6.331),
-    (1, 3, '2018-03-23', 6.311),
-    (1, 3, '2018-03-26', 6.2685),
-    (1, 3, '2018-03-27', 6.2781),
-    (1, 3, '2018-03-28', 6.2946),
-    (1, 3, '2018-03-29', 6.2876),
-    (1, 3, '2018-03-30', 6.2726),
-    (1, 3, '2018-04-02', 6.2785),
-    (1, 3, '2018-04-03', 6.2881),
-    (1, 3, '2018-04-04', 6.3045),
-    (1, 3, '2018-04-05', 6.3045),
-    (1, 3, '2018-04-06', 6.3045),
-    (1, 3, '2018-04-09', 6.3035),
-    (1, 3, '2018-04-10', 6.2798),
-    (1, 3, '2018-04-11', 6.2655),
-    (1, 3, '2018-04-12', 6.2876),
-    (1, 3, '2018-04-13', 6.2725),
-    (1, 3, '2018-04-16', 6.2752),
-    (1, 3, '2018-04-17', 6.2804),
-    (1, 3, '2018-04-18', 6.2722),
-    (1, 3, '2018-04-19', 6.2765),
-    (1, 3, '2018-04-20', 6.2945),
-    (1, 3, '2018-04-23', 6.3158),
-    (1, 3, '2018-04-24', 6.3035),
-    (1, 3, '2018-04-25', 6.3236),
-    (1, 3, '2018-04-26', 6.334),
-    (1, 3, '2018-04-27', 6.3325),
-    (1, 3, '2018-04-30', 6.3325),
-    (1, 3, '2018-05-01', 6.3325),
-    (1, 3, '2018-05-02', 6.361),
-    (1, 3, '2018-05-03', 6.352),
-    (1, 3, '2018-05-04', 6.3589),
-    (1, 3, '2018-05-07', 6.364),
-    (1, 3, '2018-05-08', 6.369),
-    (1, 3, '2018-05-09', 6.3585),
-    (1, 3, '2018-05-10', 6.344),
-    (1, 3, '2018-05-11', 6.3333),
-    (1, 3, '2018-05-14', 6.336),
-    (1, 3, '2018-05-15', 6.376),
-    (1, 3, '2018-05-16', 6.3706),
-    (1, 3, '2018-05-17', 6.3645),
-    (1, 3, '2018-05-18', 6.3768),
-    (1, 3, '2018-05-21', 6.3815),
-    (1, 3, '2018-05-22', 6.366),
-    (1, 3, '2018-05-23', 6.3875),
-    (1, 3, '2018-05-24', 6.3757),
-    (1, 3, '2018-05-25', 6.3903),
-    (1, 3, '2018-05-28', NULL),
-    (1, 3, '2018-05-29', 6.4162),
-    (1, 3, '2018-05-30', 6.4175),
-    (1, 3, '2018-05-31', 6.4096),
-    (1, 3, '2018-06-01', 6.418),
-    (1, 3, '2018-06-04', 6.405),
-    (1, 3, '2018-06-05', 6.4042),
-    (1, 3, '2018-06-06', 6.385),
-    (1, 3, '2018-06-07', 6.389),
-    (1, 3, '2018-06-08', 6.4031),
-    (1, 3, '2018-06-11', 6.4),
-    (1, 3, '2018-06-12', 6.3992),
-    (1, 3, '2018-06-13', 6.3944),
-    (1, 3, '2018-06-14', 6.398),
-    (1, 3, '2018-06-15', 6.4379),
-    (1, 3, '2018-06-18', 6.4379),
-    (1, 3, '2018-06-19', 6.4802),
-    (1, 3, '2018-06-20', 6.4713),
-    (1, 3, '2018-06-21', 6.49),
-    (1, 3, '2018-06-22', 6.5027),
-    (1, 3, '2018-06-25', 6.536),
-    (1, 3, '2018-06-26', 6.5759),
-    (1, 3, '2018-06-27', 6.5992),
-    (1, 3, '2018-06-28', 6.6235),
-    (1, 3, '2018-06-29', 6.6171),
-    (1, 3, '2018-07-02', 6.6632),
-    (1, 3, '2018-07-03', 6.6368),
-    (1, 3, '2018-07-04', NULL),
-    (1, 3, '2018-07-05', 6.6341),
-    (1, 3, '2018-07-06', 6.6396),
-    (1, 3, '2018-07-09', 6.6123),
-    (1, 3, '2018-07-10', 6.6296),
-    (1, 3, '2018-07-11', 6.6764),
-    (1, 3, '2018-07-12', 6.663),
-    (1, 3, '2018-07-13', 6.69),
-    (1, 3, '2018-07-16', 6.6866),
-    (1, 3, '2018-07-17', 6.7018),
-    (1, 3, '2018-07-18', 6.7161),
-    (1, 3, '2018-07-19', 6.7701),
-    (1, 3, '2018-07-20', 6.7659),
-    (1, 3, '2018-07-23', 6.7927),
-    (1, 3, '2018-07-24', 6.7912),
-    (1, 3, '2018-07-25', 6.7658),
-    (1, 3, '2018-07-26', 6.7865),
-    (1, 3, '2018-07-27', 6.8097),
-    (1, 3, '2018-07-30', 6.8102),
-    (1, 3, '2018-07-31', 6.8038),
-    (1, 3, '2018-08-01', 6.8154),
-    (1, 3, '2018-08-02', 6.838),
-    (1, 3, '2018-08-03', 6.8309),
-    (1, 3, '2018-08-06', 6.85),
-    (1, 3, '2018-08-07', 6.828),
-    (1, 3, '2018-08-08', 6.8344),
-    (1, 3, '2018-08-09', 6.8199),
-    (1, 3, '2018-08-10', 6.8458),
-    (1, 3, '2018-08-13', 6.888),
-    (1, 3, '2018-08-14', 6.8809),
-    (1, 3, '2018-08-15', 6.933),
-    (1, 3, '2018-08-16', 6.8792),
-    (1, 3, '2018-08-17', 6.874),
-    (1, 3, '2018-08-20', 6.8546),
-    (1, 3, '2018-08-21', 6.8444),
-    (1, 3, '2018-08-22', 6.84),
-    (1, 3, '2018-08-23', 6.8748),
-    (1, 3, '2018-08-24', 6.803),
-    (1, 3, '2018-08-27', 6.8145),
-    (1, 3, '2018-08-28', 6.8018),
-    (1, 3, '2018-08-29', 6.819),
-    (1, 3, '2018-08-30', 6.8415),
-    (1, 3, '2018-08-31', 6.83),
-    (1, 3, '2018-09-03', NULL),
-    (1, 3, '2018-09-04', 6.8427),
-    (1, 3, '2018-09-05', 6.827),
-    (1, 3, '2018-09-06', 6.8322),
-    (1, 3, '2018-09-07', 6.8419),
-    (1, 3, '2018-09-10', 6.8545),
-    (1, 3, '2018-09-11', 6.8704),
-    (1, 3, '2018-09-12', 6.8574),
-    (1, 3, '2018-09-13', 6.8407),
-    (1, 3, '2018-09-14', 6.8673),
-    (1, 3, '2018-09-17', 6.854),
-    (1, 3, '2018-09-18', 6.8591),
-    (1, 3, '2018-09-19', 6.8466),
-    (1, 3, '2018-09-20', 6.8455),
-    (1, 3, '2018-09-21', 6.8559),
-    (1, 3, '2018-09-24', 6.8559),
-    (1, 3, '2018-09-25', 6.8632),
-    (1, 3, '2018-09-26', 6.8763),
-    (1, 3, '2018-09-27', 6.888),
-    (1, 3, '2018-09-28', 6.868),
-    (1, 3, '2018-10-01', 6.868),
-    (1, 3, '2018-10-02', 6.868),
-    (1, 3, '2018-10-03', 6.868),
-    (1, 3, '2018-10-04', 6.868),
-    (1, 3, '2018-10-05', 6.868),
-    (1, 3, '2018-10-08', NULL),
-    (1, 3, '2018-10-09', 6.9217),
-    (1, 3, '2018-10-10', 6.9224),
-    (1, 3, '2018-10-11', 6.888),
-    (1, 3, '2018-10-12', 6.9182),
-    (1, 3, '2018-10-15', 6.9131),
-    (1, 3, '2018-10-16', 6.9102),
-    (1, 3, '2018-10-17', 6.9263),
-    (1, 3, '2018-10-18', 6.9367),
-    (1, 3, '2018-10-19', 6.9291),
-    (1, 3, '2018-10-22', 6.9446),
-    (1, 3, '2018-10-23', 6.9373),
-    (1, 3, '2018-10-24', 6.942),
-    (1, 3, '2018-10-25', 6.9477),
-    (1, 3, '2018-10-26', 6.9425),
-    (1, 3, '2018-10-29', 6.9605),
-    (1, 3, '2018-10-30', 6.9663),
-    (1, 3, '2018-10-31', 6.9737),
-    (1, 3, '2018-11-01', 6.9205),
-    (1, 3, '2018-11-02', 6.8894),
-    (1, 3, '2018-11-05', 6.9249),
-    (1, 3, '2018-11-06', 6.9172),
-    (1, 3, '2018-11-07', 6.9174),
-    (1, 3, '2018-11-08', 6.9318),
-    (1, 3, '2018-11-09', 6.9553),
-    (1, 3, '2018-11-12', NULL),
-    (1, 3, '2018-11-13', 6.9546),
-    (1, 3, '2018-11-14', 6.95),
-    (1, 3, '2018-11-15', 6.938),
-    (1, 3, '2018-11-16', 6.9367),
-    (1, 3, '2018-11-19', 6.9398),
-    (1, 3, '2018-11-20', 6.9448),
-    (1, 3, '2018-11-21', 6.9248),
-    (1, 3, '2018-11-22', NULL),
-    (1, 3, '2018-11-23', 6.9477),
-    (1, 3, '2018-11-26', 6.9397),
-    (1, 3, '2018-11-27', 6.9498),
-    (1, 3, '2018-11-28', 6.9534),
-    (1, 3, '2018-11-29', 6.9419),
-    (1, 3, '2018-11-30', 6.9558),
-    (1, 3, '2018-12-03', 6.8798),
-    (1, 3, '2018-12-04', 6.8343),
-    (1, 3, '2018-12-05', NULL),
-    (1, 3, '2018-12-06', 6.8816),
-    (1, 3, '2018-12-07', 6.8733),
-    (1, 3, '2018-12-10', 6.9077),
-    (1, 3, '2018-12-11', 6.8968),
-    (1, 3, '2018-12-12', 6.8778),
-    (1, 3, '2018-12-13', 6.8798),
-    (1, 3, '2018-12-14', 6.905),
-    (1, 3, '2018-12-17', 6.8962),
-    (1, 3, '2018-12-18', 6.8939),
-    (1, 3, '2018-12-19', 6.8918),
-    (1, 3, '2018-12-20', 6.8834),
-    (1, 3, '2018-12-21', 6.9048),
-    (1, 3, '2018-12-24', NULL),
-    (1, 3, '2018-12-25', NULL),
-    (1, 3, '2018-12-26', 6.8847),
-    (1, 3, '2018-12-27', 6.8649),
-    (1, 3, '2018-12-28', 6.8755),
-    (1, 3, '2018-12-31', 6.8755),
-    (1, 3, '2019-01-01', NULL),
-    (1, 3, '2019-01-02', 6.8597),
-    (1, 3, '2019-01-03', 6.8708),
-    (1, 3, '2019-01-04', 6.8663),
-    (1, 3, '2019-01-07', 6.8501),
-    (1, 3, '2019-01-08', 6.8519),
-    (1, 3, '2019-01-09', 6.8117),
-    (1, 3, '2019-01-10', 6.7875),
-    (1, 3, '2019-01-11', 6.7596),
-    (1, 3, '2019-01-14', NULL),
-    (1, 3, '2019-01-15', 6.7595),
-    (1, 3, '2019-01-16', 6.7532),
-    (1, 3, '2019-01-17', 6.7735),
-    (1, 3, '2019-01-18', 6.7765),
-    (1, 3, '2019-01-21', NULL),
-    (1, 3, '2019-01-22', 6.8072),
-    (1, 3, '2019-01-23', 6.7897),
-    (1, 3, '2019-01-24', 6.7835),
-    (1, 3, '2019-01-25', 6.7448),
-    (1, 3, '2019-01-28', 6.7437),
-    (1, 3, '2019-01-29', 6.7304),
-    (1, 3, '2019-01-30', 6.7115),
-    (1, 3, '2019-01-31', 6.6958),
-    (1, 3, '2019-02-01', 6.7426),
-    (1, 3, '2019-02-04', 6.7426),
-    (1, 3, '2019-02-05', 6.7426),
-    (1, 3, '2019-02-06', 6.7426),
-    (1, 3, '2019-02-07', 6.7426),
-    (1, 3, '2019-02-08', 6.7426),
-    (1, 3, '2019-02-11', 6.7907),
-    (1, 3, '2019-02-12', 6.7726),
-    (1, 3, '2019-02-13', 6.7598),
-    (1, 3, '2019-02-14', 6.7711),
-    (1, 3, '2019-02-15', 6.768),
-    (1, 3, '2019-02-18', NULL),
-    (1, 3, '2019-02-19', 6.756),
-    (1, 3, '2019-02-20', NULL),
-    (1, 3, '2019-02-21', 6.7195),
-    (1, 3, '2019-02-22', 6.7112),
-    (1, 3, '2019-02-25', 6.6844),
-    (1, 3, '2019-02-26', 6.698),
-    (1, 3, '2019-02-27', 6.6822),
-    (1, 3, '2019-02-28', 6.6912),
-    (1, 3, '2019-03-01', 6.7048),
-    (1, 3, '2019-03-04', 6.7045),
-    (1, 3, '2019-03-05', 6.706),
-    (1, 3, '2019-03-06', 6.7099),
-    (1, 3, '2019-03-07', 6.7132),
-    (1, 3, '2019-03-08', 6.7201),
-    (1, 3, '2019-03-11', 6.7215),
-    (1, 3, '2019-03-12', 6.707),
-    (1, 3, '2019-03-13', 6.7064),
-    (1, 3, '2019-03-14', 6.7209),
-    (1, 3, '2019-03-15', 6.7129),
-    (1, 3, '2019-03-18', 6.7121),
-    (1, 3, '2019-03-19', 6.7106),
-    (1, 3, '2019-03-20', 6.6916),
-    (1, 3, '2019-03-21', 6.6952),
-    (1, 3, '2019-03-22', 6.7162),
-    (1, 3, '2019-03-25', 6.7079),
-    (1, 3, '2019-03-26', 6.7145),
-    (1, 3, '2019-03-27', 6.7262),
-    (1, 3, '2019-03-28', 6.7381),
-    (1, 3, '2019-03-29', 6.7112),
-    (1, 3, '2019-04-01', 6.7102),
-    (1, 3, '2019-04-02', 6.7223),
-    (1, 3, '2019-04-03', 6.7098),
-    (1, 3, '2019-04-04', 6.7167),
-    (1, 3, '2019-04-05', 6.7182),
-    (1, 3, '2019-04-08', 6.7158),
-    (1, 3, '2019-04-09', 6.7111),
-    (1, 3, '2019-04-10', 6.7153),
-    (1, 3, '2019-04-11', 6.7188),
-    (1, 3, '2019-04-12', 6.7039),
-    (1, 3, '2019-04-15', 6.7072),
-    (1, 3, '2019-04-16', 6.7112),
-    (1, 3, '2019-04-17', 6.687),
-    (1, 3, '2019-04-18', 6.7081),
-    (1, 3, '2019-04-19', 6.7032),
-    (1, 3, '2019-04-22', 6.7112),
-    (1, 3, '2019-04-23', 6.7249),
-    (1, 3, '2019-04-24', 6.7207),
-    (1, 3, '2019-04-25', 6.7418),
-    (1, 3, '2019-04-26', 6.7282),
-    (1, 3, '2019-04-29', 6.7332),
-    (1, 3, '2019-04-30', 6.7347),
-    (1, 3, '2019-05-01', 6.7319),
-    (1, 3, '2019-05-02', 6.7337),
-    (1, 3, '2019-05-03', 6.7337),
-    (1, 3, '2019-05-06', 6.7602),
-    (1, 3, '2019-05-07', 6.7755),
-    (1, 3, '2019-05-08', 6.7818),
-    (1, 3, '2019-05-09', 6.8256),
-    (1, 3, '2019-05-10', 6.8217),
-    (1, 3, '2019-05-13', 6.8765),
-    (1, 3, '2019-05-14', 6.8737),
-    (1, 3, '2019-05-15', 6.8742),
-    (1, 3, '2019-05-16', 6.8832),
-    (1, 3, '2019-05-17', 6.9182),
-    (1, 3, '2019-05-20', 6.9111),
-    (1, 3, '2019-05-21', 6.9007),
-    (1, 3, '2019-05-22', 6.9054),
-    (1, 3, '2019-05-23', 6.9087),
-    (1, 3, '2019-05-24', 6.8997),
-    (1, 3, '2019-05-27', NULL),
-    (1, 3, '2019-05-28', 6.9078),
-    (1, 3, '2019-05-29', 6.9138),
-    (1, 3, '2019-05-30', 6.9011),
-    (1, 3, '2019-05-31', 6.9027),
-    (1, 3, '2019-06-03', 6.9028),
-    (1, 3, '2019-06-04', 6.9072),
-    (1, 3, '2019-06-05', 6.9084),
-    (1, 3, '2019-06-06', 6.9082),
-    (1, 3, '2019-06-07', 6.9057),
-    (1, 3, '2019-06-10', 6.9298),
-    (1, 3, '2019-06-11', 6.9107),
-    (1, 3, '2019-06-12', 6.9161),
-    (1, 3, '2019-06-13', 6.9212),
-    (1, 3, '2019-06-14', 6.9247),
-    (1, 3, '2019-06-17', 6.9251),
-    (1, 3, '2019-06-18', 6.9002),
-    (1, 3, '2019-06-19', 6.8996),
-    (1, 3, '2019-06-20', 6.851),
-    (1, 3, '2019-06-21', 6.8686),
-    (1, 3, '2019-06-24', 6.8758),
-    (1, 3, '2019-06-25', 6.8791),
-    (1, 3, '2019-06-26', 6.8781),
-    (1, 3, '2019-06-27', 6.8762),
-    (1, 3, '2019-06-28', 6.865),
-    (1, 3, '2019-07-01', 6.8487),
-    (1, 3, '2019-07-02', 6.8717),
-    (1, 3, '2019-07-03', 6.8806),
-    (1, 3, '2019-07-04', NULL),
-    (1, 3, '2019-07-05', 6.8925),
-    (1, 3, '2019-07-08', 6.8808),
-    (1, 3, '2019-07-09', 6.8866),
-    (1, 3, '2019-07-10', 6.8705),
-    (1, 3, '2019-07-11', 6.8682),
-    (1, 3, '2019-07-12', 6.8796),
-    (1, 3, '2019-07-15', 6.8769),
-    (1, 3, '2019-07-16', 6.8753),
-    (1, 3, '2019-07-17', 6.8731),
-    (1, 3, '2019-07-18', 6.8788),
-    (1, 3, '2019-07-19', 6.8812),
-    (1, 3, '2019-07-22', 6.8803),
-    (1, 3, '2019-07-23', 6.8787),
-    (1, 3, '2019-07-24', 6.8717),
-    (1, 3, '2019-07-25', 6.8714),
-    (1, 3, '2019-07-26', 6.8785),
-    (1, 3, '2019-07-29', 6.8927),
-    (1, 3, '2019-07-30', 6.8833),
-    (1, 3, '2019-07-31', 6.8833),
-    (1, 3, '2019-08-01', 6.8972),
-    (1, 3, '2019-08-02', 6.9387),
-    (1, 3, '2019-08-05', 7.0481),
-    (1, 3, '2019-08-06', 7.0192),
-    (1, 3, '2019-08-07', 7.0592),
-    (1, 3, '2019-08-08', 7.044),
-    (1, 3, '2019-08-09', 7.0613),
-    (1, 3, '2019-08-12', 7.0578),
-    (1, 3, '2019-08-13', 7.0423),
-    (1, 3, '2019-08-14', 7.0224),
-    (1, 3, '2019-08-15', 7.0327),
-    (1, 3, '2019-08-16', 7.0413),
-    (1, 3, '2019-08-19', 7.0499),
-    (1, 3, '2019-08-20', 7.0597),
-    (1, 3, '2019-08-21', 7.0623),
-    (1, 3, '2019-08-22', 7.0829),
-    (1, 3, '2019-08-23', 7.0928),
-    (1, 3, '2019-08-26', 7.1503),
-    (1, 3, '2019-08-27', 7.1603),
-    (1, 3, '2019-08-28', 7.1628),
-    (1, 3, '2019-08-29', 7.1434),
-    (1, 3, '2019-08-30', 7.1543),
-    (1, 3, '2019-09-02', NULL),
-    (1, 3, '2019-09-03', 7.1786),
-    (1, 3, '2019-09-04', 7.144),
-    (1, 3, '2019-09-05', 7.1468),
-    (1, 3, '2019-09-06', 7.1131),
-    (1, 3, '2019-09-09', 7.121),
-    (1, 3, '2019-09-10', 7.1121),
-    (1, 3, '2019-09-11', 7.1153),
-    (1, 3, '2019-09-12', 7.0787),
-    (1, 3, '2019-09-13', 7.0754),
-    (1, 3, '2019-09-16', 7.0659),
-    (1, 3, '2019-09-17', 7.0905),
-    (1, 3, '2019-09-18', 7.0849),
-    (1, 3, '2019-09-19', 7.0959),
-    (1, 3, '2019-09-20', 7.0909),
-    (1, 3, '2019-09-23', 7.1168),
-    (1, 3, '2019-09-24', 7.1138),
-    (1, 3, '2019-09-25', 7.1313),
-    (1, 3, '2019-09-26', 7.13),
-    (1, 3, '2019-09-27', 7.1218),
-    (1, 3, '2019-09-30', 7.1477),
-    (1, 3, '2019-10-01', 7.1473),
-    (1, 3, '2019-10-02', 7.1473),
-    (1, 3, '2019-10-03', 7.1473),
-    (1, 3, '2019-10-04', 7.1473),
-    (1, 3, '2019-10-07', 7.1473),
-    (1, 3, '2019-10-08', 7.1422),
-    (1, 3, '2019-10-09', 7.1316),
-    (1, 3, '2019-10-10', 7.1153),
-    (1, 3, '2019-10-11', 7.0868),
-    (1, 3, '2019-10-14', NULL),
-    (1, 3, '2019-10-15', 7.0814),
-    (1, 3, '2019-10-16', 7.092),
-    (1, 3, '2019-10-17', 7.0766),
-    (1, 3, '2019-10-18', 7.0805),
-    (1, 3, '2019-10-21', 7.0748),
-    (1, 3, '2019-10-22', 7.0761),
-    (1, 3, '2019-10-23', 7.0624),
-    (1, 3, '2019-10-24', 7.0684),
-    (1, 3, '2019-10-25', 7.0647),
-    (1, 3, '2019-10-28', 7.067),
-    (1, 3, '2019-10-29', 7.0655),
-    (1, 3, '2019-10-30', 7.0541),
-    (1, 3, '2019-10-31', 7.0379),
-    (1, 3, '2019-11-01', 7.0368),
-    (1, 3, '2019-11-04', 7.03),
-    (1, 3, '2019-11-05', 7.0082),
-    (1, 3, '2019-11-06', 6.9968),
-    (1, 3, '2019-11-07', 6.9766),
-    (1, 3, '2019-11-08', 6.9954),
-    (1, 3, '2019-11-11', NULL),
-    (1, 3, '2019-11-12', 7.0066),
-    (1, 3, '2019-11-13', 7.0223),
-    (1, 3, '2019-11-14', 7.0195),
-    (1, 3, '2019-11-15', 7.0075),
-    (1, 3, '2019-11-18', 7.0246),
-    (1, 3, '2019-11-19', 7.0263),
-    (1, 3, '2019-11-20', 7.0344),
-    (1, 3, '2019-11-21', 7.0285),
-    (1, 3, '2019-11-22', 7.0389),
-    (1, 3, '2019-11-25', 7.0349),
-    (1, 3, '2019-11-26', 7.0319),
-    (1, 3, '2019-11-27', 7.028),
-    (1, 3, '2019-11-28', NULL),
-    (1, 3, '2019-11-29', 7.0308),
-    (1, 3, '2019-12-02', 7.038),
-    (1, 3, '2019-12-03', 7.0609),
-    (1, 3, '2019-12-04', 7.0489),
-    (1, 3, '2019-12-05', 7.0445),
-    (1, 3, '2019-12-06', 7.0348),
-    (1, 3, '2019-12-09', 7.0389),
-    (1, 3, '2019-12-10', 7.0328),
-    (1, 3, '2019-12-11', 7.0384),
-    (1, 3, '2019-12-12', 6.9925),
-    (1, 3, '2019-12-13', 6.9925),
-    (1, 3, '2019-12-16', 6.9921),
-    (1, 3, '2019-12-17', 6.9967),
-    (1, 3, '2019-12-18', 7.0029),
-    (1, 3, '2019-12-19', 7.0097),
-    (1, 3, '2019-12-20', 7.0063),
-    (1, 3, '2019-12-23', 7.0124),
-    (1, 3, '2019-12-24', 7.0064),
-    (1, 3, '2019-12-25', NULL),
-    (1, 3, '2019-12-26', 6.9949),
-    (1, 3, '2019-12-27', 6.9954),
-    (1, 3, '2019-12-30', 6.9864),
-    (1, 3, '2019-12-31', 6.9618),
-    (1, 3, '2020-01-01', NULL),
-    (1, 3, '2020-01-02', 6.9642),
-    (1, 3, '2020-01-03', 6.9649),
-    (1, 3, '2020-01-06', 6.9749),
-    (1, 3, '2020-01-07', 6.9443),
-    (1, 3, '2020-01-08', 6.9447),
-    (1, 3, '2020-01-09', 6.9309),
-    (1, 3, '2020-01-10', 6.9178),
-    (1, 3, '2020-01-13', 6.8924),
-    (1, 3, '2020-01-14', 6.8837),
-    (1, 3, '2020-01-15', 6.8899),
-    (1, 3, '2020-01-16', 6.8769),
-    (1, 3, '2020-01-17', 6.8589),
-    (1, 3, '2020-01-20', NULL),
-    (1, 3, '2020-01-21', 6.9049),
-    (1, 3, '2020-01-22', 6.904),
-    (1, 3, '2020-01-23', 6.9364),
-    (1, 3, '2020-01-24', 6.9161),
-    (1, 3, '2020-01-27', 6.9161),
-    (1, 3, '2020-01-28', 6.9161),
-    (1, 3, '2020-01-29', 6.9161),
-    (1, 3, '2020-01-30', 6.9161),
-    (1, 3, '2020-01-31', 6.9161),
-    (1, 3, '2020-02-03', 7.0208),
-    (1, 3, '2020-02-04', 6.9979),
-    (1, 3, '2020-02-05', 6.972),
-    (1, 3, '2020-02-06', 6.9694),
-    (1, 3, '2020-02-07', 7.0003),
-    (1, 3, '2020-02-10', 6.982),
-    (1, 3, '2020-02-11', 6.965),
-    (1, 3, '2020-02-12', 6.9708),
-    (1, 3, '2020-02-13', 6.9759),
-    (1, 3, '2020-02-14', 6.9859),
-    (1, 3, '2020-02-17', NULL),
-    (1, 3, '2020-02-18', 6.9949),
-    (1, 3, '2020-02-19', 6.9975),
-    (1, 3, '2020-02-20', 7.0225),
-    (1, 3, '2020-02-21', 7.0255),
-    (1, 3, '2020-02-24', 7.0286),
-    (1, 3, '2020-02-25', 7.0128),
-    (1, 3, '2020-02-26', 7.0214),
-    (1, 3, '2020-02-27', 7.0036),
-    (1, 3, '2020-02-28', 6.9906),
-    (1, 3, '2020-03-02', 6.9595),
-    (1, 3, '2020-03-03', 6.9579),
-    (1, 3, '2020-03-04', 6.9244),
-    (1, 3, '2020-03-05', 6.9366),
-    (1, 3, '2020-03-06', 6.9298),
-    (1, 3, '2020-03-09', 6.9448),
-    (1, 3, '2020-03-10', 6.9549),
-    (1, 3, '2020-03-11', 6.9595),
-    (1, 3, '2020-03-12', 7.0282),
-    (1, 3, '2020-03-13', 7.0079),
-    (1, 3, '2020-03-16', 6.9913),
-    (1, 3, '2020-03-17', 7.003),
-    (1, 3, '2020-03-18', 7.0459),
-    (1, 3, '2020-03-19', 7.1058),
-    (1, 3, '2020-03-20', 7.095),
-    (1, 3, '2020-03-23', 7.09),
-    (1, 3, '2020-03-24', 7.061),
-    (1, 3, '2020-03-25', 7.1099),
-    (1, 3, '2020-03-26', 7.0709),
-    (1, 3, '2020-03-27', 7.0942),
-    (1, 3, '2020-03-30', 7.0986),
-    (1, 3, '2020-03-31', 7.0808),
-    (1, 3, '2020-04-01', 7.0989),
-    (1, 3, '2020-04-02', 7.0857),
-    (1, 3, '2020-04-03', 7.0908),
-    (1, 3, '2020-04-06', 7.0895),
-    (1, 3, '2020-04-07', 7.0448),
-    (1, 3, '2020-04-08', 7.0649),
-    (1, 3, '2020-04-09', 7.0428),
-    (1, 3, '2020-04-10', 7.0341),
-    (1, 3, '2020-04-13', 7.0501),
-    (1, 3, '2020-04-14', 7.0479),
-    (1, 3, '2020-04-15', 7.0664),
-    (1, 3, '2020-04-16', 7.0778),
-    (1, 3, '2020-04-17', 7.0711),
-    (1, 3, '2020-04-20', 7.0721),
-    (1, 3, '2020-04-21', 7.0914),
-    (1, 3, '2020-04-22', 7.0831),
-    (1, 3, '2020-04-23', 7.0654),
-    (1, 3, '2020-04-24', 7.0813),
-    (1, 3, '2020-04-27', 7.0848),
-    (1, 3, '2020-04-28', 7.0776),
-    (1, 3, '2020-04-29', 7.0755),
-    (1, 3, '2020-04-30', 7.0622),
-    (1, 3, '2020-05-01', 7.0622),
-    (1, 3, '2020-05-04', 7.0622),
-    (1, 3, '2020-05-05', 7.0622),
-    (1, 3, '2020-05-06', 7.1031),
-    (1, 3, '2020-05-07', 7.0825),
-    (1, 3, '2020-05-08', 7.0732),
-    (1, 3, '2020-05-11', 7.0972),
-    (1, 3, '2020-05-12', 7.0816),
-    (1, 3, '2020-05-13', 7.0911),
-    (1, 3, '2020-05-14', 7.0935),
-    (1, 3, '2020-05-15', 7.1013),
-    (1, 3, '2020-05-18', 7.1086),
-    (1, 3, '2020-05-19', 7.098),
-    (1, 3, '2020-05-20', 7.0928),
-    (1, 3, '2020-05-21', 7.1138),
-    (1, 3, '2020-05-22', 7.1269),
-    (1, 3, '2020-05-25', NULL),
-    (1, 3, '2020-05-26', 7.134),
-    (1, 3, '2020-05-27', 7.1681),
-    (1, 3, '2020-05-28', 7.1447),
-    (1, 3, '2020-05-29', 7.1348),
-    (1, 3, '2020-06-01', 7.1263),
-    (1, 3, '2020-06-02', 7.0992),
-    (1, 3, '2020-06-03', 7.1115),
-    (1, 3, '2020-06-04', 7.1075),
-    (1, 3, '2020-06-05', 7.0808),
-    (1, 3, '2020-06-08', 7.07),
-    (1, 3, '2020-06-09', 7.0765),
-    (1, 3, '2020-06-10', 7.0599),
-    (1, 3, '2020-06-11', 7.064),
-    (1, 3, '2020-06-12', 7.0825),
-    (1, 3, '2020-06-15', 7.0887),
-    (1, 3, '2020-06-16', 7.0854),
-    (1, 3, '2020-06-17', 7.084),
-    (1, 3, '2020-06-18', 7.0869),
-    (1, 3, '2020-06-19', 7.0707),
-    (1, 3, '2020-06-22', 7.0678),
-    (1, 3, '2020-06-23', 7.0575),
-    (1, 3, '2020-06-24', 7.077),
-    (1, 3, '2020-06-25', 7.0768),
-    (1, 3, '2020-06-26', 7.0768),
-    (1, 3, '2020-06-29', 7.0794),
-    (1, 3, '2020-06-30', 7.0651),
-    (1, 3, '2020-07-01', 7.0703),
-    (1, 3, '2020-07-02', 7.066),
-    (1, 3, '2020-07-03', NULL),
-    (1, 3, '2020-07-06', 7.0159),
-    (1, 3, '2020-07-07', 7.0127),
-    (1, 3, '2020-07-08', 7.0041),
-    (1, 3, '2020-07-09', 6.9923),
-    (1, 3, '2020-07-10', 6.999),
-    (1, 3, '2020-07-13', 6.9959),
-    (1, 3, '2020-07-14', 7.006),
-    (1, 3, '2020-07-15', 6.9885),
-    (1, 3, '2020-07-16', 6.9881),
-    (1, 3, '2020-07-17', 6.9912),
-    (1, 3, '2020-07-20', 6.9829),
-    (1, 3, '2020-07-21', 6.98),
-    (1, 3, '2020-07-22', 6.9988),
-    (1, 3, '2020-07-23', 7.0034),
-    (1, 3, '2020-07-24', 7.0168),
-    (1, 3, '2020-07-27', 6.995),
-    (1, 3, '2020-07-28', 6.9998),
-    (1, 3, '2020-07-29', 7.0014),
-    (1, 3, '2020-07-30', 7.008),
-    (1, 3, '2020-07-31', 6.9744),
-    (1, 3, '2020-08-03', 6.9799),
-    (1, 3, '2020-08-04', 6.972),
-    (1, 3, '2020-08-05', 6.9353),
-    (1, 3, '2020-08-06', 6.952),
-    (1, 3, '2020-08-07', 6.967),
-    (1, 3, '2020-08-10', 6.9611),
-    (1, 3, '2020-08-11', 6.9451),
-    (1, 3, '2020-08-12', 6.9363),
-    (1, 3, '2020-08-13', 6.944),
-    (1, 3, '2020-08-14', 6.9501),
-    (1, 3, '2020-08-17', 6.9318),
-    (1, 3, '2020-08-18', 6.9215),
-    (1, 3, '2020-08-19', 6.9192),
-    (1, 3, '2020-08-20', 6.9143),
-    (1, 3, '2020-08-21', 6.9179),
-    (1, 3, '2020-08-24', 6.918),
-    (1, 3, '2020-08-25', 6.9116),
-    (1, 3, '2020-08-26', 6.8855),
-    (1, 3, '2020-08-27', 6.8925),
-    (1, 3, '2020-08-28', 6.8647),
-    (1, 3, '2020-08-31', 6.8474),
-    (1, 3, '2020-09-01', 6.8272),
-    (1, 3, '2020-09-02', 6.8375),
-    (1, 3, '2020-09-03', 6.8474),
-    (1, 3, '2020-09-04', 6.8409),
-    (1, 3, '2020-09-07', NULL),
-    (1, 3, '2020-09-08', 6.8435),
-    (1, 3, '2020-09-09', 6.8308),
-    (1, 3, '2020-09-10', 6.8335),
-    (1, 3, '2020-09-11', 6.833),
-    (1, 3, '2020-09-14', 6.808),
-    (1, 3, '2020-09-15', 6.7819),
-    (1, 3, '2020-09-16', 6.7529),
-    (1, 3, '2020-09-17', 6.7628),
-    (1, 3, '2020-09-18', 6.7675),
-    (1, 3, '2020-09-21', 6.8043),
-    (1, 3, '2020-09-22', 6.7779),
-    (1, 3, '2020-09-23', 6.8089),
-    (1, 3, '2020-09-24', 6.8273),
-    (1, 3, '2020-09-25', 6.822),
-    (1, 3, '2020-09-28', 6.8106),
-    (1, 3, '2020-09-29', 6.815),
-    (1, 3, '2020-09-30', 6.7896),
-    (1, 3, '2020-10-01', 6.7898),
-    (1, 3, '2020-10-02', 6.7898),
-    (1, 3, '2020-10-05', 6.7896),
-    (1, 3, '2020-10-06', 6.7896),
-    (1, 3, '2020-10-07', 6.7896),
-    (1, 3, '2020-10-08', 6.7896),
-    (1, 3, '2020-10-09', 6.6933),
-    (1, 3, '2020-10-12', NULL),
-    (1, 3, '2020-10-13', 6.7452),
-    (1, 3, '2020-10-14', 6.7136),
-    (1, 3, '2020-10-15', 6.7233),
-    (1, 3, '2020-10-16', 6.6962),
-    (1, 3, '2020-10-19', 6.6803),
-    (1, 3, '2020-10-20', 6.6754),
-    (1, 3, '2020-10-21', 6.6503),
-    (1, 3, '2020-10-22', 6.6821),
-    (1, 3, '2020-10-23', 6.6861),
-    (1, 3, '2020-10-26', 6.7119),
-    (1, 3, '2020-10-27', 6.7044),
-    (1, 3, '2020-10-28', 6.7279),
-    (1, 3, '2020-10-29', 6.714),
-    (1, 3, '2020-10-30', 6.6919),
-    (1, 3, '2020-11-02', 6.6899),
-    (1, 3, '2020-11-03', 6.6759),
-    (1, 3, '2020-11-04', 6.6504),
-    (1, 3, '2020-11-05', 6.6027),
-    (1, 3, '2020-11-06', 6.608),
-    (1, 3, '2020-11-09', 6.628),
-    (1, 3, '2020-11-10', 6.6146),
-    (1, 3, '2020-11-11', NULL),
-    (1, 3, '2020-11-12', 6.6114),
-    (1, 3, '2020-11-13', 6.6039),
-    (1, 3, '2020-11-16', 6.583),
-    (1, 3, '2020-11-17', 6.5556),
-    (1, 3, '2020-11-18', 6.5588),
-    (1, 3, '2020-11-19', 6.5823),
-    (1, 3, '2020-11-20', 6.5608),
-    (1, 3, '2020-11-23', 6.585),
-    (1, 3, '2020-11-24', 6.59),
-    (1, 3, '2020-11-25', 6.575),
-    (1, 3, '2020-11-26', NULL),
-    (1, 3, '2020-11-27', NULL),
-    (1, 3, '2020-11-30', 6.576),
-    (1, 3, '2020-12-01', 6.5705),
-    (1, 3, '2020-12-02', 6.5622),
-    (1, 3, '2020-12-03', 6.5418),
-    (1, 3, '2020-12-04', 6.5301),
-    (1, 3, '2020-12-07', 6.5295),
-    (1, 3, '2020-12-08', 6.5307),
-    (1, 3, '2020-12-09', 6.541),
-    (1, 3, '2020-12-10', 6.5419),
-    (1, 3, '2020-12-11', 6.5445),
-    (1, 3, '2020-12-14', 6.548),
-    (1, 3, '2020-12-15', 6.5388),
-    (1, 3, '2020-12-16', 6.5323),
-    (1, 3, '2020-12-17', 6.5321),
-    (1, 3, '2020-12-18', 6.5395),
-    (1, 3, '2020-12-21', 6.5493),
-    (1, 3, '2020-12-22', 6.5426),
-    (1, 3, '2020-12-23', 6.54),
-    (1, 3, '2020-12-24', NULL),
-    (1, 3, '2020-12-25', NULL),
-    (1, 3, '2020-12-28', 6.5346),
-    (1, 3, '2020-12-29', 6.53),
-    (1, 3, '2020-12-30', 6.5208),
-    (1, 3, '2020-12-31', 6.525),
-    (1, 3, '2021-01-01', NULL),
-    (1, 3, '2021-01-04', 6.4601),
-    (1, 3, '2021-01-05', 6.455),
-    (1, 3, '2021-01-06', 6.4617),
-    (1, 3, '2021-01-07', 6.476),
-    (1, 3, '2021-01-08', 6.475),
-    (1, 3, '2021-01-11', 6.4795),
-    (1, 3, '2021-01-12', 6.461),
-    (1, 3, '2021-01-13', 6.4677),
-    (1, 3, '2021-01-14', 6.4736),
-    (1, 3, '2021-01-15', 6.48),
-    (1, 3, '2021-01-18', NULL),
-    (1, 3, '2021-01-19', 6.4777),
-    (1, 3, '2021-01-20', NULL),
-    (1, 3, '2021-01-21', 6.4595),
-    (1, 3, '2021-01-22', 6.481),
-    (1, 3, '2021-01-25', 6.4784),
-    (1, 3, '2021-01-26', 6.4635),
-    (1, 3, '2021-01-27', 6.4822),
-    (1, 3, '2021-01-28', 6.4491),
-    (1, 3, '2021-01-29', 6.4282);
\ No newline at end of file
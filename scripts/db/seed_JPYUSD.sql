@@ -1,1316 +0,0 @@
--- JPY 4
--- USD 1
-INSERT INTO
-    exchange_rates (
-        destination_currency_id,
-        source_currency_id,
-        date,
-        rate
-    )
-VALUES
-    (1, 4, '2016-01-29', 121.0500),
-    (1, 4, '2016-02-01', 121.0600),
-    (1, 4, '2016-02-02', 120.2700),
-    (1, 4, '2016-02-03', 117.9200),
-    (1, 4, '2016-02-04', 116.7500),
-    (1, 4, '2016-02-05', 116.9900),
-    (1, 4, '2016-02-08', 115.7000),
-    (1, 4, '2016-02-09', 114.8000),
-    (1, 4, '2016-02-10', 114.0300),
-    (1, 4, '2016-02-11', 111.7200),
-    (1, 4, '2016-02-12', 112.9600),
-    (1, 4, '2016-02-15', NULL),
-    (1, 4, '2016-02-16', 113.9000),
-    (1, 4, '2016-02-17', 114.1400),
-    (1, 4, '2016-02-18', 113.6200),
-    (1, 4, '2016-02-19', 112.4200),
-    (1, 4, '2016-02-22', 113.1200),
-    (1, 4, '2016-02-23', 112.1700),
-    (1, 4, '2016-02-24', 111.3600),
-    (1, 4, '2016-02-25', 112.7000),
-    (1, 4, '2016-02-26', 113.7800),
-    (1, 4, '2016-02-29', 112.9000),
-    (1, 4, '2016-03-01', 113.9400),
-    (1, 4, '2016-03-02', 113.8100),
-    (1, 4, '2016-03-03', 113.5300),
-    (1, 4, '2016-03-04', 113.9100),
-    (1, 4, '2016-03-07', 113.6000),
-    (1, 4, '2016-03-08', 112.5800),
-    (1, 4, '2016-03-09', 113.0600),
-    (1, 4, '2016-03-10', 113.1000),
-    (1, 4, '2016-03-11', 113.6600),
-    (1, 4, '2016-03-14', 113.5900),
-    (1, 4, '2016-03-15', 112.8800),
-    (1, 4, '2016-03-16', 113.6900),
-    (1, 4, '2016-03-17', 111.3000),
-    (1, 4, '2016-03-18', 111.3800),
-    (1, 4, '2016-03-21', 111.6200),
-    (1, 4, '2016-03-22', 111.8200),
-    (1, 4, '2016-03-23', 112.6300),
-    (1, 4, '2016-03-24', 112.6400),
-    (1, 4, '2016-03-25', 113.0500),
-    (1, 4, '2016-03-28', 113.2900),
-    (1, 4, '2016-03-29', 113.3300),
-    (1, 4, '2016-03-30', 112.6000),
-    (1, 4, '2016-03-31', 112.4200),
-    (1, 4, '2016-04-01', 112.0600),
-    (1, 4, '2016-04-04', 111.1800),
-    (1, 4, '2016-04-05', 110.2600),
-    (1, 4, '2016-04-06', 109.6300),
-    (1, 4, '2016-04-07', 107.9800),
-    (1, 4, '2016-04-08', 108.3600),
-    (1, 4, '2016-04-11', 107.9600),
-    (1, 4, '2016-04-12', 108.5400),
-    (1, 4, '2016-04-13', 109.2100),
-    (1, 4, '2016-04-14', 109.2000),
-    (1, 4, '2016-04-15', 108.7600),
-    (1, 4, '2016-04-18', 108.8500),
-    (1, 4, '2016-04-19', 109.1600),
-    (1, 4, '2016-04-20', 109.5100),
-    (1, 4, '2016-04-21', 109.4100),
-    (1, 4, '2016-04-22', 111.5000),
-    (1, 4, '2016-04-25', 111.0800),
-    (1, 4, '2016-04-26', 111.2300),
-    (1, 4, '2016-04-27', 111.2600),
-    (1, 4, '2016-04-28', 108.5500),
-    (1, 4, '2016-04-29', 106.9000),
-    (1, 4, '2016-05-02', 106.4800),
-    (1, 4, '2016-05-03', 106.3400),
-    (1, 4, '2016-05-04', 107.1200),
-    (1, 4, '2016-05-05', 107.3200),
-    (1, 4, '2016-05-06', 106.6600),
-    (1, 4, '2016-05-09', 108.3900),
-    (1, 4, '2016-05-10', 109.2200),
-    (1, 4, '2016-05-11', 108.4800),
-    (1, 4, '2016-05-12', 108.7300),
-    (1, 4, '2016-05-13', 109.1400),
-    (1, 4, '2016-05-16', 108.8900),
-    (1, 4, '2016-05-17', 109.0200),
-    (1, 4, '2016-05-18', 109.6500),
-    (1, 4, '2016-05-19', 109.8100),
-    (1, 4, '2016-05-20', 110.5200),
-    (1, 4, '2016-05-23', 109.4700),
-    (1, 4, '2016-05-24', 110.0000),
-    (1, 4, '2016-05-25', 110.2200),
-    (1, 4, '2016-05-26', 109.7400),
-    (1, 4, '2016-05-27', 109.8600),
-    (1, 4, '2016-05-30', NULL),
-    (1, 4, '2016-05-31', 110.7500),
-    (1, 4, '2016-06-01', 109.5500),
-    (1, 4, '2016-06-02', 108.7500),
-    (1, 4, '2016-06-03', 106.8800),
-    (1, 4, '2016-06-06', 107.3300),
-    (1, 4, '2016-06-07', 107.3000),
-    (1, 4, '2016-06-08', 106.6800),
-    (1, 4, '2016-06-09', 106.5400),
-    (1, 4, '2016-06-10', 107.0600),
-    (1, 4, '2016-06-13', 106.0700),
-    (1, 4, '2016-06-14', 106.0200),
-    (1, 4, '2016-06-15', 105.8700),
-    (1, 4, '2016-06-16', 104.0500),
-    (1, 4, '2016-06-17', 104.2000),
-    (1, 4, '2016-06-20', 104.3200),
-    (1, 4, '2016-06-21', 104.5600),
-    (1, 4, '2016-06-22', 104.5600),
-    (1, 4, '2016-06-23', 105.9000),
-    (1, 4, '2016-06-24', 102.2600),
-    (1, 4, '2016-06-27', 101.6600),
-    (1, 4, '2016-06-28', 102.7100),
-    (1, 4, '2016-06-29', 102.6800),
-    (1, 4, '2016-06-30', 102.7700),
-    (1, 4, '2016-07-01', 102.5500),
-    (1, 4, '2016-07-04', NULL),
-    (1, 4, '2016-07-05', 101.5800),
-    (1, 4, '2016-07-06', 101.1200),
-    (1, 4, '2016-07-07', 100.7400),
-    (1, 4, '2016-07-08', 100.6500),
-    (1, 4, '2016-07-11', 102.6600),
-    (1, 4, '2016-07-12', 104.7200),
-    (1, 4, '2016-07-13', 104.1000),
-    (1, 4, '2016-07-14', 105.4000),
-    (1, 4, '2016-07-15', 105.6400),
-    (1, 4, '2016-07-18', 106.0000),
-    (1, 4, '2016-07-19', 106.1300),
-    (1, 4, '2016-07-20', 106.6500),
-    (1, 4, '2016-07-21', 105.9800),
-    (1, 4, '2016-07-22', 106.2200),
-    (1, 4, '2016-07-25', 106.0200),
-    (1, 4, '2016-07-26', 104.8300),
-    (1, 4, '2016-07-27', 105.6500),
-    (1, 4, '2016-07-28', 104.8600),
-    (1, 4, '2016-07-29', 102.3200),
-    (1, 4, '2016-08-01', 102.2600),
-    (1, 4, '2016-08-02', 100.8200),
-    (1, 4, '2016-08-03', 101.3400),
-    (1, 4, '2016-08-04', 101.0800),
-    (1, 4, '2016-08-05', 101.7400),
-    (1, 4, '2016-08-08', 102.5300),
-    (1, 4, '2016-08-09', 101.9600),
-    (1, 4, '2016-08-10', 101.2700),
-    (1, 4, '2016-08-11', 101.4600),
-    (1, 4, '2016-08-12', 101.0100),
-    (1, 4, '2016-08-15', 101.0200),
-    (1, 4, '2016-08-16', 100.2800),
-    (1, 4, '2016-08-17', 100.2400),
-    (1, 4, '2016-08-18', 100.0700),
-    (1, 4, '2016-08-19', 100.2400),
-    (1, 4, '2016-08-22', 100.2500),
-    (1, 4, '2016-08-23', 100.2000),
-    (1, 4, '2016-08-24', 100.5000),
-    (1, 4, '2016-08-25', 100.5800),
-    (1, 4, '2016-08-26', 101.2000),
-    (1, 4, '2016-08-29', 102.1300),
-    (1, 4, '2016-08-30', 102.9200),
-    (1, 4, '2016-08-31', 103.3800),
-    (1, 4, '2016-09-01', 103.2200),
-    (1, 4, '2016-09-02', 104.1800),
-    (1, 4, '2016-09-05', NULL),
-    (1, 4, '2016-09-06', 102.2800),
-    (1, 4, '2016-09-07', 101.7200),
-    (1, 4, '2016-09-08', 102.2800),
-    (1, 4, '2016-09-09', 102.8600),
-    (1, 4, '2016-09-12', 101.9600),
-    (1, 4, '2016-09-13', 102.1400),
-    (1, 4, '2016-09-14', 102.3300),
-    (1, 4, '2016-09-15', 102.2400),
-    (1, 4, '2016-09-16', 102.3600),
-    (1, 4, '2016-09-19', 101.8200),
-    (1, 4, '2016-09-20', 101.6600),
-    (1, 4, '2016-09-21', 100.6200),
-    (1, 4, '2016-09-22', 100.8000),
-    (1, 4, '2016-09-23', 101.0400),
-    (1, 4, '2016-09-26', 100.3400),
-    (1, 4, '2016-09-27', 100.3600),
-    (1, 4, '2016-09-28', 100.5700),
-    (1, 4, '2016-09-29', 101.4800),
-    (1, 4, '2016-09-30', 101.2100),
-    (1, 4, '2016-10-03', 101.5400),
-    (1, 4, '2016-10-04', 102.6600),
-    (1, 4, '2016-10-05', 103.5300),
-    (1, 4, '2016-10-06', 104.0200),
-    (1, 4, '2016-10-07', 103.4200),
-    (1, 4, '2016-10-10', NULL),
-    (1, 4, '2016-10-11', 103.3600),
-    (1, 4, '2016-10-12', 104.4800),
-    (1, 4, '2016-10-13', 103.6200),
-    (1, 4, '2016-10-14', 104.1000),
-    (1, 4, '2016-10-17', 103.9600),
-    (1, 4, '2016-10-18', 103.8000),
-    (1, 4, '2016-10-19', 103.3000),
-    (1, 4, '2016-10-20', 103.9200),
-    (1, 4, '2016-10-21', 103.9600),
-    (1, 4, '2016-10-24', 104.2000),
-    (1, 4, '2016-10-25', 104.2500),
-    (1, 4, '2016-10-26', 104.4200),
-    (1, 4, '2016-10-27', 105.1400),
-    (1, 4, '2016-10-28', 105.4000),
-    (1, 4, '2016-10-31', 105.0700),
-    (1, 4, '2016-11-01', 104.5900),
-    (1, 4, '2016-11-02', 103.2200),
-    (1, 4, '2016-11-03', 103.1700),
-    (1, 4, '2016-11-04', 103.0200),
-    (1, 4, '2016-11-07', 104.6000),
-    (1, 4, '2016-11-08', 104.9800),
-    (1, 4, '2016-11-09', 104.8400),
-    (1, 4, '2016-11-10', 106.5600),
-    (1, 4, '2016-11-11', NULL),
-    (1, 4, '2016-11-14', 108.3000),
-    (1, 4, '2016-11-15', 108.9400),
-    (1, 4, '2016-11-16', 109.1600),
-    (1, 4, '2016-11-17', 109.6400),
-    (1, 4, '2016-11-18', 110.5100),
-    (1, 4, '2016-11-21', 111.1200),
-    (1, 4, '2016-11-22', 110.9800),
-    (1, 4, '2016-11-23', 112.5800),
-    (1, 4, '2016-11-24', NULL),
-    (1, 4, '2016-11-25', 113.1700),
-    (1, 4, '2016-11-28', 112.3600),
-    (1, 4, '2016-11-29', 112.7800),
-    (1, 4, '2016-11-30', 114.3400),
-    (1, 4, '2016-12-01', 114.3400),
-    (1, 4, '2016-12-02', 113.7600),
-    (1, 4, '2016-12-05', 114.1000),
-    (1, 4, '2016-12-06', 113.9800),
-    (1, 4, '2016-12-07', 113.5000),
-    (1, 4, '2016-12-08', 114.1600),
-    (1, 4, '2016-12-09', 115.1700),
-    (1, 4, '2016-12-12', 115.3400),
-    (1, 4, '2016-12-13', 115.2600),
-    (1, 4, '2016-12-14', 115.0600),
-    (1, 4, '2016-12-15', 118.3200),
-    (1, 4, '2016-12-16', 117.7400),
-    (1, 4, '2016-12-19', 116.6000),
-    (1, 4, '2016-12-20', 117.8600),
-    (1, 4, '2016-12-21', 117.7900),
-    (1, 4, '2016-12-22', 117.4800),
-    (1, 4, '2016-12-23', 117.2200),
-    (1, 4, '2016-12-26', NULL),
-    (1, 4, '2016-12-27', 117.5200),
-    (1, 4, '2016-12-28', 117.6600),
-    (1, 4, '2016-12-29', 116.3200),
-    (1, 4, '2016-12-30', 116.7800),
-    (1, 4, '2017-01-02', NULL),
-    (1, 4, '2017-01-03', 117.6800),
-    (1, 4, '2017-01-04', 117.3800),
-    (1, 4, '2017-01-05', 115.4600),
-    (1, 4, '2017-01-06', 116.8500),
-    (1, 4, '2017-01-09', 116.0700),
-    (1, 4, '2017-01-10', 115.7400),
-    (1, 4, '2017-01-11', 116.2600),
-    (1, 4, '2017-01-12', 113.8700),
-    (1, 4, '2017-01-13', 115.0300),
-    (1, 4, '2017-01-16', NULL),
-    (1, 4, '2017-01-17', 112.9600),
-    (1, 4, '2017-01-18', 113.4000),
-    (1, 4, '2017-01-19', 115.3000),
-    (1, 4, '2017-01-20', NULL),
-    (1, 4, '2017-01-23', 112.9000),
-    (1, 4, '2017-01-24', 113.6300),
-    (1, 4, '2017-01-25', 113.6000),
-    (1, 4, '2017-01-26', 114.7000),
-    (1, 4, '2017-01-27', 115.1600),
-    (1, 4, '2017-01-30', 113.8600),
-    (1, 4, '2017-01-31', 112.7200),
-    (1, 4, '2017-02-01', 113.2900),
-    (1, 4, '2017-02-02', 112.4800),
-    (1, 4, '2017-02-03', 112.4200),
-    (1, 4, '2017-02-06', 112.3200),
-    (1, 4, '2017-02-07', 112.1400),
-    (1, 4, '2017-02-08', 111.7400),
-    (1, 4, '2017-02-09', 113.2400),
-    (1, 4, '2017-02-10', 113.2200),
-    (1, 4, '2017-02-13', 113.7900),
-    (1, 4, '2017-02-14', 114.3400),
-    (1, 4, '2017-02-15', 114.1700),
-    (1, 4, '2017-02-16', 113.3600),
-    (1, 4, '2017-02-17', 112.8600),
-    (1, 4, '2017-02-20', NULL),
-    (1, 4, '2017-02-21', 113.4800),
-    (1, 4, '2017-02-22', 113.4600),
-    (1, 4, '2017-02-23', 112.6800),
-    (1, 4, '2017-02-24', 112.2600),
-    (1, 4, '2017-02-27', 112.0100),
-    (1, 4, '2017-02-28', 112.0600),
-    (1, 4, '2017-03-01', 113.6600),
-    (1, 4, '2017-03-02', 114.4500),
-    (1, 4, '2017-03-03', 114.6000),
-    (1, 4, '2017-03-06', 113.9200),
-    (1, 4, '2017-03-07', 113.9900),
-    (1, 4, '2017-03-08', 114.5800),
-    (1, 4, '2017-03-09', 114.7100),
-    (1, 4, '2017-03-10', 115.0200),
-    (1, 4, '2017-03-13', 114.6100),
-    (1, 4, '2017-03-14', 114.6300),
-    (1, 4, '2017-03-15', 114.6600),
-    (1, 4, '2017-03-16', 113.0200),
-    (1, 4, '2017-03-17', 112.6700),
-    (1, 4, '2017-03-20', 112.5900),
-    (1, 4, '2017-03-21', 111.9000),
-    (1, 4, '2017-03-22', 111.0600),
-    (1, 4, '2017-03-23', 111.1200),
-    (1, 4, '2017-03-24', 111.0300),
-    (1, 4, '2017-03-27', 110.4800),
-    (1, 4, '2017-03-28', 110.5600),
-    (1, 4, '2017-03-29', 111.0500),
-    (1, 4, '2017-03-30', 111.3600),
-    (1, 4, '2017-03-31', 111.4100),
-    (1, 4, '2017-04-03', 110.9600),
-    (1, 4, '2017-04-04', 110.7400),
-    (1, 4, '2017-04-05', 111.2000),
-    (1, 4, '2017-04-06', 111.0200),
-    (1, 4, '2017-04-07', 110.7600),
-    (1, 4, '2017-04-10', 110.9500),
-    (1, 4, '2017-04-11', 109.7400),
-    (1, 4, '2017-04-12', 109.5400),
-    (1, 4, '2017-04-13', 109.2300),
-    (1, 4, '2017-04-14', 108.6300),
-    (1, 4, '2017-04-17', 108.4600),
-    (1, 4, '2017-04-18', 108.4000),
-    (1, 4, '2017-04-19', 109.0600),
-    (1, 4, '2017-04-20', 109.3400),
-    (1, 4, '2017-04-21', 109.0800),
-    (1, 4, '2017-04-24', 109.7400),
-    (1, 4, '2017-04-25', 110.8800),
-    (1, 4, '2017-04-26', 111.5200),
-    (1, 4, '2017-04-27', 111.1300),
-    (1, 4, '2017-04-28', 111.4400),
-    (1, 4, '2017-05-01', 111.7800),
-    (1, 4, '2017-05-02', 112.0600),
-    (1, 4, '2017-05-03', 112.3400),
-    (1, 4, '2017-05-04', 112.7000),
-    (1, 4, '2017-05-05', 112.6300),
-    (1, 4, '2017-05-08', 112.8400),
-    (1, 4, '2017-05-09', 114.1900),
-    (1, 4, '2017-05-10', 114.0200),
-    (1, 4, '2017-05-11', 113.7700),
-    (1, 4, '2017-05-12', 113.3000),
-    (1, 4, '2017-05-15', 113.5400),
-    (1, 4, '2017-05-16', 113.2400),
-    (1, 4, '2017-05-17', 111.4600),
-    (1, 4, '2017-05-18', 110.8200),
-    (1, 4, '2017-05-19', 111.4700),
-    (1, 4, '2017-05-22', 111.1500),
-    (1, 4, '2017-05-23', 111.6400),
-    (1, 4, '2017-05-24', 112.0200),
-    (1, 4, '2017-05-25', 111.7000),
-    (1, 4, '2017-05-26', 111.3000),
-    (1, 4, '2017-05-29', NULL),
-    (1, 4, '2017-05-30', 110.6800),
-    (1, 4, '2017-05-31', 110.7100),
-    (1, 4, '2017-06-01', 111.2400),
-    (1, 4, '2017-06-02', 110.4900),
-    (1, 4, '2017-06-05', 110.4900),
-    (1, 4, '2017-06-06', 109.3400),
-    (1, 4, '2017-06-07', 109.5400),
-    (1, 4, '2017-06-08', 110.1000),
-    (1, 4, '2017-06-09', 110.6100),
-    (1, 4, '2017-06-12', 109.7200),
-    (1, 4, '2017-06-13', 110.1200),
-    (1, 4, '2017-06-14', 109.1600),
-    (1, 4, '2017-06-15', 110.6600),
-    (1, 4, '2017-06-16', 110.8400),
-    (1, 4, '2017-06-19', 111.3400),
-    (1, 4, '2017-06-20', 111.4600),
-    (1, 4, '2017-06-21', 111.5800),
-    (1, 4, '2017-06-22', 111.3600),
-    (1, 4, '2017-06-23', 111.2600),
-    (1, 4, '2017-06-26', 111.4800),
-    (1, 4, '2017-06-27', 112.4200),
-    (1, 4, '2017-06-28', 112.1800),
-    (1, 4, '2017-06-29', 112.3200),
-    (1, 4, '2017-06-30', 112.4000),
-    (1, 4, '2017-07-03', 113.3200),
-    (1, 4, '2017-07-04', NULL),
-    (1, 4, '2017-07-05', 113.2000),
-    (1, 4, '2017-07-06', 113.2800),
-    (1, 4, '2017-07-07', 114.1000),
-    (1, 4, '2017-07-10', 114.0800),
-    (1, 4, '2017-07-11', 114.2000),
-    (1, 4, '2017-07-12', 113.1900),
-    (1, 4, '2017-07-13', 113.3900),
-    (1, 4, '2017-07-14', 112.6600),
-    (1, 4, '2017-07-17', 112.7600),
-    (1, 4, '2017-07-18', 111.8900),
-    (1, 4, '2017-07-19', 111.7700),
-    (1, 4, '2017-07-20', 111.6800),
-    (1, 4, '2017-07-21', 111.1800),
-    (1, 4, '2017-07-24', 111.1000),
-    (1, 4, '2017-07-25', 111.5700),
-    (1, 4, '2017-07-26', 112.1000),
-    (1, 4, '2017-07-27', 111.6200),
-    (1, 4, '2017-07-28', 110.8700),
-    (1, 4, '2017-07-31', 110.3800),
-    (1, 4, '2017-08-01', 110.3300),
-    (1, 4, '2017-08-02', 110.4300),
-    (1, 4, '2017-08-03', 110.1200),
-    (1, 4, '2017-08-04', 110.7600),
-    (1, 4, '2017-08-07', 110.7600),
-    (1, 4, '2017-08-08', 110.7400),
-    (1, 4, '2017-08-09', 110.0400),
-    (1, 4, '2017-08-10', 109.3700),
-    (1, 4, '2017-08-11', 109.0600),
-    (1, 4, '2017-08-14', 109.4800),
-    (1, 4, '2017-08-15', 110.4200),
-    (1, 4, '2017-08-16', 110.8000),
-    (1, 4, '2017-08-17', 109.8800),
-    (1, 4, '2017-08-18', 109.3200),
-    (1, 4, '2017-08-21', 108.8900),
-    (1, 4, '2017-08-22', 109.4000),
-    (1, 4, '2017-08-23', 109.1400),
-    (1, 4, '2017-08-24', 109.3400),
-    (1, 4, '2017-08-25', 109.2400),
-    (1, 4, '2017-08-28', 109.2200),
-    (1, 4, '2017-08-29', 109.0200),
-    (1, 4, '2017-08-30', 110.2000),
-    (1, 4, '2017-08-31', 110.0600),
-    (1, 4, '2017-09-01', 110.0900),
-    (1, 4, '2017-09-04', NULL),
-    (1, 4, '2017-09-05', 108.9400),
-    (1, 4, '2017-09-06', 108.7500),
-    (1, 4, '2017-09-07', 108.2600),
-    (1, 4, '2017-09-08', 107.7200),
-    (1, 4, '2017-09-11', 109.0300),
-    (1, 4, '2017-09-12', 110.0100),
-    (1, 4, '2017-09-13', 110.5800),
-    (1, 4, '2017-09-14', 110.6600),
-    (1, 4, '2017-09-15', 110.8400),
-    (1, 4, '2017-09-18', 111.4800),
-    (1, 4, '2017-09-19', 111.4900),
-    (1, 4, '2017-09-20', 111.5000),
-    (1, 4, '2017-09-21', 112.3000),
-    (1, 4, '2017-09-22', 112.0100),
-    (1, 4, '2017-09-25', 111.6400),
-    (1, 4, '2017-09-26', 112.1600),
-    (1, 4, '2017-09-27', 112.7600),
-    (1, 4, '2017-09-28', 112.6600),
-    (1, 4, '2017-09-29', 112.6400),
-    (1, 4, '2017-10-02', 112.6000),
-    (1, 4, '2017-10-03', 112.8000),
-    (1, 4, '2017-10-04', 112.8400),
-    (1, 4, '2017-10-05', 112.7400),
-    (1, 4, '2017-10-06', 112.6800),
-    (1, 4, '2017-10-09', NULL),
-    (1, 4, '2017-10-10', 112.1500),
-    (1, 4, '2017-10-11', 112.3500),
-    (1, 4, '2017-10-12', 112.3800),
-    (1, 4, '2017-10-13', 111.9400),
-    (1, 4, '2017-10-16', 111.7200),
-    (1, 4, '2017-10-17', 112.3200),
-    (1, 4, '2017-10-18', 112.9400),
-    (1, 4, '2017-10-19', 112.5400),
-    (1, 4, '2017-10-20', 113.5000),
-    (1, 4, '2017-10-23', 113.6600),
-    (1, 4, '2017-10-24', 113.9200),
-    (1, 4, '2017-10-25', 113.7000),
-    (1, 4, '2017-10-26', 113.7200),
-    (1, 4, '2017-10-27', 113.8200),
-    (1, 4, '2017-10-30', 113.2600),
-    (1, 4, '2017-10-31', 113.6300),
-    (1, 4, '2017-11-01', 114.0400),
-    (1, 4, '2017-11-02', 113.8400),
-    (1, 4, '2017-11-03', 114.2500),
-    (1, 4, '2017-11-06', 113.9000),
-    (1, 4, '2017-11-07', 113.9000),
-    (1, 4, '2017-11-08', 113.6200),
-    (1, 4, '2017-11-09', 113.4000),
-    (1, 4, '2017-11-10', NULL),
-    (1, 4, '2017-11-13', 113.6200),
-    (1, 4, '2017-11-14', 113.5000),
-    (1, 4, '2017-11-15', 113.1500),
-    (1, 4, '2017-11-16', 112.8900),
-    (1, 4, '2017-11-17', 111.9800),
-    (1, 4, '2017-11-20', 112.4900),
-    (1, 4, '2017-11-21', 112.4600),
-    (1, 4, '2017-11-22', 111.6200),
-    (1, 4, '2017-11-23', NULL),
-    (1, 4, '2017-11-24', 111.5400),
-    (1, 4, '2017-11-27', 111.0000),
-    (1, 4, '2017-11-28', 111.1300),
-    (1, 4, '2017-11-29', 111.7500),
-    (1, 4, '2017-11-30', 112.3000),
-    (1, 4, '2017-12-01', 111.8800),
-    (1, 4, '2017-12-04', 112.8000),
-    (1, 4, '2017-12-05', 112.7600),
-    (1, 4, '2017-12-06', 112.3200),
-    (1, 4, '2017-12-07', 112.7000),
-    (1, 4, '2017-12-08', 113.5500),
-    (1, 4, '2017-12-11', 113.3400),
-    (1, 4, '2017-12-12', 113.6200),
-    (1, 4, '2017-12-13', 113.1900),
-    (1, 4, '2017-12-14', 112.5600),
-    (1, 4, '2017-12-15', 112.6800),
-    (1, 4, '2017-12-18', 112.4000),
-    (1, 4, '2017-12-19', 112.9800),
-    (1, 4, '2017-12-20', 113.2900),
-    (1, 4, '2017-12-21', 113.4200),
-    (1, 4, '2017-12-22', 113.3000),
-    (1, 4, '2017-12-25', NULL),
-    (1, 4, '2017-12-26', 113.2000),
-    (1, 4, '2017-12-27', 113.2800),
-    (1, 4, '2017-12-28', 112.8500),
-    (1, 4, '2017-12-29', 112.6900),
-    (1, 4, '2018-01-01', NULL),
-    (1, 4, '2018-01-02', 112.1800),
-    (1, 4, '2018-01-03', 112.2800),
-    (1, 4, '2018-01-04', 112.7800),
-    (1, 4, '2018-01-05', 113.1800),
-    (1, 4, '2018-01-08', 113.0800),
-    (1, 4, '2018-01-09', 112.5700),
-    (1, 4, '2018-01-10', 111.4800),
-    (1, 4, '2018-01-11', 111.3500),
-    (1, 4, '2018-01-12', 111.2300),
-    (1, 4, '2018-01-15', NULL),
-    (1, 4, '2018-01-16', 110.7400),
-    (1, 4, '2018-01-17', 110.8100),
-    (1, 4, '2018-01-18', 110.8800),
-    (1, 4, '2018-01-19', 110.5600),
-    (1, 4, '2018-01-22', 111.1500),
-    (1, 4, '2018-01-23', 110.4600),
-    (1, 4, '2018-01-24', 109.1500),
-    (1, 4, '2018-01-25', 108.7000),
-    (1, 4, '2018-01-26', 108.3800),
-    (1, 4, '2018-01-29', 109.1200),
-    (1, 4, '2018-01-30', 108.9000),
-    (1, 4, '2018-01-31', 109.3100),
-    (1, 4, '2018-02-01', 109.5000),
-    (1, 4, '2018-02-02', 110.4000),
-    (1, 4, '2018-02-05', 110.0900),
-    (1, 4, '2018-02-06', 109.1800),
-    (1, 4, '2018-02-07', 109.5300),
-    (1, 4, '2018-02-08', 109.0000),
-    (1, 4, '2018-02-09', 108.4400),
-    (1, 4, '2018-02-12', 108.7200),
-    (1, 4, '2018-02-13', 107.5900),
-    (1, 4, '2018-02-14', 107.0000),
-    (1, 4, '2018-02-15', 106.3600),
-    (1, 4, '2018-02-16', 106.1000),
-    (1, 4, '2018-02-19', NULL),
-    (1, 4, '2018-02-20', 107.2300),
-    (1, 4, '2018-02-21', 107.6400),
-    (1, 4, '2018-02-22', 106.9600),
-    (1, 4, '2018-02-23', 106.6400),
-    (1, 4, '2018-02-26', 106.96),
-    (1, 4, '2018-02-27', 107.47),
-    (1, 4, '2018-02-28', 106.62),
-    (1, 4, '2018-03-01', 106.91),
-    (1, 4, '2018-03-02', 105.65),
-    (1, 4, '2018-03-05', 105.97),
-    (1, 4, '2018-03-06', 106.03),
-    (1, 4, '2018-03-07', 105.94),
-    (1, 4, '2018-03-08', 106.08),
-    (1, 4, '2018-03-09', 106.74),
-    (1, 4, '2018-03-12', 106.52),
-    (1, 4, '2018-03-13', 106.64),
-    (1, 4, '2018-03-14', 106.17),
-    (1, 4, '2018-03-15', 106.07),
-    (1, 4, '2018-03-16', 106.1),
-    (1, 4, '2018-03-19', 105.83),
-    (1, 4, '2018-03-20', 106.42),
-    (1, 4, '2018-03-21', 106.3),
-    (1, 4, '2018-03-22', 105.34),
-    (1, 4, '2018-03-23', 104.83),
-    (1, 4, '2018-03-26', 105.0),
-    (1, 4, '2018-03-27', 105.72),
-    (1, 4, '2018-03-28', 106.3),
-    (1, 4, '2018-03-29', 106.27),
-    (1, 4, '2018-03-30', 106.2),
-    (1, 4, '2018-04-02', 105.99),
-    (1, 4, '2018-04-03', 106.45),
-    (1, 4, '2018-04-04', 106.56),
-    (1, 4, '2018-04-05', 107.48),
-    (1, 4, '2018-04-06', 107.03),
-    (1, 4, '2018-04-09', 107.06),
-    (1, 4, '2018-04-10', 107.3),
-    (1, 4, '2018-04-11', 106.78),
-    (1, 4, '2018-04-12', 107.3),
-    (1, 4, '2018-04-13', 107.52),
-    (1, 4, '2018-04-16', 107.26),
-    (1, 4, '2018-04-17', 107.09),
-    (1, 4, '2018-04-18', 107.26),
-    (1, 4, '2018-04-19', 107.42),
-    (1, 4, '2018-04-20', 107.6),
-    (1, 4, '2018-04-23', 108.62),
-    (1, 4, '2018-04-24', 109.03),
-    (1, 4, '2018-04-25', 109.33),
-    (1, 4, '2018-04-26', 109.3),
-    (1, 4, '2018-04-27', 109.12),
-    (1, 4, '2018-04-30', 109.28),
-    (1, 4, '2018-05-01', 109.69),
-    (1, 4, '2018-05-02', 109.89),
-    (1, 4, '2018-05-03', 109.06),
-    (1, 4, '2018-05-04', 109.16),
-    (1, 4, '2018-05-07', 109.14),
-    (1, 4, '2018-05-08', 109.16),
-    (1, 4, '2018-05-09', 109.68),
-    (1, 4, '2018-05-10', 109.53),
-    (1, 4, '2018-05-11', 109.26),
-    (1, 4, '2018-05-14', 109.52),
-    (1, 4, '2018-05-15', 110.25),
-    (1, 4, '2018-05-16', 110.22),
-    (1, 4, '2018-05-17', 110.76),
-    (1, 4, '2018-05-18', 110.71),
-    (1, 4, '2018-05-21', 111.08),
-    (1, 4, '2018-05-22', 110.94),
-    (1, 4, '2018-05-23', 110.05),
-    (1, 4, '2018-05-24', 109.3),
-    (1, 4, '2018-05-25', 109.35),
-    (1, 4, '2018-05-28', NULL),
-    (1, 4, '2018-05-29', 108.62),
-    (1, 4, '2018-05-30', 109.04),
-    (1, 4, '2018-05-31', 108.73),
-    (1, 4, '2018-06-01', 109.49),
-    (1, 4, '2018-06-04', 109.66),
-    (1, 4, '2018-06-05', 109.63),
-    (1, 4, '2018-06-06', 110.0),
-    (1, 4, '2018-06-07', 109.92),
-    (1, 4, '2018-06-08', 109.45),
-    (1, 4, '2018-06-11', 109.94),
-    (1, 4, '2018-06-12', 110.22),
-    (1, 4, '2018-06-13', 110.41),
-    (1, 4, '2018-06-14', 110.44),
-    (1, 4, '2018-06-15', 110.58),
-    (1, 4, '2018-06-18', 110.44),
-    (1, 4, '2018-06-19', 110.02),
-    (1, 4, '2018-06-20', 110.14),
-    (1, 4, '2018-06-21', 110.05),
-    (1, 4, '2018-06-22', 109.98),
-    (1, 4, '2018-06-25', 109.62),
-    (1, 4, '2018-06-26', 109.92),
-    (1, 4, '2018-06-27', 110.34),
-    (1, 4, '2018-06-28', 110.38),
-    (1, 4, '2018-06-29', 110.71),
-    (1, 4, '2018-07-02', 110.73),
-    (1, 4, '2018-07-03', 110.54),
-    (1, 4, '2018-07-04', NULL),
-    (1, 4, '2018-07-05', 110.59),
-    (1, 4, '2018-07-06', 110.48),
-    (1, 4, '2018-07-09', 110.78),
-    (1, 4, '2018-07-10', 111.24),
-    (1, 4, '2018-07-11', 111.52),
-    (1, 4, '2018-07-12', 112.41),
-    (1, 4, '2018-07-13', 112.38),
-    (1, 4, '2018-07-16', 112.29),
-    (1, 4, '2018-07-17', 112.79),
-    (1, 4, '2018-07-18', 112.82),
-    (1, 4, '2018-07-19', 112.98),
-    (1, 4, '2018-07-20', 111.74),
-    (1, 4, '2018-07-23', 111.36),
-    (1, 4, '2018-07-24', 111.27),
-    (1, 4, '2018-07-25', 111.02),
-    (1, 4, '2018-07-26', 111.2),
-    (1, 4, '2018-07-27', 110.94),
-    (1, 4, '2018-07-30', 110.98),
-    (1, 4, '2018-07-31', 111.88),
-    (1, 4, '2018-08-01', 111.72),
-    (1, 4, '2018-08-02', 111.48),
-    (1, 4, '2018-08-03', 111.12),
-    (1, 4, '2018-08-06', 111.43),
-    (1, 4, '2018-08-07', 111.19),
-    (1, 4, '2018-08-08', 110.96),
-    (1, 4, '2018-08-09', 110.9),
-    (1, 4, '2018-08-10', 110.66),
-    (1, 4, '2018-08-13', 110.65),
-    (1, 4, '2018-08-14', 110.92),
-    (1, 4, '2018-08-15', 110.62),
-    (1, 4, '2018-08-16', 110.89),
-    (1, 4, '2018-08-17', 110.54),
-    (1, 4, '2018-08-20', 110.38),
-    (1, 4, '2018-08-21', 110.48),
-    (1, 4, '2018-08-22', 110.54),
-    (1, 4, '2018-08-23', 111.14),
-    (1, 4, '2018-08-24', 111.22),
-    (1, 4, '2018-08-27', 111.09),
-    (1, 4, '2018-08-28', 111.04),
-    (1, 4, '2018-08-29', 111.8),
-    (1, 4, '2018-08-30', 111.17),
-    (1, 4, '2018-08-31', 110.98),
-    (1, 4, '2018-09-03', NULL),
-    (1, 4, '2018-09-04', 111.44),
-    (1, 4, '2018-09-05', 111.47),
-    (1, 4, '2018-09-06', 110.87),
-    (1, 4, '2018-09-07', 111.18),
-    (1, 4, '2018-09-10', 111.09),
-    (1, 4, '2018-09-11', 111.54),
-    (1, 4, '2018-09-12', 111.29),
-    (1, 4, '2018-09-13', 111.92),
-    (1, 4, '2018-09-14', 112.09),
-    (1, 4, '2018-09-17', 111.97),
-    (1, 4, '2018-09-18', 112.3),
-    (1, 4, '2018-09-19', 112.21),
-    (1, 4, '2018-09-20', 112.58),
-    (1, 4, '2018-09-21', 112.62),
-    (1, 4, '2018-09-24', 112.62),
-    (1, 4, '2018-09-25', 112.88),
-    (1, 4, '2018-09-26', 112.92),
-    (1, 4, '2018-09-27', 113.38),
-    (1, 4, '2018-09-28', 113.48),
-    (1, 4, '2018-10-01', 113.96),
-    (1, 4, '2018-10-02', 113.66),
-    (1, 4, '2018-10-03', 114.19),
-    (1, 4, '2018-10-04', 113.79),
-    (1, 4, '2018-10-05', 113.56),
-    (1, 4, '2018-10-08', NULL),
-    (1, 4, '2018-10-09', 113.13),
-    (1, 4, '2018-10-10', 112.71),
-    (1, 4, '2018-10-11', 112.26),
-    (1, 4, '2018-10-12', 112.02),
-    (1, 4, '2018-10-15', 111.82),
-    (1, 4, '2018-10-16', 112.16),
-    (1, 4, '2018-10-17', 112.21),
-    (1, 4, '2018-10-18', 112.11),
-    (1, 4, '2018-10-19', 112.52),
-    (1, 4, '2018-10-22', 112.78),
-    (1, 4, '2018-10-23', 112.12),
-    (1, 4, '2018-10-24', 112.58),
-    (1, 4, '2018-10-25', 112.54),
-    (1, 4, '2018-10-26', 111.65),
-    (1, 4, '2018-10-29', 112.49),
-    (1, 4, '2018-10-30', 112.76),
-    (1, 4, '2018-10-31', 112.86),
-    (1, 4, '2018-11-01', 112.79),
-    (1, 4, '2018-11-02', 113.09),
-    (1, 4, '2018-11-05', 113.22),
-    (1, 4, '2018-11-06', 113.38),
-    (1, 4, '2018-11-07', 113.32),
-    (1, 4, '2018-11-08', 113.87),
-    (1, 4, '2018-11-09', 113.77),
-    (1, 4, '2018-11-12', NULL),
-    (1, 4, '2018-11-13', 113.97),
-    (1, 4, '2018-11-14', 113.7),
-    (1, 4, '2018-11-15', 113.46),
-    (1, 4, '2018-11-16', 112.76),
-    (1, 4, '2018-11-19', 112.54),
-    (1, 4, '2018-11-20', 112.66),
-    (1, 4, '2018-11-21', 113.06),
-    (1, 4, '2018-11-22', NULL),
-    (1, 4, '2018-11-23', 112.83),
-    (1, 4, '2018-11-26', 113.58),
-    (1, 4, '2018-11-27', 113.84),
-    (1, 4, '2018-11-28', 113.96),
-    (1, 4, '2018-11-29', 113.42),
-    (1, 4, '2018-11-30', 113.54),
-    (1, 4, '2018-12-03', 113.54),
-    (1, 4, '2018-12-04', 112.93),
-    (1, 4, '2018-12-05', NULL),
-    (1, 4, '2018-12-06', 112.46),
-    (1, 4, '2018-12-07', 112.6),
-    (1, 4, '2018-12-10', 112.96),
-    (1, 4, '2018-12-11', 113.41),
-    (1, 4, '2018-12-12', 113.28),
-    (1, 4, '2018-12-13', 113.66),
-    (1, 4, '2018-12-14', 113.35),
-    (1, 4, '2018-12-17', 112.96),
-    (1, 4, '2018-12-18', 112.54),
-    (1, 4, '2018-12-19', 112.15),
-    (1, 4, '2018-12-20', 111.34),
-    (1, 4, '2018-12-21', 111.14),
-    (1, 4, '2018-12-24', NULL),
-    (1, 4, '2018-12-25', NULL),
-    (1, 4, '2018-12-26', 110.45),
-    (1, 4, '2018-12-27', 110.78),
-    (1, 4, '2018-12-28', 110.34),
-    (1, 4, '2018-12-31', 109.7),
-    (1, 4, '2019-01-01', NULL),
-    (1, 4, '2019-01-02', 109.22),
-    (1, 4, '2019-01-03', 108.07),
-    (1, 4, '2019-01-04', 108.29),
-    (1, 4, '2019-01-07', 108.62),
-    (1, 4, '2019-01-08', 108.57),
-    (1, 4, '2019-01-09', 108.38),
-    (1, 4, '2019-01-10', 108.29),
-    (1, 4, '2019-01-11', 108.34),
-    (1, 4, '2019-01-14', NULL),
-    (1, 4, '2019-01-15', 108.72),
-    (1, 4, '2019-01-16', 108.84),
-    (1, 4, '2019-01-17', 109.01),
-    (1, 4, '2019-01-18', 109.79),
-    (1, 4, '2019-01-21', NULL),
-    (1, 4, '2019-01-22', 109.34),
-    (1, 4, '2019-01-23', 109.44),
-    (1, 4, '2019-01-24', 109.72),
-    (1, 4, '2019-01-25', 109.6),
-    (1, 4, '2019-01-28', 109.2),
-    (1, 4, '2019-01-29', 109.36),
-    (1, 4, '2019-01-30', 109.57),
-    (1, 4, '2019-01-31', 108.84),
-    (1, 4, '2019-02-01', 109.55),
-    (1, 4, '2019-02-04', 109.96),
-    (1, 4, '2019-02-05', 109.99),
-    (1, 4, '2019-02-06', 109.75),
-    (1, 4, '2019-02-07', 109.7),
-    (1, 4, '2019-02-08', 109.76),
-    (1, 4, '2019-02-11', 110.4),
-    (1, 4, '2019-02-12', 110.5),
-    (1, 4, '2019-02-13', 110.86),
-    (1, 4, '2019-02-14', 110.68),
-    (1, 4, '2019-02-15', 110.54),
-    (1, 4, '2019-02-18', NULL),
-    (1, 4, '2019-02-19', 110.68),
-    (1, 4, '2019-02-20', NULL),
-    (1, 4, '2019-02-21', 110.72),
-    (1, 4, '2019-02-22', 110.7),
-    (1, 4, '2019-02-25', 111.07),
-    (1, 4, '2019-02-26', 110.76),
-    (1, 4, '2019-02-27', 110.92),
-    (1, 4, '2019-02-28', 111.38),
-    (1, 4, '2019-03-01', 111.89),
-    (1, 4, '2019-03-04', 111.68),
-    (1, 4, '2019-03-05', 111.98),
-    (1, 4, '2019-03-06', 111.66),
-    (1, 4, '2019-03-07', 111.6),
-    (1, 4, '2019-03-08', 111.11),
-    (1, 4, '2019-03-11', 111.21),
-    (1, 4, '2019-03-12', 111.38),
-    (1, 4, '2019-03-13', 111.23),
-    (1, 4, '2019-03-14', 111.71),
-    (1, 4, '2019-03-15', 111.57),
-    (1, 4, '2019-03-18', 111.41),
-    (1, 4, '2019-03-19', 111.38),
-    (1, 4, '2019-03-20', 111.41),
-    (1, 4, '2019-03-21', 110.86),
-    (1, 4, '2019-03-22', 109.76),
-    (1, 4, '2019-03-25', 110.09),
-    (1, 4, '2019-03-26', 110.5),
-    (1, 4, '2019-03-27', 110.38),
-    (1, 4, '2019-03-28', 110.54),
-    (1, 4, '2019-03-29', 110.68),
-    (1, 4, '2019-04-01', 111.29),
-    (1, 4, '2019-04-02', 111.32),
-    (1, 4, '2019-04-03', 111.48),
-    (1, 4, '2019-04-04', 111.55),
-    (1, 4, '2019-04-05', 111.71),
-    (1, 4, '2019-04-08', 111.47),
-    (1, 4, '2019-04-09', 111.08),
-    (1, 4, '2019-04-10', 110.92),
-    (1, 4, '2019-04-11', 111.48),
-    (1, 4, '2019-04-12', 112.0),
-    (1, 4, '2019-04-15', 112.0),
-    (1, 4, '2019-04-16', 111.95),
-    (1, 4, '2019-04-17', 112.0),
-    (1, 4, '2019-04-18', 111.94),
-    (1, 4, '2019-04-19', 111.92),
-    (1, 4, '2019-04-22', 111.94),
-    (1, 4, '2019-04-23', 111.86),
-    (1, 4, '2019-04-24', 111.81),
-    (1, 4, '2019-04-25', 111.59),
-    (1, 4, '2019-04-26', 111.6),
-    (1, 4, '2019-04-29', 111.8),
-    (1, 4, '2019-04-30', 111.4),
-    (1, 4, '2019-05-01', 111.18),
-    (1, 4, '2019-05-02', 111.39),
-    (1, 4, '2019-05-03', 111.28),
-    (1, 4, '2019-05-06', 110.86),
-    (1, 4, '2019-05-07', 110.34),
-    (1, 4, '2019-05-08', 110.12),
-    (1, 4, '2019-05-09', 109.55),
-    (1, 4, '2019-05-10', 109.56),
-    (1, 4, '2019-05-13', 109.18),
-    (1, 4, '2019-05-14', 109.68),
-    (1, 4, '2019-05-15', 109.58),
-    (1, 4, '2019-05-16', 109.89),
-    (1, 4, '2019-05-17', 109.9),
-    (1, 4, '2019-05-20', 109.96),
-    (1, 4, '2019-05-21', 110.6),
-    (1, 4, '2019-05-22', 110.28),
-    (1, 4, '2019-05-23', 109.58),
-    (1, 4, '2019-05-24', 109.38),
-    (1, 4, '2019-05-27', NULL),
-    (1, 4, '2019-05-28', 109.49),
-    (1, 4, '2019-05-29', 109.24),
-    (1, 4, '2019-05-30', 109.67),
-    (1, 4, '2019-05-31', 108.66),
-    (1, 4, '2019-06-03', 108.39),
-    (1, 4, '2019-06-04', 108.3),
-    (1, 4, '2019-06-05', 108.2),
-    (1, 4, '2019-06-06', 108.08),
-    (1, 4, '2019-06-07', 108.15),
-    (1, 4, '2019-06-10', 108.56),
-    (1, 4, '2019-06-11', 108.52),
-    (1, 4, '2019-06-12', 108.42),
-    (1, 4, '2019-06-13', 108.44),
-    (1, 4, '2019-06-14', 108.44),
-    (1, 4, '2019-06-17', 108.56),
-    (1, 4, '2019-06-18', 108.39),
-    (1, 4, '2019-06-19', 108.36),
-    (1, 4, '2019-06-20', 107.52),
-    (1, 4, '2019-06-21', 107.55),
-    (1, 4, '2019-06-24', 107.36),
-    (1, 4, '2019-06-25', 106.92),
-    (1, 4, '2019-06-26', 107.64),
-    (1, 4, '2019-06-27', 107.73),
-    (1, 4, '2019-06-28', 107.84),
-    (1, 4, '2019-07-01', 108.42),
-    (1, 4, '2019-07-02', 107.98),
-    (1, 4, '2019-07-03', 107.84),
-    (1, 4, '2019-07-04', NULL),
-    (1, 4, '2019-07-05', 108.55),
-    (1, 4, '2019-07-08', 108.72),
-    (1, 4, '2019-07-09', 108.78),
-    (1, 4, '2019-07-10', 108.44),
-    (1, 4, '2019-07-11', 108.29),
-    (1, 4, '2019-07-12', 108.0),
-    (1, 4, '2019-07-15', 107.89),
-    (1, 4, '2019-07-16', 108.35),
-    (1, 4, '2019-07-17', 108.1),
-    (1, 4, '2019-07-18', 107.74),
-    (1, 4, '2019-07-19', 107.78),
-    (1, 4, '2019-07-22', 107.91),
-    (1, 4, '2019-07-23', 108.06),
-    (1, 4, '2019-07-24', 108.08),
-    (1, 4, '2019-07-25', 108.58),
-    (1, 4, '2019-07-26', 108.69),
-    (1, 4, '2019-07-29', 108.86),
-    (1, 4, '2019-07-30', 108.66),
-    (1, 4, '2019-07-31', 108.58),
-    (1, 4, '2019-08-01', 108.28),
-    (1, 4, '2019-08-02', 106.64),
-    (1, 4, '2019-08-05', 106.02),
-    (1, 4, '2019-08-06', 106.45),
-    (1, 4, '2019-08-07', 105.62),
-    (1, 4, '2019-08-08', 106.04),
-    (1, 4, '2019-08-09', 105.3),
-    (1, 4, '2019-08-12', 105.38),
-    (1, 4, '2019-08-13', 106.6),
-    (1, 4, '2019-08-14', 105.79),
-    (1, 4, '2019-08-15', 106.12),
-    (1, 4, '2019-08-16', 106.34),
-    (1, 4, '2019-08-19', 106.5),
-    (1, 4, '2019-08-20', 106.35),
-    (1, 4, '2019-08-21', 106.52),
-    (1, 4, '2019-08-22', 106.44),
-    (1, 4, '2019-08-23', 105.3),
-    (1, 4, '2019-08-26', 106.0),
-    (1, 4, '2019-08-27', 105.8),
-    (1, 4, '2019-08-28', 105.86),
-    (1, 4, '2019-08-29', 106.5),
-    (1, 4, '2019-08-30', 106.3),
-    (1, 4, '2019-09-02', NULL),
-    (1, 4, '2019-09-03', 105.88),
-    (1, 4, '2019-09-04', 106.28),
-    (1, 4, '2019-09-05', 106.96),
-    (1, 4, '2019-09-06', 106.8),
-    (1, 4, '2019-09-09', 107.14),
-    (1, 4, '2019-09-10', 107.34),
-    (1, 4, '2019-09-11', 107.73),
-    (1, 4, '2019-09-12', 107.97),
-    (1, 4, '2019-09-13', 108.12),
-    (1, 4, '2019-09-16', 107.94),
-    (1, 4, '2019-09-17', 108.12),
-    (1, 4, '2019-09-18', 108.17),
-    (1, 4, '2019-09-19', 108.0),
-    (1, 4, '2019-09-20', 107.95),
-    (1, 4, '2019-09-23', 107.47),
-    (1, 4, '2019-09-24', 107.32),
-    (1, 4, '2019-09-25', 107.78),
-    (1, 4, '2019-09-26', 107.67),
-    (1, 4, '2019-09-27', 108.05),
-    (1, 4, '2019-09-30', 108.11),
-    (1, 4, '2019-10-01', 107.7),
-    (1, 4, '2019-10-02', 107.21),
-    (1, 4, '2019-10-03', 106.76),
-    (1, 4, '2019-10-04', 106.91),
-    (1, 4, '2019-10-07', 107.06),
-    (1, 4, '2019-10-08', 107.06),
-    (1, 4, '2019-10-09', 107.43),
-    (1, 4, '2019-10-10', 107.91),
-    (1, 4, '2019-10-11', 108.52),
-    (1, 4, '2019-10-14', NULL),
-    (1, 4, '2019-10-15', 108.83),
-    (1, 4, '2019-10-16', 108.71),
-    (1, 4, '2019-10-17', 108.51),
-    (1, 4, '2019-10-18', 108.44),
-    (1, 4, '2019-10-21', 108.57),
-    (1, 4, '2019-10-22', 108.56),
-    (1, 4, '2019-10-23', 108.64),
-    (1, 4, '2019-10-24', 108.62),
-    (1, 4, '2019-10-25', 108.75),
-    (1, 4, '2019-10-28', 109.02),
-    (1, 4, '2019-10-29', 108.83),
-    (1, 4, '2019-10-30', 108.88),
-    (1, 4, '2019-10-31', 108.09),
-    (1, 4, '2019-11-01', 108.16),
-    (1, 4, '2019-11-04', 108.56),
-    (1, 4, '2019-11-05', 109.13),
-    (1, 4, '2019-11-06', 108.86),
-    (1, 4, '2019-11-07', 109.42),
-    (1, 4, '2019-11-08', 109.14),
-    (1, 4, '2019-11-11', NULL),
-    (1, 4, '2019-11-12', 109.09),
-    (1, 4, '2019-11-13', 108.83),
-    (1, 4, '2019-11-14', 108.33),
-    (1, 4, '2019-11-15', 108.8),
-    (1, 4, '2019-11-18', 108.61),
-    (1, 4, '2019-11-19', 108.59),
-    (1, 4, '2019-11-20', 108.67),
-    (1, 4, '2019-11-21', 108.53),
-    (1, 4, '2019-11-22', 108.67),
-    (1, 4, '2019-11-25', 108.95),
-    (1, 4, '2019-11-26', 109.11),
-    (1, 4, '2019-11-27', 109.38),
-    (1, 4, '2019-11-28', NULL),
-    (1, 4, '2019-11-29', 109.47),
-    (1, 4, '2019-12-02', 109.09),
-    (1, 4, '2019-12-03', 108.53),
-    (1, 4, '2019-12-04', 108.87),
-    (1, 4, '2019-12-05', 108.69),
-    (1, 4, '2019-12-06', 108.66),
-    (1, 4, '2019-12-09', 108.59),
-    (1, 4, '2019-12-10', 108.73),
-    (1, 4, '2019-12-11', 108.67),
-    (1, 4, '2019-12-12', 109.16),
-    (1, 4, '2019-12-13', 109.28),
-    (1, 4, '2019-12-16', 109.64),
-    (1, 4, '2019-12-17', 109.52),
-    (1, 4, '2019-12-18', 109.58),
-    (1, 4, '2019-12-19', 109.23),
-    (1, 4, '2019-12-20', 109.45),
-    (1, 4, '2019-12-23', 109.39),
-    (1, 4, '2019-12-24', 109.38),
-    (1, 4, '2019-12-25', NULL),
-    (1, 4, '2019-12-26', 109.67),
-    (1, 4, '2019-12-27', 109.47),
-    (1, 4, '2019-12-30', 108.85),
-    (1, 4, '2019-12-31', 108.67),
-    (1, 4, '2020-01-01', NULL),
-    (1, 4, '2020-01-02', 108.43),
-    (1, 4, '2020-01-03', 107.94),
-    (1, 4, '2020-01-06', 108.36),
-    (1, 4, '2020-01-07', 108.53),
-    (1, 4, '2020-01-08', 109.03),
-    (1, 4, '2020-01-09', 109.47),
-    (1, 4, '2020-01-10', 109.5),
-    (1, 4, '2020-01-13', 109.93),
-    (1, 4, '2020-01-14', 110.05),
-    (1, 4, '2020-01-15', 109.94),
-    (1, 4, '2020-01-16', 110.16),
-    (1, 4, '2020-01-17', 110.16),
-    (1, 4, '2020-01-20', NULL),
-    (1, 4, '2020-01-21', 109.95),
-    (1, 4, '2020-01-22', 109.93),
-    (1, 4, '2020-01-23', 109.39),
-    (1, 4, '2020-01-24', 109.31),
-    (1, 4, '2020-01-27', 108.95),
-    (1, 4, '2020-01-28', 109.17),
-    (1, 4, '2020-01-29', 109.15),
-    (1, 4, '2020-01-30', 108.75),
-    (1, 4, '2020-01-31', 108.5),
-    (1, 4, '2020-02-03', 108.59),
-    (1, 4, '2020-02-04', 109.41),
-    (1, 4, '2020-02-05', 109.74),
-    (1, 4, '2020-02-06', 109.89),
-    (1, 4, '2020-02-07', 109.81),
-    (1, 4, '2020-02-10', 109.77),
-    (1, 4, '2020-02-11', 109.78),
-    (1, 4, '2020-02-12', 110.0),
-    (1, 4, '2020-02-13', 109.81),
-    (1, 4, '2020-02-14', 109.77),
-    (1, 4, '2020-02-17', NULL),
-    (1, 4, '2020-02-18', 109.8),
-    (1, 4, '2020-02-19', 111.17),
-    (1, 4, '2020-02-20', 111.86),
-    (1, 4, '2020-02-21', 111.74),
-    (1, 4, '2020-02-24', 110.56),
-    (1, 4, '2020-02-25', 110.23),
-    (1, 4, '2020-02-26', 110.36),
-    (1, 4, '2020-02-27', 110.15),
-    (1, 4, '2020-02-28', 108.12),
-    (1, 4, '2020-03-02', 108.02),
-    (1, 4, '2020-03-03', 107.28),
-    (1, 4, '2020-03-04', 107.34),
-    (1, 4, '2020-03-05', 106.56),
-    (1, 4, '2020-03-06', 105.31),
-    (1, 4, '2020-03-09', 102.52),
-    (1, 4, '2020-03-10', 103.71),
-    (1, 4, '2020-03-11', 104.81),
-    (1, 4, '2020-03-12', 105.67),
-    (1, 4, '2020-03-13', 107.15),
-    (1, 4, '2020-03-16', 105.64),
-    (1, 4, '2020-03-17', 107.41),
-    (1, 4, '2020-03-18', 108.45),
-    (1, 4, '2020-03-19', 110.08),
-    (1, 4, '2020-03-20', 111.3),
-    (1, 4, '2020-03-23', 111.36),
-    (1, 4, '2020-03-24', 111.44),
-    (1, 4, '2020-03-25', 111.43),
-    (1, 4, '2020-03-26', 109.45),
-    (1, 4, '2020-03-27', 108.16),
-    (1, 4, '2020-03-30', 108.06),
-    (1, 4, '2020-03-31', 107.53),
-    (1, 4, '2020-04-01', 107.05),
-    (1, 4, '2020-04-02', 107.85),
-    (1, 4, '2020-04-03', 108.53),
-    (1, 4, '2020-04-06', 109.11),
-    (1, 4, '2020-04-07', 108.97),
-    (1, 4, '2020-04-08', 108.7),
-    (1, 4, '2020-04-09', 108.38),
-    (1, 4, '2020-04-10', 108.39),
-    (1, 4, '2020-04-13', 107.59),
-    (1, 4, '2020-04-14', 107.08),
-    (1, 4, '2020-04-15', 107.42),
-    (1, 4, '2020-04-16', 107.72),
-    (1, 4, '2020-04-17', 107.52),
-    (1, 4, '2020-04-20', 107.69),
-    (1, 4, '2020-04-21', 107.69),
-    (1, 4, '2020-04-22', 107.81),
-    (1, 4, '2020-04-23', 107.65),
-    (1, 4, '2020-04-24', 107.44),
-    (1, 4, '2020-04-27', 107.22),
-    (1, 4, '2020-04-28', 106.83),
-    (1, 4, '2020-04-29', 106.67),
-    (1, 4, '2020-04-30', 106.94),
-    (1, 4, '2020-05-01', 106.76),
-    (1, 4, '2020-05-04', 106.82),
-    (1, 4, '2020-05-05', 106.52),
-    (1, 4, '2020-05-06', 106.07),
-    (1, 4, '2020-05-07', 106.36),
-    (1, 4, '2020-05-08', 106.5),
-    (1, 4, '2020-05-11', 107.7),
-    (1, 4, '2020-05-12', 107.33),
-    (1, 4, '2020-05-13', 106.92),
-    (1, 4, '2020-05-14', 107.09),
-    (1, 4, '2020-05-15', 107.27),
-    (1, 4, '2020-05-18', 107.25),
-    (1, 4, '2020-05-19', 107.89),
-    (1, 4, '2020-05-20', 107.46),
-    (1, 4, '2020-05-21', 107.69),
-    (1, 4, '2020-05-22', 107.5),
-    (1, 4, '2020-05-25', NULL),
-    (1, 4, '2020-05-26', 107.64),
-    (1, 4, '2020-05-27', 107.79),
-    (1, 4, '2020-05-28', 107.67),
-    (1, 4, '2020-05-29', 107.77),
-    (1, 4, '2020-06-01', 107.56),
-    (1, 4, '2020-06-02', 108.6),
-    (1, 4, '2020-06-03', 108.88),
-    (1, 4, '2020-06-04', 109.0),
-    (1, 4, '2020-06-05', 109.68),
-    (1, 4, '2020-06-08', 108.46),
-    (1, 4, '2020-06-09', 107.66),
-    (1, 4, '2020-06-10', 107.25),
-    (1, 4, '2020-06-11', 106.67),
-    (1, 4, '2020-06-12', 107.36),
-    (1, 4, '2020-06-15', 107.33),
-    (1, 4, '2020-06-16', 107.35),
-    (1, 4, '2020-06-17', 107.26),
-    (1, 4, '2020-06-18', 106.78),
-    (1, 4, '2020-06-19', 106.89),
-    (1, 4, '2020-06-22', 106.84),
-    (1, 4, '2020-06-23', 106.44),
-    (1, 4, '2020-06-24', 106.83),
-    (1, 4, '2020-06-25', 107.21),
-    (1, 4, '2020-06-26', 107.19),
-    (1, 4, '2020-06-29', 107.71),
-    (1, 4, '2020-06-30', 107.77),
-    (1, 4, '2020-07-01', 107.5),
-    (1, 4, '2020-07-02', 107.55),
-    (1, 4, '2020-07-03', NULL),
-    (1, 4, '2020-07-06', 107.5),
-    (1, 4, '2020-07-07', 107.53),
-    (1, 4, '2020-07-08', 107.39),
-    (1, 4, '2020-07-09', 107.27),
-    (1, 4, '2020-07-10', 106.77),
-    (1, 4, '2020-07-13', 107.22),
-    (1, 4, '2020-07-14', 107.2),
-    (1, 4, '2020-07-15', 106.92),
-    (1, 4, '2020-07-16', 107.05),
-    (1, 4, '2020-07-17', 107.06),
-    (1, 4, '2020-07-20', 107.21),
-    (1, 4, '2020-07-21', 106.82),
-    (1, 4, '2020-07-22', 107.18),
-    (1, 4, '2020-07-23', 106.83),
-    (1, 4, '2020-07-24', 105.79),
-    (1, 4, '2020-07-27', 105.29),
-    (1, 4, '2020-07-28', 105.03),
-    (1, 4, '2020-07-29', 105.06),
-    (1, 4, '2020-07-30', 105.05),
-    (1, 4, '2020-07-31', 105.78),
-    (1, 4, '2020-08-03', 106.11),
-    (1, 4, '2020-08-04', 105.87),
-    (1, 4, '2020-08-05', 105.44),
-    (1, 4, '2020-08-06', 105.52),
-    (1, 4, '2020-08-07', 105.92),
-    (1, 4, '2020-08-10', 105.85),
-    (1, 4, '2020-08-11', 106.56),
-    (1, 4, '2020-08-12', 106.85),
-    (1, 4, '2020-08-13', 106.89),
-    (1, 4, '2020-08-14', 106.49),
-    (1, 4, '2020-08-17', 105.97),
-    (1, 4, '2020-08-18', 105.41),
-    (1, 4, '2020-08-19', 105.71),
-    (1, 4, '2020-08-20', 105.81),
-    (1, 4, '2020-08-21', 105.88),
-    (1, 4, '2020-08-24', 105.9),
-    (1, 4, '2020-08-25', 106.4),
-    (1, 4, '2020-08-26', 106.13),
-    (1, 4, '2020-08-27', 106.42),
-    (1, 4, '2020-08-28', 105.3),
-    (1, 4, '2020-08-31', 105.84),
-    (1, 4, '2020-09-01', 106.0),
-    (1, 4, '2020-09-02', 106.15),
-    (1, 4, '2020-09-03', 106.14),
-    (1, 4, '2020-09-04', 106.34),
-    (1, 4, '2020-09-07', NULL),
-    (1, 4, '2020-09-08', 105.97),
-    (1, 4, '2020-09-09', 106.23),
-    (1, 4, '2020-09-10', 106.19),
-    (1, 4, '2020-09-11', 106.17),
-    (1, 4, '2020-09-14', 105.68),
-    (1, 4, '2020-09-15', 105.5),
-    (1, 4, '2020-09-16', 104.94),
-    (1, 4, '2020-09-17', 104.77),
-    (1, 4, '2020-09-18', 104.44),
-    (1, 4, '2020-09-21', 104.69),
-    (1, 4, '2020-09-22', 105.05),
-    (1, 4, '2020-09-23', 105.34),
-    (1, 4, '2020-09-24', 105.42),
-    (1, 4, '2020-09-25', 105.59),
-    (1, 4, '2020-09-28', 105.5),
-    (1, 4, '2020-09-29', 105.68),
-    (1, 4, '2020-09-30', 105.58),
-    (1, 4, '2020-10-01', 105.53),
-    (1, 4, '2020-10-02', 105.36),
-    (1, 4, '2020-10-05', 105.7),
-    (1, 4, '2020-10-06', 105.64),
-    (1, 4, '2020-10-07', 105.97),
-    (1, 4, '2020-10-08', 105.98),
-    (1, 4, '2020-10-09', 105.72),
-    (1, 4, '2020-10-12', NULL),
-    (1, 4, '2020-10-13', 105.55),
-    (1, 4, '2020-10-14', 105.08),
-    (1, 4, '2020-10-15', 105.38),
-    (1, 4, '2020-10-16', 105.41),
-    (1, 4, '2020-10-19', 105.42),
-    (1, 4, '2020-10-20', 105.56),
-    (1, 4, '2020-10-21', 104.58),
-    (1, 4, '2020-10-22', 104.88),
-    (1, 4, '2020-10-23', 104.78),
-    (1, 4, '2020-10-26', 104.86),
-    (1, 4, '2020-10-27', 104.44),
-    (1, 4, '2020-10-28', 104.33),
-    (1, 4, '2020-10-29', 104.69),
-    (1, 4, '2020-10-30', 104.54),
-    (1, 4, '2020-11-02', 104.8),
-    (1, 4, '2020-11-03', 104.5),
-    (1, 4, '2020-11-04', 104.39),
-    (1, 4, '2020-11-05', 103.67),
-    (1, 4, '2020-11-06', 103.32),
-    (1, 4, '2020-11-09', 105.58),
-    (1, 4, '2020-11-10', 105.36),
-    (1, 4, '2020-11-11', NULL),
-    (1, 4, '2020-11-12', 105.16),
-    (1, 4, '2020-11-13', 104.61),
-    (1, 4, '2020-11-16', 104.54),
-    (1, 4, '2020-11-17', 104.2),
-    (1, 4, '2020-11-18', 103.72),
-    (1, 4, '2020-11-19', 103.85),
-    (1, 4, '2020-11-20', 103.81),
-    (1, 4, '2020-11-23', 104.42),
-    (1, 4, '2020-11-24', 104.61),
-    (1, 4, '2020-11-25', 104.39),
-    (1, 4, '2020-11-26', NULL),
-    (1, 4, '2020-11-27', NULL),
-    (1, 4, '2020-11-30', 104.38),
-    (1, 4, '2020-12-01', 104.4),
-    (1, 4, '2020-12-02', 104.52),
-    (1, 4, '2020-12-03', 103.77),
-    (1, 4, '2020-12-04', 104.16),
-    (1, 4, '2020-12-07', 104.01),
-    (1, 4, '2020-12-08', 104.14),
-    (1, 4, '2020-12-09', 104.28),
-    (1, 4, '2020-12-10', 104.3),
-    (1, 4, '2020-12-11', 103.88),
-    (1, 4, '2020-12-14', 104.06),
-    (1, 4, '2020-12-15', 103.69),
-    (1, 4, '2020-12-16', 103.59),
-    (1, 4, '2020-12-17', 103.13),
-    (1, 4, '2020-12-18', 103.35),
-    (1, 4, '2020-12-21', 103.44),
-    (1, 4, '2020-12-22', 103.62),
-    (1, 4, '2020-12-23', 103.52),
-    (1, 4, '2020-12-24', NULL),
-    (1, 4, '2020-12-25', NULL),
-    (1, 4, '2020-12-28', 103.84),
-    (1, 4, '2020-12-29', 103.5),
-    (1, 4, '2020-12-30', 103.31),
-    (1, 4, '2020-12-31', 103.19),
-    (1, 4, '2021-01-01', NULL),
-    (1, 4, '2021-01-04', 103.19),
-    (1, 4, '2021-01-05', 102.7),
-    (1, 4, '2021-01-06', 103.25),
-    (1, 4, '2021-01-07', 103.84),
-    (1, 4, '2021-01-08', 103.89),
-    (1, 4, '2021-01-11', 104.16),
-    (1, 4, '2021-01-12', 104.09),
-    (1, 4, '2021-01-13', 103.91),
-    (1, 4, '2021-01-14', 103.66),
-    (1, 4, '2021-01-15', 103.8),
-    (1, 4, '2021-01-18', NULL),
-    (1, 4, '2021-01-19', 103.86),
-    (1, 4, '2021-01-20', NULL),
-    (1, 4, '2021-01-21', 103.57),
-    (1, 4, '2021-01-22', 103.76),
-    (1, 4, '2021-01-25', 103.78),
-    (1, 4, '2021-01-26', 103.69),
-    (1, 4, '2021-01-27', 104.09),
-    (1, 4, '2021-01-28', 104.31),
-    (1, 4, '2021-01-29', 104.64);
\ No newline at end of file
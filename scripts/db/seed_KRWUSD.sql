@@ -1,1316 +0,0 @@
--- KRW 5
--- USD 1
-INSERT INTO
-    exchange_rates (
-        destination_currency_id,
-        source_currency_id,
-        date,
-        rate
-    )
-VALUES
-    (1, 5, '2016-01-29', 1210.0400),
-    (1, 5, '2016-02-01', 1203.1900),
-    (1, 5, '2016-02-02', 1212.0100),
-    (1, 5, '2016-02-03', 1211.3300),
-    (1, 5, '2016-02-04', 1186.1100),
-    (1, 5, '2016-02-05', 1204.6900),
-    (1, 5, '2016-02-08', 1205.1000),
-    (1, 5, '2016-02-09', 1200.3900),
-    (1, 5, '2016-02-10', 1189.6900),
-    (1, 5, '2016-02-11', 1196.8900),
-    (1, 5, '2016-02-12', 1205.1300),
-    (1, 5, '2016-02-15', NULL),
-    (1, 5, '2016-02-16', 1219.4400),
-    (1, 5, '2016-02-17', 1222.3200),
-    (1, 5, '2016-02-18', 1231.1500),
-    (1, 5, '2016-02-19', 1231.0900),
-    (1, 5, '2016-02-22', 1223.3200),
-    (1, 5, '2016-02-23', 1230.0500),
-    (1, 5, '2016-02-24', 1234.6000),
-    (1, 5, '2016-02-25', 1237.3900),
-    (1, 5, '2016-02-26', 1242.5900),
-    (1, 5, '2016-02-29', 1238.0900),
-    (1, 5, '2016-03-01', 1229.5600),
-    (1, 5, '2016-03-02', 1227.5900),
-    (1, 5, '2016-03-03', 1209.5500),
-    (1, 5, '2016-03-04', 1195.0900),
-    (1, 5, '2016-03-07', 1200.0800),
-    (1, 5, '2016-03-08', 1206.1900),
-    (1, 5, '2016-03-09', 1206.8200),
-    (1, 5, '2016-03-10', 1204.0900),
-    (1, 5, '2016-03-11', 1184.5600),
-    (1, 5, '2016-03-14', 1187.3900),
-    (1, 5, '2016-03-15', 1194.9900),
-    (1, 5, '2016-03-16', 1190.5900),
-    (1, 5, '2016-03-17', 1155.8300),
-    (1, 5, '2016-03-18', 1160.1100),
-    (1, 5, '2016-03-21', 1157.3300),
-    (1, 5, '2016-03-22', 1154.5800),
-    (1, 5, '2016-03-23', 1166.5900),
-    (1, 5, '2016-03-24', 1168.0900),
-    (1, 5, '2016-03-25', 1168.1900),
-    (1, 5, '2016-03-28', 1163.3900),
-    (1, 5, '2016-03-29', 1164.6900),
-    (1, 5, '2016-03-30', 1142.0200),
-    (1, 5, '2016-03-31', 1138.8600),
-    (1, 5, '2016-04-01', 1147.1500),
-    (1, 5, '2016-04-04', 1151.3500),
-    (1, 5, '2016-04-05', 1158.3900),
-    (1, 5, '2016-04-06', 1155.2800),
-    (1, 5, '2016-04-07', 1156.8100),
-    (1, 5, '2016-04-08', 1148.8900),
-    (1, 5, '2016-04-11', 1141.9700),
-    (1, 5, '2016-04-12', 1144.5300),
-    (1, 5, '2016-04-13', 1146.2900),
-    (1, 5, '2016-04-14', 1151.9900),
-    (1, 5, '2016-04-15', 1144.8300),
-    (1, 5, '2016-04-18', 1144.4800),
-    (1, 5, '2016-04-19', 1126.0100),
-    (1, 5, '2016-04-20', 1130.4800),
-    (1, 5, '2016-04-21', 1135.4900),
-    (1, 5, '2016-04-22', 1147.8500),
-    (1, 5, '2016-04-25', 1147.7600),
-    (1, 5, '2016-04-26', 1146.6600),
-    (1, 5, '2016-04-27', 1151.5000),
-    (1, 5, '2016-04-28', 1139.9300),
-    (1, 5, '2016-04-29', 1144.0900),
-    (1, 5, '2016-05-02', 1138.4500),
-    (1, 5, '2016-05-03', 1148.6900),
-    (1, 5, '2016-05-04', 1164.5900),
-    (1, 5, '2016-05-05', 1165.9300),
-    (1, 5, '2016-05-06', 1167.2900),
-    (1, 5, '2016-05-09', 1174.2500),
-    (1, 5, '2016-05-10', 1169.8900),
-    (1, 5, '2016-05-11', 1162.4700),
-    (1, 5, '2016-05-12', 1168.7000),
-    (1, 5, '2016-05-13', 1176.8500),
-    (1, 5, '2016-05-16', 1177.2100),
-    (1, 5, '2016-05-17', 1173.6700),
-    (1, 5, '2016-05-18', 1185.7700),
-    (1, 5, '2016-05-19', 1189.2500),
-    (1, 5, '2016-05-20', 1186.9300),
-    (1, 5, '2016-05-23', 1183.3900),
-    (1, 5, '2016-05-24', 1186.3900),
-    (1, 5, '2016-05-25', 1182.2100),
-    (1, 5, '2016-05-26', 1178.0900),
-    (1, 5, '2016-05-27', 1180.5900),
-    (1, 5, '2016-05-30', NULL),
-    (1, 5, '2016-05-31', 1188.9900),
-    (1, 5, '2016-06-01', 1192.0900),
-    (1, 5, '2016-06-02', 1186.6900),
-    (1, 5, '2016-06-03', 1167.7900),
-    (1, 5, '2016-06-06', 1162.2800),
-    (1, 5, '2016-06-07', 1154.8000),
-    (1, 5, '2016-06-08', 1153.8300),
-    (1, 5, '2016-06-09', 1159.2900),
-    (1, 5, '2016-06-10', 1167.6100),
-    (1, 5, '2016-06-13', 1169.7400),
-    (1, 5, '2016-06-14', 1176.7800),
-    (1, 5, '2016-06-15', 1167.5300),
-    (1, 5, '2016-06-16', 1176.4900),
-    (1, 5, '2016-06-17', 1171.7700),
-    (1, 5, '2016-06-20', 1156.8000),
-    (1, 5, '2016-06-21', 1154.3500),
-    (1, 5, '2016-06-22', 1147.9900),
-    (1, 5, '2016-06-23', 1143.2700),
-    (1, 5, '2016-06-24', 1167.5600),
-    (1, 5, '2016-06-27', 1181.2400),
-    (1, 5, '2016-06-28', 1169.3100),
-    (1, 5, '2016-06-29', 1153.0900),
-    (1, 5, '2016-06-30', 1154.1500),
-    (1, 5, '2016-07-01', 1146.4900),
-    (1, 5, '2016-07-04', NULL),
-    (1, 5, '2016-07-05', 1156.7900),
-    (1, 5, '2016-07-06', 1160.5900),
-    (1, 5, '2016-07-07', 1154.8900),
-    (1, 5, '2016-07-08', 1148.7100),
-    (1, 5, '2016-07-11', 1148.9700),
-    (1, 5, '2016-07-12', 1146.4700),
-    (1, 5, '2016-07-13', 1145.0100),
-    (1, 5, '2016-07-14', 1130.6500),
-    (1, 5, '2016-07-15', 1135.4900),
-    (1, 5, '2016-07-18', 1134.3900),
-    (1, 5, '2016-07-19', 1140.5600),
-    (1, 5, '2016-07-20', 1142.3300),
-    (1, 5, '2016-07-21', 1137.3100),
-    (1, 5, '2016-07-22', 1137.4900),
-    (1, 5, '2016-07-25', 1140.8000),
-    (1, 5, '2016-07-26', 1137.5900),
-    (1, 5, '2016-07-27', 1135.0900),
-    (1, 5, '2016-07-28', 1124.7900),
-    (1, 5, '2016-07-29', 1112.8900),
-    (1, 5, '2016-08-01', 1106.1500),
-    (1, 5, '2016-08-02', 1108.3500),
-    (1, 5, '2016-08-03', 1116.2100),
-    (1, 5, '2016-08-04', 1111.9900),
-    (1, 5, '2016-08-05', 1114.5400),
-    (1, 5, '2016-08-08', 1108.2400),
-    (1, 5, '2016-08-09', 1101.4500),
-    (1, 5, '2016-08-10', 1095.8400),
-    (1, 5, '2016-08-11', 1097.3900),
-    (1, 5, '2016-08-12', 1100.7900),
-    (1, 5, '2016-08-15', 1095.8300),
-    (1, 5, '2016-08-16', 1093.9500),
-    (1, 5, '2016-08-17', 1114.2500),
-    (1, 5, '2016-08-18', 1107.4100),
-    (1, 5, '2016-08-19', 1116.3700),
-    (1, 5, '2016-08-22', 1120.9300),
-    (1, 5, '2016-08-23', 1116.9100),
-    (1, 5, '2016-08-24', 1121.3400),
-    (1, 5, '2016-08-25', 1115.2300),
-    (1, 5, '2016-08-26', 1111.4700),
-    (1, 5, '2016-08-29', 1121.5300),
-    (1, 5, '2016-08-30', 1118.2700),
-    (1, 5, '2016-08-31', 1115.9800),
-    (1, 5, '2016-09-01', 1116.8900),
-    (1, 5, '2016-09-02', 1114.8700),
-    (1, 5, '2016-09-05', NULL),
-    (1, 5, '2016-09-06', 1093.0400),
-    (1, 5, '2016-09-07', 1090.0300),
-    (1, 5, '2016-09-08', 1095.2700),
-    (1, 5, '2016-09-09', 1106.8300),
-    (1, 5, '2016-09-12', 1112.0300),
-    (1, 5, '2016-09-13', 1124.6100),
-    (1, 5, '2016-09-14', 1123.8100),
-    (1, 5, '2016-09-15', 1122.8300),
-    (1, 5, '2016-09-16', 1127.3700),
-    (1, 5, '2016-09-19', 1117.4400),
-    (1, 5, '2016-09-20', 1117.3400),
-    (1, 5, '2016-09-21', 1112.1700),
-    (1, 5, '2016-09-22', 1100.5900),
-    (1, 5, '2016-09-23', 1102.2100),
-    (1, 5, '2016-09-26', 1106.9900),
-    (1, 5, '2016-09-27', 1099.2200),
-    (1, 5, '2016-09-28', 1096.7300),
-    (1, 5, '2016-09-29', 1097.3900),
-    (1, 5, '2016-09-30', 1097.9800),
-    (1, 5, '2016-10-03', 1104.7900),
-    (1, 5, '2016-10-04', 1110.7700),
-    (1, 5, '2016-10-05', 1114.5300),
-    (1, 5, '2016-10-06', 1114.5300),
-    (1, 5, '2016-10-07', 1115.9500),
-    (1, 5, '2016-10-10', NULL),
-    (1, 5, '2016-10-11', 1124.2100),
-    (1, 5, '2016-10-12', 1127.2300),
-    (1, 5, '2016-10-13', 1133.3100),
-    (1, 5, '2016-10-14', 1133.1300),
-    (1, 5, '2016-10-17', 1137.6100),
-    (1, 5, '2016-10-18', 1123.7500),
-    (1, 5, '2016-10-19', 1120.0100),
-    (1, 5, '2016-10-20', 1128.3900),
-    (1, 5, '2016-10-21', 1137.1700),
-    (1, 5, '2016-10-24', 1135.4000),
-    (1, 5, '2016-10-25', 1129.8900),
-    (1, 5, '2016-10-26', 1134.9400),
-    (1, 5, '2016-10-27', 1145.8500),
-    (1, 5, '2016-10-28', 1146.4900),
-    (1, 5, '2016-10-31', 1145.3700),
-    (1, 5, '2016-11-01', 1142.6800),
-    (1, 5, '2016-11-02', 1148.3900),
-    (1, 5, '2016-11-03', 1142.6800),
-    (1, 5, '2016-11-04', 1142.6800),
-    (1, 5, '2016-11-07', 1141.2700),
-    (1, 5, '2016-11-08', 1131.3900),
-    (1, 5, '2016-11-09', 1150.6400),
-    (1, 5, '2016-11-10', 1162.1100),
-    (1, 5, '2016-11-11', NULL),
-    (1, 5, '2016-11-14', 1171.2500),
-    (1, 5, '2016-11-15', 1170.2100),
-    (1, 5, '2016-11-16', 1172.4100),
-    (1, 5, '2016-11-17', 1176.0300),
-    (1, 5, '2016-11-18', 1177.7100),
-    (1, 5, '2016-11-21', 1178.5100),
-    (1, 5, '2016-11-22', 1173.2500),
-    (1, 5, '2016-11-23', 1181.5700),
-    (1, 5, '2016-11-24', NULL),
-    (1, 5, '2016-11-25', 1174.9900),
-    (1, 5, '2016-11-28', 1170.5300),
-    (1, 5, '2016-11-29', 1169.9900),
-    (1, 5, '2016-11-30', 1175.8700),
-    (1, 5, '2016-12-01', 1167.3100),
-    (1, 5, '2016-12-02', 1167.7000),
-    (1, 5, '2016-12-05', 1168.1100),
-    (1, 5, '2016-12-06', 1171.5000),
-    (1, 5, '2016-12-07', 1161.6700),
-    (1, 5, '2016-12-08', 1162.4900),
-    (1, 5, '2016-12-09', 1171.8800),
-    (1, 5, '2016-12-12', 1164.3700),
-    (1, 5, '2016-12-13', 1164.6500),
-    (1, 5, '2016-12-14', 1165.1300),
-    (1, 5, '2016-12-15', 1185.3500),
-    (1, 5, '2016-12-16', 1183.7200),
-    (1, 5, '2016-12-19', 1187.7100),
-    (1, 5, '2016-12-20', 1192.1300),
-    (1, 5, '2016-12-21', 1193.9100),
-    (1, 5, '2016-12-22', 1204.6500),
-    (1, 5, '2016-12-23', 1201.5100),
-    (1, 5, '2016-12-26', NULL),
-    (1, 5, '2016-12-27', 1207.6800),
-    (1, 5, '2016-12-28', 1212.2200),
-    (1, 5, '2016-12-29', 1207.0500),
-    (1, 5, '2016-12-30', 1203.7300),
-    (1, 5, '2017-01-02', NULL),
-    (1, 5, '2017-01-03', 1207.2300),
-    (1, 5, '2017-01-04', 1198.0700),
-    (1, 5, '2017-01-05', 1182.4600),
-    (1, 5, '2017-01-06', 1198.8500),
-    (1, 5, '2017-01-09', 1200.5100),
-    (1, 5, '2017-01-10', 1196.9600),
-    (1, 5, '2017-01-11', 1197.5900),
-    (1, 5, '2017-01-12', 1170.9300),
-    (1, 5, '2017-01-13', 1177.8100),
-    (1, 5, '2017-01-16', NULL),
-    (1, 5, '2017-01-17', 1161.6100),
-    (1, 5, '2017-01-18', 1170.7300),
-    (1, 5, '2017-01-19', 1177.0300),
-    (1, 5, '2017-01-20', NULL),
-    (1, 5, '2017-01-23', 1165.8200),
-    (1, 5, '2017-01-24', 1165.2500),
-    (1, 5, '2017-01-25', 1164.1300),
-    (1, 5, '2017-01-26', 1169.6100),
-    (1, 5, '2017-01-27', 1175.7300),
-    (1, 5, '2017-01-30', 1171.2700),
-    (1, 5, '2017-01-31', 1151.4500),
-    (1, 5, '2017-02-01', 1154.4500),
-    (1, 5, '2017-02-02', 1139.9000),
-    (1, 5, '2017-02-03', 1134.6500),
-    (1, 5, '2017-02-06', 1134.8700),
-    (1, 5, '2017-02-07', 1142.8900),
-    (1, 5, '2017-02-08', 1143.2500),
-    (1, 5, '2017-02-09', 1149.8800),
-    (1, 5, '2017-02-10', 1146.7800),
-    (1, 5, '2017-02-13', 1150.1600),
-    (1, 5, '2017-02-14', 1139.3300),
-    (1, 5, '2017-02-15', 1136.4600),
-    (1, 5, '2017-02-16', 1140.5700),
-    (1, 5, '2017-02-17', 1150.7300),
-    (1, 5, '2017-02-20', NULL),
-    (1, 5, '2017-02-21', 1143.6300),
-    (1, 5, '2017-02-22', 1142.1500),
-    (1, 5, '2017-02-23', 1129.8400),
-    (1, 5, '2017-02-24', 1130.4100),
-    (1, 5, '2017-02-27', 1130.1400),
-    (1, 5, '2017-02-28', 1129.1700),
-    (1, 5, '2017-03-01', 1140.7500),
-    (1, 5, '2017-03-02', 1146.9000),
-    (1, 5, '2017-03-03', 1156.7700),
-    (1, 5, '2017-03-06', 1154.3600),
-    (1, 5, '2017-03-07', 1149.8700),
-    (1, 5, '2017-03-08', 1154.6900),
-    (1, 5, '2017-03-09', 1158.1200),
-    (1, 5, '2017-03-10', 1150.3700),
-    (1, 5, '2017-03-13', 1150.3700),
-    (1, 5, '2017-03-14', 1148.5400),
-    (1, 5, '2017-03-15', 1144.1600),
-    (1, 5, '2017-03-16', 1148.5400),
-    (1, 5, '2017-03-17', 1128.6700),
-    (1, 5, '2017-03-20', 1112.2900),
-    (1, 5, '2017-03-21', 1118.2300),
-    (1, 5, '2017-03-22', 1117.9100),
-    (1, 5, '2017-03-23', 1117.9100),
-    (1, 5, '2017-03-24', 1116.6900),
-    (1, 5, '2017-03-27', 1108.3000),
-    (1, 5, '2017-03-28', 1111.5100),
-    (1, 5, '2017-03-29', 1112.2800),
-    (1, 5, '2017-03-30', 1114.1100),
-    (1, 5, '2017-03-31', 1117.4800),
-    (1, 5, '2017-04-03', 1117.6500),
-    (1, 5, '2017-04-04', 1122.6100),
-    (1, 5, '2017-04-05', 1126.5800),
-    (1, 5, '2017-04-06', 1131.2100),
-    (1, 5, '2017-04-07', 1133.8900),
-    (1, 5, '2017-04-10', 1141.2400),
-    (1, 5, '2017-04-11', 1147.7700),
-    (1, 5, '2017-04-12', 1138.8300),
-    (1, 5, '2017-04-13', 1133.3200),
-    (1, 5, '2017-04-14', 1136.6700),
-    (1, 5, '2017-04-17', 1134.2500),
-    (1, 5, '2017-04-18', 1143.8900),
-    (1, 5, '2017-04-19', 1142.3700),
-    (1, 5, '2017-04-20', 1136.2900),
-    (1, 5, '2017-04-21', 1134.2200),
-    (1, 5, '2017-04-24', 1132.1900),
-    (1, 5, '2017-04-25', 1126.5400),
-    (1, 5, '2017-04-26', 1133.1700),
-    (1, 5, '2017-04-27', 1133.8300),
-    (1, 5, '2017-04-28', 1136.9900),
-    (1, 5, '2017-05-01', 1134.8700),
-    (1, 5, '2017-05-02', 1129.1600),
-    (1, 5, '2017-05-03', 1131.2500),
-    (1, 5, '2017-05-04', 1134.2000),
-    (1, 5, '2017-05-05', 1136.4900),
-    (1, 5, '2017-05-08', 1132.9100),
-    (1, 5, '2017-05-09', 1134.8400),
-    (1, 5, '2017-05-10', 1132.1300),
-    (1, 5, '2017-05-11', 1127.5100),
-    (1, 5, '2017-05-12', 1121.6800),
-    (1, 5, '2017-05-15', 1115.0100),
-    (1, 5, '2017-05-16', 1115.0000),
-    (1, 5, '2017-05-17', 1118.6700),
-    (1, 5, '2017-05-18', 1129.1700),
-    (1, 5, '2017-05-19', 1116.7100),
-    (1, 5, '2017-05-22', 1114.7100),
-    (1, 5, '2017-05-23', 1125.0200),
-    (1, 5, '2017-05-24', 1122.8300),
-    (1, 5, '2017-05-25', 1119.7100),
-    (1, 5, '2017-05-26', 1118.6700),
-    (1, 5, '2017-05-29', NULL),
-    (1, 5, '2017-05-30', 1122.8700),
-    (1, 5, '2017-05-31', 1119.5800),
-    (1, 5, '2017-06-01', 1120.8500),
-    (1, 5, '2017-06-02', 1117.4900),
-    (1, 5, '2017-06-05', 1118.4400),
-    (1, 5, '2017-06-06', 1118.5300),
-    (1, 5, '2017-06-07', 1124.1600),
-    (1, 5, '2017-06-08', 1122.7300),
-    (1, 5, '2017-06-09', 1123.5100),
-    (1, 5, '2017-06-12', 1130.5500),
-    (1, 5, '2017-06-13', 1127.7900),
-    (1, 5, '2017-06-14', 1116.4500),
-    (1, 5, '2017-06-15', 1129.9100),
-    (1, 5, '2017-06-16', 1133.2000),
-    (1, 5, '2017-06-19', 1134.6900),
-    (1, 5, '2017-06-20', 1141.3300),
-    (1, 5, '2017-06-21', 1143.0100),
-    (1, 5, '2017-06-22', 1143.0100),
-    (1, 5, '2017-06-23', 1132.9900),
-    (1, 5, '2017-06-26', 1132.4800),
-    (1, 5, '2017-06-27', 1137.7700),
-    (1, 5, '2017-06-28', 1141.9100),
-    (1, 5, '2017-06-29', 1144.0700),
-    (1, 5, '2017-06-30', 1143.7500),
-    (1, 5, '2017-07-03', 1149.2600),
-    (1, 5, '2017-07-04', NULL),
-    (1, 5, '2017-07-05', 1153.0600),
-    (1, 5, '2017-07-06', 1156.5500),
-    (1, 5, '2017-07-07', 1153.8500),
-    (1, 5, '2017-07-10', 1149.3500),
-    (1, 5, '2017-07-11', 1149.8300),
-    (1, 5, '2017-07-12', 1138.3200),
-    (1, 5, '2017-07-13', 1137.2200),
-    (1, 5, '2017-07-14', 1127.7900),
-    (1, 5, '2017-07-17', 1127.7400),
-    (1, 5, '2017-07-18', 1123.6900),
-    (1, 5, '2017-07-19', 1122.9200),
-    (1, 5, '2017-07-20', 1120.7700),
-    (1, 5, '2017-07-21', 1117.4700),
-    (1, 5, '2017-07-24', 1113.8200),
-    (1, 5, '2017-07-25', 1117.0200),
-    (1, 5, '2017-07-26', 1117.7600),
-    (1, 5, '2017-07-27', 1115.1500),
-    (1, 5, '2017-07-28', 1121.4900),
-    (1, 5, '2017-07-31', 1121.8600),
-    (1, 5, '2017-08-01', 1121.0900),
-    (1, 5, '2017-08-02', 1121.5500),
-    (1, 5, '2017-08-03', 1127.7300),
-    (1, 5, '2017-08-04', 1128.0200),
-    (1, 5, '2017-08-07', 1127.3900),
-    (1, 5, '2017-08-08', 1125.6000),
-    (1, 5, '2017-08-09', 1138.6900),
-    (1, 5, '2017-08-10', 1144.3600),
-    (1, 5, '2017-08-11', 1141.5700),
-    (1, 5, '2017-08-14', 1137.2200),
-    (1, 5, '2017-08-15', 1135.4700),
-    (1, 5, '2017-08-16', 1137.7500),
-    (1, 5, '2017-08-17', 1138.2300),
-    (1, 5, '2017-08-18', 1139.8300),
-    (1, 5, '2017-08-21', 1137.1400),
-    (1, 5, '2017-08-22', 1131.7900),
-    (1, 5, '2017-08-23', 1128.5900),
-    (1, 5, '2017-08-24', 1127.8000),
-    (1, 5, '2017-08-25', 1121.7300),
-    (1, 5, '2017-08-28', 1117.7500),
-    (1, 5, '2017-08-29', 1122.5000),
-    (1, 5, '2017-08-30', 1122.0500),
-    (1, 5, '2017-08-31', 1122.3600),
-    (1, 5, '2017-09-01', 1119.1200),
-    (1, 5, '2017-09-04', NULL),
-    (1, 5, '2017-09-05', 1130.0100),
-    (1, 5, '2017-09-06', 1132.4900),
-    (1, 5, '2017-09-07', 1125.4900),
-    (1, 5, '2017-09-08', 1133.0800),
-    (1, 5, '2017-09-11', 1129.7500),
-    (1, 5, '2017-09-12', 1126.5600),
-    (1, 5, '2017-09-13', 1129.3700),
-    (1, 5, '2017-09-14', 1132.5900),
-    (1, 5, '2017-09-15', 1130.6800),
-    (1, 5, '2017-09-18', 1127.0700),
-    (1, 5, '2017-09-19', 1128.8300),
-    (1, 5, '2017-09-20', 1125.5300),
-    (1, 5, '2017-09-21', 1130.8700),
-    (1, 5, '2017-09-22', 1130.3900),
-    (1, 5, '2017-09-25', 1134.5900),
-    (1, 5, '2017-09-26', 1136.2900),
-    (1, 5, '2017-09-27', 1141.6200),
-    (1, 5, '2017-09-28', 1145.0800),
-    (1, 5, '2017-09-29', 1142.5700),
-    (1, 5, '2017-10-02', 1146.1800),
-    (1, 5, '2017-10-03', 1140.5000),
-    (1, 5, '2017-10-04', 1140.4400),
-    (1, 5, '2017-10-05', 1139.0300),
-    (1, 5, '2017-10-06', 1143.4600),
-    (1, 5, '2017-10-09', NULL),
-    (1, 5, '2017-10-10', 1132.0800),
-    (1, 5, '2017-10-11', 1131.0400),
-    (1, 5, '2017-10-12', 1129.7100),
-    (1, 5, '2017-10-13', 1125.0100),
-    (1, 5, '2017-10-16', 1127.7200),
-    (1, 5, '2017-10-17', 1129.8600),
-    (1, 5, '2017-10-18', 1130.9400),
-    (1, 5, '2017-10-19', 1129.0600),
-    (1, 5, '2017-10-20', 1130.6900),
-    (1, 5, '2017-10-23', 1129.7300),
-    (1, 5, '2017-10-24', 1128.3600),
-    (1, 5, '2017-10-25', 1126.4900),
-    (1, 5, '2017-10-26', 1123.0000),
-    (1, 5, '2017-10-27', 1124.7900),
-    (1, 5, '2017-10-30', 1124.7900),
-    (1, 5, '2017-10-31', 1115.6800),
-    (1, 5, '2017-11-01', 1110.0300),
-    (1, 5, '2017-11-02', 1111.5400),
-    (1, 5, '2017-11-03', 1114.8600),
-    (1, 5, '2017-11-06', 1110.0900),
-    (1, 5, '2017-11-07', 1113.1000),
-    (1, 5, '2017-11-08', 1111.1400),
-    (1, 5, '2017-11-09', 1118.1300),
-    (1, 5, '2017-11-10', NULL),
-    (1, 5, '2017-11-13', 1119.9600),
-    (1, 5, '2017-11-14', 1113.6500),
-    (1, 5, '2017-11-15', 1103.9000),
-    (1, 5, '2017-11-16', 1094.4900),
-    (1, 5, '2017-11-17', 1093.1500),
-    (1, 5, '2017-11-20', 1093.1500),
-    (1, 5, '2017-11-21', 1088.1000),
-    (1, 5, '2017-11-22', 1085.6600),
-    (1, 5, '2017-11-23', NULL),
-    (1, 5, '2017-11-24', 1082.5900),
-    (1, 5, '2017-11-27', 1088.3600),
-    (1, 5, '2017-11-28', 1079.8700),
-    (1, 5, '2017-11-29', 1079.3300),
-    (1, 5, '2017-11-30', 1084.7900),
-    (1, 5, '2017-12-01', 1082.3600),
-    (1, 5, '2017-12-04', 1082.3600),
-    (1, 5, '2017-12-05', 1086.8500),
-    (1, 5, '2017-12-06', 1094.5500),
-    (1, 5, '2017-12-07', 1090.6800),
-    (1, 5, '2017-12-08', 1092.0300),
-    (1, 5, '2017-12-11', 1092.0300),
-    (1, 5, '2017-12-12', 1090.0900),
-    (1, 5, '2017-12-13', 1088.0100),
-    (1, 5, '2017-12-14', 1088.0900),
-    (1, 5, '2017-12-15', 1086.3900),
-    (1, 5, '2017-12-18', 1084.2800),
-    (1, 5, '2017-12-19', 1084.2900),
-    (1, 5, '2017-12-20', 1078.1400),
-    (1, 5, '2017-12-21', 1078.0800),
-    (1, 5, '2017-12-22', 1076.8700),
-    (1, 5, '2017-12-25', NULL),
-    (1, 5, '2017-12-26', 1073.7500),
-    (1, 5, '2017-12-27', 1073.1300),
-    (1, 5, '2017-12-28', 1068.6400),
-    (1, 5, '2017-12-29', 1067.4200),
-    (1, 5, '2018-01-01', NULL),
-    (1, 5, '2018-01-02', 1059.3900),
-    (1, 5, '2018-01-03', 1063.1100),
-    (1, 5, '2018-01-04', 1061.5000),
-    (1, 5, '2018-01-05', 1060.0700),
-    (1, 5, '2018-01-08', 1066.2600),
-    (1, 5, '2018-01-09', 1068.8000),
-    (1, 5, '2018-01-10', 1067.5500),
-    (1, 5, '2018-01-11', 1062.6700),
-    (1, 5, '2018-01-12', 1062.0900),
-    (1, 5, '2018-01-15', NULL),
-    (1, 5, '2018-01-16', 1064.1900),
-    (1, 5, '2018-01-17', 1066.7200),
-    (1, 5, '2018-01-18', 1069.3500),
-    (1, 5, '2018-01-19', 1065.8100),
-    (1, 5, '2018-01-22', 1068.0600),
-    (1, 5, '2018-01-23', 1072.5900),
-    (1, 5, '2018-01-24', 1065.3900),
-    (1, 5, '2018-01-25', 1057.5700),
-    (1, 5, '2018-01-26', 1064.1200),
-    (1, 5, '2018-01-29', 1071.2800),
-    (1, 5, '2018-01-30', 1073.6100),
-    (1, 5, '2018-01-31', 1068.3300),
-    (1, 5, '2018-02-01', 1069.3300),
-    (1, 5, '2018-02-02', 1086.2100),
-    (1, 5, '2018-02-05', 1086.7900),
-    (1, 5, '2018-02-06', 1081.2000),
-    (1, 5, '2018-02-07', 1082.5600),
-    (1, 5, '2018-02-08', 1093.0400),
-    (1, 5, '2018-02-09', 1087.9900),
-    (1, 5, '2018-02-12', 1082.2700),
-    (1, 5, '2018-02-13', 1083.0700),
-    (1, 5, '2018-02-14', 1076.7100),
-    (1, 5, '2018-02-15', 1065.6900),
-    (1, 5, '2018-02-16', 1065.2700),
-    (1, 5, '2018-02-19', NULL),
-    (1, 5, '2018-02-20', 1072.8500),
-    (1, 5, '2018-02-21', 1076.1100),
-    (1, 5, '2018-02-22', 1077.6100),
-    (1, 5, '2018-02-23', 1075.7700),
-    (1, 5, '2018-02-26', 1069.0),
-    (1, 5, '2018-02-27', 1077.43),
-    (1, 5, '2018-02-28', 1082.12),
-    (1, 5, '2018-03-01', 1081.33),
-    (1, 5, '2018-03-02', 1080.25),
-    (1, 5, '2018-03-05', 1076.75),
-    (1, 5, '2018-03-06', 1061.53),
-    (1, 5, '2018-03-07', 1067.93),
-    (1, 5, '2018-03-08', 1074.21),
-    (1, 5, '2018-03-09', 1066.59),
-    (1, 5, '2018-03-12', 1063.7),
-    (1, 5, '2018-03-13', 1062.14),
-    (1, 5, '2018-03-14', 1063.38),
-    (1, 5, '2018-03-15', 1065.89),
-    (1, 5, '2018-03-16', 1068.4),
-    (1, 5, '2018-03-19', 1072.29),
-    (1, 5, '2018-03-20', 1070.61),
-    (1, 5, '2018-03-21', 1070.51),
-    (1, 5, '2018-03-22', 1079.51),
-    (1, 5, '2018-03-23', 1081.29),
-    (1, 5, '2018-03-26', 1076.87),
-    (1, 5, '2018-03-27', 1071.21),
-    (1, 5, '2018-03-28', 1063.03),
-    (1, 5, '2018-03-29', 1060.31),
-    (1, 5, '2018-03-30', 1060.99),
-    (1, 5, '2018-04-02', 1056.03),
-    (1, 5, '2018-04-03', 1054.63),
-    (1, 5, '2018-04-04', 1059.5),
-    (1, 5, '2018-04-05', 1061.56),
-    (1, 5, '2018-04-06', 1068.73),
-    (1, 5, '2018-04-09', 1067.11),
-    (1, 5, '2018-04-10', 1064.53),
-    (1, 5, '2018-04-11', 1066.76),
-    (1, 5, '2018-04-12', 1069.84),
-    (1, 5, '2018-04-13', 1070.55),
-    (1, 5, '2018-04-16', 1071.59),
-    (1, 5, '2018-04-17', 1066.56),
-    (1, 5, '2018-04-18', 1064.51),
-    (1, 5, '2018-04-19', 1065.68),
-    (1, 5, '2018-04-20', 1071.0),
-    (1, 5, '2018-04-23', 1077.72),
-    (1, 5, '2018-04-24', 1074.8),
-    (1, 5, '2018-04-25', 1082.99),
-    (1, 5, '2018-04-26', 1078.2),
-    (1, 5, '2018-04-27', 1067.59),
-    (1, 5, '2018-04-30', 1069.07),
-    (1, 5, '2018-05-01', 1071.91),
-    (1, 5, '2018-05-02', 1076.52),
-    (1, 5, '2018-05-03', 1078.33),
-    (1, 5, '2018-05-04', 1074.09),
-    (1, 5, '2018-05-07', 1079.72),
-    (1, 5, '2018-05-08', 1078.35),
-    (1, 5, '2018-05-09', 1078.22),
-    (1, 5, '2018-05-10', 1065.41),
-    (1, 5, '2018-05-11', 1067.14),
-    (1, 5, '2018-05-14', 1068.83),
-    (1, 5, '2018-05-15', 1076.46),
-    (1, 5, '2018-05-16', 1076.66),
-    (1, 5, '2018-05-17', 1082.37),
-    (1, 5, '2018-05-18', 1080.91),
-    (1, 5, '2018-05-21', 1079.33),
-    (1, 5, '2018-05-22', 1073.3),
-    (1, 5, '2018-05-23', 1078.88),
-    (1, 5, '2018-05-24', 1082.77),
-    (1, 5, '2018-05-25', 1076.35),
-    (1, 5, '2018-05-28', NULL),
-    (1, 5, '2018-05-29', 1082.59),
-    (1, 5, '2018-05-30', 1077.62),
-    (1, 5, '2018-05-31', 1080.75),
-    (1, 5, '2018-06-01', 1071.27),
-    (1, 5, '2018-06-04', 1070.13),
-    (1, 5, '2018-06-05', 1071.86),
-    (1, 5, '2018-06-06', 1065.9),
-    (1, 5, '2018-06-07', 1071.11),
-    (1, 5, '2018-06-08', 1075.0),
-    (1, 5, '2018-06-11', 1075.08),
-    (1, 5, '2018-06-12', 1077.14),
-    (1, 5, '2018-06-13', 1082.59),
-    (1, 5, '2018-06-14', 1084.06),
-    (1, 5, '2018-06-15', 1101.89),
-    (1, 5, '2018-06-18', 1105.07),
-    (1, 5, '2018-06-19', 1110.64),
-    (1, 5, '2018-06-20', 1107.42),
-    (1, 5, '2018-06-21', 1110.01),
-    (1, 5, '2018-06-22', 1114.49),
-    (1, 5, '2018-06-25', 1114.4),
-    (1, 5, '2018-06-26', 1118.08),
-    (1, 5, '2018-06-27', 1121.57),
-    (1, 5, '2018-06-28', 1121.96),
-    (1, 5, '2018-06-29', 1111.79),
-    (1, 5, '2018-07-02', 1119.31),
-    (1, 5, '2018-07-03', 1114.41),
-    (1, 5, '2018-07-04', NULL),
-    (1, 5, '2018-07-05', 1118.2),
-    (1, 5, '2018-07-06', 1114.98),
-    (1, 5, '2018-07-09', 1111.38),
-    (1, 5, '2018-07-10', 1113.81),
-    (1, 5, '2018-07-11', 1122.1),
-    (1, 5, '2018-07-12', 1121.38),
-    (1, 5, '2018-07-13', 1130.3),
-    (1, 5, '2018-07-16', 1127.81),
-    (1, 5, '2018-07-17', 1125.63),
-    (1, 5, '2018-07-18', 1130.76),
-    (1, 5, '2018-07-19', 1136.38),
-    (1, 5, '2018-07-20', 1128.16),
-    (1, 5, '2018-07-23', 1134.45),
-    (1, 5, '2018-07-24', 1127.39),
-    (1, 5, '2018-07-25', 1122.59),
-    (1, 5, '2018-07-26', 1121.72),
-    (1, 5, '2018-07-27', 1115.77),
-    (1, 5, '2018-07-30', 1116.98),
-    (1, 5, '2018-07-31', 1112.75),
-    (1, 5, '2018-08-01', 1118.15),
-    (1, 5, '2018-08-02', 1126.91),
-    (1, 5, '2018-08-03', 1122.68),
-    (1, 5, '2018-08-06', 1125.9),
-    (1, 5, '2018-08-07', 1118.0),
-    (1, 5, '2018-08-08', 1119.01),
-    (1, 5, '2018-08-09', 1119.0),
-    (1, 5, '2018-08-10', 1130.19),
-    (1, 5, '2018-08-13', 1133.19),
-    (1, 5, '2018-08-14', 1128.9),
-    (1, 5, '2018-08-15', 1135.3),
-    (1, 5, '2018-08-16', 1125.35),
-    (1, 5, '2018-08-17', 1123.2),
-    (1, 5, '2018-08-20', 1121.3),
-    (1, 5, '2018-08-21', 1117.41),
-    (1, 5, '2018-08-22', 1117.0),
-    (1, 5, '2018-08-23', 1123.37),
-    (1, 5, '2018-08-24', 1113.3),
-    (1, 5, '2018-08-27', 1107.69),
-    (1, 5, '2018-08-28', 1105.14),
-    (1, 5, '2018-08-29', 1109.2),
-    (1, 5, '2018-08-30', 1113.13),
-    (1, 5, '2018-08-31', 1116.5),
-    (1, 5, '2018-09-03', NULL),
-    (1, 5, '2018-09-04', 1117.5),
-    (1, 5, '2018-09-05', 1121.0),
-    (1, 5, '2018-09-06', 1122.4),
-    (1, 5, '2018-09-07', 1123.5),
-    (1, 5, '2018-09-10', 1127.9),
-    (1, 5, '2018-09-11', 1126.17),
-    (1, 5, '2018-09-12', 1121.62),
-    (1, 5, '2018-09-13', 1120.9),
-    (1, 5, '2018-09-14', 1119.0),
-    (1, 5, '2018-09-17', 1124.71),
-    (1, 5, '2018-09-18', 1122.18),
-    (1, 5, '2018-09-19', 1120.61),
-    (1, 5, '2018-09-20', 1119.58),
-    (1, 5, '2018-09-21', 1114.3),
-    (1, 5, '2018-09-24', 1119.28),
-    (1, 5, '2018-09-25', 1118.12),
-    (1, 5, '2018-09-26', 1114.64),
-    (1, 5, '2018-09-27', 1113.41),
-    (1, 5, '2018-09-28', 1109.76),
-    (1, 5, '2018-10-01', 1112.53),
-    (1, 5, '2018-10-02', 1112.43),
-    (1, 5, '2018-10-03', 1121.42),
-    (1, 5, '2018-10-04', 1130.78),
-    (1, 5, '2018-10-05', 1131.25),
-    (1, 5, '2018-10-08', NULL),
-    (1, 5, '2018-10-09', 1132.87),
-    (1, 5, '2018-10-10', 1138.9),
-    (1, 5, '2018-10-11', 1134.16),
-    (1, 5, '2018-10-12', 1130.82),
-    (1, 5, '2018-10-15', 1129.3),
-    (1, 5, '2018-10-16', 1123.88),
-    (1, 5, '2018-10-17', 1126.2),
-    (1, 5, '2018-10-18', 1138.06),
-    (1, 5, '2018-10-19', 1131.13),
-    (1, 5, '2018-10-22', 1132.7),
-    (1, 5, '2018-10-23', 1137.53),
-    (1, 5, '2018-10-24', 1136.66),
-    (1, 5, '2018-10-25', 1134.9),
-    (1, 5, '2018-10-26', 1141.74),
-    (1, 5, '2018-10-29', 1139.81),
-    (1, 5, '2018-10-30', 1137.68),
-    (1, 5, '2018-10-31', 1140.78),
-    (1, 5, '2018-11-01', 1126.19),
-    (1, 5, '2018-11-02', 1117.72),
-    (1, 5, '2018-11-05', 1122.13),
-    (1, 5, '2018-11-06', 1121.31),
-    (1, 5, '2018-11-07', 1117.52),
-    (1, 5, '2018-11-08', 1118.99),
-    (1, 5, '2018-11-09', 1131.4),
-    (1, 5, '2018-11-12', NULL),
-    (1, 5, '2018-11-13', 1132.29),
-    (1, 5, '2018-11-14', 1132.1),
-    (1, 5, '2018-11-15', 1127.2),
-    (1, 5, '2018-11-16', 1124.81),
-    (1, 5, '2018-11-19', 1126.08),
-    (1, 5, '2018-11-20', 1128.53),
-    (1, 5, '2018-11-21', 1127.3),
-    (1, 5, '2018-11-22', NULL),
-    (1, 5, '2018-11-23', 1130.9),
-    (1, 5, '2018-11-26', 1126.69),
-    (1, 5, '2018-11-27', 1129.41),
-    (1, 5, '2018-11-28', 1127.81),
-    (1, 5, '2018-11-29', 1119.91),
-    (1, 5, '2018-11-30', 1118.61),
-    (1, 5, '2018-12-03', 1110.2),
-    (1, 5, '2018-12-04', 1108.82),
-    (1, 5, '2018-12-05', NULL),
-    (1, 5, '2018-12-06', 1122.07),
-    (1, 5, '2018-12-07', 1121.8),
-    (1, 5, '2018-12-10', 1128.3),
-    (1, 5, '2018-12-11', 1128.6),
-    (1, 5, '2018-12-12', 1124.51),
-    (1, 5, '2018-12-13', 1124.73),
-    (1, 5, '2018-12-14', 1133.2),
-    (1, 5, '2018-12-17', 1130.17),
-    (1, 5, '2018-12-18', 1127.76),
-    (1, 5, '2018-12-19', 1120.96),
-    (1, 5, '2018-12-20', 1123.87),
-    (1, 5, '2018-12-21', 1125.3),
-    (1, 5, '2018-12-24', NULL),
-    (1, 5, '2018-12-25', NULL),
-    (1, 5, '2018-12-26', 1124.23),
-    (1, 5, '2018-12-27', 1121.37),
-    (1, 5, '2018-12-28', 1114.9),
-    (1, 5, '2018-12-31', 1112.85),
-    (1, 5, '2019-01-01', NULL),
-    (1, 5, '2019-01-02', 1119.11),
-    (1, 5, '2019-01-03', 1124.64),
-    (1, 5, '2019-01-04', 1116.73),
-    (1, 5, '2019-01-07', 1117.5),
-    (1, 5, '2019-01-08', 1122.93),
-    (1, 5, '2019-01-09', 1117.7),
-    (1, 5, '2019-01-10', 1116.1),
-    (1, 5, '2019-01-11', 1117.18),
-    (1, 5, '2019-01-14', NULL),
-    (1, 5, '2019-01-15', 1122.59),
-    (1, 5, '2019-01-16', 1119.1),
-    (1, 5, '2019-01-17', 1123.34),
-    (1, 5, '2019-01-18', 1122.8),
-    (1, 5, '2019-01-21', NULL),
-    (1, 5, '2019-01-22', 1129.52),
-    (1, 5, '2019-01-23', 1127.89),
-    (1, 5, '2019-01-24', 1126.33),
-    (1, 5, '2019-01-25', 1117.58),
-    (1, 5, '2019-01-28', 1118.7),
-    (1, 5, '2019-01-29', 1117.75),
-    (1, 5, '2019-01-30', 1117.29),
-    (1, 5, '2019-01-31', 1111.82),
-    (1, 5, '2019-02-01', 1118.31),
-    (1, 5, '2019-02-04', 1119.15),
-    (1, 5, '2019-02-05', 1117.2),
-    (1, 5, '2019-02-06', 1119.35),
-    (1, 5, '2019-02-07', 1124.11),
-    (1, 5, '2019-02-08', 1123.14),
-    (1, 5, '2019-02-11', 1126.05),
-    (1, 5, '2019-02-12', 1122.27),
-    (1, 5, '2019-02-13', 1123.32),
-    (1, 5, '2019-02-14', 1128.0),
-    (1, 5, '2019-02-15', 1125.59),
-    (1, 5, '2019-02-18', NULL),
-    (1, 5, '2019-02-19', 1124.54),
-    (1, 5, '2019-02-20', NULL),
-    (1, 5, '2019-02-21', 1125.53),
-    (1, 5, '2019-02-22', 1121.07),
-    (1, 5, '2019-02-25', 1116.1),
-    (1, 5, '2019-02-26', 1116.88),
-    (1, 5, '2019-02-27', 1117.74),
-    (1, 5, '2019-02-28', 1124.65),
-    (1, 5, '2019-03-01', 1126.76),
-    (1, 5, '2019-03-04', 1126.73),
-    (1, 5, '2019-03-05', 1126.46),
-    (1, 5, '2019-03-06', 1127.18),
-    (1, 5, '2019-03-07', 1133.18),
-    (1, 5, '2019-03-08', 1131.74),
-    (1, 5, '2019-03-11', 1133.05),
-    (1, 5, '2019-03-12', 1127.89),
-    (1, 5, '2019-03-13', 1130.47),
-    (1, 5, '2019-03-14', 1135.05),
-    (1, 5, '2019-03-15', 1134.44),
-    (1, 5, '2019-03-18', 1131.74),
-    (1, 5, '2019-03-19', 1129.0),
-    (1, 5, '2019-03-20', 1128.25),
-    (1, 5, '2019-03-21', 1128.4),
-    (1, 5, '2019-03-22', 1136.8),
-    (1, 5, '2019-03-25', 1131.88),
-    (1, 5, '2019-03-26', 1133.55),
-    (1, 5, '2019-03-27', 1138.09),
-    (1, 5, '2019-03-28', 1136.65),
-    (1, 5, '2019-03-29', 1136.3),
-    (1, 5, '2019-04-01', 1133.0),
-    (1, 5, '2019-04-02', 1137.16),
-    (1, 5, '2019-04-03', 1134.29),
-    (1, 5, '2019-04-04', 1136.05),
-    (1, 5, '2019-04-05', 1136.87),
-    (1, 5, '2019-04-08', 1143.25),
-    (1, 5, '2019-04-09', 1140.25),
-    (1, 5, '2019-04-10', 1137.26),
-    (1, 5, '2019-04-11', 1140.73),
-    (1, 5, '2019-04-12', 1133.0),
-    (1, 5, '2019-04-15', 1133.6),
-    (1, 5, '2019-04-16', 1136.13),
-    (1, 5, '2019-04-17', 1131.7),
-    (1, 5, '2019-04-18', 1136.2),
-    (1, 5, '2019-04-19', 1135.4),
-    (1, 5, '2019-04-22', 1140.52),
-    (1, 5, '2019-04-23', 1143.22),
-    (1, 5, '2019-04-24', 1155.65),
-    (1, 5, '2019-04-25', 1160.15),
-    (1, 5, '2019-04-26', 1158.1),
-    (1, 5, '2019-04-29', 1160.0),
-    (1, 5, '2019-04-30', 1165.1),
-    (1, 5, '2019-05-01', 1161.61),
-    (1, 5, '2019-05-02', 1166.62),
-    (1, 5, '2019-05-03', 1165.95),
-    (1, 5, '2019-05-06', 1169.2),
-    (1, 5, '2019-05-07', 1172.5),
-    (1, 5, '2019-05-08', 1170.2),
-    (1, 5, '2019-05-09', 1183.1),
-    (1, 5, '2019-05-10', 1176.62),
-    (1, 5, '2019-05-13', 1186.94),
-    (1, 5, '2019-05-14', 1186.94),
-    (1, 5, '2019-05-15', 1188.74),
-    (1, 5, '2019-05-16', 1190.52),
-    (1, 5, '2019-05-17', 1195.07),
-    (1, 5, '2019-05-20', 1193.81),
-    (1, 5, '2019-05-21', 1194.31),
-    (1, 5, '2019-05-22', 1194.36),
-    (1, 5, '2019-05-23', 1189.31),
-    (1, 5, '2019-05-24', 1186.2),
-    (1, 5, '2019-05-27', NULL),
-    (1, 5, '2019-05-28', 1186.66),
-    (1, 5, '2019-05-29', 1186.66),
-    (1, 5, '2019-05-30', 1189.14),
-    (1, 5, '2019-05-31', 1190.5),
-    (1, 5, '2019-06-03', 1180.97),
-    (1, 5, '2019-06-04', 1180.58),
-    (1, 5, '2019-06-05', 1178.24),
-    (1, 5, '2019-06-06', 1179.51),
-    (1, 5, '2019-06-07', 1181.27),
-    (1, 5, '2019-06-10', 1185.01),
-    (1, 5, '2019-06-11', 1178.88),
-    (1, 5, '2019-06-12', 1182.82),
-    (1, 5, '2019-06-13', 1183.23),
-    (1, 5, '2019-06-14', 1184.7),
-    (1, 5, '2019-06-17', 1186.53),
-    (1, 5, '2019-06-18', 1185.37),
-    (1, 5, '2019-06-19', 1185.37),
-    (1, 5, '2019-06-20', 1159.05),
-    (1, 5, '2019-06-21', 1163.25),
-    (1, 5, '2019-06-24', 1156.14),
-    (1, 5, '2019-06-25', 1155.97),
-    (1, 5, '2019-06-26', 1156.36),
-    (1, 5, '2019-06-27', 1157.86),
-    (1, 5, '2019-06-28', 1154.58),
-    (1, 5, '2019-07-01', 1158.5),
-    (1, 5, '2019-07-02', 1166.31),
-    (1, 5, '2019-07-03', 1171.14),
-    (1, 5, '2019-07-04', NULL),
-    (1, 5, '2019-07-05', 1170.26),
-    (1, 5, '2019-07-08', 1181.31),
-    (1, 5, '2019-07-09', 1180.2),
-    (1, 5, '2019-07-10', 1181.58),
-    (1, 5, '2019-07-11', 1173.35),
-    (1, 5, '2019-07-12', 1178.71),
-    (1, 5, '2019-07-15', 1179.04),
-    (1, 5, '2019-07-16', 1177.31),
-    (1, 5, '2019-07-17', 1181.09),
-    (1, 5, '2019-07-18', 1178.29),
-    (1, 5, '2019-07-19', 1173.92),
-    (1, 5, '2019-07-22', 1178.17),
-    (1, 5, '2019-07-23', 1178.72),
-    (1, 5, '2019-07-24', 1177.54),
-    (1, 5, '2019-07-25', 1181.17),
-    (1, 5, '2019-07-26', 1184.51),
-    (1, 5, '2019-07-29', 1183.36),
-    (1, 5, '2019-07-30', 1181.94),
-    (1, 5, '2019-07-31', 1182.74),
-    (1, 5, '2019-08-01', 1187.0),
-    (1, 5, '2019-08-02', 1196.59),
-    (1, 5, '2019-08-05', 1215.5),
-    (1, 5, '2019-08-06', 1214.83),
-    (1, 5, '2019-08-07', 1214.46),
-    (1, 5, '2019-08-08', 1208.77),
-    (1, 5, '2019-08-09', 1214.15),
-    (1, 5, '2019-08-12', 1215.7),
-    (1, 5, '2019-08-13', 1220.7),
-    (1, 5, '2019-08-14', 1212.44),
-    (1, 5, '2019-08-15', 1214.34),
-    (1, 5, '2019-08-16', 1205.75),
-    (1, 5, '2019-08-19', 1210.6),
-    (1, 5, '2019-08-20', 1207.93),
-    (1, 5, '2019-08-21', 1202.0),
-    (1, 5, '2019-08-22', 1206.96),
-    (1, 5, '2019-08-23', 1210.5),
-    (1, 5, '2019-08-26', 1217.51),
-    (1, 5, '2019-08-27', 1211.38),
-    (1, 5, '2019-08-28', 1213.75),
-    (1, 5, '2019-08-29', 1216.14),
-    (1, 5, '2019-08-30', 1211.32),
-    (1, 5, '2019-09-02', NULL),
-    (1, 5, '2019-09-03', 1212.44),
-    (1, 5, '2019-09-04', 1207.84),
-    (1, 5, '2019-09-05', 1200.18),
-    (1, 5, '2019-09-06', 1196.81),
-    (1, 5, '2019-09-09', 1192.33),
-    (1, 5, '2019-09-10', 1191.22),
-    (1, 5, '2019-09-11', 1191.51),
-    (1, 5, '2019-09-12', 1180.7),
-    (1, 5, '2019-09-13', 1176.62),
-    (1, 5, '2019-09-16', 1182.96),
-    (1, 5, '2019-09-17', 1186.1),
-    (1, 5, '2019-09-18', 1191.51),
-    (1, 5, '2019-09-19', 1193.87),
-    (1, 5, '2019-09-20', 1188.16),
-    (1, 5, '2019-09-23', 1195.48),
-    (1, 5, '2019-09-24', 1196.52),
-    (1, 5, '2019-09-25', 1200.17),
-    (1, 5, '2019-09-26', 1201.99),
-    (1, 5, '2019-09-27', 1200.03),
-    (1, 5, '2019-09-30', 1195.85),
-    (1, 5, '2019-10-01', 1198.96),
-    (1, 5, '2019-10-02', 1205.47),
-    (1, 5, '2019-10-03', 1204.67),
-    (1, 5, '2019-10-04', 1192.93),
-    (1, 5, '2019-10-07', 1196.49),
-    (1, 5, '2019-10-08', 1193.24),
-    (1, 5, '2019-10-09', 1197.29),
-    (1, 5, '2019-10-10', 1190.06),
-    (1, 5, '2019-10-11', 1195.61),
-    (1, 5, '2019-10-14', NULL),
-    (1, 5, '2019-10-15', 1184.67),
-    (1, 5, '2019-10-16', 1186.38),
-    (1, 5, '2019-10-17', 1179.05),
-    (1, 5, '2019-10-18', 1181.06),
-    (1, 5, '2019-10-21', 1172.57),
-    (1, 5, '2019-10-22', 1170.49),
-    (1, 5, '2019-10-23', 1172.07),
-    (1, 5, '2019-10-24', 1173.19),
-    (1, 5, '2019-10-25', 1171.58),
-    (1, 5, '2019-10-28', 1170.17),
-    (1, 5, '2019-10-29', 1163.03),
-    (1, 5, '2019-10-30', 1167.76),
-    (1, 5, '2019-10-31', 1169.1),
-    (1, 5, '2019-11-01', 1165.45),
-    (1, 5, '2019-11-04', 1159.29),
-    (1, 5, '2019-11-05', 1157.64),
-    (1, 5, '2019-11-06', 1156.73),
-    (1, 5, '2019-11-07', 1154.44),
-    (1, 5, '2019-11-08', 1157.14),
-    (1, 5, '2019-11-11', NULL),
-    (1, 5, '2019-11-12', 1160.8),
-    (1, 5, '2019-11-13', 1167.76),
-    (1, 5, '2019-11-14', 1169.82),
-    (1, 5, '2019-11-15', 1166.98),
-    (1, 5, '2019-11-18', 1163.76),
-    (1, 5, '2019-11-19', 1167.13),
-    (1, 5, '2019-11-20', 1169.8),
-    (1, 5, '2019-11-21', 1175.71),
-    (1, 5, '2019-11-22', 1179.33),
-    (1, 5, '2019-11-25', 1173.09),
-    (1, 5, '2019-11-26', 1175.59),
-    (1, 5, '2019-11-27', 1176.89),
-    (1, 5, '2019-11-28', NULL),
-    (1, 5, '2019-11-29', 1181.33),
-    (1, 5, '2019-12-02', 1182.84),
-    (1, 5, '2019-12-03', 1190.31),
-    (1, 5, '2019-12-04', 1194.45),
-    (1, 5, '2019-12-05', 1189.23),
-    (1, 5, '2019-12-06', 1189.86),
-    (1, 5, '2019-12-09', 1189.45),
-    (1, 5, '2019-12-10', 1191.01),
-    (1, 5, '2019-12-11', 1192.2),
-    (1, 5, '2019-12-12', 1186.41),
-    (1, 5, '2019-12-13', 1171.97),
-    (1, 5, '2019-12-16', 1171.8),
-    (1, 5, '2019-12-17', 1165.81),
-    (1, 5, '2019-12-18', 1168.02),
-    (1, 5, '2019-12-19', 1165.04),
-    (1, 5, '2019-12-20', 1160.3),
-    (1, 5, '2019-12-23', 1163.64),
-    (1, 5, '2019-12-24', 1163.21),
-    (1, 5, '2019-12-25', NULL),
-    (1, 5, '2019-12-26', 1161.18),
-    (1, 5, '2019-12-27', 1160.87),
-    (1, 5, '2019-12-30', 1155.75),
-    (1, 5, '2019-12-31', 1155.46),
-    (1, 5, '2020-01-01', NULL),
-    (1, 5, '2020-01-02', 1157.95),
-    (1, 5, '2020-01-03', 1165.15),
-    (1, 5, '2020-01-06', 1167.49),
-    (1, 5, '2020-01-07', 1166.21),
-    (1, 5, '2020-01-08', 1170.61),
-    (1, 5, '2020-01-09', 1158.63),
-    (1, 5, '2020-01-10', 1161.18),
-    (1, 5, '2020-01-13', 1156.25),
-    (1, 5, '2020-01-14', 1156.28),
-    (1, 5, '2020-01-15', 1156.84),
-    (1, 5, '2020-01-16', 1160.86),
-    (1, 5, '2020-01-17', 1159.08),
-    (1, 5, '2020-01-20', NULL),
-    (1, 5, '2020-01-21', 1166.56),
-    (1, 5, '2020-01-22', 1165.68),
-    (1, 5, '2020-01-23', 1168.38),
-    (1, 5, '2020-01-24', 1166.91),
-    (1, 5, '2020-01-27', 1176.2),
-    (1, 5, '2020-01-28', 1176.23),
-    (1, 5, '2020-01-29', 1176.98),
-    (1, 5, '2020-01-30', 1191.94),
-    (1, 5, '2020-01-31', 1191.3),
-    (1, 5, '2020-02-03', 1194.8),
-    (1, 5, '2020-02-04', 1184.51),
-    (1, 5, '2020-02-05', 1187.25),
-    (1, 5, '2020-02-06', 1179.64),
-    (1, 5, '2020-02-07', 1186.24),
-    (1, 5, '2020-02-10', 1186.96),
-    (1, 5, '2020-02-11', 1181.32),
-    (1, 5, '2020-02-12', 1178.98),
-    (1, 5, '2020-02-13', 1182.02),
-    (1, 5, '2020-02-14', 1183.2),
-    (1, 5, '2020-02-17', NULL),
-    (1, 5, '2020-02-18', 1190.6),
-    (1, 5, '2020-02-19', 1191.1),
-    (1, 5, '2020-02-20', 1198.34),
-    (1, 5, '2020-02-21', 1207.91),
-    (1, 5, '2020-02-24', 1218.5),
-    (1, 5, '2020-02-25', 1214.45),
-    (1, 5, '2020-02-26', 1213.61),
-    (1, 5, '2020-02-27', 1217.03),
-    (1, 5, '2020-02-28', 1214.92),
-    (1, 5, '2020-03-02', 1190.89),
-    (1, 5, '2020-03-03', 1194.66),
-    (1, 5, '2020-03-04', 1187.54),
-    (1, 5, '2020-03-05', 1181.11),
-    (1, 5, '2020-03-06', 1192.0),
-    (1, 5, '2020-03-09', 1203.75),
-    (1, 5, '2020-03-10', 1193.1),
-    (1, 5, '2020-03-11', 1194.16),
-    (1, 5, '2020-03-12', 1206.19),
-    (1, 5, '2020-03-13', 1211.7),
-    (1, 5, '2020-03-16', 1225.33),
-    (1, 5, '2020-03-17', 1240.98),
-    (1, 5, '2020-03-18', 1245.19),
-    (1, 5, '2020-03-19', 1250.86),
-    (1, 5, '2020-03-20', 1250.55),
-    (1, 5, '2020-03-23', 1267.25),
-    (1, 5, '2020-03-24', 1249.88),
-    (1, 5, '2020-03-25', 1228.15),
-    (1, 5, '2020-03-26', 1233.47),
-    (1, 5, '2020-03-27', 1210.49),
-    (1, 5, '2020-03-30', 1224.11),
-    (1, 5, '2020-03-31', 1218.92),
-    (1, 5, '2020-04-01', 1229.65),
-    (1, 5, '2020-04-02', 1229.89),
-    (1, 5, '2020-04-03', 1230.56),
-    (1, 5, '2020-04-06', 1224.6),
-    (1, 5, '2020-04-07', 1220.43),
-    (1, 5, '2020-04-08', 1220.77),
-    (1, 5, '2020-04-09', 1219.71),
-    (1, 5, '2020-04-10', 1211.71),
-    (1, 5, '2020-04-13', 1217.72),
-    (1, 5, '2020-04-14', 1216.85),
-    (1, 5, '2020-04-15', 1224.55),
-    (1, 5, '2020-04-16', 1228.21),
-    (1, 5, '2020-04-17', 1217.81),
-    (1, 5, '2020-04-20', 1217.29),
-    (1, 5, '2020-04-21', 1229.28),
-    (1, 5, '2020-04-22', 1234.79),
-    (1, 5, '2020-04-23', 1229.04),
-    (1, 5, '2020-04-24', 1233.88),
-    (1, 5, '2020-04-27', 1224.16),
-    (1, 5, '2020-04-28', 1224.83),
-    (1, 5, '2020-04-29', 1219.2),
-    (1, 5, '2020-04-30', 1203.95),
-    (1, 5, '2020-05-01', 1223.2),
-    (1, 5, '2020-05-04', 1228.81),
-    (1, 5, '2020-05-05', 1220.13),
-    (1, 5, '2020-05-06', 1222.03),
-    (1, 5, '2020-05-07', 1224.76),
-    (1, 5, '2020-05-08', 1216.47),
-    (1, 5, '2020-05-11', 1220.2),
-    (1, 5, '2020-05-12', 1222.0),
-    (1, 5, '2020-05-13', 1223.86),
-    (1, 5, '2020-05-14', 1227.76),
-    (1, 5, '2020-05-15', 1230.71),
-    (1, 5, '2020-05-18', 1232.32),
-    (1, 5, '2020-05-19', 1225.19),
-    (1, 5, '2020-05-20', 1230.02),
-    (1, 5, '2020-05-21', 1230.53),
-    (1, 5, '2020-05-22', 1236.53),
-    (1, 5, '2020-05-25', NULL),
-    (1, 5, '2020-05-26', 1234.24),
-    (1, 5, '2020-05-27', 1238.03),
-    (1, 5, '2020-05-28', 1239.28),
-    (1, 5, '2020-05-29', 1236.61),
-    (1, 5, '2020-06-01', 1224.79),
-    (1, 5, '2020-06-02', 1225.07),
-    (1, 5, '2020-06-03', 1216.08),
-    (1, 5, '2020-06-04', 1218.77),
-    (1, 5, '2020-06-05', 1202.79),
-    (1, 5, '2020-06-08', 1197.84),
-    (1, 5, '2020-06-09', 1197.04),
-    (1, 5, '2020-06-10', 1189.9),
-    (1, 5, '2020-06-11', 1196.34),
-    (1, 5, '2020-06-12', 1204.52),
-    (1, 5, '2020-06-15', 1212.21),
-    (1, 5, '2020-06-16', 1207.26),
-    (1, 5, '2020-06-17', 1215.02),
-    (1, 5, '2020-06-18', 1211.65),
-    (1, 5, '2020-06-19', 1208.38),
-    (1, 5, '2020-06-22', 1210.73),
-    (1, 5, '2020-06-23', 1206.43),
-    (1, 5, '2020-06-24', 1199.55),
-    (1, 5, '2020-06-25', 1203.59),
-    (1, 5, '2020-06-26', 1203.44),
-    (1, 5, '2020-06-29', 1200.91),
-    (1, 5, '2020-06-30', 1200.5),
-    (1, 5, '2020-07-01', 1201.84),
-    (1, 5, '2020-07-02', 1199.84),
-    (1, 5, '2020-07-03', NULL),
-    (1, 5, '2020-07-06', 1192.66),
-    (1, 5, '2020-07-07', 1194.43),
-    (1, 5, '2020-07-08', 1194.63),
-    (1, 5, '2020-07-09', 1197.84),
-    (1, 5, '2020-07-10', 1204.34),
-    (1, 5, '2020-07-13', 1200.13),
-    (1, 5, '2020-07-14', 1205.24),
-    (1, 5, '2020-07-15', 1201.97),
-    (1, 5, '2020-07-16', 1202.97),
-    (1, 5, '2020-07-17', 1203.86),
-    (1, 5, '2020-07-20', 1202.26),
-    (1, 5, '2020-07-21', 1194.64),
-    (1, 5, '2020-07-22', 1194.83),
-    (1, 5, '2020-07-23', 1198.91),
-    (1, 5, '2020-07-24', 1203.17),
-    (1, 5, '2020-07-27', 1195.94),
-    (1, 5, '2020-07-28', 1198.15),
-    (1, 5, '2020-07-29', 1192.98),
-    (1, 5, '2020-07-30', 1196.3),
-    (1, 5, '2020-07-31', 1192.68),
-    (1, 5, '2020-08-03', 1194.31),
-    (1, 5, '2020-08-04', 1194.53),
-    (1, 5, '2020-08-05', 1188.93),
-    (1, 5, '2020-08-06', 1185.29),
-    (1, 5, '2020-08-07', 1189.25),
-    (1, 5, '2020-08-10', 1186.28),
-    (1, 5, '2020-08-11', 1184.84),
-    (1, 5, '2020-08-12', 1184.09),
-    (1, 5, '2020-08-13', 1183.25),
-    (1, 5, '2020-08-14', 1186.53),
-    (1, 5, '2020-08-17', 1184.17),
-    (1, 5, '2020-08-18', 1184.74),
-    (1, 5, '2020-08-19', 1181.06),
-    (1, 5, '2020-08-20', 1187.66),
-    (1, 5, '2020-08-21', 1191.84),
-    (1, 5, '2020-08-24', 1189.11),
-    (1, 5, '2020-08-25', 1188.18),
-    (1, 5, '2020-08-26', 1185.6),
-    (1, 5, '2020-08-27', 1185.22),
-    (1, 5, '2020-08-28', 1181.62),
-    (1, 5, '2020-08-31', 1187.48),
-    (1, 5, '2020-09-01', 1182.94),
-    (1, 5, '2020-09-02', 1189.98),
-    (1, 5, '2020-09-03', 1188.04),
-    (1, 5, '2020-09-04', 1190.6),
-    (1, 5, '2020-09-07', NULL),
-    (1, 5, '2020-09-08', 1188.69),
-    (1, 5, '2020-09-09', 1185.12),
-    (1, 5, '2020-09-10', 1184.41),
-    (1, 5, '2020-09-11', 1186.55),
-    (1, 5, '2020-09-14', 1181.27),
-    (1, 5, '2020-09-15', 1179.99),
-    (1, 5, '2020-09-16', 1176.0),
-    (1, 5, '2020-09-17', 1174.25),
-    (1, 5, '2020-09-18', 1163.2),
-    (1, 5, '2020-09-21', 1166.75),
-    (1, 5, '2020-09-22', 1164.83),
-    (1, 5, '2020-09-23', 1164.56),
-    (1, 5, '2020-09-24', 1170.49),
-    (1, 5, '2020-09-25', 1172.71),
-    (1, 5, '2020-09-28', 1168.08),
-    (1, 5, '2020-09-29', 1169.67),
-    (1, 5, '2020-09-30', 1165.97),
-    (1, 5, '2020-10-01', 1163.05),
-    (1, 5, '2020-10-02', 1166.08),
-    (1, 5, '2020-10-05', 1157.77),
-    (1, 5, '2020-10-06', 1162.0),
-    (1, 5, '2020-10-07', 1158.41),
-    (1, 5, '2020-10-08', 1152.44),
-    (1, 5, '2020-10-09', 1143.46),
-    (1, 5, '2020-10-12', NULL),
-    (1, 5, '2020-10-13', 1147.4),
-    (1, 5, '2020-10-14', 1146.58),
-    (1, 5, '2020-10-15', 1143.03),
-    (1, 5, '2020-10-16', 1146.82),
-    (1, 5, '2020-10-19', 1141.76),
-    (1, 5, '2020-10-20', 1138.65),
-    (1, 5, '2020-10-21', 1132.11),
-    (1, 5, '2020-10-22', 1135.26),
-    (1, 5, '2020-10-23', 1129.77),
-    (1, 5, '2020-10-26', 1127.77),
-    (1, 5, '2020-10-27', 1127.9),
-    (1, 5, '2020-10-28', 1133.76),
-    (1, 5, '2020-10-29', 1131.12),
-    (1, 5, '2020-10-30', 1133.99),
-    (1, 5, '2020-11-02', 1134.62),
-    (1, 5, '2020-11-03', 1134.3),
-    (1, 5, '2020-11-04', 1137.37),
-    (1, 5, '2020-11-05', 1122.96),
-    (1, 5, '2020-11-06', 1121.0),
-    (1, 5, '2020-11-09', 1113.14),
-    (1, 5, '2020-11-10', 1117.63),
-    (1, 5, '2020-11-11', NULL),
-    (1, 5, '2020-11-12', 1112.95),
-    (1, 5, '2020-11-13', 1109.47),
-    (1, 5, '2020-11-16', 1106.88),
-    (1, 5, '2020-11-17', 1105.33),
-    (1, 5, '2020-11-18', 1105.72),
-    (1, 5, '2020-11-19', 1114.93),
-    (1, 5, '2020-11-20', 1114.48),
-    (1, 5, '2020-11-23', 1115.42),
-    (1, 5, '2020-11-24', 1110.93),
-    (1, 5, '2020-11-25', 1106.84),
-    (1, 5, '2020-11-26', NULL),
-    (1, 5, '2020-11-27', NULL),
-    (1, 5, '2020-11-30', 1105.79),
-    (1, 5, '2020-12-01', 1107.33),
-    (1, 5, '2020-12-02', 1100.15),
-    (1, 5, '2020-12-03', 1096.28),
-    (1, 5, '2020-12-04', 1081.85),
-    (1, 5, '2020-12-07', 1082.22),
-    (1, 5, '2020-12-08', 1085.4),
-    (1, 5, '2020-12-09', 1083.78),
-    (1, 5, '2020-12-10', 1086.83),
-    (1, 5, '2020-12-11', 1091.23),
-    (1, 5, '2020-12-14', 1093.19),
-    (1, 5, '2020-12-15', 1092.22),
-    (1, 5, '2020-12-16', 1093.71),
-    (1, 5, '2020-12-17', 1092.96),
-    (1, 5, '2020-12-18', 1099.37),
-    (1, 5, '2020-12-21', 1107.03),
-    (1, 5, '2020-12-22', 1107.12),
-    (1, 5, '2020-12-23', 1106.92),
-    (1, 5, '2020-12-24', NULL),
-    (1, 5, '2020-12-25', NULL),
-    (1, 5, '2020-12-28', 1096.67),
-    (1, 5, '2020-12-29', 1092.55),
-    (1, 5, '2020-12-30', 1086.62),
-    (1, 5, '2020-12-31', 1086.11),
-    (1, 5, '2021-01-01', NULL),
-    (1, 5, '2021-01-04', 1081.62),
-    (1, 5, '2021-01-05', 1087.42),
-    (1, 5, '2021-01-06', 1085.71),
-    (1, 5, '2021-01-07', 1086.78),
-    (1, 5, '2021-01-08', 1089.48),
-    (1, 5, '2021-01-11', 1097.39),
-    (1, 5, '2021-01-12', 1099.57),
-    (1, 5, '2021-01-13', 1098.31),
-    (1, 5, '2021-01-14', 1098.37),
-    (1, 5, '2021-01-15', 1099.39),
-    (1, 5, '2021-01-18', NULL),
-    (1, 5, '2021-01-19', 1102.14),
-    (1, 5, '2021-01-20', NULL),
-    (1, 5, '2021-01-21', 1097.91),
-    (1, 5, '2021-01-22', 1105.5),
-    (1, 5, '2021-01-25', 1101.02),
-    (1, 5, '2021-01-26', 1103.26),
-    (1, 5, '2021-01-27', 1104.09),
-    (1, 5, '2021-01-28', 1111.95),
-    (1, 5, '2021-01-29', 1118.35);
\ No newline at end of file
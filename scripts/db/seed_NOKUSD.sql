@@ -1,1316 +0,0 @@
--- NOK 6
--- USD 1
-INSERT INTO
-    exchange_rates (
-        destination_currency_id,
-        source_currency_id,
-        date,
-        rate
-    )
-VALUES
-    (1, 6, '2016-01-29', 8.7071),
-    (1, 6, '2016-02-01', 8.7104),
-    (1, 6, '2016-02-02', 8.7184),
-    (1, 6, '2016-02-03', 8.6074),
-    (1, 6, '2016-02-04', 8.4820),
-    (1, 6, '2016-02-05', 8.6002),
-    (1, 6, '2016-02-08', 8.5956),
-    (1, 6, '2016-02-09', 8.5929),
-    (1, 6, '2016-02-10', 8.5613),
-    (1, 6, '2016-02-11', 8.5465),
-    (1, 6, '2016-02-12', 8.5917),
-    (1, 6, '2016-02-15', NULL),
-    (1, 6, '2016-02-16', 8.6203),
-    (1, 6, '2016-02-17', 8.5696),
-    (1, 6, '2016-02-18', 8.5961),
-    (1, 6, '2016-02-19', 8.5654),
-    (1, 6, '2016-02-22', 8.5997),
-    (1, 6, '2016-02-23', 8.5984),
-    (1, 6, '2016-02-24', 8.6713),
-    (1, 6, '2016-02-25', 8.6532),
-    (1, 6, '2016-02-26', 8.6826),
-    (1, 6, '2016-02-29', 8.6919),
-    (1, 6, '2016-03-01', 8.6574),
-    (1, 6, '2016-03-02', 8.7023),
-    (1, 6, '2016-03-03', 8.5932),
-    (1, 6, '2016-03-04', 8.5072),
-    (1, 6, '2016-03-07', 8.4984),
-    (1, 6, '2016-03-08', 8.5397),
-    (1, 6, '2016-03-09', 8.5066),
-    (1, 6, '2016-03-10', 8.5309),
-    (1, 6, '2016-03-11', 8.3974),
-    (1, 6, '2016-03-14', 8.4710),
-    (1, 6, '2016-03-15', 8.5487),
-    (1, 6, '2016-03-16', 8.5798),
-    (1, 6, '2016-03-17', 8.3467),
-    (1, 6, '2016-03-18', 8.3382),
-    (1, 6, '2016-03-21', 8.3938),
-    (1, 6, '2016-03-22', 8.4002),
-    (1, 6, '2016-03-23', 8.4854),
-    (1, 6, '2016-03-24', 8.4995),
-    (1, 6, '2016-03-25', 8.4853),
-    (1, 6, '2016-03-28', 8.4195),
-    (1, 6, '2016-03-29', 8.4597),
-    (1, 6, '2016-03-30', 8.3215),
-    (1, 6, '2016-03-31', 8.2520),
-    (1, 6, '2016-04-01', 8.3127),
-    (1, 6, '2016-04-04', 8.3098),
-    (1, 6, '2016-04-05', 8.3486),
-    (1, 6, '2016-04-06', 8.2844),
-    (1, 6, '2016-04-07', 8.3254),
-    (1, 6, '2016-04-08', 8.2230),
-    (1, 6, '2016-04-11', 8.1972),
-    (1, 6, '2016-04-12', 8.1703),
-    (1, 6, '2016-04-13', 8.2299),
-    (1, 6, '2016-04-14', 8.2241),
-    (1, 6, '2016-04-15', 8.2278),
-    (1, 6, '2016-04-18', 8.2129),
-    (1, 6, '2016-04-19', 8.1119),
-    (1, 6, '2016-04-20', 8.1050),
-    (1, 6, '2016-04-21', 8.1741),
-    (1, 6, '2016-04-22', 8.2170),
-    (1, 6, '2016-04-25', 8.1893),
-    (1, 6, '2016-04-26', 8.1360),
-    (1, 6, '2016-04-27', 8.1573),
-    (1, 6, '2016-04-28', 8.1507),
-    (1, 6, '2016-04-29', 8.0643),
-    (1, 6, '2016-05-02', 8.0361),
-    (1, 6, '2016-05-03', 8.0891),
-    (1, 6, '2016-05-04', 8.1466),
-    (1, 6, '2016-05-05', 8.1613),
-    (1, 6, '2016-05-06', 8.1868),
-    (1, 6, '2016-05-09', 8.2147),
-    (1, 6, '2016-05-10', 8.2055),
-    (1, 6, '2016-05-11', 8.1339),
-    (1, 6, '2016-05-12', 8.1522),
-    (1, 6, '2016-05-13', 8.2157),
-    (1, 6, '2016-05-16', 8.1476),
-    (1, 6, '2016-05-17', 8.1578),
-    (1, 6, '2016-05-18', 8.2253),
-    (1, 6, '2016-05-19', 8.3497),
-    (1, 6, '2016-05-20', 8.3272),
-    (1, 6, '2016-05-23', 8.3650),
-    (1, 6, '2016-05-24', 8.3515),
-    (1, 6, '2016-05-25', 8.3389),
-    (1, 6, '2016-05-26', 8.2705),
-    (1, 6, '2016-05-27', 8.3221),
-    (1, 6, '2016-05-30', NULL),
-    (1, 6, '2016-05-31', 8.3678),
-    (1, 6, '2016-06-01', 8.3099),
-    (1, 6, '2016-06-02', 8.3355),
-    (1, 6, '2016-06-03', 8.2017),
-    (1, 6, '2016-06-06', 8.1761),
-    (1, 6, '2016-06-07', 8.1332),
-    (1, 6, '2016-06-08', 8.1101),
-    (1, 6, '2016-06-09', 8.1486),
-    (1, 6, '2016-06-10', 8.2325),
-    (1, 6, '2016-06-13', 8.2787),
-    (1, 6, '2016-06-14', 8.3513),
-    (1, 6, '2016-06-15', 8.3130),
-    (1, 6, '2016-06-16', 8.4505),
-    (1, 6, '2016-06-17', 8.3841),
-    (1, 6, '2016-06-20', 8.2614),
-    (1, 6, '2016-06-21', 8.3092),
-    (1, 6, '2016-06-22', 8.2902),
-    (1, 6, '2016-06-23', 8.1661),
-    (1, 6, '2016-06-24', 8.4412),
-    (1, 6, '2016-06-27', 8.5699),
-    (1, 6, '2016-06-28', 8.5082),
-    (1, 6, '2016-06-29', 8.3763),
-    (1, 6, '2016-06-30', 8.3931),
-    (1, 6, '2016-07-01', 8.3279),
-    (1, 6, '2016-07-04', NULL),
-    (1, 6, '2016-07-05', 8.4065),
-    (1, 6, '2016-07-06', 8.4724),
-    (1, 6, '2016-07-07', 8.4940),
-    (1, 6, '2016-07-08', 8.5421),
-    (1, 6, '2016-07-11', 8.4990),
-    (1, 6, '2016-07-12', 8.4157),
-    (1, 6, '2016-07-13', 8.4159),
-    (1, 6, '2016-07-14', 8.3653),
-    (1, 6, '2016-07-15', 8.4520),
-    (1, 6, '2016-07-18', 8.4489),
-    (1, 6, '2016-07-19', 8.5075),
-    (1, 6, '2016-07-20', 8.4929),
-    (1, 6, '2016-07-21', 8.4697),
-    (1, 6, '2016-07-22', 8.5524),
-    (1, 6, '2016-07-25', 8.5847),
-    (1, 6, '2016-07-26', 8.5798),
-    (1, 6, '2016-07-27', 8.5910),
-    (1, 6, '2016-07-28', 8.5468),
-    (1, 6, '2016-07-29', 8.4250),
-    (1, 6, '2016-08-01', 8.4750),
-    (1, 6, '2016-08-02', 8.4295),
-    (1, 6, '2016-08-03', 8.4277),
-    (1, 6, '2016-08-04', 8.4283),
-    (1, 6, '2016-08-05', 8.4981),
-    (1, 6, '2016-08-08', 8.4772),
-    (1, 6, '2016-08-09', 8.3952),
-    (1, 6, '2016-08-10', 8.2804),
-    (1, 6, '2016-08-11', 8.2219),
-    (1, 6, '2016-08-12', 8.1874),
-    (1, 6, '2016-08-15', 8.2086),
-    (1, 6, '2016-08-16', 8.1940),
-    (1, 6, '2016-08-17', 8.2419),
-    (1, 6, '2016-08-18', 8.1562),
-    (1, 6, '2016-08-19', 8.2137),
-    (1, 6, '2016-08-22', 8.2256),
-    (1, 6, '2016-08-23', 8.2110),
-    (1, 6, '2016-08-24', 8.2249),
-    (1, 6, '2016-08-25', 8.2223),
-    (1, 6, '2016-08-26', 8.2343),
-    (1, 6, '2016-08-29', 8.2881),
-    (1, 6, '2016-08-30', 8.3362),
-    (1, 6, '2016-08-31', 8.3438),
-    (1, 6, '2016-09-01', 8.3256),
-    (1, 6, '2016-09-02', 8.3206),
-    (1, 6, '2016-09-05', NULL),
-    (1, 6, '2016-09-06', 8.1862),
-    (1, 6, '2016-09-07', 8.1839),
-    (1, 6, '2016-09-08', 8.1934),
-    (1, 6, '2016-09-09', 8.2573),
-    (1, 6, '2016-09-12', 8.2301),
-    (1, 6, '2016-09-13', 8.2491),
-    (1, 6, '2016-09-14', 8.2232),
-    (1, 6, '2016-09-15', 8.2309),
-    (1, 6, '2016-09-16', 8.3020),
-    (1, 6, '2016-09-19', 8.2822),
-    (1, 6, '2016-09-20', 8.2961),
-    (1, 6, '2016-09-21', 8.2941),
-    (1, 6, '2016-09-22', 8.1070),
-    (1, 6, '2016-09-23', 8.1099),
-    (1, 6, '2016-09-26', 8.0889),
-    (1, 6, '2016-09-27', 8.1532),
-    (1, 6, '2016-09-28', 8.0828),
-    (1, 6, '2016-09-29', 8.0040),
-    (1, 6, '2016-09-30', 7.9897),
-    (1, 6, '2016-10-03', 7.9725),
-    (1, 6, '2016-10-04', 7.9934),
-    (1, 6, '2016-10-05', 8.0317),
-    (1, 6, '2016-10-06', 8.0431),
-    (1, 6, '2016-10-07', 8.1198),
-    (1, 6, '2016-10-10', NULL),
-    (1, 6, '2016-10-11', 8.1872),
-    (1, 6, '2016-10-12', 8.2290),
-    (1, 6, '2016-10-13', 8.1870),
-    (1, 6, '2016-10-14', 8.2257),
-    (1, 6, '2016-10-17', 8.1820),
-    (1, 6, '2016-10-18', 8.1468),
-    (1, 6, '2016-10-19', 8.1423),
-    (1, 6, '2016-10-20', 8.1841),
-    (1, 6, '2016-10-21', 8.2636),
-    (1, 6, '2016-10-24', 8.2463),
-    (1, 6, '2016-10-25', 8.2593),
-    (1, 6, '2016-10-26', 8.2620),
-    (1, 6, '2016-10-27', 8.2536),
-    (1, 6, '2016-10-28', 8.2647),
-    (1, 6, '2016-10-31', 8.2501),
-    (1, 6, '2016-11-01', 8.1986),
-    (1, 6, '2016-11-02', 8.1826),
-    (1, 6, '2016-11-03', 8.1660),
-    (1, 6, '2016-11-04', 8.1751),
-    (1, 6, '2016-11-07', 8.2355),
-    (1, 6, '2016-11-08', 8.2277),
-    (1, 6, '2016-11-09', 8.2885),
-    (1, 6, '2016-11-10', 8.3883),
-    (1, 6, '2016-11-11', NULL),
-    (1, 6, '2016-11-14', 8.4973),
-    (1, 6, '2016-11-15', 8.4359),
-    (1, 6, '2016-11-16', 8.4915),
-    (1, 6, '2016-11-17', 8.5061),
-    (1, 6, '2016-11-18', 8.5850),
-    (1, 6, '2016-11-21', 8.5630),
-    (1, 6, '2016-11-22', 8.5354),
-    (1, 6, '2016-11-23', 8.6025),
-    (1, 6, '2016-11-24', NULL),
-    (1, 6, '2016-11-25', 8.5757),
-    (1, 6, '2016-11-28', 8.5702),
-    (1, 6, '2016-11-29', 8.5460),
-    (1, 6, '2016-11-30', 8.5223),
-    (1, 6, '2016-12-01', 8.4446),
-    (1, 6, '2016-12-02', 8.4152),
-    (1, 6, '2016-12-05', 8.3801),
-    (1, 6, '2016-12-06', 8.3907),
-    (1, 6, '2016-12-07', 8.3641),
-    (1, 6, '2016-12-08', 8.4326),
-    (1, 6, '2016-12-09', 8.5199),
-    (1, 6, '2016-12-12', 8.4506),
-    (1, 6, '2016-12-13', 8.4178),
-    (1, 6, '2016-12-14', 8.4628),
-    (1, 6, '2016-12-15', 8.6916),
-    (1, 6, '2016-12-16', 8.6706),
-    (1, 6, '2016-12-19', 8.6555),
-    (1, 6, '2016-12-20', 8.6995),
-    (1, 6, '2016-12-21', 8.6671),
-    (1, 6, '2016-12-22', 8.7014),
-    (1, 6, '2016-12-23', 8.7043),
-    (1, 6, '2016-12-26', NULL),
-    (1, 6, '2016-12-27', 8.7092),
-    (1, 6, '2016-12-28', 8.7485),
-    (1, 6, '2016-12-29', 8.6598),
-    (1, 6, '2016-12-30', 8.6107),
-    (1, 6, '2017-01-02', NULL),
-    (1, 6, '2017-01-03', 8.6489),
-    (1, 6, '2017-01-04', 8.5925),
-    (1, 6, '2017-01-05', 8.4944),
-    (1, 6, '2017-01-06', 8.5193),
-    (1, 6, '2017-01-09', 8.5454),
-    (1, 6, '2017-01-10', 8.5949),
-    (1, 6, '2017-01-11', 8.6240),
-    (1, 6, '2017-01-12', 8.4988),
-    (1, 6, '2017-01-13', 8.5060),
-    (1, 6, '2017-01-16', NULL),
-    (1, 6, '2017-01-17', 8.4667),
-    (1, 6, '2017-01-18', 8.4536),
-    (1, 6, '2017-01-19', 8.4648),
-    (1, 6, '2017-01-20', NULL),
-    (1, 6, '2017-01-23', 8.3693),
-    (1, 6, '2017-01-24', 8.3274),
-    (1, 6, '2017-01-25', 8.3213),
-    (1, 6, '2017-01-26', 8.3583),
-    (1, 6, '2017-01-27', 8.3346),
-    (1, 6, '2017-01-30', 8.3181),
-    (1, 6, '2017-01-31', 8.2444),
-    (1, 6, '2017-02-01', 8.2492),
-    (1, 6, '2017-02-02', 8.2157),
-    (1, 6, '2017-02-03', 8.2061),
-    (1, 6, '2017-02-06', 8.2552),
-    (1, 6, '2017-02-07', 8.3121),
-    (1, 6, '2017-02-08', 8.3037),
-    (1, 6, '2017-02-09', 8.3380),
-    (1, 6, '2017-02-10', 8.3656),
-    (1, 6, '2017-02-13', 8.3858),
-    (1, 6, '2017-02-14', 8.4049),
-    (1, 6, '2017-02-15', 8.3425),
-    (1, 6, '2017-02-16', 8.2965),
-    (1, 6, '2017-02-17', 8.3382),
-    (1, 6, '2017-02-20', NULL),
-    (1, 6, '2017-02-21', 8.3553),
-    (1, 6, '2017-02-22', 8.3638),
-    (1, 6, '2017-02-23', 8.3317),
-    (1, 6, '2017-02-24', 8.3375),
-    (1, 6, '2017-02-27', 8.3400),
-    (1, 6, '2017-02-28', 8.3667),
-    (1, 6, '2017-03-01', 8.3996),
-    (1, 6, '2017-03-02', 8.4667),
-    (1, 6, '2017-03-03', 8.4754),
-    (1, 6, '2017-03-06', 8.4835),
-    (1, 6, '2017-03-07', 8.4489),
-    (1, 6, '2017-03-08', 8.5094),
-    (1, 6, '2017-03-09', 8.5684),
-    (1, 6, '2017-03-10', 8.6034),
-    (1, 6, '2017-03-13', 8.5456),
-    (1, 6, '2017-03-14', 8.5977),
-    (1, 6, '2017-03-15', 8.5983),
-    (1, 6, '2017-03-16', 8.5170),
-    (1, 6, '2017-03-17', 8.4633),
-    (1, 6, '2017-03-20', 8.4648),
-    (1, 6, '2017-03-21', 8.4609),
-    (1, 6, '2017-03-22', 8.4659),
-    (1, 6, '2017-03-23', 8.4811),
-    (1, 6, '2017-03-24', 8.4926),
-    (1, 6, '2017-03-27', 8.4662),
-    (1, 6, '2017-03-28', 8.4915),
-    (1, 6, '2017-03-29', 8.5214),
-    (1, 6, '2017-03-30', 8.5484),
-    (1, 6, '2017-03-31', 8.5756),
-    (1, 6, '2017-04-03', 8.5918),
-    (1, 6, '2017-04-04', 8.5918),
-    (1, 6, '2017-04-05', 8.5984),
-    (1, 6, '2017-04-06', 8.6163),
-    (1, 6, '2017-04-07', 8.6268),
-    (1, 6, '2017-04-10', 8.6175),
-    (1, 6, '2017-04-11', 8.5961),
-    (1, 6, '2017-04-12', 8.5817),
-    (1, 6, '2017-04-13', 8.5506),
-    (1, 6, '2017-04-14', 8.5741),
-    (1, 6, '2017-04-17', 8.5355),
-    (1, 6, '2017-04-18', 8.5192),
-    (1, 6, '2017-04-19', 8.5757),
-    (1, 6, '2017-04-20', 8.5861),
-    (1, 6, '2017-04-21', 8.6779),
-    (1, 6, '2017-04-24', 8.5628),
-    (1, 6, '2017-04-25', 8.5518),
-    (1, 6, '2017-04-26', 8.5902),
-    (1, 6, '2017-04-27', 8.5935),
-    (1, 6, '2017-04-28', 8.5726),
-    (1, 6, '2017-05-01', 8.5838),
-    (1, 6, '2017-05-02', 8.5941),
-    (1, 6, '2017-05-03', 8.6191),
-    (1, 6, '2017-05-04', 8.6774),
-    (1, 6, '2017-05-05', 8.6022),
-    (1, 6, '2017-05-08', 8.6445),
-    (1, 6, '2017-05-09', 8.6802),
-    (1, 6, '2017-05-10', 8.6080),
-    (1, 6, '2017-05-11', 8.5924),
-    (1, 6, '2017-05-12', 8.5713),
-    (1, 6, '2017-05-15', 8.5416),
-    (1, 6, '2017-05-16', 8.4664),
-    (1, 6, '2017-05-17', 8.3977),
-    (1, 6, '2017-05-18', 8.4431),
-    (1, 6, '2017-05-19', 8.3866),
-    (1, 6, '2017-05-22', 8.3464),
-    (1, 6, '2017-05-23', 8.3694),
-    (1, 6, '2017-05-24', 8.3928),
-    (1, 6, '2017-05-25', 8.3531),
-    (1, 6, '2017-05-26', 8.3935),
-    (1, 6, '2017-05-29', NULL),
-    (1, 6, '2017-05-30', 8.4304),
-    (1, 6, '2017-05-31', 8.4361),
-    (1, 6, '2017-06-01', 8.4392),
-    (1, 6, '2017-06-02', 8.4237),
-    (1, 6, '2017-06-05', 8.4585),
-    (1, 6, '2017-06-06', 8.4671),
-    (1, 6, '2017-06-07', 8.4912),
-    (1, 6, '2017-06-08', 8.4850),
-    (1, 6, '2017-06-09', 8.4968),
-    (1, 6, '2017-06-12', 8.4655),
-    (1, 6, '2017-06-13', 8.4350),
-    (1, 6, '2017-06-14', 8.3662),
-    (1, 6, '2017-06-15', 8.5007),
-    (1, 6, '2017-06-16', 8.4555),
-    (1, 6, '2017-06-19', 8.4780),
-    (1, 6, '2017-06-20', 8.5613),
-    (1, 6, '2017-06-21', 8.5336),
-    (1, 6, '2017-06-22', 8.4952),
-    (1, 6, '2017-06-23', 8.4471),
-    (1, 6, '2017-06-26', 8.4667),
-    (1, 6, '2017-06-27', 8.4107),
-    (1, 6, '2017-06-28', 8.4498),
-    (1, 6, '2017-06-29', 8.4024),
-    (1, 6, '2017-06-30', 8.3781),
-    (1, 6, '2017-07-03', 8.3647),
-    (1, 6, '2017-07-04', NULL),
-    (1, 6, '2017-07-05', 8.4027),
-    (1, 6, '2017-07-06', 8.3543),
-    (1, 6, '2017-07-07', 8.3713),
-    (1, 6, '2017-07-10', 8.3304),
-    (1, 6, '2017-07-11', 8.3265),
-    (1, 6, '2017-07-12', 8.2812),
-    (1, 6, '2017-07-13', 8.2682),
-    (1, 6, '2017-07-14', 8.1977),
-    (1, 6, '2017-07-17', 8.1513),
-    (1, 6, '2017-07-18', 8.0632),
-    (1, 6, '2017-07-19', 8.0645),
-    (1, 6, '2017-07-20', 8.0243),
-    (1, 6, '2017-07-21', 8.0279),
-    (1, 6, '2017-07-24', 8.0136),
-    (1, 6, '2017-07-25', 7.9765),
-    (1, 6, '2017-07-26', 7.9595),
-    (1, 6, '2017-07-27', 7.9578),
-    (1, 6, '2017-07-28', 7.9174),
-    (1, 6, '2017-07-31', 7.8922),
-    (1, 6, '2017-08-01', 7.9235),
-    (1, 6, '2017-08-02', 7.8677),
-    (1, 6, '2017-08-03', 7.8803),
-    (1, 6, '2017-08-04', 7.9550),
-    (1, 6, '2017-08-07', 7.9453),
-    (1, 6, '2017-08-08', 7.9578),
-    (1, 6, '2017-08-09', 7.9602),
-    (1, 6, '2017-08-10', 7.9446),
-    (1, 6, '2017-08-11', 7.9482),
-    (1, 6, '2017-08-14', 7.9370),
-    (1, 6, '2017-08-15', 7.9603),
-    (1, 6, '2017-08-16', 7.9530),
-    (1, 6, '2017-08-17', 7.9504),
-    (1, 6, '2017-08-18', 7.9074),
-    (1, 6, '2017-08-21', 7.8975),
-    (1, 6, '2017-08-22', 7.9137),
-    (1, 6, '2017-08-23', 7.8776),
-    (1, 6, '2017-08-24', 7.8415),
-    (1, 6, '2017-08-25', 7.7775),
-    (1, 6, '2017-08-28', 7.7583),
-    (1, 6, '2017-08-29', 7.7233),
-    (1, 6, '2017-08-30', 7.7834),
-    (1, 6, '2017-08-31', 7.7723),
-    (1, 6, '2017-09-01', 7.7916),
-    (1, 6, '2017-09-04', NULL),
-    (1, 6, '2017-09-05', 7.7775),
-    (1, 6, '2017-09-06', 7.7713),
-    (1, 6, '2017-09-07', 7.7479),
-    (1, 6, '2017-09-08', 7.7414),
-    (1, 6, '2017-09-11', 7.8436),
-    (1, 6, '2017-09-12', 7.8267),
-    (1, 6, '2017-09-13', 7.8898),
-    (1, 6, '2017-09-14', 7.8986),
-    (1, 6, '2017-09-15', 7.8427),
-    (1, 6, '2017-09-18', 7.8294),
-    (1, 6, '2017-09-19', 7.8067),
-    (1, 6, '2017-09-20', 7.8066),
-    (1, 6, '2017-09-21', 7.8006),
-    (1, 6, '2017-09-22', 7.7833),
-    (1, 6, '2017-09-25', 7.8325),
-    (1, 6, '2017-09-26', 7.9091),
-    (1, 6, '2017-09-27', 7.9495),
-    (1, 6, '2017-09-28', 7.9530),
-    (1, 6, '2017-09-29', 7.9629),
-    (1, 6, '2017-10-02', 7.9990),
-    (1, 6, '2017-10-03', 7.9695),
-    (1, 6, '2017-10-04', 7.9419),
-    (1, 6, '2017-10-05', 7.9948),
-    (1, 6, '2017-10-06', 8.0051),
-    (1, 6, '2017-10-09', NULL),
-    (1, 6, '2017-10-10', 7.9390),
-    (1, 6, '2017-10-11', 7.9212),
-    (1, 6, '2017-10-12', 7.8928),
-    (1, 6, '2017-10-13', 7.8789),
-    (1, 6, '2017-10-16', 7.8818),
-    (1, 6, '2017-10-17', 7.9507),
-    (1, 6, '2017-10-18', 7.9675),
-    (1, 6, '2017-10-19', 7.9498),
-    (1, 6, '2017-10-20', 7.9941),
-    (1, 6, '2017-10-23', 7.9849),
-    (1, 6, '2017-10-24', 7.9927),
-    (1, 6, '2017-10-25', 8.0304),
-    (1, 6, '2017-10-26', 8.1356),
-    (1, 6, '2017-10-27', 8.1811),
-    (1, 6, '2017-10-30', 8.1623),
-    (1, 6, '2017-10-31', 8.1634),
-    (1, 6, '2017-11-01', 8.1596),
-    (1, 6, '2017-11-02', 8.1392),
-    (1, 6, '2017-11-03', 8.1796),
-    (1, 6, '2017-11-06', 8.1482),
-    (1, 6, '2017-11-07', 8.1820),
-    (1, 6, '2017-11-08', 8.1670),
-    (1, 6, '2017-11-09', 8.1290),
-    (1, 6, '2017-11-10', NULL),
-    (1, 6, '2017-11-13', 8.1774),
-    (1, 6, '2017-11-14', 8.2016),
-    (1, 6, '2017-11-15', 8.2538),
-    (1, 6, '2017-11-16', 8.2095),
-    (1, 6, '2017-11-17', 8.2431),
-    (1, 6, '2017-11-20', 8.2813),
-    (1, 6, '2017-11-21', 8.2128),
-    (1, 6, '2017-11-22', 8.1866),
-    (1, 6, '2017-11-23', NULL),
-    (1, 6, '2017-11-24', 8.1288),
-    (1, 6, '2017-11-27', 8.1470),
-    (1, 6, '2017-11-28', 8.2190),
-    (1, 6, '2017-11-29', 8.2328),
-    (1, 6, '2017-11-30', 8.2929),
-    (1, 6, '2017-12-01', 8.2777),
-    (1, 6, '2017-12-04', 8.3306),
-    (1, 6, '2017-12-05', 8.2819),
-    (1, 6, '2017-12-06', 8.2801),
-    (1, 6, '2017-12-07', 8.2961),
-    (1, 6, '2017-12-08', 8.3082),
-    (1, 6, '2017-12-11', 8.3625),
-    (1, 6, '2017-12-12', 8.3675),
-    (1, 6, '2017-12-13', 8.3761),
-    (1, 6, '2017-12-14', 8.2876),
-    (1, 6, '2017-12-15', 8.3751),
-    (1, 6, '2017-12-18', 8.3358),
-    (1, 6, '2017-12-19', 8.3733),
-    (1, 6, '2017-12-20', 8.3807),
-    (1, 6, '2017-12-21', 8.3773),
-    (1, 6, '2017-12-22', 8.3387),
-    (1, 6, '2017-12-25', NULL),
-    (1, 6, '2017-12-26', 8.3148),
-    (1, 6, '2017-12-27', 8.2720),
-    (1, 6, '2017-12-28', 8.2381),
-    (1, 6, '2017-12-29', 8.1819),
-    (1, 6, '2018-01-01', NULL),
-    (1, 6, '2018-01-02', 8.1304),
-    (1, 6, '2018-01-03', 8.1100),
-    (1, 6, '2018-01-04', 8.0731),
-    (1, 6, '2018-01-05', 8.0480),
-    (1, 6, '2018-01-08', 8.0712),
-    (1, 6, '2018-01-09', 8.1147),
-    (1, 6, '2018-01-10', 8.0718),
-    (1, 6, '2018-01-11', 8.0135),
-    (1, 6, '2018-01-12', 7.9561),
-    (1, 6, '2018-01-15', NULL),
-    (1, 6, '2018-01-16', 7.8682),
-    (1, 6, '2018-01-17', 7.8536),
-    (1, 6, '2018-01-18', 7.8285),
-    (1, 6, '2018-01-19', 7.8528),
-    (1, 6, '2018-01-22', 7.8748),
-    (1, 6, '2018-01-23', 7.8416),
-    (1, 6, '2018-01-24', 7.7679),
-    (1, 6, '2018-01-25', 7.6678),
-    (1, 6, '2018-01-26', 7.6863),
-    (1, 6, '2018-01-29', 7.7270),
-    (1, 6, '2018-01-30', 7.7218),
-    (1, 6, '2018-01-31', 7.7019),
-    (1, 6, '2018-02-01', 7.6671),
-    (1, 6, '2018-02-02', 7.7396),
-    (1, 6, '2018-02-05', 7.7832),
-    (1, 6, '2018-02-06', 7.8253),
-    (1, 6, '2018-02-07', 7.8744),
-    (1, 6, '2018-02-08', 7.9252),
-    (1, 6, '2018-02-09', 7.9867),
-    (1, 6, '2018-02-12', 7.9236),
-    (1, 6, '2018-02-13', 7.8792),
-    (1, 6, '2018-02-14', 7.8429),
-    (1, 6, '2018-02-15', 7.7926),
-    (1, 6, '2018-02-16', 7.7556),
-    (1, 6, '2018-02-19', NULL),
-    (1, 6, '2018-02-20', 7.8331),
-    (1, 6, '2018-02-21', 7.8338),
-    (1, 6, '2018-02-22', 7.8656),
-    (1, 6, '2018-02-23', 7.8415),
-    (1, 6, '2018-02-26', 7.8328),
-    (1, 6, '2018-02-27', 7.8669),
-    (1, 6, '2018-02-28', 7.8798),
-    (1, 6, '2018-03-01', 7.8612),
-    (1, 6, '2018-03-02', 7.8037),
-    (1, 6, '2018-03-05', 7.8249),
-    (1, 6, '2018-03-06', 7.7782),
-    (1, 6, '2018-03-07', 7.818),
-    (1, 6, '2018-03-08', 7.8409),
-    (1, 6, '2018-03-09', 7.7702),
-    (1, 6, '2018-03-12', 7.7555),
-    (1, 6, '2018-03-13', 7.7226),
-    (1, 6, '2018-03-14', 7.737),
-    (1, 6, '2018-03-15', 7.7064),
-    (1, 6, '2018-03-16', 7.7155),
-    (1, 6, '2018-03-19', 7.7293),
-    (1, 6, '2018-03-20', 7.7296),
-    (1, 6, '2018-03-21', 7.7401),
-    (1, 6, '2018-03-22', 7.752),
-    (1, 6, '2018-03-23', 7.7462),
-    (1, 6, '2018-03-26', 7.6858),
-    (1, 6, '2018-03-27', 7.6896),
-    (1, 6, '2018-03-28', 7.8342),
-    (1, 6, '2018-03-29', 7.8511),
-    (1, 6, '2018-03-30', 7.8518),
-    (1, 6, '2018-04-02', 7.8859),
-    (1, 6, '2018-04-03', 7.8586),
-    (1, 6, '2018-04-04', 7.8259),
-    (1, 6, '2018-04-05', 7.8396),
-    (1, 6, '2018-04-06', 7.8281),
-    (1, 6, '2018-04-09', 7.7777),
-    (1, 6, '2018-04-10', 7.8101),
-    (1, 6, '2018-04-11', 7.759),
-    (1, 6, '2018-04-12', 7.7812),
-    (1, 6, '2018-04-13', 7.7621),
-    (1, 6, '2018-04-16', 7.784),
-    (1, 6, '2018-04-17', 7.7765),
-    (1, 6, '2018-04-18', 7.7536),
-    (1, 6, '2018-04-19', 7.7874),
-    (1, 6, '2018-04-20', 7.8223),
-    (1, 6, '2018-04-23', 7.8777),
-    (1, 6, '2018-04-24', 7.8998),
-    (1, 6, '2018-04-25', 7.9701),
-    (1, 6, '2018-04-26', 7.9941),
-    (1, 6, '2018-04-27', 7.9714),
-    (1, 6, '2018-04-30', 8.0232),
-    (1, 6, '2018-05-01', 8.0834),
-    (1, 6, '2018-05-02', 8.115),
-    (1, 6, '2018-05-03', 8.0621),
-    (1, 6, '2018-05-04', 8.0575),
-    (1, 6, '2018-05-07', 8.0474),
-    (1, 6, '2018-05-08', 8.1388),
-    (1, 6, '2018-05-09', 8.0832),
-    (1, 6, '2018-05-10', 8.0206),
-    (1, 6, '2018-05-11', 7.9948),
-    (1, 6, '2018-05-14', 7.9914),
-    (1, 6, '2018-05-15', 8.0953),
-    (1, 6, '2018-05-16', 8.0946),
-    (1, 6, '2018-05-17', 8.1234),
-    (1, 6, '2018-05-18', 8.1132),
-    (1, 6, '2018-05-21', 8.0722),
-    (1, 6, '2018-05-22', 8.0358),
-    (1, 6, '2018-05-23', 8.1085),
-    (1, 6, '2018-05-24', 8.0859),
-    (1, 6, '2018-05-25', 8.1478),
-    (1, 6, '2018-05-28', NULL),
-    (1, 6, '2018-05-29', 8.2907),
-    (1, 6, '2018-05-30', 8.173),
-    (1, 6, '2018-05-31', 8.1849),
-    (1, 6, '2018-06-01', 8.1646),
-    (1, 6, '2018-06-04', 8.1147),
-    (1, 6, '2018-06-05', 8.1344),
-    (1, 6, '2018-06-06', 8.0772),
-    (1, 6, '2018-06-07', 8.0419),
-    (1, 6, '2018-06-08', 8.0574),
-    (1, 6, '2018-06-11', 8.0283),
-    (1, 6, '2018-06-12', 8.013),
-    (1, 6, '2018-06-13', 8.0076),
-    (1, 6, '2018-06-14', 8.0944),
-    (1, 6, '2018-06-15', 8.1373),
-    (1, 6, '2018-06-18', 8.1441),
-    (1, 6, '2018-06-19', 8.1891),
-    (1, 6, '2018-06-20', 8.1488),
-    (1, 6, '2018-06-21', 8.1367),
-    (1, 6, '2018-06-22', 8.1108),
-    (1, 6, '2018-06-25', 8.1182),
-    (1, 6, '2018-06-26', 8.1089),
-    (1, 6, '2018-06-27', 8.1544),
-    (1, 6, '2018-06-28', 8.1895),
-    (1, 6, '2018-06-29', 8.1478),
-    (1, 6, '2018-07-02', 8.1896),
-    (1, 6, '2018-07-03', 8.1355),
-    (1, 6, '2018-07-04', NULL),
-    (1, 6, '2018-07-05', 8.0501),
-    (1, 6, '2018-07-06', 8.0302),
-    (1, 6, '2018-07-09', 8.0155),
-    (1, 6, '2018-07-10', 8.0322),
-    (1, 6, '2018-07-11', 8.059),
-    (1, 6, '2018-07-12', 8.0925),
-    (1, 6, '2018-07-13', 8.1298),
-    (1, 6, '2018-07-16', 8.1021),
-    (1, 6, '2018-07-17', 8.134),
-    (1, 6, '2018-07-18', 8.1738),
-    (1, 6, '2018-07-19', 8.2566),
-    (1, 6, '2018-07-20', 8.1888),
-    (1, 6, '2018-07-23', 8.181),
-    (1, 6, '2018-07-24', 8.1752),
-    (1, 6, '2018-07-25', 8.168),
-    (1, 6, '2018-07-26', 8.1693),
-    (1, 6, '2018-07-27', 8.1807),
-    (1, 6, '2018-07-30', 8.1337),
-    (1, 6, '2018-07-31', 8.1466),
-    (1, 6, '2018-08-01', 8.174),
-    (1, 6, '2018-08-02', 8.2235),
-    (1, 6, '2018-08-03', 8.2361),
-    (1, 6, '2018-08-06', 8.236),
-    (1, 6, '2018-08-07', 8.1943),
-    (1, 6, '2018-08-08', 8.2315),
-    (1, 6, '2018-08-09', 8.2848),
-    (1, 6, '2018-08-10', 8.3682),
-    (1, 6, '2018-08-13', 8.3835),
-    (1, 6, '2018-08-14', 8.3991),
-    (1, 6, '2018-08-15', 8.4749),
-    (1, 6, '2018-08-16', 8.4592),
-    (1, 6, '2018-08-17', 8.4691),
-    (1, 6, '2018-08-20', 8.4706),
-    (1, 6, '2018-08-21', 8.4242),
-    (1, 6, '2018-08-22', 8.3493),
-    (1, 6, '2018-08-23', 8.369),
-    (1, 6, '2018-08-24', 8.3299),
-    (1, 6, '2018-08-27', 8.3195),
-    (1, 6, '2018-08-28', 8.3326),
-    (1, 6, '2018-08-29', 8.3436),
-    (1, 6, '2018-08-30', 8.3546),
-    (1, 6, '2018-08-31', 8.3872),
-    (1, 6, '2018-09-03', NULL),
-    (1, 6, '2018-09-04', 8.4102),
-    (1, 6, '2018-09-05', 8.4096),
-    (1, 6, '2018-09-06', 8.4094),
-    (1, 6, '2018-09-07', 8.4381),
-    (1, 6, '2018-09-10', 8.377),
-    (1, 6, '2018-09-11', 8.3778),
-    (1, 6, '2018-09-12', 8.3454),
-    (1, 6, '2018-09-13', 8.2557),
-    (1, 6, '2018-09-14', 8.235),
-    (1, 6, '2018-09-17', 8.173),
-    (1, 6, '2018-09-18', 8.1463),
-    (1, 6, '2018-09-19', 8.1503),
-    (1, 6, '2018-09-20', 8.1666),
-    (1, 6, '2018-09-21', 8.156),
-    (1, 6, '2018-09-24', 8.1304),
-    (1, 6, '2018-09-25', 8.1263),
-    (1, 6, '2018-09-26', 8.1049),
-    (1, 6, '2018-09-27', 8.137),
-    (1, 6, '2018-09-28', 8.1327),
-    (1, 6, '2018-10-01', 8.1595),
-    (1, 6, '2018-10-02', 8.1637),
-    (1, 6, '2018-10-03', 8.2007),
-    (1, 6, '2018-10-04', 8.2436),
-    (1, 6, '2018-10-05', 8.2755),
-    (1, 6, '2018-10-08', NULL),
-    (1, 6, '2018-10-09', 8.2795),
-    (1, 6, '2018-10-10', 8.2035),
-    (1, 6, '2018-10-11', 8.2262),
-    (1, 6, '2018-10-12', 8.1769),
-    (1, 6, '2018-10-15', 8.1481),
-    (1, 6, '2018-10-16', 8.1288),
-    (1, 6, '2018-10-17', 8.1903),
-    (1, 6, '2018-10-18', 8.2469),
-    (1, 6, '2018-10-19', 8.2169),
-    (1, 6, '2018-10-22', 8.2558),
-    (1, 6, '2018-10-23', 8.2978),
-    (1, 6, '2018-10-24', 8.3312),
-    (1, 6, '2018-10-25', 8.3336),
-    (1, 6, '2018-10-26', 8.3423),
-    (1, 6, '2018-10-29', 8.3711),
-    (1, 6, '2018-10-30', 8.3962),
-    (1, 6, '2018-10-31', 8.41),
-    (1, 6, '2018-11-01', 8.41),
-    (1, 6, '2018-11-02', 8.3721),
-    (1, 6, '2018-11-05', 8.3566),
-    (1, 6, '2018-11-06', 8.3781),
-    (1, 6, '2018-11-07', 8.3273),
-    (1, 6, '2018-11-08', 8.3432),
-    (1, 6, '2018-11-09', 8.4385),
-    (1, 6, '2018-11-12', NULL),
-    (1, 6, '2018-11-13', 8.4736),
-    (1, 6, '2018-11-14', 8.5),
-    (1, 6, '2018-11-15', 8.4767),
-    (1, 6, '2018-11-16', 8.4435),
-    (1, 6, '2018-11-19', 8.4767),
-    (1, 6, '2018-11-20', 8.5529),
-    (1, 6, '2018-11-21', 8.5197),
-    (1, 6, '2018-11-22', NULL),
-    (1, 6, '2018-11-23', 8.5994),
-    (1, 6, '2018-11-26', 8.5864),
-    (1, 6, '2018-11-27', 8.6199),
-    (1, 6, '2018-11-28', 8.5994),
-    (1, 6, '2018-11-29', 8.5325),
-    (1, 6, '2018-11-30', 8.5872),
-    (1, 6, '2018-12-03', 8.5345),
-    (1, 6, '2018-12-04', 8.4941),
-    (1, 6, '2018-12-05', NULL),
-    (1, 6, '2018-12-06', 8.5323),
-    (1, 6, '2018-12-07', 8.4844),
-    (1, 6, '2018-12-10', 8.5442),
-    (1, 6, '2018-12-11', 8.5471),
-    (1, 6, '2018-12-12', 8.5649),
-    (1, 6, '2018-12-13', 8.5648),
-    (1, 6, '2018-12-14', 8.6256),
-    (1, 6, '2018-12-17', 8.6279),
-    (1, 6, '2018-12-18', 8.7208),
-    (1, 6, '2018-12-19', 8.6639),
-    (1, 6, '2018-12-20', 8.6961),
-    (1, 6, '2018-12-21', 8.7407),
-    (1, 6, '2018-12-24', NULL),
-    (1, 6, '2018-12-25', NULL),
-    (1, 6, '2018-12-26', 8.7725),
-    (1, 6, '2018-12-27', 8.7884),
-    (1, 6, '2018-12-28', 8.7191),
-    (1, 6, '2018-12-31', 8.6519),
-    (1, 6, '2019-01-01', NULL),
-    (1, 6, '2019-01-02', 8.6976),
-    (1, 6, '2019-01-03', 8.694),
-    (1, 6, '2019-01-04', 8.6109),
-    (1, 6, '2019-01-07', 8.5387),
-    (1, 6, '2019-01-08', 8.5505),
-    (1, 6, '2019-01-09', 8.4745),
-    (1, 6, '2019-01-10', 8.4702),
-    (1, 6, '2019-01-11', 8.5245),
-    (1, 6, '2019-01-14', NULL),
-    (1, 6, '2019-01-15', 8.5545),
-    (1, 6, '2019-01-16', 8.5305),
-    (1, 6, '2019-01-17', 8.5554),
-    (1, 6, '2019-01-18', 8.5509),
-    (1, 6, '2019-01-21', NULL),
-    (1, 6, '2019-01-22', 8.5961),
-    (1, 6, '2019-01-23', 8.5848),
-    (1, 6, '2019-01-24', 8.5756),
-    (1, 6, '2019-01-25', 8.5193),
-    (1, 6, '2019-01-28', 8.5147),
-    (1, 6, '2019-01-29', 8.5008),
-    (1, 6, '2019-01-30', 8.4725),
-    (1, 6, '2019-01-31', 8.4359),
-    (1, 6, '2019-02-01', 8.4316),
-    (1, 6, '2019-02-04', 8.47),
-    (1, 6, '2019-02-05', 8.4813),
-    (1, 6, '2019-02-06', 8.5235),
-    (1, 6, '2019-02-07', 8.5805),
-    (1, 6, '2019-02-08', 8.6335),
-    (1, 6, '2019-02-11', 8.7232),
-    (1, 6, '2019-02-12', 8.6525),
-    (1, 6, '2019-02-13', 8.6426),
-    (1, 6, '2019-02-14', 8.6572),
-    (1, 6, '2019-02-15', 8.6473),
-    (1, 6, '2019-02-18', NULL),
-    (1, 6, '2019-02-19', 8.5829),
-    (1, 6, '2019-02-20', NULL),
-    (1, 6, '2019-02-21', 8.6254),
-    (1, 6, '2019-02-22', 8.6022),
-    (1, 6, '2019-02-25', 8.6093),
-    (1, 6, '2019-02-26', 8.5781),
-    (1, 6, '2019-02-27', 8.5433),
-    (1, 6, '2019-02-28', 8.5538),
-    (1, 6, '2019-03-01', 8.5778),
-    (1, 6, '2019-03-04', 8.6555),
-    (1, 6, '2019-03-05', 8.6739),
-    (1, 6, '2019-03-06', 8.664),
-    (1, 6, '2019-03-07', 8.7499),
-    (1, 6, '2019-03-08', 8.7639),
-    (1, 6, '2019-03-11', 8.6885),
-    (1, 6, '2019-03-12', 8.634),
-    (1, 6, '2019-03-13', 8.5768),
-    (1, 6, '2019-03-14', 8.5812),
-    (1, 6, '2019-03-15', 8.5377),
-    (1, 6, '2019-03-18', 8.5571),
-    (1, 6, '2019-03-19', 8.521),
-    (1, 6, '2019-03-20', 8.5405),
-    (1, 6, '2019-03-21', 8.4699),
-    (1, 6, '2019-03-22', 8.5816),
-    (1, 6, '2019-03-25', 8.5239),
-    (1, 6, '2019-03-26', 8.5356),
-    (1, 6, '2019-03-27', 8.6349),
-    (1, 6, '2019-03-28', 8.6408),
-    (1, 6, '2019-03-29', 8.642),
-    (1, 6, '2019-04-01', 8.59),
-    (1, 6, '2019-04-02', 8.6205),
-    (1, 6, '2019-04-03', 8.5516),
-    (1, 6, '2019-04-04', 8.5898),
-    (1, 6, '2019-04-05', 8.6155),
-    (1, 6, '2019-04-08', 8.5469),
-    (1, 6, '2019-04-09', 8.5392),
-    (1, 6, '2019-04-10', 8.4993),
-    (1, 6, '2019-04-11', 8.5353),
-    (1, 6, '2019-04-12', 8.4956),
-    (1, 6, '2019-04-15', 8.4957),
-    (1, 6, '2019-04-16', 8.4791),
-    (1, 6, '2019-04-17', 8.4793),
-    (1, 6, '2019-04-18', 8.513),
-    (1, 6, '2019-04-19', 8.5155),
-    (1, 6, '2019-04-22', 8.495),
-    (1, 6, '2019-04-23', 8.5507),
-    (1, 6, '2019-04-24', 8.6015),
-    (1, 6, '2019-04-25', 8.6631),
-    (1, 6, '2019-04-26', 8.6739),
-    (1, 6, '2019-04-29', 8.6766),
-    (1, 6, '2019-04-30', 8.6434),
-    (1, 6, '2019-05-01', 8.6371),
-    (1, 6, '2019-05-02', 8.7627),
-    (1, 6, '2019-05-03', 8.7056),
-    (1, 6, '2019-05-06', 8.7287),
-    (1, 6, '2019-05-07', 8.7509),
-    (1, 6, '2019-05-08', 8.7591),
-    (1, 6, '2019-05-09', 8.7785),
-    (1, 6, '2019-05-10', 8.7273),
-    (1, 6, '2019-05-13', 8.7556),
-    (1, 6, '2019-05-14', 8.758),
-    (1, 6, '2019-05-15', 8.7169),
-    (1, 6, '2019-05-16', 8.7184),
-    (1, 6, '2019-05-17', 8.7836),
-    (1, 6, '2019-05-20', 8.7791),
-    (1, 6, '2019-05-21', 8.7718),
-    (1, 6, '2019-05-22', 8.7495),
-    (1, 6, '2019-05-23', 8.7499),
-    (1, 6, '2019-05-24', 8.7079),
-    (1, 6, '2019-05-27', NULL),
-    (1, 6, '2019-05-28', 8.6962),
-    (1, 6, '2019-05-29', 8.6982),
-    (1, 6, '2019-05-30', 8.7602),
-    (1, 6, '2019-05-31', 8.7559),
-    (1, 6, '2019-06-03', 8.7213),
-    (1, 6, '2019-06-04', 8.7109),
-    (1, 6, '2019-06-05', 8.7271),
-    (1, 6, '2019-06-06', 8.7291),
-    (1, 6, '2019-06-07', 8.6273),
-    (1, 6, '2019-06-10', 8.6481),
-    (1, 6, '2019-06-11', 8.6327),
-    (1, 6, '2019-06-12', 8.6421),
-    (1, 6, '2019-06-13', 8.6785),
-    (1, 6, '2019-06-14', 8.7165),
-    (1, 6, '2019-06-17', 8.7135),
-    (1, 6, '2019-06-18', 8.7411),
-    (1, 6, '2019-06-19', 8.7303),
-    (1, 6, '2019-06-20', 8.5728),
-    (1, 6, '2019-06-21', 8.5434),
-    (1, 6, '2019-06-24', 8.4882),
-    (1, 6, '2019-06-25', 8.5088),
-    (1, 6, '2019-06-26', 8.4798),
-    (1, 6, '2019-06-27', 8.5184),
-    (1, 6, '2019-06-28', 8.5286),
-    (1, 6, '2019-07-01', 8.5733),
-    (1, 6, '2019-07-02', 8.5623),
-    (1, 6, '2019-07-03', 8.539),
-    (1, 6, '2019-07-04', NULL),
-    (1, 6, '2019-07-05', 8.6226),
-    (1, 6, '2019-07-08', 8.6415),
-    (1, 6, '2019-07-09', 8.6416),
-    (1, 6, '2019-07-10', 8.5814),
-    (1, 6, '2019-07-11', 8.543),
-    (1, 6, '2019-07-12', 8.5472),
-    (1, 6, '2019-07-15', 8.542),
-    (1, 6, '2019-07-16', 8.5583),
-    (1, 6, '2019-07-17', 8.5659),
-    (1, 6, '2019-07-18', 8.5888),
-    (1, 6, '2019-07-19', 8.5696),
-    (1, 6, '2019-07-22', 8.6028),
-    (1, 6, '2019-07-23', 8.684),
-    (1, 6, '2019-07-24', 8.6413),
-    (1, 6, '2019-07-25', 8.6676),
-    (1, 6, '2019-07-26', 8.7195),
-    (1, 6, '2019-07-29', 8.7007),
-    (1, 6, '2019-07-30', 8.7571),
-    (1, 6, '2019-07-31', 8.7808),
-    (1, 6, '2019-08-01', 8.8706),
-    (1, 6, '2019-08-02', 8.9135),
-    (1, 6, '2019-08-05', 8.9183),
-    (1, 6, '2019-08-06', 8.9087),
-    (1, 6, '2019-08-07', 8.965),
-    (1, 6, '2019-08-08', 8.9215),
-    (1, 6, '2019-08-09', 8.8778),
-    (1, 6, '2019-08-12', 8.9123),
-    (1, 6, '2019-08-13', 8.8756),
-    (1, 6, '2019-08-14', 8.9735),
-    (1, 6, '2019-08-15', 9.0116),
-    (1, 6, '2019-08-16', 8.9899),
-    (1, 6, '2019-08-19', 8.9716),
-    (1, 6, '2019-08-20', 9.0008),
-    (1, 6, '2019-08-21', 8.9474),
-    (1, 6, '2019-08-22', 8.9715),
-    (1, 6, '2019-08-23', 8.9644),
-    (1, 6, '2019-08-26', 8.9888),
-    (1, 6, '2019-08-27', 9.0225),
-    (1, 6, '2019-08-28', 9.0337),
-    (1, 6, '2019-08-29', 9.091),
-    (1, 6, '2019-08-30', 9.1192),
-    (1, 6, '2019-09-02', NULL),
-    (1, 6, '2019-09-03', 9.1034),
-    (1, 6, '2019-09-04', 9.0368),
-    (1, 6, '2019-09-05', 8.9955),
-    (1, 6, '2019-09-06', 8.9668),
-    (1, 6, '2019-09-09', 8.9255),
-    (1, 6, '2019-09-10', 8.951),
-    (1, 6, '2019-09-11', 8.9762),
-    (1, 6, '2019-09-12', 8.9668),
-    (1, 6, '2019-09-13', 8.9765),
-    (1, 6, '2019-09-16', 8.9607),
-    (1, 6, '2019-09-17', 8.9357),
-    (1, 6, '2019-09-18', 8.9331),
-    (1, 6, '2019-09-19', 8.9781),
-    (1, 6, '2019-09-20', 9.0461),
-    (1, 6, '2019-09-23', 9.0552),
-    (1, 6, '2019-09-24', 9.0005),
-    (1, 6, '2019-09-25', 9.0513),
-    (1, 6, '2019-09-26', 9.0813),
-    (1, 6, '2019-09-27', 9.0822),
-    (1, 6, '2019-09-30', 9.0834),
-    (1, 6, '2019-10-01', 9.1192),
-    (1, 6, '2019-10-02', 9.1412),
-    (1, 6, '2019-10-03', 9.1065),
-    (1, 6, '2019-10-04', 9.1025),
-    (1, 6, '2019-10-07', 9.129),
-    (1, 6, '2019-10-08', 9.1735),
-    (1, 6, '2019-10-09', 9.1649),
-    (1, 6, '2019-10-10', 9.1184),
-    (1, 6, '2019-10-11', 9.0664),
-    (1, 6, '2019-10-14', NULL),
-    (1, 6, '2019-10-15', 9.1194),
-    (1, 6, '2019-10-16', 9.1701),
-    (1, 6, '2019-10-17', 9.182),
-    (1, 6, '2019-10-18', 9.1756),
-    (1, 6, '2019-10-21', 9.1375),
-    (1, 6, '2019-10-22', 9.1407),
-    (1, 6, '2019-10-23', 9.1512),
-    (1, 6, '2019-10-24', 9.1372),
-    (1, 6, '2019-10-25', 9.1942),
-    (1, 6, '2019-10-28', 9.2076),
-    (1, 6, '2019-10-29', 9.2304),
-    (1, 6, '2019-10-30', 9.2122),
-    (1, 6, '2019-10-31', 9.1818),
-    (1, 6, '2019-11-01', 9.09),
-    (1, 6, '2019-11-04', 9.1226),
-    (1, 6, '2019-11-05', 9.1922),
-    (1, 6, '2019-11-06', 9.1664),
-    (1, 6, '2019-11-07', 9.1255),
-    (1, 6, '2019-11-08', 9.1476),
-    (1, 6, '2019-11-11', NULL),
-    (1, 6, '2019-11-12', 9.1438),
-    (1, 6, '2019-11-13', 9.189),
-    (1, 6, '2019-11-14', 9.1751),
-    (1, 6, '2019-11-15', 9.0857),
-    (1, 6, '2019-11-18', 9.1032),
-    (1, 6, '2019-11-19', 9.1262),
-    (1, 6, '2019-11-20', 9.1425),
-    (1, 6, '2019-11-21', 9.1348),
-    (1, 6, '2019-11-22', 9.1585),
-    (1, 6, '2019-11-25', 9.1934),
-    (1, 6, '2019-11-26', 9.1725),
-    (1, 6, '2019-11-27', 9.1659),
-    (1, 6, '2019-11-28', NULL),
-    (1, 6, '2019-11-29', 9.2156),
-    (1, 6, '2019-12-02', 9.1554),
-    (1, 6, '2019-12-03', 9.1772),
-    (1, 6, '2019-12-04', 9.1729),
-    (1, 6, '2019-12-05', 9.1317),
-    (1, 6, '2019-12-06', 9.1347),
-    (1, 6, '2019-12-09', 9.1445),
-    (1, 6, '2019-12-10', 9.172),
-    (1, 6, '2019-12-11', 9.1524),
-    (1, 6, '2019-12-12', 9.1001),
-    (1, 6, '2019-12-13', 9.048),
-    (1, 6, '2019-12-16', 9.0134),
-    (1, 6, '2019-12-17', 9.0159),
-    (1, 6, '2019-12-18', 9.0203),
-    (1, 6, '2019-12-19', 8.9619),
-    (1, 6, '2019-12-20', 8.9673),
-    (1, 6, '2019-12-23', 8.947),
-    (1, 6, '2019-12-24', 8.9142),
-    (1, 6, '2019-12-25', NULL),
-    (1, 6, '2019-12-26', 8.8799),
-    (1, 6, '2019-12-27', 8.8291),
-    (1, 6, '2019-12-30', 8.7839),
-    (1, 6, '2019-12-31', 8.7823),
-    (1, 6, '2020-01-01', NULL),
-    (1, 6, '2020-01-02', 8.8074),
-    (1, 6, '2020-01-03', 8.8181),
-    (1, 6, '2020-01-06', 8.8034),
-    (1, 6, '2020-01-07', 8.8588),
-    (1, 6, '2020-01-08', 8.8695),
-    (1, 6, '2020-01-09', 8.8769),
-    (1, 6, '2020-01-10', 8.8857),
-    (1, 6, '2020-01-13', 8.8912),
-    (1, 6, '2020-01-14', 8.871),
-    (1, 6, '2020-01-15', 8.8575),
-    (1, 6, '2020-01-16', 8.8821),
-    (1, 6, '2020-01-17', 8.9133),
-    (1, 6, '2020-01-20', NULL),
-    (1, 6, '2020-01-21', 8.9698),
-    (1, 6, '2020-01-22', 8.9846),
-    (1, 6, '2020-01-23', 9.0158),
-    (1, 6, '2020-01-24', 9.0393),
-    (1, 6, '2020-01-27', 9.1438),
-    (1, 6, '2020-01-28', 9.1325),
-    (1, 6, '2020-01-29', 9.1646),
-    (1, 6, '2020-01-30', 9.2067),
-    (1, 6, '2020-01-31', 9.1985),
-    (1, 6, '2020-02-03', 9.2815),
-    (1, 6, '2020-02-04', 9.2123),
-    (1, 6, '2020-02-05', 9.2125),
-    (1, 6, '2020-02-06', 9.2342),
-    (1, 6, '2020-02-07', 9.2888),
-    (1, 6, '2020-02-10', 9.2961),
-    (1, 6, '2020-02-11', 9.2546),
-    (1, 6, '2020-02-12', 9.2148),
-    (1, 6, '2020-02-13', 9.2602),
-    (1, 6, '2020-02-14', 9.2479),
-    (1, 6, '2020-02-17', NULL),
-    (1, 6, '2020-02-18', 9.3092),
-    (1, 6, '2020-02-19', 9.2762),
-    (1, 6, '2020-02-20', 9.3194),
-    (1, 6, '2020-02-21', 9.2782),
-    (1, 6, '2020-02-24', 9.33),
-    (1, 6, '2020-02-25', 9.3743),
-    (1, 6, '2020-02-26', 9.3868),
-    (1, 6, '2020-02-27', 9.3926),
-    (1, 6, '2020-02-28', 9.4491),
-    (1, 6, '2020-03-02', 9.3057),
-    (1, 6, '2020-03-03', 9.2372),
-    (1, 6, '2020-03-04', 9.285),
-    (1, 6, '2020-03-05', 9.2792),
-    (1, 6, '2020-03-06', 9.2473),
-    (1, 6, '2020-03-09', 9.4723),
-    (1, 6, '2020-03-10', 9.6008),
-    (1, 6, '2020-03-11', 9.6262),
-    (1, 6, '2020-03-12', 10.1959),
-    (1, 6, '2020-03-13', 10.0904),
-    (1, 6, '2020-03-16', 10.2664),
-    (1, 6, '2020-03-17', 10.4332),
-    (1, 6, '2020-03-18', 11.0912),
-    (1, 6, '2020-03-19', 11.2584),
-    (1, 6, '2020-03-20', 11.6842),
-    (1, 6, '2020-03-23', 11.2963),
-    (1, 6, '2020-03-24', 11.0926),
-    (1, 6, '2020-03-25', 10.9069),
-    (1, 6, '2020-03-26', 10.401),
-    (1, 6, '2020-03-27', 10.5017),
-    (1, 6, '2020-03-30', 10.6095),
-    (1, 6, '2020-03-31', 10.4059),
-    (1, 6, '2020-04-01', 10.4593),
-    (1, 6, '2020-04-02', 10.3592),
-    (1, 6, '2020-04-03', 10.5712),
-    (1, 6, '2020-04-06', 10.4341),
-    (1, 6, '2020-04-07', 10.2449),
-    (1, 6, '2020-04-08', 10.2676),
-    (1, 6, '2020-04-09', 10.1992),
-    (1, 6, '2020-04-10', 10.175),
-    (1, 6, '2020-04-13', 10.3121),
-    (1, 6, '2020-04-14', 10.3037),
-    (1, 6, '2020-04-15', 10.5356),
-    (1, 6, '2020-04-16', 10.4691),
-    (1, 6, '2020-04-17', 10.3099),
-    (1, 6, '2020-04-20', 10.3634),
-    (1, 6, '2020-04-21', 10.5984),
-    (1, 6, '2020-04-22', 10.764),
-    (1, 6, '2020-04-23', 10.5957),
-    (1, 6, '2020-04-24', 10.68),
-    (1, 6, '2020-04-27', 10.5772),
-    (1, 6, '2020-04-28', 10.4079),
-    (1, 6, '2020-04-29', 10.3548),
-    (1, 6, '2020-04-30', 10.2553),
-    (1, 6, '2020-05-01', 10.3348),
-    (1, 6, '2020-05-04', 10.3445),
-    (1, 6, '2020-05-05', 10.2603),
-    (1, 6, '2020-05-06', 10.2799),
-    (1, 6, '2020-05-07', 10.2274),
-    (1, 6, '2020-05-08', 10.1864),
-    (1, 6, '2020-05-11', 10.285),
-    (1, 6, '2020-05-12', 10.1585),
-    (1, 6, '2020-05-13', 10.1418),
-    (1, 6, '2020-05-14', 10.2232),
-    (1, 6, '2020-05-15', 10.2422),
-    (1, 6, '2020-05-18', 10.0317),
-    (1, 6, '2020-05-19', 9.9461),
-    (1, 6, '2020-05-20', 9.9089),
-    (1, 6, '2020-05-21', 9.951),
-    (1, 6, '2020-05-22', 10.0159),
-    (1, 6, '2020-05-25', NULL),
-    (1, 6, '2020-05-26', 9.907),
-    (1, 6, '2020-05-27', 9.9041),
-    (1, 6, '2020-05-28', 9.7729),
-    (1, 6, '2020-05-29', 9.7306),
-    (1, 6, '2020-06-01', 9.6403),
-    (1, 6, '2020-06-02', 9.5632),
-    (1, 6, '2020-06-03', 9.4544),
-    (1, 6, '2020-06-04', 9.3616),
-    (1, 6, '2020-06-05', 9.2955),
-    (1, 6, '2020-06-08', 9.2754),
-    (1, 6, '2020-06-09', 9.2866),
-    (1, 6, '2020-06-10', 9.3445),
-    (1, 6, '2020-06-11', 9.5249),
-    (1, 6, '2020-06-12', 9.6331),
-    (1, 6, '2020-06-15', 9.6138),
-    (1, 6, '2020-06-16', 9.5735),
-    (1, 6, '2020-06-17', 9.5397),
-    (1, 6, '2020-06-18', 9.5402),
-    (1, 6, '2020-06-19', 9.6154),
-    (1, 6, '2020-06-22', 9.5965),
-    (1, 6, '2020-06-23', 9.4813),
-    (1, 6, '2020-06-24', 9.6145),
-    (1, 6, '2020-06-25', 9.6892),
-    (1, 6, '2020-06-26', 9.7166),
-    (1, 6, '2020-06-29', 9.7074),
-    (1, 6, '2020-06-30', 9.6577),
-    (1, 6, '2020-07-01', 9.5139),
-    (1, 6, '2020-07-02', 9.5357),
-    (1, 6, '2020-07-03', NULL),
-    (1, 6, '2020-07-06', 9.407),
-    (1, 6, '2020-07-07', 9.4318),
-    (1, 6, '2020-07-08', 9.416),
-    (1, 6, '2020-07-09', 9.4554),
-    (1, 6, '2020-07-10', 9.4213),
-    (1, 6, '2020-07-13', 9.3667),
-    (1, 6, '2020-07-14', 9.3875),
-    (1, 6, '2020-07-15', 9.3149),
-    (1, 6, '2020-07-16', 9.2516),
-    (1, 6, '2020-07-17', 9.2712),
-    (1, 6, '2020-07-20', 9.2486),
-    (1, 6, '2020-07-21', 9.1262),
-    (1, 6, '2020-07-22', 9.1262),
-    (1, 6, '2020-07-23', 9.1544),
-    (1, 6, '2020-07-24', 9.1907),
-    (1, 6, '2020-07-27', 9.1115),
-    (1, 6, '2020-07-28', 9.0953),
-    (1, 6, '2020-07-29', 9.0647),
-    (1, 6, '2020-07-30', 9.1302),
-    (1, 6, '2020-07-31', 9.0889),
-    (1, 6, '2020-08-03', 9.1382),
-    (1, 6, '2020-08-04', 9.1165),
-    (1, 6, '2020-08-05', 8.9435),
-    (1, 6, '2020-08-06', 8.9802),
-    (1, 6, '2020-08-07', 9.0623),
-    (1, 6, '2020-08-10', 9.0288),
-    (1, 6, '2020-08-11', 8.9875),
-    (1, 6, '2020-08-12', 8.9387),
-    (1, 6, '2020-08-13', 8.8831),
-    (1, 6, '2020-08-14', 8.8945),
-    (1, 6, '2020-08-17', 8.8633),
-    (1, 6, '2020-08-18', 8.8387),
-    (1, 6, '2020-08-19', 8.8504),
-    (1, 6, '2020-08-20', 8.9437),
-    (1, 6, '2020-08-21', 9.0457),
-    (1, 6, '2020-08-24', 8.9953),
-    (1, 6, '2020-08-25', 8.9755),
-    (1, 6, '2020-08-26', 8.9201),
-    (1, 6, '2020-08-27', 8.8878),
-    (1, 6, '2020-08-28', 8.788),
-    (1, 6, '2020-08-31', 8.7043),
-    (1, 6, '2020-09-01', 8.748),
-    (1, 6, '2020-09-02', 8.8481),
-    (1, 6, '2020-09-03', 8.9253),
-    (1, 6, '2020-09-04', 8.95),
-    (1, 6, '2020-09-07', NULL),
-    (1, 6, '2020-09-08', 9.0929),
-    (1, 6, '2020-09-09', 9.0271),
-    (1, 6, '2020-09-10', 8.9975),
-    (1, 6, '2020-09-11', 9.0548),
-    (1, 6, '2020-09-14', 9.0191),
-    (1, 6, '2020-09-15', 9.04),
-    (1, 6, '2020-09-16', 9.0109),
-    (1, 6, '2020-09-17', 9.0901),
-    (1, 6, '2020-09-18', 9.062),
-    (1, 6, '2020-09-21', 9.2999),
-    (1, 6, '2020-09-22', 9.3559),
-    (1, 6, '2020-09-23', 9.4414),
-    (1, 6, '2020-09-24', 9.534),
-    (1, 6, '2020-09-25', 9.5938),
-    (1, 6, '2020-09-28', 9.4839),
-    (1, 6, '2020-09-29', 9.4636),
-    (1, 6, '2020-09-30', 9.3666),
-    (1, 6, '2020-10-01', 9.3095),
-    (1, 6, '2020-10-02', 9.3346),
-    (1, 6, '2020-10-05', 9.2312),
-    (1, 6, '2020-10-06', 9.2845),
-    (1, 6, '2020-10-07', 9.3064),
-    (1, 6, '2020-10-08', 9.2768),
-    (1, 6, '2020-10-09', 9.1531),
-    (1, 6, '2020-10-12', NULL),
-    (1, 6, '2020-10-13', 9.2129),
-    (1, 6, '2020-10-14', 9.2179),
-    (1, 6, '2020-10-15', 9.3691),
-    (1, 6, '2020-10-16', 9.358),
-    (1, 6, '2020-10-19', 9.3002),
-    (1, 6, '2020-10-20', 9.2666),
-    (1, 6, '2020-10-21', 9.2033),
-    (1, 6, '2020-10-22', 9.2425),
-    (1, 6, '2020-10-23', 9.2637),
-    (1, 6, '2020-10-26', 9.2479),
-    (1, 6, '2020-10-27', 9.1346),
-    (1, 6, '2020-10-28', 9.3456),
-    (1, 6, '2020-10-29', 9.5865),
-    (1, 6, '2020-10-30', 9.5617),
-    (1, 6, '2020-11-02', 9.5665),
-    (1, 6, '2020-11-03', 9.3807),
-    (1, 6, '2020-11-04', 9.2933),
-    (1, 6, '2020-11-05', 9.1882),
-    (1, 6, '2020-11-06', 9.1552),
-    (1, 6, '2020-11-09', 9.0415),
-    (1, 6, '2020-11-10', 9.0318),
-    (1, 6, '2020-11-11', NULL),
-    (1, 6, '2020-11-12', 9.1156),
-    (1, 6, '2020-11-13', 9.1685),
-    (1, 6, '2020-11-16', 9.0702),
-    (1, 6, '2020-11-17', 9.0661),
-    (1, 6, '2020-11-18', 8.9914),
-    (1, 6, '2020-11-19', 9.0294),
-    (1, 6, '2020-11-20', 9.0056),
-    (1, 6, '2020-11-23', 9.0577),
-    (1, 6, '2020-11-24', 8.9184),
-    (1, 6, '2020-11-25', 8.8408),
-    (1, 6, '2020-11-26', NULL),
-    (1, 6, '2020-11-27', NULL),
-    (1, 6, '2020-11-30', 8.8781),
-    (1, 6, '2020-12-01', 8.8296),
-    (1, 6, '2020-12-02', 8.8197),
-    (1, 6, '2020-12-03', 8.7585),
-    (1, 6, '2020-12-04', 8.7883),
-    (1, 6, '2020-12-07', 8.733),
-    (1, 6, '2020-12-08', 8.7644),
-    (1, 6, '2020-12-09', 8.8281),
-    (1, 6, '2020-12-10', 8.7745),
-    (1, 6, '2020-12-11', 8.8235),
-    (1, 6, '2020-12-14', 8.7463),
-    (1, 6, '2020-12-15', 8.7221),
-    (1, 6, '2020-12-16', 8.6896),
-    (1, 6, '2020-12-17', 8.5731),
-    (1, 6, '2020-12-18', 8.5959),
-    (1, 6, '2020-12-21', 8.653),
-    (1, 6, '2020-12-22', 8.7197),
-    (1, 6, '2020-12-23', 8.6307),
-    (1, 6, '2020-12-24', NULL),
-    (1, 6, '2020-12-25', NULL),
-    (1, 6, '2020-12-28', 8.6671),
-    (1, 6, '2020-12-29', 8.6017),
-    (1, 6, '2020-12-30', 8.5536),
-    (1, 6, '2020-12-31', 8.5757),
-    (1, 6, '2021-01-01', NULL),
-    (1, 6, '2021-01-04', 8.566),
-    (1, 6, '2021-01-05', 8.4901),
-    (1, 6, '2021-01-06', 8.4206),
-    (1, 6, '2021-01-07', 8.4384),
-    (1, 6, '2021-01-08', 8.4074),
-    (1, 6, '2021-01-11', 8.5192),
-    (1, 6, '2021-01-12', 8.5085),
-    (1, 6, '2021-01-13', 8.4851),
-    (1, 6, '2021-01-14', 8.4594),
-    (1, 6, '2021-01-15', 8.537),
-    (1, 6, '2021-01-18', NULL),
-    (1, 6, '2021-01-19', 8.5326),
-    (1, 6, '2021-01-20', NULL),
-    (1, 6, '2021-01-21', 8.4321),
-    (1, 6, '2021-01-22', 8.482),
-    (1, 6, '2021-01-25', 8.5568),
-    (1, 6, '2021-01-26', 8.5518),
-    (1, 6, '2021-01-27', 8.6341),
-    (1, 6, '2021-01-28', 8.606),
-    (1, 6, '2021-01-29', 8.5454);
\ No newline at end of file
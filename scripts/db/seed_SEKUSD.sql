@@ -1,1316 +0,0 @@
--- SEK 7
--- USD 1
-INSERT INTO
-    exchange_rates (
-        destination_currency_id,
-        source_currency_id,
-        date,
-        rate
-    )
-VALUES
-    (1, 7, '2016-01-29', 8.5709),
-    (1, 7, '2016-02-01', 8.5385),
-    (1, 7, '2016-02-02', 8.5749),
-    (1, 7, '2016-02-03', 8.4745),
-    (1, 7, '2016-02-04', 8.3983),
-    (1, 7, '2016-02-05', 8.4802),
-    (1, 7, '2016-02-08', 8.4593),
-    (1, 7, '2016-02-09', 8.4061),
-    (1, 7, '2016-02-10', 8.4612),
-    (1, 7, '2016-02-11', 8.3440),
-    (1, 7, '2016-02-12', 8.4337),
-    (1, 7, '2016-02-15', NULL),
-    (1, 7, '2016-02-16', 8.4959),
-    (1, 7, '2016-02-17', 8.5104),
-    (1, 7, '2016-02-18', 8.4758),
-    (1, 7, '2016-02-19', 8.4426),
-    (1, 7, '2016-02-22', 8.5023),
-    (1, 7, '2016-02-23', 8.4829),
-    (1, 7, '2016-02-24', 8.4859),
-    (1, 7, '2016-02-25', 8.5157),
-    (1, 7, '2016-02-26', 8.5557),
-    (1, 7, '2016-02-29', 8.5709),
-    (1, 7, '2016-03-01', 8.6312),
-    (1, 7, '2016-03-02', 8.6321),
-    (1, 7, '2016-03-03', 8.5418),
-    (1, 7, '2016-03-04', 8.4779),
-    (1, 7, '2016-03-07', 8.4752),
-    (1, 7, '2016-03-08', 8.4776),
-    (1, 7, '2016-03-09', 8.4238),
-    (1, 7, '2016-03-10', 8.3712),
-    (1, 7, '2016-03-11', 8.3339),
-    (1, 7, '2016-03-14', 8.3326),
-    (1, 7, '2016-03-15', 8.3031),
-    (1, 7, '2016-03-16', 8.3168),
-    (1, 7, '2016-03-17', 8.1867),
-    (1, 7, '2016-03-18', 8.2090),
-    (1, 7, '2016-03-21', 8.2166),
-    (1, 7, '2016-03-22', 8.2188),
-    (1, 7, '2016-03-23', 8.2672),
-    (1, 7, '2016-03-24', 8.3021),
-    (1, 7, '2016-03-25', 8.3066),
-    (1, 7, '2016-03-28', 8.2808),
-    (1, 7, '2016-03-29', 8.2546),
-    (1, 7, '2016-03-30', 8.1500),
-    (1, 7, '2016-03-31', 8.0962),
-    (1, 7, '2016-04-01', 8.1164),
-    (1, 7, '2016-04-04', 8.1239),
-    (1, 7, '2016-04-05', 8.1408),
-    (1, 7, '2016-04-06', 8.1378),
-    (1, 7, '2016-04-07', 8.1520),
-    (1, 7, '2016-04-08', 8.1453),
-    (1, 7, '2016-04-11', 8.1112),
-    (1, 7, '2016-04-12', 8.0701),
-    (1, 7, '2016-04-13', 8.1415),
-    (1, 7, '2016-04-14', 8.1264),
-    (1, 7, '2016-04-15', 8.1234),
-    (1, 7, '2016-04-18', 8.1214),
-    (1, 7, '2016-04-19', 8.0740),
-    (1, 7, '2016-04-20', 8.0890),
-    (1, 7, '2016-04-21', 8.1199),
-    (1, 7, '2016-04-22', 8.1244),
-    (1, 7, '2016-04-25', 8.1269),
-    (1, 7, '2016-04-26', 8.0938),
-    (1, 7, '2016-04-27', 8.0814),
-    (1, 7, '2016-04-28', 8.0851),
-    (1, 7, '2016-04-29', 8.0267),
-    (1, 7, '2016-05-02', 7.9761),
-    (1, 7, '2016-05-03', 8.0272),
-    (1, 7, '2016-05-04', 8.0679),
-    (1, 7, '2016-05-05', 8.1073),
-    (1, 7, '2016-05-06', 8.1254),
-    (1, 7, '2016-05-09', 8.1546),
-    (1, 7, '2016-05-10', 8.1466),
-    (1, 7, '2016-05-11', 8.1207),
-    (1, 7, '2016-05-12', 8.1880),
-    (1, 7, '2016-05-13', 8.2562),
-    (1, 7, '2016-05-16', 8.2416),
-    (1, 7, '2016-05-17', 8.2281),
-    (1, 7, '2016-05-18', 8.2868),
-    (1, 7, '2016-05-19', 8.3375),
-    (1, 7, '2016-05-20', 8.3308),
-    (1, 7, '2016-05-23', 8.3599),
-    (1, 7, '2016-05-24', 8.3219),
-    (1, 7, '2016-05-25', 8.3028),
-    (1, 7, '2016-05-26', 8.2847),
-    (1, 7, '2016-05-27', 8.3224),
-    (1, 7, '2016-05-30', NULL),
-    (1, 7, '2016-05-31', 8.3360),
-    (1, 7, '2016-06-01', 8.3030),
-    (1, 7, '2016-06-02', 8.3278),
-    (1, 7, '2016-06-03', 8.1742),
-    (1, 7, '2016-06-06', 8.1258),
-    (1, 7, '2016-06-07', 8.1404),
-    (1, 7, '2016-06-08', 8.1102),
-    (1, 7, '2016-06-09', 8.1685),
-    (1, 7, '2016-06-10', 8.2743),
-    (1, 7, '2016-06-13', 8.2548),
-    (1, 7, '2016-06-14', 8.3033),
-    (1, 7, '2016-06-15', 8.3230),
-    (1, 7, '2016-06-16', 8.4216),
-    (1, 7, '2016-06-17', 8.3406),
-    (1, 7, '2016-06-20', 8.2374),
-    (1, 7, '2016-06-21', 8.2575),
-    (1, 7, '2016-06-22', 8.2727),
-    (1, 7, '2016-06-23', 8.1654),
-    (1, 7, '2016-06-24', 8.4345),
-    (1, 7, '2016-06-27', 8.5598),
-    (1, 7, '2016-06-28', 8.5372),
-    (1, 7, '2016-06-29', 8.4602),
-    (1, 7, '2016-06-30', 8.5028),
-    (1, 7, '2016-07-01', 8.4301),
-    (1, 7, '2016-07-04', NULL),
-    (1, 7, '2016-07-05', 8.5280),
-    (1, 7, '2016-07-06', 8.5276),
-    (1, 7, '2016-07-07', 8.5724),
-    (1, 7, '2016-07-08', 8.5984),
-    (1, 7, '2016-07-11', 8.5840),
-    (1, 7, '2016-07-12', 8.5203),
-    (1, 7, '2016-07-13', 8.4751),
-    (1, 7, '2016-07-14', 8.4823),
-    (1, 7, '2016-07-15', 8.5455),
-    (1, 7, '2016-07-18', 8.5505),
-    (1, 7, '2016-07-19', 8.6114),
-    (1, 7, '2016-07-20', 8.6003),
-    (1, 7, '2016-07-21', 8.5947),
-    (1, 7, '2016-07-22', 8.6603),
-    (1, 7, '2016-07-25', 8.6513),
-    (1, 7, '2016-07-26', 8.6568),
-    (1, 7, '2016-07-27', 8.6797),
-    (1, 7, '2016-07-28', 8.6243),
-    (1, 7, '2016-07-29', 8.5503),
-    (1, 7, '2016-08-01', 8.5701),
-    (1, 7, '2016-08-02', 8.5105),
-    (1, 7, '2016-08-03', 8.5207),
-    (1, 7, '2016-08-04', 8.5214),
-    (1, 7, '2016-08-05', 8.5634),
-    (1, 7, '2016-08-08', 8.5867),
-    (1, 7, '2016-08-09', 8.5314),
-    (1, 7, '2016-08-10', 8.4686),
-    (1, 7, '2016-08-11', 8.4306),
-    (1, 7, '2016-08-12', 8.4400),
-    (1, 7, '2016-08-15', 8.4542),
-    (1, 7, '2016-08-16', 8.4091),
-    (1, 7, '2016-08-17', 8.4384),
-    (1, 7, '2016-08-18', 8.3580),
-    (1, 7, '2016-08-19', 8.3781),
-    (1, 7, '2016-08-22', 8.3826),
-    (1, 7, '2016-08-23', 8.3781),
-    (1, 7, '2016-08-24', 8.4068),
-    (1, 7, '2016-08-25', 8.4132),
-    (1, 7, '2016-08-26', 8.4464),
-    (1, 7, '2016-08-29', 8.4767),
-    (1, 7, '2016-08-30', 8.5389),
-    (1, 7, '2016-08-31', 8.5689),
-    (1, 7, '2016-09-01', 8.5504),
-    (1, 7, '2016-09-02', 8.5886),
-    (1, 7, '2016-09-05', NULL),
-    (1, 7, '2016-09-06', 8.4765),
-    (1, 7, '2016-09-07', 8.4443),
-    (1, 7, '2016-09-08', 8.4483),
-    (1, 7, '2016-09-09', 8.4995),
-    (1, 7, '2016-09-12', 8.4992),
-    (1, 7, '2016-09-13', 8.5072),
-    (1, 7, '2016-09-14', 8.4628),
-    (1, 7, '2016-09-15', 8.4753),
-    (1, 7, '2016-09-16', 8.5761),
-    (1, 7, '2016-09-19', 8.5483),
-    (1, 7, '2016-09-20', 8.5801),
-    (1, 7, '2016-09-21', 8.5831),
-    (1, 7, '2016-09-22', 8.5322),
-    (1, 7, '2016-09-23', 8.5457),
-    (1, 7, '2016-09-26', 8.5175),
-    (1, 7, '2016-09-27', 8.6120),
-    (1, 7, '2016-09-28', 8.5889),
-    (1, 7, '2016-09-29', 8.5435),
-    (1, 7, '2016-09-30', 8.5726),
-    (1, 7, '2016-10-03', 8.5641),
-    (1, 7, '2016-10-04', 8.5838),
-    (1, 7, '2016-10-05', 8.5989),
-    (1, 7, '2016-10-06', 8.6246),
-    (1, 7, '2016-10-07', 8.6616),
-    (1, 7, '2016-10-10', NULL),
-    (1, 7, '2016-10-11', 8.8172),
-    (1, 7, '2016-10-12', 8.8306),
-    (1, 7, '2016-10-13', 8.8050),
-    (1, 7, '2016-10-14', 8.8353),
-    (1, 7, '2016-10-17', 8.8135),
-    (1, 7, '2016-10-18', 8.8087),
-    (1, 7, '2016-10-19', 8.8431),
-    (1, 7, '2016-10-20', 8.8577),
-    (1, 7, '2016-10-21', 8.9297),
-    (1, 7, '2016-10-24', 8.9039),
-    (1, 7, '2016-10-25', 8.9224),
-    (1, 7, '2016-10-26', 8.8994),
-    (1, 7, '2016-10-27', 9.0646),
-    (1, 7, '2016-10-28', 9.0353),
-    (1, 7, '2016-10-31', 9.0207),
-    (1, 7, '2016-11-01', 8.9479),
-    (1, 7, '2016-11-02', 8.8938),
-    (1, 7, '2016-11-03', 8.9318),
-    (1, 7, '2016-11-04', 8.9543),
-    (1, 7, '2016-11-07', 9.0272),
-    (1, 7, '2016-11-08', 9.0102),
-    (1, 7, '2016-11-09', 9.0293),
-    (1, 7, '2016-11-10', 9.0874),
-    (1, 7, '2016-11-11', NULL),
-    (1, 7, '2016-11-14', 9.1669),
-    (1, 7, '2016-11-15', 9.1814),
-    (1, 7, '2016-11-16', 9.1849),
-    (1, 7, '2016-11-17', 9.2191),
-    (1, 7, '2016-11-18', 9.2515),
-    (1, 7, '2016-11-21', 9.2494),
-    (1, 7, '2016-11-22', 9.2267),
-    (1, 7, '2016-11-23', 9.2686),
-    (1, 7, '2016-11-24', NULL),
-    (1, 7, '2016-11-25', 9.2289),
-    (1, 7, '2016-11-28', 9.2275),
-    (1, 7, '2016-11-29', 9.1902),
-    (1, 7, '2016-11-30', 9.2548),
-    (1, 7, '2016-12-01', 9.2479),
-    (1, 7, '2016-12-02', 9.1936),
-    (1, 7, '2016-12-05', 9.1554),
-    (1, 7, '2016-12-06', 9.1214),
-    (1, 7, '2016-12-07', 9.0784),
-    (1, 7, '2016-12-08', 9.1273),
-    (1, 7, '2016-12-09', 9.1968),
-    (1, 7, '2016-12-12', 9.1874),
-    (1, 7, '2016-12-13', 9.1553),
-    (1, 7, '2016-12-14', 9.1535),
-    (1, 7, '2016-12-15', 9.4207),
-    (1, 7, '2016-12-16', 9.3581),
-    (1, 7, '2016-12-19', 9.3427),
-    (1, 7, '2016-12-20', 9.3557),
-    (1, 7, '2016-12-21', 9.2280),
-    (1, 7, '2016-12-22', 9.1911),
-    (1, 7, '2016-12-23', 9.1980),
-    (1, 7, '2016-12-26', NULL),
-    (1, 7, '2016-12-27', 9.2277),
-    (1, 7, '2016-12-28', 9.2060),
-    (1, 7, '2016-12-29', 9.1213),
-    (1, 7, '2016-12-30', 9.0803),
-    (1, 7, '2017-01-02', NULL),
-    (1, 7, '2017-01-03', 9.1583),
-    (1, 7, '2017-01-04', 9.1108),
-    (1, 7, '2017-01-05', 8.9981),
-    (1, 7, '2017-01-06', 9.0421),
-    (1, 7, '2017-01-09', 9.0441),
-    (1, 7, '2017-01-10', 9.0643),
-    (1, 7, '2017-01-11', 9.1003),
-    (1, 7, '2017-01-12', 8.9121),
-    (1, 7, '2017-01-13', 8.9143),
-    (1, 7, '2017-01-16', NULL),
-    (1, 7, '2017-01-17', 8.9119),
-    (1, 7, '2017-01-18', 8.9134),
-    (1, 7, '2017-01-19', 8.9770),
-    (1, 7, '2017-01-20', NULL),
-    (1, 7, '2017-01-23', 8.8427),
-    (1, 7, '2017-01-24', 8.8411),
-    (1, 7, '2017-01-25', 8.8254),
-    (1, 7, '2017-01-26', 8.8582),
-    (1, 7, '2017-01-27', 8.8499),
-    (1, 7, '2017-01-30', 8.8396),
-    (1, 7, '2017-01-31', 8.7525),
-    (1, 7, '2017-02-01', 8.7643),
-    (1, 7, '2017-02-02', 8.7176),
-    (1, 7, '2017-02-03', 8.7681),
-    (1, 7, '2017-02-06', 8.8343),
-    (1, 7, '2017-02-07', 8.8625),
-    (1, 7, '2017-02-08', 8.8331),
-    (1, 7, '2017-02-09', 8.9006),
-    (1, 7, '2017-02-10', 8.9000),
-    (1, 7, '2017-02-13', 8.9362),
-    (1, 7, '2017-02-14', 8.9400),
-    (1, 7, '2017-02-15', 8.9191),
-    (1, 7, '2017-02-16', 8.8612),
-    (1, 7, '2017-02-17', 8.9175),
-    (1, 7, '2017-02-20', NULL),
-    (1, 7, '2017-02-21', 8.9677),
-    (1, 7, '2017-02-22', 8.9820),
-    (1, 7, '2017-02-23', 8.9860),
-    (1, 7, '2017-02-24', 8.9994),
-    (1, 7, '2017-02-27', 9.0134),
-    (1, 7, '2017-02-28', 9.0098),
-    (1, 7, '2017-03-01', 9.0293),
-    (1, 7, '2017-03-02', 9.0664),
-    (1, 7, '2017-03-03', 9.0372),
-    (1, 7, '2017-03-06', 9.0115),
-    (1, 7, '2017-03-07', 8.9966),
-    (1, 7, '2017-03-08', 9.0444),
-    (1, 7, '2017-03-09', 9.0305),
-    (1, 7, '2017-03-10', 9.0139),
-    (1, 7, '2017-03-13', 8.9466),
-    (1, 7, '2017-03-14', 8.9471),
-    (1, 7, '2017-03-15', 8.9571),
-    (1, 7, '2017-03-16', 8.8241),
-    (1, 7, '2017-03-17', 8.8167),
-    (1, 7, '2017-03-20', 8.8247),
-    (1, 7, '2017-03-21', 8.7890),
-    (1, 7, '2017-03-22', 8.7984),
-    (1, 7, '2017-03-23', 8.8139),
-    (1, 7, '2017-03-24', 8.8111),
-    (1, 7, '2017-03-27', 8.7701),
-    (1, 7, '2017-03-28', 8.7864),
-    (1, 7, '2017-03-29', 8.8801),
-    (1, 7, '2017-03-30', 8.9092),
-    (1, 7, '2017-03-31', 8.9349),
-    (1, 7, '2017-04-03', 8.9639),
-    (1, 7, '2017-04-04', 9.0014),
-    (1, 7, '2017-04-05', 8.9780),
-    (1, 7, '2017-04-06', 9.0076),
-    (1, 7, '2017-04-07', 9.0503),
-    (1, 7, '2017-04-10', 9.0635),
-    (1, 7, '2017-04-11', 9.0450),
-    (1, 7, '2017-04-12', 9.0165),
-    (1, 7, '2017-04-13', 9.0255),
-    (1, 7, '2017-04-14', 9.0289),
-    (1, 7, '2017-04-17', 8.9864),
-    (1, 7, '2017-04-18', 8.9737),
-    (1, 7, '2017-04-19', 8.9820),
-    (1, 7, '2017-04-20', 8.9546),
-    (1, 7, '2017-04-21', 9.0155),
-    (1, 7, '2017-04-24', 8.8732),
-    (1, 7, '2017-04-25', 8.7675),
-    (1, 7, '2017-04-26', 8.7855),
-    (1, 7, '2017-04-27', 8.8499),
-    (1, 7, '2017-04-28', 8.8635),
-    (1, 7, '2017-05-01', 8.8281),
-    (1, 7, '2017-05-02', 8.8291),
-    (1, 7, '2017-05-03', 8.8148),
-    (1, 7, '2017-05-04', 8.8160),
-    (1, 7, '2017-05-05', 8.7941),
-    (1, 7, '2017-05-08', 8.8372),
-    (1, 7, '2017-05-09', 8.8876),
-    (1, 7, '2017-05-10', 8.9130),
-    (1, 7, '2017-05-11', 8.8799),
-    (1, 7, '2017-05-12', 8.8439),
-    (1, 7, '2017-05-15', 8.8190),
-    (1, 7, '2017-05-16', 8.7982),
-    (1, 7, '2017-05-17', 8.7533),
-    (1, 7, '2017-05-18', 8.7755),
-    (1, 7, '2017-05-19', 8.7398),
-    (1, 7, '2017-05-22', 8.6994),
-    (1, 7, '2017-05-23', 8.7069),
-    (1, 7, '2017-05-24', 8.7107),
-    (1, 7, '2017-05-25', 8.6731),
-    (1, 7, '2017-05-26', 8.6926),
-    (1, 7, '2017-05-29', NULL),
-    (1, 7, '2017-05-30', 8.7256),
-    (1, 7, '2017-05-31', 8.6788),
-    (1, 7, '2017-06-01', 8.6915),
-    (1, 7, '2017-06-02', 8.6323),
-    (1, 7, '2017-06-05', 8.6621),
-    (1, 7, '2017-06-06', 8.6733),
-    (1, 7, '2017-06-07', 8.6945),
-    (1, 7, '2017-06-08', 8.7159),
-    (1, 7, '2017-06-09', 8.7178),
-    (1, 7, '2017-06-12', 8.7412),
-    (1, 7, '2017-06-13', 8.7035),
-    (1, 7, '2017-06-14', 8.6421),
-    (1, 7, '2017-06-15', 8.7503),
-    (1, 7, '2017-06-16', 8.7035),
-    (1, 7, '2017-06-19', 8.7339),
-    (1, 7, '2017-06-20', 8.7941),
-    (1, 7, '2017-06-21', 8.7619),
-    (1, 7, '2017-06-22', 8.7530),
-    (1, 7, '2017-06-23', 8.7139),
-    (1, 7, '2017-06-26', 8.7130),
-    (1, 7, '2017-06-27', 8.6147),
-    (1, 7, '2017-06-28', 8.5665),
-    (1, 7, '2017-06-29', 8.4950),
-    (1, 7, '2017-06-30', 8.4400),
-    (1, 7, '2017-07-03', 8.4742),
-    (1, 7, '2017-07-04', NULL),
-    (1, 7, '2017-07-05', 8.5031),
-    (1, 7, '2017-07-06', 8.4454),
-    (1, 7, '2017-07-07', 8.4373),
-    (1, 7, '2017-07-10', 8.4368),
-    (1, 7, '2017-07-11', 8.4259),
-    (1, 7, '2017-07-12', 8.4176),
-    (1, 7, '2017-07-13', 8.3662),
-    (1, 7, '2017-07-14', 8.3298),
-    (1, 7, '2017-07-17', 8.3016),
-    (1, 7, '2017-07-18', 8.2742),
-    (1, 7, '2017-07-19', 8.2893),
-    (1, 7, '2017-07-20', 8.2314),
-    (1, 7, '2017-07-21', 8.2491),
-    (1, 7, '2017-07-24', 8.2205),
-    (1, 7, '2017-07-25', 8.2025),
-    (1, 7, '2017-07-26', 8.2301),
-    (1, 7, '2017-07-27', 8.2297),
-    (1, 7, '2017-07-28', 8.1177),
-    (1, 7, '2017-07-31', 8.0752),
-    (1, 7, '2017-08-01', 8.1124),
-    (1, 7, '2017-08-02', 8.0958),
-    (1, 7, '2017-08-03', 8.0922),
-    (1, 7, '2017-08-04', 8.1615),
-    (1, 7, '2017-08-07', 8.1597),
-    (1, 7, '2017-08-08', 8.1928),
-    (1, 7, '2017-08-09', 8.1879),
-    (1, 7, '2017-08-10', 8.1475),
-    (1, 7, '2017-08-11', 8.1405),
-    (1, 7, '2017-08-14', 8.1107),
-    (1, 7, '2017-08-15', 8.0847),
-    (1, 7, '2017-08-16', 8.1177),
-    (1, 7, '2017-08-17', 8.1152),
-    (1, 7, '2017-08-18', 8.1082),
-    (1, 7, '2017-08-21', 8.0693),
-    (1, 7, '2017-08-22', 8.1034),
-    (1, 7, '2017-08-23', 8.0753),
-    (1, 7, '2017-08-24', 8.0584),
-    (1, 7, '2017-08-25', 7.9963),
-    (1, 7, '2017-08-28', 7.9591),
-    (1, 7, '2017-08-29', 7.9132),
-    (1, 7, '2017-08-30', 7.9635),
-    (1, 7, '2017-08-31', 7.9550),
-    (1, 7, '2017-09-01', 7.9911),
-    (1, 7, '2017-09-04', NULL),
-    (1, 7, '2017-09-05', 7.9640),
-    (1, 7, '2017-09-06', 7.9506),
-    (1, 7, '2017-09-07', 7.9435),
-    (1, 7, '2017-09-08', 7.9361),
-    (1, 7, '2017-09-11', 8.0063),
-    (1, 7, '2017-09-12', 7.9619),
-    (1, 7, '2017-09-13', 8.0295),
-    (1, 7, '2017-09-14', 8.0116),
-    (1, 7, '2017-09-15', 7.9589),
-    (1, 7, '2017-09-18', 7.9673),
-    (1, 7, '2017-09-19', 7.9585),
-    (1, 7, '2017-09-20', 7.9472),
-    (1, 7, '2017-09-21', 7.9705),
-    (1, 7, '2017-09-22', 7.9536),
-    (1, 7, '2017-09-25', 8.0486),
-    (1, 7, '2017-09-26', 8.1471),
-    (1, 7, '2017-09-27', 8.1508),
-    (1, 7, '2017-09-28', 8.1411),
-    (1, 7, '2017-09-29', 8.1538),
-    (1, 7, '2017-10-02', 8.1595),
-    (1, 7, '2017-10-03', 8.1252),
-    (1, 7, '2017-10-04', 8.0959),
-    (1, 7, '2017-10-05', 8.1354),
-    (1, 7, '2017-10-06', 8.1198),
-    (1, 7, '2017-10-09', NULL),
-    (1, 7, '2017-10-10', 8.0743),
-    (1, 7, '2017-10-11', 8.0534),
-    (1, 7, '2017-10-12', 8.1051),
-    (1, 7, '2017-10-13', 8.0990),
-    (1, 7, '2017-10-16', 8.1015),
-    (1, 7, '2017-10-17', 8.1608),
-    (1, 7, '2017-10-18', 8.1522),
-    (1, 7, '2017-10-19', 8.1393),
-    (1, 7, '2017-10-20', 8.1729),
-    (1, 7, '2017-10-23', 8.1885),
-    (1, 7, '2017-10-24', 8.2044),
-    (1, 7, '2017-10-25', 8.2228),
-    (1, 7, '2017-10-26', 8.3177),
-    (1, 7, '2017-10-27', 8.3803),
-    (1, 7, '2017-10-30', 8.3602),
-    (1, 7, '2017-10-31', 8.3612),
-    (1, 7, '2017-11-01', 8.4017),
-    (1, 7, '2017-11-02', 8.3849),
-    (1, 7, '2017-11-03', 8.4340),
-    (1, 7, '2017-11-06', 8.4036),
-    (1, 7, '2017-11-07', 8.4021),
-    (1, 7, '2017-11-08', 8.3826),
-    (1, 7, '2017-11-09', 8.3683),
-    (1, 7, '2017-11-10', NULL),
-    (1, 7, '2017-11-13', 8.3942),
-    (1, 7, '2017-11-14', 8.4188),
-    (1, 7, '2017-11-15', 8.4436),
-    (1, 7, '2017-11-16', 8.4038),
-    (1, 7, '2017-11-17', 8.4375),
-    (1, 7, '2017-11-20', 8.4803),
-    (1, 7, '2017-11-21', 8.4297),
-    (1, 7, '2017-11-22', 8.3785),
-    (1, 7, '2017-11-23', NULL),
-    (1, 7, '2017-11-24', 8.2769),
-    (1, 7, '2017-11-27', 8.2971),
-    (1, 7, '2017-11-28', 8.3377),
-    (1, 7, '2017-11-29', 8.3510),
-    (1, 7, '2017-11-30', 8.3599),
-    (1, 7, '2017-12-01', 8.3623),
-    (1, 7, '2017-12-04', 8.4238),
-    (1, 7, '2017-12-05', 8.3763),
-    (1, 7, '2017-12-06', 8.4298),
-    (1, 7, '2017-12-07', 8.4676),
-    (1, 7, '2017-12-08', 8.4696),
-    (1, 7, '2017-12-11', 8.4962),
-    (1, 7, '2017-12-12', 8.4465),
-    (1, 7, '2017-12-13', 8.4464),
-    (1, 7, '2017-12-14', 8.4288),
-    (1, 7, '2017-12-15', 8.5053),
-    (1, 7, '2017-12-18', 8.4169),
-    (1, 7, '2017-12-19', 8.4195),
-    (1, 7, '2017-12-20', 8.3783),
-    (1, 7, '2017-12-21', 8.3935),
-    (1, 7, '2017-12-22', 8.3580),
-    (1, 7, '2017-12-25', NULL),
-    (1, 7, '2017-12-26', 8.3451),
-    (1, 7, '2017-12-27', 8.2684),
-    (1, 7, '2017-12-28', 8.2438),
-    (1, 7, '2017-12-29', 8.1732),
-    (1, 7, '2018-01-01', NULL),
-    (1, 7, '2018-01-02', 8.1724),
-    (1, 7, '2018-01-03', 8.1708),
-    (1, 7, '2018-01-04', 8.1370),
-    (1, 7, '2018-01-05', 8.1331),
-    (1, 7, '2018-01-08', 8.1986),
-    (1, 7, '2018-01-09', 8.2413),
-    (1, 7, '2018-01-10', 8.1973),
-    (1, 7, '2018-01-11', 8.1325),
-    (1, 7, '2018-01-12', 8.0933),
-    (1, 7, '2018-01-15', NULL),
-    (1, 7, '2018-01-16', 8.0402),
-    (1, 7, '2018-01-17', 8.0309),
-    (1, 7, '2018-01-18', 8.0151),
-    (1, 7, '2018-01-19', 8.0348),
-    (1, 7, '2018-01-22', 8.0412),
-    (1, 7, '2018-01-23', 8.0289),
-    (1, 7, '2018-01-24', 7.9408),
-    (1, 7, '2018-01-25', 7.8549),
-    (1, 7, '2018-01-26', 7.8723),
-    (1, 7, '2018-01-29', 7.9063),
-    (1, 7, '2018-01-30', 7.8925),
-    (1, 7, '2018-01-31', 7.8736),
-    (1, 7, '2018-02-01', 7.8588),
-    (1, 7, '2018-02-02', 7.9092),
-    (1, 7, '2018-02-05', 7.9258),
-    (1, 7, '2018-02-06', 7.9540),
-    (1, 7, '2018-02-07', 8.0396),
-    (1, 7, '2018-02-08', 8.1225),
-    (1, 7, '2018-02-09', 8.1069),
-    (1, 7, '2018-02-12', 8.0868),
-    (1, 7, '2018-02-13', 8.0257),
-    (1, 7, '2018-02-14', 8.0051),
-    (1, 7, '2018-02-15', 7.9596),
-    (1, 7, '2018-02-16', 7.9440),
-    (1, 7, '2018-02-19', NULL),
-    (1, 7, '2018-02-20', 8.0775),
-    (1, 7, '2018-02-21', 8.0766),
-    (1, 7, '2018-02-22', 8.1228),
-    (1, 7, '2018-02-23', 8.1611),
-    (1, 7, '2018-02-26', 8.1609),
-    (1, 7, '2018-02-27', 8.2235),
-    (1, 7, '2018-02-28', 8.2694),
-    (1, 7, '2018-03-01', 8.2603),
-    (1, 7, '2018-03-02', 8.259),
-    (1, 7, '2018-03-05', 8.2558),
-    (1, 7, '2018-03-06', 8.209),
-    (1, 7, '2018-03-07', 8.2539),
-    (1, 7, '2018-03-08', 8.2705),
-    (1, 7, '2018-03-09', 8.2307),
-    (1, 7, '2018-03-12', 8.2461),
-    (1, 7, '2018-03-13', 8.2013),
-    (1, 7, '2018-03-14', 8.1973),
-    (1, 7, '2018-03-15', 8.1808),
-    (1, 7, '2018-03-16', 8.196),
-    (1, 7, '2018-03-19', 8.1707),
-    (1, 7, '2018-03-20', 8.2043),
-    (1, 7, '2018-03-21', 8.223),
-    (1, 7, '2018-03-22', 8.2448),
-    (1, 7, '2018-03-23', 8.2472),
-    (1, 7, '2018-03-26', 8.1941),
-    (1, 7, '2018-03-27', 8.2118),
-    (1, 7, '2018-03-28', 8.3133),
-    (1, 7, '2018-03-29', 8.3619),
-    (1, 7, '2018-03-30', 8.3619),
-    (1, 7, '2018-04-02', 8.39),
-    (1, 7, '2018-04-03', 8.3973),
-    (1, 7, '2018-04-04', 8.4029),
-    (1, 7, '2018-04-05', 8.4306),
-    (1, 7, '2018-04-06', 8.3906),
-    (1, 7, '2018-04-09', 8.3617),
-    (1, 7, '2018-04-10', 8.3157),
-    (1, 7, '2018-04-11', 8.3274),
-    (1, 7, '2018-04-12', 8.4237),
-    (1, 7, '2018-04-13', 8.446),
-    (1, 7, '2018-04-16', 8.4279),
-    (1, 7, '2018-04-17', 8.4183),
-    (1, 7, '2018-04-18', 8.3922),
-    (1, 7, '2018-04-19', 8.407),
-    (1, 7, '2018-04-20', 8.4453),
-    (1, 7, '2018-04-23', 8.501),
-    (1, 7, '2018-04-24', 8.5138),
-    (1, 7, '2018-04-25', 8.5597),
-    (1, 7, '2018-04-26', 8.6604),
-    (1, 7, '2018-04-27', 8.6769),
-    (1, 7, '2018-04-30', 8.763),
-    (1, 7, '2018-05-01', 8.8386),
-    (1, 7, '2018-05-02', 8.8974),
-    (1, 7, '2018-05-03', 8.8417),
-    (1, 7, '2018-05-04', 8.8033),
-    (1, 7, '2018-05-07', 8.8184),
-    (1, 7, '2018-05-08', 8.8136),
-    (1, 7, '2018-05-09', 8.7044),
-    (1, 7, '2018-05-10', 8.6631),
-    (1, 7, '2018-05-11', 8.5912),
-    (1, 7, '2018-05-14', 8.6003),
-    (1, 7, '2018-05-15', 8.6668),
-    (1, 7, '2018-05-16', 8.6664),
-    (1, 7, '2018-05-17', 8.7446),
-    (1, 7, '2018-05-18', 8.7504),
-    (1, 7, '2018-05-21', 8.7015),
-    (1, 7, '2018-05-22', 8.6708),
-    (1, 7, '2018-05-23', 8.7691),
-    (1, 7, '2018-05-24', 8.7539),
-    (1, 7, '2018-05-25', 8.7432),
-    (1, 7, '2018-05-28', NULL),
-    (1, 7, '2018-05-29', 8.9564),
-    (1, 7, '2018-05-30', 8.8025),
-    (1, 7, '2018-05-31', 8.8233),
-    (1, 7, '2018-06-01', 8.8205),
-    (1, 7, '2018-06-04', 8.753),
-    (1, 7, '2018-06-05', 8.7774),
-    (1, 7, '2018-06-06', 8.7279),
-    (1, 7, '2018-06-07', 8.6793),
-    (1, 7, '2018-06-08', 8.716),
-    (1, 7, '2018-06-11', 8.6511),
-    (1, 7, '2018-06-12', 8.6059),
-    (1, 7, '2018-06-13', 8.6179),
-    (1, 7, '2018-06-14', 8.6839),
-    (1, 7, '2018-06-15', 8.7822),
-    (1, 7, '2018-06-18', 8.8301),
-    (1, 7, '2018-06-19', 8.9066),
-    (1, 7, '2018-06-20', 8.8703),
-    (1, 7, '2018-06-21', 8.9077),
-    (1, 7, '2018-06-22', 8.8698),
-    (1, 7, '2018-06-25', 8.8699),
-    (1, 7, '2018-06-26', 8.8558),
-    (1, 7, '2018-06-27', 8.9352),
-    (1, 7, '2018-06-28', 9.0091),
-    (1, 7, '2018-06-29', 8.9511),
-    (1, 7, '2018-07-02', 8.9988),
-    (1, 7, '2018-07-03', 8.8262),
-    (1, 7, '2018-07-04', NULL),
-    (1, 7, '2018-07-05', 8.767),
-    (1, 7, '2018-07-06', 8.7275),
-    (1, 7, '2018-07-09', 8.7241),
-    (1, 7, '2018-07-10', 8.7474),
-    (1, 7, '2018-07-11', 8.7769),
-    (1, 7, '2018-07-12', 8.8454),
-    (1, 7, '2018-07-13', 8.904),
-    (1, 7, '2018-07-16', 8.8205),
-    (1, 7, '2018-07-17', 8.825),
-    (1, 7, '2018-07-18', 8.8646),
-    (1, 7, '2018-07-19', 8.9426),
-    (1, 7, '2018-07-20', 8.8671),
-    (1, 7, '2018-07-23', 8.8522),
-    (1, 7, '2018-07-24', 8.828),
-    (1, 7, '2018-07-25', 8.8035),
-    (1, 7, '2018-07-26', 8.8052),
-    (1, 7, '2018-07-27', 8.8373),
-    (1, 7, '2018-07-30', 8.7522),
-    (1, 7, '2018-07-31', 8.7856),
-    (1, 7, '2018-08-01', 8.8033),
-    (1, 7, '2018-08-02', 8.8729),
-    (1, 7, '2018-08-03', 8.9009),
-    (1, 7, '2018-08-06', 8.9208),
-    (1, 7, '2018-08-07', 8.9106),
-    (1, 7, '2018-08-08', 8.9601),
-    (1, 7, '2018-08-09', 8.9786),
-    (1, 7, '2018-08-10', 9.1367),
-    (1, 7, '2018-08-13', 9.1325),
-    (1, 7, '2018-08-14', 9.1308),
-    (1, 7, '2018-08-15', 9.2228),
-    (1, 7, '2018-08-16', 9.1874),
-    (1, 7, '2018-08-17', 9.1726),
-    (1, 7, '2018-08-20', 9.1778),
-    (1, 7, '2018-08-21', 9.1198),
-    (1, 7, '2018-08-22', 9.0668),
-    (1, 7, '2018-08-23', 9.1208),
-    (1, 7, '2018-08-24', 9.1138),
-    (1, 7, '2018-08-27', 9.0953),
-    (1, 7, '2018-08-28', 9.1154),
-    (1, 7, '2018-08-29', 9.1518),
-    (1, 7, '2018-08-30', 9.1388),
-    (1, 7, '2018-08-31', 9.1566),
-    (1, 7, '2018-09-03', NULL),
-    (1, 7, '2018-09-04', 9.1118),
-    (1, 7, '2018-09-05', 9.0702),
-    (1, 7, '2018-09-06', 9.0697),
-    (1, 7, '2018-09-07', 9.0813),
-    (1, 7, '2018-09-10', 9.0357),
-    (1, 7, '2018-09-11', 9.0354),
-    (1, 7, '2018-09-12', 9.0653),
-    (1, 7, '2018-09-13', 8.988),
-    (1, 7, '2018-09-14', 9.0299),
-    (1, 7, '2018-09-17', 8.9141),
-    (1, 7, '2018-09-18', 8.8993),
-    (1, 7, '2018-09-19', 8.868),
-    (1, 7, '2018-09-20', 8.7931),
-    (1, 7, '2018-09-21', 8.7938),
-    (1, 7, '2018-09-24', 8.7953),
-    (1, 7, '2018-09-25', 8.7939),
-    (1, 7, '2018-09-26', 8.8082),
-    (1, 7, '2018-09-27', 8.8202),
-    (1, 7, '2018-09-28', 8.878),
-    (1, 7, '2018-10-01', 8.9488),
-    (1, 7, '2018-10-02', 8.9855),
-    (1, 7, '2018-10-03', 9.029),
-    (1, 7, '2018-10-04', 9.0479),
-    (1, 7, '2018-10-05', 9.0857),
-    (1, 7, '2018-10-08', NULL),
-    (1, 7, '2018-10-09', 9.115),
-    (1, 7, '2018-10-10', 9.0955),
-    (1, 7, '2018-10-11', 9.0146),
-    (1, 7, '2018-10-12', 8.9654),
-    (1, 7, '2018-10-15', 8.9405),
-    (1, 7, '2018-10-16', 8.886),
-    (1, 7, '2018-10-17', 8.949),
-    (1, 7, '2018-10-18', 9.0182),
-    (1, 7, '2018-10-19', 8.9864),
-    (1, 7, '2018-10-22', 8.9967),
-    (1, 7, '2018-10-23', 9.035),
-    (1, 7, '2018-10-24', 9.0959),
-    (1, 7, '2018-10-25', 9.1089),
-    (1, 7, '2018-10-26', 9.1256),
-    (1, 7, '2018-10-29', 9.1278),
-    (1, 7, '2018-10-30', 9.1567),
-    (1, 7, '2018-10-31', 9.1462),
-    (1, 7, '2018-11-01', 9.1462),
-    (1, 7, '2018-11-02', 9.0777),
-    (1, 7, '2018-11-05', 9.0722),
-    (1, 7, '2018-11-06', 9.0716),
-    (1, 7, '2018-11-07', 9.0028),
-    (1, 7, '2018-11-08', 8.9832),
-    (1, 7, '2018-11-09', 9.0716),
-    (1, 7, '2018-11-12', NULL),
-    (1, 7, '2018-11-13', 9.1207),
-    (1, 7, '2018-11-14', 9.0863),
-    (1, 7, '2018-11-15', 9.0535),
-    (1, 7, '2018-11-16', 9.0151),
-    (1, 7, '2018-11-19', 9.0025),
-    (1, 7, '2018-11-20', 9.0638),
-    (1, 7, '2018-11-21', 9.0278),
-    (1, 7, '2018-11-22', NULL),
-    (1, 7, '2018-11-23', 9.0909),
-    (1, 7, '2018-11-26', 9.0929),
-    (1, 7, '2018-11-27', 9.1149),
-    (1, 7, '2018-11-28', 9.1007),
-    (1, 7, '2018-11-29', 9.0599),
-    (1, 7, '2018-11-30', 9.0982),
-    (1, 7, '2018-12-03', 9.0234),
-    (1, 7, '2018-12-04', 9.0086),
-    (1, 7, '2018-12-05', NULL),
-    (1, 7, '2018-12-06', 9.0037),
-    (1, 7, '2018-12-07', 9.0318),
-    (1, 7, '2018-12-10', 9.0804),
-    (1, 7, '2018-12-11', 9.0819),
-    (1, 7, '2018-12-12', 9.0916),
-    (1, 7, '2018-12-13', 9.054),
-    (1, 7, '2018-12-14', 9.0758),
-    (1, 7, '2018-12-17', 9.0447),
-    (1, 7, '2018-12-18', 9.0711),
-    (1, 7, '2018-12-19', 9.0572),
-    (1, 7, '2018-12-20', 8.9922),
-    (1, 7, '2018-12-21', 9.0227),
-    (1, 7, '2018-12-24', NULL),
-    (1, 7, '2018-12-25', NULL),
-    (1, 7, '2018-12-26', 9.0704),
-    (1, 7, '2018-12-27', 9.0101),
-    (1, 7, '2018-12-28', 8.9595),
-    (1, 7, '2018-12-31', 8.8654),
-    (1, 7, '2019-01-01', NULL),
-    (1, 7, '2019-01-02', 8.9931),
-    (1, 7, '2019-01-03', 9.0004),
-    (1, 7, '2019-01-04', 8.9488),
-    (1, 7, '2019-01-07', 8.9013),
-    (1, 7, '2019-01-08', 8.9281),
-    (1, 7, '2019-01-09', 8.8836),
-    (1, 7, '2019-01-10', 8.8829),
-    (1, 7, '2019-01-11', 8.9305),
-    (1, 7, '2019-01-14', NULL),
-    (1, 7, '2019-01-15', 8.9763),
-    (1, 7, '2019-01-16', 8.9846),
-    (1, 7, '2019-01-17', 9.027),
-    (1, 7, '2019-01-18', 9.0187),
-    (1, 7, '2019-01-21', NULL),
-    (1, 7, '2019-01-22', 9.0263),
-    (1, 7, '2019-01-23', 9.0118),
-    (1, 7, '2019-01-24', 9.0729),
-    (1, 7, '2019-01-25', 9.0518),
-    (1, 7, '2019-01-28', 9.0527),
-    (1, 7, '2019-01-29', 9.0726),
-    (1, 7, '2019-01-30', 9.0908),
-    (1, 7, '2019-01-31', 9.0465),
-    (1, 7, '2019-02-01', 9.0384),
-    (1, 7, '2019-02-04', 9.0968),
-    (1, 7, '2019-02-05', 9.1098),
-    (1, 7, '2019-02-06', 9.1721),
-    (1, 7, '2019-02-07', 9.238),
-    (1, 7, '2019-02-08', 9.265),
-    (1, 7, '2019-02-11', 9.3008),
-    (1, 7, '2019-02-12', 9.2598),
-    (1, 7, '2019-02-13', 9.2425),
-    (1, 7, '2019-02-14', 9.3032),
-    (1, 7, '2019-02-15', 9.2867),
-    (1, 7, '2019-02-18', NULL),
-    (1, 7, '2019-02-19', 9.3256),
-    (1, 7, '2019-02-20', NULL),
-    (1, 7, '2019-02-21', 9.3519),
-    (1, 7, '2019-02-22', 9.351),
-    (1, 7, '2019-02-25', 9.3218),
-    (1, 7, '2019-02-26', 9.3018),
-    (1, 7, '2019-02-27', 9.2671),
-    (1, 7, '2019-02-28', 9.2341),
-    (1, 7, '2019-03-01', 9.2607),
-    (1, 7, '2019-03-04', 9.3444),
-    (1, 7, '2019-03-05', 9.3244),
-    (1, 7, '2019-03-06', 9.288),
-    (1, 7, '2019-03-07', 9.4392),
-    (1, 7, '2019-03-08', 9.4369),
-    (1, 7, '2019-03-11', 9.4168),
-    (1, 7, '2019-03-12', 9.3647),
-    (1, 7, '2019-03-13', 9.3031),
-    (1, 7, '2019-03-14', 9.3143),
-    (1, 7, '2019-03-15', 9.2399),
-    (1, 7, '2019-03-18', 9.2351),
-    (1, 7, '2019-03-19', 9.202),
-    (1, 7, '2019-03-20', 9.1839),
-    (1, 7, '2019-03-21', 9.1783),
-    (1, 7, '2019-03-22', 9.2947),
-    (1, 7, '2019-03-25', 9.2282),
-    (1, 7, '2019-03-26', 9.232),
-    (1, 7, '2019-03-27', 9.2772),
-    (1, 7, '2019-03-28', 9.2962),
-    (1, 7, '2019-03-29', 9.2949),
-    (1, 7, '2019-04-01', 9.2821),
-    (1, 7, '2019-04-02', 9.3349),
-    (1, 7, '2019-04-03', 9.2636),
-    (1, 7, '2019-04-04', 9.2768),
-    (1, 7, '2019-04-05', 9.2875),
-    (1, 7, '2019-04-08', 9.2523),
-    (1, 7, '2019-04-09', 9.2437),
-    (1, 7, '2019-04-10', 9.2637),
-    (1, 7, '2019-04-11', 9.2745),
-    (1, 7, '2019-04-12', 9.2636),
-    (1, 7, '2019-04-15', 9.2573),
-    (1, 7, '2019-04-16', 9.2445),
-    (1, 7, '2019-04-17', 9.245),
-    (1, 7, '2019-04-18', 9.3007),
-    (1, 7, '2019-04-19', 9.3027),
-    (1, 7, '2019-04-22', 9.3024),
-    (1, 7, '2019-04-23', 9.3665),
-    (1, 7, '2019-04-24', 9.3982),
-    (1, 7, '2019-04-25', 9.5287),
-    (1, 7, '2019-04-26', 9.4979),
-    (1, 7, '2019-04-29', 9.5242),
-    (1, 7, '2019-04-30', 9.5041),
-    (1, 7, '2019-05-01', 9.4968),
-    (1, 7, '2019-05-02', 9.5663),
-    (1, 7, '2019-05-03', 9.5483),
-    (1, 7, '2019-05-06', 9.5618),
-    (1, 7, '2019-05-07', 9.5996),
-    (1, 7, '2019-05-08', 9.5894),
-    (1, 7, '2019-05-09', 9.6466),
-    (1, 7, '2019-05-10', 9.6173),
-    (1, 7, '2019-05-13', 9.6251),
-    (1, 7, '2019-05-14', 9.626),
-    (1, 7, '2019-05-15', 9.6003),
-    (1, 7, '2019-05-16', 9.6018),
-    (1, 7, '2019-05-17', 9.6408),
-    (1, 7, '2019-05-20', 9.6552),
-    (1, 7, '2019-05-21', 9.66),
-    (1, 7, '2019-05-22', 9.6331),
-    (1, 7, '2019-05-23', 9.624),
-    (1, 7, '2019-05-24', 9.5539),
-    (1, 7, '2019-05-27', NULL),
-    (1, 7, '2019-05-28', 9.5673),
-    (1, 7, '2019-05-29', 9.5692),
-    (1, 7, '2019-05-30', 9.5312),
-    (1, 7, '2019-05-31', 9.513),
-    (1, 7, '2019-06-03', 9.4729),
-    (1, 7, '2019-06-04', 9.4561),
-    (1, 7, '2019-06-05', 9.4394),
-    (1, 7, '2019-06-06', 9.4418),
-    (1, 7, '2019-06-07', 9.4001),
-    (1, 7, '2019-06-10', 9.4173),
-    (1, 7, '2019-06-11', 9.4424),
-    (1, 7, '2019-06-12', 9.4516),
-    (1, 7, '2019-06-13', 9.4878),
-    (1, 7, '2019-06-14', 9.4858),
-    (1, 7, '2019-06-17', 9.4705),
-    (1, 7, '2019-06-18', 9.5197),
-    (1, 7, '2019-06-19', 9.5197),
-    (1, 7, '2019-06-20', 9.4176),
-    (1, 7, '2019-06-21', 9.3861),
-    (1, 7, '2019-06-24', 9.2946),
-    (1, 7, '2019-06-25', 9.2585),
-    (1, 7, '2019-06-26', 9.2506),
-    (1, 7, '2019-06-27', 9.2713),
-    (1, 7, '2019-06-28', 9.2869),
-    (1, 7, '2019-07-01', 9.3364),
-    (1, 7, '2019-07-02', 9.3219),
-    (1, 7, '2019-07-03', 9.3155),
-    (1, 7, '2019-07-04', NULL),
-    (1, 7, '2019-07-05', 9.4413),
-    (1, 7, '2019-07-08', 9.4618),
-    (1, 7, '2019-07-09', 9.4701),
-    (1, 7, '2019-07-10', 9.4105),
-    (1, 7, '2019-07-11', 9.3845),
-    (1, 7, '2019-07-12', 9.3763),
-    (1, 7, '2019-07-15', 9.3568),
-    (1, 7, '2019-07-16', 9.3801),
-    (1, 7, '2019-07-17', 9.3537),
-    (1, 7, '2019-07-18', 9.351),
-    (1, 7, '2019-07-19', 9.3764),
-    (1, 7, '2019-07-22', 9.4079),
-    (1, 7, '2019-07-23', 9.4649),
-    (1, 7, '2019-07-24', 9.4284),
-    (1, 7, '2019-07-25', 9.439),
-    (1, 7, '2019-07-26', 9.4974),
-    (1, 7, '2019-07-29', 9.4853),
-    (1, 7, '2019-07-30', 9.5678),
-    (1, 7, '2019-07-31', 9.5929),
-    (1, 7, '2019-08-01', 9.6492),
-    (1, 7, '2019-08-02', 9.6397),
-    (1, 7, '2019-08-05', 9.6109),
-    (1, 7, '2019-08-06', 9.588),
-    (1, 7, '2019-08-07', 9.6311),
-    (1, 7, '2019-08-08', 9.5777),
-    (1, 7, '2019-08-09', 9.5384),
-    (1, 7, '2019-08-12', 9.5709),
-    (1, 7, '2019-08-13', 9.5513),
-    (1, 7, '2019-08-14', 9.6326),
-    (1, 7, '2019-08-15', 9.6393),
-    (1, 7, '2019-08-16', 9.6643),
-    (1, 7, '2019-08-19', 9.6668),
-    (1, 7, '2019-08-20', 9.7057),
-    (1, 7, '2019-08-21', 9.6202),
-    (1, 7, '2019-08-22', 9.6641),
-    (1, 7, '2019-08-23', 9.6278),
-    (1, 7, '2019-08-26', 9.6712),
-    (1, 7, '2019-08-27', 9.6623),
-    (1, 7, '2019-08-28', 9.719),
-    (1, 7, '2019-08-29', 9.7695),
-    (1, 7, '2019-08-30', 9.8277),
-    (1, 7, '2019-09-02', NULL),
-    (1, 7, '2019-09-03', 9.8378),
-    (1, 7, '2019-09-04', 9.7519),
-    (1, 7, '2019-09-05', 9.6796),
-    (1, 7, '2019-09-06', 9.6179),
-    (1, 7, '2019-09-09', 9.6489),
-    (1, 7, '2019-09-10', 9.6885),
-    (1, 7, '2019-09-11', 9.6871),
-    (1, 7, '2019-09-12', 9.6312),
-    (1, 7, '2019-09-13', 9.6095),
-    (1, 7, '2019-09-16', 9.6649),
-    (1, 7, '2019-09-17', 9.6711),
-    (1, 7, '2019-09-18', 9.6955),
-    (1, 7, '2019-09-19', 9.7018),
-    (1, 7, '2019-09-20', 9.7313),
-    (1, 7, '2019-09-23', 9.7217),
-    (1, 7, '2019-09-24', 9.6905),
-    (1, 7, '2019-09-25', 9.7538),
-    (1, 7, '2019-09-26', 9.757),
-    (1, 7, '2019-09-27', 9.8006),
-    (1, 7, '2019-09-30', 9.8307),
-    (1, 7, '2019-10-01', 9.8791),
-    (1, 7, '2019-10-02', 9.8791),
-    (1, 7, '2019-10-03', 9.8375),
-    (1, 7, '2019-10-04', 9.8512),
-    (1, 7, '2019-10-07', 9.8999),
-    (1, 7, '2019-10-08', 9.9471),
-    (1, 7, '2019-10-09', 9.9576),
-    (1, 7, '2019-10-10', 9.8406),
-    (1, 7, '2019-10-11', 9.8406),
-    (1, 7, '2019-10-14', NULL),
-    (1, 7, '2019-10-15', 9.7916),
-    (1, 7, '2019-10-16', 9.7807),
-    (1, 7, '2019-10-17', 9.7088),
-    (1, 7, '2019-10-18', 9.6665),
-    (1, 7, '2019-10-21', 9.629),
-    (1, 7, '2019-10-22', 9.6328),
-    (1, 7, '2019-10-23', 9.6592),
-    (1, 7, '2019-10-24', 9.6481),
-    (1, 7, '2019-10-25', 9.6901),
-    (1, 7, '2019-10-28', 9.7045),
-    (1, 7, '2019-10-29', 9.7176),
-    (1, 7, '2019-10-30', 9.6903),
-    (1, 7, '2019-10-31', 9.6334),
-    (1, 7, '2019-11-01', 9.5639),
-    (1, 7, '2019-11-04', 9.603),
-    (1, 7, '2019-11-05', 9.6698),
-    (1, 7, '2019-11-06', 9.6179),
-    (1, 7, '2019-11-07', 9.6369),
-    (1, 7, '2019-11-08', 9.7095),
-    (1, 7, '2019-11-11', NULL),
-    (1, 7, '2019-11-12', 9.71),
-    (1, 7, '2019-11-13', 9.7303),
-    (1, 7, '2019-11-14', 9.6964),
-    (1, 7, '2019-11-15', 9.6467),
-    (1, 7, '2019-11-18', 9.6139),
-    (1, 7, '2019-11-19', 9.6399),
-    (1, 7, '2019-11-20', 9.6334),
-    (1, 7, '2019-11-21', 9.6344),
-    (1, 7, '2019-11-22', 9.6235),
-    (1, 7, '2019-11-25', 9.6468),
-    (1, 7, '2019-11-26', 9.5976),
-    (1, 7, '2019-11-27', 9.5794),
-    (1, 7, '2019-11-28', NULL),
-    (1, 7, '2019-11-29', 9.57),
-    (1, 7, '2019-12-02', 9.5384),
-    (1, 7, '2019-12-03', 9.5254),
-    (1, 7, '2019-12-04', 9.5217),
-    (1, 7, '2019-12-05', 9.4811),
-    (1, 7, '2019-12-06', 9.4929),
-    (1, 7, '2019-12-09', 9.5292),
-    (1, 7, '2019-12-10', 9.4971),
-    (1, 7, '2019-12-11', 9.4401),
-    (1, 7, '2019-12-12', 9.406),
-    (1, 7, '2019-12-13', 9.4002),
-    (1, 7, '2019-12-16', 9.3699),
-    (1, 7, '2019-12-17', 9.3754),
-    (1, 7, '2019-12-18', 9.4203),
-    (1, 7, '2019-12-19', 9.4211),
-    (1, 7, '2019-12-20', 9.4111),
-    (1, 7, '2019-12-23', 9.4295),
-    (1, 7, '2019-12-24', 9.4133),
-    (1, 7, '2019-12-25', NULL),
-    (1, 7, '2019-12-26', 9.4108),
-    (1, 7, '2019-12-27', 9.3405),
-    (1, 7, '2019-12-30', 9.3145),
-    (1, 7, '2019-12-31', 9.3425),
-    (1, 7, '2020-01-01', NULL),
-    (1, 7, '2020-01-02', 9.3689),
-    (1, 7, '2020-01-03', 9.3844),
-    (1, 7, '2020-01-06', 9.4132),
-    (1, 7, '2020-01-07', 9.4504),
-    (1, 7, '2020-01-08', 9.4514),
-    (1, 7, '2020-01-09', 9.4877),
-    (1, 7, '2020-01-10', 9.5037),
-    (1, 7, '2020-01-13', 9.4679),
-    (1, 7, '2020-01-14', 9.4548),
-    (1, 7, '2020-01-15', 9.4441),
-    (1, 7, '2020-01-16', 9.4876),
-    (1, 7, '2020-01-17', 9.5141),
-    (1, 7, '2020-01-20', NULL),
-    (1, 7, '2020-01-21', 9.5099),
-    (1, 7, '2020-01-22', 9.5151),
-    (1, 7, '2020-01-23', 9.5506),
-    (1, 7, '2020-01-24', 9.565),
-    (1, 7, '2020-01-27', 9.6207),
-    (1, 7, '2020-01-28', 9.6213),
-    (1, 7, '2020-01-29', 9.6224),
-    (1, 7, '2020-01-30', 9.6387),
-    (1, 7, '2020-01-31', 9.6353),
-    (1, 7, '2020-02-03', 9.6541),
-    (1, 7, '2020-02-04', 9.5939),
-    (1, 7, '2020-02-05', 9.5951),
-    (1, 7, '2020-02-06', 9.6282),
-    (1, 7, '2020-02-07', 9.6493),
-    (1, 7, '2020-02-10', 9.6648),
-    (1, 7, '2020-02-11', 9.6335),
-    (1, 7, '2020-02-12', 9.6235),
-    (1, 7, '2020-02-13', 9.6758),
-    (1, 7, '2020-02-14', 9.7176),
-    (1, 7, '2020-02-17', NULL),
-    (1, 7, '2020-02-18', 9.7715),
-    (1, 7, '2020-02-19', 9.8041),
-    (1, 7, '2020-02-20', 9.8168),
-    (1, 7, '2020-02-21', 9.7269),
-    (1, 7, '2020-02-24', 9.7277),
-    (1, 7, '2020-02-25', 9.7283),
-    (1, 7, '2020-02-26', 9.7263),
-    (1, 7, '2020-02-27', 9.6568),
-    (1, 7, '2020-02-28', 9.6802),
-    (1, 7, '2020-03-02', 9.4936),
-    (1, 7, '2020-03-03', 9.4301),
-    (1, 7, '2020-03-04', 9.5),
-    (1, 7, '2020-03-05', 9.4508),
-    (1, 7, '2020-03-06', 9.3651),
-    (1, 7, '2020-03-09', 9.4147),
-    (1, 7, '2020-03-10', 9.5002),
-    (1, 7, '2020-03-11', 9.4951),
-    (1, 7, '2020-03-12', 9.8319),
-    (1, 7, '2020-03-13', 9.8113),
-    (1, 7, '2020-03-16', 9.8405),
-    (1, 7, '2020-03-17', 9.9453),
-    (1, 7, '2020-03-18', 10.2426),
-    (1, 7, '2020-03-19', 10.3827),
-    (1, 7, '2020-03-20', 10.3349),
-    (1, 7, '2020-03-23', 10.3085),
-    (1, 7, '2020-03-24', 10.1627),
-    (1, 7, '2020-03-25', 10.1783),
-    (1, 7, '2020-03-26', 9.9501),
-    (1, 7, '2020-03-27', 9.9481),
-    (1, 7, '2020-03-30', 10.0596),
-    (1, 7, '2020-03-31', 9.9145),
-    (1, 7, '2020-04-01', 10.0468),
-    (1, 7, '2020-04-02', 10.1075),
-    (1, 7, '2020-04-03', 10.1865),
-    (1, 7, '2020-04-06', 10.1237),
-    (1, 7, '2020-04-07', 10.0318),
-    (1, 7, '2020-04-08', 10.057),
-    (1, 7, '2020-04-09', 9.9551),
-    (1, 7, '2020-04-10', 9.9204),
-    (1, 7, '2020-04-13', 9.9931),
-    (1, 7, '2020-04-14', 9.9606),
-    (1, 7, '2020-04-15', 10.0391),
-    (1, 7, '2020-04-16', 10.0384),
-    (1, 7, '2020-04-17', 9.9801),
-    (1, 7, '2020-04-20', 10.0102),
-    (1, 7, '2020-04-21', 10.105),
-    (1, 7, '2020-04-22', 10.0949),
-    (1, 7, '2020-04-23', 10.0467),
-    (1, 7, '2020-04-24', 10.0732),
-    (1, 7, '2020-04-27', 10.0473),
-    (1, 7, '2020-04-28', 9.8868),
-    (1, 7, '2020-04-29', 9.8824),
-    (1, 7, '2020-04-30', 9.7751),
-    (1, 7, '2020-05-01', 9.8316),
-    (1, 7, '2020-05-04', 9.8856),
-    (1, 7, '2020-05-05', 9.8296),
-    (1, 7, '2020-05-06', 9.8486),
-    (1, 7, '2020-05-07', 9.8128),
-    (1, 7, '2020-05-08', 9.759),
-    (1, 7, '2020-05-11', 9.8649),
-    (1, 7, '2020-05-12', 9.7394),
-    (1, 7, '2020-05-13', 9.7939),
-    (1, 7, '2020-05-14', 9.8438),
-    (1, 7, '2020-05-15', 9.866),
-    (1, 7, '2020-05-18', 9.7192),
-    (1, 7, '2020-05-19', 9.6594),
-    (1, 7, '2020-05-20', 9.5831),
-    (1, 7, '2020-05-21', 9.6318),
-    (1, 7, '2020-05-22', 9.6838),
-    (1, 7, '2020-05-25', NULL),
-    (1, 7, '2020-05-26', 9.6426),
-    (1, 7, '2020-05-27', 9.6477),
-    (1, 7, '2020-05-28', 9.504),
-    (1, 7, '2020-05-29', 9.4248),
-    (1, 7, '2020-06-01', 9.386),
-    (1, 7, '2020-06-02', 9.3538),
-    (1, 7, '2020-06-03', 9.26),
-    (1, 7, '2020-06-04', 9.196),
-    (1, 7, '2020-06-05', 9.1806),
-    (1, 7, '2020-06-08', 9.2138),
-    (1, 7, '2020-06-09', 9.1774),
-    (1, 7, '2020-06-10', 9.2273),
-    (1, 7, '2020-06-11', 9.2524),
-    (1, 7, '2020-06-12', 9.3322),
-    (1, 7, '2020-06-15', 9.3404),
-    (1, 7, '2020-06-16', 9.3804),
-    (1, 7, '2020-06-17', 9.3589),
-    (1, 7, '2020-06-18', 9.4211),
-    (1, 7, '2020-06-19', 9.4542),
-    (1, 7, '2020-06-22', 9.3703),
-    (1, 7, '2020-06-23', 9.2806),
-    (1, 7, '2020-06-24', 9.3315),
-    (1, 7, '2020-06-25', 9.3234),
-    (1, 7, '2020-06-26', 9.3582),
-    (1, 7, '2020-06-29', 9.3254),
-    (1, 7, '2020-06-30', 9.3071),
-    (1, 7, '2020-07-01', 9.29),
-    (1, 7, '2020-07-02', 9.3226),
-    (1, 7, '2020-07-03', NULL),
-    (1, 7, '2020-07-06', 9.2691),
-    (1, 7, '2020-07-07', 9.2255),
-    (1, 7, '2020-07-08', 9.1916),
-    (1, 7, '2020-07-09', 9.2164),
-    (1, 7, '2020-07-10', 9.1764),
-    (1, 7, '2020-07-13', 9.1381),
-    (1, 7, '2020-07-14', 9.0986),
-    (1, 7, '2020-07-15', 9.0732),
-    (1, 7, '2020-07-16', 9.0348),
-    (1, 7, '2020-07-17', 9.0241),
-    (1, 7, '2020-07-20', 8.9752),
-    (1, 7, '2020-07-21', 8.9005),
-    (1, 7, '2020-07-22', 8.8498),
-    (1, 7, '2020-07-23', 8.8326),
-    (1, 7, '2020-07-24', 8.8533),
-    (1, 7, '2020-07-27', 8.7379),
-    (1, 7, '2020-07-28', 8.7536),
-    (1, 7, '2020-07-29', 8.7534),
-    (1, 7, '2020-07-30', 8.7473),
-    (1, 7, '2020-07-31', 8.7487),
-    (1, 7, '2020-08-03', 8.7591),
-    (1, 7, '2020-08-04', 8.7452),
-    (1, 7, '2020-08-05', 8.6532),
-    (1, 7, '2020-08-06', 8.6973),
-    (1, 7, '2020-08-07', 8.7518),
-    (1, 7, '2020-08-10', 8.7419),
-    (1, 7, '2020-08-11', 8.7392),
-    (1, 7, '2020-08-12', 8.6888),
-    (1, 7, '2020-08-13', 8.6839),
-    (1, 7, '2020-08-14', 8.6984),
-    (1, 7, '2020-08-17', 8.6918),
-    (1, 7, '2020-08-18', 8.6481),
-    (1, 7, '2020-08-19', 8.6574),
-    (1, 7, '2020-08-20', 8.706),
-    (1, 7, '2020-08-21', 8.7988),
-    (1, 7, '2020-08-24', 8.7819),
-    (1, 7, '2020-08-25', 8.7721),
-    (1, 7, '2020-08-26', 8.7389),
-    (1, 7, '2020-08-27', 8.7099),
-    (1, 7, '2020-08-28', 8.627),
-    (1, 7, '2020-08-31', 8.6249),
-    (1, 7, '2020-09-01', 8.6717),
-    (1, 7, '2020-09-02', 8.731),
-    (1, 7, '2020-09-03', 8.7494),
-    (1, 7, '2020-09-04', 8.7642),
-    (1, 7, '2020-09-07', NULL),
-    (1, 7, '2020-09-08', 8.8122),
-    (1, 7, '2020-09-09', 8.7628),
-    (1, 7, '2020-09-10', 8.7364),
-    (1, 7, '2020-09-11', 8.7848),
-    (1, 7, '2020-09-14', 8.7657),
-    (1, 7, '2020-09-15', 8.7882),
-    (1, 7, '2020-09-16', 8.7894),
-    (1, 7, '2020-09-17', 8.807),
-    (1, 7, '2020-09-18', 8.7539),
-    (1, 7, '2020-09-21', 8.8829),
-    (1, 7, '2020-09-22', 8.9081),
-    (1, 7, '2020-09-23', 8.981),
-    (1, 7, '2020-09-24', 9.1146),
-    (1, 7, '2020-09-25', 9.1347),
-    (1, 7, '2020-09-28', 9.0615),
-    (1, 7, '2020-09-29', 9.0009),
-    (1, 7, '2020-09-30', 8.9627),
-    (1, 7, '2020-10-01', 8.9197),
-    (1, 7, '2020-10-02', 8.9317),
-    (1, 7, '2020-10-05', 8.8936),
-    (1, 7, '2020-10-06', 8.9171),
-    (1, 7, '2020-10-07', 8.8934),
-    (1, 7, '2020-10-08', 8.872),
-    (1, 7, '2020-10-09', 8.7918),
-    (1, 7, '2020-10-12', NULL),
-    (1, 7, '2020-10-13', 8.8146),
-    (1, 7, '2020-10-14', 8.823),
-    (1, 7, '2020-10-15', 8.8685),
-    (1, 7, '2020-10-16', 8.837),
-    (1, 7, '2020-10-19', 8.8054),
-    (1, 7, '2020-10-20', 8.747),
-    (1, 7, '2020-10-21', 8.7195),
-    (1, 7, '2020-10-22', 8.7794),
-    (1, 7, '2020-10-23', 8.7688),
-    (1, 7, '2020-10-26', 8.7517),
-    (1, 7, '2020-10-27', 8.7022),
-    (1, 7, '2020-10-28', 8.8458),
-    (1, 7, '2020-10-29', 8.9427),
-    (1, 7, '2020-10-30', 8.9009),
-    (1, 7, '2020-11-02', 8.9179),
-    (1, 7, '2020-11-03', 8.8377),
-    (1, 7, '2020-11-04', 8.7718),
-    (1, 7, '2020-11-05', 8.7167),
-    (1, 7, '2020-11-06', 8.6339),
-    (1, 7, '2020-11-09', 8.6335),
-    (1, 7, '2020-11-10', 8.6152),
-    (1, 7, '2020-11-11', NULL),
-    (1, 7, '2020-11-12', 8.6229),
-    (1, 7, '2020-11-13', 8.6947),
-    (1, 7, '2020-11-16', 8.6326),
-    (1, 7, '2020-11-17', 8.6281),
-    (1, 7, '2020-11-18', 8.5813),
-    (1, 7, '2020-11-19', 8.6288),
-    (1, 7, '2020-11-20', 8.6171),
-    (1, 7, '2020-11-23', 8.6496),
-    (1, 7, '2020-11-24', 8.5678),
-    (1, 7, '2020-11-25', 8.5169),
-    (1, 7, '2020-11-26', NULL),
-    (1, 7, '2020-11-27', NULL),
-    (1, 7, '2020-11-30', 8.5576),
-    (1, 7, '2020-12-01', 8.5082),
-    (1, 7, '2020-12-02', 8.4986),
-    (1, 7, '2020-12-03', 8.4415),
-    (1, 7, '2020-12-04', 8.4494),
-    (1, 7, '2020-12-07', 8.3864),
-    (1, 7, '2020-12-08', 8.472),
-    (1, 7, '2020-12-09', 8.4914),
-    (1, 7, '2020-12-10', 8.4499),
-    (1, 7, '2020-12-11', 8.4717),
-    (1, 7, '2020-12-14', 8.4133),
-    (1, 7, '2020-12-15', 8.3759),
-    (1, 7, '2020-12-16', 8.3645),
-    (1, 7, '2020-12-17', 8.2689),
-    (1, 7, '2020-12-18', 8.2786),
-    (1, 7, '2020-12-21', 8.2576),
-    (1, 7, '2020-12-22', 8.3303),
-    (1, 7, '2020-12-23', 8.2841),
-    (1, 7, '2020-12-24', NULL),
-    (1, 7, '2020-12-25', NULL),
-    (1, 7, '2020-12-28', 8.2854),
-    (1, 7, '2020-12-29', 8.1906),
-    (1, 7, '2020-12-30', 8.1972),
-    (1, 7, '2020-12-31', 8.2095),
-    (1, 7, '2021-01-01', NULL),
-    (1, 7, '2021-01-04', 8.2486),
-    (1, 7, '2021-01-05', 8.1856),
-    (1, 7, '2021-01-06', 8.1855),
-    (1, 7, '2021-01-07', 8.1992),
-    (1, 7, '2021-01-08', 8.2085),
-    (1, 7, '2021-01-11', 8.281),
-    (1, 7, '2021-01-12', 8.2864),
-    (1, 7, '2021-01-13', 8.3373),
-    (1, 7, '2021-01-14', 8.309),
-    (1, 7, '2021-01-15', 8.3715),
-    (1, 7, '2021-01-18', NULL),
-    (1, 7, '2021-01-19', 8.348),
-    (1, 7, '2021-01-20', NULL),
-    (1, 7, '2021-01-21', 8.2948),
-    (1, 7, '2021-01-22', 8.282),
-    (1, 7, '2021-01-25', 8.3046),
-    (1, 7, '2021-01-26', 8.3025),
-    (1, 7, '2021-01-27', 8.3511),
-    (1, 7, '2021-01-28', 8.3301),
-    (1, 7, '2021-01-29', 8.3357);
\ No newline at end of file
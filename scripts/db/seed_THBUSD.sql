@@ -1,1316 +0,0 @@
--- THB 8
--- USD 1
-INSERT INTO
-    exchange_rates (
-        destination_currency_id,
-        source_currency_id,
-        date,
-        rate
-    )
-VALUES
-    (1, 8, '2016-01-29', 35.6700),
-    (1, 8, '2016-02-01', 35.5800),
-    (1, 8, '2016-02-02', 35.7500),
-    (1, 8, '2016-02-03', 35.7700),
-    (1, 8, '2016-02-04', 35.5500),
-    (1, 8, '2016-02-05', 35.5600),
-    (1, 8, '2016-02-08', 35.5100),
-    (1, 8, '2016-02-09', 35.3900),
-    (1, 8, '2016-02-10', 35.3100),
-    (1, 8, '2016-02-11', 35.2200),
-    (1, 8, '2016-02-12', 35.5900),
-    (1, 8, '2016-02-15', NULL),
-    (1, 8, '2016-02-16', 35.6500),
-    (1, 8, '2016-02-17', 35.6000),
-    (1, 8, '2016-02-18', 35.5800),
-    (1, 8, '2016-02-19', 35.7300),
-    (1, 8, '2016-02-22', 35.7300),
-    (1, 8, '2016-02-23', 35.7100),
-    (1, 8, '2016-02-24', 35.6800),
-    (1, 8, '2016-02-25', 35.6600),
-    (1, 8, '2016-02-26', 35.7300),
-    (1, 8, '2016-02-29', 35.6100),
-    (1, 8, '2016-03-01', 35.5900),
-    (1, 8, '2016-03-02', 35.5400),
-    (1, 8, '2016-03-03', 35.4300),
-    (1, 8, '2016-03-04', 35.3400),
-    (1, 8, '2016-03-07', 35.4100),
-    (1, 8, '2016-03-08', 35.3600),
-    (1, 8, '2016-03-09', 35.2600),
-    (1, 8, '2016-03-10', 35.2200),
-    (1, 8, '2016-03-11', 35.0500),
-    (1, 8, '2016-03-14', 35.1000),
-    (1, 8, '2016-03-15', 35.1100),
-    (1, 8, '2016-03-16', 35.0500),
-    (1, 8, '2016-03-17', 34.7600),
-    (1, 8, '2016-03-18', 34.8800),
-    (1, 8, '2016-03-21', 34.9000),
-    (1, 8, '2016-03-22', 34.9500),
-    (1, 8, '2016-03-23', 35.2600),
-    (1, 8, '2016-03-24', 35.3200),
-    (1, 8, '2016-03-25', 35.2800),
-    (1, 8, '2016-03-28', 35.3400),
-    (1, 8, '2016-03-29', 35.3900),
-    (1, 8, '2016-03-30', 35.2400),
-    (1, 8, '2016-03-31', 35.0900),
-    (1, 8, '2016-04-01', 35.1800),
-    (1, 8, '2016-04-04', 35.2300),
-    (1, 8, '2016-04-05', 35.2800),
-    (1, 8, '2016-04-06', 35.2200),
-    (1, 8, '2016-04-07', 35.1800),
-    (1, 8, '2016-04-08', 35.1000),
-    (1, 8, '2016-04-11', 35.0400),
-    (1, 8, '2016-04-12', 34.9700),
-    (1, 8, '2016-04-13', 35.0300),
-    (1, 8, '2016-04-14', 35.0900),
-    (1, 8, '2016-04-15', 35.0100),
-    (1, 8, '2016-04-18', 34.9800),
-    (1, 8, '2016-04-19', 34.8300),
-    (1, 8, '2016-04-20', 34.8400),
-    (1, 8, '2016-04-21', 35.0600),
-    (1, 8, '2016-04-22', 35.0600),
-    (1, 8, '2016-04-25', 35.0700),
-    (1, 8, '2016-04-26', 35.1200),
-    (1, 8, '2016-04-27', 35.1200),
-    (1, 8, '2016-04-28', 35.0000),
-    (1, 8, '2016-04-29', 34.9100),
-    (1, 8, '2016-05-02', 34.8400),
-    (1, 8, '2016-05-03', 34.9400),
-    (1, 8, '2016-05-04', 35.1100),
-    (1, 8, '2016-05-05', 35.1700),
-    (1, 8, '2016-05-06', 35.1300),
-    (1, 8, '2016-05-09', 35.2000),
-    (1, 8, '2016-05-10', 35.2100),
-    (1, 8, '2016-05-11', 35.2100),
-    (1, 8, '2016-05-12', 35.3200),
-    (1, 8, '2016-05-13', 35.4700),
-    (1, 8, '2016-05-16', 35.3900),
-    (1, 8, '2016-05-17', 35.5000),
-    (1, 8, '2016-05-18', 35.6000),
-    (1, 8, '2016-05-19', 35.7200),
-    (1, 8, '2016-05-20', 35.7100),
-    (1, 8, '2016-05-23', 35.6800),
-    (1, 8, '2016-05-24', 35.7300),
-    (1, 8, '2016-05-25', 35.6800),
-    (1, 8, '2016-05-26', 35.5900),
-    (1, 8, '2016-05-27', 35.6800),
-    (1, 8, '2016-05-30', NULL),
-    (1, 8, '2016-05-31', 35.6800),
-    (1, 8, '2016-06-01', 35.7000),
-    (1, 8, '2016-06-02', 35.6200),
-    (1, 8, '2016-06-03', 35.3400),
-    (1, 8, '2016-06-06', 35.2700),
-    (1, 8, '2016-06-07', 35.2100),
-    (1, 8, '2016-06-08', 35.1400),
-    (1, 8, '2016-06-09', 35.1400),
-    (1, 8, '2016-06-10', 35.1800),
-    (1, 8, '2016-06-13', 35.1700),
-    (1, 8, '2016-06-14', 35.3000),
-    (1, 8, '2016-06-15', 35.2700),
-    (1, 8, '2016-06-16', 35.3200),
-    (1, 8, '2016-06-17', 35.2300),
-    (1, 8, '2016-06-20', 35.1900),
-    (1, 8, '2016-06-21', 35.2500),
-    (1, 8, '2016-06-22', 35.1600),
-    (1, 8, '2016-06-23', 35.1500),
-    (1, 8, '2016-06-24', 35.3200),
-    (1, 8, '2016-06-27', 35.3400),
-    (1, 8, '2016-06-28', 35.2600),
-    (1, 8, '2016-06-29', 35.1600),
-    (1, 8, '2016-06-30', 35.1500),
-    (1, 8, '2016-07-01', 35.0300),
-    (1, 8, '2016-07-04', NULL),
-    (1, 8, '2016-07-05', 35.1600),
-    (1, 8, '2016-07-06', 35.2200),
-    (1, 8, '2016-07-07', 35.2100),
-    (1, 8, '2016-07-08', 35.2000),
-    (1, 8, '2016-07-11', 35.1500),
-    (1, 8, '2016-07-12', 35.2200),
-    (1, 8, '2016-07-13', 35.2000),
-    (1, 8, '2016-07-14', 35.0100),
-    (1, 8, '2016-07-15', 34.9400),
-    (1, 8, '2016-07-18', 34.9400),
-    (1, 8, '2016-07-19', 35.0200),
-    (1, 8, '2016-07-20', 35.0400),
-    (1, 8, '2016-07-21', 34.9600),
-    (1, 8, '2016-07-22', 34.9400),
-    (1, 8, '2016-07-25', 35.0300),
-    (1, 8, '2016-07-26', 34.9800),
-    (1, 8, '2016-07-27', 34.9400),
-    (1, 8, '2016-07-28', 34.8500),
-    (1, 8, '2016-07-29', 34.7800),
-    (1, 8, '2016-08-01', 34.7500),
-    (1, 8, '2016-08-02', 34.7600),
-    (1, 8, '2016-08-03', 34.9600),
-    (1, 8, '2016-08-04', 34.8900),
-    (1, 8, '2016-08-05', 35.0400),
-    (1, 8, '2016-08-08', 35.0100),
-    (1, 8, '2016-08-09', 34.8900),
-    (1, 8, '2016-08-10', 34.7600),
-    (1, 8, '2016-08-11', 34.7000),
-    (1, 8, '2016-08-12', 34.7400),
-    (1, 8, '2016-08-15', 34.6000),
-    (1, 8, '2016-08-16', 34.6200),
-    (1, 8, '2016-08-17', 34.6900),
-    (1, 8, '2016-08-18', 34.5500),
-    (1, 8, '2016-08-19', 34.6400),
-    (1, 8, '2016-08-22', 34.6600),
-    (1, 8, '2016-08-23', 34.5800),
-    (1, 8, '2016-08-24', 34.6400),
-    (1, 8, '2016-08-25', 34.5400),
-    (1, 8, '2016-08-26', 34.6100),
-    (1, 8, '2016-08-29', 34.5500),
-    (1, 8, '2016-08-30', 34.5800),
-    (1, 8, '2016-08-31', 34.6200),
-    (1, 8, '2016-09-01', 34.5900),
-    (1, 8, '2016-09-02', 34.5800),
-    (1, 8, '2016-09-05', NULL),
-    (1, 8, '2016-09-06', 34.5900),
-    (1, 8, '2016-09-07', 34.6300),
-    (1, 8, '2016-09-08', 34.7700),
-    (1, 8, '2016-09-09', 34.8300),
-    (1, 8, '2016-09-12', 34.8800),
-    (1, 8, '2016-09-13', 34.9300),
-    (1, 8, '2016-09-14', 34.8700),
-    (1, 8, '2016-09-15', 34.9000),
-    (1, 8, '2016-09-16', 34.9200),
-    (1, 8, '2016-09-19', 34.7700),
-    (1, 8, '2016-09-20', 34.7600),
-    (1, 8, '2016-09-21', 34.7000),
-    (1, 8, '2016-09-22', 34.5900),
-    (1, 8, '2016-09-23', 34.6300),
-    (1, 8, '2016-09-26', 34.6100),
-    (1, 8, '2016-09-27', 34.5900),
-    (1, 8, '2016-09-28', 34.6000),
-    (1, 8, '2016-09-29', 34.6400),
-    (1, 8, '2016-09-30', 34.6000),
-    (1, 8, '2016-10-03', 34.6500),
-    (1, 8, '2016-10-04', 34.7500),
-    (1, 8, '2016-10-05', 34.8400),
-    (1, 8, '2016-10-06', 34.8600),
-    (1, 8, '2016-10-07', 34.9200),
-    (1, 8, '2016-10-10', NULL),
-    (1, 8, '2016-10-11', 35.4300),
-    (1, 8, '2016-10-12', 35.6200),
-    (1, 8, '2016-10-13', 35.3900),
-    (1, 8, '2016-10-14', 35.3100),
-    (1, 8, '2016-10-17', 35.2600),
-    (1, 8, '2016-10-18', 35.0200),
-    (1, 8, '2016-10-19', 34.8700),
-    (1, 8, '2016-10-20', 34.9600),
-    (1, 8, '2016-10-21', 35.1400),
-    (1, 8, '2016-10-24', 35.0400),
-    (1, 8, '2016-10-25', 34.9000),
-    (1, 8, '2016-10-26', 34.9900),
-    (1, 8, '2016-10-27', 35.0900),
-    (1, 8, '2016-10-28', 35.0600),
-    (1, 8, '2016-10-31', 34.9900),
-    (1, 8, '2016-11-01', 35.0100),
-    (1, 8, '2016-11-02', 34.9500),
-    (1, 8, '2016-11-03', 34.9500),
-    (1, 8, '2016-11-04', 35.0100),
-    (1, 8, '2016-11-07', 35.0100),
-    (1, 8, '2016-11-08', 34.9600),
-    (1, 8, '2016-11-09', 34.9400),
-    (1, 8, '2016-11-10', 35.1900),
-    (1, 8, '2016-11-11', NULL),
-    (1, 8, '2016-11-14', 35.5000),
-    (1, 8, '2016-11-15', 35.4400),
-    (1, 8, '2016-11-16', 35.4700),
-    (1, 8, '2016-11-17', 35.4500),
-    (1, 8, '2016-11-18', 35.5400),
-    (1, 8, '2016-11-21', 35.5200),
-    (1, 8, '2016-11-22', 35.4900),
-    (1, 8, '2016-11-23', 35.6900),
-    (1, 8, '2016-11-24', NULL),
-    (1, 8, '2016-11-25', 35.6100),
-    (1, 8, '2016-11-28', 35.6000),
-    (1, 8, '2016-11-29', 35.6600),
-    (1, 8, '2016-11-30', 35.7000),
-    (1, 8, '2016-12-01', 35.6700),
-    (1, 8, '2016-12-02', 35.6100),
-    (1, 8, '2016-12-05', 35.6500),
-    (1, 8, '2016-12-06', 35.6100),
-    (1, 8, '2016-12-07', 35.6100),
-    (1, 8, '2016-12-08', 35.6300),
-    (1, 8, '2016-12-09', 35.6800),
-    (1, 8, '2016-12-12', 35.5900),
-    (1, 8, '2016-12-13', 35.5800),
-    (1, 8, '2016-12-14', 35.5700),
-    (1, 8, '2016-12-15', 35.8000),
-    (1, 8, '2016-12-16', 35.8100),
-    (1, 8, '2016-12-19', 35.8500),
-    (1, 8, '2016-12-20', 36.0000),
-    (1, 8, '2016-12-21', 36.0000),
-    (1, 8, '2016-12-22', 36.0100),
-    (1, 8, '2016-12-23', 35.9600),
-    (1, 8, '2016-12-26', NULL),
-    (1, 8, '2016-12-27', 36.0000),
-    (1, 8, '2016-12-28', 36.0000),
-    (1, 8, '2016-12-29', 35.8900),
-    (1, 8, '2016-12-30', 35.8100),
-    (1, 8, '2017-01-02', NULL),
-    (1, 8, '2017-01-03', 35.8900),
-    (1, 8, '2017-01-04', 35.8200),
-    (1, 8, '2017-01-05', 35.6700),
-    (1, 8, '2017-01-06', 35.7200),
-    (1, 8, '2017-01-09', 35.6400),
-    (1, 8, '2017-01-10', 35.5300),
-    (1, 8, '2017-01-11', 35.5500),
-    (1, 8, '2017-01-12', 35.2900),
-    (1, 8, '2017-01-13', 35.4200),
-    (1, 8, '2017-01-16', NULL),
-    (1, 8, '2017-01-17', 35.3000),
-    (1, 8, '2017-01-18', 35.3000),
-    (1, 8, '2017-01-19', 35.4200),
-    (1, 8, '2017-01-20', NULL),
-    (1, 8, '2017-01-23', 35.2600),
-    (1, 8, '2017-01-24', 35.2200),
-    (1, 8, '2017-01-25', 35.2200),
-    (1, 8, '2017-01-26', 35.3200),
-    (1, 8, '2017-01-27', 35.2800),
-    (1, 8, '2017-01-30', 35.2100),
-    (1, 8, '2017-01-31', 35.0900),
-    (1, 8, '2017-02-01', 35.1200),
-    (1, 8, '2017-02-02', 35.0500),
-    (1, 8, '2017-02-03', 35.0100),
-    (1, 8, '2017-02-06', 35.0100),
-    (1, 8, '2017-02-07', 35.0200),
-    (1, 8, '2017-02-08', 34.9900),
-    (1, 8, '2017-02-09', 35.0600),
-    (1, 8, '2017-02-10', 35.0600),
-    (1, 8, '2017-02-13', 35.0900),
-    (1, 8, '2017-02-14', 35.0500),
-    (1, 8, '2017-02-15', 35.0100),
-    (1, 8, '2017-02-16', 34.9800),
-    (1, 8, '2017-02-17', 34.9800),
-    (1, 8, '2017-02-20', NULL),
-    (1, 8, '2017-02-21', 35.0300),
-    (1, 8, '2017-02-22', 35.0000),
-    (1, 8, '2017-02-23', 34.9600),
-    (1, 8, '2017-02-24', 34.8500),
-    (1, 8, '2017-02-27', 34.8200),
-    (1, 8, '2017-02-28', 34.8900),
-    (1, 8, '2017-03-01', 34.9600),
-    (1, 8, '2017-03-02', 35.0300),
-    (1, 8, '2017-03-03', 35.0700),
-    (1, 8, '2017-03-06', 35.0600),
-    (1, 8, '2017-03-07', 35.0900),
-    (1, 8, '2017-03-08', 35.3100),
-    (1, 8, '2017-03-09', 35.3700),
-    (1, 8, '2017-03-10', 35.3500),
-    (1, 8, '2017-03-13', 35.3500),
-    (1, 8, '2017-03-14', 35.2900),
-    (1, 8, '2017-03-15', 35.2500),
-    (1, 8, '2017-03-16', 35.2900),
-    (1, 8, '2017-03-17', 34.8600),
-    (1, 8, '2017-03-20', 34.6100),
-    (1, 8, '2017-03-21', 34.6600),
-    (1, 8, '2017-03-22', 34.6000),
-    (1, 8, '2017-03-23', 34.5800),
-    (1, 8, '2017-03-24', 34.5500),
-    (1, 8, '2017-03-27', 34.3500),
-    (1, 8, '2017-03-28', 34.3600),
-    (1, 8, '2017-03-29', 34.4200),
-    (1, 8, '2017-03-30', 34.4000),
-    (1, 8, '2017-03-31', 34.3200),
-    (1, 8, '2017-04-03', 34.3500),
-    (1, 8, '2017-04-04', 34.4500),
-    (1, 8, '2017-04-05', 34.5500),
-    (1, 8, '2017-04-06', 34.6400),
-    (1, 8, '2017-04-07', 34.5700),
-    (1, 8, '2017-04-10', 34.6100),
-    (1, 8, '2017-04-11', 34.5500),
-    (1, 8, '2017-04-12', 34.4500),
-    (1, 8, '2017-04-13', 34.3100),
-    (1, 8, '2017-04-14', 34.3400),
-    (1, 8, '2017-04-17', 34.2500),
-    (1, 8, '2017-04-18', 34.3400),
-    (1, 8, '2017-04-19', 34.3900),
-    (1, 8, '2017-04-20', 34.3700),
-    (1, 8, '2017-04-21', 34.3700),
-    (1, 8, '2017-04-24', 34.3700),
-    (1, 8, '2017-04-25', 34.4000),
-    (1, 8, '2017-04-26', 34.4800),
-    (1, 8, '2017-04-27', 34.6200),
-    (1, 8, '2017-04-28', 34.5800),
-    (1, 8, '2017-05-01', 34.5800),
-    (1, 8, '2017-05-02', 34.4700),
-    (1, 8, '2017-05-03', 34.5100),
-    (1, 8, '2017-05-04', 34.6300),
-    (1, 8, '2017-05-05', 34.6700),
-    (1, 8, '2017-05-08', 34.6200),
-    (1, 8, '2017-05-09', 34.7700),
-    (1, 8, '2017-05-10', 34.7300),
-    (1, 8, '2017-05-11', 34.7200),
-    (1, 8, '2017-05-12', 34.6500),
-    (1, 8, '2017-05-15', 34.5300),
-    (1, 8, '2017-05-16', 34.4700),
-    (1, 8, '2017-05-17', 34.4700),
-    (1, 8, '2017-05-18', 34.5000),
-    (1, 8, '2017-05-19', 34.3300),
-    (1, 8, '2017-05-22', 34.3200),
-    (1, 8, '2017-05-23', 34.4200),
-    (1, 8, '2017-05-24', 34.3800),
-    (1, 8, '2017-05-25', 34.1100),
-    (1, 8, '2017-05-26', 34.0400),
-    (1, 8, '2017-05-29', NULL),
-    (1, 8, '2017-05-30', 34.1100),
-    (1, 8, '2017-05-31', 34.0300),
-    (1, 8, '2017-06-01', 34.1600),
-    (1, 8, '2017-06-02', 34.1100),
-    (1, 8, '2017-06-05', 34.0500),
-    (1, 8, '2017-06-06', 33.9600),
-    (1, 8, '2017-06-07', 34.0200),
-    (1, 8, '2017-06-08', 34.0600),
-    (1, 8, '2017-06-09', 34.0500),
-    (1, 8, '2017-06-12', 34.0600),
-    (1, 8, '2017-06-13', 33.9200),
-    (1, 8, '2017-06-14', 33.8300),
-    (1, 8, '2017-06-15', 33.9500),
-    (1, 8, '2017-06-16', 33.9500),
-    (1, 8, '2017-06-19', 33.9400),
-    (1, 8, '2017-06-20', 34.0100),
-    (1, 8, '2017-06-21', 33.9900),
-    (1, 8, '2017-06-22', 33.9900),
-    (1, 8, '2017-06-23', 33.9500),
-    (1, 8, '2017-06-26', 33.9700),
-    (1, 8, '2017-06-27', 33.9400),
-    (1, 8, '2017-06-28', 33.9500),
-    (1, 8, '2017-06-29', 33.9800),
-    (1, 8, '2017-06-30', 33.9200),
-    (1, 8, '2017-07-03', 33.9900),
-    (1, 8, '2017-07-04', NULL),
-    (1, 8, '2017-07-05', 34.0300),
-    (1, 8, '2017-07-06', 34.0700),
-    (1, 8, '2017-07-07', 34.0900),
-    (1, 8, '2017-07-10', 34.1300),
-    (1, 8, '2017-07-11', 34.1000),
-    (1, 8, '2017-07-12', 33.9400),
-    (1, 8, '2017-07-13', 33.9600),
-    (1, 8, '2017-07-14', 33.7600),
-    (1, 8, '2017-07-17', 33.6300),
-    (1, 8, '2017-07-18', 33.5700),
-    (1, 8, '2017-07-19', 33.6000),
-    (1, 8, '2017-07-20', 33.5700),
-    (1, 8, '2017-07-21', 33.4500),
-    (1, 8, '2017-07-24', 33.3900),
-    (1, 8, '2017-07-25', 33.4400),
-    (1, 8, '2017-07-26', 33.4800),
-    (1, 8, '2017-07-27', 33.3300),
-    (1, 8, '2017-07-28', 33.3700),
-    (1, 8, '2017-07-31', 33.2800),
-    (1, 8, '2017-08-01', 33.2800),
-    (1, 8, '2017-08-02', 33.2500),
-    (1, 8, '2017-08-03', 33.2500),
-    (1, 8, '2017-08-04', 33.2900),
-    (1, 8, '2017-08-07', 33.2900),
-    (1, 8, '2017-08-08', 33.2800),
-    (1, 8, '2017-08-09', 33.2300),
-    (1, 8, '2017-08-10', 33.2500),
-    (1, 8, '2017-08-11', 33.2200),
-    (1, 8, '2017-08-14', 33.2400),
-    (1, 8, '2017-08-15', 33.2800),
-    (1, 8, '2017-08-16', 33.2700),
-    (1, 8, '2017-08-17', 33.2400),
-    (1, 8, '2017-08-18', 33.2000),
-    (1, 8, '2017-08-21', 33.2400),
-    (1, 8, '2017-08-22', 33.2400),
-    (1, 8, '2017-08-23', 33.3400),
-    (1, 8, '2017-08-24', 33.3400),
-    (1, 8, '2017-08-25', 33.2500),
-    (1, 8, '2017-08-28', 33.1800),
-    (1, 8, '2017-08-29', 33.1700),
-    (1, 8, '2017-08-30', 33.1800),
-    (1, 8, '2017-08-31', 33.1700),
-    (1, 8, '2017-09-01', 33.1500),
-    (1, 8, '2017-09-04', NULL),
-    (1, 8, '2017-09-05', 33.1500),
-    (1, 8, '2017-09-06', 33.1400),
-    (1, 8, '2017-09-07', 33.0700),
-    (1, 8, '2017-09-08', 33.1100),
-    (1, 8, '2017-09-11', 33.1100),
-    (1, 8, '2017-09-12', 33.1100),
-    (1, 8, '2017-09-13', 33.1200),
-    (1, 8, '2017-09-14', 33.0700),
-    (1, 8, '2017-09-15', 33.0700),
-    (1, 8, '2017-09-18', 33.1000),
-    (1, 8, '2017-09-19', 33.0600),
-    (1, 8, '2017-09-20', 33.0500),
-    (1, 8, '2017-09-21', 33.1000),
-    (1, 8, '2017-09-22', 33.0600),
-    (1, 8, '2017-09-25', 33.1000),
-    (1, 8, '2017-09-26', 33.2100),
-    (1, 8, '2017-09-27', 33.2900),
-    (1, 8, '2017-09-28', 33.4000),
-    (1, 8, '2017-09-29', 33.3000),
-    (1, 8, '2017-10-02', 33.4000),
-    (1, 8, '2017-10-03', 33.3900),
-    (1, 8, '2017-10-04', 33.3600),
-    (1, 8, '2017-10-05', 33.3800),
-    (1, 8, '2017-10-06', 33.4300),
-    (1, 8, '2017-10-09', NULL),
-    (1, 8, '2017-10-10', 33.2200),
-    (1, 8, '2017-10-11', 33.1700),
-    (1, 8, '2017-10-12', 33.1000),
-    (1, 8, '2017-10-13', 33.0600),
-    (1, 8, '2017-10-16', 33.0400),
-    (1, 8, '2017-10-17', 33.1100),
-    (1, 8, '2017-10-18', 33.1400),
-    (1, 8, '2017-10-19', 33.1200),
-    (1, 8, '2017-10-20', 33.1900),
-    (1, 8, '2017-10-23', 33.1900),
-    (1, 8, '2017-10-24', 33.1700),
-    (1, 8, '2017-10-25', 33.1800),
-    (1, 8, '2017-10-26', 33.1900),
-    (1, 8, '2017-10-27', 33.2600),
-    (1, 8, '2017-10-30', 33.2600),
-    (1, 8, '2017-10-31', 33.2300),
-    (1, 8, '2017-11-01', 33.1100),
-    (1, 8, '2017-11-02', 33.0800),
-    (1, 8, '2017-11-03', 33.1700),
-    (1, 8, '2017-11-06', 33.1100),
-    (1, 8, '2017-11-07', 33.1200),
-    (1, 8, '2017-11-08', 33.1000),
-    (1, 8, '2017-11-09', 33.0900),
-    (1, 8, '2017-11-10', NULL),
-    (1, 8, '2017-11-13', 33.0600),
-    (1, 8, '2017-11-14', 33.0500),
-    (1, 8, '2017-11-15', 33.0000),
-    (1, 8, '2017-11-16', 32.9100),
-    (1, 8, '2017-11-17', 32.8100),
-    (1, 8, '2017-11-20', 32.8100),
-    (1, 8, '2017-11-21', 32.7400),
-    (1, 8, '2017-11-22', 32.6900),
-    (1, 8, '2017-11-23', NULL),
-    (1, 8, '2017-11-24', 32.6500),
-    (1, 8, '2017-11-27', 32.6100),
-    (1, 8, '2017-11-28', 32.5500),
-    (1, 8, '2017-11-29', 32.5700),
-    (1, 8, '2017-11-30', 32.5800),
-    (1, 8, '2017-12-01', 32.5900),
-    (1, 8, '2017-12-04', 32.5900),
-    (1, 8, '2017-12-05', 32.6100),
-    (1, 8, '2017-12-06', 32.6400),
-    (1, 8, '2017-12-07', 32.6000),
-    (1, 8, '2017-12-08', 32.6200),
-    (1, 8, '2017-12-11', 32.6200),
-    (1, 8, '2017-12-12', 32.6000),
-    (1, 8, '2017-12-13', 32.5300),
-    (1, 8, '2017-12-14', 32.4900),
-    (1, 8, '2017-12-15', 32.5000),
-    (1, 8, '2017-12-18', 32.6200),
-    (1, 8, '2017-12-19', 32.5000),
-    (1, 8, '2017-12-20', 32.7000),
-    (1, 8, '2017-12-21', 32.7200),
-    (1, 8, '2017-12-22', 32.7300),
-    (1, 8, '2017-12-25', NULL),
-    (1, 8, '2017-12-26', 32.7500),
-    (1, 8, '2017-12-27', 32.7700),
-    (1, 8, '2017-12-28', 32.5900),
-    (1, 8, '2017-12-29', 32.5600),
-    (1, 8, '2018-01-01', NULL),
-    (1, 8, '2018-01-02', 32.4400),
-    (1, 8, '2018-01-03', 32.2600),
-    (1, 8, '2018-01-04', 32.2100),
-    (1, 8, '2018-01-05', 32.1900),
-    (1, 8, '2018-01-08', 32.2100),
-    (1, 8, '2018-01-09', 32.2600),
-    (1, 8, '2018-01-10', 32.0900),
-    (1, 8, '2018-01-11', 31.9700),
-    (1, 8, '2018-01-12', 31.9500),
-    (1, 8, '2018-01-15', NULL),
-    (1, 8, '2018-01-16', 31.9500),
-    (1, 8, '2018-01-17', 31.9500),
-    (1, 8, '2018-01-18', 31.9100),
-    (1, 8, '2018-01-19', 31.8400),
-    (1, 8, '2018-01-22', 31.8400),
-    (1, 8, '2018-01-23', 31.7600),
-    (1, 8, '2018-01-24', 31.5400),
-    (1, 8, '2018-01-25', 31.3300),
-    (1, 8, '2018-01-26', 31.3600),
-    (1, 8, '2018-01-29', 31.4500),
-    (1, 8, '2018-01-30', 31.4300),
-    (1, 8, '2018-01-31', 31.3300),
-    (1, 8, '2018-02-01', 31.3100),
-    (1, 8, '2018-02-02', 31.4300),
-    (1, 8, '2018-02-05', 31.5200),
-    (1, 8, '2018-02-06', 31.5900),
-    (1, 8, '2018-02-07', 31.6100),
-    (1, 8, '2018-02-08', 31.8400),
-    (1, 8, '2018-02-09', 31.7900),
-    (1, 8, '2018-02-12', 31.6700),
-    (1, 8, '2018-02-13', 31.4800),
-    (1, 8, '2018-02-14', 31.3700),
-    (1, 8, '2018-02-15', 31.2700),
-    (1, 8, '2018-02-16', 31.2900),
-    (1, 8, '2018-02-19', NULL),
-    (1, 8, '2018-02-20', 31.5000),
-    (1, 8, '2018-02-21', 31.5000),
-    (1, 8, '2018-02-22', 31.4800),
-    (1, 8, '2018-02-23', 31.4200),
-    (1, 8, '2018-02-26', 31.31),
-    (1, 8, '2018-02-27', 31.47),
-    (1, 8, '2018-02-28', 31.46),
-    (1, 8, '2018-03-01', 31.54),
-    (1, 8, '2018-03-02', 31.45),
-    (1, 8, '2018-03-05', 31.4),
-    (1, 8, '2018-03-06', 31.27),
-    (1, 8, '2018-03-07', 31.33),
-    (1, 8, '2018-03-08', 31.37),
-    (1, 8, '2018-03-09', 31.31),
-    (1, 8, '2018-03-12', 31.3),
-    (1, 8, '2018-03-13', 31.2),
-    (1, 8, '2018-03-14', 31.12),
-    (1, 8, '2018-03-15', 31.18),
-    (1, 8, '2018-03-16', 31.18),
-    (1, 8, '2018-03-19', 31.18),
-    (1, 8, '2018-03-20', 31.19),
-    (1, 8, '2018-03-21', 31.18),
-    (1, 8, '2018-03-22', 31.28),
-    (1, 8, '2018-03-23', 31.17),
-    (1, 8, '2018-03-26', 31.12),
-    (1, 8, '2018-03-27', 31.18),
-    (1, 8, '2018-03-28', 31.26),
-    (1, 8, '2018-03-29', 31.24),
-    (1, 8, '2018-03-30', 31.19),
-    (1, 8, '2018-04-02', 31.21),
-    (1, 8, '2018-04-03', 31.21),
-    (1, 8, '2018-04-04', 31.23),
-    (1, 8, '2018-04-05', 31.26),
-    (1, 8, '2018-04-06', 31.28),
-    (1, 8, '2018-04-09', 31.26),
-    (1, 8, '2018-04-10', 31.18),
-    (1, 8, '2018-04-11', 31.11),
-    (1, 8, '2018-04-12', 31.19),
-    (1, 8, '2018-04-13', 31.16),
-    (1, 8, '2018-04-16', 31.19),
-    (1, 8, '2018-04-17', 31.19),
-    (1, 8, '2018-04-18', 31.19),
-    (1, 8, '2018-04-19', 31.23),
-    (1, 8, '2018-04-20', 31.32),
-    (1, 8, '2018-04-23', 31.54),
-    (1, 8, '2018-04-24', 31.45),
-    (1, 8, '2018-04-25', 31.57),
-    (1, 8, '2018-04-26', 31.62),
-    (1, 8, '2018-04-27', 31.52),
-    (1, 8, '2018-04-30', 31.57),
-    (1, 8, '2018-05-01', 31.67),
-    (1, 8, '2018-05-02', 31.74),
-    (1, 8, '2018-05-03', 31.67),
-    (1, 8, '2018-05-04', 31.72),
-    (1, 8, '2018-05-07', 31.84),
-    (1, 8, '2018-05-08', 31.95),
-    (1, 8, '2018-05-09', 32.07),
-    (1, 8, '2018-05-10', 31.98),
-    (1, 8, '2018-05-11', 31.88),
-    (1, 8, '2018-05-14', 31.83),
-    (1, 8, '2018-05-15', 32.12),
-    (1, 8, '2018-05-16', 32.06),
-    (1, 8, '2018-05-17', 32.11),
-    (1, 8, '2018-05-18', 32.18),
-    (1, 8, '2018-05-21', 32.17),
-    (1, 8, '2018-05-22', 31.98),
-    (1, 8, '2018-05-23', 32.15),
-    (1, 8, '2018-05-24', 32.01),
-    (1, 8, '2018-05-25', 31.9),
-    (1, 8, '2018-05-28', NULL),
-    (1, 8, '2018-05-29', 32.1),
-    (1, 8, '2018-05-30', 32.02),
-    (1, 8, '2018-05-31', 32.06),
-    (1, 8, '2018-06-01', 32.02),
-    (1, 8, '2018-06-04', 31.99),
-    (1, 8, '2018-06-05', 31.96),
-    (1, 8, '2018-06-06', 31.89),
-    (1, 8, '2018-06-07', 31.99),
-    (1, 8, '2018-06-08', 32.05),
-    (1, 8, '2018-06-11', 32.04),
-    (1, 8, '2018-06-12', 32.07),
-    (1, 8, '2018-06-13', 32.12),
-    (1, 8, '2018-06-14', 32.17),
-    (1, 8, '2018-06-15', 32.66),
-    (1, 8, '2018-06-18', 32.71),
-    (1, 8, '2018-06-19', 32.76),
-    (1, 8, '2018-06-20', 32.83),
-    (1, 8, '2018-06-21', 32.88),
-    (1, 8, '2018-06-22', 32.93),
-    (1, 8, '2018-06-25', 32.94),
-    (1, 8, '2018-06-26', 33.0),
-    (1, 8, '2018-06-27', 33.03),
-    (1, 8, '2018-06-28', 33.14),
-    (1, 8, '2018-06-29', 33.04),
-    (1, 8, '2018-07-02', 33.17),
-    (1, 8, '2018-07-03', 33.15),
-    (1, 8, '2018-07-04', NULL),
-    (1, 8, '2018-07-05', 33.19),
-    (1, 8, '2018-07-06', 33.15),
-    (1, 8, '2018-07-09', 33.09),
-    (1, 8, '2018-07-10', 33.14),
-    (1, 8, '2018-07-11', 33.29),
-    (1, 8, '2018-07-12', 33.18),
-    (1, 8, '2018-07-13', 33.33),
-    (1, 8, '2018-07-16', 33.26),
-    (1, 8, '2018-07-17', 33.31),
-    (1, 8, '2018-07-18', 33.3),
-    (1, 8, '2018-07-19', 33.44),
-    (1, 8, '2018-07-20', 33.36),
-    (1, 8, '2018-07-23', 33.43),
-    (1, 8, '2018-07-24', 33.36),
-    (1, 8, '2018-07-25', 33.26),
-    (1, 8, '2018-07-26', 33.41),
-    (1, 8, '2018-07-27', 33.36),
-    (1, 8, '2018-07-30', 33.28),
-    (1, 8, '2018-07-31', 33.17),
-    (1, 8, '2018-08-01', 33.13),
-    (1, 8, '2018-08-02', 33.27),
-    (1, 8, '2018-08-03', 33.24),
-    (1, 8, '2018-08-06', 33.34),
-    (1, 8, '2018-08-07', 33.21),
-    (1, 8, '2018-08-08', 33.21),
-    (1, 8, '2018-08-09', 33.17),
-    (1, 8, '2018-08-10', 33.3),
-    (1, 8, '2018-08-13', 33.37),
-    (1, 8, '2018-08-14', 33.29),
-    (1, 8, '2018-08-15', 33.34),
-    (1, 8, '2018-08-16', 33.15),
-    (1, 8, '2018-08-17', 33.21),
-    (1, 8, '2018-08-20', 32.96),
-    (1, 8, '2018-08-21', 32.66),
-    (1, 8, '2018-08-22', 32.66),
-    (1, 8, '2018-08-23', 32.82),
-    (1, 8, '2018-08-24', 32.62),
-    (1, 8, '2018-08-27', 32.53),
-    (1, 8, '2018-08-28', 32.54),
-    (1, 8, '2018-08-29', 32.72),
-    (1, 8, '2018-08-30', 32.77),
-    (1, 8, '2018-08-31', 32.79),
-    (1, 8, '2018-09-03', NULL),
-    (1, 8, '2018-09-04', 32.81),
-    (1, 8, '2018-09-05', 32.82),
-    (1, 8, '2018-09-06', 32.78),
-    (1, 8, '2018-09-07', 32.8),
-    (1, 8, '2018-09-10', 32.8),
-    (1, 8, '2018-09-11', 32.8),
-    (1, 8, '2018-09-12', 32.7),
-    (1, 8, '2018-09-13', 32.62),
-    (1, 8, '2018-09-14', 32.64),
-    (1, 8, '2018-09-17', 32.55),
-    (1, 8, '2018-09-18', 32.55),
-    (1, 8, '2018-09-19', 32.38),
-    (1, 8, '2018-09-20', 32.37),
-    (1, 8, '2018-09-21', 32.44),
-    (1, 8, '2018-09-24', 32.39),
-    (1, 8, '2018-09-25', 32.4),
-    (1, 8, '2018-09-26', 32.45),
-    (1, 8, '2018-09-27', 32.39),
-    (1, 8, '2018-09-28', 32.3),
-    (1, 8, '2018-10-01', 32.28),
-    (1, 8, '2018-10-02', 32.34),
-    (1, 8, '2018-10-03', 32.43),
-    (1, 8, '2018-10-04', 32.67),
-    (1, 8, '2018-10-05', 32.83),
-    (1, 8, '2018-10-08', NULL),
-    (1, 8, '2018-10-09', 33.01),
-    (1, 8, '2018-10-10', 32.93),
-    (1, 8, '2018-10-11', 32.68),
-    (1, 8, '2018-10-12', 32.76),
-    (1, 8, '2018-10-15', 32.62),
-    (1, 8, '2018-10-16', 32.47),
-    (1, 8, '2018-10-17', 32.45),
-    (1, 8, '2018-10-18', 32.58),
-    (1, 8, '2018-10-19', 32.58),
-    (1, 8, '2018-10-22', 32.79),
-    (1, 8, '2018-10-23', 32.88),
-    (1, 8, '2018-10-24', 32.93),
-    (1, 8, '2018-10-25', 32.92),
-    (1, 8, '2018-10-26', 33.07),
-    (1, 8, '2018-10-29', 33.22),
-    (1, 8, '2018-10-30', 33.26),
-    (1, 8, '2018-10-31', 33.1),
-    (1, 8, '2018-11-01', 32.93),
-    (1, 8, '2018-11-02', 32.85),
-    (1, 8, '2018-11-05', 32.96),
-    (1, 8, '2018-11-06', 32.92),
-    (1, 8, '2018-11-07', 32.81),
-    (1, 8, '2018-11-08', 32.94),
-    (1, 8, '2018-11-09', 33.09),
-    (1, 8, '2018-11-12', NULL),
-    (1, 8, '2018-11-13', 32.96),
-    (1, 8, '2018-11-14', 32.96),
-    (1, 8, '2018-11-15', 32.96),
-    (1, 8, '2018-11-16', 32.82),
-    (1, 8, '2018-11-19', 32.97),
-    (1, 8, '2018-11-20', 32.95),
-    (1, 8, '2018-11-21', 32.89),
-    (1, 8, '2018-11-22', NULL),
-    (1, 8, '2018-11-23', 33.05),
-    (1, 8, '2018-11-26', 33.0),
-    (1, 8, '2018-11-27', 33.03),
-    (1, 8, '2018-11-28', 33.03),
-    (1, 8, '2018-11-29', 32.93),
-    (1, 8, '2018-11-30', 32.92),
-    (1, 8, '2018-12-03', 32.78),
-    (1, 8, '2018-12-04', 32.75),
-    (1, 8, '2018-12-05', NULL),
-    (1, 8, '2018-12-06', 32.9),
-    (1, 8, '2018-12-07', 32.85),
-    (1, 8, '2018-12-10', 32.88),
-    (1, 8, '2018-12-11', 32.82),
-    (1, 8, '2018-12-12', 32.74),
-    (1, 8, '2018-12-13', 32.74),
-    (1, 8, '2018-12-14', 32.82),
-    (1, 8, '2018-12-17', 32.79),
-    (1, 8, '2018-12-18', 32.76),
-    (1, 8, '2018-12-19', 32.69),
-    (1, 8, '2018-12-20', 32.66),
-    (1, 8, '2018-12-21', 32.62),
-    (1, 8, '2018-12-24', NULL),
-    (1, 8, '2018-12-25', NULL),
-    (1, 8, '2018-12-26', 32.56),
-    (1, 8, '2018-12-27', 32.53),
-    (1, 8, '2018-12-28', 32.54),
-    (1, 8, '2018-12-31', 32.31),
-    (1, 8, '2019-01-01', NULL),
-    (1, 8, '2019-01-02', 32.26),
-    (1, 8, '2019-01-03', 32.19),
-    (1, 8, '2019-01-04', 32.0),
-    (1, 8, '2019-01-07', 31.96),
-    (1, 8, '2019-01-08', 32.04),
-    (1, 8, '2019-01-09', 31.97),
-    (1, 8, '2019-01-10', 31.91),
-    (1, 8, '2019-01-11', 31.93),
-    (1, 8, '2019-01-14', NULL),
-    (1, 8, '2019-01-15', 31.9),
-    (1, 8, '2019-01-16', 31.68),
-    (1, 8, '2019-01-17', 31.74),
-    (1, 8, '2019-01-18', 31.74),
-    (1, 8, '2019-01-21', NULL),
-    (1, 8, '2019-01-22', 31.78),
-    (1, 8, '2019-01-23', 31.72),
-    (1, 8, '2019-01-24', 31.68),
-    (1, 8, '2019-01-25', 31.52),
-    (1, 8, '2019-01-28', 31.51),
-    (1, 8, '2019-01-29', 31.47),
-    (1, 8, '2019-01-30', 31.33),
-    (1, 8, '2019-01-31', 31.22),
-    (1, 8, '2019-02-01', 31.28),
-    (1, 8, '2019-02-04', 31.3),
-    (1, 8, '2019-02-05', 31.24),
-    (1, 8, '2019-02-06', 31.23),
-    (1, 8, '2019-02-07', 31.23),
-    (1, 8, '2019-02-08', 31.51),
-    (1, 8, '2019-02-11', 31.42),
-    (1, 8, '2019-02-12', 31.51),
-    (1, 8, '2019-02-13', 31.33),
-    (1, 8, '2019-02-14', 31.32),
-    (1, 8, '2019-02-15', 31.23),
-    (1, 8, '2019-02-18', NULL),
-    (1, 8, '2019-02-19', 31.15),
-    (1, 8, '2019-02-20', NULL),
-    (1, 8, '2019-02-21', 31.22),
-    (1, 8, '2019-02-22', 31.3),
-    (1, 8, '2019-02-25', 31.27),
-    (1, 8, '2019-02-26', 31.33),
-    (1, 8, '2019-02-27', 31.45),
-    (1, 8, '2019-02-28', 31.57),
-    (1, 8, '2019-03-01', 31.8),
-    (1, 8, '2019-03-04', 31.86),
-    (1, 8, '2019-03-05', 31.77),
-    (1, 8, '2019-03-06', 31.85),
-    (1, 8, '2019-03-07', 31.86),
-    (1, 8, '2019-03-08', 31.73),
-    (1, 8, '2019-03-11', 31.7),
-    (1, 8, '2019-03-12', 31.66),
-    (1, 8, '2019-03-13', 31.59),
-    (1, 8, '2019-03-14', 31.78),
-    (1, 8, '2019-03-15', 31.68),
-    (1, 8, '2019-03-18', 31.69),
-    (1, 8, '2019-03-19', 31.67),
-    (1, 8, '2019-03-20', 31.74),
-    (1, 8, '2019-03-21', 31.72),
-    (1, 8, '2019-03-22', 31.73),
-    (1, 8, '2019-03-25', 31.55),
-    (1, 8, '2019-03-26', 31.62),
-    (1, 8, '2019-03-27', 31.87),
-    (1, 8, '2019-03-28', 31.87),
-    (1, 8, '2019-03-29', 31.7),
-    (1, 8, '2019-04-01', 31.71),
-    (1, 8, '2019-04-02', 31.78),
-    (1, 8, '2019-04-03', 31.73),
-    (1, 8, '2019-04-04', 31.79),
-    (1, 8, '2019-04-05', 31.91),
-    (1, 8, '2019-04-08', 31.9),
-    (1, 8, '2019-04-09', 31.75),
-    (1, 8, '2019-04-10', 31.75),
-    (1, 8, '2019-04-11', 31.85),
-    (1, 8, '2019-04-12', 31.79),
-    (1, 8, '2019-04-15', 31.78),
-    (1, 8, '2019-04-16', 31.82),
-    (1, 8, '2019-04-17', 31.8),
-    (1, 8, '2019-04-18', 31.83),
-    (1, 8, '2019-04-19', 31.81),
-    (1, 8, '2019-04-22', 31.86),
-    (1, 8, '2019-04-23', 31.99),
-    (1, 8, '2019-04-24', 32.02),
-    (1, 8, '2019-04-25', 32.06),
-    (1, 8, '2019-04-26', 31.91),
-    (1, 8, '2019-04-29', 31.92),
-    (1, 8, '2019-04-30', 31.89),
-    (1, 8, '2019-05-01', 31.91),
-    (1, 8, '2019-05-02', 32.04),
-    (1, 8, '2019-05-03', 31.92),
-    (1, 8, '2019-05-06', 31.88),
-    (1, 8, '2019-05-07', 31.91),
-    (1, 8, '2019-05-08', 31.77),
-    (1, 8, '2019-05-09', 31.82),
-    (1, 8, '2019-05-10', 31.55),
-    (1, 8, '2019-05-13', 31.62),
-    (1, 8, '2019-05-14', 31.62),
-    (1, 8, '2019-05-15', 31.54),
-    (1, 8, '2019-05-16', 31.63),
-    (1, 8, '2019-05-17', 31.83),
-    (1, 8, '2019-05-20', 31.89),
-    (1, 8, '2019-05-21', 31.95),
-    (1, 8, '2019-05-22', 31.92),
-    (1, 8, '2019-05-23', 31.92),
-    (1, 8, '2019-05-24', 31.83),
-    (1, 8, '2019-05-27', NULL),
-    (1, 8, '2019-05-28', 31.82),
-    (1, 8, '2019-05-29', 31.82),
-    (1, 8, '2019-05-30', 31.77),
-    (1, 8, '2019-05-31', 31.56),
-    (1, 8, '2019-06-03', 31.34),
-    (1, 8, '2019-06-04', 31.35),
-    (1, 8, '2019-06-05', 31.4),
-    (1, 8, '2019-06-06', 31.4),
-    (1, 8, '2019-06-07', 31.25),
-    (1, 8, '2019-06-10', 31.35),
-    (1, 8, '2019-06-11', 31.28),
-    (1, 8, '2019-06-12', 31.26),
-    (1, 8, '2019-06-13', 31.19),
-    (1, 8, '2019-06-14', 31.21),
-    (1, 8, '2019-06-17', 31.35),
-    (1, 8, '2019-06-18', 31.21),
-    (1, 8, '2019-06-19', 31.23),
-    (1, 8, '2019-06-20', 30.86),
-    (1, 8, '2019-06-21', 30.78),
-    (1, 8, '2019-06-24', 30.66),
-    (1, 8, '2019-06-25', 30.71),
-    (1, 8, '2019-06-26', 30.73),
-    (1, 8, '2019-06-27', 30.78),
-    (1, 8, '2019-06-28', 30.68),
-    (1, 8, '2019-07-01', 30.64),
-    (1, 8, '2019-07-02', 30.56),
-    (1, 8, '2019-07-03', 30.59),
-    (1, 8, '2019-07-04', NULL),
-    (1, 8, '2019-07-05', 30.78),
-    (1, 8, '2019-07-08', 30.8),
-    (1, 8, '2019-07-09', 30.8),
-    (1, 8, '2019-07-10', 30.69),
-    (1, 8, '2019-07-11', 30.7),
-    (1, 8, '2019-07-12', 30.93),
-    (1, 8, '2019-07-15', 30.89),
-    (1, 8, '2019-07-16', 30.91),
-    (1, 8, '2019-07-17', 30.87),
-    (1, 8, '2019-07-18', 30.81),
-    (1, 8, '2019-07-19', 30.83),
-    (1, 8, '2019-07-22', 30.85),
-    (1, 8, '2019-07-23', 30.91),
-    (1, 8, '2019-07-24', 30.9),
-    (1, 8, '2019-07-25', 30.92),
-    (1, 8, '2019-07-26', 30.87),
-    (1, 8, '2019-07-29', 30.85),
-    (1, 8, '2019-07-30', 30.79),
-    (1, 8, '2019-07-31', 30.7),
-    (1, 8, '2019-08-01', 30.83),
-    (1, 8, '2019-08-02', 30.71),
-    (1, 8, '2019-08-05', 30.82),
-    (1, 8, '2019-08-06', 30.72),
-    (1, 8, '2019-08-07', 30.8),
-    (1, 8, '2019-08-08', 30.75),
-    (1, 8, '2019-08-09', 30.79),
-    (1, 8, '2019-08-12', 30.85),
-    (1, 8, '2019-08-13', 30.78),
-    (1, 8, '2019-08-14', 30.84),
-    (1, 8, '2019-08-15', 30.82),
-    (1, 8, '2019-08-16', 30.91),
-    (1, 8, '2019-08-19', 30.85),
-    (1, 8, '2019-08-20', 30.76),
-    (1, 8, '2019-08-21', 30.77),
-    (1, 8, '2019-08-22', 30.79),
-    (1, 8, '2019-08-23', 30.6),
-    (1, 8, '2019-08-26', 30.58),
-    (1, 8, '2019-08-27', 30.57),
-    (1, 8, '2019-08-28', 30.61),
-    (1, 8, '2019-08-29', 30.61),
-    (1, 8, '2019-08-30', 30.61),
-    (1, 8, '2019-09-02', NULL),
-    (1, 8, '2019-09-03', 30.6),
-    (1, 8, '2019-09-04', 30.56),
-    (1, 8, '2019-09-05', 30.68),
-    (1, 8, '2019-09-06', 30.62),
-    (1, 8, '2019-09-09', 30.63),
-    (1, 8, '2019-09-10', 30.6),
-    (1, 8, '2019-09-11', 30.58),
-    (1, 8, '2019-09-12', 30.4),
-    (1, 8, '2019-09-13', 30.47),
-    (1, 8, '2019-09-16', 30.51),
-    (1, 8, '2019-09-17', 30.51),
-    (1, 8, '2019-09-18', 30.51),
-    (1, 8, '2019-09-19', 30.53),
-    (1, 8, '2019-09-20', 30.49),
-    (1, 8, '2019-09-23', 30.46),
-    (1, 8, '2019-09-24', 30.54),
-    (1, 8, '2019-09-25', 30.61),
-    (1, 8, '2019-09-26', 30.64),
-    (1, 8, '2019-09-27', 30.63),
-    (1, 8, '2019-09-30', 30.59),
-    (1, 8, '2019-10-01', 30.61),
-    (1, 8, '2019-10-02', 30.6),
-    (1, 8, '2019-10-03', 30.53),
-    (1, 8, '2019-10-04', 30.42),
-    (1, 8, '2019-10-07', 30.45),
-    (1, 8, '2019-10-08', 30.4),
-    (1, 8, '2019-10-09', 30.31),
-    (1, 8, '2019-10-10', 30.39),
-    (1, 8, '2019-10-11', 30.39),
-    (1, 8, '2019-10-14', NULL),
-    (1, 8, '2019-10-15', 30.42),
-    (1, 8, '2019-10-16', 30.36),
-    (1, 8, '2019-10-17', 30.27),
-    (1, 8, '2019-10-18', 30.28),
-    (1, 8, '2019-10-21', 30.27),
-    (1, 8, '2019-10-22', 30.3),
-    (1, 8, '2019-10-23', 30.27),
-    (1, 8, '2019-10-24', 30.25),
-    (1, 8, '2019-10-25', 30.16),
-    (1, 8, '2019-10-28', 30.23),
-    (1, 8, '2019-10-29', 30.24),
-    (1, 8, '2019-10-30', 30.24),
-    (1, 8, '2019-10-31', 30.18),
-    (1, 8, '2019-11-01', 30.17),
-    (1, 8, '2019-11-04', 30.2),
-    (1, 8, '2019-11-05', 30.24),
-    (1, 8, '2019-11-06', 30.29),
-    (1, 8, '2019-11-07', 30.43),
-    (1, 8, '2019-11-08', 30.37),
-    (1, 8, '2019-11-11', NULL),
-    (1, 8, '2019-11-12', 30.34),
-    (1, 8, '2019-11-13', 30.22),
-    (1, 8, '2019-11-14', 30.2),
-    (1, 8, '2019-11-15', 30.26),
-    (1, 8, '2019-11-18', 30.19),
-    (1, 8, '2019-11-19', 30.19),
-    (1, 8, '2019-11-20', 30.19),
-    (1, 8, '2019-11-21', 30.21),
-    (1, 8, '2019-11-22', 30.2),
-    (1, 8, '2019-11-25', 30.19),
-    (1, 8, '2019-11-26', 30.23),
-    (1, 8, '2019-11-27', 30.23),
-    (1, 8, '2019-11-28', NULL),
-    (1, 8, '2019-11-29', 30.22),
-    (1, 8, '2019-12-02', 30.27),
-    (1, 8, '2019-12-03', 30.26),
-    (1, 8, '2019-12-04', 30.31),
-    (1, 8, '2019-12-05', 30.36),
-    (1, 8, '2019-12-06', 30.35),
-    (1, 8, '2019-12-09', 30.31),
-    (1, 8, '2019-12-10', 30.3),
-    (1, 8, '2019-12-11', 30.25),
-    (1, 8, '2019-12-12', 30.16),
-    (1, 8, '2019-12-13', 30.21),
-    (1, 8, '2019-12-16', 30.22),
-    (1, 8, '2019-12-17', 30.24),
-    (1, 8, '2019-12-18', 30.2),
-    (1, 8, '2019-12-19', 30.19),
-    (1, 8, '2019-12-20', 30.19),
-    (1, 8, '2019-12-23', 30.16),
-    (1, 8, '2019-12-24', 30.15),
-    (1, 8, '2019-12-25', NULL),
-    (1, 8, '2019-12-26', 30.15),
-    (1, 8, '2019-12-27', 30.14),
-    (1, 8, '2019-12-30', 29.94),
-    (1, 8, '2019-12-31', 29.75),
-    (1, 8, '2020-01-01', NULL),
-    (1, 8, '2020-01-02', 30.15),
-    (1, 8, '2020-01-03', 30.18),
-    (1, 8, '2020-01-06', 30.16),
-    (1, 8, '2020-01-07', 30.31),
-    (1, 8, '2020-01-08', 30.37),
-    (1, 8, '2020-01-09', 30.25),
-    (1, 8, '2020-01-10', 30.22),
-    (1, 8, '2020-01-13', 30.18),
-    (1, 8, '2020-01-14', 30.25),
-    (1, 8, '2020-01-15', 30.29),
-    (1, 8, '2020-01-16', 30.39),
-    (1, 8, '2020-01-17', 30.38),
-    (1, 8, '2020-01-20', NULL),
-    (1, 8, '2020-01-21', 30.39),
-    (1, 8, '2020-01-22', 30.4),
-    (1, 8, '2020-01-23', 30.57),
-    (1, 8, '2020-01-24', 30.56),
-    (1, 8, '2020-01-27', 30.69),
-    (1, 8, '2020-01-28', 30.81),
-    (1, 8, '2020-01-29', 30.95),
-    (1, 8, '2020-01-30', 31.18),
-    (1, 8, '2020-01-31', 31.19),
-    (1, 8, '2020-02-03', 31.06),
-    (1, 8, '2020-02-04', 30.97),
-    (1, 8, '2020-02-05', 30.97),
-    (1, 8, '2020-02-06', 31.15),
-    (1, 8, '2020-02-07', 31.34),
-    (1, 8, '2020-02-10', 31.3),
-    (1, 8, '2020-02-11', 31.25),
-    (1, 8, '2020-02-12', 31.1),
-    (1, 8, '2020-02-13', 31.1),
-    (1, 8, '2020-02-14', 31.21),
-    (1, 8, '2020-02-17', NULL),
-    (1, 8, '2020-02-18', 31.16),
-    (1, 8, '2020-02-19', 31.21),
-    (1, 8, '2020-02-20', 31.43),
-    (1, 8, '2020-02-21', 31.55),
-    (1, 8, '2020-02-24', 31.66),
-    (1, 8, '2020-02-25', 31.73),
-    (1, 8, '2020-02-26', 31.83),
-    (1, 8, '2020-02-27', 31.58),
-    (1, 8, '2020-02-28', 31.53),
-    (1, 8, '2020-03-02', 31.41),
-    (1, 8, '2020-03-03', 31.34),
-    (1, 8, '2020-03-04', 31.34),
-    (1, 8, '2020-03-05', 31.56),
-    (1, 8, '2020-03-06', 31.49),
-    (1, 8, '2020-03-09', 31.46),
-    (1, 8, '2020-03-10', 31.47),
-    (1, 8, '2020-03-11', 31.45),
-    (1, 8, '2020-03-12', 31.72),
-    (1, 8, '2020-03-13', 31.95),
-    (1, 8, '2020-03-16', 31.98),
-    (1, 8, '2020-03-17', 32.18),
-    (1, 8, '2020-03-18', 32.5),
-    (1, 8, '2020-03-19', 32.57),
-    (1, 8, '2020-03-20', 32.69),
-    (1, 8, '2020-03-23', 32.86),
-    (1, 8, '2020-03-24', 32.82),
-    (1, 8, '2020-03-25', 32.77),
-    (1, 8, '2020-03-26', 32.6),
-    (1, 8, '2020-03-27', 32.54),
-    (1, 8, '2020-03-30', 32.68),
-    (1, 8, '2020-03-31', 32.7),
-    (1, 8, '2020-04-01', 33.04),
-    (1, 8, '2020-04-02', 32.93),
-    (1, 8, '2020-04-03', 33.03),
-    (1, 8, '2020-04-06', 32.78),
-    (1, 8, '2020-04-07', 32.72),
-    (1, 8, '2020-04-08', 32.72),
-    (1, 8, '2020-04-09', 32.66),
-    (1, 8, '2020-04-10', 32.69),
-    (1, 8, '2020-04-13', 32.7),
-    (1, 8, '2020-04-14', 32.62),
-    (1, 8, '2020-04-15', 32.7),
-    (1, 8, '2020-04-16', 32.65),
-    (1, 8, '2020-04-17', 32.49),
-    (1, 8, '2020-04-20', 32.46),
-    (1, 8, '2020-04-21', 32.51),
-    (1, 8, '2020-04-22', 32.33),
-    (1, 8, '2020-04-23', 32.31),
-    (1, 8, '2020-04-24', 32.42),
-    (1, 8, '2020-04-27', 32.47),
-    (1, 8, '2020-04-28', 32.43),
-    (1, 8, '2020-04-29', 32.4),
-    (1, 8, '2020-04-30', 32.42),
-    (1, 8, '2020-05-01', 32.52),
-    (1, 8, '2020-05-04', 32.4),
-    (1, 8, '2020-05-05', 32.37),
-    (1, 8, '2020-05-06', 32.41),
-    (1, 8, '2020-05-07', 32.38),
-    (1, 8, '2020-05-08', 32.17),
-    (1, 8, '2020-05-11', 32.17),
-    (1, 8, '2020-05-12', 32.08),
-    (1, 8, '2020-05-13', 32.07),
-    (1, 8, '2020-05-14', 32.08),
-    (1, 8, '2020-05-15', 32.06),
-    (1, 8, '2020-05-18', 32.01),
-    (1, 8, '2020-05-19', 31.88),
-    (1, 8, '2020-05-20', 31.81),
-    (1, 8, '2020-05-21', 31.84),
-    (1, 8, '2020-05-22', 31.9),
-    (1, 8, '2020-05-25', NULL),
-    (1, 8, '2020-05-26', 31.88),
-    (1, 8, '2020-05-27', 31.91),
-    (1, 8, '2020-05-28', 31.85),
-    (1, 8, '2020-05-29', 31.82),
-    (1, 8, '2020-06-01', 31.65),
-    (1, 8, '2020-06-02', 31.53),
-    (1, 8, '2020-06-03', 31.59),
-    (1, 8, '2020-06-04', 31.55),
-    (1, 8, '2020-06-05', 31.5),
-    (1, 8, '2020-06-08', 31.41),
-    (1, 8, '2020-06-09', 31.31),
-    (1, 8, '2020-06-10', 31.14),
-    (1, 8, '2020-06-11', 30.92),
-    (1, 8, '2020-06-12', 30.97),
-    (1, 8, '2020-06-15', 31.05),
-    (1, 8, '2020-06-16', 31.1),
-    (1, 8, '2020-06-17', 31.13),
-    (1, 8, '2020-06-18', 31.07),
-    (1, 8, '2020-06-19', 30.98),
-    (1, 8, '2020-06-22', 30.95),
-    (1, 8, '2020-06-23', 30.87),
-    (1, 8, '2020-06-24', 30.84),
-    (1, 8, '2020-06-25', 30.9),
-    (1, 8, '2020-06-26', 30.9),
-    (1, 8, '2020-06-29', 30.89),
-    (1, 8, '2020-06-30', 30.88),
-    (1, 8, '2020-07-01', 31.02),
-    (1, 8, '2020-07-02', 31.06),
-    (1, 8, '2020-07-03', NULL),
-    (1, 8, '2020-07-06', 31.06),
-    (1, 8, '2020-07-07', 31.18),
-    (1, 8, '2020-07-08', 31.22),
-    (1, 8, '2020-07-09', 31.26),
-    (1, 8, '2020-07-10', 31.31),
-    (1, 8, '2020-07-13', 31.36),
-    (1, 8, '2020-07-14', 31.53),
-    (1, 8, '2020-07-15', 31.57),
-    (1, 8, '2020-07-16', 31.69),
-    (1, 8, '2020-07-17', 31.68),
-    (1, 8, '2020-07-20', 31.8),
-    (1, 8, '2020-07-21', 31.57),
-    (1, 8, '2020-07-22', 31.6),
-    (1, 8, '2020-07-23', 31.69),
-    (1, 8, '2020-07-24', 31.61),
-    (1, 8, '2020-07-27', 31.48),
-    (1, 8, '2020-07-28', 31.47),
-    (1, 8, '2020-07-29', 31.41),
-    (1, 8, '2020-07-30', 31.37),
-    (1, 8, '2020-07-31', 31.23),
-    (1, 8, '2020-08-03', 31.21),
-    (1, 8, '2020-08-04', 31.05),
-    (1, 8, '2020-08-05', 31.01),
-    (1, 8, '2020-08-06', 31.13),
-    (1, 8, '2020-08-07', 31.2),
-    (1, 8, '2020-08-10', 31.11),
-    (1, 8, '2020-08-11', 31.1),
-    (1, 8, '2020-08-12', 31.07),
-    (1, 8, '2020-08-13', 31.05),
-    (1, 8, '2020-08-14', 31.09),
-    (1, 8, '2020-08-17', 31.15),
-    (1, 8, '2020-08-18', 31.16),
-    (1, 8, '2020-08-19', 31.25),
-    (1, 8, '2020-08-20', 31.41),
-    (1, 8, '2020-08-21', 31.56),
-    (1, 8, '2020-08-24', 31.48),
-    (1, 8, '2020-08-25', 31.44),
-    (1, 8, '2020-08-26', 31.27),
-    (1, 8, '2020-08-27', 31.28),
-    (1, 8, '2020-08-28', 31.09),
-    (1, 8, '2020-08-31', 31.06),
-    (1, 8, '2020-09-01', 31.18),
-    (1, 8, '2020-09-02', 31.3),
-    (1, 8, '2020-09-03', 31.43),
-    (1, 8, '2020-09-04', 31.41),
-    (1, 8, '2020-09-07', NULL),
-    (1, 8, '2020-09-08', 31.43),
-    (1, 8, '2020-09-09', 31.27),
-    (1, 8, '2020-09-10', 31.31),
-    (1, 8, '2020-09-11', 31.26),
-    (1, 8, '2020-09-14', 31.27),
-    (1, 8, '2020-09-15', 31.21),
-    (1, 8, '2020-09-16', 31.06),
-    (1, 8, '2020-09-17', 31.17),
-    (1, 8, '2020-09-18', 31.09),
-    (1, 8, '2020-09-21', 31.37),
-    (1, 8, '2020-09-22', 31.4),
-    (1, 8, '2020-09-23', 31.57),
-    (1, 8, '2020-09-24', 31.6),
-    (1, 8, '2020-09-25', 31.64),
-    (1, 8, '2020-09-28', 31.64),
-    (1, 8, '2020-09-29', 31.64),
-    (1, 8, '2020-09-30', 31.57),
-    (1, 8, '2020-10-01', 31.56),
-    (1, 8, '2020-10-02', 31.56),
-    (1, 8, '2020-10-05', 31.29),
-    (1, 8, '2020-10-06', 31.19),
-    (1, 8, '2020-10-07', 31.22),
-    (1, 8, '2020-10-08', 31.21),
-    (1, 8, '2020-10-09', 31.02),
-    (1, 8, '2020-10-12', NULL),
-    (1, 8, '2020-10-13', 31.24),
-    (1, 8, '2020-10-14', 31.1),
-    (1, 8, '2020-10-15', 31.22),
-    (1, 8, '2020-10-16', 31.16),
-    (1, 8, '2020-10-19', 31.2),
-    (1, 8, '2020-10-20', 31.26),
-    (1, 8, '2020-10-21', 31.19),
-    (1, 8, '2020-10-22', 31.31),
-    (1, 8, '2020-10-23', 31.31),
-    (1, 8, '2020-10-26', 31.27),
-    (1, 8, '2020-10-27', 31.2),
-    (1, 8, '2020-10-28', 31.26),
-    (1, 8, '2020-10-29', 31.25),
-    (1, 8, '2020-10-30', 31.17),
-    (1, 8, '2020-11-02', 31.13),
-    (1, 8, '2020-11-03', 31.02),
-    (1, 8, '2020-11-04', 31.0),
-    (1, 8, '2020-11-05', 30.79),
-    (1, 8, '2020-11-06', 30.57),
-    (1, 8, '2020-11-09', 30.58),
-    (1, 8, '2020-11-10', 30.37),
-    (1, 8, '2020-11-11', NULL),
-    (1, 8, '2020-11-12', 30.21),
-    (1, 8, '2020-11-13', 30.18),
-    (1, 8, '2020-11-16', 30.19),
-    (1, 8, '2020-11-17', 30.19),
-    (1, 8, '2020-11-18', 30.28),
-    (1, 8, '2020-11-19', 30.38),
-    (1, 8, '2020-11-20', 30.25),
-    (1, 8, '2020-11-23', 30.36),
-    (1, 8, '2020-11-24', 30.33),
-    (1, 8, '2020-11-25', 30.3),
-    (1, 8, '2020-11-26', NULL),
-    (1, 8, '2020-11-27', NULL),
-    (1, 8, '2020-11-30', 30.28),
-    (1, 8, '2020-12-01', 30.27),
-    (1, 8, '2020-12-02', 30.21),
-    (1, 8, '2020-12-03', 30.17),
-    (1, 8, '2020-12-04', 30.16),
-    (1, 8, '2020-12-07', 30.16),
-    (1, 8, '2020-12-08', 30.02),
-    (1, 8, '2020-12-09', 30.05),
-    (1, 8, '2020-12-10', 30.06),
-    (1, 8, '2020-12-11', 30.09),
-    (1, 8, '2020-12-14', 30.09),
-    (1, 8, '2020-12-15', 30.04),
-    (1, 8, '2020-12-16', 30.01),
-    (1, 8, '2020-12-17', 29.79),
-    (1, 8, '2020-12-18', 29.84),
-    (1, 8, '2020-12-21', 30.14),
-    (1, 8, '2020-12-22', 30.21),
-    (1, 8, '2020-12-23', 30.21),
-    (1, 8, '2020-12-24', NULL),
-    (1, 8, '2020-12-25', NULL),
-    (1, 8, '2020-12-28', 30.16),
-    (1, 8, '2020-12-29', 30.04),
-    (1, 8, '2020-12-30', 29.95),
-    (1, 8, '2020-12-31', 30.02),
-    (1, 8, '2021-01-01', NULL),
-    (1, 8, '2021-01-04', 29.92),
-    (1, 8, '2021-01-05', 29.92),
-    (1, 8, '2021-01-06', 29.93),
-    (1, 8, '2021-01-07', 30.07),
-    (1, 8, '2021-01-08', 30.09),
-    (1, 8, '2021-01-11', 30.14),
-    (1, 8, '2021-01-12', 30.12),
-    (1, 8, '2021-01-13', 30.02),
-    (1, 8, '2021-01-14', 29.97),
-    (1, 8, '2021-01-15', 30.07),
-    (1, 8, '2021-01-18', NULL),
-    (1, 8, '2021-01-19', 29.99),
-    (1, 8, '2021-01-20', NULL),
-    (1, 8, '2021-01-21', 29.93),
-    (1, 8, '2021-01-22', 29.99),
-    (1, 8, '2021-01-25', 29.99),
-    (1, 8, '2021-01-26', 29.95),
-    (1, 8, '2021-01-27', 30.0),
-    (1, 8, '2021-01-28', 29.96),
-    (1, 8, '2021-01-29', 29.89);
\ No newline at end of file
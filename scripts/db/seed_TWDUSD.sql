@@ -1,1316 +0,0 @@
--- TWD 9
--- USD 1
-INSERT INTO
-    exchange_rates (
-        destination_currency_id,
-        source_currency_id,
-        date,
-        rate
-    )
-VALUES
-    (1, 9, '2016-01-29', 33.4300),
-    (1, 9, '2016-02-01', 33.4100),
-    (1, 9, '2016-02-02', 33.5100),
-    (1, 9, '2016-02-03', 33.4200),
-    (1, 9, '2016-02-04', 33.0000),
-    (1, 9, '2016-02-05', 33.3700),
-    (1, 9, '2016-02-08', 33.4100),
-    (1, 9, '2016-02-09', 33.2300),
-    (1, 9, '2016-02-10', 33.0000),
-    (1, 9, '2016-02-11', 32.9500),
-    (1, 9, '2016-02-12', 33.0400),
-    (1, 9, '2016-02-15', NULL),
-    (1, 9, '2016-02-16', 33.2100),
-    (1, 9, '2016-02-17', 33.2500),
-    (1, 9, '2016-02-18', 33.2600),
-    (1, 9, '2016-02-19', 33.3000),
-    (1, 9, '2016-02-22', 33.1500),
-    (1, 9, '2016-02-23', 33.2600),
-    (1, 9, '2016-02-24', 33.3000),
-    (1, 9, '2016-02-25', 33.1900),
-    (1, 9, '2016-02-26', 33.2900),
-    (1, 9, '2016-02-29', 33.2200),
-    (1, 9, '2016-03-01', 33.0400),
-    (1, 9, '2016-03-02', 33.0900),
-    (1, 9, '2016-03-03', 32.9200),
-    (1, 9, '2016-03-04', 32.4900),
-    (1, 9, '2016-03-07', 32.5400),
-    (1, 9, '2016-03-08', 32.7600),
-    (1, 9, '2016-03-09', 32.7500),
-    (1, 9, '2016-03-10', 32.8700),
-    (1, 9, '2016-03-11', 32.5800),
-    (1, 9, '2016-03-14', 32.6800),
-    (1, 9, '2016-03-15', 32.8600),
-    (1, 9, '2016-03-16', 32.7700),
-    (1, 9, '2016-03-17', 32.2400),
-    (1, 9, '2016-03-18', 32.3000),
-    (1, 9, '2016-03-21', 32.3300),
-    (1, 9, '2016-03-22', 32.3400),
-    (1, 9, '2016-03-23', 32.4900),
-    (1, 9, '2016-03-24', 32.5000),
-    (1, 9, '2016-03-25', 32.4900),
-    (1, 9, '2016-03-28', 32.5600),
-    (1, 9, '2016-03-29', 32.5700),
-    (1, 9, '2016-03-30', 32.1600),
-    (1, 9, '2016-03-31', 32.1800),
-    (1, 9, '2016-04-01', 32.2600),
-    (1, 9, '2016-04-04', 32.3800),
-    (1, 9, '2016-04-05', 32.4400),
-    (1, 9, '2016-04-06', 32.3800),
-    (1, 9, '2016-04-07', 32.4000),
-    (1, 9, '2016-04-08', 32.4000),
-    (1, 9, '2016-04-11', 32.3300),
-    (1, 9, '2016-04-12', 32.3300),
-    (1, 9, '2016-04-13', 32.3500),
-    (1, 9, '2016-04-14', 32.4100),
-    (1, 9, '2016-04-15', 32.3200),
-    (1, 9, '2016-04-18', 32.3200),
-    (1, 9, '2016-04-19', 32.1100),
-    (1, 9, '2016-04-20', 32.1800),
-    (1, 9, '2016-04-21', 32.2700),
-    (1, 9, '2016-04-22', 32.3600),
-    (1, 9, '2016-04-25', 32.3400),
-    (1, 9, '2016-04-26', 32.2700),
-    (1, 9, '2016-04-27', 32.3600),
-    (1, 9, '2016-04-28', 32.2300),
-    (1, 9, '2016-04-29', 32.2800),
-    (1, 9, '2016-05-02', 32.2200),
-    (1, 9, '2016-05-03', 32.3200),
-    (1, 9, '2016-05-04', 32.3900),
-    (1, 9, '2016-05-05', 32.4000),
-    (1, 9, '2016-05-06', 32.3800),
-    (1, 9, '2016-05-09', 32.5400),
-    (1, 9, '2016-05-10', 32.5400),
-    (1, 9, '2016-05-11', 32.4900),
-    (1, 9, '2016-05-12', 32.5900),
-    (1, 9, '2016-05-13', 32.6600),
-    (1, 9, '2016-05-16', 32.6500),
-    (1, 9, '2016-05-17', 32.5600),
-    (1, 9, '2016-05-18', 32.7600),
-    (1, 9, '2016-05-19', 32.7500),
-    (1, 9, '2016-05-20', 32.6900),
-    (1, 9, '2016-05-23', 32.5800),
-    (1, 9, '2016-05-24', 32.6400),
-    (1, 9, '2016-05-25', 32.5500),
-    (1, 9, '2016-05-26', 32.4900),
-    (1, 9, '2016-05-27', 32.5400),
-    (1, 9, '2016-05-30', NULL),
-    (1, 9, '2016-05-31', 32.5800),
-    (1, 9, '2016-06-01', 32.6200),
-    (1, 9, '2016-06-02', 32.6000),
-    (1, 9, '2016-06-03', 32.2800),
-    (1, 9, '2016-06-06', 32.2500),
-    (1, 9, '2016-06-07', 32.1600),
-    (1, 9, '2016-06-08', 32.1300),
-    (1, 9, '2016-06-09', 32.2700),
-    (1, 9, '2016-06-10', 32.2900),
-    (1, 9, '2016-06-13', 32.3700),
-    (1, 9, '2016-06-14', 32.3900),
-    (1, 9, '2016-06-15', 32.3100),
-    (1, 9, '2016-06-16', 32.3700),
-    (1, 9, '2016-06-17', 32.3400),
-    (1, 9, '2016-06-20', 32.2200),
-    (1, 9, '2016-06-21', 32.1500),
-    (1, 9, '2016-06-22', 32.0700),
-    (1, 9, '2016-06-23', 31.9900),
-    (1, 9, '2016-06-24', 32.3600),
-    (1, 9, '2016-06-27', 32.5300),
-    (1, 9, '2016-06-28', 32.4100),
-    (1, 9, '2016-06-29', 32.2700),
-    (1, 9, '2016-06-30', 32.2200),
-    (1, 9, '2016-07-01', 32.2000),
-    (1, 9, '2016-07-04', NULL),
-    (1, 9, '2016-07-05', 32.3300),
-    (1, 9, '2016-07-06', 32.3600),
-    (1, 9, '2016-07-07', 32.3200),
-    (1, 9, '2016-07-08', 32.2300),
-    (1, 9, '2016-07-11', 32.2100),
-    (1, 9, '2016-07-12', 32.1300),
-    (1, 9, '2016-07-13', 32.1600),
-    (1, 9, '2016-07-14', 31.9400),
-    (1, 9, '2016-07-15', 31.9200),
-    (1, 9, '2016-07-18', 31.9300),
-    (1, 9, '2016-07-19', 32.0600),
-    (1, 9, '2016-07-20', 32.0700),
-    (1, 9, '2016-07-21', 31.9800),
-    (1, 9, '2016-07-22', 32.0300),
-    (1, 9, '2016-07-25', 32.2000),
-    (1, 9, '2016-07-26', 32.1100),
-    (1, 9, '2016-07-27', 32.0600),
-    (1, 9, '2016-07-28', 31.9600),
-    (1, 9, '2016-07-29', 31.8200),
-    (1, 9, '2016-08-01', 31.5500),
-    (1, 9, '2016-08-02', 31.5700),
-    (1, 9, '2016-08-03', 31.6600),
-    (1, 9, '2016-08-04', 31.6200),
-    (1, 9, '2016-08-05', 31.5400),
-    (1, 9, '2016-08-08', 31.4500),
-    (1, 9, '2016-08-09', 31.2800),
-    (1, 9, '2016-08-10', 31.0500),
-    (1, 9, '2016-08-11', 31.2500),
-    (1, 9, '2016-08-12', 31.3800),
-    (1, 9, '2016-08-15', 31.3100),
-    (1, 9, '2016-08-16', 31.2600),
-    (1, 9, '2016-08-17', 31.5300),
-    (1, 9, '2016-08-18', 31.4000),
-    (1, 9, '2016-08-19', 31.6400),
-    (1, 9, '2016-08-22', 31.8000),
-    (1, 9, '2016-08-23', 31.7800),
-    (1, 9, '2016-08-24', 31.7900),
-    (1, 9, '2016-08-25', 31.6800),
-    (1, 9, '2016-08-26', 31.6400),
-    (1, 9, '2016-08-29', 31.7000),
-    (1, 9, '2016-08-30', 31.7400),
-    (1, 9, '2016-08-31', 31.7400),
-    (1, 9, '2016-09-01', 31.7200),
-    (1, 9, '2016-09-02', 31.6600),
-    (1, 9, '2016-09-05', NULL),
-    (1, 9, '2016-09-06', 31.1800),
-    (1, 9, '2016-09-07', 31.2400),
-    (1, 9, '2016-09-08', 31.3200),
-    (1, 9, '2016-09-09', 31.5800),
-    (1, 9, '2016-09-12', 31.7000),
-    (1, 9, '2016-09-13', 31.7700),
-    (1, 9, '2016-09-14', 31.6800),
-    (1, 9, '2016-09-15', 31.6800),
-    (1, 9, '2016-09-16', 31.7000),
-    (1, 9, '2016-09-19', 31.3700),
-    (1, 9, '2016-09-20', 31.3100),
-    (1, 9, '2016-09-21', 31.2800),
-    (1, 9, '2016-09-22', 31.3000),
-    (1, 9, '2016-09-23', 31.3400),
-    (1, 9, '2016-09-26', 31.4100),
-    (1, 9, '2016-09-27', 31.3700),
-    (1, 9, '2016-09-28', 31.3500),
-    (1, 9, '2016-09-29', 31.3500),
-    (1, 9, '2016-09-30', 31.2700),
-    (1, 9, '2016-10-03', 31.3600),
-    (1, 9, '2016-10-04', 31.4300),
-    (1, 9, '2016-10-05', 31.4500),
-    (1, 9, '2016-10-06', 31.5000),
-    (1, 9, '2016-10-07', 31.4700),
-    (1, 9, '2016-10-10', NULL),
-    (1, 9, '2016-10-11', 31.6400),
-    (1, 9, '2016-10-12', 31.6300),
-    (1, 9, '2016-10-13', 31.7400),
-    (1, 9, '2016-10-14', 31.7600),
-    (1, 9, '2016-10-17', 31.7900),
-    (1, 9, '2016-10-18', 31.5300),
-    (1, 9, '2016-10-19', 31.4400),
-    (1, 9, '2016-10-20', 31.5800),
-    (1, 9, '2016-10-21', 31.7500),
-    (1, 9, '2016-10-24', 31.6800),
-    (1, 9, '2016-10-25', 31.5300),
-    (1, 9, '2016-10-26', 31.5900),
-    (1, 9, '2016-10-27', 31.7000),
-    (1, 9, '2016-10-28', 31.6100),
-    (1, 9, '2016-10-31', 31.5400),
-    (1, 9, '2016-11-01', 31.5400),
-    (1, 9, '2016-11-02', 31.5200),
-    (1, 9, '2016-11-03', 31.5400),
-    (1, 9, '2016-11-04', 31.5400),
-    (1, 9, '2016-11-07', 31.4500),
-    (1, 9, '2016-11-08', 31.4100),
-    (1, 9, '2016-11-09', 31.5300),
-    (1, 9, '2016-11-10', 31.7700),
-    (1, 9, '2016-11-11', NULL),
-    (1, 9, '2016-11-14', 31.9900),
-    (1, 9, '2016-11-15', 31.8300),
-    (1, 9, '2016-11-16', 31.8500),
-    (1, 9, '2016-11-17', 31.9300),
-    (1, 9, '2016-11-18', 32.0100),
-    (1, 9, '2016-11-21', 31.8900),
-    (1, 9, '2016-11-22', 31.8500),
-    (1, 9, '2016-11-23', 31.9900),
-    (1, 9, '2016-11-24', NULL),
-    (1, 9, '2016-11-25', 31.8500),
-    (1, 9, '2016-11-28', 31.7600),
-    (1, 9, '2016-11-29', 31.8200),
-    (1, 9, '2016-11-30', 31.9200),
-    (1, 9, '2016-12-01', 31.9300),
-    (1, 9, '2016-12-02', 31.8800),
-    (1, 9, '2016-12-05', 32.0100),
-    (1, 9, '2016-12-06', 31.9200),
-    (1, 9, '2016-12-07', 31.7200),
-    (1, 9, '2016-12-08', 31.7300),
-    (1, 9, '2016-12-09', 31.8000),
-    (1, 9, '2016-12-12', 31.7700),
-    (1, 9, '2016-12-13', 31.7700),
-    (1, 9, '2016-12-14', 31.7300),
-    (1, 9, '2016-12-15', 32.0000),
-    (1, 9, '2016-12-16', 31.9400),
-    (1, 9, '2016-12-19', 31.9800),
-    (1, 9, '2016-12-20', 32.0600),
-    (1, 9, '2016-12-21', 32.0500),
-    (1, 9, '2016-12-22', 32.1900),
-    (1, 9, '2016-12-23', 32.1800),
-    (1, 9, '2016-12-26', NULL),
-    (1, 9, '2016-12-27', 32.2700),
-    (1, 9, '2016-12-28', 32.4200),
-    (1, 9, '2016-12-29', 32.2600),
-    (1, 9, '2016-12-30', 32.4000),
-    (1, 9, '2017-01-02', NULL),
-    (1, 9, '2017-01-03', 32.3700),
-    (1, 9, '2017-01-04', 32.1600),
-    (1, 9, '2017-01-05', 31.7800),
-    (1, 9, '2017-01-06', 32.0000),
-    (1, 9, '2017-01-09', 32.0200),
-    (1, 9, '2017-01-10', 31.9200),
-    (1, 9, '2017-01-11', 31.9000),
-    (1, 9, '2017-01-12', 31.4900),
-    (1, 9, '2017-01-13', 31.6000),
-    (1, 9, '2017-01-16', NULL),
-    (1, 9, '2017-01-17', 31.3800),
-    (1, 9, '2017-01-18', 31.5300),
-    (1, 9, '2017-01-19', 31.6000),
-    (1, 9, '2017-01-20', NULL),
-    (1, 9, '2017-01-23', 31.4100),
-    (1, 9, '2017-01-24', 31.3100),
-    (1, 9, '2017-01-25', 31.3100),
-    (1, 9, '2017-01-26', 31.4200),
-    (1, 9, '2017-01-27', 31.5000),
-    (1, 9, '2017-01-30', 31.3800),
-    (1, 9, '2017-01-31', 31.1900),
-    (1, 9, '2017-02-01', 31.1700),
-    (1, 9, '2017-02-02', 31.0000),
-    (1, 9, '2017-02-03', 30.7900),
-    (1, 9, '2017-02-06', 30.8900),
-    (1, 9, '2017-02-07', 31.0600),
-    (1, 9, '2017-02-08', 31.0300),
-    (1, 9, '2017-02-09', 31.0600),
-    (1, 9, '2017-02-10', 30.9400),
-    (1, 9, '2017-02-13', 30.9600),
-    (1, 9, '2017-02-14', 30.7800),
-    (1, 9, '2017-02-15', 30.7300),
-    (1, 9, '2017-02-16', 30.7500),
-    (1, 9, '2017-02-17', 30.9000),
-    (1, 9, '2017-02-20', NULL),
-    (1, 9, '2017-02-21', 30.8000),
-    (1, 9, '2017-02-22', 30.7700),
-    (1, 9, '2017-02-23', 30.6100),
-    (1, 9, '2017-02-24', 30.6400),
-    (1, 9, '2017-02-27', 30.7200),
-    (1, 9, '2017-02-28', 30.6400),
-    (1, 9, '2017-03-01', 30.7600),
-    (1, 9, '2017-03-02', 30.8800),
-    (1, 9, '2017-03-03', 31.0300),
-    (1, 9, '2017-03-06', 30.9500),
-    (1, 9, '2017-03-07', 30.9200),
-    (1, 9, '2017-03-08', 30.9300),
-    (1, 9, '2017-03-09', 31.0100),
-    (1, 9, '2017-03-10', 30.9900),
-    (1, 9, '2017-03-13', 30.9900),
-    (1, 9, '2017-03-14', 30.9400),
-    (1, 9, '2017-03-15', 30.8300),
-    (1, 9, '2017-03-16', 30.9400),
-    (1, 9, '2017-03-17', 30.5200),
-    (1, 9, '2017-03-20', 30.3800),
-    (1, 9, '2017-03-21', 30.4300),
-    (1, 9, '2017-03-22', 30.4500),
-    (1, 9, '2017-03-23', 30.4600),
-    (1, 9, '2017-03-24', 30.3200),
-    (1, 9, '2017-03-27', 30.1400),
-    (1, 9, '2017-03-28', 30.1700),
-    (1, 9, '2017-03-29', 30.2100),
-    (1, 9, '2017-03-30', 30.2900),
-    (1, 9, '2017-03-31', 30.3800),
-    (1, 9, '2017-04-03', 30.3300),
-    (1, 9, '2017-04-04', 30.4700),
-    (1, 9, '2017-04-05', 30.4000),
-    (1, 9, '2017-04-06', 30.5100),
-    (1, 9, '2017-04-07', 30.5600),
-    (1, 9, '2017-04-10', 30.5700),
-    (1, 9, '2017-04-11', 30.6300),
-    (1, 9, '2017-04-12', 30.5500),
-    (1, 9, '2017-04-13', 30.3200),
-    (1, 9, '2017-04-14', 30.3100),
-    (1, 9, '2017-04-17', 30.3200),
-    (1, 9, '2017-04-18', 30.3900),
-    (1, 9, '2017-04-19', 30.4000),
-    (1, 9, '2017-04-20', 30.3700),
-    (1, 9, '2017-04-21', 30.3400),
-    (1, 9, '2017-04-24', 30.2300),
-    (1, 9, '2017-04-25', 30.0600),
-    (1, 9, '2017-04-26', 30.1000),
-    (1, 9, '2017-04-27', 30.1200),
-    (1, 9, '2017-04-28', 30.1900),
-    (1, 9, '2017-05-01', 30.1600),
-    (1, 9, '2017-05-02', 30.0200),
-    (1, 9, '2017-05-03', 30.0500),
-    (1, 9, '2017-05-04', 30.1200),
-    (1, 9, '2017-05-05', 30.1800),
-    (1, 9, '2017-05-08', 30.1300),
-    (1, 9, '2017-05-09', 30.2400),
-    (1, 9, '2017-05-10', 30.2000),
-    (1, 9, '2017-05-11', 30.1800),
-    (1, 9, '2017-05-12', 30.1500),
-    (1, 9, '2017-05-15', 30.0400),
-    (1, 9, '2017-05-16', 30.0900),
-    (1, 9, '2017-05-17', 30.1000),
-    (1, 9, '2017-05-18', 30.3000),
-    (1, 9, '2017-05-19', 30.0200),
-    (1, 9, '2017-05-22', 30.0100),
-    (1, 9, '2017-05-23', 30.1600),
-    (1, 9, '2017-05-24', 30.1500),
-    (1, 9, '2017-05-25', 30.0800),
-    (1, 9, '2017-05-26', 30.1800),
-    (1, 9, '2017-05-29', NULL),
-    (1, 9, '2017-05-30', 30.1500),
-    (1, 9, '2017-05-31', 30.1100),
-    (1, 9, '2017-06-01', 30.0900),
-    (1, 9, '2017-06-02', 30.0900),
-    (1, 9, '2017-06-05', 30.0700),
-    (1, 9, '2017-06-06', 30.0900),
-    (1, 9, '2017-06-07', 30.0900),
-    (1, 9, '2017-06-08', 30.1100),
-    (1, 9, '2017-06-09', 30.1200),
-    (1, 9, '2017-06-12', 30.2200),
-    (1, 9, '2017-06-13', 30.2300),
-    (1, 9, '2017-06-14', 30.0800),
-    (1, 9, '2017-06-15', 30.3500),
-    (1, 9, '2017-06-16', 30.3100),
-    (1, 9, '2017-06-19', 30.3700),
-    (1, 9, '2017-06-20', 30.4500),
-    (1, 9, '2017-06-21', 30.4400),
-    (1, 9, '2017-06-22', 30.4400),
-    (1, 9, '2017-06-23', 30.2900),
-    (1, 9, '2017-06-26', 30.3000),
-    (1, 9, '2017-06-27', 30.3200),
-    (1, 9, '2017-06-28', 30.4100),
-    (1, 9, '2017-06-29', 30.4600),
-    (1, 9, '2017-06-30', 30.3800),
-    (1, 9, '2017-07-03', 30.4900),
-    (1, 9, '2017-07-04', NULL),
-    (1, 9, '2017-07-05', 30.5600),
-    (1, 9, '2017-07-06', 30.6100),
-    (1, 9, '2017-07-07', 30.5600),
-    (1, 9, '2017-07-10', 30.5600),
-    (1, 9, '2017-07-11', 30.5300),
-    (1, 9, '2017-07-12', 30.3800),
-    (1, 9, '2017-07-13', 30.3600),
-    (1, 9, '2017-07-14', 30.2600),
-    (1, 9, '2017-07-17', 30.3700),
-    (1, 9, '2017-07-18', 30.3400),
-    (1, 9, '2017-07-19', 30.3700),
-    (1, 9, '2017-07-20', 30.3800),
-    (1, 9, '2017-07-21', 30.4100),
-    (1, 9, '2017-07-24', 30.3400),
-    (1, 9, '2017-07-25', 30.3200),
-    (1, 9, '2017-07-26', 30.3600),
-    (1, 9, '2017-07-27', 30.1800),
-    (1, 9, '2017-07-28', 30.2600),
-    (1, 9, '2017-07-31', 30.2000),
-    (1, 9, '2017-08-01', 30.2400),
-    (1, 9, '2017-08-02', 30.2200),
-    (1, 9, '2017-08-03', 30.1900),
-    (1, 9, '2017-08-04', 30.1800),
-    (1, 9, '2017-08-07', 30.2100),
-    (1, 9, '2017-08-08', 30.1800),
-    (1, 9, '2017-08-09', 30.2400),
-    (1, 9, '2017-08-10', 30.3400),
-    (1, 9, '2017-08-11', 30.3500),
-    (1, 9, '2017-08-14', 30.2800),
-    (1, 9, '2017-08-15', 30.3200),
-    (1, 9, '2017-08-16', 30.3200),
-    (1, 9, '2017-08-17', 30.3200),
-    (1, 9, '2017-08-18', 30.3300),
-    (1, 9, '2017-08-21', 30.3100),
-    (1, 9, '2017-08-22', 30.2800),
-    (1, 9, '2017-08-23', 30.2600),
-    (1, 9, '2017-08-24', 30.2400),
-    (1, 9, '2017-08-25', 30.0900),
-    (1, 9, '2017-08-28', 30.1300),
-    (1, 9, '2017-08-29', 30.0700),
-    (1, 9, '2017-08-30', 30.1300),
-    (1, 9, '2017-08-31', 30.1300),
-    (1, 9, '2017-09-01', 30.0600),
-    (1, 9, '2017-09-04', NULL),
-    (1, 9, '2017-09-05', 30.0500),
-    (1, 9, '2017-09-06', 30.0800),
-    (1, 9, '2017-09-07', 29.9300),
-    (1, 9, '2017-09-08', 30.0500),
-    (1, 9, '2017-09-11', 30.0100),
-    (1, 9, '2017-09-12', 30.0400),
-    (1, 9, '2017-09-13', 30.0500),
-    (1, 9, '2017-09-14', 30.1000),
-    (1, 9, '2017-09-15', 30.0600),
-    (1, 9, '2017-09-18', 30.1400),
-    (1, 9, '2017-09-19', 30.1200),
-    (1, 9, '2017-09-20', 30.0800),
-    (1, 9, '2017-09-21', 30.1900),
-    (1, 9, '2017-09-22', 30.1600),
-    (1, 9, '2017-09-25', 30.2500),
-    (1, 9, '2017-09-26', 30.2300),
-    (1, 9, '2017-09-27', 30.3100),
-    (1, 9, '2017-09-28', 30.3700),
-    (1, 9, '2017-09-29', 30.3300),
-    (1, 9, '2017-10-02', 30.3800),
-    (1, 9, '2017-10-03', 30.4400),
-    (1, 9, '2017-10-04', 30.4300),
-    (1, 9, '2017-10-05', 30.3300),
-    (1, 9, '2017-10-06', 30.3600),
-    (1, 9, '2017-10-09', NULL),
-    (1, 9, '2017-10-10', 30.2800),
-    (1, 9, '2017-10-11', 30.2300),
-    (1, 9, '2017-10-12', 30.1700),
-    (1, 9, '2017-10-13', 30.1300),
-    (1, 9, '2017-10-16', 30.1400),
-    (1, 9, '2017-10-17', 30.1800),
-    (1, 9, '2017-10-18', 30.2000),
-    (1, 9, '2017-10-19', 30.2300),
-    (1, 9, '2017-10-20', 30.2200),
-    (1, 9, '2017-10-23', 30.2500),
-    (1, 9, '2017-10-24', 30.2800),
-    (1, 9, '2017-10-25', 30.2400),
-    (1, 9, '2017-10-26', 30.2200),
-    (1, 9, '2017-10-27', 30.2300),
-    (1, 9, '2017-10-30', 30.2300),
-    (1, 9, '2017-10-31', 30.1200),
-    (1, 9, '2017-11-01', 30.1300),
-    (1, 9, '2017-11-02', 30.1900),
-    (1, 9, '2017-11-03', 30.2100),
-    (1, 9, '2017-11-06', 30.1600),
-    (1, 9, '2017-11-07', 30.1900),
-    (1, 9, '2017-11-08', 30.1500),
-    (1, 9, '2017-11-09', 30.2000),
-    (1, 9, '2017-11-10', NULL),
-    (1, 9, '2017-11-13', 30.1800),
-    (1, 9, '2017-11-14', 30.1300),
-    (1, 9, '2017-11-15', 30.0600),
-    (1, 9, '2017-11-16', 30.0500),
-    (1, 9, '2017-11-17', 30.0500),
-    (1, 9, '2017-11-20', 30.0500),
-    (1, 9, '2017-11-21', 29.9700),
-    (1, 9, '2017-11-22', 29.9700),
-    (1, 9, '2017-11-23', NULL),
-    (1, 9, '2017-11-24', 29.9800),
-    (1, 9, '2017-11-27', 30.0200),
-    (1, 9, '2017-11-28', 29.9800),
-    (1, 9, '2017-11-29', 30.0200),
-    (1, 9, '2017-11-30', 29.9800),
-    (1, 9, '2017-12-01', 30.0200),
-    (1, 9, '2017-12-04', 29.9800),
-    (1, 9, '2017-12-05', 30.0300),
-    (1, 9, '2017-12-06', 30.0400),
-    (1, 9, '2017-12-07', 30.0300),
-    (1, 9, '2017-12-08', 30.0200),
-    (1, 9, '2017-12-11', 30.0200),
-    (1, 9, '2017-12-12', 30.0500),
-    (1, 9, '2017-12-13', 30.0100),
-    (1, 9, '2017-12-14', 30.0000),
-    (1, 9, '2017-12-15', 29.9500),
-    (1, 9, '2017-12-18', 29.9500),
-    (1, 9, '2017-12-19', 29.9500),
-    (1, 9, '2017-12-20', 29.9600),
-    (1, 9, '2017-12-21', 29.9300),
-    (1, 9, '2017-12-22', 29.8900),
-    (1, 9, '2017-12-25', NULL),
-    (1, 9, '2017-12-26', 29.8900),
-    (1, 9, '2017-12-27', 29.9500),
-    (1, 9, '2017-12-28', 29.7800),
-    (1, 9, '2017-12-29', 29.6400),
-    (1, 9, '2018-01-01', NULL),
-    (1, 9, '2018-01-02', 29.5800),
-    (1, 9, '2018-01-03', 29.5700),
-    (1, 9, '2018-01-04', 29.6000),
-    (1, 9, '2018-01-05', 29.4700),
-    (1, 9, '2018-01-08', 29.5200),
-    (1, 9, '2018-01-09', 29.6100),
-    (1, 9, '2018-01-10', 29.5800),
-    (1, 9, '2018-01-11', 29.5800),
-    (1, 9, '2018-01-12', 29.6000),
-    (1, 9, '2018-01-15', NULL),
-    (1, 9, '2018-01-16', 29.5700),
-    (1, 9, '2018-01-17', 29.5700),
-    (1, 9, '2018-01-18', 29.4800),
-    (1, 9, '2018-01-19', 29.3100),
-    (1, 9, '2018-01-22', 29.2500),
-    (1, 9, '2018-01-23', 29.2300),
-    (1, 9, '2018-01-24', 29.1100),
-    (1, 9, '2018-01-25', 29.0500),
-    (1, 9, '2018-01-26', 29.1200),
-    (1, 9, '2018-01-29', 29.1900),
-    (1, 9, '2018-01-30', 29.2600),
-    (1, 9, '2018-01-31', 29.1600),
-    (1, 9, '2018-02-01', 29.2100),
-    (1, 9, '2018-02-02', 29.3200),
-    (1, 9, '2018-02-05', 29.3200),
-    (1, 9, '2018-02-06', 29.2700),
-    (1, 9, '2018-02-07', 29.2400),
-    (1, 9, '2018-02-08', 29.4200),
-    (1, 9, '2018-02-09', 29.3500),
-    (1, 9, '2018-02-12', 29.3400),
-    (1, 9, '2018-02-13', 29.2900),
-    (1, 9, '2018-02-14', 29.1300),
-    (1, 9, '2018-02-15', 29.0300),
-    (1, 9, '2018-02-16', 29.0800),
-    (1, 9, '2018-02-19', NULL),
-    (1, 9, '2018-02-20', 29.1300),
-    (1, 9, '2018-02-21', 29.1900),
-    (1, 9, '2018-02-22', 29.2500),
-    (1, 9, '2018-02-23', 29.2700),
-    (1, 9, '2018-02-26', 29.22),
-    (1, 9, '2018-02-27', 29.29),
-    (1, 9, '2018-02-28', 29.32),
-    (1, 9, '2018-03-01', 29.31),
-    (1, 9, '2018-03-02', 29.29),
-    (1, 9, '2018-03-05', 29.27),
-    (1, 9, '2018-03-06', 29.21),
-    (1, 9, '2018-03-07', 29.29),
-    (1, 9, '2018-03-08', 29.35),
-    (1, 9, '2018-03-09', 29.24),
-    (1, 9, '2018-03-12', 29.25),
-    (1, 9, '2018-03-13', 29.22),
-    (1, 9, '2018-03-14', 29.23),
-    (1, 9, '2018-03-15', 29.15),
-    (1, 9, '2018-03-16', 29.13),
-    (1, 9, '2018-03-19', 29.17),
-    (1, 9, '2018-03-20', 29.16),
-    (1, 9, '2018-03-21', 29.16),
-    (1, 9, '2018-03-22', 29.19),
-    (1, 9, '2018-03-23', 29.18),
-    (1, 9, '2018-03-26', 29.1),
-    (1, 9, '2018-03-27', 29.13),
-    (1, 9, '2018-03-28', 29.12),
-    (1, 9, '2018-03-29', 29.13),
-    (1, 9, '2018-03-30', 29.1),
-    (1, 9, '2018-04-02', 29.14),
-    (1, 9, '2018-04-03', 29.16),
-    (1, 9, '2018-04-04', 29.23),
-    (1, 9, '2018-04-05', 29.24),
-    (1, 9, '2018-04-06', 29.29),
-    (1, 9, '2018-04-09', 29.24),
-    (1, 9, '2018-04-10', 29.2),
-    (1, 9, '2018-04-11', 29.2),
-    (1, 9, '2018-04-12', 29.31),
-    (1, 9, '2018-04-13', 29.32),
-    (1, 9, '2018-04-16', 29.35),
-    (1, 9, '2018-04-17', 29.36),
-    (1, 9, '2018-04-18', 29.31),
-    (1, 9, '2018-04-19', 29.39),
-    (1, 9, '2018-04-20', 29.48),
-    (1, 9, '2018-04-23', 29.58),
-    (1, 9, '2018-04-24', 29.57),
-    (1, 9, '2018-04-25', 29.72),
-    (1, 9, '2018-04-26', 29.68),
-    (1, 9, '2018-04-27', 29.54),
-    (1, 9, '2018-04-30', 29.6),
-    (1, 9, '2018-05-01', 29.68),
-    (1, 9, '2018-05-02', 29.76),
-    (1, 9, '2018-05-03', 29.71),
-    (1, 9, '2018-05-04', 29.7),
-    (1, 9, '2018-05-07', 29.82),
-    (1, 9, '2018-05-08', 29.8),
-    (1, 9, '2018-05-09', 29.92),
-    (1, 9, '2018-05-10', 29.75),
-    (1, 9, '2018-05-11', 29.77),
-    (1, 9, '2018-05-14', 29.77),
-    (1, 9, '2018-05-15', 29.89),
-    (1, 9, '2018-05-16', 29.87),
-    (1, 9, '2018-05-17', 29.93),
-    (1, 9, '2018-05-18', 29.95),
-    (1, 9, '2018-05-21', 29.98),
-    (1, 9, '2018-05-22', 29.84),
-    (1, 9, '2018-05-23', 29.98),
-    (1, 9, '2018-05-24', 29.97),
-    (1, 9, '2018-05-25', 29.94),
-    (1, 9, '2018-05-28', NULL),
-    (1, 9, '2018-05-29', 30.09),
-    (1, 9, '2018-05-30', 29.97),
-    (1, 9, '2018-05-31', 29.99),
-    (1, 9, '2018-06-01', 29.82),
-    (1, 9, '2018-06-04', 29.77),
-    (1, 9, '2018-06-05', 29.84),
-    (1, 9, '2018-06-06', 29.7),
-    (1, 9, '2018-06-07', 29.75),
-    (1, 9, '2018-06-08', 29.87),
-    (1, 9, '2018-06-11', 29.82),
-    (1, 9, '2018-06-12', 29.83),
-    (1, 9, '2018-06-13', 29.82),
-    (1, 9, '2018-06-14', 29.91),
-    (1, 9, '2018-06-15', 30.13),
-    (1, 9, '2018-06-18', 30.18),
-    (1, 9, '2018-06-19', 30.16),
-    (1, 9, '2018-06-20', 30.18),
-    (1, 9, '2018-06-21', 30.33),
-    (1, 9, '2018-06-22', 30.35),
-    (1, 9, '2018-06-25', 30.39),
-    (1, 9, '2018-06-26', 30.41),
-    (1, 9, '2018-06-27', 30.51),
-    (1, 9, '2018-06-28', 30.58),
-    (1, 9, '2018-06-29', 30.43),
-    (1, 9, '2018-07-02', 30.56),
-    (1, 9, '2018-07-03', 30.52),
-    (1, 9, '2018-07-04', NULL),
-    (1, 9, '2018-07-05', 30.53),
-    (1, 9, '2018-07-06', 30.46),
-    (1, 9, '2018-07-09', 30.36),
-    (1, 9, '2018-07-10', 30.38),
-    (1, 9, '2018-07-11', 30.56),
-    (1, 9, '2018-07-12', 30.46),
-    (1, 9, '2018-07-13', 30.58),
-    (1, 9, '2018-07-16', 30.54),
-    (1, 9, '2018-07-17', 30.53),
-    (1, 9, '2018-07-18', 30.61),
-    (1, 9, '2018-07-19', 30.69),
-    (1, 9, '2018-07-20', 30.59),
-    (1, 9, '2018-07-23', 30.64),
-    (1, 9, '2018-07-24', 30.63),
-    (1, 9, '2018-07-25', 30.51),
-    (1, 9, '2018-07-26', 30.58),
-    (1, 9, '2018-07-27', 30.56),
-    (1, 9, '2018-07-30', 30.57),
-    (1, 9, '2018-07-31', 30.54),
-    (1, 9, '2018-08-01', 30.63),
-    (1, 9, '2018-08-02', 30.71),
-    (1, 9, '2018-08-03', 30.57),
-    (1, 9, '2018-08-06', 30.63),
-    (1, 9, '2018-08-07', 30.56),
-    (1, 9, '2018-08-08', 30.6),
-    (1, 9, '2018-08-09', 30.64),
-    (1, 9, '2018-08-10', 30.77),
-    (1, 9, '2018-08-13', 30.83),
-    (1, 9, '2018-08-14', 30.83),
-    (1, 9, '2018-08-15', 30.85),
-    (1, 9, '2018-08-16', 30.76),
-    (1, 9, '2018-08-17', 30.76),
-    (1, 9, '2018-08-20', 30.76),
-    (1, 9, '2018-08-21', 30.7),
-    (1, 9, '2018-08-22', 30.66),
-    (1, 9, '2018-08-23', 30.81),
-    (1, 9, '2018-08-24', 30.66),
-    (1, 9, '2018-08-27', 30.68),
-    (1, 9, '2018-08-28', 30.64),
-    (1, 9, '2018-08-29', 30.71),
-    (1, 9, '2018-08-30', 30.73),
-    (1, 9, '2018-08-31', 30.7),
-    (1, 9, '2018-09-03', NULL),
-    (1, 9, '2018-09-04', 30.79),
-    (1, 9, '2018-09-05', 30.79),
-    (1, 9, '2018-09-06', 30.78),
-    (1, 9, '2018-09-07', 30.77),
-    (1, 9, '2018-09-10', 30.84),
-    (1, 9, '2018-09-11', 30.81),
-    (1, 9, '2018-09-12', 30.74),
-    (1, 9, '2018-09-13', 30.79),
-    (1, 9, '2018-09-14', 30.77),
-    (1, 9, '2018-09-17', 30.77),
-    (1, 9, '2018-09-18', 30.79),
-    (1, 9, '2018-09-19', 30.75),
-    (1, 9, '2018-09-20', 30.76),
-    (1, 9, '2018-09-21', 30.65),
-    (1, 9, '2018-09-24', 30.69),
-    (1, 9, '2018-09-25', 30.66),
-    (1, 9, '2018-09-26', 30.65),
-    (1, 9, '2018-09-27', 30.52),
-    (1, 9, '2018-09-28', 30.46),
-    (1, 9, '2018-10-01', 30.54),
-    (1, 9, '2018-10-02', 30.55),
-    (1, 9, '2018-10-03', 30.72),
-    (1, 9, '2018-10-04', 30.84),
-    (1, 9, '2018-10-05', 30.91),
-    (1, 9, '2018-10-08', NULL),
-    (1, 9, '2018-10-09', 30.97),
-    (1, 9, '2018-10-10', 30.99),
-    (1, 9, '2018-10-11', 31.0),
-    (1, 9, '2018-10-12', 30.89),
-    (1, 9, '2018-10-15', 30.85),
-    (1, 9, '2018-10-16', 30.82),
-    (1, 9, '2018-10-17', 30.83),
-    (1, 9, '2018-10-18', 30.98),
-    (1, 9, '2018-10-19', 30.88),
-    (1, 9, '2018-10-22', 30.92),
-    (1, 9, '2018-10-23', 30.97),
-    (1, 9, '2018-10-24', 30.99),
-    (1, 9, '2018-10-25', 30.93),
-    (1, 9, '2018-10-26', 31.0),
-    (1, 9, '2018-10-29', 30.96),
-    (1, 9, '2018-10-30', 30.93),
-    (1, 9, '2018-10-31', 30.94),
-    (1, 9, '2018-11-01', 30.75),
-    (1, 9, '2018-11-02', 30.57),
-    (1, 9, '2018-11-05', 30.7),
-    (1, 9, '2018-11-06', 30.79),
-    (1, 9, '2018-11-07', 30.64),
-    (1, 9, '2018-11-08', 30.68),
-    (1, 9, '2018-11-09', 30.79),
-    (1, 9, '2018-11-12', NULL),
-    (1, 9, '2018-11-13', 30.88),
-    (1, 9, '2018-11-14', 30.88),
-    (1, 9, '2018-11-15', 30.81),
-    (1, 9, '2018-11-16', 30.8),
-    (1, 9, '2018-11-19', 30.89),
-    (1, 9, '2018-11-20', 30.94),
-    (1, 9, '2018-11-21', 30.84),
-    (1, 9, '2018-11-22', NULL),
-    (1, 9, '2018-11-23', 30.93),
-    (1, 9, '2018-11-26', 30.85),
-    (1, 9, '2018-11-27', 30.9),
-    (1, 9, '2018-11-28', 30.86),
-    (1, 9, '2018-11-29', 30.79),
-    (1, 9, '2018-11-30', 30.83),
-    (1, 9, '2018-12-03', 30.67),
-    (1, 9, '2018-12-04', 30.71),
-    (1, 9, '2018-12-05', NULL),
-    (1, 9, '2018-12-06', 30.91),
-    (1, 9, '2018-12-07', 30.92),
-    (1, 9, '2018-12-10', 30.9),
-    (1, 9, '2018-12-11', 30.86),
-    (1, 9, '2018-12-12', 30.79),
-    (1, 9, '2018-12-13', 30.84),
-    (1, 9, '2018-12-14', 30.86),
-    (1, 9, '2018-12-17', 30.8),
-    (1, 9, '2018-12-18', 30.81),
-    (1, 9, '2018-12-19', 30.72),
-    (1, 9, '2018-12-20', 30.78),
-    (1, 9, '2018-12-21', 30.74),
-    (1, 9, '2018-12-24', NULL),
-    (1, 9, '2018-12-25', NULL),
-    (1, 9, '2018-12-26', 30.81),
-    (1, 9, '2018-12-27', 30.8),
-    (1, 9, '2018-12-28', 30.55),
-    (1, 9, '2018-12-31', 30.61),
-    (1, 9, '2019-01-01', NULL),
-    (1, 9, '2019-01-02', 30.78),
-    (1, 9, '2019-01-03', 30.86),
-    (1, 9, '2019-01-04', 30.8),
-    (1, 9, '2019-01-07', 30.8),
-    (1, 9, '2019-01-08', 30.84),
-    (1, 9, '2019-01-09', 30.77),
-    (1, 9, '2019-01-10', 30.79),
-    (1, 9, '2019-01-11', 30.79),
-    (1, 9, '2019-01-14', NULL),
-    (1, 9, '2019-01-15', 30.82),
-    (1, 9, '2019-01-16', 30.82),
-    (1, 9, '2019-01-17', 30.86),
-    (1, 9, '2019-01-18', 30.86),
-    (1, 9, '2019-01-21', NULL),
-    (1, 9, '2019-01-22', 30.89),
-    (1, 9, '2019-01-23', 30.86),
-    (1, 9, '2019-01-24', 30.87),
-    (1, 9, '2019-01-25', 30.71),
-    (1, 9, '2019-01-28', 30.78),
-    (1, 9, '2019-01-29', 30.8),
-    (1, 9, '2019-01-30', 30.77),
-    (1, 9, '2019-01-31', 30.69),
-    (1, 9, '2019-02-01', 30.78),
-    (1, 9, '2019-02-04', 30.77),
-    (1, 9, '2019-02-05', 30.77),
-    (1, 9, '2019-02-06', 30.78),
-    (1, 9, '2019-02-07', 30.81),
-    (1, 9, '2019-02-08', 30.82),
-    (1, 9, '2019-02-11', 30.88),
-    (1, 9, '2019-02-12', 30.82),
-    (1, 9, '2019-02-13', 30.84),
-    (1, 9, '2019-02-14', 30.86),
-    (1, 9, '2019-02-15', 30.82),
-    (1, 9, '2019-02-18', NULL),
-    (1, 9, '2019-02-19', 30.81),
-    (1, 9, '2019-02-20', NULL),
-    (1, 9, '2019-02-21', 30.8),
-    (1, 9, '2019-02-22', 30.71),
-    (1, 9, '2019-02-25', 30.74),
-    (1, 9, '2019-02-26', 30.76),
-    (1, 9, '2019-02-27', 30.78),
-    (1, 9, '2019-02-28', 30.83),
-    (1, 9, '2019-03-01', 30.84),
-    (1, 9, '2019-03-04', 30.83),
-    (1, 9, '2019-03-05', 30.84),
-    (1, 9, '2019-03-06', 30.84),
-    (1, 9, '2019-03-07', 30.91),
-    (1, 9, '2019-03-08', 30.88),
-    (1, 9, '2019-03-11', 30.91),
-    (1, 9, '2019-03-12', 30.91),
-    (1, 9, '2019-03-13', 30.9),
-    (1, 9, '2019-03-14', 30.92),
-    (1, 9, '2019-03-15', 30.85),
-    (1, 9, '2019-03-18', 30.78),
-    (1, 9, '2019-03-19', 30.8),
-    (1, 9, '2019-03-20', 30.8),
-    (1, 9, '2019-03-21', 30.82),
-    (1, 9, '2019-03-22', 30.87),
-    (1, 9, '2019-03-25', 30.82),
-    (1, 9, '2019-03-26', 30.84),
-    (1, 9, '2019-03-27', 30.89),
-    (1, 9, '2019-03-28', 30.88),
-    (1, 9, '2019-03-29', 30.86),
-    (1, 9, '2019-04-01', 30.8),
-    (1, 9, '2019-04-02', 30.84),
-    (1, 9, '2019-04-03', 30.8),
-    (1, 9, '2019-04-04', 30.81),
-    (1, 9, '2019-04-05', 30.82),
-    (1, 9, '2019-04-08', 30.82),
-    (1, 9, '2019-04-09', 30.83),
-    (1, 9, '2019-04-10', 30.83),
-    (1, 9, '2019-04-11', 30.85),
-    (1, 9, '2019-04-12', 30.84),
-    (1, 9, '2019-04-15', 30.85),
-    (1, 9, '2019-04-16', 30.85),
-    (1, 9, '2019-04-17', 30.79),
-    (1, 9, '2019-04-18', 30.83),
-    (1, 9, '2019-04-19', 30.82),
-    (1, 9, '2019-04-22', 30.84),
-    (1, 9, '2019-04-23', 30.86),
-    (1, 9, '2019-04-24', 30.89),
-    (1, 9, '2019-04-25', 30.94),
-    (1, 9, '2019-04-26', 30.9),
-    (1, 9, '2019-04-29', 30.91),
-    (1, 9, '2019-04-30', 30.91),
-    (1, 9, '2019-05-01', 30.89),
-    (1, 9, '2019-05-02', 30.92),
-    (1, 9, '2019-05-03', 30.87),
-    (1, 9, '2019-05-06', 30.92),
-    (1, 9, '2019-05-07', 30.95),
-    (1, 9, '2019-05-08', 30.93),
-    (1, 9, '2019-05-09', 31.01),
-    (1, 9, '2019-05-10', 30.95),
-    (1, 9, '2019-05-13', 31.16),
-    (1, 9, '2019-05-14', 31.07),
-    (1, 9, '2019-05-15', 31.11),
-    (1, 9, '2019-05-16', 31.11),
-    (1, 9, '2019-05-17', 31.31),
-    (1, 9, '2019-05-20', 31.4),
-    (1, 9, '2019-05-21', 31.48),
-    (1, 9, '2019-05-22', 31.52),
-    (1, 9, '2019-05-23', 31.52),
-    (1, 9, '2019-05-24', 31.52),
-    (1, 9, '2019-05-27', NULL),
-    (1, 9, '2019-05-28', 31.47),
-    (1, 9, '2019-05-29', 31.58),
-    (1, 9, '2019-05-30', 31.6),
-    (1, 9, '2019-05-31', 31.59),
-    (1, 9, '2019-06-03', 31.41),
-    (1, 9, '2019-06-04', 31.46),
-    (1, 9, '2019-06-05', 31.35),
-    (1, 9, '2019-06-06', 31.32),
-    (1, 9, '2019-06-07', 31.42),
-    (1, 9, '2019-06-10', 31.43),
-    (1, 9, '2019-06-11', 31.39),
-    (1, 9, '2019-06-12', 31.41),
-    (1, 9, '2019-06-13', 31.48),
-    (1, 9, '2019-06-14', 31.51),
-    (1, 9, '2019-06-17', 31.51),
-    (1, 9, '2019-06-18', 31.35),
-    (1, 9, '2019-06-19', 31.51),
-    (1, 9, '2019-06-20', 30.99),
-    (1, 9, '2019-06-21', 31.02),
-    (1, 9, '2019-06-24', 31.03),
-    (1, 9, '2019-06-25', 31.09),
-    (1, 9, '2019-06-26', 31.05),
-    (1, 9, '2019-06-27', 31.05),
-    (1, 9, '2019-06-28', 31.01),
-    (1, 9, '2019-07-01', 30.97),
-    (1, 9, '2019-07-02', 31.04),
-    (1, 9, '2019-07-03', 31.08),
-    (1, 9, '2019-07-04', NULL),
-    (1, 9, '2019-07-05', 31.18),
-    (1, 9, '2019-07-08', 31.17),
-    (1, 9, '2019-07-09', 31.17),
-    (1, 9, '2019-07-10', 31.13),
-    (1, 9, '2019-07-11', 31.02),
-    (1, 9, '2019-07-12', 31.07),
-    (1, 9, '2019-07-15', 31.04),
-    (1, 9, '2019-07-16', 31.04),
-    (1, 9, '2019-07-17', 31.07),
-    (1, 9, '2019-07-18', 31.04),
-    (1, 9, '2019-07-19', 31.04),
-    (1, 9, '2019-07-22', 31.07),
-    (1, 9, '2019-07-23', 31.07),
-    (1, 9, '2019-07-24', 31.08),
-    (1, 9, '2019-07-25', 31.08),
-    (1, 9, '2019-07-26', 31.08),
-    (1, 9, '2019-07-29', 31.1),
-    (1, 9, '2019-07-30', 31.06),
-    (1, 9, '2019-07-31', 31.09),
-    (1, 9, '2019-08-01', 31.15),
-    (1, 9, '2019-08-02', 31.38),
-    (1, 9, '2019-08-05', 31.63),
-    (1, 9, '2019-08-06', 31.48),
-    (1, 9, '2019-08-07', 31.51),
-    (1, 9, '2019-08-08', 31.34),
-    (1, 9, '2019-08-09', 31.41),
-    (1, 9, '2019-08-12', 31.4),
-    (1, 9, '2019-08-13', 31.5),
-    (1, 9, '2019-08-14', 31.36),
-    (1, 9, '2019-08-15', 31.33),
-    (1, 9, '2019-08-16', 31.3),
-    (1, 9, '2019-08-19', 31.36),
-    (1, 9, '2019-08-20', 31.39),
-    (1, 9, '2019-08-21', 31.33),
-    (1, 9, '2019-08-22', 31.36),
-    (1, 9, '2019-08-23', 31.43),
-    (1, 9, '2019-08-26', 31.41),
-    (1, 9, '2019-08-27', 31.39),
-    (1, 9, '2019-08-28', 31.43),
-    (1, 9, '2019-08-29', 31.34),
-    (1, 9, '2019-08-30', 31.32),
-    (1, 9, '2019-09-02', NULL),
-    (1, 9, '2019-09-03', 31.38),
-    (1, 9, '2019-09-04', 31.3),
-    (1, 9, '2019-09-05', 31.26),
-    (1, 9, '2019-09-06', 31.22),
-    (1, 9, '2019-09-09', 31.21),
-    (1, 9, '2019-09-10', 31.22),
-    (1, 9, '2019-09-11', 31.13),
-    (1, 9, '2019-09-12', 31.03),
-    (1, 9, '2019-09-13', 30.88),
-    (1, 9, '2019-09-16', 30.9),
-    (1, 9, '2019-09-17', 30.95),
-    (1, 9, '2019-09-18', 30.95),
-    (1, 9, '2019-09-19', 30.98),
-    (1, 9, '2019-09-20', 30.93),
-    (1, 9, '2019-09-23', 30.98),
-    (1, 9, '2019-09-24', 31.01),
-    (1, 9, '2019-09-25', 31.04),
-    (1, 9, '2019-09-26', 31.02),
-    (1, 9, '2019-09-27', 31.05),
-    (1, 9, '2019-09-30', 31.05),
-    (1, 9, '2019-10-01', 31.07),
-    (1, 9, '2019-10-02', 31.06),
-    (1, 9, '2019-10-03', 30.97),
-    (1, 9, '2019-10-04', 30.92),
-    (1, 9, '2019-10-07', 30.89),
-    (1, 9, '2019-10-08', 30.81),
-    (1, 9, '2019-10-09', 30.83),
-    (1, 9, '2019-10-10', 30.75),
-    (1, 9, '2019-10-11', 30.75),
-    (1, 9, '2019-10-14', NULL),
-    (1, 9, '2019-10-15', 30.62),
-    (1, 9, '2019-10-16', 30.69),
-    (1, 9, '2019-10-17', 30.63),
-    (1, 9, '2019-10-18', 30.59),
-    (1, 9, '2019-10-21', 30.56),
-    (1, 9, '2019-10-22', 30.58),
-    (1, 9, '2019-10-23', 30.6),
-    (1, 9, '2019-10-24', 30.57),
-    (1, 9, '2019-10-25', 30.59),
-    (1, 9, '2019-10-28', 30.56),
-    (1, 9, '2019-10-29', 30.52),
-    (1, 9, '2019-10-30', 30.43),
-    (1, 9, '2019-10-31', 30.44),
-    (1, 9, '2019-11-01', 30.45),
-    (1, 9, '2019-11-04', 30.42),
-    (1, 9, '2019-11-05', 30.39),
-    (1, 9, '2019-11-06', 30.39),
-    (1, 9, '2019-11-07', 30.35),
-    (1, 9, '2019-11-08', 30.37),
-    (1, 9, '2019-11-11', NULL),
-    (1, 9, '2019-11-12', 30.41),
-    (1, 9, '2019-11-13', 30.5),
-    (1, 9, '2019-11-14', 30.55),
-    (1, 9, '2019-11-15', 30.5),
-    (1, 9, '2019-11-18', 30.5),
-    (1, 9, '2019-11-19', 30.5),
-    (1, 9, '2019-11-20', 30.5),
-    (1, 9, '2019-11-21', 30.52),
-    (1, 9, '2019-11-22', 30.51),
-    (1, 9, '2019-11-25', 30.51),
-    (1, 9, '2019-11-26', 30.51),
-    (1, 9, '2019-11-27', 30.5),
-    (1, 9, '2019-11-28', NULL),
-    (1, 9, '2019-11-29', 30.5),
-    (1, 9, '2019-12-02', 30.51),
-    (1, 9, '2019-12-03', 30.5),
-    (1, 9, '2019-12-04', 30.5),
-    (1, 9, '2019-12-05', 30.48),
-    (1, 9, '2019-12-06', 30.48),
-    (1, 9, '2019-12-09', 30.47),
-    (1, 9, '2019-12-10', 30.47),
-    (1, 9, '2019-12-11', 30.45),
-    (1, 9, '2019-12-12', 30.22),
-    (1, 9, '2019-12-13', 30.24),
-    (1, 9, '2019-12-16', 30.11),
-    (1, 9, '2019-12-17', 30.13),
-    (1, 9, '2019-12-18', 30.15),
-    (1, 9, '2019-12-19', 30.16),
-    (1, 9, '2019-12-20', 30.14),
-    (1, 9, '2019-12-23', 30.14),
-    (1, 9, '2019-12-24', 30.14),
-    (1, 9, '2019-12-25', NULL),
-    (1, 9, '2019-12-26', 30.11),
-    (1, 9, '2019-12-27', 30.09),
-    (1, 9, '2019-12-30', 30.04),
-    (1, 9, '2019-12-31', 29.91),
-    (1, 9, '2020-01-01', NULL),
-    (1, 9, '2020-01-02', 29.99),
-    (1, 9, '2020-01-03', 30.03),
-    (1, 9, '2020-01-06', 30.06),
-    (1, 9, '2020-01-07', 30.05),
-    (1, 9, '2020-01-08', 30.02),
-    (1, 9, '2020-01-09', 29.95),
-    (1, 9, '2020-01-10', 29.97),
-    (1, 9, '2020-01-13', 29.88),
-    (1, 9, '2020-01-14', 29.89),
-    (1, 9, '2020-01-15', 29.91),
-    (1, 9, '2020-01-16', 29.93),
-    (1, 9, '2020-01-17', 29.93),
-    (1, 9, '2020-01-20', NULL),
-    (1, 9, '2020-01-21', 29.98),
-    (1, 9, '2020-01-22', 29.97),
-    (1, 9, '2020-01-23', 30.04),
-    (1, 9, '2020-01-24', 30.03),
-    (1, 9, '2020-01-27', 30.1),
-    (1, 9, '2020-01-28', 30.1),
-    (1, 9, '2020-01-29', 30.1),
-    (1, 9, '2020-01-30', 30.26),
-    (1, 9, '2020-01-31', 30.23),
-    (1, 9, '2020-02-03', 30.29),
-    (1, 9, '2020-02-04', 30.12),
-    (1, 9, '2020-02-05', 30.0),
-    (1, 9, '2020-02-06', 30.04),
-    (1, 9, '2020-02-07', 30.1),
-    (1, 9, '2020-02-10', 30.08),
-    (1, 9, '2020-02-11', 30.02),
-    (1, 9, '2020-02-12', 29.98),
-    (1, 9, '2020-02-13', 29.98),
-    (1, 9, '2020-02-14', 30.02),
-    (1, 9, '2020-02-17', NULL),
-    (1, 9, '2020-02-18', 30.11),
-    (1, 9, '2020-02-19', 30.11),
-    (1, 9, '2020-02-20', 30.26),
-    (1, 9, '2020-02-21', 30.4),
-    (1, 9, '2020-02-24', 30.45),
-    (1, 9, '2020-02-25', 30.34),
-    (1, 9, '2020-02-26', 30.28),
-    (1, 9, '2020-02-27', 30.23),
-    (1, 9, '2020-02-28', 30.18),
-    (1, 9, '2020-03-02', 29.88),
-    (1, 9, '2020-03-03', 29.82),
-    (1, 9, '2020-03-04', 29.92),
-    (1, 9, '2020-03-05', 29.91),
-    (1, 9, '2020-03-06', 29.94),
-    (1, 9, '2020-03-09', 29.97),
-    (1, 9, '2020-03-10', 29.91),
-    (1, 9, '2020-03-11', 30.07),
-    (1, 9, '2020-03-12', 30.18),
-    (1, 9, '2020-03-13', 30.13),
-    (1, 9, '2020-03-16', 30.19),
-    (1, 9, '2020-03-17', 30.21),
-    (1, 9, '2020-03-18', 30.37),
-    (1, 9, '2020-03-19', 30.4),
-    (1, 9, '2020-03-20', 30.3),
-    (1, 9, '2020-03-23', 30.33),
-    (1, 9, '2020-03-24', 30.16),
-    (1, 9, '2020-03-25', 30.29),
-    (1, 9, '2020-03-26', 30.19),
-    (1, 9, '2020-03-27', 30.21),
-    (1, 9, '2020-03-30', 30.24),
-    (1, 9, '2020-03-31', 30.25),
-    (1, 9, '2020-04-01', 30.29),
-    (1, 9, '2020-04-02', 30.2),
-    (1, 9, '2020-04-03', 30.26),
-    (1, 9, '2020-04-06', 30.21),
-    (1, 9, '2020-04-07', 30.08),
-    (1, 9, '2020-04-08', 30.11),
-    (1, 9, '2020-04-09', 30.01),
-    (1, 9, '2020-04-10', 30.06),
-    (1, 9, '2020-04-13', 30.06),
-    (1, 9, '2020-04-14', 30.07),
-    (1, 9, '2020-04-15', 30.04),
-    (1, 9, '2020-04-16', 30.11),
-    (1, 9, '2020-04-17', 30.07),
-    (1, 9, '2020-04-20', 30.04),
-    (1, 9, '2020-04-21', 30.1),
-    (1, 9, '2020-04-22', 30.1),
-    (1, 9, '2020-04-23', 30.08),
-    (1, 9, '2020-04-24', 30.1),
-    (1, 9, '2020-04-27', 30.02),
-    (1, 9, '2020-04-28', 29.99),
-    (1, 9, '2020-04-29', 29.89),
-    (1, 9, '2020-04-30', 29.72),
-    (1, 9, '2020-05-01', 29.72),
-    (1, 9, '2020-05-04', 29.82),
-    (1, 9, '2020-05-05', 29.84),
-    (1, 9, '2020-05-06', 29.9),
-    (1, 9, '2020-05-07', 29.9),
-    (1, 9, '2020-05-08', 29.87),
-    (1, 9, '2020-05-11', 29.87),
-    (1, 9, '2020-05-12', 29.88),
-    (1, 9, '2020-05-13', 29.91),
-    (1, 9, '2020-05-14', 29.91),
-    (1, 9, '2020-05-15', 29.94),
-    (1, 9, '2020-05-18', 29.96),
-    (1, 9, '2020-05-19', 29.94),
-    (1, 9, '2020-05-20', 29.97),
-    (1, 9, '2020-05-21', 29.91),
-    (1, 9, '2020-05-22', 30.08),
-    (1, 9, '2020-05-25', NULL),
-    (1, 9, '2020-05-26', 29.97),
-    (1, 9, '2020-05-27', 30.04),
-    (1, 9, '2020-05-28', 29.97),
-    (1, 9, '2020-05-29', 30.01),
-    (1, 9, '2020-06-01', 29.91),
-    (1, 9, '2020-06-02', 29.91),
-    (1, 9, '2020-06-03', 29.88),
-    (1, 9, '2020-06-04', 29.9),
-    (1, 9, '2020-06-05', 29.78),
-    (1, 9, '2020-06-08', 29.75),
-    (1, 9, '2020-06-09', 29.68),
-    (1, 9, '2020-06-10', 29.54),
-    (1, 9, '2020-06-11', 29.65),
-    (1, 9, '2020-06-12', 29.63),
-    (1, 9, '2020-06-15', 29.67),
-    (1, 9, '2020-06-16', 29.65),
-    (1, 9, '2020-06-17', 29.64),
-    (1, 9, '2020-06-18', 29.59),
-    (1, 9, '2020-06-19', 29.59),
-    (1, 9, '2020-06-22', 29.6),
-    (1, 9, '2020-06-23', 29.54),
-    (1, 9, '2020-06-24', 29.52),
-    (1, 9, '2020-06-25', 29.52),
-    (1, 9, '2020-06-26', 29.51),
-    (1, 9, '2020-06-29', 29.45),
-    (1, 9, '2020-06-30', 29.44),
-    (1, 9, '2020-07-01', 29.43),
-    (1, 9, '2020-07-02', 29.45),
-    (1, 9, '2020-07-03', NULL),
-    (1, 9, '2020-07-06', 29.4),
-    (1, 9, '2020-07-07', 29.45),
-    (1, 9, '2020-07-08', 29.43),
-    (1, 9, '2020-07-09', 29.42),
-    (1, 9, '2020-07-10', 29.45),
-    (1, 9, '2020-07-13', 29.42),
-    (1, 9, '2020-07-14', 29.45),
-    (1, 9, '2020-07-15', 29.5),
-    (1, 9, '2020-07-16', 29.44),
-    (1, 9, '2020-07-17', 29.46),
-    (1, 9, '2020-07-20', 29.45),
-    (1, 9, '2020-07-21', 29.4),
-    (1, 9, '2020-07-22', 29.41),
-    (1, 9, '2020-07-23', 29.41),
-    (1, 9, '2020-07-24', 29.45),
-    (1, 9, '2020-07-27', 29.31),
-    (1, 9, '2020-07-28', 29.3),
-    (1, 9, '2020-07-29', 29.26),
-    (1, 9, '2020-07-30', 29.32),
-    (1, 9, '2020-07-31', 29.34),
-    (1, 9, '2020-08-03', 29.35),
-    (1, 9, '2020-08-04', 29.38),
-    (1, 9, '2020-08-05', 29.34),
-    (1, 9, '2020-08-06', 29.37),
-    (1, 9, '2020-08-07', 29.39),
-    (1, 9, '2020-08-10', 29.39),
-    (1, 9, '2020-08-11', 29.39),
-    (1, 9, '2020-08-12', 29.37),
-    (1, 9, '2020-08-13', 29.43),
-    (1, 9, '2020-08-14', 29.38),
-    (1, 9, '2020-08-17', 29.38),
-    (1, 9, '2020-08-18', 29.39),
-    (1, 9, '2020-08-19', 29.37),
-    (1, 9, '2020-08-20', 29.42),
-    (1, 9, '2020-08-21', 29.4),
-    (1, 9, '2020-08-24', 29.38),
-    (1, 9, '2020-08-25', 29.38),
-    (1, 9, '2020-08-26', 29.34),
-    (1, 9, '2020-08-27', 29.36),
-    (1, 9, '2020-08-28', 29.34),
-    (1, 9, '2020-08-31', 29.37),
-    (1, 9, '2020-09-01', 29.36),
-    (1, 9, '2020-09-02', 29.36),
-    (1, 9, '2020-09-03', 29.35),
-    (1, 9, '2020-09-04', 29.32),
-    (1, 9, '2020-09-07', NULL),
-    (1, 9, '2020-09-08', 29.25),
-    (1, 9, '2020-09-09', 29.29),
-    (1, 9, '2020-09-10', 29.27),
-    (1, 9, '2020-09-11', 29.3),
-    (1, 9, '2020-09-14', 29.23),
-    (1, 9, '2020-09-15', 29.23),
-    (1, 9, '2020-09-16', 29.21),
-    (1, 9, '2020-09-17', 29.14),
-    (1, 9, '2020-09-18', 29.02),
-    (1, 9, '2020-09-21', 28.99),
-    (1, 9, '2020-09-22', 29.05),
-    (1, 9, '2020-09-23', 29.15),
-    (1, 9, '2020-09-24', 29.31),
-    (1, 9, '2020-09-25', 29.25),
-    (1, 9, '2020-09-28', 29.08),
-    (1, 9, '2020-09-29', 29.03),
-    (1, 9, '2020-09-30', 28.95),
-    (1, 9, '2020-10-01', 28.86),
-    (1, 9, '2020-10-02', 28.91),
-    (1, 9, '2020-10-05', 28.75),
-    (1, 9, '2020-10-06', 28.79),
-    (1, 9, '2020-10-07', 28.66),
-    (1, 9, '2020-10-08', 28.65),
-    (1, 9, '2020-10-09', 28.62),
-    (1, 9, '2020-10-12', NULL),
-    (1, 9, '2020-10-13', 28.74),
-    (1, 9, '2020-10-14', 28.76),
-    (1, 9, '2020-10-15', 28.77),
-    (1, 9, '2020-10-16', 28.75),
-    (1, 9, '2020-10-19', 28.73),
-    (1, 9, '2020-10-20', 28.7),
-    (1, 9, '2020-10-21', 28.67),
-    (1, 9, '2020-10-22', 28.68),
-    (1, 9, '2020-10-23', 28.65),
-    (1, 9, '2020-10-26', 28.62),
-    (1, 9, '2020-10-27', 28.56),
-    (1, 9, '2020-10-28', 28.57),
-    (1, 9, '2020-10-29', 28.59),
-    (1, 9, '2020-10-30', 28.57),
-    (1, 9, '2020-11-02', 28.55),
-    (1, 9, '2020-11-03', 28.61),
-    (1, 9, '2020-11-04', 28.6),
-    (1, 9, '2020-11-05', 28.54),
-    (1, 9, '2020-11-06', 28.59),
-    (1, 9, '2020-11-09', 28.58),
-    (1, 9, '2020-11-10', 28.55),
-    (1, 9, '2020-11-11', NULL),
-    (1, 9, '2020-11-12', 28.5),
-    (1, 9, '2020-11-13', 28.51),
-    (1, 9, '2020-11-16', 28.52),
-    (1, 9, '2020-11-17', 28.53),
-    (1, 9, '2020-11-18', 28.5),
-    (1, 9, '2020-11-19', 28.52),
-    (1, 9, '2020-11-20', 28.52),
-    (1, 9, '2020-11-23', 28.52),
-    (1, 9, '2020-11-24', 28.5),
-    (1, 9, '2020-11-25', 28.48),
-    (1, 9, '2020-11-26', NULL),
-    (1, 9, '2020-11-27', NULL),
-    (1, 9, '2020-11-30', 28.55),
-    (1, 9, '2020-12-01', 28.54),
-    (1, 9, '2020-12-02', 28.49),
-    (1, 9, '2020-12-03', 28.39),
-    (1, 9, '2020-12-04', 28.21),
-    (1, 9, '2020-12-07', 28.25),
-    (1, 9, '2020-12-08', 28.24),
-    (1, 9, '2020-12-09', 28.19),
-    (1, 9, '2020-12-10', 28.23),
-    (1, 9, '2020-12-11', 28.17),
-    (1, 9, '2020-12-14', 28.14),
-    (1, 9, '2020-12-15', 28.12),
-    (1, 9, '2020-12-16', 28.15),
-    (1, 9, '2020-12-17', 28.13),
-    (1, 9, '2020-12-18', 28.14),
-    (1, 9, '2020-12-21', 28.16),
-    (1, 9, '2020-12-22', 28.18),
-    (1, 9, '2020-12-23', 28.14),
-    (1, 9, '2020-12-24', NULL),
-    (1, 9, '2020-12-25', NULL),
-    (1, 9, '2020-12-28', 28.11),
-    (1, 9, '2020-12-29', 28.1),
-    (1, 9, '2020-12-30', 28.1),
-    (1, 9, '2020-12-31', 28.08),
-    (1, 9, '2021-01-01', NULL),
-    (1, 9, '2021-01-04', 28.08),
-    (1, 9, '2021-01-05', 27.99),
-    (1, 9, '2021-01-06', 28.0),
-    (1, 9, '2021-01-07', 28.02),
-    (1, 9, '2021-01-08', 27.98),
-    (1, 9, '2021-01-11', 28.0),
-    (1, 9, '2021-01-12', 28.0),
-    (1, 9, '2021-01-13', 28.01),
-    (1, 9, '2021-01-14', 28.01),
-    (1, 9, '2021-01-15', 28.02),
-    (1, 9, '2021-01-18', NULL),
-    (1, 9, '2021-01-19', 28.02),
-    (1, 9, '2021-01-20', NULL),
-    (1, 9, '2021-01-21', 27.97),
-    (1, 9, '2021-01-22', 27.96),
-    (1, 9, '2021-01-25', 27.99),
-    (1, 9, '2021-01-26', 28.02),
-    (1, 9, '2021-01-27', 28.0),
-    (1, 9, '2021-01-28', 28.03),
-    (1, 9, '2021-01-29', 28.01);
\ No newline at end of file